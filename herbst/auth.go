@@ -920,6 +920,11 @@ func (m *model) loadCharacter(charID int) {
 
 	// Update last seen
 	m.updateLastSeenAt()
+	m.updateCurrentRoom()
+
+	// Subscribe to live room events (arrivals, speech, fights, NPCs)
+	m.startEventStream()
+	m.refreshPartyMembers()
 
 	m.effectsService.FireEvent("on_login", m.currentCharacterID, "", map[string]interface{}{
 		"room_id": m.currentRoom,
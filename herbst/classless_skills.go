@@ -3,7 +3,6 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"net/http"
 )
 
@@ -117,180 +116,34 @@ func (m *model) useAbilitySlot(slot int) bool {
 		return false
 	}
 
+	// The server checks cooldowns and costs again and resolves the effects
+	// on the next tick; the checks above just save a round trip.
+	payload := fmt.Sprintf(`{"action": "skill", "skill_id": %d}`, ability.ID)
+	if !m.sendCombatAction(payload, ability.Name) {
+		return false
+	}
 	m.characterMana -= ability.ManaCost
 	m.characterStamina -= ability.StaminaCost
-
-	m.resolveAbilityEffects(ability)
 	m.combatSkills.Cooldowns[ability.ID] = ability.Cooldown
-	return true
-}
 
-// resolveAbilityEffects applies all effects of an ability generically
-func (m *model) resolveAbilityEffects(ability AbilityData) {
 	for _, effect := range ability.Effects {
-		modifier := m.calcScalingModifier(effect.ScalingStat, effect.ScalingRatio, effect.Value)
-
-		active := ActiveEffect{
-			AbilityID:  ability.ID,
-			Name:       ability.Name,
-			EffectType: effect.EffectType,
-			Target:      effect.Target,
-			Duration:   effect.Duration,
-			Modifier:   modifier,
-			AppliedAt:  m.currentTick(),
-		}
-
-		switch effect.EffectType {
-		case "damage":
-			m.applyDamageEffect(ability, effect, modifier)
-		case "heal":
-			m.applyHealEffect(ability, effect, modifier)
-		case "stun":
-			m.applyStunEffect(ability, effect)
-		case "set_bind_point":
+		if effect.EffectType == "set_bind_point" {
 			m.setBindPoint()
-		default:
-			m.combatSkills.ActiveEffects = append(m.combatSkills.ActiveEffects, active)
-			m.addCombatLog(fmt.Sprintf("%s: %s applied for %d rounds", ability.Name, effect.EffectType, effect.Duration))
-		}
-	}
-}
-
-// calcScalingModifier computes the stat-scaled modifier for an effect
-func (m *model) calcScalingModifier(stat string, ratio float64, baseValue int) int {
-	if stat == "" || ratio == 0 {
-		return baseValue
-	}
-	statVal := m.getStatValue(stat)
-	statMod := (statVal - 10) / 2
-	if statMod < 0 {
-		statMod = 0
-	}
-	scaled := int(float64(baseValue) + float64(statMod)*ratio*float64(baseValue))
-	if scaled < 1 && baseValue > 0 {
-		scaled = baseValue
-	}
-	return scaled
-}
-
-// getStatValue returns a stat value by name
-func (m *model) getStatValue(stat string) int {
-	switch stat {
-	case "strength":
-		return m.getCharacterStrength()
-	case "dexterity":
-		return m.characterLevel*2 + 8
-	case "constitution":
-		return 10 + m.characterLevel
-	case "intelligence":
-		return 10 + m.characterLevel/2
-	case "wisdom":
-		return m.getCharacterWisdom()
-	default:
-		return 10
-	}
-}
-
-// currentTick returns the current combat tick
-func (m *model) currentTick() int {
-	if c, ok := m.combatManager.GetCombat(m.combatID); ok {
-		return c.CurrentTick
-	}
-	return 0
-}
-
-// applyDamageEffect applies a damage effect to the target
-func (m *model) applyDamageEffect(ability AbilityData, effect EffectData, damage int) {
-	if effect.Target == "enemy" && m.combatTarget != nil {
-		applyDamageToCharacter(m.combatTarget.ID, damage)
-		m.combatTarget.HP -= damage
-		if m.combatTarget.HP < 0 {
-			m.combatTarget.HP = 0
-		}
-		m.addCombatLog(fmt.Sprintf("%s deals %d damage!", ability.Name, damage))
-		if m.combatTarget.HP <= 0 {
-			m.handleTargetDefeat()
 		}
-	} else {
-		m.addCombatLog(fmt.Sprintf("%s: %d damage to %s", ability.Name, damage, effect.Target))
-	}
-}
-
-// applyHealEffect applies a heal effect
-func (m *model) applyHealEffect(ability AbilityData, effect EffectData, amount int) {
-	if effect.Target == "self" {
-		m.characterHP += amount
-		if m.characterHP > m.characterMaxHP {
-			m.characterHP = m.characterMaxHP
-		}
-		healCharacter(m.currentCharacterID, amount)
-		m.addCombatLog(fmt.Sprintf("%s heals %d HP!", ability.Name, amount))
-	} else {
-		m.addCombatLog(fmt.Sprintf("%s: %d heal to %s", ability.Name, amount, effect.Target))
 	}
+	return true
 }
 
-// applyStunEffect applies a stun effect with a stat contest
-func (m *model) applyStunEffect(ability AbilityData, effect EffectData) {
-	if effect.Target != "enemy" {
-		m.addCombatLog(fmt.Sprintf("%s: stun applied to %s", ability.Name, effect.Target))
+// decrementCooldowns reduces the local skill cooldowns by one tick.
+func (m *model) decrementCooldowns() {
+	if m.combatSkills == nil {
 		return
 	}
-
-	dexMod := m.getDexModifier()
-	targetCon := 10
-	if m.combatTarget != nil {
-		targetCon = m.getTargetConstitution()
-	}
-
-	success := dexMod + rollDie(6) > (targetCon-10)/2 + rollDie(6)
-
-	if success {
-		active := ActiveEffect{
-			AbilityID:  ability.ID,
-			Name:       ability.Name,
-			EffectType: "stun",
-			Target:      "enemy",
-			Duration:   effect.Duration,
-			AppliedAt:  m.currentTick(),
+	for skillID, cd := range m.combatSkills.Cooldowns {
+		if cd > 0 {
+			m.combatSkills.Cooldowns[skillID] = cd - 1
 		}
-		m.combatSkills.ActiveEffects = append(m.combatSkills.ActiveEffects, active)
-		m.addCombatLog(fmt.Sprintf("%s! Target is stunned for %d round!", ability.Name, effect.Duration))
-	} else {
-		m.addCombatLog(fmt.Sprintf("%s missed! Target resisted.", ability.Name))
-	}
-}
-
-// getCharacterWisdom fetches WIS from server
-func (m *model) getCharacterWisdom() int {
-	if m.currentCharacterID == 0 {
-		return 10
-	}
-	resp, err := httpGet(fmt.Sprintf("%s/characters/%d/stats", RESTAPIBase, m.currentCharacterID))
-	if err != nil {
-		return 10
-	}
-	defer resp.Body.Close()
-	var stats struct {
-		Wisdom int `json:"wisdom"`
 	}
-	if json.NewDecoder(resp.Body).Decode(&stats) != nil {
-		return 10
-	}
-	return stats.Wisdom
-}
-
-// getTargetConstitution estimates target CON
-func (m *model) getTargetConstitution() int {
-	if m.combatTarget == nil {
-		return 10
-	}
-	return 10 + m.combatTarget.Level
-}
-
-// rollDie rolls a simple dN die
-func rollDie(sides int) int {
-	return 1 + rand.Intn(sides)
 }
 
 // setBindPoint updates the character's respawn room to the current room
@@ -298,7 +151,7 @@ func (m *model) setBindPoint() {
 	url := fmt.Sprintf("%s/characters/%d", RESTAPIBase, m.currentCharacterID)
 	payload := fmt.Sprintf(`{"respawnRoomId": %d}`, m.currentRoom)
 
-	resp, err := httpPut(url, payload)
+	resp, err := m.authedRequest("PUT", url, payload)
 	if err != nil {
 		m.AppendMessage("Failed to set bind point.", "error")
 		return
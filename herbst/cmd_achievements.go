@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================
+// ACHIEVEMENT COMMANDS — who, achievements, title
+// ============================================================
+
+// achievementsView mirrors the server's achievements response.
+type achievementsView struct {
+	Title        string `json:"title"`
+	Achievements []struct {
+		Name      string `json:"name"`
+		Title     string `json:"title"`
+		XPReward  int    `json:"xp_reward"`
+		Progress  int    `json:"progress"`
+		Target    int    `json:"target"`
+		Completed bool   `json:"completed"`
+	} `json:"achievements"`
+}
+
+// whoView mirrors the server's who response.
+type whoView struct {
+	Players []struct {
+		Name  string `json:"name"`
+		Title string `json:"title"`
+		Level int    `json:"level"`
+		Class string `json:"class"`
+	} `json:"players"`
+}
+
+// titledName is how a character appears in who and examine: their name
+// followed by their chosen title, e.g. "Mira the Ratcatcher".
+func titledName(name, title string) string {
+	if title == "" {
+		return name
+	}
+	return name + " " + title
+}
+
+// handleWhoCommand lists the players who are online.
+func (m *model) handleWhoCommand(_ *model, args []string) {
+	if m.characterToken == "" {
+		m.AppendMessage("You must be playing to see who is online.", "error")
+		return
+	}
+	var who whoView
+	if !m.apiRequest("GET", "/api/who", nil, &who) {
+		return
+	}
+	if len(who.Players) == 0 {
+		m.AppendMessage("You are alone in the world.", "info")
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("=== Players Online (%d) ===\n\n", len(who.Players)))
+	for _, p := range who.Players {
+		sb.WriteString(fmt.Sprintf("[%3d %-10s] %s\n", p.Level, p.Class, titledName(p.Name, p.Title)))
+	}
+	m.AppendMessage(sb.String(), "info")
+}
+
+// handleAchievementsCommand shows completed achievements and progress
+// towards the rest.
+func (m *model) handleAchievementsCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to see your achievements.", "error")
+		return
+	}
+	var view achievementsView
+	if !m.apiRequest("GET", fmt.Sprintf("/api/characters/%d/achievements", m.currentCharacterID), nil, &view) {
+		return
+	}
+	if len(view.Achievements) == 0 {
+		m.AppendMessage("There are no achievements to earn yet.", "info")
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString("=== Achievements ===\n\n")
+	for _, a := range view.Achievements {
+		marker := "  "
+		if a.Completed {
+			marker = "★ "
+		}
+		line := fmt.Sprintf("%s%-28s %d/%d", marker, a.Name, a.Progress, a.Target)
+		if a.Title != "" {
+			line += fmt.Sprintf("  (title: %s)", a.Title)
+		}
+		sb.WriteString(line + "\n")
+	}
+	if view.Title != "" {
+		sb.WriteString(fmt.Sprintf("\nCurrent title: %s", view.Title))
+	} else {
+		sb.WriteString("\nUse 'title <title>' to display a title you have earned.")
+	}
+	m.AppendMessage(sb.String(), "info")
+}
+
+// handleTitleCommand sets or clears the title shown after the character's
+// name: title <title> | title none
+func (m *model) handleTitleCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to change your title.", "error")
+		return
+	}
+	if len(args) == 0 {
+		m.AppendMessage("Usage: title <title> | title none", "error")
+		return
+	}
+	var result struct {
+		Title string `json:"title"`
+	}
+	path := fmt.Sprintf("/api/characters/%d/title", m.currentCharacterID)
+	if !m.apiRequest("PUT", path, map[string]string{"title": strings.Join(args, " ")}, &result) {
+		return
+	}
+	if result.Title == "" {
+		m.AppendMessage("You no longer display a title.", "success")
+		return
+	}
+	m.AppendMessage(fmt.Sprintf("Your title is now \"%s\".", result.Title), "success")
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ============================================================
+// AUCTION COMMANDS — auction list/mine/sell/bid/buyout/cancel/collect
+// ============================================================
+
+// auctionView mirrors one auction in the server's auction responses.
+type auctionView struct {
+	ID       int    `json:"id"`
+	Item     string `json:"item"`
+	Seller   string `json:"seller"`
+	StartBid int    `json:"start_bid"`
+	Buyout   int    `json:"buyout"`
+	Bid      int    `json:"bid"`
+	MinBid   int    `json:"min_bid"`
+	Winning  bool   `json:"winning"`
+	Status   string `json:"status"`
+	Proceeds int    `json:"proceeds"`
+}
+
+// handleAuctionCommand handles the auction command:
+// auction [list] [search]                   - browse the auction house
+// auction mine                              - your auctions, bids and proceeds
+// auction sell <item> <start> [buyout] [hours] - list an item
+// auction bid <id> <amount>                 - bid on an auction
+// auction buyout <id>                       - buy an item outright
+// auction cancel <id>                       - take back an item nobody bid on
+// auction collect                           - collect your proceeds
+func (m *model) handleAuctionCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to use the auction house.", "error")
+		return
+	}
+	base := fmt.Sprintf("/api/characters/%d/auctions", m.currentCharacterID)
+	if len(args) == 0 {
+		m.browseAuctions(base, "")
+		return
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "list", "browse", "search":
+		m.browseAuctions(base, strings.Join(args[1:], " "))
+	case "mine":
+		m.showMyAuctions(base)
+	case "sell":
+		m.sellAtAuction(base, args[1:])
+	case "bid":
+		if len(args) < 3 {
+			m.AppendMessage("Usage: auction bid <id> <amount>", "error")
+			return
+		}
+		id, err1 := strconv.Atoi(strings.TrimPrefix(args[1], "#"))
+		amount, err2 := strconv.Atoi(args[2])
+		if err1 != nil || err2 != nil {
+			m.AppendMessage("Usage: auction bid <id> <amount>", "error")
+			return
+		}
+		var a auctionView
+		if !m.apiRequest("POST", fmt.Sprintf("%s/%d/bid", base, id), map[string]int{"amount": amount}, &a) {
+			return
+		}
+		if a.Status == "sold" {
+			m.AppendMessage(fmt.Sprintf("You buy %s for %d gold. It is in your inventory.", a.Item, a.Bid), "success")
+			return
+		}
+		m.AppendMessage(fmt.Sprintf("You bid %d gold on %s.", a.Bid, a.Item), "success")
+	case "buyout", "buy":
+		id, ok := auctionArg(m, args, "buyout")
+		if !ok {
+			return
+		}
+		var a auctionView
+		if m.apiRequest("POST", fmt.Sprintf("%s/%d/buyout", base, id), nil, &a) {
+			m.AppendMessage(fmt.Sprintf("You buy %s for %d gold. It is in your inventory.", a.Item, a.Bid), "success")
+		}
+	case "cancel":
+		id, ok := auctionArg(m, args, "cancel")
+		if !ok {
+			return
+		}
+		var result struct {
+			Message string `json:"message"`
+		}
+		if m.apiRequest("DELETE", fmt.Sprintf("%s/%d", base, id), nil, &result) {
+			m.AppendMessage("You cancel the auction and take the item back.", "success")
+		}
+	case "collect":
+		var result struct {
+			Gold int `json:"gold"`
+		}
+		if m.apiRequest("POST", base+"/collect", nil, &result) {
+			m.AppendMessage(fmt.Sprintf("You collect %d gold from your sales.", result.Gold), "success")
+		}
+	default:
+		m.AppendMessage("Usage: auction [list [search]|mine|sell <item> <start> [buyout] [hours]|bid <id> <amount>|buyout <id>|cancel <id>|collect]", "error")
+	}
+}
+
+// auctionArg reads the auction ID after an auction subcommand.
+func auctionArg(m *model, args []string, sub string) (int, bool) {
+	if len(args) >= 2 {
+		if id, err := strconv.Atoi(strings.TrimPrefix(args[1], "#")); err == nil {
+			return id, true
+		}
+	}
+	m.AppendMessage(fmt.Sprintf("Usage: auction %s <id>", sub), "error")
+	return 0, false
+}
+
+// browseAuctions lists the open auctions, optionally filtered by item name.
+func (m *model) browseAuctions(base, search string) {
+	path := base
+	if search != "" {
+		path += "?q=" + url.QueryEscape(search)
+	}
+	var result struct {
+		Auctions []auctionView `json:"auctions"`
+	}
+	if !m.apiRequest("GET", path, nil, &result) {
+		return
+	}
+	if len(result.Auctions) == 0 {
+		m.AppendMessage("Nothing is up for auction.", "info")
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString("=== Auction House ===\n\n")
+	for _, a := range result.Auctions {
+		sb.WriteString(formatAuction(a) + "\n")
+	}
+	sb.WriteString("\nUse 'auction bid <id> <amount>' or 'auction buyout <id>'.")
+	m.AppendMessage(sb.String(), "info")
+}
+
+// showMyAuctions lists the character's auctions, winning bids and proceeds.
+func (m *model) showMyAuctions(base string) {
+	var mine struct {
+		Selling  []auctionView `json:"selling"`
+		Bidding  []auctionView `json:"bidding"`
+		Proceeds int           `json:"proceeds"`
+	}
+	if !m.apiRequest("GET", base+"/mine", nil, &mine) {
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString("=== Your Auctions ===\n\n")
+	if len(mine.Selling) == 0 {
+		sb.WriteString("You are selling nothing.\n")
+	}
+	for _, a := range mine.Selling {
+		if a.Status == "sold" {
+			sb.WriteString(fmt.Sprintf("#%-4d %-24s sold for %d, %d to collect\n", a.ID, a.Item, a.Bid, a.Proceeds))
+			continue
+		}
+		sb.WriteString(formatAuction(a) + "\n")
+	}
+	if len(mine.Bidding) > 0 {
+		sb.WriteString("\nWinning bids:\n")
+		for _, a := range mine.Bidding {
+			sb.WriteString(formatAuction(a) + "\n")
+		}
+	}
+	if mine.Proceeds > 0 {
+		sb.WriteString(fmt.Sprintf("\n%d gold waiting. Use 'auction collect' at an auction house.", mine.Proceeds))
+	}
+	m.AppendMessage(strings.TrimRight(sb.String(), "\n"), "info")
+}
+
+// sellAtAuction parses: <item> <start> [buyout] [hours]. The numbers are
+// read from the end, so the item name can have spaces.
+func (m *model) sellAtAuction(base string, args []string) {
+	usage := "Usage: auction sell <item> <start> [buyout] [hours]"
+	var nums []int
+	for len(args) > 1 && len(nums) < 3 {
+		n, err := strconv.Atoi(args[len(args)-1])
+		if err != nil {
+			break
+		}
+		nums = append([]int{n}, nums...)
+		args = args[:len(args)-1]
+	}
+	if len(args) == 0 || len(nums) == 0 {
+		m.AppendMessage(usage, "error")
+		return
+	}
+	name := strings.Join(args, " ")
+	id := matchInventoryItem(m.fetchInventoryItems(), name, nil)
+	if id == 0 {
+		m.AppendMessage(fmt.Sprintf("You aren't carrying %q.", name), "error")
+		return
+	}
+	req := map[string]int{"item_id": id, "start_bid": nums[0]}
+	if len(nums) > 1 {
+		req["buyout"] = nums[1]
+	}
+	if len(nums) > 2 {
+		req["hours"] = nums[2]
+	}
+	var a auctionView
+	if m.apiRequest("POST", base, req, &a) {
+		m.AppendMessage(fmt.Sprintf("You put %s up for auction (#%d).", a.Item, a.ID), "success")
+	}
+}
+
+// formatAuction renders one open auction on a line.
+func formatAuction(a auctionView) string {
+	line := fmt.Sprintf("#%-4d %-24s %-12s", a.ID, a.Item, a.Seller)
+	if a.Bid > 0 {
+		line += fmt.Sprintf(" bid %d", a.Bid)
+	} else {
+		line += fmt.Sprintf(" start %d", a.StartBid)
+	}
+	if a.Buyout > 0 {
+		line += fmt.Sprintf(", buyout %d", a.Buyout)
+	}
+	if a.Winning {
+		line += " (your bid)"
+	}
+	return line
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ============================================================
+// BANK COMMANDS — bank/deposit/withdraw/expand
+// ============================================================
+
+// stashView mirrors one stash in the server's bank responses.
+type stashView struct {
+	Gold  int `json:"gold"`
+	Slots int `json:"slots"`
+	Items []struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"items"`
+	ExpandCost int `json:"expand_cost"`
+}
+
+// bankView mirrors the server's bank responses.
+type bankView struct {
+	CarriedGold int        `json:"carried_gold"`
+	Stash       stashView  `json:"stash"`
+	Account     *stashView `json:"account"`
+}
+
+// handleBankCommand handles the bank command:
+// bank                                   - show your stash
+// bank deposit [account] <gold|item>     - put gold or an item in a stash
+// bank withdraw [account] <gold|item>    - take gold or an item out
+// bank expand [account]                  - buy more stash slots
+func (m *model) handleBankCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to use a bank.", "error")
+		return
+	}
+	base := fmt.Sprintf("/api/characters/%d/bank", m.currentCharacterID)
+	usage := "Usage: bank [deposit|withdraw [account] <gold|item>|expand [account]]"
+	if len(args) == 0 {
+		var v bankView
+		if m.apiRequest("GET", base, nil, &v) {
+			m.AppendMessage(formatBank(v), "info")
+		}
+		return
+	}
+
+	sub := strings.ToLower(args[0])
+	args = args[1:]
+	account := len(args) > 0 && strings.ToLower(args[0]) == "account"
+	if account {
+		args = args[1:]
+	}
+
+	switch sub {
+	case "deposit", "dep":
+		req, ok := m.bankTransfer(base, args, account, true)
+		if !ok {
+			m.AppendMessage(usage, "error")
+			return
+		}
+		var v bankView
+		if m.apiRequest("POST", base+"/deposit", req, &v) {
+			m.AppendMessage("Deposited.\n\n"+formatBank(v), "success")
+		}
+	case "withdraw", "wd":
+		req, ok := m.bankTransfer(base, args, account, false)
+		if !ok {
+			m.AppendMessage(usage, "error")
+			return
+		}
+		var v bankView
+		if m.apiRequest("POST", base+"/withdraw", req, &v) {
+			m.AppendMessage("Withdrawn.\n\n"+formatBank(v), "success")
+		}
+	case "expand":
+		var v bankView
+		if m.apiRequest("POST", base+"/expand", map[string]bool{"account": account}, &v) {
+			m.AppendMessage("Your stash grows.\n\n"+formatBank(v), "success")
+		}
+	default:
+		m.AppendMessage(usage, "error")
+	}
+}
+
+// bankTransfer builds a deposit or withdrawal from its arguments: a number
+// is gold, anything else names an item you carry (deposit) or that is in the
+// stash (withdraw).
+func (m *model) bankTransfer(base string, args []string, account, deposit bool) (map[string]interface{}, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+	req := map[string]interface{}{"account": account}
+	if len(args) == 1 {
+		if gold, err := strconv.Atoi(args[0]); err == nil {
+			req["gold"] = gold
+			return req, true
+		}
+	}
+	name := strings.Join(args, " ")
+	id := 0
+	if deposit {
+		id = matchInventoryItem(m.fetchInventoryItems(), name, nil)
+	} else {
+		var v bankView
+		if !m.apiRequest("GET", base, nil, &v) {
+			return nil, false
+		}
+		st := &v.Stash
+		if account {
+			st = v.Account
+		}
+		if st != nil {
+			for _, it := range st.Items {
+				if strings.Contains(strings.ToLower(it.Name), strings.ToLower(name)) {
+					id = it.ID
+					break
+				}
+			}
+		}
+	}
+	if id == 0 {
+		m.AppendMessage(fmt.Sprintf("There is no %q to move.", name), "error")
+		return nil, false
+	}
+	req["item_ids"] = []int{id}
+	return req, true
+}
+
+// formatBank renders the gold you carry and your stashes.
+func formatBank(v bankView) string {
+	var sb strings.Builder
+	sb.WriteString("=== Bank ===\n\n")
+	sb.WriteString(fmt.Sprintf("You carry %d gold.\n", v.CarriedGold))
+	sb.WriteString(formatStash("Your stash", v.Stash))
+	if v.Account != nil {
+		sb.WriteString(formatStash("Account stash", *v.Account))
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func formatStash(title string, s stashView) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("\n%s: %d gold, %d/%d slots\n", title, s.Gold, len(s.Items), s.Slots))
+	for _, it := range s.Items {
+		sb.WriteString("  " + it.Name + "\n")
+	}
+	if s.ExpandCost >= 0 {
+		sb.WriteString(fmt.Sprintf("  More slots cost %d gold.\n", s.ExpandCost))
+	}
+	return sb.String()
+}
@@ -11,31 +11,39 @@ import (
 // CRAFTING COMMANDS — craft, recipes, stations
 // ============================================================
 
-// handleCraftCommand crafts an item using a recipe
+// handleCraftCommand crafts an item using a recipe. Timed recipes run in
+// the background; progress and the result arrive on the event stream.
+// craft <recipe_name>   - start crafting
+// craft stop            - stop crafting (materials are kept)
 func (m *model) handleCraftCommand(cmd string) {
-	if m.currentCharacterID == 0 {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
 		m.AppendMessage("You need to be playing to craft items.", "error")
 		return
 	}
 
 	parts := strings.Fields(cmd)
 	if len(parts) < 2 {
-		m.AppendMessage("Usage: craft <recipe_name>\nType 'recipes' to see available recipes.", "error")
+		m.AppendMessage("Usage: craft <recipe_name> | craft stop\nType 'recipes' to see available recipes.", "error")
 		return
 	}
 
-	recipeName := strings.Join(parts[1:], " ")
 	url := fmt.Sprintf("%s/api/characters/%d/craft", RESTAPIBase, m.currentCharacterID)
-	payload := fmt.Sprintf(`{"recipe": "%s"}`, recipeName)
+	if len(parts) == 2 && (strings.EqualFold(parts[1], "stop") || strings.EqualFold(parts[1], "cancel")) {
+		m.stopCrafting(url)
+		return
+	}
+
+	recipeName := strings.Join(parts[1:], " ")
+	payload, _ := json.Marshal(map[string]string{"recipe": recipeName})
 
-	resp, err := httpPost(url, payload)
+	resp, err := m.authedRequest("POST", url, string(payload))
 	if err != nil {
 		m.AppendMessage(fmt.Sprintf("Error crafting: %v", err), "error")
 		return
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
 		var errResp struct {
 			Error string `json:"error"`
 		}
@@ -48,19 +56,36 @@ func (m *model) handleCraftCommand(cmd string) {
 	}
 
 	var craftResp struct {
-		Success bool `json:"success"`
+		Success bool   `json:"success"`
+		Outcome string `json:"outcome"`
 		Outputs []struct {
 			Name       string `json:"name"`
 			InstanceID int    `json:"instance_id"`
 		} `json:"outputs"`
+		Result struct {
+			Message string `json:"message"`
+		} `json:"result"`
+		Job struct {
+			DisplayName  string `json:"display_name"`
+			DurationSecs int    `json:"duration_secs"`
+		} `json:"job"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&craftResp); err != nil {
 		m.AppendMessage("Error reading crafting response", "error")
 		return
 	}
 
-	if !craftResp.Success {
-		m.AppendMessage("Crafting was not successful.", "error")
+	if resp.StatusCode == http.StatusAccepted {
+		m.AppendMessage(fmt.Sprintf("You begin crafting %s. (about %ds — 'craft stop' to stop)", craftResp.Job.DisplayName, craftResp.Job.DurationSecs), "info")
+		return
+	}
+
+	if !craftResp.Success || craftResp.Outcome == "failure" {
+		msg := craftResp.Result.Message
+		if msg == "" {
+			msg = "Crafting was not successful."
+		}
+		m.AppendMessage(msg, "error")
 		return
 	}
 
@@ -71,6 +96,24 @@ func (m *model) handleCraftCommand(cmd string) {
 	m.AppendMessage(output, "success")
 }
 
+// stopCrafting cancels the character's running craft.
+func (m *model) stopCrafting(url string) {
+	resp, err := m.authedRequest("DELETE", url, "")
+	if err != nil {
+		m.AppendMessage(fmt.Sprintf("Error stopping craft: %v", err), "error")
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		m.AppendMessage("You are not crafting anything.", "error")
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		m.AppendMessage(fmt.Sprintf("Could not stop crafting (status %d)", resp.StatusCode), "error")
+	}
+	// The "You stop crafting." line arrives on the event stream.
+}
+
 // handleRecipesCommand lists available recipes
 func (m *model) handleRecipesCommand(cmd string) {
 	if m.currentCharacterID == 0 {
@@ -2,8 +2,7 @@ package main
 
 import (
 	"fmt"
-	"io"
-	"net/http"
+	"sort"
 	"strings"
 )
 
@@ -19,20 +18,43 @@ func (m *model) displayItemDetails(item RoomItem) {
 	}
 	details.WriteString(desc + "\n")
 
-	if item.ItemType == "weapon" || item.ItemType == "armor" {
+	isGear := item.ItemType == "weapon" || item.ItemType == "armor" || item.DamageDiceCount > 0 || item.ArmorRating > 0
+	if isGear {
 		details.WriteString("\n--- Stats ---\n")
+		if item.Rarity != "" && item.Rarity != "common" {
+			details.WriteString(fmt.Sprintf("  Rarity: %s\n", strings.Title(item.Rarity)))
+		}
 		if item.Weight > 0 {
 			details.WriteString(fmt.Sprintf("  Weight: %d\n", item.Weight))
 		}
-		if item.ItemDamage > 0 {
+		if item.DamageDiceCount > 0 && item.DamageDiceSides > 0 {
+			dmg := fmt.Sprintf("%dd%d", item.DamageDiceCount, item.DamageDiceSides)
+			if item.DamageBonus != 0 {
+				dmg += fmt.Sprintf("%+d", item.DamageBonus)
+			}
+			if item.DamageType != "" {
+				dmg += " " + item.DamageType
+			}
+			details.WriteString(fmt.Sprintf("  Damage: %s\n", dmg))
+		} else if item.ItemDamage > 0 {
 			details.WriteString(fmt.Sprintf("  Damage: %d\n", item.ItemDamage))
 		}
+		if item.ArmorRating > 0 {
+			details.WriteString(fmt.Sprintf("  Armor: +%d AC\n", item.ArmorRating))
+		}
 		if item.ItemDurability > 0 {
 			details.WriteString(fmt.Sprintf("  Durability: %d\n", item.ItemDurability))
 		}
 		details.WriteString(fmt.Sprintf("  Type: %s\n", item.ItemType))
 	}
 
+	if lines := formatItemAffixes(item.Stats); len(lines) > 0 {
+		details.WriteString("\n--- Affixes ---\n")
+		for _, line := range lines {
+			details.WriteString("  " + line + "\n")
+		}
+	}
+
 	if len(item.HiddenDetails) > 0 {
 		details.WriteString("\n--- You Notice ---\n")
 		for _, hd := range item.HiddenDetails {
@@ -43,6 +65,29 @@ func (m *model) displayItemDetails(item RoomItem) {
 	m.AppendMessage(details.String(), "info")
 }
 
+// formatItemAffixes describes an item's stat bonuses and loot affixes,
+// e.g. "+2 strength" or "+1d4 fire damage".
+func formatItemAffixes(stats map[string]int) []string {
+	var lines []string
+	for _, x := range affixExtraDice(stats) {
+		lines = append(lines, fmt.Sprintf("+%dd%d %s damage", x.Count, x.Sides, x.Type))
+	}
+	keys := make([]string, 0, len(stats))
+	for key := range stats {
+		if strings.HasSuffix(key, "_damage_dice") || strings.HasSuffix(key, "_damage_sides") {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if v := stats[key]; v != 0 {
+			lines = append(lines, fmt.Sprintf("%+d %s", v, strings.ReplaceAll(key, "_", " ")))
+		}
+	}
+	return lines
+}
+
 // fuzzyWordMatch returns true if all words in target appear as substrings in name.
 // "grand man" matches "Grand Ol' Man". Case-insensitive.
 func fuzzyWordMatch(name, target string) bool {
@@ -54,29 +99,3 @@ func fuzzyWordMatch(name, target string) bool {
 	}
 	return true
 }
-
-// httpGet is a helper for making GET requests
-func httpGet(url string) (*http.Response, error) {
-	return http.Get(url)
-}
-
-// httpPost is a helper for making POST requests with a body
-func httpPost(url, body string) (*http.Response, error) {
-	return http.Post(url, "application/json", strings.NewReader(body))
-}
-
-// httpPut is a helper for making PUT requests with a body
-func httpPut(url, body string) (*http.Response, error) {
-	req, err := http.NewRequest("PUT", url, strings.NewReader(body))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{Timeout: 5e9}
-	return client.Do(req)
-}
-
-// ioReadAll is exported for use by other files
-func ioReadAll(r io.Reader) ([]byte, error) {
-	return io.ReadAll(r)
-}
\ No newline at end of file
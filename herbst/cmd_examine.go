@@ -30,16 +30,19 @@ func (m *model) handleExamineCommand(cmd string) {
 	}
 
 	// Hidden items that reveal on examine
-	if m.currentRoom > 0 {
-		m.examineHiddenItems(target)
+	if m.currentRoom > 0 && m.examineHiddenItems(target) {
 		return
 	}
 
 	// Inventory
-	m.examineInventory(target)
+	if m.examineInventory(target) {
+		return
+	}
 
 	// NPCs
-	m.examineNPCs(target)
+	if m.examineNPCs(target) {
+		return
+	}
 
 	m.AppendMessage(fmt.Sprintf("You don't see '%s' here.", target), "error")
 }
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ============================================================
+// DOOR COMMANDS — open/close/lock/unlock <direction>, hidden exits
+// ============================================================
+
+// exitView mirrors one exit in the server's exit responses.
+type exitView struct {
+	Direction string `json:"direction"`
+	Target    int    `json:"target"`
+	Door      bool   `json:"door"`
+	State     string `json:"state"`
+}
+
+// setExits sets the exits of the room just entered. When playing, the
+// server says which of them the character can see and which doors are
+// shut, so hidden exits they haven't found stay hidden. If the server can't
+// be reached, or is looking at another room, every exit is shown.
+func (m *model) setExits(exits map[string]int) {
+	m.exits = exits
+	m.exitStates = map[string]string{}
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		return
+	}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/characters/%d/exits", RESTAPIBase, m.currentCharacterID), nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+m.characterToken)
+	resp, err := (&http.Client{Timeout: 3 * time.Second}).Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	var result struct {
+		Exits []exitView `json:"exits"`
+	}
+	if resp.StatusCode != http.StatusOK || json.NewDecoder(resp.Body).Decode(&result) != nil {
+		return
+	}
+
+	visible := make(map[string]int, len(result.Exits))
+	states := map[string]string{}
+	for _, ex := range result.Exits {
+		if to, ok := exits[ex.Direction]; !ok || to != ex.Target {
+			return
+		}
+		visible[ex.Direction] = ex.Target
+		if ex.State != "" && ex.State != "open" {
+			states[ex.Direction] = ex.State
+		}
+	}
+	m.exits = visible
+	m.exitStates = states
+}
+
+// handleDoorCommand handles open, close, lock and unlock <direction>.
+func (m *model) handleDoorCommand(_ *model, args []string) {
+	verb := strings.ToLower(args[0])
+	if len(args) < 2 {
+		m.AppendMessage(fmt.Sprintf("Usage: %s <direction>", verb), "error")
+		return
+	}
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to use doors.", "error")
+		return
+	}
+	dir := strings.ToLower(args[1])
+	if full, ok := directionNames[dir]; ok {
+		dir = full
+	}
+	var ex exitView
+	if !m.apiRequest("POST", fmt.Sprintf("/api/characters/%d/exits/%s/%s", m.currentCharacterID, dir, verb), nil, &ex) {
+		return
+	}
+	if m.exitStates == nil {
+		m.exitStates = map[string]string{}
+	}
+	if ex.State == "open" {
+		delete(m.exitStates, dir)
+	} else {
+		m.exitStates[dir] = ex.State
+	}
+	m.AppendMessage(fmt.Sprintf("You %s the door %s.", verb, dir), "success")
+}
+
+// searchForExits asks the server to search the room for hidden exits and
+// adds any found to the room's exits, returning their directions.
+func (m *model) searchForExits() []string {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		return nil
+	}
+	var result struct {
+		Found []exitView `json:"found"`
+	}
+	if !m.apiRequest("POST", fmt.Sprintf("/api/characters/%d/search", m.currentCharacterID), nil, &result) {
+		return nil
+	}
+	if m.exits == nil {
+		m.exits = map[string]int{}
+	}
+	if m.exitStates == nil {
+		m.exitStates = map[string]string{}
+	}
+	var dirs []string
+	for _, ex := range result.Found {
+		m.exits[ex.Direction] = ex.Target
+		if ex.State != "" && ex.State != "open" {
+			m.exitStates[ex.Direction] = ex.State
+		}
+		dirs = append(dirs, ex.Direction)
+	}
+	return dirs
+}
+
+// directionNames maps direction abbreviations to the names exits use.
+var directionNames = map[string]string{
+	"n": "north", "s": "south", "e": "east", "w": "west",
+	"ne": "northeast", "se": "southeast", "sw": "southwest", "nw": "northwest",
+	"u": "up", "d": "down",
+}
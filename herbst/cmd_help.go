@@ -15,6 +15,9 @@ func (m *model) handleHelpCommand(_ *model, args []string) {
   ctrl+n - Scroll output down (newer messages)
   exits/x - Show exits
   peer <dir> - Peek at adjacent room
+  open/close <dir> - Open or close a door
+  lock/unlock <dir> - Lock or unlock a door with its key
+  search - Search for hidden items and exits
   take/get <item> - Pick up an item
   drop <item> - Drop an item
   inventory/inv/i - Show your inventory
@@ -25,7 +28,36 @@ func (m *model) handleHelpCommand(_ *model, args []string) {
   quest accept <id> - Accept a quest
   quest abandon <id> - Abandon an active quest
   talk <npc> - Talk to an NPC
+  party - Show your party
+  party invite/kick <name> - Invite or remove a party member
+  party accept/leave - Join or leave a party
+  party say <msg> - Talk to your party
+  list/wares - Show a shopkeeper's wares
+  buy <item> [qty] - Buy from a shopkeeper
+  sell <item> - Sell an item to a shopkeeper
+  value <item> - Ask what a shopkeeper would pay
   whoami - Show your info
+  who - See who's online
+  achievements/ach - Show your achievements
+  title <title>|none - Display an earned title
+  reputation/rep - Show your standing with each faction
+  mail [read|take|return|delete <id>] - Check your mail
+  mail send <name> [gold=N] [cod=N] [item=<item>] <subject> | <msg> - Send mail from a mailbox
+  trade <name> - Ask a player here to trade
+  trade add/remove <item>, gold <n> - Change your offer
+  trade confirm/cancel - Accept or call off the trade
+  auction/ah [search] - Browse the auction house
+  auction sell <item> <start> [buyout] [hours] - List an item
+  auction bid <id> <n>, buyout <id> - Bid on or buy an item
+  auction mine/cancel <id>/collect - Manage your auctions
+  bank - Show your stash at a bank
+  bank deposit/withdraw [account] <gold|item> - Move gold or items
+  bank expand [account] - Buy more stash slots
+  house [plots] - Show your house, or plots for sale here
+  house buy [dir] - Buy a plot here
+  house name/describe <text> - Change the room you're in
+  house place/retrieve <item> - Set out or take back a decoration
+  house guest add/remove <name> - Change who may visit
   profile/p - Edit character profile
   skills - Show your equipped combat skills
   skill slot <1-5> - Select a skill for a slot
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ============================================================
+// HOUSE COMMANDS — buy, furnish and share a player house
+// ============================================================
+
+// houseItemView mirrors a decoration or guest in the server's house
+// responses.
+type houseItemView struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// houseView mirrors the server's house responses.
+type houseView struct {
+	Direction string `json:"direction"`
+	Rooms     []struct {
+		ID          int             `json:"id"`
+		Name        string          `json:"name"`
+		Decorations []houseItemView `json:"decorations"`
+	} `json:"rooms"`
+	Guests []houseItemView `json:"guests"`
+}
+
+// housePlotView mirrors one plot in the server's plot listing.
+type housePlotView struct {
+	Direction string `json:"direction"`
+	Price     int    `json:"price"`
+	RoomCount int    `json:"room_count"`
+}
+
+// handleHouseCommand handles the house command:
+// house                          - show your house
+// house plots                    - list plots for sale here
+// house buy [direction]          - buy a plot here
+// house name|describe <text>     - rename or redescribe the room you're in
+// house place|retrieve <item>    - set out or take back a decoration
+// house guest add|remove <name>  - change who may come in
+func (m *model) handleHouseCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to use a house.", "error")
+		return
+	}
+	base := fmt.Sprintf("/api/characters/%d/house", m.currentCharacterID)
+	usage := "Usage: house [plots|buy [dir]|name <text>|describe <text>|place <item>|retrieve <item>|guest add|remove <name>]"
+	if len(args) == 0 {
+		var v houseView
+		if m.apiRequest("GET", base, nil, &v) {
+			m.AppendMessage(formatHouse(v), "info")
+		}
+		return
+	}
+
+	sub := strings.ToLower(args[0])
+	rest := strings.TrimSpace(strings.Join(args[1:], " "))
+	switch sub {
+	case "plots":
+		var result struct {
+			Plots []housePlotView `json:"plots"`
+		}
+		if !m.apiRequest("GET", base+"/plots", nil, &result) {
+			return
+		}
+		if len(result.Plots) == 0 {
+			m.AppendMessage("There are no plots for sale here.", "info")
+			return
+		}
+		var sb strings.Builder
+		sb.WriteString("=== Plots for sale ===\n")
+		for _, p := range result.Plots {
+			sb.WriteString(fmt.Sprintf("\n  %s: %d room(s) for %d gold", p.Direction, p.RoomCount, p.Price))
+		}
+		m.AppendMessage(sb.String(), "info")
+	case "buy":
+		dir := strings.ToLower(rest)
+		if full, ok := directionNames[dir]; ok {
+			dir = full
+		}
+		var v houseView
+		if m.apiRequest("POST", base+"/buy", map[string]string{"direction": dir}, &v) {
+			m.AppendMessage("You buy the plot. Welcome home!\n\n"+formatHouse(v), "success")
+		}
+	case "name", "describe":
+		if rest == "" || m.currentRoom == 0 {
+			m.AppendMessage(usage, "error")
+			return
+		}
+		field := "name"
+		if sub == "describe" {
+			field = "description"
+		}
+		var v houseView
+		if m.apiRequest("PUT", fmt.Sprintf("%s/rooms/%d", base, m.currentRoom), map[string]string{field: rest}, &v) {
+			m.AppendMessage("The room is changed.", "success")
+		}
+	case "place":
+		if rest == "" {
+			m.AppendMessage(usage, "error")
+			return
+		}
+		id := matchInventoryItem(m.fetchInventoryItems(), rest, nil)
+		if id == 0 {
+			m.AppendMessage(fmt.Sprintf("You aren't carrying %q.", rest), "error")
+			return
+		}
+		var it houseItemView
+		if m.apiRequest("POST", base+"/place", map[string]int{"item_id": id}, &it) {
+			m.AppendMessage(fmt.Sprintf("You set out %s.", it.Name), "success")
+		}
+	case "retrieve":
+		if rest == "" {
+			m.AppendMessage(usage, "error")
+			return
+		}
+		id := m.houseDecoration(base, rest)
+		if id == 0 {
+			return
+		}
+		var it houseItemView
+		if m.apiRequest("POST", base+"/retrieve", map[string]int{"item_id": id}, &it) {
+			m.AppendMessage(fmt.Sprintf("You take back %s.", it.Name), "success")
+		}
+	case "guest", "guests":
+		fields := strings.Fields(rest)
+		if len(fields) < 2 {
+			var v houseView
+			if m.apiRequest("GET", base, nil, &v) {
+				m.AppendMessage(formatHouseGuests(v), "info")
+			}
+			return
+		}
+		name := strings.Join(fields[1:], " ")
+		var v houseView
+		switch strings.ToLower(fields[0]) {
+		case "add":
+			if m.apiRequest("POST", base+"/guests", map[string]string{"name": name}, &v) {
+				m.AppendMessage(fmt.Sprintf("%s may now visit.\n\n%s", name, formatHouseGuests(v)), "success")
+			}
+		case "remove", "rm":
+			if m.apiRequest("DELETE", base+"/guests/"+url.PathEscape(name), nil, &v) {
+				m.AppendMessage(fmt.Sprintf("%s is no longer welcome.\n\n%s", name, formatHouseGuests(v)), "success")
+			}
+		default:
+			m.AppendMessage(usage, "error")
+		}
+	default:
+		m.AppendMessage(usage, "error")
+	}
+}
+
+// houseDecoration finds the decoration named name in your house.
+func (m *model) houseDecoration(base, name string) int {
+	var v houseView
+	if !m.apiRequest("GET", base, nil, &v) {
+		return 0
+	}
+	for _, rm := range v.Rooms {
+		for _, it := range rm.Decorations {
+			if strings.Contains(strings.ToLower(it.Name), strings.ToLower(name)) {
+				return it.ID
+			}
+		}
+	}
+	m.AppendMessage(fmt.Sprintf("There is no %q among your decorations.", name), "error")
+	return 0
+}
+
+// formatHouse renders your house's rooms and decorations.
+func formatHouse(v houseView) string {
+	var sb strings.Builder
+	sb.WriteString("=== Your House ===\n")
+	for _, rm := range v.Rooms {
+		sb.WriteString("\n" + rm.Name + "\n")
+		for _, it := range rm.Decorations {
+			sb.WriteString("  " + it.Name + "\n")
+		}
+	}
+	sb.WriteString("\n" + formatHouseGuests(v))
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+func formatHouseGuests(v houseView) string {
+	if len(v.Guests) == 0 {
+		return "Guests: none"
+	}
+	names := make([]string, len(v.Guests))
+	for i, g := range v.Guests {
+		names[i] = g.Name
+	}
+	return "Guests: " + strings.Join(names, ", ")
+}
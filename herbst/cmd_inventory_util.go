@@ -46,13 +46,13 @@ func formatItemStats(item inventoryItem) string {
 	name := item.Name
 	if item.DamageDiceCount > 0 && item.DamageDiceSides > 0 {
 		bonus := ""
-		if item.DamageBonus > 0 {
-			bonus = fmt.Sprintf("+%d", item.DamageBonus)
+		if b := item.DamageBonus + item.Stats["damage"]; b > 0 {
+			bonus = fmt.Sprintf("+%d", b)
 		}
 		name += fmt.Sprintf(" (%dd%d%s)", item.DamageDiceCount, item.DamageDiceSides, bonus)
 	}
-	if item.ArmorRating > 0 {
-		name += fmt.Sprintf(" (+%d AC)", item.ArmorRating)
+	if ac := item.ArmorRating + item.Stats["armor"]; ac > 0 {
+		name += fmt.Sprintf(" (+%d AC)", ac)
 	}
 	return name
 }
\ No newline at end of file
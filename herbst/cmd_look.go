@@ -93,7 +93,7 @@ func (m *model) handleLookAt(target string) {
 				m.AppendMessage(fmt.Sprintf("[%s]\nAn NPC you can see here.\n\nLevel: %d", char.Name, char.Level), "info")
 				return
 			}
-			desc := fmt.Sprintf("[%s]\nA player adventurer.\n\nLevel: %d", char.Name, char.Level)
+			desc := fmt.Sprintf("[%s]\nA player adventurer.\n\nLevel: %d", titledName(char.Name, char.Title), char.Level)
 			equipped := fetchEquippedItems(char.ID)
 			desc += formatCharacterEquipment(equipped)
 			m.AppendMessage(desc, "info")
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ============================================================
+// MAIL COMMANDS — mail list/read/send/take/return/delete
+// ============================================================
+
+// mailView mirrors one mail in the server's mail responses.
+type mailView struct {
+	ID       int    `json:"id"`
+	From     string `json:"from"`
+	Subject  string `json:"subject"`
+	Body     string `json:"body"`
+	Gold     int    `json:"gold"`
+	COD      int    `json:"cod"`
+	Read     bool   `json:"read"`
+	Returned bool   `json:"returned"`
+	Items    []struct {
+		Name string `json:"name"`
+	} `json:"items"`
+}
+
+// handleMailCommand handles the mail command:
+// mail                       - list your inbox
+// mail read <id>             - read a mail
+// mail send <name> [gold=N] [cod=N] [item=<item>]... <subject> | <message>
+// mail take <id>             - collect a mail's gold and items (at a mailbox)
+// mail return <id>           - send a mail back to its sender
+// mail delete <id>           - delete a mail with nothing attached
+func (m *model) handleMailCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to use the mail.", "error")
+		return
+	}
+	if len(args) == 0 {
+		m.showInbox()
+		return
+	}
+
+	base := fmt.Sprintf("/api/characters/%d/mail", m.currentCharacterID)
+	switch strings.ToLower(args[0]) {
+	case "list", "inbox":
+		m.showInbox()
+	case "read":
+		id, ok := mailArg(m, args, "read")
+		if !ok {
+			return
+		}
+		var mv mailView
+		if m.apiRequest("GET", fmt.Sprintf("%s/%d", base, id), nil, &mv) {
+			m.AppendMessage(formatMail(mv), "info")
+		}
+	case "send":
+		m.sendMail(base, args[1:])
+	case "take", "collect":
+		id, ok := mailArg(m, args, "take")
+		if !ok {
+			return
+		}
+		var result struct {
+			Gold  int `json:"gold"`
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+			CODPaid int `json:"cod_paid"`
+		}
+		if !m.apiRequest("POST", fmt.Sprintf("%s/%d/collect", base, id), nil, &result) {
+			return
+		}
+		var got []string
+		if result.Gold > 0 {
+			got = append(got, fmt.Sprintf("%d gold", result.Gold))
+		}
+		for _, it := range result.Items {
+			got = append(got, it.Name)
+		}
+		msg := fmt.Sprintf("You take %s from the mail.", strings.Join(got, ", "))
+		if result.CODPaid > 0 {
+			msg += fmt.Sprintf(" You pay %d gold cash on delivery.", result.CODPaid)
+		}
+		m.AppendMessage(msg, "success")
+	case "return":
+		id, ok := mailArg(m, args, "return")
+		if !ok {
+			return
+		}
+		var result struct {
+			Message string `json:"message"`
+		}
+		if m.apiRequest("POST", fmt.Sprintf("%s/%d/return", base, id), nil, &result) {
+			m.AppendMessage("You send the mail back to its sender.", "success")
+		}
+	case "delete", "del":
+		id, ok := mailArg(m, args, "delete")
+		if !ok {
+			return
+		}
+		var result struct {
+			Message string `json:"message"`
+		}
+		if m.apiRequest("DELETE", fmt.Sprintf("%s/%d", base, id), nil, &result) {
+			m.AppendMessage("Mail deleted.", "success")
+		}
+	default:
+		m.AppendMessage("Usage: mail [list|read <id>|send <name> ... <subject> | <message>|take <id>|return <id>|delete <id>]", "error")
+	}
+}
+
+// mailArg reads the mail ID after a mail subcommand.
+func mailArg(m *model, args []string, sub string) (int, bool) {
+	if len(args) < 2 {
+		m.AppendMessage(fmt.Sprintf("Usage: mail %s <id>", sub), "error")
+		return 0, false
+	}
+	id, err := strconv.Atoi(strings.TrimPrefix(args[1], "#"))
+	if err != nil {
+		m.AppendMessage(fmt.Sprintf("Usage: mail %s <id>", sub), "error")
+		return 0, false
+	}
+	return id, true
+}
+
+// showInbox lists the character's mail.
+func (m *model) showInbox() {
+	var inbox struct {
+		Mail   []mailView `json:"mail"`
+		Unread int        `json:"unread"`
+	}
+	if !m.apiRequest("GET", fmt.Sprintf("/api/characters/%d/mail", m.currentCharacterID), nil, &inbox) {
+		return
+	}
+	if len(inbox.Mail) == 0 {
+		m.AppendMessage("You have no mail.", "info")
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("=== Mail (%d unread) ===\n\n", inbox.Unread))
+	for _, mv := range inbox.Mail {
+		marker := "  "
+		if !mv.Read {
+			marker = "* "
+		}
+		line := fmt.Sprintf("%s#%-4d %-14s %s", marker, mv.ID, mv.From, mv.Subject)
+		if note := attachmentNote(mv); note != "" {
+			line += "  [" + note + "]"
+		}
+		sb.WriteString(line + "\n")
+	}
+	sb.WriteString("\nUse 'mail read <id>' to read a mail.")
+	m.AppendMessage(sb.String(), "info")
+}
+
+// formatMail renders a mail for reading.
+func formatMail(mv mailView) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("=== #%d: %s ===\nFrom: %s", mv.ID, mv.Subject, mv.From))
+	if mv.Returned {
+		sb.WriteString(" (returned)")
+	}
+	sb.WriteString("\n\n")
+	if mv.Body != "" {
+		sb.WriteString(mv.Body + "\n")
+	}
+	if note := attachmentNote(mv); note != "" {
+		sb.WriteString(fmt.Sprintf("\nAttached: %s\nUse 'mail take %d' at a mailbox to collect it.", note, mv.ID))
+	}
+	return sb.String()
+}
+
+// attachmentNote summarises what is still attached to a mail.
+func attachmentNote(mv mailView) string {
+	var parts []string
+	if mv.Gold > 0 {
+		parts = append(parts, fmt.Sprintf("%d gold", mv.Gold))
+	}
+	for _, it := range mv.Items {
+		parts = append(parts, it.Name)
+	}
+	if mv.COD > 0 {
+		parts = append(parts, fmt.Sprintf("COD %d gold", mv.COD))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// sendMail parses and sends: <name> [gold=N] [cod=N] [item=<item>]...
+// <subject> | <message>. Items are matched by name or ID against the
+// unequipped items in the character's inventory.
+func (m *model) sendMail(base string, args []string) {
+	usage := "Usage: mail send <name> [gold=N] [cod=N] [item=<item>]... <subject> | <message>"
+	if len(args) < 2 {
+		m.AppendMessage(usage, "error")
+		return
+	}
+	req := struct {
+		To      string `json:"to"`
+		Subject string `json:"subject"`
+		Body    string `json:"body"`
+		Gold    int    `json:"gold"`
+		COD     int    `json:"cod"`
+		ItemIDs []int  `json:"item_ids"`
+	}{To: args[0]}
+
+	rest := args[1:]
+	var inventory []inventoryItem
+	for len(rest) > 0 {
+		key, value, found := strings.Cut(rest[0], "=")
+		if !found {
+			break
+		}
+		switch strings.ToLower(key) {
+		case "gold", "cod":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				m.AppendMessage(fmt.Sprintf("%s must be a number of gold.", key), "error")
+				return
+			}
+			if strings.EqualFold(key, "gold") {
+				req.Gold = n
+			} else {
+				req.COD = n
+			}
+		case "item":
+			if inventory == nil {
+				inventory = m.fetchInventoryItems()
+			}
+			id := matchInventoryItem(inventory, value, req.ItemIDs)
+			if id == 0 {
+				m.AppendMessage(fmt.Sprintf("You aren't carrying %q.", value), "error")
+				return
+			}
+			req.ItemIDs = append(req.ItemIDs, id)
+		default:
+			m.AppendMessage(usage, "error")
+			return
+		}
+		rest = rest[1:]
+	}
+
+	subject, body, _ := strings.Cut(strings.Join(rest, " "), "|")
+	req.Subject, req.Body = strings.TrimSpace(subject), strings.TrimSpace(body)
+	if req.Subject == "" {
+		m.AppendMessage(usage, "error")
+		return
+	}
+	var sent mailView
+	if m.apiRequest("POST", base, req, &sent) {
+		m.AppendMessage(fmt.Sprintf("You send \"%s\" to %s.", sent.Subject, req.To), "success")
+	}
+}
+
+// matchInventoryItem finds an unequipped inventory item by ID or by name,
+// skipping items already picked.
+func matchInventoryItem(inventory []inventoryItem, query string, picked []int) int {
+	taken := map[int]bool{}
+	for _, id := range picked {
+		taken[id] = true
+	}
+	id, _ := strconv.Atoi(query)
+	query = strings.ToLower(strings.ReplaceAll(query, "_", " "))
+	for _, it := range inventory {
+		if it.IsEquipped || taken[it.ID] {
+			continue
+		}
+		if it.ID == id || strings.Contains(strings.ToLower(it.Name), query) {
+			return it.ID
+		}
+	}
+	return 0
+}
@@ -2,8 +2,11 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -39,6 +42,11 @@ func (m *model) handleMovement(cmd string) bool {
 
 	m.knownExits[direction] = true
 
+	if msg, ok := m.moveOnServer(direction); !ok {
+		m.AppendMessage(msg, "error")
+		return true
+	}
+
 	m.debugLogf("leaving room %d → %s (room %d)", m.currentRoom, direction, nextRoomID)
 
 	m.effectsService.FireEvent("on_leave_room", m.currentCharacterID, "", map[string]interface{}{
@@ -54,7 +62,7 @@ func (m *model) handleMovement(cmd string) bool {
 		m.currentRoom = room.ID
 		m.roomName = room.Name
 		m.roomDesc = room.Description
-		m.exits = room.Exits
+		m.setExits(room.Exits)
 
 		m.loadRoomItems()
 		m.loadRoomCharacters()
@@ -100,6 +108,44 @@ func (m *model) handleMovement(cmd string) bool {
 	return true
 }
 
+// moveOnServer walks the character through an exit on the server so other
+// players in both rooms see it leave and arrive. It only refuses the move
+// when the server does; if the server can't be reached the move stays local.
+func (m *model) moveOnServer(direction string) (string, bool) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		return "", true
+	}
+	body, _ := json.Marshal(map[string]string{"direction": direction})
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/characters/%d/move", RESTAPIBase, m.currentCharacterID), strings.NewReader(string(body)))
+	if err != nil {
+		return "", true
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.characterToken)
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		m.debugLogf("move %s not sent to server: %v", direction, err)
+		return "", true
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusBadRequest {
+		var result struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&result)
+		if result.Error == "" {
+			result.Error = "You can't go that way."
+		}
+		return result.Error, false
+	}
+	if resp.StatusCode != http.StatusOK {
+		m.debugLogf("move %s rejected by server: %s", direction, resp.Status)
+	}
+	return "", true
+}
+
 func (m *model) handlePeerCommand(cmd string) {
 	parts := strings.Fields(cmd)
 	if len(parts) < 2 {
@@ -131,6 +177,10 @@ func (m *model) handlePeerCommand(cmd string) {
 		m.AppendMessage("You can't peer that way — there's no exit.", "error")
 		return
 	}
+	if m.exitStates[dir] != "" {
+		m.AppendMessage(fmt.Sprintf("The door %s is %s.", dir, m.exitStates[dir]), "error")
+		return
+	}
 
 	if m.client != nil {
 		room, err := m.client.Room.Get(context.Background(), nextRoomID)
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ============================================================
+// PARTY COMMANDS — party list/invite/accept/leave/kick/say
+// ============================================================
+
+// partyView mirrors the server's party response.
+type partyView struct {
+	ID       int `json:"id"`
+	LeaderID int `json:"leader_id"`
+	Members  []struct {
+		ID     int    `json:"id"`
+		Name   string `json:"name"`
+		Level  int    `json:"level"`
+		HP     int    `json:"hp"`
+		MaxHP  int    `json:"max_hp"`
+		RoomID int    `json:"room_id"`
+		Leader bool   `json:"leader"`
+	} `json:"members"`
+}
+
+// handlePartyCommand handles the party command:
+// party                 - show your party
+// party invite <name>   - invite a player (you lead the party that forms)
+// party accept          - join the party you were invited to
+// party leave           - leave your party
+// party kick <name>     - remove a member (leader only)
+// party say <message>   - talk on the party channel
+func (m *model) handlePartyCommand(args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to join a party.", "error")
+		return
+	}
+	if len(args) == 0 {
+		m.showParty()
+		return
+	}
+
+	rest := strings.Join(args[1:], " ")
+	switch strings.ToLower(args[0]) {
+	case "list", "show", "who":
+		m.showParty()
+	case "invite":
+		if rest == "" {
+			m.AppendMessage("Usage: party invite <name>", "error")
+			return
+		}
+		m.partyAction("invite", rest)
+	case "accept", "join":
+		m.partyAction("accept", "")
+	case "leave", "quit":
+		m.partyAction("leave", "")
+	case "kick", "remove":
+		if rest == "" {
+			m.AppendMessage("Usage: party kick <name>", "error")
+			return
+		}
+		m.partyAction("kick", rest)
+	case "say", "chat":
+		m.sendPartyMessage(rest)
+	default:
+		m.AppendMessage("Usage: party [list|invite <name>|accept|leave|kick <name>|say <message>]", "error")
+	}
+}
+
+// partyRequest calls a party endpoint as the current character. It returns
+// the response body on success and shows the server's error otherwise.
+func (m *model) partyRequest(method, path string, body interface{}) ([]byte, bool) {
+	var reader *bytes.Reader
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	url := fmt.Sprintf("%s/api/characters/%d/party%s", RESTAPIBase, m.currentCharacterID, path)
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		m.AppendMessage("Failed to reach the party service.", "error")
+		return nil, false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.characterToken)
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		m.AppendMessage("Failed to reach the party service.", "error")
+		return nil, false
+	}
+	defer resp.Body.Close()
+
+	var buf bytes.Buffer
+	buf.ReadFrom(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(buf.Bytes(), &errResp) == nil && errResp.Error != "" {
+			m.AppendMessage(errResp.Error, "error")
+		} else {
+			m.AppendMessage(fmt.Sprintf("Party request failed (status %d)", resp.StatusCode), "error")
+		}
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+// partyAction runs invite/accept/leave/kick and shows the result.
+func (m *model) partyAction(action, name string) {
+	var body interface{}
+	if name != "" {
+		body = map[string]string{"name": name}
+	}
+	data, ok := m.partyRequest("POST", "/"+action, body)
+	if !ok {
+		return
+	}
+	var result struct {
+		Message string `json:"message"`
+	}
+	json.Unmarshal(data, &result)
+	if result.Message != "" {
+		m.AppendMessage(result.Message, "success")
+	}
+	m.refreshPartyMembers()
+}
+
+// showParty lists the members of the character's party.
+func (m *model) showParty() {
+	data, ok := m.partyRequest("GET", "", nil)
+	if !ok {
+		return
+	}
+	var p partyView
+	if err := json.Unmarshal(data, &p); err != nil {
+		m.AppendMessage("Error reading party", "error")
+		return
+	}
+	m.setPartyMembers(p)
+
+	var sb strings.Builder
+	sb.WriteString("=== Party ===\n\n")
+	for _, mem := range p.Members {
+		marker := "  "
+		if mem.Leader {
+			marker = "★ "
+		}
+		where := ""
+		if mem.RoomID != m.currentRoom {
+			where = "  (elsewhere)"
+		}
+		sb.WriteString(fmt.Sprintf("%s%-16s Lv %-3d HP %d/%d%s\n", marker, mem.Name, mem.Level, mem.HP, mem.MaxHP, where))
+	}
+	m.AppendMessage(sb.String(), "info")
+}
+
+// sendPartyMessage says something on the party channel.
+func (m *model) sendPartyMessage(message string) {
+	if message == "" {
+		m.AppendMessage("Usage: party say <message>", "error")
+		return
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"character_id": m.currentCharacterID,
+		"channel":      "party",
+		"message":      message,
+	})
+	req, err := http.NewRequest("POST", RESTAPIBase+"/api/chat/channel", bytes.NewReader(body))
+	if err != nil {
+		m.AppendMessage("Failed to send message.", "error")
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.characterToken)
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		m.AppendMessage("Failed to send message.", "error")
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		if msg, ok := result["error"].(string); ok {
+			m.AppendMessage(msg, "error")
+		} else {
+			m.AppendMessage("Failed to send message.", "error")
+		}
+		return
+	}
+	m.AppendMessage(fmt.Sprintf("[party] You: %s", message), "info")
+}
+
+// refreshPartyMembers reloads the cached party member IDs used to avoid
+// double-crediting shared kills. It is quiet when there is no party.
+func (m *model) refreshPartyMembers() {
+	m.partyMemberIDs = nil
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		return
+	}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/characters/%d/party", RESTAPIBase, m.currentCharacterID), nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+m.characterToken)
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return
+	}
+	var p partyView
+	if json.NewDecoder(resp.Body).Decode(&p) == nil {
+		m.setPartyMembers(p)
+	}
+}
+
+func (m *model) setPartyMembers(p partyView) {
+	m.partyMemberIDs = m.partyMemberIDs[:0]
+	for _, mem := range p.Members {
+		m.partyMemberIDs = append(m.partyMemberIDs, mem.ID)
+	}
+}
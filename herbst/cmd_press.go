@@ -45,6 +45,11 @@ func (m *model) handlePressCommand(cmd string) {
 		return
 	}
 
+	if !m.triggerConditionMet(matchedTrigger) {
+		m.AppendMessage("Nothing happens.", "info")
+		return
+	}
+
 	// Process based on trigger type
 	switch matchedTrigger.TriggerType {
 	case "press":
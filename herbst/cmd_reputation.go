@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================
+// REPUTATION COMMAND — standing with each faction
+// ============================================================
+
+// reputationView mirrors the server's reputation response.
+type reputationView struct {
+	Reputation []struct {
+		Faction  string `json:"faction"`
+		Standing int    `json:"standing"`
+		Tier     string `json:"tier"`
+	} `json:"reputation"`
+}
+
+// handleReputationCommand shows the character's standing and tier with
+// every faction they have any standing with.
+func (m *model) handleReputationCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to see your reputation.", "error")
+		return
+	}
+	var view reputationView
+	if !m.apiRequest("GET", fmt.Sprintf("/api/characters/%d/reputation", m.currentCharacterID), nil, &view) {
+		return
+	}
+	if len(view.Reputation) == 0 {
+		m.AppendMessage("No faction has formed an opinion of you yet.", "info")
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString("=== Reputation ===\n\n")
+	for _, r := range view.Reputation {
+		sb.WriteString(fmt.Sprintf("%-24s %-10s %5d\n", r.Faction, r.Tier, r.Standing))
+	}
+	m.AppendMessage(sb.String(), "info")
+}
@@ -30,7 +30,6 @@ func (m *model) handleSayCommand(args []string) {
 	// Build request body with character data
 	bodyData := map[string]interface{}{
 		"character_id": m.currentCharacterID,
-		"room_id":      m.currentRoom,
 		"message":      message,
 	}
 	body, _ := json.Marshal(bodyData)
@@ -58,10 +58,17 @@ func (m *model) handleSearchCommand(cmd string) {
 	}
 
 	m.loadRoomItems()
+	ways := m.searchForExits()
 
-	if revealed > 0 {
-		m.AppendMessage(fmt.Sprintf("🔍 You search the area carefully...\n\n✨ You discovered %d hidden item(s): %s",
-			revealed, strings.Join(found, ", ")), "success")
+	if revealed > 0 || len(ways) > 0 {
+		msg := "🔍 You search the area carefully...\n"
+		if revealed > 0 {
+			msg += fmt.Sprintf("\n✨ You discovered %d hidden item(s): %s", revealed, strings.Join(found, ", "))
+		}
+		if len(ways) > 0 {
+			msg += fmt.Sprintf("\n✨ You found a hidden way %s!", strings.Join(ways, " and "))
+		}
+		m.AppendMessage(msg, "success")
 	} else {
 		m.AppendMessage("🔍 You search the area carefully...\n\nYou find nothing of interest.", "info")
 	}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ============================================================
+// SHOP COMMANDS — list, buy, sell, value
+// ============================================================
+
+// shopPost sends an item request to a shop endpoint and decodes the response
+// into out. Server errors are shown to the player; returns false on failure.
+func (m *model) shopPost(action, item string, quantity int, out interface{}) bool {
+	url := fmt.Sprintf("%s/api/characters/%d/shop/%s", RESTAPIBase, m.currentCharacterID, action)
+	body, _ := json.Marshal(map[string]interface{}{"item": item, "quantity": quantity})
+
+	resp, err := m.authedRequest("POST", url, string(body))
+	if err != nil {
+		m.AppendMessage(fmt.Sprintf("Error contacting shop: %v", err), "error")
+		return false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&errResp) == nil && errResp.Error != "" {
+			m.AppendMessage(errResp.Error, "error")
+		} else {
+			m.AppendMessage(fmt.Sprintf("Shop request failed (status %d)", resp.StatusCode), "error")
+		}
+		return false
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		m.AppendMessage("Error reading shop response", "error")
+		return false
+	}
+	return true
+}
+
+// handleListCommand shows the wares of the shopkeeper in the room
+func (m *model) handleListCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 {
+		m.AppendMessage("You need to be playing to shop.", "error")
+		return
+	}
+
+	resp, err := m.authedRequest("GET", fmt.Sprintf("%s/api/characters/%d/shop", RESTAPIBase, m.currentCharacterID), "")
+	if err != nil {
+		m.AppendMessage(fmt.Sprintf("Error fetching wares: %v", err), "error")
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&errResp) == nil && errResp.Error != "" {
+			m.AppendMessage(errResp.Error, "error")
+		} else {
+			m.AppendMessage(fmt.Sprintf("Could not load wares (status %d)", resp.StatusCode), "error")
+		}
+		return
+	}
+
+	var shop struct {
+		ShopName       string `json:"shop_name"`
+		ShopkeeperName string `json:"shopkeeper_name"`
+		Wares          []struct {
+			Name     string `json:"name"`
+			Price    int    `json:"price"`
+			Quantity int    `json:"quantity"`
+		} `json:"wares"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&shop); err != nil {
+		m.AppendMessage("Error reading wares", "error")
+		return
+	}
+
+	if len(shop.Wares) == 0 {
+		m.AppendMessage(fmt.Sprintf("%s has nothing for sale right now.", shop.ShopkeeperName), "info")
+		return
+	}
+
+	output := fmt.Sprintf("=== %s (%s) ===\n\n", shop.ShopName, shop.ShopkeeperName)
+	for _, w := range shop.Wares {
+		output += fmt.Sprintf("  %-28s %6d gold  (%d in stock)\n", w.Name, w.Price, w.Quantity)
+	}
+	output += "\nUse 'buy <item> [qty]' to purchase."
+	m.AppendMessage(output, "info")
+}
+
+// handleBuyCommand buys an item from the shopkeeper; a trailing number is the quantity
+func (m *model) handleBuyCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 {
+		m.AppendMessage("You need to be playing to shop.", "error")
+		return
+	}
+	if len(args) < 2 {
+		m.AppendMessage("Usage: buy <item> [quantity]", "error")
+		return
+	}
+
+	words := args[1:]
+	quantity := 1
+	if n, err := strconv.Atoi(words[len(words)-1]); err == nil && len(words) > 1 {
+		quantity = n
+		words = words[:len(words)-1]
+	}
+
+	var result struct {
+		Message string `json:"message"`
+	}
+	if m.shopPost("buy", strings.Join(words, " "), quantity, &result) {
+		m.AppendMessage(result.Message, "success")
+	}
+}
+
+// handleSellCommand sells an inventory item to the shopkeeper
+func (m *model) handleSellCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 {
+		m.AppendMessage("You need to be playing to shop.", "error")
+		return
+	}
+	if len(args) < 2 {
+		m.AppendMessage("Usage: sell <item>", "error")
+		return
+	}
+
+	var result struct {
+		Message string `json:"message"`
+	}
+	if m.shopPost("sell", strings.Join(args[1:], " "), 1, &result) {
+		m.AppendMessage(result.Message, "success")
+	}
+}
+
+// handleValueCommand asks the shopkeeper what they would pay for an item
+func (m *model) handleValueCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 {
+		m.AppendMessage("You need to be playing to shop.", "error")
+		return
+	}
+	if len(args) < 2 {
+		m.AppendMessage("Usage: value <item>", "error")
+		return
+	}
+
+	var appraisal struct {
+		ItemName string `json:"item_name"`
+		Value    int    `json:"value"`
+		Offer    int    `json:"offer"`
+		WillBuy  bool   `json:"will_buy"`
+		Reason   string `json:"reason"`
+	}
+	if !m.shopPost("value", strings.Join(args[1:], " "), 1, &appraisal) {
+		return
+	}
+	if !appraisal.WillBuy {
+		m.AppendMessage(fmt.Sprintf("Your %s is worth about %d gold, but %s.", appraisal.ItemName, appraisal.Value, appraisal.Reason), "info")
+		return
+	}
+	m.AppendMessage(fmt.Sprintf("The shopkeeper would pay %d gold for your %s.", appraisal.Offer, appraisal.ItemName), "info")
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -39,32 +40,24 @@ func (m *model) handleTalkCommand(cmd string) {
 		m.AppendMessage(fmt.Sprintf("%s doesn't want to talk.", found.Name), "info")
 		return
 	}
-	// Fetch dialog nodes for this NPC template
-	nodes, err := m.fetchDialogNodes(found.NpcTemplateID)
-	if err != nil || len(nodes) == 0 {
+	// The server picks the entry node and hides responses this character
+	// can't choose.
+	var conv serverConversation
+	status, errMsg := m.conversationRequest("", map[string]interface{}{"npc_template_id": found.NpcTemplateID}, &conv)
+	switch {
+	case status == http.StatusNotFound || status == http.StatusConflict:
 		m.AppendMessage(fmt.Sprintf("%s has nothing to say right now.", found.Name), "info")
 		return
-	}
-	// Find entry node
-	nodeMap := make(map[string]*DialogNode, len(nodes))
-	var entryNode *DialogNode
-	for i := range nodes {
-		nodeMap[nodes[i].ID] = &nodes[i]
-		if nodes[i].IsEntry {
-			entryNode = &nodes[i]
-		}
-	}
-	if entryNode == nil {
-		// Use first node as fallback
-		entryNode = &nodes[0]
+	case status != http.StatusOK:
+		m.AppendMessage(errMsg, "error")
+		return
 	}
 	m.conversation = &ConversationState{
 		NPCTemplateID: found.NpcTemplateID,
 		NPCName:       found.Name,
-		CurrentNodeID: entryNode.ID,
-		Nodes:         nodeMap,
 		StartedAt:     time.Now(),
 	}
+	m.conversation.load(conv)
 	m.renderCurrentNode()
 }
 
@@ -79,6 +72,18 @@ func (m *model) handleDialogChoice(choice int) {
 		return
 	}
 	resp := node.Responses[choice-1]
+	var result struct {
+		Conversation *serverConversation `json:"conversation"`
+	}
+	status, errMsg := m.conversationRequest("/choose", map[string]interface{}{
+		"npc_template_id": m.conversation.NPCTemplateID,
+		"node_id":         node.ID,
+		"choice":          choice,
+	}, &result)
+	if status != http.StatusOK {
+		m.AppendMessage(errMsg, "error")
+		return
+	}
 	// Apply effects from the response via event hooks
 	for _, effectID := range resp.Effects {
 		m.effectsService.FireEvent("on_dialog_response", m.currentCharacterID, m.conversation.NPCTemplateID, map[string]interface{}{"effect_id": effectID})
@@ -101,17 +106,17 @@ func (m *model) handleDialogChoice(choice int) {
 		return
 	}
 	// Navigate to next node
-	nextNode, ok := m.conversation.Nodes[resp.NextNodeID]
-	if !ok {
+	if result.Conversation == nil {
 		m.AppendMessage("The conversation ends abruptly.", "info")
 		m.endConversation()
 		return
 	}
+	m.conversation.load(*result.Conversation)
+	nextNode := m.conversation.Nodes[m.conversation.CurrentNodeID]
 	// Apply on-enter effects via event hooks
 	for _, effectID := range nextNode.OnEnterEffects {
 		m.effectsService.FireEvent("on_dialog_enter", m.currentCharacterID, m.conversation.NPCTemplateID, map[string]interface{}{"effect_id": effectID})
 	}
-	m.conversation.CurrentNodeID = nextNode.ID
 	m.renderCurrentNode()
 }
 
@@ -150,22 +155,52 @@ func (m *model) renderCurrentNode() {
 	m.AppendMessage(b.String(), "info")
 }
 
-// fetchDialogNodes loads all dialog nodes for an NPC template from the REST API.
-func (m *model) fetchDialogNodes(templateID string) ([]DialogNode, error) {
-	url := fmt.Sprintf("%s/npc-templates/%s/dialog-nodes", RESTAPIBase, templateID)
-	resp, err := httpGet(url)
+// serverConversation is a dialog tree as the server shows it to this
+// character: nodes carry only the responses the character may pick.
+type serverConversation struct {
+	CurrentNodeID string       `json:"current_node_id"`
+	Nodes         []DialogNode `json:"nodes"`
+}
+
+// load replaces the conversation's nodes and current node with conv's.
+func (c *ConversationState) load(conv serverConversation) {
+	c.CurrentNodeID = conv.CurrentNodeID
+	c.Nodes = make(map[string]*DialogNode, len(conv.Nodes))
+	for i := range conv.Nodes {
+		c.Nodes[conv.Nodes[i].ID] = &conv.Nodes[i]
+	}
+}
+
+// conversationRequest posts to a conversation endpoint as the current
+// character and decodes a 200 response into out. On failure it returns the
+// status and the server's error message.
+func (m *model) conversationRequest(path string, body interface{}, out interface{}) (int, string) {
+	data, _ := json.Marshal(body)
+	url := fmt.Sprintf("%s/api/characters/%d/conversations%s", RESTAPIBase, m.currentCharacterID, path)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return 0, "Failed to reach the dialog service."
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.characterToken)
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return 0, "Failed to reach the dialog service."
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET %s: %d", url, resp.StatusCode)
-	}
-	var result struct {
-		Nodes []DialogNode `json:"nodes"`
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&errResp) == nil && errResp.Error != "" {
+			return resp.StatusCode, errResp.Error
+		}
+		return resp.StatusCode, fmt.Sprintf("Dialog request failed (status %d)", resp.StatusCode)
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, "The conversation trails off."
 	}
-	return result.Nodes, nil
-}
\ No newline at end of file
+	return resp.StatusCode, ""
+}
@@ -47,6 +47,11 @@ func (m *model) handleTouchCommand(cmd string) {
 		return
 	}
 
+	if !m.triggerConditionMet(matchedTrigger) {
+		m.AppendMessage("Nothing happens.", "info")
+		return
+	}
+
 	// Process based on trigger type
 	switch matchedTrigger.TriggerType {
 	case "touch":
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ============================================================
+// TRADE COMMANDS — trade <player>/add/remove/gold/confirm/cancel
+// ============================================================
+
+// tradeOfferView mirrors one side of the server's trade view.
+type tradeOfferView struct {
+	Gold  int `json:"gold"`
+	Items []struct {
+		ID       int    `json:"id"`
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+	} `json:"items"`
+	Confirmed bool `json:"confirmed"`
+}
+
+// tradeView mirrors the server's trade view.
+type tradeView struct {
+	With string         `json:"with"`
+	You  tradeOfferView `json:"you"`
+	Them tradeOfferView `json:"them"`
+}
+
+// handleTradeCommand handles the trade command:
+// trade                 - show your open trade
+// trade <player>        - ask a player here to trade, or accept their request
+// trade add <item>      - offer an item from your inventory
+// trade remove <item>   - take an item off your offer
+// trade gold <amount>   - offer gold
+// trade confirm         - accept the trade as it stands
+// trade cancel          - call the trade off
+func (m *model) handleTradeCommand(_ *model, args []string) {
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		m.AppendMessage("You must be playing to trade.", "error")
+		return
+	}
+	base := fmt.Sprintf("/api/characters/%d/trade", m.currentCharacterID)
+	if len(args) == 0 {
+		var view tradeView
+		if m.apiRequest("GET", base, nil, &view) {
+			m.AppendMessage(formatTrade(view), "info")
+		}
+		return
+	}
+
+	rest := strings.Join(args[1:], " ")
+	switch strings.ToLower(args[0]) {
+	case "add", "offer":
+		if rest == "" {
+			m.AppendMessage("Usage: trade add <item>", "error")
+			return
+		}
+		id := matchInventoryItem(m.fetchInventoryItems(), rest, nil)
+		if id == 0 {
+			m.AppendMessage(fmt.Sprintf("You aren't carrying %q.", rest), "error")
+			return
+		}
+		m.tradeAction(base+"/offer", map[string]interface{}{"add": []int{id}})
+	case "remove":
+		if rest == "" {
+			m.AppendMessage("Usage: trade remove <item>", "error")
+			return
+		}
+		var view tradeView
+		if !m.apiRequest("GET", base, nil, &view) {
+			return
+		}
+		for _, it := range view.You.Items {
+			if strings.Contains(strings.ToLower(it.Name), strings.ToLower(rest)) {
+				m.tradeAction(base+"/offer", map[string]interface{}{"remove": []int{it.ID}})
+				return
+			}
+		}
+		m.AppendMessage("You aren't offering that.", "error")
+	case "gold":
+		gold, err := strconv.Atoi(rest)
+		if err != nil || gold < 0 {
+			m.AppendMessage("Usage: trade gold <amount>", "error")
+			return
+		}
+		m.tradeAction(base+"/offer", map[string]interface{}{"gold": gold})
+	case "confirm", "accept":
+		m.tradeAction(base+"/confirm", nil)
+	case "cancel":
+		m.tradeAction(base+"/cancel", nil)
+	default:
+		m.tradeAction(base, map[string]string{"name": strings.Join(args, " ")})
+	}
+}
+
+// tradeAction posts to a trade endpoint and shows the result, with the
+// trade as it now stands when there still is one.
+func (m *model) tradeAction(path string, body interface{}) {
+	var update struct {
+		Trade   *tradeView `json:"trade"`
+		Message string     `json:"message"`
+	}
+	if !m.apiRequest("POST", path, body, &update) {
+		return
+	}
+	if update.Trade != nil {
+		m.AppendMessage(update.Message+"\n"+formatTrade(*update.Trade), "success")
+		return
+	}
+	m.AppendMessage(update.Message, "success")
+}
+
+// formatTrade shows both sides of a trade.
+func formatTrade(view tradeView) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("=== Trading with %s ===\n", view.With))
+	side := func(label string, o tradeOfferView) {
+		mark := ""
+		if o.Confirmed {
+			mark = " (confirmed)"
+		}
+		sb.WriteString(fmt.Sprintf("%s%s: %d gold", label, mark, o.Gold))
+		for _, it := range o.Items {
+			sb.WriteString(", " + it.Name)
+			if it.Quantity > 1 {
+				sb.WriteString(fmt.Sprintf(" (x%d)", it.Quantity))
+			}
+		}
+		sb.WriteString("\n")
+	}
+	side("You offer", view.You)
+	side(view.With+" offers", view.Them)
+	sb.WriteString("Use 'trade confirm' to accept or 'trade cancel' to call it off.")
+	return sb.String()
+}
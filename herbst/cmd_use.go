@@ -1,10 +1,12 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // ============================================================
@@ -60,6 +62,11 @@ func (m *model) handleUseCommand(cmd string) {
 		return
 	}
 
+	if !m.triggerConditionMet(matchedTrigger) {
+		m.AppendMessage("Nothing happens.", "info")
+		return
+	}
+
 	// Process based on trigger type
 	switch matchedTrigger.TriggerType {
 	case "use":
@@ -270,6 +277,36 @@ func (m *model) getTriggersForEquipment(equipmentID int) ([]*triggerView, error)
 	return result.Triggers, nil
 }
 
+// triggerConditionMet asks the server whether a trigger's condition holds
+// for the current character and room. Triggers without a condition always
+// pass; evaluation errors count as not met.
+func (m *model) triggerConditionMet(trigger *triggerView) bool {
+	if trigger.Condition == "" {
+		return true
+	}
+	url := fmt.Sprintf("%s/api/characters/%d/conditions/evaluate", RESTAPIBase, m.currentCharacterID)
+	body, _ := json.Marshal(map[string]interface{}{
+		"condition": trigger.Condition,
+		"room_id":   m.currentRoom,
+		"extras":    map[string]interface{}{"trigger_id": trigger.ID, "trigger_type": trigger.TriggerType},
+	})
+	resp, err := serviceSigner.Client(5*time.Second).Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	var result struct {
+		Result bool `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false
+	}
+	return result.Result
+}
+
 // triggerView represents the JSON response from the triggers API
 type triggerView struct {
 	ID          int    `json:"id"`
@@ -45,11 +45,49 @@ func (m *model) initCommands() {
 	m.commands.Register("channels", m.handleChannelWrapperCommand)
 	m.commands.Register("channel", m.handleChannelWrapperCommand)
 
+	// Party commands
+	m.commands.Register("party", m.handlePartyWrapperCommand, "group")
+
+	// Achievement commands
+	m.commands.Register("who", m.handleWhoCommand)
+	m.commands.Register("achievements", m.handleAchievementsCommand, "ach")
+	m.commands.Register("title", m.handleTitleCommand)
+
+	// Reputation commands
+	m.commands.Register("reputation", m.handleReputationCommand, "rep")
+
+	// Mail commands
+	m.commands.Register("mail", m.handleMailCommand)
+
+	// Trade commands
+	m.commands.Register("trade", m.handleTradeCommand)
+
+	// Auction house commands
+	m.commands.Register("auction", m.handleAuctionCommand, "ah")
+
+	// Bank commands
+	m.commands.Register("bank", m.handleBankCommand)
+
+	// House commands
+	m.commands.Register("house", m.handleHouseCommand)
+
+	// Door commands
+	m.commands.Register("open", m.handleDoorCommand)
+	m.commands.Register("close", m.handleDoorCommand)
+	m.commands.Register("lock", m.handleDoorCommand)
+	m.commands.Register("unlock", m.handleDoorCommand)
+
 	// Crafting commands
 	m.commands.Register("craft", m.handleCraftWrapperCommand)
 	m.commands.Register("recipes", m.handleRecipesWrapperCommand)
 	m.commands.Register("stations", m.handleStationsWrapperCommand)
 
+	// Shop commands
+	m.commands.Register("list", m.handleListCommand, "wares")
+	m.commands.Register("buy", m.handleBuyCommand)
+	m.commands.Register("sell", m.handleSellCommand)
+	m.commands.Register("value", m.handleValueCommand, "appraise")
+
 	// Object interaction commands
 	m.commands.Register("use", m.handleUseWrapperCommand)
 	m.commands.Register("touch", m.handleTouchWrapperCommand)
@@ -155,4 +193,8 @@ func (m *model) handleRecipesWrapperCommand(_ *model, args []string) {
 
 func (m *model) handleStationsWrapperCommand(_ *model, args []string) {
 	m.handleStationsCommand()
-}
\ No newline at end of file
+}
+
+func (m *model) handlePartyWrapperCommand(_ *model, args []string) {
+	m.handlePartyCommand(args)
+}
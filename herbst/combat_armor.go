@@ -14,7 +14,8 @@ type ArmorDetail struct {
 }
 
 // calculateArmorAC computes total AC bonus from all equipped items.
-// Items with armor_rating > 0 contribute. Untrained skill = half AC.
+// Items with armor (armor_rating plus armor affixes) contribute.
+// Untrained skill = half AC.
 func (m *model) calculateArmorAC(charID int, skills *CharacterSkills) ArmorResult {
 	items := m.fetchEquippedCombatItems(charID)
 	if len(items) == 0 {
@@ -24,11 +25,11 @@ func (m *model) calculateArmorAC(charID int, skills *CharacterSkills) ArmorResul
 	var result ArmorResult
 	for i := range items {
 		item := &items[i]
-		if item.ArmorRating <= 0 {
+		ac := item.armorValue()
+		if ac <= 0 {
 			continue
 		}
 
-		ac := item.ArmorRating
 		halved := false
 
 		if !isTrainedWithArmor(item, skills) {
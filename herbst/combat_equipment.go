@@ -3,6 +3,8 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // CombatItem holds equipment fields relevant to combat calculations.
@@ -22,6 +24,8 @@ type CombatItem struct {
 	ArmorType             string `json:"armor_type"`
 	SkillRequirement      string `json:"skill_requirement"`
 	SkillRequirementLevel int    `json:"skill_requirement_level"`
+	// Stats holds stat bonuses and loot affixes (see affixExtraDice).
+	Stats map[string]int `json:"stats"`
 }
 
 // fetchEquippedCombatItems retrieves all equipped items for a character.
@@ -78,13 +82,51 @@ func findOffHandWeapon(items []CombatItem) *CombatItem {
 	return nil
 }
 
-// findArmorItems returns all equipped items with armor_rating > 0.
+// findArmorItems returns all equipped items that add armor.
 func findArmorItems(items []CombatItem) []CombatItem {
 	var armor []CombatItem
 	for i := range items {
-		if items[i].ArmorRating > 0 {
+		if items[i].armorValue() > 0 {
 			armor = append(armor, items[i])
 		}
 	}
 	return armor
-}
\ No newline at end of file
+}
+
+// armorValue is the item's armor rating plus any armor affix.
+func (c *CombatItem) armorValue() int {
+	return c.ArmorRating + c.Stats["armor"]
+}
+
+// equippedStatBonus sums a stat bonus (e.g. "strength") across items.
+func equippedStatBonus(items []CombatItem, stat string) int {
+	total := 0
+	for i := range items {
+		total += items[i].Stats[stat]
+	}
+	return total
+}
+
+// extraDamageDice is bonus damage from a loot affix, e.g. 1d4 fire.
+type extraDamageDice struct {
+	Type  string
+	Count int
+	Sides int
+}
+
+// affixExtraDice reads the extra damage dice loot affixes store in an
+// item's stats as "<type>_damage_dice" and "<type>_damage_sides".
+func affixExtraDice(stats map[string]int) []extraDamageDice {
+	var out []extraDamageDice
+	for key, count := range stats {
+		if !strings.HasSuffix(key, "_damage_dice") || count <= 0 {
+			continue
+		}
+		damageType := strings.TrimSuffix(key, "_damage_dice")
+		if sides := stats[damageType+"_damage_sides"]; sides > 0 {
+			out = append(out, extraDamageDice{Type: damageType, Count: count, Sides: sides})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Type < out[j].Type })
+	return out
+}
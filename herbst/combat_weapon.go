@@ -15,9 +15,12 @@ type WeaponDamageResult struct {
 
 // calculateWeaponDamage computes damage for an attack with equipped weapons.
 // If no weapon is equipped, falls back to bare fists (1d6 + STR mod).
+// Strength affixes on equipped items raise the STR mod; damage affixes and
+// extra elemental dice add to the weapon's roll.
 func (m *model) calculateWeaponDamage(strMod int) WeaponDamageResult {
 	items := m.fetchEquippedCombatItems(m.currentCharacterID)
 	mainWeapon := findMainHandWeapon(items)
+	strMod += equippedStatBonus(items, "strength") / 2
 
 	if mainWeapon == nil {
 		// Bare fists: 1d6 + STR mod
@@ -42,8 +45,9 @@ func (m *model) calculateWeaponDamage(strMod int) WeaponDamageResult {
 		damageMod = damageMod / 2 // Half STR mod when untrained
 	}
 
-	totalMod := mainWeapon.DamageBonus + damageMod
+	totalMod := mainWeapon.DamageBonus + mainWeapon.Stats["damage"] + damageMod
 	roll, total := dice.Roll(mainWeapon.DamageDiceSides, mainWeapon.DamageDiceCount, totalMod)
+	total += rollExtraDice(mainWeapon)
 	if total < 1 {
 		total = 1
 	}
@@ -79,8 +83,9 @@ func (m *model) calcOffHandDamage(offHand *CombatItem, skills *CharacterSkills,
 	if !offTrained {
 		offMod = offMod / 2
 	}
-	offTotalMod := offHand.DamageBonus + offMod
+	offTotalMod := offHand.DamageBonus + offHand.Stats["damage"] + offMod
 	_, offDmg := dice.Roll(offHand.DamageDiceSides, offHand.DamageDiceCount, offTotalMod)
+	offDmg += rollExtraDice(offHand)
 	if !offTrained {
 		offDmg = offDmg / 2
 	}
@@ -89,4 +94,14 @@ func (m *model) calcOffHandDamage(offHand *CombatItem, skills *CharacterSkills,
 		offHandContribution = 1
 	}
 	return offHandContribution
-}
\ No newline at end of file
+}
+
+// rollExtraDice rolls a weapon's extra damage dice from loot affixes.
+func rollExtraDice(w *CombatItem) int {
+	total := 0
+	for _, x := range affixExtraDice(w.Stats) {
+		_, dmg := dice.Roll(x.Sides, x.Count, 0)
+		total += dmg
+	}
+	return total
+}
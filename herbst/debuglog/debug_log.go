@@ -9,15 +9,11 @@ import (
 	"time"
 )
 
-// TokenFetcher returns a JWT for authenticating to the server API.
-type TokenFetcher func() (string, error)
-
 // Logger batches debug log entries and POSTs them to the server's
 // /api/debug-log endpoint. Each entry is tagged with character_id and
 // room_id so the admin /logs page can filter by character.
 type Logger struct {
 	baseURL      string
-	fetchToken   TokenFetcher
 	client       *http.Client
 	mu           sync.Mutex
 	buffer       []entry
@@ -30,15 +26,15 @@ type entry struct {
 	Message     string `json:"message"`
 }
 
-// New creates a Logger that POSTs to baseURL + "/api/debug-log".
-// fetchToken is called before each flush to get a valid JWT.
-// Entries are batched and flushed every 500ms to avoid blocking game ticks.
-func New(baseURL string, fetchToken TokenFetcher) *Logger {
+// New creates a Logger that POSTs to baseURL + "/api/debug-log" with
+// client, which must sign its requests with a service key that has the
+// debug:log scope. Entries are batched and flushed every 500ms to avoid
+// blocking game ticks.
+func New(baseURL string, client *http.Client) *Logger {
 	l := &Logger{
-		baseURL:    baseURL,
-		fetchToken: fetchToken,
-		client:     &http.Client{Timeout: 3 * time.Second},
-		done:       make(chan struct{}),
+		baseURL: baseURL,
+		client:  client,
+		done:    make(chan struct{}),
 	}
 	go l.flushLoop()
 	return l
@@ -91,11 +87,6 @@ func (l *Logger) flush() {
 	l.buffer = nil
 	l.mu.Unlock()
 
-	token, err := l.fetchToken()
-	if err != nil {
-		return
-	}
-
 	for _, e := range batch {
 		body, _ := json.Marshal(e)
 		req, err := http.NewRequest("POST", l.baseURL+"/api/debug-log", bytes.NewReader(body))
@@ -103,7 +94,6 @@ func (l *Logger) flush() {
 			continue
 		}
 		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Authorization", "Bearer "+token)
 		resp, err := l.client.Do(req)
 		if err != nil {
 			continue
@@ -11,12 +11,12 @@ import (
 
 // applyXPChange modifies a character's XP via the REST API.
 func (s *Service) applyXPChange(charID int, delta int) error {
-	return s.patchCharacter(charID, map[string]interface{}{"xp_delta": delta})
+	return s.patchCharacterEffects(charID, map[string]interface{}{"xp_delta": delta})
 }
 
 // applyXPSet sets a character's XP to an absolute value via the REST API.
 func (s *Service) applyXPSet(charID int, value int) error {
-	return s.patchCharacter(charID, map[string]interface{}{"xp": value})
+	return s.patchCharacterEffects(charID, map[string]interface{}{"xp": value})
 }
 
 // applyHPChange modifies a character's HP via the REST API.
@@ -36,12 +36,12 @@ func (s *Service) applyManaChange(charID int, delta int) error {
 
 // applyBindPointSet changes a character's respawn room via the REST API.
 func (s *Service) applyBindPointSet(charID int, roomID int) error {
-	return s.patchCharacter(charID, map[string]interface{}{"respawn_room_id": roomID})
+	return s.patchCharacterEffects(charID, map[string]interface{}{"respawn_room_id": roomID})
 }
 
 // applyTeleport moves a character to a new room via the REST API.
 func (s *Service) applyTeleport(charID int, roomID int) error {
-	return s.patchCharacter(charID, map[string]interface{}{"current_room_id": roomID})
+	return s.patchCharacterEffects(charID, map[string]interface{}{"current_room_id": roomID})
 }
 
 // applyTagAdd adds a tag to a character via the REST API.
@@ -57,12 +57,19 @@ func (s *Service) applyTagRemove(charID int, tag string) error {
 	return s.deleteJSON(url)
 }
 
-// patchCharacter sends a PATCH request to update a character.
+// patchCharacter sends a PATCH request to change a character's vitals.
 func (s *Service) patchCharacter(charID int, fields map[string]interface{}) error {
 	url := fmt.Sprintf("%s/api/characters/%d", s.restBase, charID)
 	return s.patchJSON(url, fields)
 }
 
+// patchCharacterEffects sends a PATCH request to change a character's XP,
+// bind point or room.
+func (s *Service) patchCharacterEffects(charID int, fields map[string]interface{}) error {
+	url := fmt.Sprintf("%s/api/characters/%d/effects/character", s.restBase, charID)
+	return s.patchJSON(url, fields)
+}
+
 func (s *Service) patchJSON(url string, body interface{}) error {
 	data, err := json.Marshal(body)
 	if err != nil {
@@ -118,6 +125,54 @@ func (s *Service) deleteJSON(url string) error {
 	return nil
 }
 
+// applyRoomHook asks the server to apply a room-targeted hook's effect to
+// everyone in the source's room. The request is signed with the service
+// key. The other players are told by the server; the source gets the
+// message here if the effect reached it.
+func (s *Service) applyRoomHook(hookID int, eff EffectDef, sourceCharID int, extras map[string]interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"hook_id": hookID,
+		"extras":  extras,
+	})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/api/characters/%d/effects/room", s.restBase, sourceCharID)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("POST %s: %d", url, resp.StatusCode)
+	}
+	var result struct {
+		EffectType string `json:"effect_type"`
+		Message    string `json:"message"`
+		Targets    []struct {
+			ID int `json:"id"`
+		} `json:"targets"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	s.logger.Debug("room effect applied", "hook_id", hookID, "targets", len(result.Targets))
+	if result.EffectType != "message" {
+		return nil
+	}
+	for _, t := range result.Targets {
+		if t.ID == sourceCharID {
+			dispatchMessage(s, sourceCharID, result.Message, strParam(eff.Parameters, "message_type"))
+		}
+	}
+	return nil
+}
+
 // MessageBus allows the effects service to send messages to characters.
 // The game model registers a handler via RegisterMessageHandler.
 type MessageBus struct {
@@ -156,7 +211,8 @@ func dispatchMessage(s *Service, charID int, text string, msgType string) {
 }
 
 // FireEvent looks up hooks for the given event and NPC template,
-// resolves targets, and applies the linked effects.
+// resolves targets, and applies the linked effects to each target whose
+// hook condition holds.
 func (s *Service) FireEvent(eventName string, sourceCharID int, npcTemplateID string, extras map[string]interface{}) {
 	s.logger.Debug("FireEvent", "event", eventName, "source_char_id", sourceCharID, "npc_template_id", npcTemplateID, "extras", extras)
 	hooks := s.GetHooksForEvent(eventName)
@@ -177,24 +233,19 @@ func (s *Service) FireEvent(eventName string, sourceCharID int, npcTemplateID st
 			s.logger.Warn("hook references missing effect", "hook_id", hook.ID, "effect_id", hook.EffectID)
 			continue
 		}
+		targets = s.filterByCondition(hook, sourceCharID, npcTemplateID, extras, targets)
+		if len(targets) == 0 {
+			s.logger.Debug("FireEvent: condition not met", "hook_id", hook.ID, "condition", hook.Condition)
+			continue
+		}
 		s.logger.Debug("FireEvent: applying effect", "hook_id", hook.ID, "effect_id", hook.EffectID, "effect_type", eff.EffectType)
 		dispatchStartMessage(eff.Messages, s.messageBus, sourceCharID)
 		for _, targetID := range targets {
-			// Check if this is a room target (special marker)
+			// Room targets are applied by the server to every occupant
 			if roomID, isRoom := ParseRoomTarget(targetID); isRoom {
-				s.logger.Debug("FireEvent: room target, will dispatch to all room members", "room_id", roomID)
-				// For room target, the effect type determines how we handle it
-				if eff.EffectType == "message" {
-					// For message effects on room target, send to all characters in room
-					// The actual room member lookup should be done by the game server
-					// For now, we dispatch to sourceCharID as a fallback
-					s.logger.Debug("FireEvent: message effect on room, dispatching to source", "source_char_id", sourceCharID)
-					dispatchMessage(s, sourceCharID, strParam(eff.Parameters, "text"), strParam(eff.Parameters, "message_type"))
-				} else {
-					s.logger.Debug("FireEvent: non-message room target, applying to source", "target_id", sourceCharID)
-					if err := s.ApplyEffect(hook.EffectID, sourceCharID, sourceCharID, 0); err != nil {
-						s.logger.Error("apply effect failed", "effect_id", hook.EffectID, "target", sourceCharID, "error", err)
-					}
+				s.logger.Debug("FireEvent: room target, applying on server", "hook_id", hook.ID, "room_id", roomID)
+				if err := s.applyRoomHook(hook.ID, eff, sourceCharID, extras); err != nil {
+					s.logger.Error("apply room effect failed", "hook_id", hook.ID, "room_id", roomID, "error", err)
 				}
 			} else {
 				if err := s.ApplyEffect(hook.EffectID, targetID, sourceCharID, 0); err != nil {
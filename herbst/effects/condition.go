@@ -0,0 +1,84 @@
+package effects
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// filterByCondition returns the targets for which the hook's condition holds.
+// Room targets pass through: the server evaluates the condition for each
+// occupant when it applies the effect.
+func (s *Service) filterByCondition(hook HookDef, sourceCharID int, npcTemplateID string, extras map[string]interface{}, targets []int) []int {
+	if hook.Condition == "" {
+		return targets
+	}
+	var passed []int
+	for _, targetID := range targets {
+		if _, isRoom := ParseRoomTarget(targetID); isRoom {
+			passed = append(passed, targetID)
+			continue
+		}
+		if s.conditionMet(hook, sourceCharID, targetID, npcTemplateID, extras) {
+			passed = append(passed, targetID)
+		}
+	}
+	return passed
+}
+
+// conditionMet asks the server to evaluate a hook's condition for one target.
+// Hooks without a condition always pass. Evaluation failures (bad syntax,
+// missing characters, server errors) are logged and treated as false so a
+// broken condition never fires an effect.
+func (s *Service) conditionMet(hook HookDef, sourceCharID, targetCharID int, npcTemplateID string, extras map[string]interface{}) bool {
+	if hook.Condition == "" {
+		return true
+	}
+	if npcTemplateID == "" {
+		npcTemplateID = hook.NPCTemplateID
+	}
+	body := map[string]interface{}{
+		"condition":       hook.Condition,
+		"target_id":       targetCharID,
+		"room_id":         intFromExtras(extras, "room_id"),
+		"npc_template_id": npcTemplateID,
+		"extras":          extras,
+	}
+	result, err := s.evaluateCondition(sourceCharID, body)
+	if err != nil {
+		s.logger.Warn("hook condition evaluation failed", "hook_id", hook.ID, "condition", hook.Condition, "error", err)
+		return false
+	}
+	s.logger.Debug("FireEvent: condition evaluated", "hook_id", hook.ID, "target", targetCharID, "result", result)
+	return result
+}
+
+func (s *Service) evaluateCondition(sourceCharID int, body map[string]interface{}) (bool, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return false, err
+	}
+	url := fmt.Sprintf("%s/api/characters/%d/conditions/evaluate", s.restBase, sourceCharID)
+	resp, err := s.httpClient.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&errResp) == nil && errResp.Error != "" {
+			return false, fmt.Errorf("POST %s: %d: %s", url, resp.StatusCode, errResp.Error)
+		}
+		return false, fmt.Errorf("POST %s: %d", url, resp.StatusCode)
+	}
+	var out struct {
+		Result bool `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return false, err
+	}
+	return out.Result, nil
+}
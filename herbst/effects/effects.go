@@ -62,6 +62,12 @@ func NewService(restBase string, logger *slog.Logger) *Service {
 	}
 }
 
+// SetHTTPClient replaces the client used for API calls, e.g. with one that
+// signs requests with the SSH server's service key.
+func (s *Service) SetHTTPClient(client *http.Client) {
+	s.httpClient = client
+}
+
 // RefreshCache loads all effects and hooks from the REST API.
 func (s *Service) RefreshCache(ctx context.Context) error {
 	var effects []EffectDef
@@ -20,12 +20,11 @@ func ResolveTarget(target string, sourceCharID int, extras map[string]interface{
 			return []int{id}
 		}
 		return nil
-	case "room":
-		// For "room" target, we need to return all character IDs in the room
-		// Room ID is passed in extras
+	case "room", "room_except_source":
+		// Room targets resolve to a marker for the room ID from extras.
+		// FireEvent hands these to the server, which applies the effect to
+		// each occupant.
 		if roomID := intFromExtras(extras, "room_id"); roomID > 0 {
-			// Return a special marker that means "all characters in room"
-			// The FireEvent caller should handle this by getting room members
 			return []int{roomID | 0x80000000} // Use high bit as marker
 		}
 		return nil
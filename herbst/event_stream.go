@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	streamRetryMin = time.Second
+	streamRetryMax = 30 * time.Second
+)
+
+// streamEventMsg is a live room event pushed by the server: another player
+// arriving, leaving or speaking, a fight in the room, or a roaming NPC.
+type streamEventMsg struct {
+	Type    string `json:"type"`
+	Text    string `json:"text"`
+	RoomID  int    `json:"room_id"`
+	ActorID int    `json:"actor_id"`
+
+	stream *eventStream
+}
+
+// eventStream is a session's subscription to its character's event stream.
+type eventStream struct {
+	events chan streamEventMsg
+	cancel context.CancelFunc
+}
+
+// startEventStream subscribes to the current character's live room events,
+// closing any subscription left over from a previous character. Update
+// starts reading from it once the current input has been processed.
+func (m *model) startEventStream() {
+	m.stopEventStream()
+	if m.currentCharacterID == 0 || m.characterToken == "" {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &eventStream{events: make(chan streamEventMsg, 64), cancel: cancel}
+	m.eventStream = s
+	m.eventStreamJustStarted = true
+	url := fmt.Sprintf("%s/api/characters/%d/stream", RESTAPIBase, m.currentCharacterID)
+	go s.run(ctx, url, m.characterToken)
+}
+
+// stopEventStream closes the session's event stream, if any.
+func (m *model) stopEventStream() {
+	if m.eventStream != nil {
+		m.eventStream.cancel()
+		m.eventStream = nil
+	}
+	m.eventStreamJustStarted = false
+}
+
+// takeEventStreamCmd returns the command that starts reading a freshly
+// opened event stream, or nil.
+func (m *model) takeEventStreamCmd() tea.Cmd {
+	if !m.eventStreamJustStarted || m.eventStream == nil {
+		return nil
+	}
+	m.eventStreamJustStarted = false
+	return m.eventStream.next()
+}
+
+// next waits for the stream's next event. It yields nil once the stream
+// has been stopped.
+func (s *eventStream) next() tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-s.events
+		if !ok {
+			return nil
+		}
+		return ev
+	}
+}
+
+// handleStreamEvent shows a pushed event in the message log and keeps the
+// room's occupant list current when someone comes or goes.
+func (m *model) handleStreamEvent(ev streamEventMsg) tea.Cmd {
+	if ev.stream == nil || ev.stream != m.eventStream {
+		return nil
+	}
+	if ev.RoomID == 0 || ev.RoomID == m.currentRoom {
+		msgType := "info"
+		switch ev.Type {
+		case "combat":
+			msgType = "damage"
+		case "enter", "leave", "npc":
+			m.loadRoomCharacters()
+		case "party":
+			m.refreshPartyMembers()
+		case "achievement", "mail":
+			msgType = "success"
+		}
+		m.AppendMessage(ev.Text, msgType)
+	}
+	return ev.stream.next()
+}
+
+// run keeps the SSE connection open until ctx is cancelled, reconnecting
+// with backoff. It closes s.events on exit.
+func (s *eventStream) run(ctx context.Context, url, token string) {
+	defer close(s.events)
+	backoff := streamRetryMin
+	for {
+		connected, fatal := s.read(ctx, url, token)
+		if fatal || ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = streamRetryMin
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > streamRetryMax {
+			backoff = streamRetryMax
+		}
+	}
+}
+
+// read consumes one SSE connection. It reports whether the connection was
+// established and whether the server refused the subscription outright.
+func (s *eventStream) read(ctx context.Context, url, token string) (connected, fatal bool) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return false, true
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, false
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized, resp.StatusCode == http.StatusForbidden, resp.StatusCode == http.StatusNotFound:
+		log.Printf("event stream refused: %s", resp.Status)
+		return false, true
+	case resp.StatusCode != http.StatusOK:
+		return false, false
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if !bytes.HasPrefix(line, []byte("data:")) {
+			continue
+		}
+		var ev streamEventMsg
+		if json.Unmarshal(bytes.TrimSpace(line[len("data:"):]), &ev) != nil || strings.TrimSpace(ev.Text) == "" {
+			continue
+		}
+		ev.stream = s
+		select {
+		case s.events <- ev:
+		case <-ctx.Done():
+			return true, false
+		}
+	}
+	return true, false
+}
@@ -4,12 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/rand"
+	"net/http"
 	"strings"
 	"time"
-
-	"herbst/combat"
-	"herbst/dice"
 )
 
 // getCharacterStrength returns the character's strength stat
@@ -34,45 +31,92 @@ func (m *model) getCharacterStrength() int {
 	return stats.Strength
 }
 
-// startCombat initializes a combat encounter
+// combatStatus mirrors the server's combat.Status.
+type combatStatus struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	HP     int    `json:"hp"`
+	MaxHP  int    `json:"max_hp"`
+	Threat int    `json:"threat"`
+}
+
+// combatTickResult mirrors one server-side combat.TickResult.
+type combatTickResult struct {
+	FightID  int            `json:"fight_id"`
+	Tick     int            `json:"tick"`
+	Lines    []string       `json:"lines"`
+	NPC      combatStatus   `json:"npc"`
+	Players  []combatStatus `json:"players"`
+	Fled     []int          `json:"fled"`
+	Died     []int          `json:"died"`
+	TargetID int            `json:"target_id"`
+	KillerID int            `json:"killer_id"`
+	Ended    bool           `json:"ended"`
+}
+
+// combatView mirrors the server's combat.View.
+type combatView struct {
+	FightID  int            `json:"fight_id"`
+	Tick     int            `json:"tick"`
+	NPC      combatStatus   `json:"npc"`
+	Players  []combatStatus `json:"players"`
+	TargetID int            `json:"target_id"`
+	Queued   string         `json:"queued"`
+}
+
+// readCombatError extracts the server's error message, capitalised for display.
+func readCombatError(resp *http.Response) string {
+	var body struct {
+		Error string `json:"error"`
+	}
+	if json.NewDecoder(resp.Body).Decode(&body) != nil || body.Error == "" {
+		return fmt.Sprintf("combat request failed (status %d)", resp.StatusCode)
+	}
+	return strings.ToUpper(body.Error[:1]) + body.Error[1:]
+}
+
+// startCombat engages the target on the server. Dice are rolled by the
+// server's combat engine; the client queues actions and renders results.
 func (m *model) startCombat(target *RoomCharacter) {
 	m.debugLogf("combat started vs %s (room %d)", target.Name, m.currentRoom)
+
+	url := fmt.Sprintf("%s/api/characters/%d/combat", RESTAPIBase, m.currentCharacterID)
+	resp, err := m.authedRequest("POST", url, fmt.Sprintf(`{"target_id": %d}`, target.ID))
+	if err != nil {
+		m.AppendMessage(fmt.Sprintf("Could not start combat: %v", err), "error")
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		m.AppendMessage(readCombatError(resp)+".", "error")
+		return
+	}
+	var view combatView
+	if err := json.NewDecoder(resp.Body).Decode(&view); err != nil {
+		m.AppendMessage("Could not start combat: bad response from server.", "error")
+		return
+	}
+
 	m.inCombat = true
 	m.combatTarget = target
+	m.combatTarget.HP = view.NPC.HP
+	m.combatTarget.MaxHP = view.NPC.MaxHP
 	m.combatLog = []string{}
 	m.combatQueuedAction = ""
+	m.combatLastTick = view.Tick
+	m.combatAllies = view.Players
+	m.combatNPCTargetID = view.TargetID
 	m.combatJustStarted = true // Signal Update to start tick
 	m.screen = ScreenCombat
 
-	// Initialize combat manager if not already done
-	if m.combatManager == nil {
-		tickLoop := combat.NewTickLoop(combat.DefaultTickInterval)
-		m.combatManager = combat.NewCombatManager(tickLoop)
-	}
-
-	// Create a new combat encounter
-	m.combatID = m.combatManager.CreateCombat(0)
-
-	// Add player as participant
-	playerParticipant := combat.NewParticipant(
-		m.currentCharacterID,
-		m.currentCharacterName,
-		m.characterHP,
-	)
-	m.combatManager.AddParticipant(m.combatID, playerParticipant)
-
-	// Add target as participant
-	targetParticipant := combat.NewParticipant(
-		target.ID,
-		target.Name,
-		target.HP,
-	)
-	m.combatManager.AddParticipant(m.combatID, targetParticipant)
-
 	// Load classless skills for combat (slots 1-5)
 	m.initCombatSkillState()
 
-	m.AppendMessage(fmt.Sprintf("⚔ You enter combat with %s!", target.Name), "combat")
+	if len(view.Players) > 1 {
+		m.AppendMessage(fmt.Sprintf("⚔ You join the fight against %s!", target.Name), "combat")
+	} else {
+		m.AppendMessage(fmt.Sprintf("⚔ You enter combat with %s!", target.Name), "combat")
+	}
 	m.addCombatLog(fmt.Sprintf("Combat started with %s (Level %d)", target.Name, target.Level))
 	m.addCombatLog("⏱ Tick combat begins - actions queue for next tick")
 }
@@ -87,139 +131,147 @@ func (m *model) combatAction(action string) {
 	m.queueCombatAction(action)
 }
 
-// performCombatAttack handles the attack action with dice rolls
-func (m *model) performCombatAttack() {
-	if m.combatTarget == nil || m.combatTarget.HP <= 0 {
+// combatFlee queues an escape attempt; the server rolls it on the next tick.
+func (m *model) combatFlee() {
+	if !m.inCombat {
 		return
 	}
+	m.queueCombatAction("flee")
+}
 
-	dexMod := m.getDexModifier()
-	strMod := m.getStrModifier()
+// queueCombatAction queues an action for the next tick
+func (m *model) queueCombatAction(action string) {
+	m.sendCombatAction(fmt.Sprintf(`{"action": %q}`, action), action)
+}
 
-	// Roll to hit (d20 + DEX vs target AC)
-	roll, toHit, isCrit, isFumble := dice.RollWithCrit(dexMod)
-	targetAC := m.calculateAC(m.combatTarget)
+// sendCombatAction posts an action to the server and logs the outcome.
+func (m *model) sendCombatAction(payload, label string) bool {
+	url := fmt.Sprintf("%s/api/characters/%d/combat/action", RESTAPIBase, m.currentCharacterID)
+	resp, err := m.authedRequest("POST", url, payload)
+	if err != nil {
+		m.addCombatLog(fmt.Sprintf("⚠ Could not queue %s: %v", label, err))
+		return false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		m.addCombatLog("⚠ " + readCombatError(resp))
+		return false
+	}
+	m.combatQueuedAction = label
+	m.addCombatLog(fmt.Sprintf("⏱ Queued: %s", label))
+	return true
+}
 
-	if isFumble {
-		m.addCombatLog("🎲 FUMBLE! Natural 1 - You stumble badly!")
+// processCombatTick polls the server for tick results newer than the last
+// one seen and applies them.
+func (m *model) processCombatTick() {
+	if !m.inCombat || m.combatTarget == nil {
 		return
 	}
 
-	if toHit < targetAC && !isCrit {
-		m.addCombatLog(fmt.Sprintf("🎲 Miss! (d20=%d + %d DEX = %d vs AC %d)",
-			roll, dexMod, toHit, targetAC))
+	url := fmt.Sprintf("%s/api/characters/%d/combat?since=%d", RESTAPIBase, m.currentCharacterID, m.combatLastTick)
+	resp, err := m.authedRequest("GET", url, "")
+	if err != nil {
+		m.debugLogf("combat poll failed: %v", err)
 		return
 	}
+	defer resp.Body.Close()
 
-	// Calculate weapon damage (replaces bare fists if weapon equipped)
-	wpnResult := m.calculateWeaponDamage(strMod)
-	damage := wpnResult.TotalDamage
-
-	if isCrit {
-		damage *= 2
-		m.addCombatLog("🎲 CRITICAL HIT! Natural 20!")
+	var state struct {
+		InCombat bool               `json:"in_combat"`
+		Results  []combatTickResult `json:"results"`
 	}
-
-	if damage < 1 {
-		damage = 1
+	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
+		m.debugLogf("combat poll decode failed: %v", err)
+		return
 	}
 
-	// Apply damage
-	applyDamageToCharacter(m.combatTarget.ID, damage, m.currentCharacterID)
-	m.combatTarget.HP -= damage
-	if m.combatTarget.HP < 0 {
-		m.combatTarget.HP = 0
+	for _, r := range state.Results {
+		m.combatLastTick = r.Tick
+		if m.applyCombatResult(r) {
+			return
+		}
 	}
-
-	m.effectsService.FireEvent("on_hit_dealt", m.currentCharacterID, "", map[string]interface{}{
-		"target_id": m.combatTarget.ID,
-		"damage":    damage,
-	})
-
-	// Build combat message
-	m.logWeaponHit(wpnResult, damage, isCrit)
-
-	if m.combatTarget.HP <= 0 {
-		m.handleTargetDefeat()
+	if !state.InCombat && m.inCombat {
+		m.exitCombat()
 	}
 }
 
-// logWeaponHit adds combat log messages for a weapon hit.
-func (m *model) logWeaponHit(wpn WeaponDamageResult, damage int, isCrit bool) {
-	if wpn.IsUntrained {
-		m.addCombatLog("⚠ Untrained with this weapon - half damage!")
-	}
-	if wpn.WeaponName == "fists" {
-		if isCrit {
-			m.addCombatLog(fmt.Sprintf("⚔ Critical hit! %d damage (fists, doubled!)",
-				damage))
-		} else {
-			m.addCombatLog(fmt.Sprintf("⚔ Hit! %d damage (fists)", damage))
+// applyCombatResult renders one tick result. It reports whether the result
+// took the player out of combat.
+func (m *model) applyCombatResult(r combatTickResult) bool {
+	m.combatQueuedAction = ""
+	m.decrementCooldowns()
+	for _, line := range r.Lines {
+		m.addCombatLog(line)
+	}
+	m.combatTarget.HP = r.NPC.HP
+	m.combatTarget.MaxHP = r.NPC.MaxHP
+	m.combatAllies = r.Players
+	m.combatNPCTargetID = r.TargetID
+	for _, p := range r.Players {
+		if p.ID == m.currentCharacterID {
+			m.characterHP = p.HP
+			m.characterMaxHP = p.MaxHP
 		}
-		return
 	}
-	verb := damageVerb(wpn.WeaponName)
-	if isCrit {
-		m.addCombatLog(fmt.Sprintf("⚔ Critical %s with %s! %d damage (doubled!)",
-			verb, wpn.WeaponName, damage))
-	} else {
-		m.addCombatLog(fmt.Sprintf("⚔ You %s %s with %s for %d damage!",
-			verb, m.combatTarget.Name, wpn.WeaponName, damage))
-	}
-	if wpn.OffHandDmg > 0 {
-		m.addCombatLog(fmt.Sprintf("  + %d off-hand damage", wpn.OffHandDmg))
-	}
-}
 
-// damageVerb returns a combat verb based on weapon name.
-func damageVerb(weaponName string) string {
 	switch {
-	case containsAny(weaponName, "sword", "blade", "saber", "cutlass"):
-		return "slash"
-	case containsAny(weaponName, "dagger", "knife", "shiv", "stiletto"):
-		return "stab"
-	case containsAny(weaponName, "staff", "club", "mace", "hammer"):
-		return "bludgeon"
-	case containsAny(weaponName, "spear", "pike", "lance"):
-		return "pierce"
-	default:
-		return "strike"
+	case containsID(r.Died, m.currentCharacterID):
+		m.handlePlayerDefeat()
+		return true
+	case containsID(r.Fled, m.currentCharacterID):
+		m.AppendMessage("🏃 You fled from combat!", "success")
+		m.exitCombat()
+		return true
+	case r.Ended && r.NPC.HP <= 0:
+		m.handleTargetDefeat(r.KillerID)
+		return true
+	case r.Ended:
+		m.exitCombat()
+		return true
 	}
+	return false
 }
 
-// containsAny checks if s contains any of the substrings (case-insensitive).
-func containsAny(s string, substrings ...string) bool {
-	sLower := strings.ToLower(s)
-	for _, sub := range substrings {
-		if strings.Contains(sLower, sub) {
+// containsID reports whether id is in ids.
+func containsID(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
 			return true
 		}
 	}
 	return false
 }
 
-// handleTargetDefeat processes defeating a combat target.
-func (m *model) handleTargetDefeat() {
+// handleTargetDefeat processes defeating a combat target. XP is awarded by
+// the server from the damage log; the killer handles the corpse and the
+// NPC's recovery so shared fights don't do it twice. The killer also shares
+// kill credit with its party, so party members leave that to the killer.
+func (m *model) handleTargetDefeat(killerID int) {
+	killingBlow := killerID == m.currentCharacterID
 	m.debugLogf("defeated %s (room %d)", m.combatTarget.Name, m.currentRoom)
 	m.addCombatLog(fmt.Sprintf("✦ %s has been defeated!", m.combatTarget.Name))
 	m.AppendMessage(fmt.Sprintf("⚔ You defeated %s!", m.combatTarget.Name), "success")
 
-	if m.combatTarget.IsNPC && m.combatTarget.XpValue > 0 {
-		FireDefeatEvent(m.currentCharacterID, m.combatTarget.ID, m.combatTarget.Level, m.combatTarget.XpValue)
-	}
-
-
 	m.effectsService.FireEvent("on_kill", m.currentCharacterID, "", map[string]interface{}{
 		"target_id": m.combatTarget.ID,
 	})
-	m.generateCorpse(m.combatTarget)
 
-		if m.combatTarget.IsNPC && m.combatTarget.NpcTemplateID != "" {
+	if m.combatTarget.IsNPC && m.combatTarget.NpcTemplateID != "" {
+		switch {
+		case killingBlow:
+			m.questService.CheckPartyProgress(m.currentCharacterID, m.combatTarget.NpcTemplateID)
+		case !containsID(m.partyMemberIDs, killerID):
 			m.questService.CheckProgress(m.currentCharacterID, "kill", m.combatTarget.NpcTemplateID)
 		}
+	}
 
-	if m.combatTarget.IsNPC {
-		healCharacter(m.combatTarget.ID, m.combatTarget.MaxHP)
+	if killingBlow {
+		m.generateCorpse(m.combatTarget)
+		if m.combatTarget.IsNPC {
+			healCharacter(m.combatTarget.ID, m.combatTarget.MaxHP)
+		}
 	}
 	m.exitCombat()
 }
@@ -230,259 +282,6 @@ func (m *model) getDexModifier() int {
 	return m.characterLevel / 3
 }
 
-// getStrModifier returns the STR modifier for the player
-func (m *model) getStrModifier() int {
-	strength := m.getCharacterStrength()
-	// D&D-style: 10 is 0, each +2 is +1 modifier
-	return (strength - 10) / 2
-}
-
-// calculateAC returns target's armor class including equipped armor.
-func (m *model) calculateAC(target *RoomCharacter) int {
-	baseAC := 10 + target.Level/2
-	if target.IsNPC {
-		return baseAC
-	}
-	// For players: add armor AC from equipped items
-	skills := m.fetchCharacterSkills()
-	armor := m.calculateArmorAC(m.currentCharacterID, skills)
-	return baseAC + armor.TotalAC
-}
-
-// performCombatDefend handles the defend action
-func (m *model) performCombatDefend() {
-	m.addCombatLog("🛡 You take a defensive stance!")
-	// Defense reduces damage on the next enemy hit
-	// This is handled in enemyTurnWithDice via a defending flag
-}
-
-// performCombatSkill handles skill actions (placeholder)
-func (m *model) performCombatSkill() {
-	m.addCombatLog("⚡ You attempt to use a skill... (not implemented)")
-}
-
-// performCombatItem handles item usage in combat (placeholder)
-func (m *model) performCombatItem() {
-	m.addCombatLog("🎒 You reach for an item... (not implemented)")
-}
-
-// enemyTurn handles the enemy's combat turn (legacy, use enemyTurnWithDice)
-func (m *model) enemyTurn() {
-	m.enemyTurnWithDice()
-}
-
-// enemyTurnWithDefense handles enemy turn with defense consideration
-func (m *model) enemyTurnWithDefense(defending bool) {
-	if m.combatTarget == nil || m.combatTarget.HP <= 0 {
-		return
-	}
-
-	enemyDexMod := m.combatTarget.Level / 3
-	roll, toHit, isCrit, isFumble := dice.RollWithCrit(enemyDexMod)
-	playerAC := m.calculatePlayerAC()
-
-	if defending {
-		playerAC += 5 // Defense bonus
-	}
-
-	if isFumble {
-		m.addCombatLog(fmt.Sprintf("🎲 %s FUMBLES! (rolled 1)", m.combatTarget.Name))
-		return
-	}
-
-	if toHit < playerAC && !isCrit {
-		m.addCombatLog(fmt.Sprintf("🎲 %s misses! (d20=%d + %d = %d vs AC %d)",
-			m.combatTarget.Name, roll, enemyDexMod, toHit, playerAC))
-		return
-	}
-
-	// Enemy hits - calculate NPC damage
-	damage := m.calculateNPCDamage(m.combatTarget, isCrit)
-
-	// Apply damage
-	applyDamageToCharacter(m.currentCharacterID, damage)
-	m.characterHP -= damage
-	if m.characterHP < 0 {
-		m.characterHP = 0
-	}
-
-	m.effectsService.FireEvent("on_hit_received", m.currentCharacterID, "", map[string]interface{}{
-		"attacker_id": m.combatTarget.ID,
-		"damage":      damage,
-	})
-	if defending {
-		m.addCombatLog(fmt.Sprintf("⚔ %s attacks! Blocked for %d damage!",
-			m.combatTarget.Name, damage))
-	} else {
-		m.logEnemyHit(damage, isCrit)
-	}
-
-	if m.characterHP <= 0 {
-		m.handlePlayerDefeat()
-	}
-}
-
-// combatFlee attempts to flee from combat
-func (m *model) combatFlee() {
-	if !m.inCombat {
-		return
-	}
-
-	// Roll to escape - d20 vs difficulty
-	roll, total := dice.D20(m.characterLevel / 2)
-	escapeDC := 12 // Escape difficulty
-
-	if total >= escapeDC {
-		m.addCombatLog(fmt.Sprintf("🏃 Escape successful! (d20=%d + %d = %d vs DC %d)",
-			roll, m.characterLevel/2, total, escapeDC))
-		m.AppendMessage("🏃 You fled from combat!", "success")
-		m.exitCombat()
-	} else {
-		m.addCombatLog(fmt.Sprintf("🏃 Escape failed! (d20=%d + %d = %d vs DC %d)",
-			roll, m.characterLevel/2, total, escapeDC))
-		m.AppendMessage("🏃 You couldn't escape!", "error")
-	}
-}
-
-// processCombatTick executes at each combat tick interval
-func (m *model) processCombatTick() {
-	if !m.inCombat || m.combatTarget == nil {
-		return
-	}
-
-	// Decrement cooldowns at the start of each tick
-	m.decrementCooldowns()
-
-	// Execute queued action or auto-attack
-	if m.combatQueuedAction != "" {
-		m.executeCombatAction(m.combatQueuedAction)
-		m.combatQueuedAction = ""
-	} else {
-		// No action = auto-attack
-		m.addCombatLog("⏱ No action queued - auto-attacking!")
-		m.performCombatAttack()
-	}
-
-	// Check if combat ended after player action
-	if !m.inCombat {
-		return
-	}
-
-	// Enemy turn (with dice rolls)
-	m.enemyTurnWithDice()
-
-	// Check if combat ended after enemy action
-	m.checkCombatEnd()
-}
-
-// executeCombatAction executes a queued combat action
-func (m *model) executeCombatAction(action string) {
-	switch action {
-	case "attack":
-		m.performCombatAttack()
-	case "defend":
-		m.performCombatDefend()
-	default:
-		// Unknown action - default to attack
-		m.performCombatAttack()
-	}
-}
-
-// queueCombatAction queues an action for the next tick
-func (m *model) queueCombatAction(action string) {
-	m.combatQueuedAction = action
-	m.addCombatLog(fmt.Sprintf("⏱ Queued: %s", action))
-}
-
-// enemyTurnWithDice handles enemy turn with dice rolls
-func (m *model) enemyTurnWithDice() {
-	if m.combatTarget == nil || m.combatTarget.HP <= 0 {
-		return
-	}
-
-	// Attempt NPC skill usage (happens before regular attack)
-	if m.attemptNPCSkill(m.combatTarget) {
-		if !m.inCombat || m.combatTarget.HP <= 0 {
-			return
-		}
-		if rand.Float64() < 0.5 {
-			return
-		}
-	}
-
-	// Enemy rolls to hit player
-	enemyDexMod := m.combatTarget.Level / 3
-	roll, toHit, isCrit, isFumble := dice.RollWithCrit(enemyDexMod)
-	playerAC := m.calculatePlayerAC()
-
-	if isFumble {
-		m.addCombatLog(fmt.Sprintf("🎲 %s FUMBLES! (rolled 1)", m.combatTarget.Name))
-		return
-	}
-
-	if toHit < playerAC && !isCrit {
-		m.addCombatLog(fmt.Sprintf("🎲 %s misses! (d20=%d + %d = %d vs AC %d)",
-			m.combatTarget.Name, roll, enemyDexMod, toHit, playerAC))
-		return
-	}
-
-	// Enemy hits - check for NPC weapon
-	damage := m.calculateNPCDamage(m.combatTarget, isCrit)
-
-	// Apply damage
-	applyDamageToCharacter(m.currentCharacterID, damage)
-	m.characterHP -= damage
-	if m.characterHP < 0 {
-		m.characterHP = 0
-	}
-
-	m.effectsService.FireEvent("on_hit_received", m.currentCharacterID, "", map[string]interface{}{
-		"attacker_id": m.combatTarget.ID,
-		"damage":      damage,
-	})
-	m.logEnemyHit(damage, isCrit)
-
-	if m.characterHP <= 0 {
-		m.handlePlayerDefeat()
-	}
-}
-
-// calculateNPCDamage computes damage for an NPC attack.
-func (m *model) calculateNPCDamage(target *RoomCharacter, isCrit bool) int {
-	items := m.fetchEquippedCombatItems(target.ID)
-	if len(items) > 0 {
-		weapon := findMainHandWeapon(items)
-		if weapon != nil {
-			enemyStrMod := target.Level / 3
-			totalMod := weapon.DamageBonus + enemyStrMod
-			_, dmg := dice.Roll(weapon.DamageDiceSides, weapon.DamageDiceCount, totalMod)
-			if dmg < 1 {
-				dmg = 1
-			}
-			if isCrit {
-				dmg *= 2
-			}
-			return dmg
-		}
-	}
-	baseDamage := target.Level + 2
-	if isCrit {
-		baseDamage *= 2
-	}
-	return baseDamage
-}
-
-// logEnemyHit adds combat log messages for an enemy hit on the player.
-func (m *model) logEnemyHit(damage int, isCrit bool) {
-	if isCrit {
-		m.addCombatLog(fmt.Sprintf("⚔ %s critical hit! %d damage!",
-			m.combatTarget.Name, damage))
-	} else {
-		m.addCombatLog(fmt.Sprintf("⚔ %s hits for %d damage!",
-			m.combatTarget.Name, damage))
-	}
-}
-
 // handlePlayerDefeat processes player being defeated.
 func (m *model) handlePlayerDefeat() {
 	m.debugLogf("player died in room %d", m.currentRoom)
@@ -506,38 +305,13 @@ func (m *model) handlePlayerDefeat() {
 	m.respawnPlayer()
 }
 
-// checkCombatEnd checks if combat should end
-func (m *model) checkCombatEnd() {
-	if m.combatTarget == nil || m.combatTarget.HP <= 0 {
-		m.exitCombat()
-	}
-}
-
 // exitCombat cleans up combat state
-// decrementCooldowns reduces all cooldowns at the start of a tick
-func (m *model) decrementCooldowns() {
-	// Decrement player skill cooldowns
-	if m.combatSkills != nil {
-		for skillID, cd := range m.combatSkills.Cooldowns {
-			if cd > 0 {
-				m.combatSkills.Cooldowns[skillID] = cd - 1
-			}
-		}
-	}
-	
-	// Decrement NPC skill cooldown
-	if m.npcSkillCooldown > 0 {
-		m.npcSkillCooldown--
-	}
-}
-
 func (m *model) exitCombat() {
-	if m.combatManager != nil && m.combatID > 0 {
-		m.combatManager.EndCombat(m.combatID)
-	}
 	m.inCombat = false
 	m.combatTarget = nil
-	m.combatID = 0
+	m.combatLastTick = 0
+	m.combatAllies = nil
+	m.combatNPCTargetID = 0
 	m.combatQueuedAction = ""
 	m.combatJustStarted = false
 	m.screen = ScreenPlaying
@@ -625,6 +399,7 @@ func (m *model) respawnPlayer() {
 
 	// Move player to respawn room
 	m.currentRoom = respawnRoomID
+	m.updateCurrentRoom()
 
 	// Reload room data from server
 	if m.client != nil {
@@ -632,7 +407,7 @@ func (m *model) respawnPlayer() {
 		if err == nil {
 			m.roomName = room.Name
 			m.roomDesc = room.Description
-			m.exits = room.Exits
+			m.setExits(room.Exits)
 		}
 	}
 
@@ -646,27 +421,17 @@ func (m *model) respawnPlayer() {
 	m.AppendMessage(fmt.Sprintf("☠ You respawn at %s!", m.roomName), "success")
 }
 
-// applyDamageToCharacter sends damage to the server
-func applyDamageToCharacter(characterID, damage int, attackerID ...int) {
-	url := fmt.Sprintf("%s/characters/%d/damage", RESTAPIBase, characterID)
-	payload := fmt.Sprintf(`{"damage": %d}`, damage)
-	if len(attackerID) > 0 && attackerID[0] > 0 {
-		payload = fmt.Sprintf(`{"damage": %d, "attacker_id": %d}`, damage, attackerID[0])
-	}
-
-	resp, err := httpPost(url, payload)
-	if err != nil {
-		return
-	}
-	defer resp.Body.Close()
-}
-
-// healCharacter sends heal request to the server
+// healCharacter sends heal request to the server, signed as the SSH server
 func healCharacter(characterID, amount int) {
 	url := fmt.Sprintf("%s/characters/%d/heal", RESTAPIBase, characterID)
 	payload := fmt.Sprintf(`{"amount": %d}`, amount)
 
-	resp, err := httpPost(url, payload)
+	req, err := http.NewRequest("POST", url, strings.NewReader(payload))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := serviceSigner.Client(5 * time.Second).Do(req)
 	if err != nil {
 		return
 	}
@@ -55,6 +55,9 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 
+	case streamEventMsg:
+		return m, m.handleStreamEvent(msg)
+
 	case combatTickMsg:
 		if m.inCombat {
 			m.processCombatTick()
@@ -281,14 +284,15 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			m.historyIndex = 0 // Reset history position
 			m.processInput(input)
+			streamCmd := m.takeEventStreamCmd()
 			// Check if combat just started - start tick timer
 			if m.combatJustStarted {
 				m.combatJustStarted = false
-				return m, tea.Tick(time.Duration(combat.DefaultTickInterval)*time.Millisecond, func(t time.Time) tea.Msg {
+				return m, tea.Batch(streamCmd, tea.Tick(time.Duration(combat.DefaultTickInterval)*time.Millisecond, func(t time.Time) tea.Msg {
 					return combatTickMsg(t)
-				})
+				}))
 			}
-			return m, nil
+			return m, streamCmd
 		}
 
 		// Escape
@@ -48,7 +48,11 @@ func (m *model) formatExitsWithColor() string {
 			m.knownExits[dir] = true
 			exitStyle = lipgloss.NewStyle().Foreground(exitNewColor)
 		}
-		formatted = append(formatted, exitStyle.Render(dir))
+		label := exitStyle.Render(dir)
+		if state := m.exitStates[dir]; state != "" {
+			label += lipgloss.NewStyle().Foreground(gray).Render(" (" + state + ")")
+		}
+		formatted = append(formatted, label)
 	}
 
 	return strings.Join(formatted, ", ")
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// httpGet is a helper for making GET requests
+func httpGet(url string) (*http.Response, error) {
+	return http.Get(url)
+}
+
+// httpPost is a helper for making POST requests with a body
+func httpPost(url, body string) (*http.Response, error) {
+	return http.Post(url, "application/json", strings.NewReader(body))
+}
+
+// httpPut is a helper for making PUT requests with a body
+func httpPut(url, body string) (*http.Response, error) {
+	req, err := http.NewRequest("PUT", url, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Timeout: 5e9}
+	return client.Do(req)
+}
+
+// authedRequest sends a JSON request to url with the current character's
+// Bearer token, for endpoints that act as that character.
+func (m *model) authedRequest(method, url, body string) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.characterToken)
+	return (&http.Client{Timeout: 5 * time.Second}).Do(req)
+}
+
+// ioReadAll is exported for use by other files
+func ioReadAll(r io.Reader) ([]byte, error) {
+	return io.ReadAll(r)
+}
+
+// apiRequest calls a server endpoint as the current character and decodes
+// a 2xx response, such as the 201 from creating something, into out. It
+// shows the server's error and returns false otherwise.
+func (m *model) apiRequest(method, path string, body interface{}, out interface{}) bool {
+	reader := bytes.NewReader(nil)
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, RESTAPIBase+path, reader)
+	if err != nil {
+		m.AppendMessage("Failed to reach the server.", "error")
+		return false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.characterToken)
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		m.AppendMessage("Failed to reach the server.", "error")
+		return false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var errResp struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&errResp) == nil && errResp.Error != "" {
+			m.AppendMessage(errResp.Error, "error")
+		} else {
+			m.AppendMessage(fmt.Sprintf("Request failed (status %d)", resp.StatusCode), "error")
+		}
+		return false
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		m.AppendMessage("Error reading server response", "error")
+		return false
+	}
+	return true
+}
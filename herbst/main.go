@@ -94,6 +94,7 @@ func main() {
 	// Initialize the effects service (caches hooks and effect definitions)
 	restBase := getEnv("API_BASE_URL", "http://localhost:8080")
 	effectsSvc := effects.NewService(restBase, slog.Default())
+	effectsSvc.SetHTTPClient(serviceSigner.Client(10 * time.Second))
 	if err := effectsSvc.RefreshCache(context.Background()); err != nil {
 		log.Printf("Warning: failed to load effects cache: %v", err)
 	}
@@ -151,7 +152,7 @@ func main() {
 							commands:      NewCommandRegistry(),
 						effectsService: effectsSvc,
 						questService:   questSvc,
-						debugLog:       debuglog.New(restBase, fetchAdminEventsToken),
+						debugLog:       debuglog.New(restBase, serviceSigner.Client(3*time.Second)),
 					}
 
 					// Initialize commands
@@ -174,6 +175,7 @@ func main() {
 					if _, err := p.Run(); err != nil {
 						log.Printf("Bubbletea error: %v", err)
 					}
+					m.stopEventStream()
 
 					// Audit: disconnect
 					if m.currentCharacterName != "" {
@@ -9,7 +9,6 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/ssh"
-	"herbst/combat"
 	"herbst/db"
 	"herbst/debuglog"
 	"herbst/effects"
@@ -52,6 +51,7 @@ type model struct {
 	roomName    string
 	roomDesc    string
 	exits       map[string]int
+	exitStates  map[string]string // closed or locked doors, by direction
 	inputBuffer string
 	message     string
 	messageType string // "success", "error", "info"
@@ -114,11 +114,19 @@ type model struct {
 	// Combat state
 	inCombat           bool
 	combatTarget       *RoomCharacter
-	combatManager      *combat.CombatManager
-	combatID           int
-	combatLog          []string // Combat messages for display
-	combatQueuedAction string   // Action queued for next tick
-	combatJustStarted  bool     // Flag to start tick timer
+	combatLastTick     int            // Last server combat tick applied
+	combatAllies       []combatStatus // Everyone fighting the same NPC
+	combatNPCTargetID  int            // Who the NPC is attacking
+	combatLog          []string       // Combat messages for display
+	combatQueuedAction string         // Action queued for next tick
+	combatJustStarted  bool           // Flag to start tick timer
+
+	// Live room events pushed by the server
+	eventStream            *eventStream
+	eventStreamJustStarted bool // Flag to start reading the stream
+
+	// IDs of the character's party members (including itself)
+	partyMemberIDs []int
 
 	// Classless combat skills (slots 1-5)
 	combatSkills *CombatSkillState
@@ -132,9 +140,6 @@ type model struct {
 	// Quest service (data-driven quest tracking)
 	questService *questservice.Service
 
-	// NPC skill cooldown (for enemy skills)
-	npcSkillCooldown int
-
 	// Skill selection state
 	skillSelectSlot   int                 // Which slot we're selecting for (1-5)
 	skillSelectCursor int                 // Cursor position in the list
@@ -207,12 +212,20 @@ type RoomItem struct {
 	ItemDamage      int            `json:"itemDamage"`
 	ItemDurability  int            `json:"itemDurability"`
 	RevealCondition map[string]any `json:"revealCondition"`
+	Rarity          string         `json:"rarity"`
+	Stats           map[string]int `json:"stats"`
+	DamageDiceCount int            `json:"damage_dice_count"`
+	DamageDiceSides int            `json:"damage_dice_sides"`
+	DamageBonus     int            `json:"damage_bonus"`
+	DamageType      string         `json:"damage_type"`
+	ArmorRating     int            `json:"armor_rating"`
 }
 
 // RoomCharacter represents a character in a room
 type RoomCharacter struct {
 	ID            int    `json:"id"`
 	Name          string `json:"name"`
+	Title         string `json:"title,omitempty"`
 	IsNPC         bool   `json:"isNPC"`
 	Level         int    `json:"level"`
 	Class         string `json:"class"`
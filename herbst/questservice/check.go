@@ -11,11 +11,24 @@ import (
 // It calls the server's bulk check endpoint which finds matching quests and
 // increments progress on each one.
 func (s *Service) CheckProgress(charID int, objectiveType string, targetID string) error {
-	url := fmt.Sprintf("%s/api/characters/%d/quests/check-all", s.restBase, charID)
-	body := map[string]interface{}{
+	return s.checkAll(charID, map[string]interface{}{
 		"objective_type": objectiveType,
 		"target_id":      targetID,
-	}
+	})
+}
+
+// CheckPartyProgress credits a kill to the character and to its party
+// members standing in the same room. Call it only for the killing blow.
+func (s *Service) CheckPartyProgress(charID int, targetID string) error {
+	return s.checkAll(charID, map[string]interface{}{
+		"objective_type":   "kill",
+		"target_id":        targetID,
+		"share_with_party": true,
+	})
+}
+
+func (s *Service) checkAll(charID int, body map[string]interface{}) error {
+	url := fmt.Sprintf("%s/api/characters/%d/quests/check-all", s.restBase, charID)
 	data, err := json.Marshal(body)
 	if err != nil {
 		return err
@@ -26,12 +26,13 @@ type QuestObjective struct {
 
 // QuestRewards describes what a character receives on quest completion.
 type QuestRewards struct {
-	XP             int      `json:"xp"`
-	ItemIDs        []string `json:"item_ids"`
-	EffectIDs      []int    `json:"effect_ids"`
-	TagAdds        []string `json:"tag_adds"`
-	TagRemoves     []string `json:"tag_removes"`
-	AchievementIDs []int    `json:"achievement_ids"`
+	XP             int            `json:"xp"`
+	ItemIDs        []string       `json:"item_ids"`
+	EffectIDs      []int          `json:"effect_ids"`
+	TagAdds        []string       `json:"tag_adds"`
+	TagRemoves     []string       `json:"tag_removes"`
+	AchievementIDs []int          `json:"achievement_ids"`
+	Reputation     map[string]int `json:"reputation,omitempty"`
 }
 
 // QuestProgress represents a character's progress on a quest.
@@ -109,7 +109,7 @@ func (m *model) loadRoom(roomID int) {
 	m.currentRoom = room.ID
 	m.roomName = room.Name
 	m.roomDesc = room.Description
-	m.exits = room.Exits
+	m.setExits(room.Exits)
 	for dir := range m.exits {
 		m.knownExits[dir] = true
 	}
@@ -125,9 +125,24 @@ func (m *model) updateLastSeenAt() {
 	now := time.Now().UTC().Format(time.RFC3339)
 	payload := fmt.Sprintf(`{"lastSeenAt": "%s"}`, now)
 
-	resp, err := httpPut(url, payload)
+	resp, err := m.authedRequest("PUT", url, payload)
 	if err != nil {
 		return
 	}
 	defer resp.Body.Close()
-}
\ No newline at end of file
+}
+
+// updateCurrentRoom records the character's room on the server so live
+// room events reach this session after a login or respawn relocates it.
+func (m *model) updateCurrentRoom() {
+	if m.currentCharacterID == 0 || m.currentRoom == 0 {
+		return
+	}
+
+	url := fmt.Sprintf("%s/characters/%d", RESTAPIBase, m.currentCharacterID)
+	resp, err := m.authedRequest("PUT", url, fmt.Sprintf(`{"currentRoomId": %d}`, m.currentRoom))
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+}
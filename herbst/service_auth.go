@@ -0,0 +1,18 @@
+package main
+
+import (
+	"log"
+
+	"herbst/svcauth"
+)
+
+// serviceSigner signs the SSH server's own requests to the API server, such
+// as debug logs and effect damage, with its service key. Without a key
+// those requests fail rather than go out unsigned.
+var serviceSigner = svcauth.FromEnv()
+
+func init() {
+	if !serviceSigner.HasKey() {
+		log.Printf("Warning: no service key; set SERVICE_KEY_ID and SERVICE_KEY_SECRET (or SERVICE_KEYS_DEV=1 for local development)")
+	}
+}
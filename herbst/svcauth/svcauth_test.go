@@ -0,0 +1,66 @@
+package svcauth
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientSignsRequests(t *testing.T) {
+	s := New("herbst", "0123456789abcdef-secret")
+	s.now = func() time.Time { return time.Unix(1760000000, 0) }
+
+	var got *http.Request
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		got, body = r, string(b)
+	}))
+	defer srv.Close()
+
+	var nonces []string
+	for i := 0; i < 2; i++ {
+		resp, err := s.Client(time.Second).Post(srv.URL+"/api/debug-log?x=1", "application/json", strings.NewReader(`{"message":"hi"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		nonces = append(nonces, got.Header.Get(HeaderNonce))
+	}
+	if nonces[0] == "" || nonces[0] == nonces[1] {
+		t.Errorf("identical requests got nonces %q, want distinct ones", nonces)
+	}
+
+	if body != `{"message":"hi"}` {
+		t.Errorf("body reached the server as %q", body)
+	}
+	if got.Header.Get(HeaderKey) != "herbst" || got.Header.Get(HeaderTimestamp) != "1760000000" {
+		t.Errorf("headers: %v", got.Header)
+	}
+	want := Signature(s.Secret, "POST", "/api/debug-log?x=1", "1760000000", nonces[1], []byte(body))
+	if got.Header.Get(HeaderSignature) != want {
+		t.Errorf("signature %q, want %q", got.Header.Get(HeaderSignature), want)
+	}
+}
+
+func TestFromEnvFailsClosed(t *testing.T) {
+	t.Setenv("SERVICE_KEY_ID", "")
+	t.Setenv("SERVICE_KEY_SECRET", "")
+	t.Setenv("SERVICE_KEYS_DEV", "")
+	s := FromEnv()
+	if s.HasKey() {
+		t.Fatalf("got key %q without any configured", s.KeyID)
+	}
+	req, _ := http.NewRequest("POST", "http://api/api/events", strings.NewReader("{}"))
+	if err := s.Sign(req); err != ErrNoKey {
+		t.Errorf("signing without a key: got %v, want ErrNoKey", err)
+	}
+
+	t.Setenv("SERVICE_KEYS_DEV", "1")
+	if s := FromEnv(); s.KeyID != devKeyID {
+		t.Errorf("with SERVICE_KEYS_DEV, got key %q, want the development key", s.KeyID)
+	}
+}
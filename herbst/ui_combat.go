@@ -99,6 +99,20 @@ func (m *model) renderCombatScreen() string {
 		content.WriteString(fmt.Sprintf("HP: %s %d/%d\n", hpBar, targetHP, targetMaxHP))
 	}
 
+	// Group fight: everyone on the NPC, with a marker on its current target
+	if len(m.combatAllies) > 1 {
+		allyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#a29bfe"))
+		content.WriteString("\n")
+		for _, ally := range m.combatAllies {
+			marker := "  "
+			if ally.ID == m.combatNPCTargetID {
+				marker = "🎯"
+			}
+			content.WriteString(fmt.Sprintf("%s %s %d/%d HP (threat %d)\n",
+				marker, allyStyle.Render(ally.Name), ally.HP, ally.MaxHP, ally.Threat))
+		}
+	}
+
 	content.WriteString("\n")
 
 	// Player stats
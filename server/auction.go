@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"herbst-server/service"
+)
+
+// startAuctionSettlement runs a background goroutine that ends auctions once
+// their time runs out, handing items to the winners or back to the sellers.
+func startAuctionSettlement(services *service.Container) {
+	interval := time.Minute
+	log.Printf("[auction] running: settling finished auctions every %s", interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			n, err := services.Auction.SettleDue(context.Background(), time.Now())
+			if err != nil {
+				log.Printf("[auction] settlement error: %v", err)
+			}
+			if n > 0 {
+				log.Printf("[auction] settled %d auction(s)", n)
+			}
+		}
+	}()
+}
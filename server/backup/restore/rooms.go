@@ -9,6 +9,7 @@ import (
 	"herbst-server/db"
 	"herbst-server/backup/types"
 	"herbst-server/db/room"
+	"herbst-server/db/schema"
 )
 
 // Rooms imports rooms from backup
@@ -19,12 +20,13 @@ func Rooms(ctx context.Context, client *db.Client, backupDir string, mapping *ty
 	}
 
 	var rooms []struct {
-		ID             int            `json:"id"`
-		Name           string         `json:"name"`
-		Description    string         `json:"description"`
-		IsStartingRoom bool           `json:"isStartingRoom"`
-		Exits          map[string]int `json:"exits"`
-		Atmosphere     string         `json:"atmosphere"`
+		ID             int                         `json:"id"`
+		Name           string                      `json:"name"`
+		Description    string                      `json:"description"`
+		IsStartingRoom bool                        `json:"isStartingRoom"`
+		Exits          map[string]int              `json:"exits"`
+		ExitStates     map[string]schema.ExitState `json:"exit_states"`
+		Atmosphere     string                      `json:"atmosphere"`
 	}
 	if err := json.Unmarshal(data, &rooms); err != nil {
 		return err
@@ -39,7 +41,7 @@ func Rooms(ctx context.Context, client *db.Client, backupDir string, mapping *ty
 
 		created, err := client.Room.Create().
 			SetName(r.Name).SetDescription(r.Description).
-			SetIsStartingRoom(r.IsStartingRoom).SetExits(r.Exits).
+			SetIsStartingRoom(r.IsStartingRoom).SetExits(r.Exits).SetExitStates(r.ExitStates).
 			SetAtmosphere(room.Atmosphere(r.Atmosphere)).Save(ctx)
 		if err != nil {
 			return err
@@ -0,0 +1,308 @@
+package combat
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// scripted returns queued d20/die faces (1-based) and chance rolls in order.
+type scripted struct {
+	faces  []int
+	floats []float64
+}
+
+func (s *scripted) Intn(n int) int {
+	if len(s.faces) == 0 {
+		return 0
+	}
+	f := s.faces[0]
+	s.faces = s.faces[1:]
+	if f > n {
+		f = n
+	}
+	return f - 1
+}
+
+func (s *scripted) Float64() float64 {
+	if len(s.floats) == 0 {
+		return 0.99
+	}
+	f := s.floats[0]
+	s.floats = s.floats[1:]
+	return f
+}
+
+type fakeBackend struct {
+	chars  map[int]*Combatant
+	damage map[int]int // attacker → total damage dealt
+}
+
+func newFakeBackend(chars ...*Combatant) *fakeBackend {
+	b := &fakeBackend{chars: map[int]*Combatant{}, damage: map[int]int{}}
+	for _, c := range chars {
+		b.chars[c.ID] = c
+	}
+	return b
+}
+
+func (b *fakeBackend) Load(_ context.Context, id int) (*Combatant, error) {
+	c, ok := b.chars[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	cp := *c
+	return &cp, nil
+}
+
+func (b *fakeBackend) ApplyDamage(_ context.Context, attackerID, targetID, damage int) (int, bool, error) {
+	c := b.chars[targetID]
+	c.HP -= damage
+	if c.HP < 0 {
+		c.HP = 0
+	}
+	b.damage[attackerID] += damage
+	return c.HP, c.HP == 0, nil
+}
+
+func (b *fakeBackend) Heal(_ context.Context, id, amount int) (int, error) {
+	c := b.chars[id]
+	c.HP += amount
+	if c.HP > c.MaxHP {
+		c.HP = c.MaxHP
+	}
+	return c.HP, nil
+}
+
+func (b *fakeBackend) UseSkill(_ context.Context, _, skillID int) (*Skill, error) {
+	return &Skill{ID: skillID, Name: "Firebolt", Cooldown: 2, Effects: []SkillEffect{
+		{Type: "damage", Target: "enemy", Value: 7},
+	}}, nil
+}
+
+func (b *fakeBackend) Flee(_ context.Context, npcID int) (string, error) {
+	b.chars[npcID].RoomID = 2
+	return "north", nil
+}
+
+func player(id int, name string) *Combatant {
+	return &Combatant{ID: id, Name: name, Level: 1, HP: 20, MaxHP: 20, AC: 10, RoomID: 1,
+		MainHand: Weapon{Name: "fists", DiceCount: 1, DiceSides: 6}}
+}
+
+func goblin() *Combatant {
+	return &Combatant{ID: 100, Name: "Goblin", Level: 1, HP: 10, MaxHP: 10, AC: 10, IsNPC: true, RoomID: 1,
+		MainHand: Weapon{Bonus: 3}}
+}
+
+func TestPlayersShareFight(t *testing.T) {
+	b := newFakeBackend(player(1, "Alice"), player(2, "Bob"), goblin())
+	m := NewManager(b, &Dice{src: &scripted{}}, nil)
+	ctx := context.Background()
+
+	a, err := m.Engage(ctx, 1, 100)
+	if err != nil {
+		t.Fatalf("engage alice: %v", err)
+	}
+	bv, err := m.Engage(ctx, 2, 100)
+	if err != nil {
+		t.Fatalf("engage bob: %v", err)
+	}
+	if a.FightID != bv.FightID {
+		t.Fatalf("expected shared fight, got %d and %d", a.FightID, bv.FightID)
+	}
+	if len(bv.Players) != 2 {
+		t.Fatalf("expected 2 players, got %d", len(bv.Players))
+	}
+}
+
+func TestTickResolvesKill(t *testing.T) {
+	b := newFakeBackend(player(1, "Alice"), player(2, "Bob"), goblin())
+	// Alice: d20=15 hits, d6=6. Bob: d20=15 hits, d6=5 → 11 total kills the goblin.
+	m := NewManager(b, &Dice{src: &scripted{faces: []int{15, 6, 15, 5}}}, nil)
+	ctx := context.Background()
+	m.Engage(ctx, 1, 100)
+	m.Engage(ctx, 2, 100)
+
+	var notified []int
+	m.SetNotifier(func(ids []int, r TickResult) { notified = ids })
+	m.Tick(ctx)
+
+	if b.chars[100].HP != 0 {
+		t.Fatalf("expected goblin dead, hp=%d", b.chars[100].HP)
+	}
+	if b.damage[1] != 6 || b.damage[2] != 5 {
+		t.Fatalf("unexpected damage split: %v", b.damage)
+	}
+	res := m.Results(1, 0)
+	if len(res) != 1 || !res[0].Ended || res[0].KillerID != 2 {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	if len(notified) != 2 {
+		t.Fatalf("expected both players notified, got %v", notified)
+	}
+	if m.InCombat(1) || m.InCombat(2) {
+		t.Fatal("players should leave combat when the fight ends")
+	}
+	if _, err := m.Engage(ctx, 1, 100); !errors.Is(err, ErrTargetDefeated) {
+		t.Fatalf("expected ErrTargetDefeated, got %v", err)
+	}
+}
+
+func TestNPCAttacksFirstPlayerAndDefendHelps(t *testing.T) {
+	b := newFakeBackend(player(1, "Alice"), goblin())
+	// Alice defends; goblin rolls 12 (+0) vs AC 10+5 → miss.
+	m := NewManager(b, &Dice{src: &scripted{faces: []int{12}}}, nil)
+	ctx := context.Background()
+	m.Engage(ctx, 1, 100)
+	if err := m.Queue(1, Action{Type: ActionDefend}); err != nil {
+		t.Fatalf("queue: %v", err)
+	}
+	m.Tick(ctx)
+	if b.chars[1].HP != 20 {
+		t.Fatalf("defend should have blocked the hit, hp=%d", b.chars[1].HP)
+	}
+
+	// Next tick Alice waits; goblin rolls 12 vs AC 10 → 3 damage.
+	m.dice.src = &scripted{faces: []int{12}}
+	m.Queue(1, Action{Type: ActionWait})
+	m.Tick(ctx)
+	if b.chars[1].HP != 17 {
+		t.Fatalf("expected 17 hp, got %d", b.chars[1].HP)
+	}
+}
+
+func TestFleeLeavesFight(t *testing.T) {
+	b := newFakeBackend(player(1, "Alice"), goblin())
+	m := NewManager(b, &Dice{src: &scripted{faces: []int{18}}}, nil)
+	ctx := context.Background()
+	m.Engage(ctx, 1, 100)
+	m.Queue(1, Action{Type: ActionFlee})
+	m.Tick(ctx)
+	if m.InCombat(1) {
+		t.Fatal("expected player to have fled")
+	}
+	res := m.Results(1, 0)
+	if len(res) != 1 || len(res[0].Fled) != 1 || !res[0].Ended {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+}
+
+func TestQueueValidation(t *testing.T) {
+	b := newFakeBackend(player(1, "Alice"), goblin())
+	m := NewManager(b, &Dice{src: &scripted{faces: []int{20, 1}}}, nil)
+	ctx := context.Background()
+
+	if err := m.Queue(1, Action{Type: ActionAttack}); !errors.Is(err, ErrNotInCombat) {
+		t.Fatalf("expected ErrNotInCombat, got %v", err)
+	}
+	m.Engage(ctx, 1, 100)
+	if err := m.Queue(1, Action{Type: "dance"}); !errors.Is(err, ErrInvalidAction) {
+		t.Fatalf("expected ErrInvalidAction, got %v", err)
+	}
+	if err := m.Queue(1, Action{Type: ActionSkill, SkillID: 9}); err != nil {
+		t.Fatalf("queue skill: %v", err)
+	}
+	m.Tick(ctx)
+	if b.chars[100].HP != 3 {
+		t.Fatalf("expected skill to deal 7, hp=%d", b.chars[100].HP)
+	}
+	if err := m.Queue(1, Action{Type: ActionSkill, SkillID: 9}); !errors.Is(err, ErrSkillOnCooldown) {
+		t.Fatalf("expected ErrSkillOnCooldown, got %v", err)
+	}
+}
+
+func TestThreatPicksTarget(t *testing.T) {
+	g := goblin()
+	g.HP, g.MaxHP = 50, 50
+	b := newFakeBackend(player(1, "Alice"), player(2, "Bob"), g)
+	// Tick 1: Bob hits for 6 (threat 7 vs Alice's 1), goblin hits Bob.
+	// Tick 2: Alice hits for 6 — 7 vs 7 is not enough to pull the goblin.
+	// Tick 3: Alice hits for 2 more — 9 > 7×1.1, the goblin turns on her.
+	m := NewManager(b, &Dice{src: &scripted{faces: []int{15, 6, 12, 15, 6, 12, 15, 2, 12}}}, nil)
+	ctx := context.Background()
+	m.Engage(ctx, 1, 100)
+	m.Engage(ctx, 2, 100)
+
+	m.Queue(1, Action{Type: ActionWait})
+	m.Tick(ctx)
+	if b.chars[2].HP != 17 || b.chars[1].HP != 20 {
+		t.Fatalf("expected goblin to hit Bob, alice=%d bob=%d", b.chars[1].HP, b.chars[2].HP)
+	}
+
+	m.Queue(2, Action{Type: ActionWait})
+	m.Tick(ctx)
+	if b.chars[2].HP != 14 {
+		t.Fatalf("expected goblin to stay on Bob, bob=%d", b.chars[2].HP)
+	}
+
+	m.Queue(2, Action{Type: ActionWait})
+	m.Tick(ctx)
+	if b.chars[1].HP != 17 {
+		t.Fatalf("expected goblin to switch to Alice, alice=%d", b.chars[1].HP)
+	}
+	res := m.Results(1, 2)
+	if len(res) != 1 || res[0].TargetID != 1 {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	for _, p := range res[0].Players {
+		if p.ID == 1 && p.Threat != 9 {
+			t.Fatalf("expected Alice threat 9, got %d", p.Threat)
+		}
+	}
+}
+
+func TestNPCFleesBelowThreshold(t *testing.T) {
+	g := goblin()
+	g.FleeBelow = 50
+	b := newFakeBackend(player(1, "Alice"), g)
+	// Alice hits for 6 (10 → 4 HP, under half); the goblin rolls 15 to flee.
+	m := NewManager(b, &Dice{src: &scripted{faces: []int{15, 6, 15}}}, nil)
+	ctx := context.Background()
+	m.Engage(ctx, 1, 100)
+	m.Tick(ctx)
+
+	res := m.Results(1, 0)
+	if len(res) != 1 || !res[0].NPCFled || !res[0].Ended {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	if b.chars[100].RoomID != 2 || b.chars[1].HP != 20 {
+		t.Fatalf("expected goblin to flee without attacking, room=%d alice=%d", b.chars[100].RoomID, b.chars[1].HP)
+	}
+	if m.InCombat(1) || m.Fighting(100) {
+		t.Fatal("fight should be over once the goblin escapes")
+	}
+}
+
+func TestHelperAssistsAndTakesOver(t *testing.T) {
+	hob := goblin()
+	hob.ID, hob.Name = 101, "Hobgoblin"
+	b := newFakeBackend(player(1, "Alice"), goblin(), hob)
+	// Tick 1: Alice hits for 6; goblin and hobgoblin each hit her for 3.
+	// Tick 2: Alice kills the goblin; the hobgoblin hits and takes over.
+	m := NewManager(b, &Dice{src: &scripted{faces: []int{15, 6, 12, 12, 15, 6, 12}}}, nil)
+	ctx := context.Background()
+	m.Engage(ctx, 1, 100)
+	if err := m.Assist(ctx, 101, 100); err != nil {
+		t.Fatalf("assist: %v", err)
+	}
+	if err := m.Assist(ctx, 101, 100); !errors.Is(err, ErrAlreadyFighting) {
+		t.Fatalf("expected ErrAlreadyFighting, got %v", err)
+	}
+
+	m.Tick(ctx)
+	if b.chars[1].HP != 14 {
+		t.Fatalf("expected both NPCs to hit Alice, hp=%d", b.chars[1].HP)
+	}
+	m.Tick(ctx)
+	res := m.Results(1, 1)
+	if len(res) != 1 || res[0].Ended || res[0].KillerID != 1 || res[0].NPC.ID != 101 {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	if b.chars[1].HP != 11 {
+		t.Fatalf("expected the hobgoblin to keep hitting, hp=%d", b.chars[1].HP)
+	}
+	if !m.InCombat(1) || m.Fighting(100) || !m.Fighting(101) {
+		t.Fatal("expected Alice to be fighting the hobgoblin")
+	}
+}
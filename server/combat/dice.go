@@ -0,0 +1,60 @@
+package combat
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// source is the randomness a Dice draws from. *rand.Rand satisfies it;
+// tests substitute a fixed sequence.
+type source interface {
+	Intn(n int) int
+	Float64() float64
+}
+
+// Dice rolls the server's combat dice. It is safe for concurrent use.
+type Dice struct {
+	mu  sync.Mutex
+	src source
+}
+
+// NewDice returns dice seeded from the current time.
+func NewDice() *Dice {
+	return &Dice{src: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+func (d *Dice) intn(n int) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.src.Intn(n)
+}
+
+// Roll rolls count dice with the given number of sides and returns the raw
+// roll and the total with modifier.
+func (d *Dice) Roll(sides, count, modifier int) (roll, total int) {
+	for i := 0; i < count; i++ {
+		roll += d.intn(sides) + 1
+	}
+	return roll, roll + modifier
+}
+
+// D20 rolls a d20 and adds modifier.
+func (d *Dice) D20(modifier int) (roll, total int) {
+	roll = d.intn(20) + 1
+	return roll, roll + modifier
+}
+
+// RollWithCrit rolls a d20 to hit. A natural 20 is a critical, a natural 1
+// a fumble.
+func (d *Dice) RollWithCrit(modifier int) (roll, total int, isCrit, isFumble bool) {
+	roll, total = d.D20(modifier)
+	return roll, total, roll == 20, roll == 1
+}
+
+// Chance reports true with probability p.
+func (d *Dice) Chance(p float64) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.src.Float64() < p
+}
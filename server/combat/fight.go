@@ -0,0 +1,577 @@
+package combat
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"sync"
+)
+
+const (
+	// defendBonus is the AC bonus for a player who defends this tick.
+	defendBonus = 5
+	// fleeDC is the difficulty of escaping combat.
+	fleeDC = 12
+)
+
+// npcSkill is a special ability an NPC may use instead of attacking.
+type npcSkill struct {
+	name     string
+	cooldown int     // minimum ticks between uses
+	healPct  float64 // fraction of max HP restored
+}
+
+// npcSkills is keyed by Character.npc_skill_id.
+var npcSkills = map[string]npcSkill{
+	"druid_heal": {name: "Nature's Blessing", cooldown: 4, healPct: 0.05},
+}
+
+// NPCSkillUsageChance is the chance per tick an NPC uses its skill: 10% at
+// full health rising with missing health, capped at 50%.
+func NPCSkillUsageChance(hp, maxHP int) float64 {
+	if maxHP <= 0 {
+		return 0
+	}
+	missing := 1.0 - float64(hp)/float64(maxHP)
+	return math.Min(0.10+missing*0.40, 0.50)
+}
+
+// Fight is one NPC and the players fighting it. Other NPCs may assist the
+// NPC: they strike its target every tick, and the first of them takes over
+// if the NPC dies or flees while players are still fighting.
+type Fight struct {
+	id               int
+	npc              *Combatant
+	helpers          []*Combatant // in join order
+	players          []*Combatant // in join order
+	threat           threatTable
+	target           int // the NPC's current target
+	queued           map[int]Action
+	defending        map[int]bool
+	cooldowns        map[int]map[int]int // player → skill → ticks remaining
+	npcSkillCooldown int
+	npcStunned       int
+	ended            bool
+
+	mu sync.Mutex
+}
+
+func newFight(id int, npc *Combatant) *Fight {
+	return &Fight{
+		id:        id,
+		npc:       npc,
+		threat:    make(threatTable),
+		queued:    make(map[int]Action),
+		defending: make(map[int]bool),
+		cooldowns: make(map[int]map[int]int),
+	}
+}
+
+// join adds a player. It returns false if the fight has already ended.
+func (f *Fight) join(p *Combatant) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.ended {
+		return false
+	}
+	f.players = append(f.players, p)
+	f.threat.add(p.ID, joinThreat)
+	f.cooldowns[p.ID] = make(map[int]int)
+	return true
+}
+
+func (f *Fight) queue(charID int, a Action) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.player(charID) == nil {
+		return ErrNotInCombat
+	}
+	if a.Type == ActionSkill && f.cooldowns[charID][a.SkillID] > 0 {
+		return ErrSkillOnCooldown
+	}
+	f.queued[charID] = a
+	return nil
+}
+
+func (f *Fight) player(id int) *Combatant {
+	for _, p := range f.players {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func (f *Fight) playerIDs() []int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ids := make([]int, len(f.players))
+	for i, p := range f.players {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func (f *Fight) view(charID, tick int) *View {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &View{
+		FightID:  f.id,
+		Tick:     tick,
+		NPC:      status(f.npc),
+		Players:  f.statuses(),
+		TargetID: f.target,
+		Queued:   f.queued[charID].Type,
+	}
+}
+
+func (f *Fight) statuses() []Status {
+	out := make([]Status, len(f.players))
+	for i, p := range f.players {
+		out[i] = status(p)
+		out[i].Threat = f.threat[p.ID]
+	}
+	return out
+}
+
+func status(c *Combatant) Status {
+	return Status{ID: c.ID, Name: c.Name, HP: c.HP, MaxHP: c.MaxHP}
+}
+
+// round collects the outcome of one tick.
+type round struct {
+	ctx     context.Context
+	backend Backend
+	dice    *Dice
+	logger  *slog.Logger
+	result  TickResult
+}
+
+func (r *round) logf(format string, args ...interface{}) {
+	r.result.Lines = append(r.result.Lines, fmt.Sprintf(format, args...))
+}
+
+// resolve runs one tick: every player acts in join order (auto-attacking if
+// nothing was queued), then the NPC acts against the player at the top of
+// its threat table.
+func (f *Fight) resolve(ctx context.Context, tick int, backend Backend, dice *Dice, logger *slog.Logger) TickResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r := &round{
+		ctx:     ctx,
+		backend: backend,
+		dice:    dice,
+		logger:  logger,
+		result:  TickResult{FightID: f.id, Tick: tick, RoomID: f.npc.RoomID},
+	}
+
+	for _, cds := range f.cooldowns {
+		for id, cd := range cds {
+			if cd > 0 {
+				cds[id] = cd - 1
+			}
+		}
+	}
+	if f.npcSkillCooldown > 0 {
+		f.npcSkillCooldown--
+	}
+
+	queued := f.queued
+	f.queued = make(map[int]Action)
+	f.defending = make(map[int]bool)
+
+	for _, p := range append([]*Combatant(nil), f.players...) {
+		if f.npc.HP <= 0 {
+			break
+		}
+		a, ok := queued[p.ID]
+		if !ok {
+			a = Action{Type: ActionAttack}
+		}
+		f.act(r, p, a)
+	}
+
+	if f.npc.HP > 0 && len(f.players) > 0 {
+		if f.wantsToFlee() {
+			f.npcFlee(r)
+		} else {
+			f.npcTurn(r)
+		}
+	}
+	for _, h := range f.helpers {
+		if len(f.players) == 0 {
+			break
+		}
+		if target, _ := f.pickTarget(); target != nil {
+			f.strike(r, h, target)
+		}
+	}
+
+	if (f.npc.HP <= 0 || r.result.NPCFled) && len(f.helpers) > 0 && len(f.players) > 0 {
+		f.npc = f.helpers[0]
+		f.helpers = f.helpers[1:]
+		f.npcSkillCooldown = 0
+		f.npcStunned = 0
+		r.logf("⚔ %s takes up the fight!", f.npc.Name)
+	} else if f.npc.HP <= 0 || r.result.NPCFled || len(f.players) == 0 {
+		f.ended = true
+		r.result.Ended = true
+	}
+	r.result.NPC = status(f.npc)
+	for _, h := range f.helpers {
+		r.result.Helpers = append(r.result.Helpers, status(h))
+	}
+	r.result.Players = f.statuses()
+	r.result.TargetID = f.target
+	return r.result
+}
+
+func (f *Fight) act(r *round, p *Combatant, a Action) {
+	switch a.Type {
+	case ActionAttack:
+		f.attack(r, p)
+	case ActionDefend:
+		f.defending[p.ID] = true
+		r.logf("🛡 %s takes a defensive stance!", p.Name)
+	case ActionFlee:
+		roll, total := r.dice.D20(p.Level / 2)
+		if total >= fleeDC {
+			r.logf("🏃 %s escapes! (d20=%d + %d = %d vs DC %d)", p.Name, roll, p.Level/2, total, fleeDC)
+			f.remove(p.ID)
+			r.result.Fled = append(r.result.Fled, p.ID)
+		} else {
+			r.logf("🏃 %s fails to escape! (d20=%d + %d = %d vs DC %d)", p.Name, roll, p.Level/2, total, fleeDC)
+		}
+	case ActionSkill:
+		f.useSkill(r, p, a.SkillID)
+	case ActionWait:
+		r.logf("⏱ %s waits.", p.Name)
+	}
+}
+
+func (f *Fight) attack(r *round, p *Combatant) {
+	roll, toHit, isCrit, isFumble := r.dice.RollWithCrit(p.ToHit)
+	if isFumble {
+		r.logf("🎲 FUMBLE! %s stumbles badly!", p.Name)
+		return
+	}
+	if toHit < f.npc.AC && !isCrit {
+		r.logf("🎲 %s misses! (d20=%d + %d = %d vs AC %d)", p.Name, roll, p.ToHit, toHit, f.npc.AC)
+		return
+	}
+
+	damage := rollWeapon(r.dice, p.MainHand)
+	offHand := 0
+	if p.OffHand != nil {
+		offHand = rollWeapon(r.dice, *p.OffHand) / 2
+		if offHand < 1 {
+			offHand = 1
+		}
+		damage += offHand
+	}
+	if isCrit {
+		damage *= 2
+		r.logf("🎲 CRITICAL HIT! Natural 20!")
+	}
+	if p.MainHand.Untrained {
+		r.logf("⚠ %s is untrained with %s - half damage!", p.Name, p.MainHand.Name)
+	}
+
+	if !f.damageNPC(r, p, damage) {
+		return
+	}
+	r.logf("⚔ %s %s %s with %s for %d damage!", p.Name, damageVerb(p.MainHand.Name), f.npc.Name, p.MainHand.Name, damage)
+	if offHand > 0 {
+		r.logf("  + %d off-hand damage", offHand)
+	}
+	f.checkNPCDefeated(r, p)
+}
+
+// damageNPC applies damage from p to the NPC and records its new HP.
+func (f *Fight) damageNPC(r *round, p *Combatant, damage int) bool {
+	hp, _, err := r.backend.ApplyDamage(r.ctx, p.ID, f.npc.ID, damage)
+	if err != nil {
+		r.logger.Error("combat: failed to damage npc",
+			slog.String("service", "combat"), slog.Int("npc_id", f.npc.ID), slog.String("error", err.Error()))
+		r.logf("%s's blow seems to pass straight through %s!", p.Name, f.npc.Name)
+		return false
+	}
+	f.npc.HP = hp
+	f.threat.add(p.ID, damage)
+	return true
+}
+
+func (f *Fight) checkNPCDefeated(r *round, p *Combatant) {
+	if f.npc.HP > 0 {
+		return
+	}
+	r.logf("✦ %s has been defeated!", f.npc.Name)
+	r.result.KillerID = p.ID
+}
+
+func (f *Fight) useSkill(r *round, p *Combatant, skillID int) {
+	if f.cooldowns[p.ID][skillID] > 0 {
+		r.logf("⏱ %s's skill is still on cooldown.", p.Name)
+		return
+	}
+	skill, err := r.backend.UseSkill(r.ctx, p.ID, skillID)
+	if err != nil {
+		r.logf("⚡ %s fails to use a skill: %v", p.Name, err)
+		return
+	}
+	f.cooldowns[p.ID][skill.ID] = skill.Cooldown
+
+	for _, e := range skill.Effects {
+		switch {
+		case e.Type == "damage" && e.Target == "enemy":
+			if !f.damageNPC(r, p, e.Value) {
+				return
+			}
+			r.logf("⚡ %s's %s deals %d damage!", p.Name, skill.Name, e.Value)
+			f.checkNPCDefeated(r, p)
+			if f.npc.HP <= 0 {
+				return
+			}
+		case e.Type == "heal" && e.Target == "self":
+			hp, err := r.backend.Heal(r.ctx, p.ID, e.Value)
+			if err != nil {
+				r.logger.Error("combat: failed to heal",
+					slog.String("service", "combat"), slog.Int("character_id", p.ID), slog.String("error", err.Error()))
+				continue
+			}
+			p.HP = hp
+			f.threat.addHeal(p.ID, e.Value)
+			r.logf("✚ %s's %s heals %d HP!", p.Name, skill.Name, e.Value)
+		case e.Type == "stun" && e.Target == "enemy":
+			// Stat contest: the caster's accuracy against the target's constitution.
+			if p.ToHit+r.dice.intn(6)+1 > f.npc.Level/2+r.dice.intn(6)+1 {
+				f.npcStunned = e.Duration
+				r.logf("⚡ %s's %s stuns %s for %d round(s)!", p.Name, skill.Name, f.npc.Name, e.Duration)
+			} else {
+				r.logf("⚡ %s's %s missed! %s resisted.", p.Name, skill.Name, f.npc.Name)
+			}
+		default:
+			r.logf("⚡ %s uses %s: %s", p.Name, skill.Name, e.Type)
+		}
+	}
+}
+
+func (f *Fight) npcTurn(r *round) {
+	if f.npcStunned > 0 {
+		f.npcStunned--
+		r.logf("💫 %s is stunned!", f.npc.Name)
+		return
+	}
+
+	if f.tryNPCSkill(r) && r.dice.Chance(0.5) {
+		return
+	}
+
+	target, switched := f.pickTarget()
+	if switched {
+		r.logf("🎯 %s turns on %s!", f.npc.Name, target.Name)
+	}
+	f.strike(r, f.npc, target)
+}
+
+// strike resolves one attack by an NPC — the one being fought or a helper —
+// against a player.
+func (f *Fight) strike(r *round, npc, target *Combatant) {
+	roll, toHit, isCrit, isFumble := r.dice.RollWithCrit(npc.ToHit)
+	ac := target.AC
+	if f.defending[target.ID] {
+		ac += defendBonus
+	}
+	if isFumble {
+		r.logf("🎲 %s FUMBLES! (rolled 1)", npc.Name)
+		return
+	}
+	if toHit < ac && !isCrit {
+		r.logf("🎲 %s misses %s! (d20=%d + %d = %d vs AC %d)", npc.Name, target.Name, roll, npc.ToHit, toHit, ac)
+		return
+	}
+
+	damage := rollWeapon(r.dice, npc.MainHand)
+	if isCrit {
+		damage *= 2
+	}
+	hp, defeated, err := r.backend.ApplyDamage(r.ctx, npc.ID, target.ID, damage)
+	if err != nil {
+		r.logger.Error("combat: failed to damage player",
+			slog.String("service", "combat"), slog.Int("character_id", target.ID), slog.String("error", err.Error()))
+		return
+	}
+	target.HP = hp
+
+	switch {
+	case f.defending[target.ID]:
+		r.logf("⚔ %s attacks %s! Blocked for %d damage!", npc.Name, target.Name, damage)
+	case isCrit:
+		r.logf("⚔ %s critical hit on %s! %d damage!", npc.Name, target.Name, damage)
+	default:
+		r.logf("⚔ %s hits %s for %d damage!", npc.Name, target.Name, damage)
+	}
+
+	if defeated {
+		r.logf("☠ %s has been defeated!", target.Name)
+		f.remove(target.ID)
+		r.result.Died = append(r.result.Died, target.ID)
+	}
+}
+
+// wantsToFlee reports whether the NPC has dropped below its flee threshold.
+func (f *Fight) wantsToFlee() bool {
+	return f.npc.FleeBelow > 0 && f.npc.MaxHP > 0 && f.npc.HP*100 < f.npc.FleeBelow*f.npc.MaxHP
+}
+
+// npcFlee spends the NPC's turn trying to escape, with the same roll players
+// make. An NPC that gets away ends its part in the fight.
+func (f *Fight) npcFlee(r *round) {
+	if f.npcStunned > 0 {
+		f.npcStunned--
+		r.logf("💫 %s is stunned!", f.npc.Name)
+		return
+	}
+	roll, total := r.dice.D20(f.npc.Level / 2)
+	if total < fleeDC {
+		r.logf("🏃 %s tries to flee but can't get away! (d20=%d + %d = %d vs DC %d)", f.npc.Name, roll, f.npc.Level/2, total, fleeDC)
+		return
+	}
+	exit, err := r.backend.Flee(r.ctx, f.npc.ID)
+	if err != nil {
+		r.logger.Error("combat: npc flee failed",
+			slog.String("service", "combat"), slog.Int("npc_id", f.npc.ID), slog.String("error", err.Error()))
+	}
+	if err != nil || exit == "" {
+		r.logf("🏃 %s looks for a way out, but there is none!", f.npc.Name)
+		return
+	}
+	r.logf("🏃 %s flees %s!", f.npc.Name, exit)
+	r.result.NPCFled = true
+}
+
+// addHelper brings another NPC into the fight. It returns false if the
+// fight has already ended.
+func (f *Fight) addHelper(h *Combatant) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.ended {
+		return false
+	}
+	f.helpers = append(f.helpers, h)
+	return true
+}
+
+// roomID is where the fight is taking place.
+func (f *Fight) roomID() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.npc.RoomID
+}
+
+// involves reports whether npcID is the NPC being fought or one helping it.
+func (f *Fight) involves(npcID int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.npc.ID == npcID {
+		return true
+	}
+	for _, h := range f.helpers {
+		if h.ID == npcID {
+			return true
+		}
+	}
+	return false
+}
+
+// tryNPCSkill rolls for the NPC's special skill and reports whether it was used.
+func (f *Fight) tryNPCSkill(r *round) bool {
+	skill, ok := npcSkills[f.npc.NPCSkill]
+	if !ok || f.npcSkillCooldown > 0 {
+		return false
+	}
+	if !r.dice.Chance(NPCSkillUsageChance(f.npc.HP, f.npc.MaxHP)) {
+		return false
+	}
+	amount := int(float64(f.npc.MaxHP) * skill.healPct)
+	if amount < 1 {
+		amount = 1
+	}
+	hp, err := r.backend.Heal(r.ctx, f.npc.ID, amount)
+	if err != nil {
+		r.logger.Error("combat: npc skill failed",
+			slog.String("service", "combat"), slog.Int("npc_id", f.npc.ID), slog.String("error", err.Error()))
+		return false
+	}
+	f.npc.HP = hp
+	f.npcSkillCooldown = skill.cooldown
+	r.logf("🌿 %s uses %s! Heals for %d HP (%d/%d)", f.npc.Name, skill.name, amount, f.npc.HP, f.npc.MaxHP)
+	return true
+}
+
+func (f *Fight) remove(charID int) {
+	for i, p := range f.players {
+		if p.ID == charID {
+			f.players = append(f.players[:i], f.players[i+1:]...)
+			break
+		}
+	}
+	delete(f.cooldowns, charID)
+	delete(f.threat, charID)
+	if f.target == charID {
+		f.target = 0
+	}
+}
+
+// rollWeapon rolls a weapon's damage dice. Untrained wielders deal half.
+func rollWeapon(d *Dice, w Weapon) int {
+	total := w.Bonus
+	if w.DiceCount > 0 && w.DiceSides > 0 {
+		_, total = d.Roll(w.DiceSides, w.DiceCount, w.Bonus)
+	}
+	for _, x := range w.Extra {
+		if x.DiceCount > 0 && x.DiceSides > 0 {
+			_, extra := d.Roll(x.DiceSides, x.DiceCount, 0)
+			total += extra
+		}
+	}
+	if total < 1 {
+		total = 1
+	}
+	if w.Untrained {
+		total /= 2
+		if total < 1 {
+			total = 1
+		}
+	}
+	return total
+}
+
+// damageVerb returns a combat verb based on weapon name.
+func damageVerb(weaponName string) string {
+	name := strings.ToLower(weaponName)
+	switch {
+	case containsAny(name, "sword", "blade", "saber", "cutlass"):
+		return "slashes"
+	case containsAny(name, "dagger", "knife", "shiv", "stiletto"):
+		return "stabs"
+	case containsAny(name, "staff", "club", "mace", "hammer"):
+		return "bludgeons"
+	case containsAny(name, "spear", "pike", "lance"):
+		return "pierces"
+	default:
+		return "strikes"
+	}
+}
+
+func containsAny(s string, subs ...string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,490 @@
+// Package combat runs tick-based fights on the server. Players engage an
+// NPC, queue Actions, and every tick the Manager resolves all queued actions
+// with server-side dice, applies damage through a Backend and pushes the
+// resulting TickResult to every participant.
+//
+// All players fighting the same NPC share one Fight, so they see the same
+// hit points and the same combat log.
+package combat
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// DefaultTickInterval is the time between combat ticks.
+const DefaultTickInterval = 1500 * time.Millisecond
+
+// resultBacklog is how many recent tick results are kept per player so
+// clients that poll can catch up.
+const resultBacklog = 20
+
+var (
+	ErrNotInCombat      = errors.New("not in combat")
+	ErrAlreadyInCombat  = errors.New("already fighting another target")
+	ErrInvalidTarget    = errors.New("invalid combat target")
+	ErrTargetNotHere    = errors.New("target is not in your room")
+	ErrTargetDefeated   = errors.New("target is already defeated")
+	ErrAttackerDefeated = errors.New("you are in no shape to fight")
+	ErrInvalidAction    = errors.New("invalid combat action")
+	ErrSkillOnCooldown  = errors.New("skill is on cooldown")
+	ErrAlreadyFighting  = errors.New("npc is already fighting")
+)
+
+// ActionType defines the type of combat action.
+type ActionType string
+
+const (
+	ActionAttack ActionType = "attack"
+	ActionDefend ActionType = "defend"
+	ActionSkill  ActionType = "skill"
+	ActionFlee   ActionType = "flee"
+	ActionWait   ActionType = "wait"
+)
+
+// Valid reports whether t is a known action type.
+func (t ActionType) Valid() bool {
+	switch t {
+	case ActionAttack, ActionDefend, ActionSkill, ActionFlee, ActionWait:
+		return true
+	}
+	return false
+}
+
+// Action is what a player will do on the next tick. Players who have not
+// queued anything auto-attack.
+type Action struct {
+	Type    ActionType `json:"action"`
+	SkillID int        `json:"skill_id,omitempty"`
+}
+
+// Weapon describes how a combatant deals damage. A weapon with no dice deals
+// a flat Bonus.
+type Weapon struct {
+	Name      string
+	DiceCount int
+	DiceSides int
+	Bonus     int
+	Untrained bool
+	// Extra is bonus damage dice from the weapon's affixes.
+	Extra []ExtraDamage
+}
+
+// ExtraDamage is extra damage dice of one type, e.g. 1d4 fire.
+type ExtraDamage struct {
+	Type      string
+	DiceCount int
+	DiceSides int
+}
+
+// Combatant is a character's combat stats, loaded by the Backend when it
+// joins a fight. HP is kept in sync with the values the Backend returns.
+type Combatant struct {
+	ID            int
+	Name          string
+	Level         int
+	HP            int
+	MaxHP         int
+	AC            int
+	ToHit         int
+	IsNPC         bool
+	RoomID        int
+	NPCTemplateID string
+	NPCSkill      string
+	// FleeBelow is the percent of max HP under which an NPC tries to
+	// flee instead of attacking (0 = fights to the death).
+	FleeBelow int
+	MainHand  Weapon
+	OffHand   *Weapon
+}
+
+// Skill is a player ability as resolved in combat. Effect values are already
+// scaled by the caster's stats.
+type Skill struct {
+	ID       int
+	Name     string
+	Cooldown int
+	Effects  []SkillEffect
+}
+
+// SkillEffect is a single effect of a Skill.
+type SkillEffect struct {
+	Type     string
+	Target   string
+	Value    int
+	Duration int
+}
+
+// Backend persists the consequences of a fight.
+type Backend interface {
+	// Load returns the combat stats for a character.
+	Load(ctx context.Context, id int) (*Combatant, error)
+	// ApplyDamage damages targetID and returns its remaining HP.
+	ApplyDamage(ctx context.Context, attackerID, targetID, damage int) (hp int, defeated bool, err error)
+	// Heal restores HP and returns the new value.
+	Heal(ctx context.Context, id, amount int) (hp int, err error)
+	// UseSkill checks that charID has skillID equipped, pays its costs and
+	// returns it.
+	UseSkill(ctx context.Context, charID, skillID int) (*Skill, error)
+	// Flee moves a fleeing NPC out of its room and returns the exit it
+	// took, or "" if there was nowhere to go.
+	Flee(ctx context.Context, npcID int) (exit string, err error)
+}
+
+// Status is a combatant's health as reported to clients.
+type Status struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	HP     int    `json:"hp"`
+	MaxHP  int    `json:"max_hp"`
+	Threat int    `json:"threat,omitempty"` // players only
+}
+
+// TickResult is what happened in one fight on one tick.
+type TickResult struct {
+	FightID  int      `json:"fight_id"`
+	Tick     int      `json:"tick"`
+	Lines    []string `json:"lines"`
+	NPC      Status   `json:"npc"`
+	Players  []Status `json:"players"`
+	Helpers  []Status `json:"helpers,omitempty"` // NPCs assisting the one being fought
+	Fled     []int    `json:"fled,omitempty"`
+	NPCFled  bool     `json:"npc_fled,omitempty"`
+	Died     []int    `json:"died,omitempty"`
+	TargetID int      `json:"target_id,omitempty"` // the NPC's current target
+	RoomID   int      `json:"room_id,omitempty"`   // where the fight is taking place
+	KillerID int      `json:"killer_id,omitempty"`
+	Ended    bool     `json:"ended"`
+}
+
+// View is the current state of a player's fight.
+type View struct {
+	FightID  int        `json:"fight_id"`
+	Tick     int        `json:"tick"`
+	NPC      Status     `json:"npc"`
+	Players  []Status   `json:"players"`
+	TargetID int        `json:"target_id,omitempty"`
+	Queued   ActionType `json:"queued,omitempty"`
+}
+
+// Notifier is called after every tick with the players the result concerns.
+type Notifier func(characterIDs []int, result TickResult)
+
+// Manager owns every active fight.
+type Manager struct {
+	mu       sync.Mutex
+	backend  Backend
+	dice     *Dice
+	logger   *slog.Logger
+	tick     int
+	nextID   int
+	byNPC    map[int]*Fight
+	byHelper map[int]*Fight
+	byPlayer map[int]*Fight
+	recent   map[int][]TickResult
+	notify   Notifier
+	stop     chan struct{}
+}
+
+// NewManager creates a Manager that persists through backend.
+func NewManager(backend Backend, dice *Dice, logger *slog.Logger) *Manager {
+	if dice == nil {
+		dice = NewDice()
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Manager{
+		backend:  backend,
+		dice:     dice,
+		logger:   logger,
+		nextID:   1,
+		byNPC:    make(map[int]*Fight),
+		byHelper: make(map[int]*Fight),
+		byPlayer: make(map[int]*Fight),
+		recent:   make(map[int][]TickResult),
+	}
+}
+
+// SetNotifier registers the function that pushes tick results to clients.
+func (m *Manager) SetNotifier(fn Notifier) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.notify = fn
+}
+
+// Start runs the tick loop in the background until Stop is called.
+func (m *Manager) Start(interval time.Duration) {
+	m.mu.Lock()
+	if m.stop != nil {
+		m.mu.Unlock()
+		return
+	}
+	m.stop = make(chan struct{})
+	stop := m.stop
+	m.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.Tick(context.Background())
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts the tick loop.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stop != nil {
+		close(m.stop)
+		m.stop = nil
+	}
+}
+
+// Engage puts charID into a fight with targetID, joining the fight already
+// in progress against that NPC, or the one it is assisting, if there is one.
+func (m *Manager) Engage(ctx context.Context, charID, targetID int) (*View, error) {
+	if charID == targetID {
+		return nil, ErrInvalidTarget
+	}
+
+	m.mu.Lock()
+	if f, ok := m.byPlayer[charID]; ok {
+		tick := m.tick
+		m.mu.Unlock()
+		if !f.involves(targetID) {
+			return nil, ErrAlreadyInCombat
+		}
+		return f.view(charID, tick), nil
+	}
+	m.mu.Unlock()
+
+	player, err := m.backend.Load(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	if player.IsNPC {
+		return nil, ErrInvalidTarget
+	}
+	if player.HP <= 0 {
+		return nil, ErrAttackerDefeated
+	}
+	npc, err := m.backend.Load(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if !npc.IsNPC {
+		return nil, ErrInvalidTarget
+	}
+	if npc.RoomID != player.RoomID {
+		return nil, ErrTargetNotHere
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.byPlayer[charID]; ok {
+		return nil, ErrAlreadyInCombat
+	}
+	f, ok := m.byNPC[targetID]
+	if !ok {
+		f, ok = m.byHelper[targetID]
+	}
+	if !ok {
+		if npc.HP <= 0 {
+			return nil, ErrTargetDefeated
+		}
+		f = newFight(m.nextID, npc)
+		m.nextID++
+		m.byNPC[targetID] = f
+	}
+	if !f.join(player) {
+		return nil, ErrTargetDefeated
+	}
+	m.byPlayer[charID] = f
+	m.logger.Info("combat engaged",
+		slog.String("service", "combat"),
+		slog.Int("fight_id", f.id),
+		slog.Int("character_id", charID),
+		slog.Int("npc_id", targetID))
+	return f.view(charID, m.tick), nil
+}
+
+// Assist brings helperID into the fight against allyID. The helper must be
+// a living NPC in the same room that is not already fighting.
+func (m *Manager) Assist(ctx context.Context, helperID, allyID int) error {
+	m.mu.Lock()
+	f, ok := m.byNPC[allyID]
+	busy := m.byNPC[helperID] != nil || m.byHelper[helperID] != nil
+	m.mu.Unlock()
+	if !ok {
+		return ErrNotInCombat
+	}
+	if busy {
+		return ErrAlreadyFighting
+	}
+
+	helper, err := m.backend.Load(ctx, helperID)
+	if err != nil {
+		return err
+	}
+	if !helper.IsNPC || helperID == allyID {
+		return ErrInvalidTarget
+	}
+	if helper.HP <= 0 {
+		return ErrAttackerDefeated
+	}
+	if helper.RoomID != f.roomID() {
+		return ErrTargetNotHere
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.byNPC[helperID] != nil || m.byHelper[helperID] != nil {
+		return ErrAlreadyFighting
+	}
+	if m.byNPC[allyID] != f || !f.addHelper(helper) {
+		return ErrNotInCombat
+	}
+	m.byHelper[helperID] = f
+	m.logger.Info("combat assist",
+		slog.String("service", "combat"),
+		slog.Int("fight_id", f.id),
+		slog.Int("npc_id", helperID),
+		slog.Int("ally_id", allyID))
+	return nil
+}
+
+// Fighting reports whether the NPC npcID is being fought or is helping an
+// ally that is.
+func (m *Manager) Fighting(npcID int) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.byNPC[npcID] != nil || m.byHelper[npcID] != nil
+}
+
+// Queue sets the action charID takes on the next tick, replacing any
+// action already queued.
+func (m *Manager) Queue(charID int, action Action) error {
+	if !action.Type.Valid() {
+		return ErrInvalidAction
+	}
+	if action.Type == ActionSkill && action.SkillID <= 0 {
+		return ErrInvalidAction
+	}
+	m.mu.Lock()
+	f, ok := m.byPlayer[charID]
+	m.mu.Unlock()
+	if !ok {
+		return ErrNotInCombat
+	}
+	return f.queue(charID, action)
+}
+
+// View returns the fight charID is in.
+func (m *Manager) View(charID int) (*View, bool) {
+	m.mu.Lock()
+	f, ok := m.byPlayer[charID]
+	tick := m.tick
+	m.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	return f.view(charID, tick), true
+}
+
+// InCombat reports whether charID is in a fight.
+func (m *Manager) InCombat(charID int) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.byPlayer[charID]
+	return ok
+}
+
+// Results returns the recent tick results delivered to charID that are
+// newer than since, oldest first.
+func (m *Manager) Results(charID, since int) []TickResult {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := []TickResult{}
+	for _, r := range m.recent[charID] {
+		if r.Tick > since {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// Tick resolves one round of every active fight.
+func (m *Manager) Tick(ctx context.Context) {
+	m.mu.Lock()
+	m.tick++
+	tick := m.tick
+	fights := make([]*Fight, 0, len(m.byNPC))
+	leads := make([]int, 0, len(m.byNPC))
+	for id, f := range m.byNPC {
+		fights = append(fights, f)
+		leads = append(leads, id)
+	}
+	m.mu.Unlock()
+
+	type delivery struct {
+		to     []int
+		result TickResult
+	}
+	var out []delivery
+	for i, f := range fights {
+		to := f.playerIDs()
+		result := f.resolve(ctx, tick, m.backend, m.dice, m.logger)
+
+		m.mu.Lock()
+		if result.NPC.ID != leads[i] {
+			// A helper took over from an NPC that died or fled.
+			delete(m.byNPC, leads[i])
+			delete(m.byHelper, result.NPC.ID)
+			m.byNPC[result.NPC.ID] = f
+		}
+		for _, id := range append(result.Fled, result.Died...) {
+			delete(m.byPlayer, id)
+		}
+		if result.Ended {
+			for _, id := range to {
+				if m.byPlayer[id] == f {
+					delete(m.byPlayer, id)
+				}
+			}
+			delete(m.byNPC, result.NPC.ID)
+			for _, h := range result.Helpers {
+				delete(m.byHelper, h.ID)
+			}
+		}
+		for _, id := range to {
+			backlog := append(m.recent[id], result)
+			if len(backlog) > resultBacklog {
+				backlog = backlog[len(backlog)-resultBacklog:]
+			}
+			m.recent[id] = backlog
+		}
+		m.mu.Unlock()
+		out = append(out, delivery{to: to, result: result})
+	}
+
+	m.mu.Lock()
+	notify := m.notify
+	m.mu.Unlock()
+	if notify == nil {
+		return
+	}
+	for _, d := range out {
+		notify(d.to, d.result)
+	}
+}
@@ -0,0 +1,61 @@
+package combat
+
+const (
+	// joinThreat seeds a player's threat when they enter a fight so the
+	// NPC has someone to attack before any damage lands.
+	joinThreat = 1
+	// healThreatRatio is the threat generated per point healed.
+	healThreatRatio = 0.5
+	// targetSwitchRatio is how far a player must out-threat the NPC's
+	// current target before it turns on them. It stops the NPC bouncing
+	// between players whose threat is nearly equal.
+	targetSwitchRatio = 1.1
+)
+
+// threatTable tracks how much each player has angered the NPC.
+type threatTable map[int]int
+
+func (t threatTable) add(charID, amount int) {
+	if amount > 0 {
+		t[charID] += amount
+	}
+}
+
+// addHeal records threat for healing. Any heal generates at least 1.
+func (t threatTable) addHeal(charID, amount int) {
+	if amount <= 0 {
+		return
+	}
+	threat := int(float64(amount) * healThreatRatio)
+	if threat < 1 {
+		threat = 1
+	}
+	t[charID] += threat
+}
+
+// pickTarget chooses who the NPC attacks this tick: the player with the
+// most threat, unless the current target is still within
+// targetSwitchRatio of them. Ties go to whoever joined first. It reports
+// whether the NPC switched away from a previous target.
+func (f *Fight) pickTarget() (target *Combatant, switched bool) {
+	var top *Combatant
+	for _, p := range f.players {
+		if top == nil || f.threat[p.ID] > f.threat[top.ID] {
+			top = p
+		}
+	}
+	if top == nil {
+		return nil, false
+	}
+
+	current := f.player(f.target)
+	if current == nil {
+		f.target = top.ID
+		return top, false
+	}
+	if top.ID != current.ID && float64(f.threat[top.ID]) > float64(f.threat[current.ID])*targetSwitchRatio {
+		f.target = top.ID
+		return top, true
+	}
+	return current, false
+}
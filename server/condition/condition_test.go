@@ -0,0 +1,125 @@
+package condition
+
+import (
+	"testing"
+)
+
+func testEnv() Env {
+	return Env{
+		"source": map[string]interface{}{
+			"level":   3,
+			"class":   "chef",
+			"tags":    []string{"newbie"},
+			"effects": []string{"poisoned"},
+		},
+		"target": map[string]interface{}{
+			"level": 7,
+			"hp":    12,
+		},
+		"room": map[string]interface{}{
+			"id":   42,
+			"tags": []string{"graveyard", "outdoors"},
+		},
+		"event": map[string]interface{}{
+			"damage": 15.0,
+			"slot":   "main_hand",
+		},
+	}
+}
+
+func TestEval(t *testing.T) {
+	cases := []struct {
+		expr string
+		want bool
+	}{
+		{"", true},
+		{`target.level >= 5 && room.has_tag("graveyard")`, true},
+		{`target.level >= 5 and room.has_tag("crypt")`, false},
+		{`source.has_effect("poisoned") || false`, true},
+		{`not source.has_tag("veteran")`, true},
+		{`source.class == 'chef'`, true},
+		{`event.damage > target.hp`, true},
+		{`event.damage - 10 < 5 * 2`, true},
+		{`npc.level > 1`, false}, // missing root reads as null
+		{`npc == null`, true},
+		{`len(room.tags) == 2`, true},
+		{`contains(event.slot, "hand")`, true},
+		{`room.tags[1] == "outdoors"`, true},
+		{`(source.level + 2) % 2 == 1`, true},
+		{`-source.level < 0`, true},
+	}
+	env := testEnv()
+	for _, tc := range cases {
+		got, err := Eval(tc.expr, env)
+		if err != nil {
+			t.Errorf("Eval(%q) returned error: %v", tc.expr, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Eval(%q) = %v, want %v", tc.expr, got, tc.want)
+		}
+	}
+}
+
+func TestValidateRejectsBadSyntax(t *testing.T) {
+	bad := []string{
+		`target.level >=`,
+		`target.level >= 5 &&`,
+		`(target.level`,
+		`room.has_tag("graveyard"`,
+		`"unterminated`,
+		`target.level = 5`,
+		`player.level > 1`,       // unknown root
+		`exec("rm -rf /")`,       // unknown function
+		`room.delete()`,          // unknown method
+		`room.has_tag("a", "b")`, // wrong arity
+		`target.level # 5`,
+	}
+	for _, expr := range bad {
+		if err := Validate(expr); err == nil {
+			t.Errorf("Validate(%q) = nil, want error", expr)
+		}
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	bad := []string{
+		`target.level / 0 > 1`,
+		`source.class > 3`,
+		`-source.class`,
+	}
+	env := testEnv()
+	for _, expr := range bad {
+		if _, err := Eval(expr, env); err == nil {
+			t.Errorf("Eval(%q) returned nil error", expr)
+		}
+	}
+}
+
+func TestNestingLimit(t *testing.T) {
+	expr := ""
+	for i := 0; i < maxDepth+5; i++ {
+		expr += "("
+	}
+	expr += "true"
+	for i := 0; i < maxDepth+5; i++ {
+		expr += ")"
+	}
+	if err := Validate(expr); err == nil {
+		t.Error("expected deeply nested expression to be rejected")
+	}
+}
+
+func TestIndexOutOfRange(t *testing.T) {
+	for _, idx := range []string{"2", "-1", "0.5", "9223372036854775808", "100000000000000000000000000000"} {
+		expr := "room.tags[" + idx + "] == null"
+		if err := Validate(expr); err != nil {
+			t.Errorf("Validate(%q) returned error: %v", expr, err)
+			continue
+		}
+		got, err := Eval(expr, testEnv())
+		if err != nil || !got {
+			t.Errorf("Eval(%q) = %v, %v; want true", expr, got, err)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+package condition
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Env supplies the values for the root identifiers (see Roots). Objects are
+// map[string]interface{}; lists are []interface{} or []string; numbers may be
+// any Go int or float type.
+type Env map[string]interface{}
+
+// ErrDivideByZero is returned when an expression divides by zero.
+var ErrDivideByZero = errors.New("condition: division by zero")
+
+// builtin is a function callable from an expression as name(args...).
+type builtin struct {
+	arity int
+	fn    func(args []interface{}) (interface{}, error)
+}
+
+// method is callable on an object as obj.name(args...).
+type method struct {
+	arity int
+	fn    func(recv interface{}, args []interface{}) (interface{}, error)
+}
+
+var functions = map[string]builtin{
+	"len": {1, func(args []interface{}) (interface{}, error) {
+		switch v := args[0].(type) {
+		case string:
+			return float64(len(v)), nil
+		case nil:
+			return float64(0), nil
+		}
+		if list, ok := asList(args[0]); ok {
+			return float64(len(list)), nil
+		}
+		if obj, ok := args[0].(map[string]interface{}); ok {
+			return float64(len(obj)), nil
+		}
+		return nil, fmt.Errorf("condition: len of %s", typeName(args[0]))
+	}},
+	"contains": {2, func(args []interface{}) (interface{}, error) {
+		if s, ok := args[0].(string); ok {
+			sub, ok := args[1].(string)
+			return ok && strings.Contains(s, sub), nil
+		}
+		list, _ := asList(args[0])
+		return listContains(list, args[1]), nil
+	}},
+	"lower": {1, func(args []interface{}) (interface{}, error) {
+		s, _ := args[0].(string)
+		return strings.ToLower(s), nil
+	}},
+}
+
+var methods = map[string]method{
+	// obj.has_tag("x") checks obj.tags
+	"has_tag": {1, func(recv interface{}, args []interface{}) (interface{}, error) {
+		return listContains(fieldList(recv, "tags"), args[0]), nil
+	}},
+	// obj.has_effect("x") checks obj.effects (active effect names)
+	"has_effect": {1, func(recv interface{}, args []interface{}) (interface{}, error) {
+		return listContains(fieldList(recv, "effects"), args[0]), nil
+	}},
+}
+
+// Eval evaluates the expression against env and reports whether it holds.
+// The result is the truthiness of the final value (see truthy).
+func (e *Expr) Eval(env Env) (bool, error) {
+	v, err := eval(e.root, env)
+	if err != nil {
+		return false, err
+	}
+	return truthy(v), nil
+}
+
+// Eval compiles and evaluates src in one step. An empty src is always true.
+func Eval(src string, env Env) (bool, error) {
+	expr, err := Compile(src)
+	if err != nil {
+		return false, err
+	}
+	return expr.Eval(env)
+}
+
+func eval(n node, env Env) (interface{}, error) {
+	switch n := n.(type) {
+	case literalNode:
+		return n.val, nil
+
+	case identNode:
+		return normalize(env[n.name]), nil
+
+	case memberNode:
+		obj, err := eval(n.obj, env)
+		if err != nil {
+			return nil, err
+		}
+		// Missing objects and fields read as null rather than failing, so
+		// "target.level > 5" is simply false when there is no target.
+		if m, ok := obj.(map[string]interface{}); ok {
+			return normalize(m[n.name]), nil
+		}
+		return nil, nil
+
+	case indexNode:
+		obj, err := eval(n.obj, env)
+		if err != nil {
+			return nil, err
+		}
+		idx, err := eval(n.index, env)
+		if err != nil {
+			return nil, err
+		}
+		if m, ok := obj.(map[string]interface{}); ok {
+			key, _ := idx.(string)
+			return normalize(m[key]), nil
+		}
+		if list, ok := asList(obj); ok {
+			// Check the float before converting: a huge index would
+			// overflow int and slip past the bounds check.
+			i, ok := idx.(float64)
+			if !ok || i < 0 || i >= float64(len(list)) || i != math.Trunc(i) {
+				return nil, nil
+			}
+			return normalize(list[int(i)]), nil
+		}
+		return nil, nil
+
+	case callNode:
+		args := make([]interface{}, len(n.args))
+		for i, a := range n.args {
+			v, err := eval(a, env)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+		if n.recv != nil {
+			recv, err := eval(n.recv, env)
+			if err != nil {
+				return nil, err
+			}
+			return methods[n.name].fn(recv, args)
+		}
+		return functions[n.name].fn(args)
+
+	case unaryNode:
+		v, err := eval(n.operand, env)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "!" {
+			return !truthy(v), nil
+		}
+		f, ok := v.(float64)
+		if !ok {
+			return nil, fmt.Errorf("condition: cannot negate %s", typeName(v))
+		}
+		return -f, nil
+
+	case binaryNode:
+		left, err := eval(n.left, env)
+		if err != nil {
+			return nil, err
+		}
+		// Logical operators short-circuit.
+		switch n.op {
+		case "&&":
+			if !truthy(left) {
+				return false, nil
+			}
+			right, err := eval(n.right, env)
+			if err != nil {
+				return nil, err
+			}
+			return truthy(right), nil
+		case "||":
+			if truthy(left) {
+				return true, nil
+			}
+			right, err := eval(n.right, env)
+			if err != nil {
+				return nil, err
+			}
+			return truthy(right), nil
+		}
+		right, err := eval(n.right, env)
+		if err != nil {
+			return nil, err
+		}
+		return binary(n.op, left, right)
+	}
+	return nil, fmt.Errorf("condition: unknown node %T", n)
+}
+
+func binary(op string, left, right interface{}) (interface{}, error) {
+	switch op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	}
+
+	if op == "+" {
+		if ls, ok := left.(string); ok {
+			if rs, ok := right.(string); ok {
+				return ls + rs, nil
+			}
+		}
+	}
+
+	// Ordering against null (e.g. a missing field) is false, not an error.
+	if left == nil || right == nil {
+		switch op {
+		case "<", "<=", ">", ">=":
+			return false, nil
+		}
+	}
+
+	if ls, ok := left.(string); ok {
+		if rs, ok := right.(string); ok {
+			switch op {
+			case "<":
+				return ls < rs, nil
+			case "<=":
+				return ls <= rs, nil
+			case ">":
+				return ls > rs, nil
+			case ">=":
+				return ls >= rs, nil
+			}
+		}
+	}
+
+	l, lok := left.(float64)
+	r, rok := right.(float64)
+	if !lok || !rok {
+		return nil, fmt.Errorf("condition: cannot apply %q to %s and %s", op, typeName(left), typeName(right))
+	}
+	switch op {
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	case ">=":
+		return l >= r, nil
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, ErrDivideByZero
+		}
+		return l / r, nil
+	case "%":
+		if r == 0 {
+			return nil, ErrDivideByZero
+		}
+		return math.Mod(l, r), nil
+	}
+	return nil, fmt.Errorf("condition: unknown operator %q", op)
+}
+
+// normalize converts Go numeric types to float64 so comparisons are uniform.
+func normalize(v interface{}) interface{} {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case float32:
+		return float64(n)
+	}
+	return v
+}
+
+// truthy: null, false, 0 and "" are false; everything else is true.
+func truthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	}
+	return true
+}
+
+func equal(a, b interface{}) bool {
+	a, b = normalize(a), normalize(b)
+	switch a.(type) {
+	case nil, bool, float64, string:
+		return a == b
+	}
+	return false
+}
+
+func asList(v interface{}) ([]interface{}, bool) {
+	switch l := v.(type) {
+	case []interface{}:
+		return l, true
+	case []string:
+		out := make([]interface{}, len(l))
+		for i, s := range l {
+			out[i] = s
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+func fieldList(obj interface{}, field string) []interface{} {
+	m, ok := obj.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	list, _ := asList(m[field])
+	return list
+}
+
+func listContains(list []interface{}, v interface{}) bool {
+	for _, item := range list {
+		if equal(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case map[string]interface{}:
+		return "object"
+	}
+	if _, ok := asList(v); ok {
+		return "list"
+	}
+	return fmt.Sprintf("%T", v)
+}
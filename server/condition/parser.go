@@ -0,0 +1,337 @@
+package condition
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxLength is the longest condition source accepted by Compile.
+const MaxLength = 1024
+
+// maxDepth bounds parser recursion so deeply nested input can't blow the stack.
+const maxDepth = 32
+
+// Roots are the identifiers an expression may start from.
+var Roots = map[string]bool{
+	"source": true, // the character the event originated from
+	"target": true, // the character the effect would land on
+	"room":   true, // the room the event happened in
+	"npc":    true, // the NPC template the hook belongs to
+	"event":  true, // the event's extras
+	"dialog": true, // what the NPC remembers about the source (dialog state)
+}
+
+// SyntaxError reports a problem found while compiling an expression.
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("condition syntax error at %d: %s", e.Pos, e.Msg)
+}
+
+// node is a parsed expression.
+type node interface{}
+
+type (
+	literalNode struct{ val interface{} }
+	identNode   struct{ name string }
+	memberNode  struct {
+		obj  node
+		name string
+	}
+	indexNode struct{ obj, index node }
+	callNode  struct {
+		recv node // nil for plain function calls
+		name string
+		args []node
+		pos  int
+	}
+	unaryNode struct {
+		op      string
+		operand node
+	}
+	binaryNode struct {
+		op          string
+		left, right node
+	}
+)
+
+// Expr is a compiled condition.
+type Expr struct {
+	src  string
+	root node
+}
+
+// String returns the source the expression was compiled from.
+func (e *Expr) String() string { return e.src }
+
+// Compile parses src and checks that it only references known roots,
+// functions and methods. An empty src compiles to an expression that is
+// always true.
+func Compile(src string) (*Expr, error) {
+	if len(src) > MaxLength {
+		return nil, &SyntaxError{Pos: MaxLength, Msg: fmt.Sprintf("condition longer than %d characters", MaxLength)}
+	}
+	if strings.TrimSpace(src) == "" {
+		return &Expr{src: src, root: literalNode{val: true}}, nil
+	}
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+// Validate reports whether src is a valid condition. Empty is valid.
+func Validate(src string) error {
+	_, err := Compile(src)
+	return err
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOp(ops ...string) bool {
+	t := p.peek()
+	if t.kind != tokOp {
+		return false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	t := p.next()
+	if t.kind != tokOp || t.text != op {
+		if t.kind == tokEOF {
+			return &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("expected %q, got end of input", op)}
+		}
+		return &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("expected %q, got %q", op, t.text)}
+	}
+	return nil
+}
+
+func (p *parser) parseExpr() (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxDepth {
+		return nil, &SyntaxError{Pos: p.peek().pos, Msg: "expression nested too deeply"}
+	}
+	return p.parseOr()
+}
+
+// binaryLevel parses a left-associative chain of ops over sub-expressions.
+func (p *parser) binaryLevel(sub func() (node, error), ops ...string) (node, error) {
+	left, err := sub()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp(ops...) {
+		op := p.next().text
+		right, err := sub()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseOr() (node, error)  { return p.binaryLevel(p.parseAnd, "||") }
+func (p *parser) parseAnd() (node, error) { return p.binaryLevel(p.parseNot, "&&") }
+
+func (p *parser) parseNot() (node, error) {
+	if p.isOp("!") {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: "!", operand: operand}, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *parser) parseCompare() (node, error) {
+	left, err := p.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp("==", "!=", "<", "<=", ">", ">=") {
+		op := p.next().text
+		right, err := p.parseAdd()
+		if err != nil {
+			return nil, err
+		}
+		return binaryNode{op: op, left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) parseAdd() (node, error) { return p.binaryLevel(p.parseMul, "+", "-") }
+func (p *parser) parseMul() (node, error) { return p.binaryLevel(p.parseUnary, "*", "/", "%") }
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOp("-") {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: "-", operand: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.isOp("."):
+			p.next()
+			t := p.next()
+			if t.kind != tokIdent {
+				return nil, &SyntaxError{Pos: t.pos, Msg: "expected field name after '.'"}
+			}
+			if p.isOp("(") {
+				m, ok := methods[t.text]
+				if !ok {
+					return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unknown method %q", t.text)}
+				}
+				args, err := p.parseArgs()
+				if err != nil {
+					return nil, err
+				}
+				if len(args) != m.arity {
+					return nil, arityError(t, m.arity, len(args))
+				}
+				n = callNode{recv: n, name: t.text, args: args, pos: t.pos}
+			} else {
+				n = memberNode{obj: n, name: t.text}
+			}
+		case p.isOp("["):
+			p.next()
+			idx, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			n = indexNode{obj: n, index: idx}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) parseArgs() ([]node, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []node
+	if p.isOp(")") {
+		p.next()
+		return args, nil
+	}
+	for {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.isOp(",") {
+			p.next()
+			continue
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return args, nil
+	}
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNumber:
+		return literalNode{val: t.num}, nil
+	case tokString:
+		return literalNode{val: t.text}, nil
+	case tokIdent:
+		switch t.text {
+		case "true":
+			return literalNode{val: true}, nil
+		case "false":
+			return literalNode{val: false}, nil
+		case "null", "nil":
+			return literalNode{val: nil}, nil
+		}
+		if p.isOp("(") {
+			fn, ok := functions[t.text]
+			if !ok {
+				return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unknown function %q", t.text)}
+			}
+			args, err := p.parseArgs()
+			if err != nil {
+				return nil, err
+			}
+			if len(args) != fn.arity {
+				return nil, arityError(t, fn.arity, len(args))
+			}
+			return callNode{name: t.text, args: args, pos: t.pos}, nil
+		}
+		if !Roots[t.text] {
+			return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unknown identifier %q", t.text)}
+		}
+		return identNode{name: t.text}, nil
+	case tokOp:
+		if t.text == "(" {
+			n, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return n, nil
+		}
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+	default:
+		return nil, &SyntaxError{Pos: t.pos, Msg: "unexpected end of input"}
+	}
+}
+
+func arityError(t token, want, got int) error {
+	return &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("%s takes %d argument(s), got %d", t.text, want, got)}
+}
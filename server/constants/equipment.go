@@ -16,4 +16,24 @@ func IsValidSlot(slot string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+// RarityCatalog lists item rarities from most to least common.
+var RarityCatalog = []string{"common", "uncommon", "rare", "epic", "legendary"}
+
+// rarityValueMultipliers scales an item's base value by rarity.
+var rarityValueMultipliers = map[string]int{
+	"common":    1,
+	"uncommon":  2,
+	"rare":      5,
+	"epic":      10,
+	"legendary": 25,
+}
+
+// RarityValueMultiplier returns the value multiplier for a rarity.
+// Unknown or empty rarities are treated as common.
+func RarityValueMultiplier(rarity string) int {
+	if m, ok := rarityValueMultipliers[rarity]; ok {
+		return m
+	}
+	return 1
+}
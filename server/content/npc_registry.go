@@ -39,6 +39,7 @@ type NPCTemplate struct {
 	Flags          []string          `json:"flags,omitempty"`
 	Reputation     *ReputationDef    `json:"reputation,omitempty"`
 	QuestsOffered  []string          `json:"quests_offered,omitempty"`
+	Behavior       *BehaviorDef      `json:"behavior,omitempty" yaml:"behavior"`
 }
 
 // StatsDef represents NPC base stats
@@ -122,6 +123,30 @@ type ReputationDef struct {
 	Standing string `json:"standing"`
 }
 
+// BehaviorDef scripts what the NPC does on its own. It is copied onto the
+// NPC template with the same ID in the database when the server starts.
+type BehaviorDef struct {
+	Faction      string          `json:"faction,omitempty" yaml:"faction"`
+	Aggro        *AggroDef       `json:"aggro,omitempty" yaml:"aggro"`
+	FleeBelowPct int             `json:"flee_below_pct,omitempty" yaml:"flee_below_pct"`
+	AssistAllies bool            `json:"assist_allies,omitempty" yaml:"assist_allies"`
+	Schedule     []ScheduleEntry `json:"schedule,omitempty" yaml:"schedule"`
+}
+
+// AggroDef limits which players an aggressive NPC attacks
+type AggroDef struct {
+	MinLevel       int      `json:"min_level,omitempty" yaml:"min_level"`
+	MaxLevel       int      `json:"max_level,omitempty" yaml:"max_level"`
+	IgnoreFactions []string `json:"ignore_factions,omitempty" yaml:"ignore_factions"`
+}
+
+// ScheduleEntry puts the NPC in a room between two hours of the day
+type ScheduleEntry struct {
+	From   int `json:"from" yaml:"from"`
+	To     int `json:"to" yaml:"to"`
+	RoomID int `json:"room_id" yaml:"room_id"`
+}
+
 // Register adds an NPC template
 func (r *NPCRegistry) Register(npc *NPCTemplate) error {
 	r.mu.Lock()
@@ -255,6 +280,16 @@ func (r *NPCRegistry) Validate(skills *SkillRegistry, items *ItemRegistry, quest
 			}
 		}
 
+		// Validate behavior
+		for _, msg := range npc.Behavior.problems() {
+			errors = append(errors, ValidationError{
+				Type:    "npc",
+				ID:      id,
+				Field:   "behavior",
+				Message: msg,
+			})
+		}
+
 		// Validate quest references in quests_offered
 		for _, questID := range npc.QuestsOffered {
 			if _, exists := quests.Get(questID); !exists {
@@ -271,6 +306,32 @@ func (r *NPCRegistry) Validate(skills *SkillRegistry, items *ItemRegistry, quest
 	return errors
 }
 
+// problems lists what is wrong with a behavior definition
+func (b *BehaviorDef) problems() []string {
+	if b == nil {
+		return nil
+	}
+	var out []string
+	if b.FleeBelowPct < 0 || b.FleeBelowPct > 100 {
+		out = append(out, fmt.Sprintf("flee_below_pct must be 0-100, got %d", b.FleeBelowPct))
+	}
+	if b.AssistAllies && b.Faction == "" {
+		out = append(out, "assist_allies needs a faction")
+	}
+	if b.Aggro != nil && b.Aggro.MaxLevel > 0 && b.Aggro.MaxLevel < b.Aggro.MinLevel {
+		out = append(out, "aggro max_level is below min_level")
+	}
+	for i, e := range b.Schedule {
+		if e.From < 0 || e.From > 23 || e.To < 0 || e.To > 23 {
+			out = append(out, fmt.Sprintf("schedule[%d]: hours must be 0-23", i))
+		}
+		if e.RoomID <= 0 {
+			out = append(out, fmt.Sprintf("schedule[%d]: room_id is required", i))
+		}
+	}
+	return out
+}
+
 // HasFlag checks if NPC has a flag
 func (n *NPCTemplate) HasFlag(flag string) bool {
 	for _, f := range n.Flags {
@@ -7,20 +7,25 @@ import (
 
 	"herbst-server/db"
 	"herbst-server/db/equipment"
+	"herbst-server/db/room"
 )
 
 // corpseRotConfig holds the rotting configuration.
 // A corpse expires after CorpseRotMinutes minutes (nil = never rots).
 var CorpseRotMinutes *int
 
-// cleanupExpiredCorpses deletes expired corpses from the database.
+// cleanupExpiredCorpses deletes expired corpses from the database. Items
+// lying in player houses are never touched.
 // Runs every minute from the background goroutine started by startCorpseCleanup.
 func cleanupExpiredCorpses(client *db.Client) error {
 	ctx := context.Background()
 	now := time.Now()
 
 	count, err := client.Equipment.Delete().
-		Where(equipment.ExpiresAtLTE(now)).
+		Where(
+			equipment.ExpiresAtLTE(now),
+			equipment.Not(equipment.HasRoomWith(room.HouseIDNotNil())),
+		).
 		Exec(ctx)
 	if err != nil {
 		return err
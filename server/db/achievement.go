@@ -25,10 +25,33 @@ type Achievement struct {
 	// XP awarded when the achievement is unlocked
 	XpReward int `json:"xp_reward,omitempty"`
 	// JSON criteria describing how the achievement is earned
-	Criteria     string `json:"criteria,omitempty"`
+	Criteria string `json:"criteria,omitempty"`
+	// Title granted on completion, shown in who and examine
+	Title string `json:"title,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AchievementQuery when eager-loading is set.
+	Edges        AchievementEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// AchievementEdges holds the relations/edges for other nodes in the graph.
+type AchievementEdges struct {
+	// CharacterAchievements holds the value of the character_achievements edge.
+	CharacterAchievements []*CharacterAchievement `json:"character_achievements,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CharacterAchievementsOrErr returns the CharacterAchievements value or an error if the edge
+// was not loaded in eager-loading.
+func (e AchievementEdges) CharacterAchievementsOrErr() ([]*CharacterAchievement, error) {
+	if e.loadedTypes[0] {
+		return e.CharacterAchievements, nil
+	}
+	return nil, &NotLoadedError{edge: "character_achievements"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Achievement) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -36,7 +59,7 @@ func (*Achievement) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case achievement.FieldID, achievement.FieldXpReward:
 			values[i] = new(sql.NullInt64)
-		case achievement.FieldName, achievement.FieldDescription, achievement.FieldIcon, achievement.FieldCriteria:
+		case achievement.FieldName, achievement.FieldDescription, achievement.FieldIcon, achievement.FieldCriteria, achievement.FieldTitle:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -89,6 +112,12 @@ func (_m *Achievement) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Criteria = value.String
 			}
+		case achievement.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -102,6 +131,11 @@ func (_m *Achievement) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryCharacterAchievements queries the "character_achievements" edge of the Achievement entity.
+func (_m *Achievement) QueryCharacterAchievements() *CharacterAchievementQuery {
+	return NewAchievementClient(_m.config).QueryCharacterAchievements(_m)
+}
+
 // Update returns a builder for updating this Achievement.
 // Note that you need to call Achievement.Unwrap() before calling this method if this Achievement
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -139,6 +173,9 @@ func (_m *Achievement) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("criteria=")
 	builder.WriteString(_m.Criteria)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,7 @@ package achievement
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -21,8 +22,19 @@ const (
 	FieldXpReward = "xp_reward"
 	// FieldCriteria holds the string denoting the criteria field in the database.
 	FieldCriteria = "criteria"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// EdgeCharacterAchievements holds the string denoting the character_achievements edge name in mutations.
+	EdgeCharacterAchievements = "character_achievements"
 	// Table holds the table name of the achievement in the database.
 	Table = "achievements"
+	// CharacterAchievementsTable is the table that holds the character_achievements relation/edge.
+	CharacterAchievementsTable = "character_achievements"
+	// CharacterAchievementsInverseTable is the table name for the CharacterAchievement entity.
+	// It exists in this package in order to avoid circular dependency with the "characterachievement" package.
+	CharacterAchievementsInverseTable = "character_achievements"
+	// CharacterAchievementsColumn is the table column denoting the character_achievements relation/edge.
+	CharacterAchievementsColumn = "achievement_id"
 )
 
 // Columns holds all SQL columns for achievement fields.
@@ -33,6 +45,7 @@ var Columns = []string{
 	FieldIcon,
 	FieldXpReward,
 	FieldCriteria,
+	FieldTitle,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -84,3 +97,29 @@ func ByXpReward(opts ...sql.OrderTermOption) OrderOption {
 func ByCriteria(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCriteria, opts...).ToFunc()
 }
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByCharacterAchievementsCount orders the results by character_achievements count.
+func ByCharacterAchievementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCharacterAchievementsStep(), opts...)
+	}
+}
+
+// ByCharacterAchievements orders the results by character_achievements terms.
+func ByCharacterAchievements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCharacterAchievementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newCharacterAchievementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CharacterAchievementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CharacterAchievementsTable, CharacterAchievementsColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"herbst-server/db/predicate"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -78,6 +79,11 @@ func Criteria(v string) predicate.Achievement {
 	return predicate.Achievement(sql.FieldEQ(FieldCriteria, v))
 }
 
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldEQ(FieldTitle, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Achievement {
 	return predicate.Achievement(sql.FieldEQ(FieldName, v))
@@ -398,6 +404,104 @@ func CriteriaContainsFold(v string) predicate.Achievement {
 	return predicate.Achievement(sql.FieldContainsFold(FieldCriteria, v))
 }
 
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Achievement {
+	return predicate.Achievement(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Achievement {
+	return predicate.Achievement(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Achievement {
+	return predicate.Achievement(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// HasCharacterAchievements applies the HasEdge predicate on the "character_achievements" edge.
+func HasCharacterAchievements() predicate.Achievement {
+	return predicate.Achievement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CharacterAchievementsTable, CharacterAchievementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCharacterAchievementsWith applies the HasEdge predicate on the "character_achievements" edge with a given conditions (other predicates).
+func HasCharacterAchievementsWith(preds ...predicate.CharacterAchievement) predicate.Achievement {
+	return predicate.Achievement(func(s *sql.Selector) {
+		step := newCharacterAchievementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Achievement) predicate.Achievement {
 	return predicate.Achievement(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"herbst-server/db/achievement"
+	"herbst-server/db/characterachievement"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -81,6 +82,35 @@ func (_c *AchievementCreate) SetNillableCriteria(v *string) *AchievementCreate {
 	return _c
 }
 
+// SetTitle sets the "title" field.
+func (_c *AchievementCreate) SetTitle(v string) *AchievementCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *AchievementCreate) SetNillableTitle(v *string) *AchievementCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// AddCharacterAchievementIDs adds the "character_achievements" edge to the CharacterAchievement entity by IDs.
+func (_c *AchievementCreate) AddCharacterAchievementIDs(ids ...int) *AchievementCreate {
+	_c.mutation.AddCharacterAchievementIDs(ids...)
+	return _c
+}
+
+// AddCharacterAchievements adds the "character_achievements" edges to the CharacterAchievement entity.
+func (_c *AchievementCreate) AddCharacterAchievements(v ...*CharacterAchievement) *AchievementCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCharacterAchievementIDs(ids...)
+}
+
 // Mutation returns the AchievementMutation object of the builder.
 func (_c *AchievementCreate) Mutation() *AchievementMutation {
 	return _c.mutation
@@ -183,6 +213,26 @@ func (_c *AchievementCreate) createSpec() (*Achievement, *sqlgraph.CreateSpec) {
 		_spec.SetField(achievement.FieldCriteria, field.TypeString, value)
 		_node.Criteria = value
 	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(achievement.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if nodes := _c.mutation.CharacterAchievementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   achievement.CharacterAchievementsTable,
+			Columns: []string{achievement.CharacterAchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
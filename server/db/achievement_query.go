@@ -4,8 +4,10 @@ package db
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"herbst-server/db/achievement"
+	"herbst-server/db/characterachievement"
 	"herbst-server/db/predicate"
 	"math"
 
@@ -18,10 +20,11 @@ import (
 // AchievementQuery is the builder for querying Achievement entities.
 type AchievementQuery struct {
 	config
-	ctx        *QueryContext
-	order      []achievement.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Achievement
+	ctx                       *QueryContext
+	order                     []achievement.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Achievement
+	withCharacterAchievements *CharacterAchievementQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +61,28 @@ func (_q *AchievementQuery) Order(o ...achievement.OrderOption) *AchievementQuer
 	return _q
 }
 
+// QueryCharacterAchievements chains the current query on the "character_achievements" edge.
+func (_q *AchievementQuery) QueryCharacterAchievements() *CharacterAchievementQuery {
+	query := (&CharacterAchievementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(achievement.Table, achievement.FieldID, selector),
+			sqlgraph.To(characterachievement.Table, characterachievement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, achievement.CharacterAchievementsTable, achievement.CharacterAchievementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Achievement entity from the query.
 // Returns a *NotFoundError when no Achievement was found.
 func (_q *AchievementQuery) First(ctx context.Context) (*Achievement, error) {
@@ -245,17 +270,29 @@ func (_q *AchievementQuery) Clone() *AchievementQuery {
 		return nil
 	}
 	return &AchievementQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]achievement.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Achievement{}, _q.predicates...),
+		config:                    _q.config,
+		ctx:                       _q.ctx.Clone(),
+		order:                     append([]achievement.OrderOption{}, _q.order...),
+		inters:                    append([]Interceptor{}, _q.inters...),
+		predicates:                append([]predicate.Achievement{}, _q.predicates...),
+		withCharacterAchievements: _q.withCharacterAchievements.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithCharacterAchievements tells the query-builder to eager-load the nodes that are connected to
+// the "character_achievements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AchievementQuery) WithCharacterAchievements(opts ...func(*CharacterAchievementQuery)) *AchievementQuery {
+	query := (&CharacterAchievementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCharacterAchievements = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +369,11 @@ func (_q *AchievementQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *AchievementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Achievement, error) {
 	var (
-		nodes = []*Achievement{}
-		_spec = _q.querySpec()
+		nodes       = []*Achievement{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withCharacterAchievements != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Achievement).scanValues(nil, columns)
@@ -341,6 +381,7 @@ func (_q *AchievementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Achievement{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +393,49 @@ func (_q *AchievementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withCharacterAchievements; query != nil {
+		if err := _q.loadCharacterAchievements(ctx, query, nodes,
+			func(n *Achievement) { n.Edges.CharacterAchievements = []*CharacterAchievement{} },
+			func(n *Achievement, e *CharacterAchievement) {
+				n.Edges.CharacterAchievements = append(n.Edges.CharacterAchievements, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *AchievementQuery) loadCharacterAchievements(ctx context.Context, query *CharacterAchievementQuery, nodes []*Achievement, init func(*Achievement), assign func(*Achievement, *CharacterAchievement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Achievement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(characterachievement.FieldAchievementID)
+	}
+	query.Where(predicate.CharacterAchievement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(achievement.CharacterAchievementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AchievementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "achievement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *AchievementQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"herbst-server/db/achievement"
+	"herbst-server/db/characterachievement"
 	"herbst-server/db/predicate"
 
 	"entgo.io/ent/dialect/sql"
@@ -116,11 +117,67 @@ func (_u *AchievementUpdate) ClearCriteria() *AchievementUpdate {
 	return _u
 }
 
+// SetTitle sets the "title" field.
+func (_u *AchievementUpdate) SetTitle(v string) *AchievementUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *AchievementUpdate) SetNillableTitle(v *string) *AchievementUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *AchievementUpdate) ClearTitle() *AchievementUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// AddCharacterAchievementIDs adds the "character_achievements" edge to the CharacterAchievement entity by IDs.
+func (_u *AchievementUpdate) AddCharacterAchievementIDs(ids ...int) *AchievementUpdate {
+	_u.mutation.AddCharacterAchievementIDs(ids...)
+	return _u
+}
+
+// AddCharacterAchievements adds the "character_achievements" edges to the CharacterAchievement entity.
+func (_u *AchievementUpdate) AddCharacterAchievements(v ...*CharacterAchievement) *AchievementUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCharacterAchievementIDs(ids...)
+}
+
 // Mutation returns the AchievementMutation object of the builder.
 func (_u *AchievementUpdate) Mutation() *AchievementMutation {
 	return _u.mutation
 }
 
+// ClearCharacterAchievements clears all "character_achievements" edges to the CharacterAchievement entity.
+func (_u *AchievementUpdate) ClearCharacterAchievements() *AchievementUpdate {
+	_u.mutation.ClearCharacterAchievements()
+	return _u
+}
+
+// RemoveCharacterAchievementIDs removes the "character_achievements" edge to CharacterAchievement entities by IDs.
+func (_u *AchievementUpdate) RemoveCharacterAchievementIDs(ids ...int) *AchievementUpdate {
+	_u.mutation.RemoveCharacterAchievementIDs(ids...)
+	return _u
+}
+
+// RemoveCharacterAchievements removes "character_achievements" edges to CharacterAchievement entities.
+func (_u *AchievementUpdate) RemoveCharacterAchievements(v ...*CharacterAchievement) *AchievementUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCharacterAchievementIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AchievementUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -181,6 +238,57 @@ func (_u *AchievementUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.CriteriaCleared() {
 		_spec.ClearField(achievement.FieldCriteria, field.TypeString)
 	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(achievement.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(achievement.FieldTitle, field.TypeString)
+	}
+	if _u.mutation.CharacterAchievementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   achievement.CharacterAchievementsTable,
+			Columns: []string{achievement.CharacterAchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCharacterAchievementsIDs(); len(nodes) > 0 && !_u.mutation.CharacterAchievementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   achievement.CharacterAchievementsTable,
+			Columns: []string{achievement.CharacterAchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CharacterAchievementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   achievement.CharacterAchievementsTable,
+			Columns: []string{achievement.CharacterAchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{achievement.Label}
@@ -290,11 +398,67 @@ func (_u *AchievementUpdateOne) ClearCriteria() *AchievementUpdateOne {
 	return _u
 }
 
+// SetTitle sets the "title" field.
+func (_u *AchievementUpdateOne) SetTitle(v string) *AchievementUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *AchievementUpdateOne) SetNillableTitle(v *string) *AchievementUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *AchievementUpdateOne) ClearTitle() *AchievementUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// AddCharacterAchievementIDs adds the "character_achievements" edge to the CharacterAchievement entity by IDs.
+func (_u *AchievementUpdateOne) AddCharacterAchievementIDs(ids ...int) *AchievementUpdateOne {
+	_u.mutation.AddCharacterAchievementIDs(ids...)
+	return _u
+}
+
+// AddCharacterAchievements adds the "character_achievements" edges to the CharacterAchievement entity.
+func (_u *AchievementUpdateOne) AddCharacterAchievements(v ...*CharacterAchievement) *AchievementUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCharacterAchievementIDs(ids...)
+}
+
 // Mutation returns the AchievementMutation object of the builder.
 func (_u *AchievementUpdateOne) Mutation() *AchievementMutation {
 	return _u.mutation
 }
 
+// ClearCharacterAchievements clears all "character_achievements" edges to the CharacterAchievement entity.
+func (_u *AchievementUpdateOne) ClearCharacterAchievements() *AchievementUpdateOne {
+	_u.mutation.ClearCharacterAchievements()
+	return _u
+}
+
+// RemoveCharacterAchievementIDs removes the "character_achievements" edge to CharacterAchievement entities by IDs.
+func (_u *AchievementUpdateOne) RemoveCharacterAchievementIDs(ids ...int) *AchievementUpdateOne {
+	_u.mutation.RemoveCharacterAchievementIDs(ids...)
+	return _u
+}
+
+// RemoveCharacterAchievements removes "character_achievements" edges to CharacterAchievement entities.
+func (_u *AchievementUpdateOne) RemoveCharacterAchievements(v ...*CharacterAchievement) *AchievementUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCharacterAchievementIDs(ids...)
+}
+
 // Where appends a list predicates to the AchievementUpdate builder.
 func (_u *AchievementUpdateOne) Where(ps ...predicate.Achievement) *AchievementUpdateOne {
 	_u.mutation.Where(ps...)
@@ -385,6 +549,57 @@ func (_u *AchievementUpdateOne) sqlSave(ctx context.Context) (_node *Achievement
 	if _u.mutation.CriteriaCleared() {
 		_spec.ClearField(achievement.FieldCriteria, field.TypeString)
 	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(achievement.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(achievement.FieldTitle, field.TypeString)
+	}
+	if _u.mutation.CharacterAchievementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   achievement.CharacterAchievementsTable,
+			Columns: []string{achievement.CharacterAchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCharacterAchievementsIDs(); len(nodes) > 0 && !_u.mutation.CharacterAchievementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   achievement.CharacterAchievementsTable,
+			Columns: []string{achievement.CharacterAchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CharacterAchievementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   achievement.CharacterAchievementsTable,
+			Columns: []string{achievement.CharacterAchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Achievement{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
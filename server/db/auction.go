@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"herbst-server/db/auction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Auction is the model entity for the Auction schema.
+type Auction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Character who listed the item
+	SellerID int `json:"seller_id,omitempty"`
+	// Name of seller (denormalized for when seller is deleted)
+	SellerName string `json:"seller_name,omitempty"`
+	// Escrowed equipment for sale
+	ItemID int `json:"item_id,omitempty"`
+	// Name of the item (denormalized for browsing)
+	ItemName string `json:"item_name,omitempty"`
+	// Lowest first bid
+	StartBid int `json:"start_bid,omitempty"`
+	// Price that ends the auction at once; 0 for none
+	Buyout int `json:"buyout,omitempty"`
+	// Current high bid, 0 before the first bid
+	Bid int `json:"bid,omitempty"`
+	// Character holding the high bid, 0 before the first bid
+	BidderID int `json:"bidder_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status auction.Status `json:"status,omitempty"`
+	// Gold owed to the seller after sales tax, once sold
+	Proceeds int `json:"proceeds,omitempty"`
+	// ListedAt holds the value of the "listed_at" field.
+	ListedAt time.Time `json:"listed_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Auction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auction.FieldID, auction.FieldSellerID, auction.FieldItemID, auction.FieldStartBid, auction.FieldBuyout, auction.FieldBid, auction.FieldBidderID, auction.FieldProceeds:
+			values[i] = new(sql.NullInt64)
+		case auction.FieldSellerName, auction.FieldItemName, auction.FieldStatus:
+			values[i] = new(sql.NullString)
+		case auction.FieldListedAt, auction.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Auction fields.
+func (_m *Auction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case auction.FieldSellerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_id", values[i])
+			} else if value.Valid {
+				_m.SellerID = int(value.Int64)
+			}
+		case auction.FieldSellerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_name", values[i])
+			} else if value.Valid {
+				_m.SellerName = value.String
+			}
+		case auction.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				_m.ItemID = int(value.Int64)
+			}
+		case auction.FieldItemName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_name", values[i])
+			} else if value.Valid {
+				_m.ItemName = value.String
+			}
+		case auction.FieldStartBid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_bid", values[i])
+			} else if value.Valid {
+				_m.StartBid = int(value.Int64)
+			}
+		case auction.FieldBuyout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field buyout", values[i])
+			} else if value.Valid {
+				_m.Buyout = int(value.Int64)
+			}
+		case auction.FieldBid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bid", values[i])
+			} else if value.Valid {
+				_m.Bid = int(value.Int64)
+			}
+		case auction.FieldBidderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bidder_id", values[i])
+			} else if value.Valid {
+				_m.BidderID = int(value.Int64)
+			}
+		case auction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = auction.Status(value.String)
+			}
+		case auction.FieldProceeds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field proceeds", values[i])
+			} else if value.Valid {
+				_m.Proceeds = int(value.Int64)
+			}
+		case auction.FieldListedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field listed_at", values[i])
+			} else if value.Valid {
+				_m.ListedAt = value.Time
+			}
+		case auction.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Auction.
+// This includes values selected through modifiers, order, etc.
+func (_m *Auction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Auction.
+// Note that you need to call Auction.Unwrap() before calling this method if this Auction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Auction) Update() *AuctionUpdateOne {
+	return NewAuctionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Auction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Auction) Unwrap() *Auction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: Auction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Auction) String() string {
+	var builder strings.Builder
+	builder.WriteString("Auction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("seller_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SellerID))
+	builder.WriteString(", ")
+	builder.WriteString("seller_name=")
+	builder.WriteString(_m.SellerName)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("item_name=")
+	builder.WriteString(_m.ItemName)
+	builder.WriteString(", ")
+	builder.WriteString("start_bid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartBid))
+	builder.WriteString(", ")
+	builder.WriteString("buyout=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Buyout))
+	builder.WriteString(", ")
+	builder.WriteString("bid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Bid))
+	builder.WriteString(", ")
+	builder.WriteString("bidder_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BidderID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("proceeds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Proceeds))
+	builder.WriteString(", ")
+	builder.WriteString("listed_at=")
+	builder.WriteString(_m.ListedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Auctions is a parsable slice of Auction.
+type Auctions []*Auction
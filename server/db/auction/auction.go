@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auction type in the database.
+	Label = "auction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSellerID holds the string denoting the seller_id field in the database.
+	FieldSellerID = "seller_id"
+	// FieldSellerName holds the string denoting the seller_name field in the database.
+	FieldSellerName = "seller_name"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldItemName holds the string denoting the item_name field in the database.
+	FieldItemName = "item_name"
+	// FieldStartBid holds the string denoting the start_bid field in the database.
+	FieldStartBid = "start_bid"
+	// FieldBuyout holds the string denoting the buyout field in the database.
+	FieldBuyout = "buyout"
+	// FieldBid holds the string denoting the bid field in the database.
+	FieldBid = "bid"
+	// FieldBidderID holds the string denoting the bidder_id field in the database.
+	FieldBidderID = "bidder_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldProceeds holds the string denoting the proceeds field in the database.
+	FieldProceeds = "proceeds"
+	// FieldListedAt holds the string denoting the listed_at field in the database.
+	FieldListedAt = "listed_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the auction in the database.
+	Table = "auctions"
+)
+
+// Columns holds all SQL columns for auction fields.
+var Columns = []string{
+	FieldID,
+	FieldSellerID,
+	FieldSellerName,
+	FieldItemID,
+	FieldItemName,
+	FieldStartBid,
+	FieldBuyout,
+	FieldBid,
+	FieldBidderID,
+	FieldStatus,
+	FieldProceeds,
+	FieldListedAt,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultBuyout holds the default value on creation for the "buyout" field.
+	DefaultBuyout int
+	// DefaultBid holds the default value on creation for the "bid" field.
+	DefaultBid int
+	// DefaultBidderID holds the default value on creation for the "bidder_id" field.
+	DefaultBidderID int
+	// DefaultProceeds holds the default value on creation for the "proceeds" field.
+	DefaultProceeds int
+	// DefaultListedAt holds the default value on creation for the "listed_at" field.
+	DefaultListedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen Status = "open"
+	StatusSold Status = "sold"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusSold:
+		return nil
+	default:
+		return fmt.Errorf("auction: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Auction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySellerID orders the results by the seller_id field.
+func BySellerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellerID, opts...).ToFunc()
+}
+
+// BySellerName orders the results by the seller_name field.
+func BySellerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellerName, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByItemName orders the results by the item_name field.
+func ByItemName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemName, opts...).ToFunc()
+}
+
+// ByStartBid orders the results by the start_bid field.
+func ByStartBid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartBid, opts...).ToFunc()
+}
+
+// ByBuyout orders the results by the buyout field.
+func ByBuyout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyout, opts...).ToFunc()
+}
+
+// ByBid orders the results by the bid field.
+func ByBid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBid, opts...).ToFunc()
+}
+
+// ByBidderID orders the results by the bidder_id field.
+func ByBidderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBidderID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByProceeds orders the results by the proceeds field.
+func ByProceeds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProceeds, opts...).ToFunc()
+}
+
+// ByListedAt orders the results by the listed_at field.
+func ByListedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListedAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auction
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldID, id))
+}
+
+// SellerID applies equality check predicate on the "seller_id" field. It's identical to SellerIDEQ.
+func SellerID(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldSellerID, v))
+}
+
+// SellerName applies equality check predicate on the "seller_name" field. It's identical to SellerNameEQ.
+func SellerName(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldSellerName, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemName applies equality check predicate on the "item_name" field. It's identical to ItemNameEQ.
+func ItemName(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldItemName, v))
+}
+
+// StartBid applies equality check predicate on the "start_bid" field. It's identical to StartBidEQ.
+func StartBid(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldStartBid, v))
+}
+
+// Buyout applies equality check predicate on the "buyout" field. It's identical to BuyoutEQ.
+func Buyout(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldBuyout, v))
+}
+
+// Bid applies equality check predicate on the "bid" field. It's identical to BidEQ.
+func Bid(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldBid, v))
+}
+
+// BidderID applies equality check predicate on the "bidder_id" field. It's identical to BidderIDEQ.
+func BidderID(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldBidderID, v))
+}
+
+// Proceeds applies equality check predicate on the "proceeds" field. It's identical to ProceedsEQ.
+func Proceeds(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldProceeds, v))
+}
+
+// ListedAt applies equality check predicate on the "listed_at" field. It's identical to ListedAtEQ.
+func ListedAt(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldListedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// SellerIDEQ applies the EQ predicate on the "seller_id" field.
+func SellerIDEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldSellerID, v))
+}
+
+// SellerIDNEQ applies the NEQ predicate on the "seller_id" field.
+func SellerIDNEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldSellerID, v))
+}
+
+// SellerIDIn applies the In predicate on the "seller_id" field.
+func SellerIDIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldSellerID, vs...))
+}
+
+// SellerIDNotIn applies the NotIn predicate on the "seller_id" field.
+func SellerIDNotIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldSellerID, vs...))
+}
+
+// SellerIDGT applies the GT predicate on the "seller_id" field.
+func SellerIDGT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldSellerID, v))
+}
+
+// SellerIDGTE applies the GTE predicate on the "seller_id" field.
+func SellerIDGTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldSellerID, v))
+}
+
+// SellerIDLT applies the LT predicate on the "seller_id" field.
+func SellerIDLT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldSellerID, v))
+}
+
+// SellerIDLTE applies the LTE predicate on the "seller_id" field.
+func SellerIDLTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldSellerID, v))
+}
+
+// SellerNameEQ applies the EQ predicate on the "seller_name" field.
+func SellerNameEQ(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldSellerName, v))
+}
+
+// SellerNameNEQ applies the NEQ predicate on the "seller_name" field.
+func SellerNameNEQ(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldSellerName, v))
+}
+
+// SellerNameIn applies the In predicate on the "seller_name" field.
+func SellerNameIn(vs ...string) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldSellerName, vs...))
+}
+
+// SellerNameNotIn applies the NotIn predicate on the "seller_name" field.
+func SellerNameNotIn(vs ...string) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldSellerName, vs...))
+}
+
+// SellerNameGT applies the GT predicate on the "seller_name" field.
+func SellerNameGT(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldSellerName, v))
+}
+
+// SellerNameGTE applies the GTE predicate on the "seller_name" field.
+func SellerNameGTE(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldSellerName, v))
+}
+
+// SellerNameLT applies the LT predicate on the "seller_name" field.
+func SellerNameLT(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldSellerName, v))
+}
+
+// SellerNameLTE applies the LTE predicate on the "seller_name" field.
+func SellerNameLTE(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldSellerName, v))
+}
+
+// SellerNameContains applies the Contains predicate on the "seller_name" field.
+func SellerNameContains(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldContains(FieldSellerName, v))
+}
+
+// SellerNameHasPrefix applies the HasPrefix predicate on the "seller_name" field.
+func SellerNameHasPrefix(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldHasPrefix(FieldSellerName, v))
+}
+
+// SellerNameHasSuffix applies the HasSuffix predicate on the "seller_name" field.
+func SellerNameHasSuffix(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldHasSuffix(FieldSellerName, v))
+}
+
+// SellerNameEqualFold applies the EqualFold predicate on the "seller_name" field.
+func SellerNameEqualFold(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldEqualFold(FieldSellerName, v))
+}
+
+// SellerNameContainsFold applies the ContainsFold predicate on the "seller_name" field.
+func SellerNameContainsFold(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldContainsFold(FieldSellerName, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemNameEQ applies the EQ predicate on the "item_name" field.
+func ItemNameEQ(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldItemName, v))
+}
+
+// ItemNameNEQ applies the NEQ predicate on the "item_name" field.
+func ItemNameNEQ(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldItemName, v))
+}
+
+// ItemNameIn applies the In predicate on the "item_name" field.
+func ItemNameIn(vs ...string) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldItemName, vs...))
+}
+
+// ItemNameNotIn applies the NotIn predicate on the "item_name" field.
+func ItemNameNotIn(vs ...string) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldItemName, vs...))
+}
+
+// ItemNameGT applies the GT predicate on the "item_name" field.
+func ItemNameGT(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldItemName, v))
+}
+
+// ItemNameGTE applies the GTE predicate on the "item_name" field.
+func ItemNameGTE(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldItemName, v))
+}
+
+// ItemNameLT applies the LT predicate on the "item_name" field.
+func ItemNameLT(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldItemName, v))
+}
+
+// ItemNameLTE applies the LTE predicate on the "item_name" field.
+func ItemNameLTE(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldItemName, v))
+}
+
+// ItemNameContains applies the Contains predicate on the "item_name" field.
+func ItemNameContains(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldContains(FieldItemName, v))
+}
+
+// ItemNameHasPrefix applies the HasPrefix predicate on the "item_name" field.
+func ItemNameHasPrefix(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldHasPrefix(FieldItemName, v))
+}
+
+// ItemNameHasSuffix applies the HasSuffix predicate on the "item_name" field.
+func ItemNameHasSuffix(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldHasSuffix(FieldItemName, v))
+}
+
+// ItemNameEqualFold applies the EqualFold predicate on the "item_name" field.
+func ItemNameEqualFold(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldEqualFold(FieldItemName, v))
+}
+
+// ItemNameContainsFold applies the ContainsFold predicate on the "item_name" field.
+func ItemNameContainsFold(v string) predicate.Auction {
+	return predicate.Auction(sql.FieldContainsFold(FieldItemName, v))
+}
+
+// StartBidEQ applies the EQ predicate on the "start_bid" field.
+func StartBidEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldStartBid, v))
+}
+
+// StartBidNEQ applies the NEQ predicate on the "start_bid" field.
+func StartBidNEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldStartBid, v))
+}
+
+// StartBidIn applies the In predicate on the "start_bid" field.
+func StartBidIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldStartBid, vs...))
+}
+
+// StartBidNotIn applies the NotIn predicate on the "start_bid" field.
+func StartBidNotIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldStartBid, vs...))
+}
+
+// StartBidGT applies the GT predicate on the "start_bid" field.
+func StartBidGT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldStartBid, v))
+}
+
+// StartBidGTE applies the GTE predicate on the "start_bid" field.
+func StartBidGTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldStartBid, v))
+}
+
+// StartBidLT applies the LT predicate on the "start_bid" field.
+func StartBidLT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldStartBid, v))
+}
+
+// StartBidLTE applies the LTE predicate on the "start_bid" field.
+func StartBidLTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldStartBid, v))
+}
+
+// BuyoutEQ applies the EQ predicate on the "buyout" field.
+func BuyoutEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldBuyout, v))
+}
+
+// BuyoutNEQ applies the NEQ predicate on the "buyout" field.
+func BuyoutNEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldBuyout, v))
+}
+
+// BuyoutIn applies the In predicate on the "buyout" field.
+func BuyoutIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldBuyout, vs...))
+}
+
+// BuyoutNotIn applies the NotIn predicate on the "buyout" field.
+func BuyoutNotIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldBuyout, vs...))
+}
+
+// BuyoutGT applies the GT predicate on the "buyout" field.
+func BuyoutGT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldBuyout, v))
+}
+
+// BuyoutGTE applies the GTE predicate on the "buyout" field.
+func BuyoutGTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldBuyout, v))
+}
+
+// BuyoutLT applies the LT predicate on the "buyout" field.
+func BuyoutLT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldBuyout, v))
+}
+
+// BuyoutLTE applies the LTE predicate on the "buyout" field.
+func BuyoutLTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldBuyout, v))
+}
+
+// BidEQ applies the EQ predicate on the "bid" field.
+func BidEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldBid, v))
+}
+
+// BidNEQ applies the NEQ predicate on the "bid" field.
+func BidNEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldBid, v))
+}
+
+// BidIn applies the In predicate on the "bid" field.
+func BidIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldBid, vs...))
+}
+
+// BidNotIn applies the NotIn predicate on the "bid" field.
+func BidNotIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldBid, vs...))
+}
+
+// BidGT applies the GT predicate on the "bid" field.
+func BidGT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldBid, v))
+}
+
+// BidGTE applies the GTE predicate on the "bid" field.
+func BidGTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldBid, v))
+}
+
+// BidLT applies the LT predicate on the "bid" field.
+func BidLT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldBid, v))
+}
+
+// BidLTE applies the LTE predicate on the "bid" field.
+func BidLTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldBid, v))
+}
+
+// BidderIDEQ applies the EQ predicate on the "bidder_id" field.
+func BidderIDEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldBidderID, v))
+}
+
+// BidderIDNEQ applies the NEQ predicate on the "bidder_id" field.
+func BidderIDNEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldBidderID, v))
+}
+
+// BidderIDIn applies the In predicate on the "bidder_id" field.
+func BidderIDIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldBidderID, vs...))
+}
+
+// BidderIDNotIn applies the NotIn predicate on the "bidder_id" field.
+func BidderIDNotIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldBidderID, vs...))
+}
+
+// BidderIDGT applies the GT predicate on the "bidder_id" field.
+func BidderIDGT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldBidderID, v))
+}
+
+// BidderIDGTE applies the GTE predicate on the "bidder_id" field.
+func BidderIDGTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldBidderID, v))
+}
+
+// BidderIDLT applies the LT predicate on the "bidder_id" field.
+func BidderIDLT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldBidderID, v))
+}
+
+// BidderIDLTE applies the LTE predicate on the "bidder_id" field.
+func BidderIDLTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldBidderID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ProceedsEQ applies the EQ predicate on the "proceeds" field.
+func ProceedsEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldProceeds, v))
+}
+
+// ProceedsNEQ applies the NEQ predicate on the "proceeds" field.
+func ProceedsNEQ(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldProceeds, v))
+}
+
+// ProceedsIn applies the In predicate on the "proceeds" field.
+func ProceedsIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldProceeds, vs...))
+}
+
+// ProceedsNotIn applies the NotIn predicate on the "proceeds" field.
+func ProceedsNotIn(vs ...int) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldProceeds, vs...))
+}
+
+// ProceedsGT applies the GT predicate on the "proceeds" field.
+func ProceedsGT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldProceeds, v))
+}
+
+// ProceedsGTE applies the GTE predicate on the "proceeds" field.
+func ProceedsGTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldProceeds, v))
+}
+
+// ProceedsLT applies the LT predicate on the "proceeds" field.
+func ProceedsLT(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldProceeds, v))
+}
+
+// ProceedsLTE applies the LTE predicate on the "proceeds" field.
+func ProceedsLTE(v int) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldProceeds, v))
+}
+
+// ListedAtEQ applies the EQ predicate on the "listed_at" field.
+func ListedAtEQ(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldListedAt, v))
+}
+
+// ListedAtNEQ applies the NEQ predicate on the "listed_at" field.
+func ListedAtNEQ(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldListedAt, v))
+}
+
+// ListedAtIn applies the In predicate on the "listed_at" field.
+func ListedAtIn(vs ...time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldListedAt, vs...))
+}
+
+// ListedAtNotIn applies the NotIn predicate on the "listed_at" field.
+func ListedAtNotIn(vs ...time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldListedAt, vs...))
+}
+
+// ListedAtGT applies the GT predicate on the "listed_at" field.
+func ListedAtGT(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldListedAt, v))
+}
+
+// ListedAtGTE applies the GTE predicate on the "listed_at" field.
+func ListedAtGTE(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldListedAt, v))
+}
+
+// ListedAtLT applies the LT predicate on the "listed_at" field.
+func ListedAtLT(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldListedAt, v))
+}
+
+// ListedAtLTE applies the LTE predicate on the "listed_at" field.
+func ListedAtLTE(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldListedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Auction {
+	return predicate.Auction(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Auction) predicate.Auction {
+	return predicate.Auction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Auction) predicate.Auction {
+	return predicate.Auction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Auction) predicate.Auction {
+	return predicate.Auction(sql.NotPredicates(p))
+}
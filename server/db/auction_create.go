@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/auction"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuctionCreate is the builder for creating a Auction entity.
+type AuctionCreate struct {
+	config
+	mutation *AuctionMutation
+	hooks    []Hook
+}
+
+// SetSellerID sets the "seller_id" field.
+func (_c *AuctionCreate) SetSellerID(v int) *AuctionCreate {
+	_c.mutation.SetSellerID(v)
+	return _c
+}
+
+// SetSellerName sets the "seller_name" field.
+func (_c *AuctionCreate) SetSellerName(v string) *AuctionCreate {
+	_c.mutation.SetSellerName(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *AuctionCreate) SetItemID(v int) *AuctionCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetItemName sets the "item_name" field.
+func (_c *AuctionCreate) SetItemName(v string) *AuctionCreate {
+	_c.mutation.SetItemName(v)
+	return _c
+}
+
+// SetStartBid sets the "start_bid" field.
+func (_c *AuctionCreate) SetStartBid(v int) *AuctionCreate {
+	_c.mutation.SetStartBid(v)
+	return _c
+}
+
+// SetBuyout sets the "buyout" field.
+func (_c *AuctionCreate) SetBuyout(v int) *AuctionCreate {
+	_c.mutation.SetBuyout(v)
+	return _c
+}
+
+// SetNillableBuyout sets the "buyout" field if the given value is not nil.
+func (_c *AuctionCreate) SetNillableBuyout(v *int) *AuctionCreate {
+	if v != nil {
+		_c.SetBuyout(*v)
+	}
+	return _c
+}
+
+// SetBid sets the "bid" field.
+func (_c *AuctionCreate) SetBid(v int) *AuctionCreate {
+	_c.mutation.SetBid(v)
+	return _c
+}
+
+// SetNillableBid sets the "bid" field if the given value is not nil.
+func (_c *AuctionCreate) SetNillableBid(v *int) *AuctionCreate {
+	if v != nil {
+		_c.SetBid(*v)
+	}
+	return _c
+}
+
+// SetBidderID sets the "bidder_id" field.
+func (_c *AuctionCreate) SetBidderID(v int) *AuctionCreate {
+	_c.mutation.SetBidderID(v)
+	return _c
+}
+
+// SetNillableBidderID sets the "bidder_id" field if the given value is not nil.
+func (_c *AuctionCreate) SetNillableBidderID(v *int) *AuctionCreate {
+	if v != nil {
+		_c.SetBidderID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AuctionCreate) SetStatus(v auction.Status) *AuctionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *AuctionCreate) SetNillableStatus(v *auction.Status) *AuctionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetProceeds sets the "proceeds" field.
+func (_c *AuctionCreate) SetProceeds(v int) *AuctionCreate {
+	_c.mutation.SetProceeds(v)
+	return _c
+}
+
+// SetNillableProceeds sets the "proceeds" field if the given value is not nil.
+func (_c *AuctionCreate) SetNillableProceeds(v *int) *AuctionCreate {
+	if v != nil {
+		_c.SetProceeds(*v)
+	}
+	return _c
+}
+
+// SetListedAt sets the "listed_at" field.
+func (_c *AuctionCreate) SetListedAt(v time.Time) *AuctionCreate {
+	_c.mutation.SetListedAt(v)
+	return _c
+}
+
+// SetNillableListedAt sets the "listed_at" field if the given value is not nil.
+func (_c *AuctionCreate) SetNillableListedAt(v *time.Time) *AuctionCreate {
+	if v != nil {
+		_c.SetListedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AuctionCreate) SetExpiresAt(v time.Time) *AuctionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// Mutation returns the AuctionMutation object of the builder.
+func (_c *AuctionCreate) Mutation() *AuctionMutation {
+	return _c.mutation
+}
+
+// Save creates the Auction in the database.
+func (_c *AuctionCreate) Save(ctx context.Context) (*Auction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuctionCreate) SaveX(ctx context.Context) *Auction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuctionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuctionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuctionCreate) defaults() {
+	if _, ok := _c.mutation.Buyout(); !ok {
+		v := auction.DefaultBuyout
+		_c.mutation.SetBuyout(v)
+	}
+	if _, ok := _c.mutation.Bid(); !ok {
+		v := auction.DefaultBid
+		_c.mutation.SetBid(v)
+	}
+	if _, ok := _c.mutation.BidderID(); !ok {
+		v := auction.DefaultBidderID
+		_c.mutation.SetBidderID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := auction.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Proceeds(); !ok {
+		v := auction.DefaultProceeds
+		_c.mutation.SetProceeds(v)
+	}
+	if _, ok := _c.mutation.ListedAt(); !ok {
+		v := auction.DefaultListedAt()
+		_c.mutation.SetListedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuctionCreate) check() error {
+	if _, ok := _c.mutation.SellerID(); !ok {
+		return &ValidationError{Name: "seller_id", err: errors.New(`db: missing required field "Auction.seller_id"`)}
+	}
+	if _, ok := _c.mutation.SellerName(); !ok {
+		return &ValidationError{Name: "seller_name", err: errors.New(`db: missing required field "Auction.seller_name"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`db: missing required field "Auction.item_id"`)}
+	}
+	if _, ok := _c.mutation.ItemName(); !ok {
+		return &ValidationError{Name: "item_name", err: errors.New(`db: missing required field "Auction.item_name"`)}
+	}
+	if _, ok := _c.mutation.StartBid(); !ok {
+		return &ValidationError{Name: "start_bid", err: errors.New(`db: missing required field "Auction.start_bid"`)}
+	}
+	if _, ok := _c.mutation.Buyout(); !ok {
+		return &ValidationError{Name: "buyout", err: errors.New(`db: missing required field "Auction.buyout"`)}
+	}
+	if _, ok := _c.mutation.Bid(); !ok {
+		return &ValidationError{Name: "bid", err: errors.New(`db: missing required field "Auction.bid"`)}
+	}
+	if _, ok := _c.mutation.BidderID(); !ok {
+		return &ValidationError{Name: "bidder_id", err: errors.New(`db: missing required field "Auction.bidder_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "Auction.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := auction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "Auction.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Proceeds(); !ok {
+		return &ValidationError{Name: "proceeds", err: errors.New(`db: missing required field "Auction.proceeds"`)}
+	}
+	if _, ok := _c.mutation.ListedAt(); !ok {
+		return &ValidationError{Name: "listed_at", err: errors.New(`db: missing required field "Auction.listed_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`db: missing required field "Auction.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *AuctionCreate) sqlSave(ctx context.Context) (*Auction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuctionCreate) createSpec() (*Auction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Auction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auction.Table, sqlgraph.NewFieldSpec(auction.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SellerID(); ok {
+		_spec.SetField(auction.FieldSellerID, field.TypeInt, value)
+		_node.SellerID = value
+	}
+	if value, ok := _c.mutation.SellerName(); ok {
+		_spec.SetField(auction.FieldSellerName, field.TypeString, value)
+		_node.SellerName = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(auction.FieldItemID, field.TypeInt, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.ItemName(); ok {
+		_spec.SetField(auction.FieldItemName, field.TypeString, value)
+		_node.ItemName = value
+	}
+	if value, ok := _c.mutation.StartBid(); ok {
+		_spec.SetField(auction.FieldStartBid, field.TypeInt, value)
+		_node.StartBid = value
+	}
+	if value, ok := _c.mutation.Buyout(); ok {
+		_spec.SetField(auction.FieldBuyout, field.TypeInt, value)
+		_node.Buyout = value
+	}
+	if value, ok := _c.mutation.Bid(); ok {
+		_spec.SetField(auction.FieldBid, field.TypeInt, value)
+		_node.Bid = value
+	}
+	if value, ok := _c.mutation.BidderID(); ok {
+		_spec.SetField(auction.FieldBidderID, field.TypeInt, value)
+		_node.BidderID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(auction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Proceeds(); ok {
+		_spec.SetField(auction.FieldProceeds, field.TypeInt, value)
+		_node.Proceeds = value
+	}
+	if value, ok := _c.mutation.ListedAt(); ok {
+		_spec.SetField(auction.FieldListedAt, field.TypeTime, value)
+		_node.ListedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(auction.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// AuctionCreateBulk is the builder for creating many Auction entities in bulk.
+type AuctionCreateBulk struct {
+	config
+	err      error
+	builders []*AuctionCreate
+}
+
+// Save creates the Auction entities in the database.
+func (_c *AuctionCreateBulk) Save(ctx context.Context) ([]*Auction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Auction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuctionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuctionCreateBulk) SaveX(ctx context.Context) []*Auction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuctionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuctionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/auction"
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuctionUpdate is the builder for updating Auction entities.
+type AuctionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuctionMutation
+}
+
+// Where appends a list predicates to the AuctionUpdate builder.
+func (_u *AuctionUpdate) Where(ps ...predicate.Auction) *AuctionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSellerID sets the "seller_id" field.
+func (_u *AuctionUpdate) SetSellerID(v int) *AuctionUpdate {
+	_u.mutation.ResetSellerID()
+	_u.mutation.SetSellerID(v)
+	return _u
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableSellerID(v *int) *AuctionUpdate {
+	if v != nil {
+		_u.SetSellerID(*v)
+	}
+	return _u
+}
+
+// AddSellerID adds value to the "seller_id" field.
+func (_u *AuctionUpdate) AddSellerID(v int) *AuctionUpdate {
+	_u.mutation.AddSellerID(v)
+	return _u
+}
+
+// SetSellerName sets the "seller_name" field.
+func (_u *AuctionUpdate) SetSellerName(v string) *AuctionUpdate {
+	_u.mutation.SetSellerName(v)
+	return _u
+}
+
+// SetNillableSellerName sets the "seller_name" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableSellerName(v *string) *AuctionUpdate {
+	if v != nil {
+		_u.SetSellerName(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *AuctionUpdate) SetItemID(v int) *AuctionUpdate {
+	_u.mutation.ResetItemID()
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableItemID(v *int) *AuctionUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// AddItemID adds value to the "item_id" field.
+func (_u *AuctionUpdate) AddItemID(v int) *AuctionUpdate {
+	_u.mutation.AddItemID(v)
+	return _u
+}
+
+// SetItemName sets the "item_name" field.
+func (_u *AuctionUpdate) SetItemName(v string) *AuctionUpdate {
+	_u.mutation.SetItemName(v)
+	return _u
+}
+
+// SetNillableItemName sets the "item_name" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableItemName(v *string) *AuctionUpdate {
+	if v != nil {
+		_u.SetItemName(*v)
+	}
+	return _u
+}
+
+// SetStartBid sets the "start_bid" field.
+func (_u *AuctionUpdate) SetStartBid(v int) *AuctionUpdate {
+	_u.mutation.ResetStartBid()
+	_u.mutation.SetStartBid(v)
+	return _u
+}
+
+// SetNillableStartBid sets the "start_bid" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableStartBid(v *int) *AuctionUpdate {
+	if v != nil {
+		_u.SetStartBid(*v)
+	}
+	return _u
+}
+
+// AddStartBid adds value to the "start_bid" field.
+func (_u *AuctionUpdate) AddStartBid(v int) *AuctionUpdate {
+	_u.mutation.AddStartBid(v)
+	return _u
+}
+
+// SetBuyout sets the "buyout" field.
+func (_u *AuctionUpdate) SetBuyout(v int) *AuctionUpdate {
+	_u.mutation.ResetBuyout()
+	_u.mutation.SetBuyout(v)
+	return _u
+}
+
+// SetNillableBuyout sets the "buyout" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableBuyout(v *int) *AuctionUpdate {
+	if v != nil {
+		_u.SetBuyout(*v)
+	}
+	return _u
+}
+
+// AddBuyout adds value to the "buyout" field.
+func (_u *AuctionUpdate) AddBuyout(v int) *AuctionUpdate {
+	_u.mutation.AddBuyout(v)
+	return _u
+}
+
+// SetBid sets the "bid" field.
+func (_u *AuctionUpdate) SetBid(v int) *AuctionUpdate {
+	_u.mutation.ResetBid()
+	_u.mutation.SetBid(v)
+	return _u
+}
+
+// SetNillableBid sets the "bid" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableBid(v *int) *AuctionUpdate {
+	if v != nil {
+		_u.SetBid(*v)
+	}
+	return _u
+}
+
+// AddBid adds value to the "bid" field.
+func (_u *AuctionUpdate) AddBid(v int) *AuctionUpdate {
+	_u.mutation.AddBid(v)
+	return _u
+}
+
+// SetBidderID sets the "bidder_id" field.
+func (_u *AuctionUpdate) SetBidderID(v int) *AuctionUpdate {
+	_u.mutation.ResetBidderID()
+	_u.mutation.SetBidderID(v)
+	return _u
+}
+
+// SetNillableBidderID sets the "bidder_id" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableBidderID(v *int) *AuctionUpdate {
+	if v != nil {
+		_u.SetBidderID(*v)
+	}
+	return _u
+}
+
+// AddBidderID adds value to the "bidder_id" field.
+func (_u *AuctionUpdate) AddBidderID(v int) *AuctionUpdate {
+	_u.mutation.AddBidderID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AuctionUpdate) SetStatus(v auction.Status) *AuctionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableStatus(v *auction.Status) *AuctionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetProceeds sets the "proceeds" field.
+func (_u *AuctionUpdate) SetProceeds(v int) *AuctionUpdate {
+	_u.mutation.ResetProceeds()
+	_u.mutation.SetProceeds(v)
+	return _u
+}
+
+// SetNillableProceeds sets the "proceeds" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableProceeds(v *int) *AuctionUpdate {
+	if v != nil {
+		_u.SetProceeds(*v)
+	}
+	return _u
+}
+
+// AddProceeds adds value to the "proceeds" field.
+func (_u *AuctionUpdate) AddProceeds(v int) *AuctionUpdate {
+	_u.mutation.AddProceeds(v)
+	return _u
+}
+
+// SetListedAt sets the "listed_at" field.
+func (_u *AuctionUpdate) SetListedAt(v time.Time) *AuctionUpdate {
+	_u.mutation.SetListedAt(v)
+	return _u
+}
+
+// SetNillableListedAt sets the "listed_at" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableListedAt(v *time.Time) *AuctionUpdate {
+	if v != nil {
+		_u.SetListedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuctionUpdate) SetExpiresAt(v time.Time) *AuctionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuctionUpdate) SetNillableExpiresAt(v *time.Time) *AuctionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuctionMutation object of the builder.
+func (_u *AuctionUpdate) Mutation() *AuctionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuctionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuctionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuctionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuctionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuctionUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := auction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "Auction.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuctionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auction.Table, auction.Columns, sqlgraph.NewFieldSpec(auction.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SellerID(); ok {
+		_spec.SetField(auction.FieldSellerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSellerID(); ok {
+		_spec.AddField(auction.FieldSellerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SellerName(); ok {
+		_spec.SetField(auction.FieldSellerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(auction.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemID(); ok {
+		_spec.AddField(auction.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemName(); ok {
+		_spec.SetField(auction.FieldItemName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartBid(); ok {
+		_spec.SetField(auction.FieldStartBid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartBid(); ok {
+		_spec.AddField(auction.FieldStartBid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Buyout(); ok {
+		_spec.SetField(auction.FieldBuyout, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBuyout(); ok {
+		_spec.AddField(auction.FieldBuyout, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bid(); ok {
+		_spec.SetField(auction.FieldBid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBid(); ok {
+		_spec.AddField(auction.FieldBid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BidderID(); ok {
+		_spec.SetField(auction.FieldBidderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBidderID(); ok {
+		_spec.AddField(auction.FieldBidderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(auction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Proceeds(); ok {
+		_spec.SetField(auction.FieldProceeds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProceeds(); ok {
+		_spec.AddField(auction.FieldProceeds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ListedAt(); ok {
+		_spec.SetField(auction.FieldListedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(auction.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuctionUpdateOne is the builder for updating a single Auction entity.
+type AuctionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuctionMutation
+}
+
+// SetSellerID sets the "seller_id" field.
+func (_u *AuctionUpdateOne) SetSellerID(v int) *AuctionUpdateOne {
+	_u.mutation.ResetSellerID()
+	_u.mutation.SetSellerID(v)
+	return _u
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableSellerID(v *int) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetSellerID(*v)
+	}
+	return _u
+}
+
+// AddSellerID adds value to the "seller_id" field.
+func (_u *AuctionUpdateOne) AddSellerID(v int) *AuctionUpdateOne {
+	_u.mutation.AddSellerID(v)
+	return _u
+}
+
+// SetSellerName sets the "seller_name" field.
+func (_u *AuctionUpdateOne) SetSellerName(v string) *AuctionUpdateOne {
+	_u.mutation.SetSellerName(v)
+	return _u
+}
+
+// SetNillableSellerName sets the "seller_name" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableSellerName(v *string) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetSellerName(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *AuctionUpdateOne) SetItemID(v int) *AuctionUpdateOne {
+	_u.mutation.ResetItemID()
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableItemID(v *int) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// AddItemID adds value to the "item_id" field.
+func (_u *AuctionUpdateOne) AddItemID(v int) *AuctionUpdateOne {
+	_u.mutation.AddItemID(v)
+	return _u
+}
+
+// SetItemName sets the "item_name" field.
+func (_u *AuctionUpdateOne) SetItemName(v string) *AuctionUpdateOne {
+	_u.mutation.SetItemName(v)
+	return _u
+}
+
+// SetNillableItemName sets the "item_name" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableItemName(v *string) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetItemName(*v)
+	}
+	return _u
+}
+
+// SetStartBid sets the "start_bid" field.
+func (_u *AuctionUpdateOne) SetStartBid(v int) *AuctionUpdateOne {
+	_u.mutation.ResetStartBid()
+	_u.mutation.SetStartBid(v)
+	return _u
+}
+
+// SetNillableStartBid sets the "start_bid" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableStartBid(v *int) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetStartBid(*v)
+	}
+	return _u
+}
+
+// AddStartBid adds value to the "start_bid" field.
+func (_u *AuctionUpdateOne) AddStartBid(v int) *AuctionUpdateOne {
+	_u.mutation.AddStartBid(v)
+	return _u
+}
+
+// SetBuyout sets the "buyout" field.
+func (_u *AuctionUpdateOne) SetBuyout(v int) *AuctionUpdateOne {
+	_u.mutation.ResetBuyout()
+	_u.mutation.SetBuyout(v)
+	return _u
+}
+
+// SetNillableBuyout sets the "buyout" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableBuyout(v *int) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetBuyout(*v)
+	}
+	return _u
+}
+
+// AddBuyout adds value to the "buyout" field.
+func (_u *AuctionUpdateOne) AddBuyout(v int) *AuctionUpdateOne {
+	_u.mutation.AddBuyout(v)
+	return _u
+}
+
+// SetBid sets the "bid" field.
+func (_u *AuctionUpdateOne) SetBid(v int) *AuctionUpdateOne {
+	_u.mutation.ResetBid()
+	_u.mutation.SetBid(v)
+	return _u
+}
+
+// SetNillableBid sets the "bid" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableBid(v *int) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetBid(*v)
+	}
+	return _u
+}
+
+// AddBid adds value to the "bid" field.
+func (_u *AuctionUpdateOne) AddBid(v int) *AuctionUpdateOne {
+	_u.mutation.AddBid(v)
+	return _u
+}
+
+// SetBidderID sets the "bidder_id" field.
+func (_u *AuctionUpdateOne) SetBidderID(v int) *AuctionUpdateOne {
+	_u.mutation.ResetBidderID()
+	_u.mutation.SetBidderID(v)
+	return _u
+}
+
+// SetNillableBidderID sets the "bidder_id" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableBidderID(v *int) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetBidderID(*v)
+	}
+	return _u
+}
+
+// AddBidderID adds value to the "bidder_id" field.
+func (_u *AuctionUpdateOne) AddBidderID(v int) *AuctionUpdateOne {
+	_u.mutation.AddBidderID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AuctionUpdateOne) SetStatus(v auction.Status) *AuctionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableStatus(v *auction.Status) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetProceeds sets the "proceeds" field.
+func (_u *AuctionUpdateOne) SetProceeds(v int) *AuctionUpdateOne {
+	_u.mutation.ResetProceeds()
+	_u.mutation.SetProceeds(v)
+	return _u
+}
+
+// SetNillableProceeds sets the "proceeds" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableProceeds(v *int) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetProceeds(*v)
+	}
+	return _u
+}
+
+// AddProceeds adds value to the "proceeds" field.
+func (_u *AuctionUpdateOne) AddProceeds(v int) *AuctionUpdateOne {
+	_u.mutation.AddProceeds(v)
+	return _u
+}
+
+// SetListedAt sets the "listed_at" field.
+func (_u *AuctionUpdateOne) SetListedAt(v time.Time) *AuctionUpdateOne {
+	_u.mutation.SetListedAt(v)
+	return _u
+}
+
+// SetNillableListedAt sets the "listed_at" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableListedAt(v *time.Time) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetListedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuctionUpdateOne) SetExpiresAt(v time.Time) *AuctionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuctionUpdateOne) SetNillableExpiresAt(v *time.Time) *AuctionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuctionMutation object of the builder.
+func (_u *AuctionUpdateOne) Mutation() *AuctionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuctionUpdate builder.
+func (_u *AuctionUpdateOne) Where(ps ...predicate.Auction) *AuctionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuctionUpdateOne) Select(field string, fields ...string) *AuctionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Auction entity.
+func (_u *AuctionUpdateOne) Save(ctx context.Context) (*Auction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuctionUpdateOne) SaveX(ctx context.Context) *Auction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuctionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuctionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuctionUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := auction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "Auction.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuctionUpdateOne) sqlSave(ctx context.Context) (_node *Auction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auction.Table, auction.Columns, sqlgraph.NewFieldSpec(auction.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "Auction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auction.FieldID)
+		for _, f := range fields {
+			if !auction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != auction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SellerID(); ok {
+		_spec.SetField(auction.FieldSellerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSellerID(); ok {
+		_spec.AddField(auction.FieldSellerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SellerName(); ok {
+		_spec.SetField(auction.FieldSellerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(auction.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemID(); ok {
+		_spec.AddField(auction.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemName(); ok {
+		_spec.SetField(auction.FieldItemName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartBid(); ok {
+		_spec.SetField(auction.FieldStartBid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartBid(); ok {
+		_spec.AddField(auction.FieldStartBid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Buyout(); ok {
+		_spec.SetField(auction.FieldBuyout, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBuyout(); ok {
+		_spec.AddField(auction.FieldBuyout, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bid(); ok {
+		_spec.SetField(auction.FieldBid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBid(); ok {
+		_spec.AddField(auction.FieldBid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BidderID(); ok {
+		_spec.SetField(auction.FieldBidderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBidderID(); ok {
+		_spec.AddField(auction.FieldBidderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(auction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Proceeds(); ok {
+		_spec.SetField(auction.FieldProceeds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProceeds(); ok {
+		_spec.AddField(auction.FieldProceeds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ListedAt(); ok {
+		_spec.SetField(auction.FieldListedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(auction.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &Auction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
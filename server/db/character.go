@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"herbst-server/db/character"
 	"herbst-server/db/npctemplate"
+	"herbst-server/db/party"
 	"herbst-server/db/room"
 	"herbst-server/db/user"
 	"herbst-server/db/world"
@@ -98,9 +99,18 @@ type Character struct {
 	Charisma int `json:"charisma,omitempty"`
 	// Anti-grind: kill counts per NPC template ID
 	KillCounts map[string]int `json:"kill_counts,omitempty"`
+	// IDs of every room the character has entered
+	VisitedRooms []int `json:"visited_rooms,omitempty"`
+	// Hidden exits the character has found, as "<room id>:<direction>"
+	FoundExits []string `json:"found_exits,omitempty"`
+	// Successful crafts per recipe name
+	CraftedCounts map[string]int `json:"crafted_counts,omitempty"`
+	// Achievement title shown after the name in who and examine
+	Title string `json:"title,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CharacterQuery when eager-loading is set.
 	Edges           CharacterEdges `json:"edges"`
+	party_members   *int
 	room_characters *int
 	user_characters *int
 	selectValues    sql.SelectValues
@@ -142,9 +152,13 @@ type CharacterEdges struct {
 	ClassHistory []*CharacterClassHistory `json:"class_history,omitempty"`
 	// RaceHistory holds the value of the race_history edge.
 	RaceHistory []*CharacterRaceHistory `json:"race_history,omitempty"`
+	// Achievements holds the value of the achievements edge.
+	Achievements []*CharacterAchievement `json:"achievements,omitempty"`
+	// Party holds the value of the party edge.
+	Party *Party `json:"party,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [17]bool
+	loadedTypes [19]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -308,24 +322,46 @@ func (e CharacterEdges) RaceHistoryOrErr() ([]*CharacterRaceHistory, error) {
 	return nil, &NotLoadedError{edge: "race_history"}
 }
 
+// AchievementsOrErr returns the Achievements value or an error if the edge
+// was not loaded in eager-loading.
+func (e CharacterEdges) AchievementsOrErr() ([]*CharacterAchievement, error) {
+	if e.loadedTypes[17] {
+		return e.Achievements, nil
+	}
+	return nil, &NotLoadedError{edge: "achievements"}
+}
+
+// PartyOrErr returns the Party value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CharacterEdges) PartyOrErr() (*Party, error) {
+	if e.Party != nil {
+		return e.Party, nil
+	} else if e.loadedTypes[18] {
+		return nil, &NotFoundError{label: party.Label}
+	}
+	return nil, &NotLoadedError{edge: "party"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Character) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case character.FieldKillCounts:
+		case character.FieldKillCounts, character.FieldVisitedRooms, character.FieldFoundExits, character.FieldCraftedCounts:
 			values[i] = new([]byte)
 		case character.FieldIsNPC, character.FieldIsAdmin, character.FieldIsImmortal, character.FieldIsTest, character.FieldIsInstance:
 			values[i] = new(sql.NullBool)
 		case character.FieldID, character.FieldCurrentRoomId, character.FieldStartingRoomId, character.FieldRespawnRoomId, character.FieldInstanceNumber, character.FieldWorldID, character.FieldNpcSkillCooldown, character.FieldHitpoints, character.FieldMaxHitpoints, character.FieldStamina, character.FieldMaxStamina, character.FieldMana, character.FieldMaxMana, character.FieldLevel, character.FieldXp, character.FieldGoldCredits, character.FieldConstitution, character.FieldStrength, character.FieldDexterity, character.FieldIntelligence, character.FieldWisdom, character.FieldCharisma:
 			values[i] = new(sql.NullInt64)
-		case character.FieldName, character.FieldNpcTemplateID, character.FieldNpcSkillID, character.FieldCurrentWorld, character.FieldRace, character.FieldClass, character.FieldSpecialty, character.FieldGender, character.FieldDescription:
+		case character.FieldName, character.FieldNpcTemplateID, character.FieldNpcSkillID, character.FieldCurrentWorld, character.FieldRace, character.FieldClass, character.FieldSpecialty, character.FieldGender, character.FieldDescription, character.FieldTitle:
 			values[i] = new(sql.NullString)
 		case character.FieldDiedAt, character.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
-		case character.ForeignKeys[0]: // room_characters
+		case character.ForeignKeys[0]: // party_members
+			values[i] = new(sql.NullInt64)
+		case character.ForeignKeys[1]: // room_characters
 			values[i] = new(sql.NullInt64)
-		case character.ForeignKeys[1]: // user_characters
+		case character.ForeignKeys[2]: // user_characters
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -580,14 +616,51 @@ func (_m *Character) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field kill_counts: %w", err)
 				}
 			}
+		case character.FieldVisitedRooms:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field visited_rooms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VisitedRooms); err != nil {
+					return fmt.Errorf("unmarshal field visited_rooms: %w", err)
+				}
+			}
+		case character.FieldFoundExits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field found_exits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FoundExits); err != nil {
+					return fmt.Errorf("unmarshal field found_exits: %w", err)
+				}
+			}
+		case character.FieldCraftedCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field crafted_counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CraftedCounts); err != nil {
+					return fmt.Errorf("unmarshal field crafted_counts: %w", err)
+				}
+			}
+		case character.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
 		case character.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field party_members", value)
+			} else if value.Valid {
+				_m.party_members = new(int)
+				*_m.party_members = int(value.Int64)
+			}
+		case character.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field room_characters", value)
 			} else if value.Valid {
 				_m.room_characters = new(int)
 				*_m.room_characters = int(value.Int64)
 			}
-		case character.ForeignKeys[1]:
+		case character.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_characters", value)
 			} else if value.Valid {
@@ -692,6 +765,16 @@ func (_m *Character) QueryRaceHistory() *CharacterRaceHistoryQuery {
 	return NewCharacterClient(_m.config).QueryRaceHistory(_m)
 }
 
+// QueryAchievements queries the "achievements" edge of the Character entity.
+func (_m *Character) QueryAchievements() *CharacterAchievementQuery {
+	return NewCharacterClient(_m.config).QueryAchievements(_m)
+}
+
+// QueryParty queries the "party" edge of the Character entity.
+func (_m *Character) QueryParty() *PartyQuery {
+	return NewCharacterClient(_m.config).QueryParty(_m)
+}
+
 // Update returns a builder for updating this Character.
 // Note that you need to call Character.Unwrap() before calling this method if this Character
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -832,6 +915,18 @@ func (_m *Character) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("kill_counts=")
 	builder.WriteString(fmt.Sprintf("%v", _m.KillCounts))
+	builder.WriteString(", ")
+	builder.WriteString("visited_rooms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VisitedRooms))
+	builder.WriteString(", ")
+	builder.WriteString("found_exits=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FoundExits))
+	builder.WriteString(", ")
+	builder.WriteString("crafted_counts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CraftedCounts))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
 	builder.WriteByte(')')
 	return builder.String()
 }
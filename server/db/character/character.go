@@ -88,6 +88,14 @@ const (
 	FieldCharisma = "charisma"
 	// FieldKillCounts holds the string denoting the kill_counts field in the database.
 	FieldKillCounts = "kill_counts"
+	// FieldVisitedRooms holds the string denoting the visited_rooms field in the database.
+	FieldVisitedRooms = "visited_rooms"
+	// FieldFoundExits holds the string denoting the found_exits field in the database.
+	FieldFoundExits = "found_exits"
+	// FieldCraftedCounts holds the string denoting the crafted_counts field in the database.
+	FieldCraftedCounts = "crafted_counts"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeWorld holds the string denoting the world edge name in mutations.
@@ -122,6 +130,10 @@ const (
 	EdgeClassHistory = "class_history"
 	// EdgeRaceHistory holds the string denoting the race_history edge name in mutations.
 	EdgeRaceHistory = "race_history"
+	// EdgeAchievements holds the string denoting the achievements edge name in mutations.
+	EdgeAchievements = "achievements"
+	// EdgeParty holds the string denoting the party edge name in mutations.
+	EdgeParty = "party"
 	// Table holds the table name of the character in the database.
 	Table = "characters"
 	// UserTable is the table that holds the user relation/edge.
@@ -243,6 +255,20 @@ const (
 	RaceHistoryInverseTable = "character_race_histories"
 	// RaceHistoryColumn is the table column denoting the race_history relation/edge.
 	RaceHistoryColumn = "character_id"
+	// AchievementsTable is the table that holds the achievements relation/edge.
+	AchievementsTable = "character_achievements"
+	// AchievementsInverseTable is the table name for the CharacterAchievement entity.
+	// It exists in this package in order to avoid circular dependency with the "characterachievement" package.
+	AchievementsInverseTable = "character_achievements"
+	// AchievementsColumn is the table column denoting the achievements relation/edge.
+	AchievementsColumn = "character_id"
+	// PartyTable is the table that holds the party relation/edge.
+	PartyTable = "characters"
+	// PartyInverseTable is the table name for the Party entity.
+	// It exists in this package in order to avoid circular dependency with the "party" package.
+	PartyInverseTable = "parties"
+	// PartyColumn is the table column denoting the party relation/edge.
+	PartyColumn = "party_members"
 )
 
 // Columns holds all SQL columns for character fields.
@@ -286,11 +312,16 @@ var Columns = []string{
 	FieldWisdom,
 	FieldCharisma,
 	FieldKillCounts,
+	FieldVisitedRooms,
+	FieldFoundExits,
+	FieldCraftedCounts,
+	FieldTitle,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "characters"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"party_members",
 	"room_characters",
 	"user_characters",
 }
@@ -558,6 +589,11 @@ func ByCharisma(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCharisma, opts...).ToFunc()
 }
 
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -767,6 +803,27 @@ func ByRaceHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRaceHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAchievementsCount orders the results by achievements count.
+func ByAchievementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAchievementsStep(), opts...)
+	}
+}
+
+// ByAchievements orders the results by achievements terms.
+func ByAchievements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAchievementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPartyField orders the results by party field.
+func ByPartyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPartyStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -886,3 +943,17 @@ func newRaceHistoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RaceHistoryTable, RaceHistoryColumn),
 	)
 }
+func newAchievementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AchievementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AchievementsTable, AchievementsColumn),
+	)
+}
+func newPartyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PartyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PartyTable, PartyColumn),
+	)
+}
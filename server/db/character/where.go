@@ -240,6 +240,11 @@ func Charisma(v int) predicate.Character {
 	return predicate.Character(sql.FieldEQ(FieldCharisma, v))
 }
 
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Character {
+	return predicate.Character(sql.FieldEQ(FieldTitle, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Character {
 	return predicate.Character(sql.FieldEQ(FieldName, v))
@@ -1855,6 +1860,111 @@ func KillCountsNotNil() predicate.Character {
 	return predicate.Character(sql.FieldNotNull(FieldKillCounts))
 }
 
+// VisitedRoomsIsNil applies the IsNil predicate on the "visited_rooms" field.
+func VisitedRoomsIsNil() predicate.Character {
+	return predicate.Character(sql.FieldIsNull(FieldVisitedRooms))
+}
+
+// VisitedRoomsNotNil applies the NotNil predicate on the "visited_rooms" field.
+func VisitedRoomsNotNil() predicate.Character {
+	return predicate.Character(sql.FieldNotNull(FieldVisitedRooms))
+}
+
+// FoundExitsIsNil applies the IsNil predicate on the "found_exits" field.
+func FoundExitsIsNil() predicate.Character {
+	return predicate.Character(sql.FieldIsNull(FieldFoundExits))
+}
+
+// FoundExitsNotNil applies the NotNil predicate on the "found_exits" field.
+func FoundExitsNotNil() predicate.Character {
+	return predicate.Character(sql.FieldNotNull(FieldFoundExits))
+}
+
+// CraftedCountsIsNil applies the IsNil predicate on the "crafted_counts" field.
+func CraftedCountsIsNil() predicate.Character {
+	return predicate.Character(sql.FieldIsNull(FieldCraftedCounts))
+}
+
+// CraftedCountsNotNil applies the NotNil predicate on the "crafted_counts" field.
+func CraftedCountsNotNil() predicate.Character {
+	return predicate.Character(sql.FieldNotNull(FieldCraftedCounts))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Character {
+	return predicate.Character(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Character {
+	return predicate.Character(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Character {
+	return predicate.Character(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Character {
+	return predicate.Character(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Character {
+	return predicate.Character(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Character {
+	return predicate.Character(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Character {
+	return predicate.Character(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Character {
+	return predicate.Character(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Character {
+	return predicate.Character(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Character {
+	return predicate.Character(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Character {
+	return predicate.Character(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Character {
+	return predicate.Character(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Character {
+	return predicate.Character(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Character {
+	return predicate.Character(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Character {
+	return predicate.Character(sql.FieldContainsFold(FieldTitle, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Character {
 	return predicate.Character(func(s *sql.Selector) {
@@ -2246,6 +2356,52 @@ func HasRaceHistoryWith(preds ...predicate.CharacterRaceHistory) predicate.Chara
 	})
 }
 
+// HasAchievements applies the HasEdge predicate on the "achievements" edge.
+func HasAchievements() predicate.Character {
+	return predicate.Character(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AchievementsTable, AchievementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAchievementsWith applies the HasEdge predicate on the "achievements" edge with a given conditions (other predicates).
+func HasAchievementsWith(preds ...predicate.CharacterAchievement) predicate.Character {
+	return predicate.Character(func(s *sql.Selector) {
+		step := newAchievementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParty applies the HasEdge predicate on the "party" edge.
+func HasParty() predicate.Character {
+	return predicate.Character(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PartyTable, PartyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPartyWith applies the HasEdge predicate on the "party" edge with a given conditions (other predicates).
+func HasPartyWith(preds ...predicate.Party) predicate.Character {
+	return predicate.Character(func(s *sql.Selector) {
+		step := newPartyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Character) predicate.Character {
 	return predicate.Character(sql.AndPredicates(predicates...))
@@ -9,6 +9,7 @@ import (
 	"herbst-server/db/activeeffect"
 	"herbst-server/db/character"
 	"herbst-server/db/characterability"
+	"herbst-server/db/characterachievement"
 	"herbst-server/db/characterchannel"
 	"herbst-server/db/characterclasshistory"
 	"herbst-server/db/charactercompetency"
@@ -18,6 +19,7 @@ import (
 	"herbst-server/db/characterskill"
 	"herbst-server/db/charactertag"
 	"herbst-server/db/npctemplate"
+	"herbst-server/db/party"
 	"herbst-server/db/questprogress"
 	"herbst-server/db/room"
 	"herbst-server/db/shoptemplate"
@@ -537,6 +539,38 @@ func (_c *CharacterCreate) SetKillCounts(v map[string]int) *CharacterCreate {
 	return _c
 }
 
+// SetVisitedRooms sets the "visited_rooms" field.
+func (_c *CharacterCreate) SetVisitedRooms(v []int) *CharacterCreate {
+	_c.mutation.SetVisitedRooms(v)
+	return _c
+}
+
+// SetFoundExits sets the "found_exits" field.
+func (_c *CharacterCreate) SetFoundExits(v []string) *CharacterCreate {
+	_c.mutation.SetFoundExits(v)
+	return _c
+}
+
+// SetCraftedCounts sets the "crafted_counts" field.
+func (_c *CharacterCreate) SetCraftedCounts(v map[string]int) *CharacterCreate {
+	_c.mutation.SetCraftedCounts(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *CharacterCreate) SetTitle(v string) *CharacterCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *CharacterCreate) SetNillableTitle(v *string) *CharacterCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_c *CharacterCreate) SetUserID(id int) *CharacterCreate {
 	_c.mutation.SetUserID(id)
@@ -772,6 +806,40 @@ func (_c *CharacterCreate) AddRaceHistory(v ...*CharacterRaceHistory) *Character
 	return _c.AddRaceHistoryIDs(ids...)
 }
 
+// AddAchievementIDs adds the "achievements" edge to the CharacterAchievement entity by IDs.
+func (_c *CharacterCreate) AddAchievementIDs(ids ...int) *CharacterCreate {
+	_c.mutation.AddAchievementIDs(ids...)
+	return _c
+}
+
+// AddAchievements adds the "achievements" edges to the CharacterAchievement entity.
+func (_c *CharacterCreate) AddAchievements(v ...*CharacterAchievement) *CharacterCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAchievementIDs(ids...)
+}
+
+// SetPartyID sets the "party" edge to the Party entity by ID.
+func (_c *CharacterCreate) SetPartyID(id int) *CharacterCreate {
+	_c.mutation.SetPartyID(id)
+	return _c
+}
+
+// SetNillablePartyID sets the "party" edge to the Party entity by ID if the given value is not nil.
+func (_c *CharacterCreate) SetNillablePartyID(id *int) *CharacterCreate {
+	if id != nil {
+		_c = _c.SetPartyID(*id)
+	}
+	return _c
+}
+
+// SetParty sets the "party" edge to the Party entity.
+func (_c *CharacterCreate) SetParty(v *Party) *CharacterCreate {
+	return _c.SetPartyID(v.ID)
+}
+
 // Mutation returns the CharacterMutation object of the builder.
 func (_c *CharacterCreate) Mutation() *CharacterMutation {
 	return _c.mutation
@@ -1168,6 +1236,22 @@ func (_c *CharacterCreate) createSpec() (*Character, *sqlgraph.CreateSpec) {
 		_spec.SetField(character.FieldKillCounts, field.TypeJSON, value)
 		_node.KillCounts = value
 	}
+	if value, ok := _c.mutation.VisitedRooms(); ok {
+		_spec.SetField(character.FieldVisitedRooms, field.TypeJSON, value)
+		_node.VisitedRooms = value
+	}
+	if value, ok := _c.mutation.FoundExits(); ok {
+		_spec.SetField(character.FieldFoundExits, field.TypeJSON, value)
+		_node.FoundExits = value
+	}
+	if value, ok := _c.mutation.CraftedCounts(); ok {
+		_spec.SetField(character.FieldCraftedCounts, field.TypeJSON, value)
+		_node.CraftedCounts = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(character.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1444,6 +1528,39 @@ func (_c *CharacterCreate) createSpec() (*Character, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AchievementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.AchievementsTable,
+			Columns: []string{character.AchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PartyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.PartyTable,
+			Columns: []string{character.PartyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(party.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.party_members = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
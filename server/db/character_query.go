@@ -9,6 +9,7 @@ import (
 	"herbst-server/db/activeeffect"
 	"herbst-server/db/character"
 	"herbst-server/db/characterability"
+	"herbst-server/db/characterachievement"
 	"herbst-server/db/characterchannel"
 	"herbst-server/db/characterclasshistory"
 	"herbst-server/db/charactercompetency"
@@ -18,6 +19,7 @@ import (
 	"herbst-server/db/characterskill"
 	"herbst-server/db/charactertag"
 	"herbst-server/db/npctemplate"
+	"herbst-server/db/party"
 	"herbst-server/db/predicate"
 	"herbst-server/db/questprogress"
 	"herbst-server/db/room"
@@ -57,6 +59,8 @@ type CharacterQuery struct {
 	withCharacterSkills    *CharacterSkillQuery
 	withClassHistory       *CharacterClassHistoryQuery
 	withRaceHistory        *CharacterRaceHistoryQuery
+	withAchievements       *CharacterAchievementQuery
+	withParty              *PartyQuery
 	withFKs                bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -468,6 +472,50 @@ func (_q *CharacterQuery) QueryRaceHistory() *CharacterRaceHistoryQuery {
 	return query
 }
 
+// QueryAchievements chains the current query on the "achievements" edge.
+func (_q *CharacterQuery) QueryAchievements() *CharacterAchievementQuery {
+	query := (&CharacterAchievementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(character.Table, character.FieldID, selector),
+			sqlgraph.To(characterachievement.Table, characterachievement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, character.AchievementsTable, character.AchievementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParty chains the current query on the "party" edge.
+func (_q *CharacterQuery) QueryParty() *PartyQuery {
+	query := (&PartyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(character.Table, character.FieldID, selector),
+			sqlgraph.To(party.Table, party.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, character.PartyTable, character.PartyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Character entity from the query.
 // Returns a *NotFoundError when no Character was found.
 func (_q *CharacterQuery) First(ctx context.Context) (*Character, error) {
@@ -677,6 +725,8 @@ func (_q *CharacterQuery) Clone() *CharacterQuery {
 		withCharacterSkills:    _q.withCharacterSkills.Clone(),
 		withClassHistory:       _q.withClassHistory.Clone(),
 		withRaceHistory:        _q.withRaceHistory.Clone(),
+		withAchievements:       _q.withAchievements.Clone(),
+		withParty:              _q.withParty.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -870,6 +920,28 @@ func (_q *CharacterQuery) WithRaceHistory(opts ...func(*CharacterRaceHistoryQuer
 	return _q
 }
 
+// WithAchievements tells the query-builder to eager-load the nodes that are connected to
+// the "achievements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CharacterQuery) WithAchievements(opts ...func(*CharacterAchievementQuery)) *CharacterQuery {
+	query := (&CharacterAchievementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAchievements = query
+	return _q
+}
+
+// WithParty tells the query-builder to eager-load the nodes that are connected to
+// the "party" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CharacterQuery) WithParty(opts ...func(*PartyQuery)) *CharacterQuery {
+	query := (&PartyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParty = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -949,7 +1021,7 @@ func (_q *CharacterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ch
 		nodes       = []*Character{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [17]bool{
+		loadedTypes = [19]bool{
 			_q.withUser != nil,
 			_q.withWorld != nil,
 			_q.withRoom != nil,
@@ -967,9 +1039,11 @@ func (_q *CharacterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ch
 			_q.withCharacterSkills != nil,
 			_q.withClassHistory != nil,
 			_q.withRaceHistory != nil,
+			_q.withAchievements != nil,
+			_q.withParty != nil,
 		}
 	)
-	if _q.withUser != nil {
+	if _q.withUser != nil || _q.withParty != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -1110,6 +1184,19 @@ func (_q *CharacterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ch
 			return nil, err
 		}
 	}
+	if query := _q.withAchievements; query != nil {
+		if err := _q.loadAchievements(ctx, query, nodes,
+			func(n *Character) { n.Edges.Achievements = []*CharacterAchievement{} },
+			func(n *Character, e *CharacterAchievement) { n.Edges.Achievements = append(n.Edges.Achievements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withParty; query != nil {
+		if err := _q.loadParty(ctx, query, nodes, nil,
+			func(n *Character, e *Party) { n.Edges.Party = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1631,6 +1718,68 @@ func (_q *CharacterQuery) loadRaceHistory(ctx context.Context, query *CharacterR
 	}
 	return nil
 }
+func (_q *CharacterQuery) loadAchievements(ctx context.Context, query *CharacterAchievementQuery, nodes []*Character, init func(*Character), assign func(*Character, *CharacterAchievement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Character)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(characterachievement.FieldCharacterID)
+	}
+	query.Where(predicate.CharacterAchievement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(character.AchievementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CharacterID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "character_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CharacterQuery) loadParty(ctx context.Context, query *PartyQuery, nodes []*Character, init func(*Character), assign func(*Character, *Party)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Character)
+	for i := range nodes {
+		if nodes[i].party_members == nil {
+			continue
+		}
+		fk := *nodes[i].party_members
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(party.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "party_members" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *CharacterQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -9,6 +9,7 @@ import (
 	"herbst-server/db/activeeffect"
 	"herbst-server/db/character"
 	"herbst-server/db/characterability"
+	"herbst-server/db/characterachievement"
 	"herbst-server/db/characterchannel"
 	"herbst-server/db/characterclasshistory"
 	"herbst-server/db/charactercompetency"
@@ -18,6 +19,7 @@ import (
 	"herbst-server/db/characterskill"
 	"herbst-server/db/charactertag"
 	"herbst-server/db/npctemplate"
+	"herbst-server/db/party"
 	"herbst-server/db/predicate"
 	"herbst-server/db/questprogress"
 	"herbst-server/db/room"
@@ -29,6 +31,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -762,6 +765,74 @@ func (_u *CharacterUpdate) ClearKillCounts() *CharacterUpdate {
 	return _u
 }
 
+// SetVisitedRooms sets the "visited_rooms" field.
+func (_u *CharacterUpdate) SetVisitedRooms(v []int) *CharacterUpdate {
+	_u.mutation.SetVisitedRooms(v)
+	return _u
+}
+
+// AppendVisitedRooms appends value to the "visited_rooms" field.
+func (_u *CharacterUpdate) AppendVisitedRooms(v []int) *CharacterUpdate {
+	_u.mutation.AppendVisitedRooms(v)
+	return _u
+}
+
+// ClearVisitedRooms clears the value of the "visited_rooms" field.
+func (_u *CharacterUpdate) ClearVisitedRooms() *CharacterUpdate {
+	_u.mutation.ClearVisitedRooms()
+	return _u
+}
+
+// SetFoundExits sets the "found_exits" field.
+func (_u *CharacterUpdate) SetFoundExits(v []string) *CharacterUpdate {
+	_u.mutation.SetFoundExits(v)
+	return _u
+}
+
+// AppendFoundExits appends value to the "found_exits" field.
+func (_u *CharacterUpdate) AppendFoundExits(v []string) *CharacterUpdate {
+	_u.mutation.AppendFoundExits(v)
+	return _u
+}
+
+// ClearFoundExits clears the value of the "found_exits" field.
+func (_u *CharacterUpdate) ClearFoundExits() *CharacterUpdate {
+	_u.mutation.ClearFoundExits()
+	return _u
+}
+
+// SetCraftedCounts sets the "crafted_counts" field.
+func (_u *CharacterUpdate) SetCraftedCounts(v map[string]int) *CharacterUpdate {
+	_u.mutation.SetCraftedCounts(v)
+	return _u
+}
+
+// ClearCraftedCounts clears the value of the "crafted_counts" field.
+func (_u *CharacterUpdate) ClearCraftedCounts() *CharacterUpdate {
+	_u.mutation.ClearCraftedCounts()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *CharacterUpdate) SetTitle(v string) *CharacterUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *CharacterUpdate) SetNillableTitle(v *string) *CharacterUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *CharacterUpdate) ClearTitle() *CharacterUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *CharacterUpdate) SetUserID(id int) *CharacterUpdate {
 	_u.mutation.SetUserID(id)
@@ -997,6 +1068,40 @@ func (_u *CharacterUpdate) AddRaceHistory(v ...*CharacterRaceHistory) *Character
 	return _u.AddRaceHistoryIDs(ids...)
 }
 
+// AddAchievementIDs adds the "achievements" edge to the CharacterAchievement entity by IDs.
+func (_u *CharacterUpdate) AddAchievementIDs(ids ...int) *CharacterUpdate {
+	_u.mutation.AddAchievementIDs(ids...)
+	return _u
+}
+
+// AddAchievements adds the "achievements" edges to the CharacterAchievement entity.
+func (_u *CharacterUpdate) AddAchievements(v ...*CharacterAchievement) *CharacterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAchievementIDs(ids...)
+}
+
+// SetPartyID sets the "party" edge to the Party entity by ID.
+func (_u *CharacterUpdate) SetPartyID(id int) *CharacterUpdate {
+	_u.mutation.SetPartyID(id)
+	return _u
+}
+
+// SetNillablePartyID sets the "party" edge to the Party entity by ID if the given value is not nil.
+func (_u *CharacterUpdate) SetNillablePartyID(id *int) *CharacterUpdate {
+	if id != nil {
+		_u = _u.SetPartyID(*id)
+	}
+	return _u
+}
+
+// SetParty sets the "party" edge to the Party entity.
+func (_u *CharacterUpdate) SetParty(v *Party) *CharacterUpdate {
+	return _u.SetPartyID(v.ID)
+}
+
 // Mutation returns the CharacterMutation object of the builder.
 func (_u *CharacterUpdate) Mutation() *CharacterMutation {
 	return _u.mutation
@@ -1299,6 +1404,33 @@ func (_u *CharacterUpdate) RemoveRaceHistory(v ...*CharacterRaceHistory) *Charac
 	return _u.RemoveRaceHistoryIDs(ids...)
 }
 
+// ClearAchievements clears all "achievements" edges to the CharacterAchievement entity.
+func (_u *CharacterUpdate) ClearAchievements() *CharacterUpdate {
+	_u.mutation.ClearAchievements()
+	return _u
+}
+
+// RemoveAchievementIDs removes the "achievements" edge to CharacterAchievement entities by IDs.
+func (_u *CharacterUpdate) RemoveAchievementIDs(ids ...int) *CharacterUpdate {
+	_u.mutation.RemoveAchievementIDs(ids...)
+	return _u
+}
+
+// RemoveAchievements removes "achievements" edges to CharacterAchievement entities.
+func (_u *CharacterUpdate) RemoveAchievements(v ...*CharacterAchievement) *CharacterUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAchievementIDs(ids...)
+}
+
+// ClearParty clears the "party" edge to the Party entity.
+func (_u *CharacterUpdate) ClearParty() *CharacterUpdate {
+	_u.mutation.ClearParty()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CharacterUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -1532,6 +1664,40 @@ func (_u *CharacterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.KillCountsCleared() {
 		_spec.ClearField(character.FieldKillCounts, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.VisitedRooms(); ok {
+		_spec.SetField(character.FieldVisitedRooms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVisitedRooms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, character.FieldVisitedRooms, value)
+		})
+	}
+	if _u.mutation.VisitedRoomsCleared() {
+		_spec.ClearField(character.FieldVisitedRooms, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FoundExits(); ok {
+		_spec.SetField(character.FieldFoundExits, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFoundExits(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, character.FieldFoundExits, value)
+		})
+	}
+	if _u.mutation.FoundExitsCleared() {
+		_spec.ClearField(character.FieldFoundExits, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CraftedCounts(); ok {
+		_spec.SetField(character.FieldCraftedCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.CraftedCountsCleared() {
+		_spec.ClearField(character.FieldCraftedCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(character.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(character.FieldTitle, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -2233,6 +2399,80 @@ func (_u *CharacterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AchievementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.AchievementsTable,
+			Columns: []string{character.AchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAchievementsIDs(); len(nodes) > 0 && !_u.mutation.AchievementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.AchievementsTable,
+			Columns: []string{character.AchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AchievementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.AchievementsTable,
+			Columns: []string{character.AchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PartyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.PartyTable,
+			Columns: []string{character.PartyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(party.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PartyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.PartyTable,
+			Columns: []string{character.PartyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(party.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{character.Label}
@@ -2970,6 +3210,74 @@ func (_u *CharacterUpdateOne) ClearKillCounts() *CharacterUpdateOne {
 	return _u
 }
 
+// SetVisitedRooms sets the "visited_rooms" field.
+func (_u *CharacterUpdateOne) SetVisitedRooms(v []int) *CharacterUpdateOne {
+	_u.mutation.SetVisitedRooms(v)
+	return _u
+}
+
+// AppendVisitedRooms appends value to the "visited_rooms" field.
+func (_u *CharacterUpdateOne) AppendVisitedRooms(v []int) *CharacterUpdateOne {
+	_u.mutation.AppendVisitedRooms(v)
+	return _u
+}
+
+// ClearVisitedRooms clears the value of the "visited_rooms" field.
+func (_u *CharacterUpdateOne) ClearVisitedRooms() *CharacterUpdateOne {
+	_u.mutation.ClearVisitedRooms()
+	return _u
+}
+
+// SetFoundExits sets the "found_exits" field.
+func (_u *CharacterUpdateOne) SetFoundExits(v []string) *CharacterUpdateOne {
+	_u.mutation.SetFoundExits(v)
+	return _u
+}
+
+// AppendFoundExits appends value to the "found_exits" field.
+func (_u *CharacterUpdateOne) AppendFoundExits(v []string) *CharacterUpdateOne {
+	_u.mutation.AppendFoundExits(v)
+	return _u
+}
+
+// ClearFoundExits clears the value of the "found_exits" field.
+func (_u *CharacterUpdateOne) ClearFoundExits() *CharacterUpdateOne {
+	_u.mutation.ClearFoundExits()
+	return _u
+}
+
+// SetCraftedCounts sets the "crafted_counts" field.
+func (_u *CharacterUpdateOne) SetCraftedCounts(v map[string]int) *CharacterUpdateOne {
+	_u.mutation.SetCraftedCounts(v)
+	return _u
+}
+
+// ClearCraftedCounts clears the value of the "crafted_counts" field.
+func (_u *CharacterUpdateOne) ClearCraftedCounts() *CharacterUpdateOne {
+	_u.mutation.ClearCraftedCounts()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *CharacterUpdateOne) SetTitle(v string) *CharacterUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *CharacterUpdateOne) SetNillableTitle(v *string) *CharacterUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *CharacterUpdateOne) ClearTitle() *CharacterUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *CharacterUpdateOne) SetUserID(id int) *CharacterUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -3205,6 +3513,40 @@ func (_u *CharacterUpdateOne) AddRaceHistory(v ...*CharacterRaceHistory) *Charac
 	return _u.AddRaceHistoryIDs(ids...)
 }
 
+// AddAchievementIDs adds the "achievements" edge to the CharacterAchievement entity by IDs.
+func (_u *CharacterUpdateOne) AddAchievementIDs(ids ...int) *CharacterUpdateOne {
+	_u.mutation.AddAchievementIDs(ids...)
+	return _u
+}
+
+// AddAchievements adds the "achievements" edges to the CharacterAchievement entity.
+func (_u *CharacterUpdateOne) AddAchievements(v ...*CharacterAchievement) *CharacterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAchievementIDs(ids...)
+}
+
+// SetPartyID sets the "party" edge to the Party entity by ID.
+func (_u *CharacterUpdateOne) SetPartyID(id int) *CharacterUpdateOne {
+	_u.mutation.SetPartyID(id)
+	return _u
+}
+
+// SetNillablePartyID sets the "party" edge to the Party entity by ID if the given value is not nil.
+func (_u *CharacterUpdateOne) SetNillablePartyID(id *int) *CharacterUpdateOne {
+	if id != nil {
+		_u = _u.SetPartyID(*id)
+	}
+	return _u
+}
+
+// SetParty sets the "party" edge to the Party entity.
+func (_u *CharacterUpdateOne) SetParty(v *Party) *CharacterUpdateOne {
+	return _u.SetPartyID(v.ID)
+}
+
 // Mutation returns the CharacterMutation object of the builder.
 func (_u *CharacterUpdateOne) Mutation() *CharacterMutation {
 	return _u.mutation
@@ -3507,6 +3849,33 @@ func (_u *CharacterUpdateOne) RemoveRaceHistory(v ...*CharacterRaceHistory) *Cha
 	return _u.RemoveRaceHistoryIDs(ids...)
 }
 
+// ClearAchievements clears all "achievements" edges to the CharacterAchievement entity.
+func (_u *CharacterUpdateOne) ClearAchievements() *CharacterUpdateOne {
+	_u.mutation.ClearAchievements()
+	return _u
+}
+
+// RemoveAchievementIDs removes the "achievements" edge to CharacterAchievement entities by IDs.
+func (_u *CharacterUpdateOne) RemoveAchievementIDs(ids ...int) *CharacterUpdateOne {
+	_u.mutation.RemoveAchievementIDs(ids...)
+	return _u
+}
+
+// RemoveAchievements removes "achievements" edges to CharacterAchievement entities.
+func (_u *CharacterUpdateOne) RemoveAchievements(v ...*CharacterAchievement) *CharacterUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAchievementIDs(ids...)
+}
+
+// ClearParty clears the "party" edge to the Party entity.
+func (_u *CharacterUpdateOne) ClearParty() *CharacterUpdateOne {
+	_u.mutation.ClearParty()
+	return _u
+}
+
 // Where appends a list predicates to the CharacterUpdate builder.
 func (_u *CharacterUpdateOne) Where(ps ...predicate.Character) *CharacterUpdateOne {
 	_u.mutation.Where(ps...)
@@ -3770,6 +4139,40 @@ func (_u *CharacterUpdateOne) sqlSave(ctx context.Context) (_node *Character, er
 	if _u.mutation.KillCountsCleared() {
 		_spec.ClearField(character.FieldKillCounts, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.VisitedRooms(); ok {
+		_spec.SetField(character.FieldVisitedRooms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVisitedRooms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, character.FieldVisitedRooms, value)
+		})
+	}
+	if _u.mutation.VisitedRoomsCleared() {
+		_spec.ClearField(character.FieldVisitedRooms, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FoundExits(); ok {
+		_spec.SetField(character.FieldFoundExits, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFoundExits(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, character.FieldFoundExits, value)
+		})
+	}
+	if _u.mutation.FoundExitsCleared() {
+		_spec.ClearField(character.FieldFoundExits, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CraftedCounts(); ok {
+		_spec.SetField(character.FieldCraftedCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.CraftedCountsCleared() {
+		_spec.ClearField(character.FieldCraftedCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(character.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(character.FieldTitle, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -4471,6 +4874,80 @@ func (_u *CharacterUpdateOne) sqlSave(ctx context.Context) (_node *Character, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AchievementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.AchievementsTable,
+			Columns: []string{character.AchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAchievementsIDs(); len(nodes) > 0 && !_u.mutation.AchievementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.AchievementsTable,
+			Columns: []string{character.AchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AchievementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   character.AchievementsTable,
+			Columns: []string{character.AchievementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PartyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.PartyTable,
+			Columns: []string{character.PartyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(party.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PartyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   character.PartyTable,
+			Columns: []string{character.PartyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(party.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Character{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"herbst-server/db/achievement"
+	"herbst-server/db/character"
+	"herbst-server/db/characterachievement"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CharacterAchievement is the model entity for the CharacterAchievement schema.
+type CharacterAchievement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FK to character
+	CharacterID int `json:"character_id,omitempty"`
+	// FK to achievement
+	AchievementID int `json:"achievement_id,omitempty"`
+	// Current value towards the criteria threshold
+	Progress int `json:"progress,omitempty"`
+	// Criteria threshold when progress was last recorded
+	Target int `json:"target,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CharacterAchievementQuery when eager-loading is set.
+	Edges        CharacterAchievementEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CharacterAchievementEdges holds the relations/edges for other nodes in the graph.
+type CharacterAchievementEdges struct {
+	// Character holds the value of the character edge.
+	Character *Character `json:"character,omitempty"`
+	// Achievement holds the value of the achievement edge.
+	Achievement *Achievement `json:"achievement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CharacterOrErr returns the Character value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CharacterAchievementEdges) CharacterOrErr() (*Character, error) {
+	if e.Character != nil {
+		return e.Character, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: character.Label}
+	}
+	return nil, &NotLoadedError{edge: "character"}
+}
+
+// AchievementOrErr returns the Achievement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CharacterAchievementEdges) AchievementOrErr() (*Achievement, error) {
+	if e.Achievement != nil {
+		return e.Achievement, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: achievement.Label}
+	}
+	return nil, &NotLoadedError{edge: "achievement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CharacterAchievement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case characterachievement.FieldID, characterachievement.FieldCharacterID, characterachievement.FieldAchievementID, characterachievement.FieldProgress, characterachievement.FieldTarget:
+			values[i] = new(sql.NullInt64)
+		case characterachievement.FieldCompletedAt, characterachievement.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CharacterAchievement fields.
+func (_m *CharacterAchievement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case characterachievement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case characterachievement.FieldCharacterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field character_id", values[i])
+			} else if value.Valid {
+				_m.CharacterID = int(value.Int64)
+			}
+		case characterachievement.FieldAchievementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field achievement_id", values[i])
+			} else if value.Valid {
+				_m.AchievementID = int(value.Int64)
+			}
+		case characterachievement.FieldProgress:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress", values[i])
+			} else if value.Valid {
+				_m.Progress = int(value.Int64)
+			}
+		case characterachievement.FieldTarget:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				_m.Target = int(value.Int64)
+			}
+		case characterachievement.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case characterachievement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CharacterAchievement.
+// This includes values selected through modifiers, order, etc.
+func (_m *CharacterAchievement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCharacter queries the "character" edge of the CharacterAchievement entity.
+func (_m *CharacterAchievement) QueryCharacter() *CharacterQuery {
+	return NewCharacterAchievementClient(_m.config).QueryCharacter(_m)
+}
+
+// QueryAchievement queries the "achievement" edge of the CharacterAchievement entity.
+func (_m *CharacterAchievement) QueryAchievement() *AchievementQuery {
+	return NewCharacterAchievementClient(_m.config).QueryAchievement(_m)
+}
+
+// Update returns a builder for updating this CharacterAchievement.
+// Note that you need to call CharacterAchievement.Unwrap() before calling this method if this CharacterAchievement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CharacterAchievement) Update() *CharacterAchievementUpdateOne {
+	return NewCharacterAchievementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CharacterAchievement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CharacterAchievement) Unwrap() *CharacterAchievement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: CharacterAchievement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CharacterAchievement) String() string {
+	var builder strings.Builder
+	builder.WriteString("CharacterAchievement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("character_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CharacterID))
+	builder.WriteString(", ")
+	builder.WriteString("achievement_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AchievementID))
+	builder.WriteString(", ")
+	builder.WriteString("progress=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Progress))
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Target))
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CharacterAchievements is a parsable slice of CharacterAchievement.
+type CharacterAchievements []*CharacterAchievement
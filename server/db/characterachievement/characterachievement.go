@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package characterachievement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the characterachievement type in the database.
+	Label = "character_achievement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCharacterID holds the string denoting the character_id field in the database.
+	FieldCharacterID = "character_id"
+	// FieldAchievementID holds the string denoting the achievement_id field in the database.
+	FieldAchievementID = "achievement_id"
+	// FieldProgress holds the string denoting the progress field in the database.
+	FieldProgress = "progress"
+	// FieldTarget holds the string denoting the target field in the database.
+	FieldTarget = "target"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCharacter holds the string denoting the character edge name in mutations.
+	EdgeCharacter = "character"
+	// EdgeAchievement holds the string denoting the achievement edge name in mutations.
+	EdgeAchievement = "achievement"
+	// Table holds the table name of the characterachievement in the database.
+	Table = "character_achievements"
+	// CharacterTable is the table that holds the character relation/edge.
+	CharacterTable = "character_achievements"
+	// CharacterInverseTable is the table name for the Character entity.
+	// It exists in this package in order to avoid circular dependency with the "character" package.
+	CharacterInverseTable = "characters"
+	// CharacterColumn is the table column denoting the character relation/edge.
+	CharacterColumn = "character_id"
+	// AchievementTable is the table that holds the achievement relation/edge.
+	AchievementTable = "character_achievements"
+	// AchievementInverseTable is the table name for the Achievement entity.
+	// It exists in this package in order to avoid circular dependency with the "achievement" package.
+	AchievementInverseTable = "achievements"
+	// AchievementColumn is the table column denoting the achievement relation/edge.
+	AchievementColumn = "achievement_id"
+)
+
+// Columns holds all SQL columns for characterachievement fields.
+var Columns = []string{
+	FieldID,
+	FieldCharacterID,
+	FieldAchievementID,
+	FieldProgress,
+	FieldTarget,
+	FieldCompletedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultProgress holds the default value on creation for the "progress" field.
+	DefaultProgress int
+	// DefaultTarget holds the default value on creation for the "target" field.
+	DefaultTarget int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CharacterAchievement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCharacterID orders the results by the character_id field.
+func ByCharacterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharacterID, opts...).ToFunc()
+}
+
+// ByAchievementID orders the results by the achievement_id field.
+func ByAchievementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAchievementID, opts...).ToFunc()
+}
+
+// ByProgress orders the results by the progress field.
+func ByProgress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgress, opts...).ToFunc()
+}
+
+// ByTarget orders the results by the target field.
+func ByTarget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTarget, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCharacterField orders the results by character field.
+func ByCharacterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCharacterStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAchievementField orders the results by achievement field.
+func ByAchievementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAchievementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCharacterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CharacterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CharacterTable, CharacterColumn),
+	)
+}
+func newAchievementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AchievementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AchievementTable, AchievementColumn),
+	)
+}
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package characterachievement
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLTE(FieldID, id))
+}
+
+// CharacterID applies equality check predicate on the "character_id" field. It's identical to CharacterIDEQ.
+func CharacterID(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldCharacterID, v))
+}
+
+// AchievementID applies equality check predicate on the "achievement_id" field. It's identical to AchievementIDEQ.
+func AchievementID(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldAchievementID, v))
+}
+
+// Progress applies equality check predicate on the "progress" field. It's identical to ProgressEQ.
+func Progress(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldProgress, v))
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldTarget, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CharacterIDEQ applies the EQ predicate on the "character_id" field.
+func CharacterIDEQ(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldCharacterID, v))
+}
+
+// CharacterIDNEQ applies the NEQ predicate on the "character_id" field.
+func CharacterIDNEQ(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNEQ(FieldCharacterID, v))
+}
+
+// CharacterIDIn applies the In predicate on the "character_id" field.
+func CharacterIDIn(vs ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldIn(FieldCharacterID, vs...))
+}
+
+// CharacterIDNotIn applies the NotIn predicate on the "character_id" field.
+func CharacterIDNotIn(vs ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNotIn(FieldCharacterID, vs...))
+}
+
+// AchievementIDEQ applies the EQ predicate on the "achievement_id" field.
+func AchievementIDEQ(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldAchievementID, v))
+}
+
+// AchievementIDNEQ applies the NEQ predicate on the "achievement_id" field.
+func AchievementIDNEQ(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNEQ(FieldAchievementID, v))
+}
+
+// AchievementIDIn applies the In predicate on the "achievement_id" field.
+func AchievementIDIn(vs ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldIn(FieldAchievementID, vs...))
+}
+
+// AchievementIDNotIn applies the NotIn predicate on the "achievement_id" field.
+func AchievementIDNotIn(vs ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNotIn(FieldAchievementID, vs...))
+}
+
+// ProgressEQ applies the EQ predicate on the "progress" field.
+func ProgressEQ(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldProgress, v))
+}
+
+// ProgressNEQ applies the NEQ predicate on the "progress" field.
+func ProgressNEQ(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNEQ(FieldProgress, v))
+}
+
+// ProgressIn applies the In predicate on the "progress" field.
+func ProgressIn(vs ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldIn(FieldProgress, vs...))
+}
+
+// ProgressNotIn applies the NotIn predicate on the "progress" field.
+func ProgressNotIn(vs ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNotIn(FieldProgress, vs...))
+}
+
+// ProgressGT applies the GT predicate on the "progress" field.
+func ProgressGT(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGT(FieldProgress, v))
+}
+
+// ProgressGTE applies the GTE predicate on the "progress" field.
+func ProgressGTE(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGTE(FieldProgress, v))
+}
+
+// ProgressLT applies the LT predicate on the "progress" field.
+func ProgressLT(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLT(FieldProgress, v))
+}
+
+// ProgressLTE applies the LTE predicate on the "progress" field.
+func ProgressLTE(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLTE(FieldProgress, v))
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNEQ(FieldTarget, v))
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldIn(FieldTarget, vs...))
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNotIn(FieldTarget, vs...))
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGT(FieldTarget, v))
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGTE(FieldTarget, v))
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLT(FieldTarget, v))
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v int) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLTE(FieldTarget, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasCharacter applies the HasEdge predicate on the "character" edge.
+func HasCharacter() predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CharacterTable, CharacterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCharacterWith applies the HasEdge predicate on the "character" edge with a given conditions (other predicates).
+func HasCharacterWith(preds ...predicate.Character) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(func(s *sql.Selector) {
+		step := newCharacterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAchievement applies the HasEdge predicate on the "achievement" edge.
+func HasAchievement() predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AchievementTable, AchievementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAchievementWith applies the HasEdge predicate on the "achievement" edge with a given conditions (other predicates).
+func HasAchievementWith(preds ...predicate.Achievement) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(func(s *sql.Selector) {
+		step := newAchievementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CharacterAchievement) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CharacterAchievement) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CharacterAchievement) predicate.CharacterAchievement {
+	return predicate.CharacterAchievement(sql.NotPredicates(p))
+}
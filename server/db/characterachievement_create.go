@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/achievement"
+	"herbst-server/db/character"
+	"herbst-server/db/characterachievement"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CharacterAchievementCreate is the builder for creating a CharacterAchievement entity.
+type CharacterAchievementCreate struct {
+	config
+	mutation *CharacterAchievementMutation
+	hooks    []Hook
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_c *CharacterAchievementCreate) SetCharacterID(v int) *CharacterAchievementCreate {
+	_c.mutation.SetCharacterID(v)
+	return _c
+}
+
+// SetAchievementID sets the "achievement_id" field.
+func (_c *CharacterAchievementCreate) SetAchievementID(v int) *CharacterAchievementCreate {
+	_c.mutation.SetAchievementID(v)
+	return _c
+}
+
+// SetProgress sets the "progress" field.
+func (_c *CharacterAchievementCreate) SetProgress(v int) *CharacterAchievementCreate {
+	_c.mutation.SetProgress(v)
+	return _c
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_c *CharacterAchievementCreate) SetNillableProgress(v *int) *CharacterAchievementCreate {
+	if v != nil {
+		_c.SetProgress(*v)
+	}
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *CharacterAchievementCreate) SetTarget(v int) *CharacterAchievementCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_c *CharacterAchievementCreate) SetNillableTarget(v *int) *CharacterAchievementCreate {
+	if v != nil {
+		_c.SetTarget(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *CharacterAchievementCreate) SetCompletedAt(v time.Time) *CharacterAchievementCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *CharacterAchievementCreate) SetNillableCompletedAt(v *time.Time) *CharacterAchievementCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CharacterAchievementCreate) SetUpdatedAt(v time.Time) *CharacterAchievementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CharacterAchievementCreate) SetNillableUpdatedAt(v *time.Time) *CharacterAchievementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCharacter sets the "character" edge to the Character entity.
+func (_c *CharacterAchievementCreate) SetCharacter(v *Character) *CharacterAchievementCreate {
+	return _c.SetCharacterID(v.ID)
+}
+
+// SetAchievement sets the "achievement" edge to the Achievement entity.
+func (_c *CharacterAchievementCreate) SetAchievement(v *Achievement) *CharacterAchievementCreate {
+	return _c.SetAchievementID(v.ID)
+}
+
+// Mutation returns the CharacterAchievementMutation object of the builder.
+func (_c *CharacterAchievementCreate) Mutation() *CharacterAchievementMutation {
+	return _c.mutation
+}
+
+// Save creates the CharacterAchievement in the database.
+func (_c *CharacterAchievementCreate) Save(ctx context.Context) (*CharacterAchievement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CharacterAchievementCreate) SaveX(ctx context.Context) *CharacterAchievement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CharacterAchievementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CharacterAchievementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CharacterAchievementCreate) defaults() {
+	if _, ok := _c.mutation.Progress(); !ok {
+		v := characterachievement.DefaultProgress
+		_c.mutation.SetProgress(v)
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		v := characterachievement.DefaultTarget
+		_c.mutation.SetTarget(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := characterachievement.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CharacterAchievementCreate) check() error {
+	if _, ok := _c.mutation.CharacterID(); !ok {
+		return &ValidationError{Name: "character_id", err: errors.New(`db: missing required field "CharacterAchievement.character_id"`)}
+	}
+	if _, ok := _c.mutation.AchievementID(); !ok {
+		return &ValidationError{Name: "achievement_id", err: errors.New(`db: missing required field "CharacterAchievement.achievement_id"`)}
+	}
+	if _, ok := _c.mutation.Progress(); !ok {
+		return &ValidationError{Name: "progress", err: errors.New(`db: missing required field "CharacterAchievement.progress"`)}
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`db: missing required field "CharacterAchievement.target"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "CharacterAchievement.updated_at"`)}
+	}
+	if len(_c.mutation.CharacterIDs()) == 0 {
+		return &ValidationError{Name: "character", err: errors.New(`db: missing required edge "CharacterAchievement.character"`)}
+	}
+	if len(_c.mutation.AchievementIDs()) == 0 {
+		return &ValidationError{Name: "achievement", err: errors.New(`db: missing required edge "CharacterAchievement.achievement"`)}
+	}
+	return nil
+}
+
+func (_c *CharacterAchievementCreate) sqlSave(ctx context.Context) (*CharacterAchievement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CharacterAchievementCreate) createSpec() (*CharacterAchievement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CharacterAchievement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(characterachievement.Table, sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Progress(); ok {
+		_spec.SetField(characterachievement.FieldProgress, field.TypeInt, value)
+		_node.Progress = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(characterachievement.FieldTarget, field.TypeInt, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(characterachievement.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(characterachievement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.CharacterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.CharacterTable,
+			Columns: []string{characterachievement.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CharacterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AchievementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.AchievementTable,
+			Columns: []string{characterachievement.AchievementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(achievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AchievementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CharacterAchievementCreateBulk is the builder for creating many CharacterAchievement entities in bulk.
+type CharacterAchievementCreateBulk struct {
+	config
+	err      error
+	builders []*CharacterAchievementCreate
+}
+
+// Save creates the CharacterAchievement entities in the database.
+func (_c *CharacterAchievementCreateBulk) Save(ctx context.Context) ([]*CharacterAchievement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CharacterAchievement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CharacterAchievementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CharacterAchievementCreateBulk) SaveX(ctx context.Context) []*CharacterAchievement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CharacterAchievementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CharacterAchievementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
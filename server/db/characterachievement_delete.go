@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"herbst-server/db/characterachievement"
+	"herbst-server/db/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CharacterAchievementDelete is the builder for deleting a CharacterAchievement entity.
+type CharacterAchievementDelete struct {
+	config
+	hooks    []Hook
+	mutation *CharacterAchievementMutation
+}
+
+// Where appends a list predicates to the CharacterAchievementDelete builder.
+func (_d *CharacterAchievementDelete) Where(ps ...predicate.CharacterAchievement) *CharacterAchievementDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CharacterAchievementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CharacterAchievementDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CharacterAchievementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(characterachievement.Table, sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CharacterAchievementDeleteOne is the builder for deleting a single CharacterAchievement entity.
+type CharacterAchievementDeleteOne struct {
+	_d *CharacterAchievementDelete
+}
+
+// Where appends a list predicates to the CharacterAchievementDelete builder.
+func (_d *CharacterAchievementDeleteOne) Where(ps ...predicate.CharacterAchievement) *CharacterAchievementDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CharacterAchievementDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{characterachievement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CharacterAchievementDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"herbst-server/db/achievement"
+	"herbst-server/db/character"
+	"herbst-server/db/characterachievement"
+	"herbst-server/db/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CharacterAchievementQuery is the builder for querying CharacterAchievement entities.
+type CharacterAchievementQuery struct {
+	config
+	ctx             *QueryContext
+	order           []characterachievement.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.CharacterAchievement
+	withCharacter   *CharacterQuery
+	withAchievement *AchievementQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CharacterAchievementQuery builder.
+func (_q *CharacterAchievementQuery) Where(ps ...predicate.CharacterAchievement) *CharacterAchievementQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *CharacterAchievementQuery) Limit(limit int) *CharacterAchievementQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *CharacterAchievementQuery) Offset(offset int) *CharacterAchievementQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *CharacterAchievementQuery) Unique(unique bool) *CharacterAchievementQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *CharacterAchievementQuery) Order(o ...characterachievement.OrderOption) *CharacterAchievementQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryCharacter chains the current query on the "character" edge.
+func (_q *CharacterAchievementQuery) QueryCharacter() *CharacterQuery {
+	query := (&CharacterClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(characterachievement.Table, characterachievement.FieldID, selector),
+			sqlgraph.To(character.Table, character.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, characterachievement.CharacterTable, characterachievement.CharacterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAchievement chains the current query on the "achievement" edge.
+func (_q *CharacterAchievementQuery) QueryAchievement() *AchievementQuery {
+	query := (&AchievementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(characterachievement.Table, characterachievement.FieldID, selector),
+			sqlgraph.To(achievement.Table, achievement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, characterachievement.AchievementTable, characterachievement.AchievementColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CharacterAchievement entity from the query.
+// Returns a *NotFoundError when no CharacterAchievement was found.
+func (_q *CharacterAchievementQuery) First(ctx context.Context) (*CharacterAchievement, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{characterachievement.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *CharacterAchievementQuery) FirstX(ctx context.Context) *CharacterAchievement {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CharacterAchievement ID from the query.
+// Returns a *NotFoundError when no CharacterAchievement ID was found.
+func (_q *CharacterAchievementQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{characterachievement.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *CharacterAchievementQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CharacterAchievement entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CharacterAchievement entity is found.
+// Returns a *NotFoundError when no CharacterAchievement entities are found.
+func (_q *CharacterAchievementQuery) Only(ctx context.Context) (*CharacterAchievement, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{characterachievement.Label}
+	default:
+		return nil, &NotSingularError{characterachievement.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *CharacterAchievementQuery) OnlyX(ctx context.Context) *CharacterAchievement {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CharacterAchievement ID in the query.
+// Returns a *NotSingularError when more than one CharacterAchievement ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *CharacterAchievementQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{characterachievement.Label}
+	default:
+		err = &NotSingularError{characterachievement.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *CharacterAchievementQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CharacterAchievements.
+func (_q *CharacterAchievementQuery) All(ctx context.Context) ([]*CharacterAchievement, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CharacterAchievement, *CharacterAchievementQuery]()
+	return withInterceptors[[]*CharacterAchievement](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *CharacterAchievementQuery) AllX(ctx context.Context) []*CharacterAchievement {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CharacterAchievement IDs.
+func (_q *CharacterAchievementQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(characterachievement.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *CharacterAchievementQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *CharacterAchievementQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*CharacterAchievementQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *CharacterAchievementQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *CharacterAchievementQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *CharacterAchievementQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CharacterAchievementQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *CharacterAchievementQuery) Clone() *CharacterAchievementQuery {
+	if _q == nil {
+		return nil
+	}
+	return &CharacterAchievementQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]characterachievement.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.CharacterAchievement{}, _q.predicates...),
+		withCharacter:   _q.withCharacter.Clone(),
+		withAchievement: _q.withAchievement.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithCharacter tells the query-builder to eager-load the nodes that are connected to
+// the "character" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CharacterAchievementQuery) WithCharacter(opts ...func(*CharacterQuery)) *CharacterAchievementQuery {
+	query := (&CharacterClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCharacter = query
+	return _q
+}
+
+// WithAchievement tells the query-builder to eager-load the nodes that are connected to
+// the "achievement" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CharacterAchievementQuery) WithAchievement(opts ...func(*AchievementQuery)) *CharacterAchievementQuery {
+	query := (&AchievementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAchievement = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CharacterID int `json:"character_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CharacterAchievement.Query().
+//		GroupBy(characterachievement.FieldCharacterID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (_q *CharacterAchievementQuery) GroupBy(field string, fields ...string) *CharacterAchievementGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CharacterAchievementGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = characterachievement.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CharacterID int `json:"character_id,omitempty"`
+//	}
+//
+//	client.CharacterAchievement.Query().
+//		Select(characterachievement.FieldCharacterID).
+//		Scan(ctx, &v)
+func (_q *CharacterAchievementQuery) Select(fields ...string) *CharacterAchievementSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &CharacterAchievementSelect{CharacterAchievementQuery: _q}
+	sbuild.label = characterachievement.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CharacterAchievementSelect configured with the given aggregations.
+func (_q *CharacterAchievementQuery) Aggregate(fns ...AggregateFunc) *CharacterAchievementSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *CharacterAchievementQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !characterachievement.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *CharacterAchievementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CharacterAchievement, error) {
+	var (
+		nodes       = []*CharacterAchievement{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withCharacter != nil,
+			_q.withAchievement != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CharacterAchievement).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CharacterAchievement{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withCharacter; query != nil {
+		if err := _q.loadCharacter(ctx, query, nodes, nil,
+			func(n *CharacterAchievement, e *Character) { n.Edges.Character = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAchievement; query != nil {
+		if err := _q.loadAchievement(ctx, query, nodes, nil,
+			func(n *CharacterAchievement, e *Achievement) { n.Edges.Achievement = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *CharacterAchievementQuery) loadCharacter(ctx context.Context, query *CharacterQuery, nodes []*CharacterAchievement, init func(*CharacterAchievement), assign func(*CharacterAchievement, *Character)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CharacterAchievement)
+	for i := range nodes {
+		fk := nodes[i].CharacterID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(character.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "character_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CharacterAchievementQuery) loadAchievement(ctx context.Context, query *AchievementQuery, nodes []*CharacterAchievement, init func(*CharacterAchievement), assign func(*CharacterAchievement, *Achievement)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CharacterAchievement)
+	for i := range nodes {
+		fk := nodes[i].AchievementID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(achievement.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "achievement_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *CharacterAchievementQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *CharacterAchievementQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(characterachievement.Table, characterachievement.Columns, sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, characterachievement.FieldID)
+		for i := range fields {
+			if fields[i] != characterachievement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withCharacter != nil {
+			_spec.Node.AddColumnOnce(characterachievement.FieldCharacterID)
+		}
+		if _q.withAchievement != nil {
+			_spec.Node.AddColumnOnce(characterachievement.FieldAchievementID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *CharacterAchievementQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(characterachievement.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = characterachievement.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CharacterAchievementGroupBy is the group-by builder for CharacterAchievement entities.
+type CharacterAchievementGroupBy struct {
+	selector
+	build *CharacterAchievementQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *CharacterAchievementGroupBy) Aggregate(fns ...AggregateFunc) *CharacterAchievementGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *CharacterAchievementGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CharacterAchievementQuery, *CharacterAchievementGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *CharacterAchievementGroupBy) sqlScan(ctx context.Context, root *CharacterAchievementQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CharacterAchievementSelect is the builder for selecting fields of CharacterAchievement entities.
+type CharacterAchievementSelect struct {
+	*CharacterAchievementQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *CharacterAchievementSelect) Aggregate(fns ...AggregateFunc) *CharacterAchievementSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *CharacterAchievementSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CharacterAchievementQuery, *CharacterAchievementSelect](ctx, _s.CharacterAchievementQuery, _s, _s.inters, v)
+}
+
+func (_s *CharacterAchievementSelect) sqlScan(ctx context.Context, root *CharacterAchievementQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/achievement"
+	"herbst-server/db/character"
+	"herbst-server/db/characterachievement"
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CharacterAchievementUpdate is the builder for updating CharacterAchievement entities.
+type CharacterAchievementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CharacterAchievementMutation
+}
+
+// Where appends a list predicates to the CharacterAchievementUpdate builder.
+func (_u *CharacterAchievementUpdate) Where(ps ...predicate.CharacterAchievement) *CharacterAchievementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_u *CharacterAchievementUpdate) SetCharacterID(v int) *CharacterAchievementUpdate {
+	_u.mutation.SetCharacterID(v)
+	return _u
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_u *CharacterAchievementUpdate) SetNillableCharacterID(v *int) *CharacterAchievementUpdate {
+	if v != nil {
+		_u.SetCharacterID(*v)
+	}
+	return _u
+}
+
+// SetAchievementID sets the "achievement_id" field.
+func (_u *CharacterAchievementUpdate) SetAchievementID(v int) *CharacterAchievementUpdate {
+	_u.mutation.SetAchievementID(v)
+	return _u
+}
+
+// SetNillableAchievementID sets the "achievement_id" field if the given value is not nil.
+func (_u *CharacterAchievementUpdate) SetNillableAchievementID(v *int) *CharacterAchievementUpdate {
+	if v != nil {
+		_u.SetAchievementID(*v)
+	}
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *CharacterAchievementUpdate) SetProgress(v int) *CharacterAchievementUpdate {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *CharacterAchievementUpdate) SetNillableProgress(v *int) *CharacterAchievementUpdate {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *CharacterAchievementUpdate) AddProgress(v int) *CharacterAchievementUpdate {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *CharacterAchievementUpdate) SetTarget(v int) *CharacterAchievementUpdate {
+	_u.mutation.ResetTarget()
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *CharacterAchievementUpdate) SetNillableTarget(v *int) *CharacterAchievementUpdate {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// AddTarget adds value to the "target" field.
+func (_u *CharacterAchievementUpdate) AddTarget(v int) *CharacterAchievementUpdate {
+	_u.mutation.AddTarget(v)
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *CharacterAchievementUpdate) SetCompletedAt(v time.Time) *CharacterAchievementUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *CharacterAchievementUpdate) SetNillableCompletedAt(v *time.Time) *CharacterAchievementUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *CharacterAchievementUpdate) ClearCompletedAt() *CharacterAchievementUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CharacterAchievementUpdate) SetUpdatedAt(v time.Time) *CharacterAchievementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCharacter sets the "character" edge to the Character entity.
+func (_u *CharacterAchievementUpdate) SetCharacter(v *Character) *CharacterAchievementUpdate {
+	return _u.SetCharacterID(v.ID)
+}
+
+// SetAchievement sets the "achievement" edge to the Achievement entity.
+func (_u *CharacterAchievementUpdate) SetAchievement(v *Achievement) *CharacterAchievementUpdate {
+	return _u.SetAchievementID(v.ID)
+}
+
+// Mutation returns the CharacterAchievementMutation object of the builder.
+func (_u *CharacterAchievementUpdate) Mutation() *CharacterAchievementMutation {
+	return _u.mutation
+}
+
+// ClearCharacter clears the "character" edge to the Character entity.
+func (_u *CharacterAchievementUpdate) ClearCharacter() *CharacterAchievementUpdate {
+	_u.mutation.ClearCharacter()
+	return _u
+}
+
+// ClearAchievement clears the "achievement" edge to the Achievement entity.
+func (_u *CharacterAchievementUpdate) ClearAchievement() *CharacterAchievementUpdate {
+	_u.mutation.ClearAchievement()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CharacterAchievementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CharacterAchievementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CharacterAchievementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CharacterAchievementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CharacterAchievementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := characterachievement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CharacterAchievementUpdate) check() error {
+	if _u.mutation.CharacterCleared() && len(_u.mutation.CharacterIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "CharacterAchievement.character"`)
+	}
+	if _u.mutation.AchievementCleared() && len(_u.mutation.AchievementIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "CharacterAchievement.achievement"`)
+	}
+	return nil
+}
+
+func (_u *CharacterAchievementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(characterachievement.Table, characterachievement.Columns, sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(characterachievement.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(characterachievement.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(characterachievement.FieldTarget, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTarget(); ok {
+		_spec.AddField(characterachievement.FieldTarget, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(characterachievement.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(characterachievement.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(characterachievement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CharacterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.CharacterTable,
+			Columns: []string{characterachievement.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CharacterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.CharacterTable,
+			Columns: []string{characterachievement.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AchievementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.AchievementTable,
+			Columns: []string{characterachievement.AchievementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(achievement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AchievementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.AchievementTable,
+			Columns: []string{characterachievement.AchievementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(achievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{characterachievement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CharacterAchievementUpdateOne is the builder for updating a single CharacterAchievement entity.
+type CharacterAchievementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CharacterAchievementMutation
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_u *CharacterAchievementUpdateOne) SetCharacterID(v int) *CharacterAchievementUpdateOne {
+	_u.mutation.SetCharacterID(v)
+	return _u
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_u *CharacterAchievementUpdateOne) SetNillableCharacterID(v *int) *CharacterAchievementUpdateOne {
+	if v != nil {
+		_u.SetCharacterID(*v)
+	}
+	return _u
+}
+
+// SetAchievementID sets the "achievement_id" field.
+func (_u *CharacterAchievementUpdateOne) SetAchievementID(v int) *CharacterAchievementUpdateOne {
+	_u.mutation.SetAchievementID(v)
+	return _u
+}
+
+// SetNillableAchievementID sets the "achievement_id" field if the given value is not nil.
+func (_u *CharacterAchievementUpdateOne) SetNillableAchievementID(v *int) *CharacterAchievementUpdateOne {
+	if v != nil {
+		_u.SetAchievementID(*v)
+	}
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *CharacterAchievementUpdateOne) SetProgress(v int) *CharacterAchievementUpdateOne {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *CharacterAchievementUpdateOne) SetNillableProgress(v *int) *CharacterAchievementUpdateOne {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *CharacterAchievementUpdateOne) AddProgress(v int) *CharacterAchievementUpdateOne {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *CharacterAchievementUpdateOne) SetTarget(v int) *CharacterAchievementUpdateOne {
+	_u.mutation.ResetTarget()
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *CharacterAchievementUpdateOne) SetNillableTarget(v *int) *CharacterAchievementUpdateOne {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// AddTarget adds value to the "target" field.
+func (_u *CharacterAchievementUpdateOne) AddTarget(v int) *CharacterAchievementUpdateOne {
+	_u.mutation.AddTarget(v)
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *CharacterAchievementUpdateOne) SetCompletedAt(v time.Time) *CharacterAchievementUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *CharacterAchievementUpdateOne) SetNillableCompletedAt(v *time.Time) *CharacterAchievementUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *CharacterAchievementUpdateOne) ClearCompletedAt() *CharacterAchievementUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CharacterAchievementUpdateOne) SetUpdatedAt(v time.Time) *CharacterAchievementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCharacter sets the "character" edge to the Character entity.
+func (_u *CharacterAchievementUpdateOne) SetCharacter(v *Character) *CharacterAchievementUpdateOne {
+	return _u.SetCharacterID(v.ID)
+}
+
+// SetAchievement sets the "achievement" edge to the Achievement entity.
+func (_u *CharacterAchievementUpdateOne) SetAchievement(v *Achievement) *CharacterAchievementUpdateOne {
+	return _u.SetAchievementID(v.ID)
+}
+
+// Mutation returns the CharacterAchievementMutation object of the builder.
+func (_u *CharacterAchievementUpdateOne) Mutation() *CharacterAchievementMutation {
+	return _u.mutation
+}
+
+// ClearCharacter clears the "character" edge to the Character entity.
+func (_u *CharacterAchievementUpdateOne) ClearCharacter() *CharacterAchievementUpdateOne {
+	_u.mutation.ClearCharacter()
+	return _u
+}
+
+// ClearAchievement clears the "achievement" edge to the Achievement entity.
+func (_u *CharacterAchievementUpdateOne) ClearAchievement() *CharacterAchievementUpdateOne {
+	_u.mutation.ClearAchievement()
+	return _u
+}
+
+// Where appends a list predicates to the CharacterAchievementUpdate builder.
+func (_u *CharacterAchievementUpdateOne) Where(ps ...predicate.CharacterAchievement) *CharacterAchievementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CharacterAchievementUpdateOne) Select(field string, fields ...string) *CharacterAchievementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CharacterAchievement entity.
+func (_u *CharacterAchievementUpdateOne) Save(ctx context.Context) (*CharacterAchievement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CharacterAchievementUpdateOne) SaveX(ctx context.Context) *CharacterAchievement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CharacterAchievementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CharacterAchievementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CharacterAchievementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := characterachievement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CharacterAchievementUpdateOne) check() error {
+	if _u.mutation.CharacterCleared() && len(_u.mutation.CharacterIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "CharacterAchievement.character"`)
+	}
+	if _u.mutation.AchievementCleared() && len(_u.mutation.AchievementIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "CharacterAchievement.achievement"`)
+	}
+	return nil
+}
+
+func (_u *CharacterAchievementUpdateOne) sqlSave(ctx context.Context) (_node *CharacterAchievement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(characterachievement.Table, characterachievement.Columns, sqlgraph.NewFieldSpec(characterachievement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "CharacterAchievement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, characterachievement.FieldID)
+		for _, f := range fields {
+			if !characterachievement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != characterachievement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(characterachievement.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(characterachievement.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(characterachievement.FieldTarget, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTarget(); ok {
+		_spec.AddField(characterachievement.FieldTarget, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(characterachievement.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(characterachievement.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(characterachievement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CharacterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.CharacterTable,
+			Columns: []string{characterachievement.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CharacterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.CharacterTable,
+			Columns: []string{characterachievement.CharacterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AchievementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.AchievementTable,
+			Columns: []string{characterachievement.AchievementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(achievement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AchievementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   characterachievement.AchievementTable,
+			Columns: []string{characterachievement.AchievementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(achievement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CharacterAchievement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{characterachievement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
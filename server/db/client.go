@@ -16,9 +16,11 @@ import (
 	"herbst-server/db/achievement"
 	"herbst-server/db/activeeffect"
 	"herbst-server/db/applog"
+	"herbst-server/db/auction"
 	"herbst-server/db/channelconfig"
 	"herbst-server/db/character"
 	"herbst-server/db/characterability"
+	"herbst-server/db/characterachievement"
 	"herbst-server/db/characterchannel"
 	"herbst-server/db/characterclasshistory"
 	"herbst-server/db/charactercompetency"
@@ -31,26 +33,36 @@ import (
 	"herbst-server/db/competencylevelthreshold"
 	"herbst-server/db/craftingrecipe"
 	"herbst-server/db/damagelog"
+	"herbst-server/db/deadletter"
 	"herbst-server/db/dialognode"
+	"herbst-server/db/dialogstate"
 	"herbst-server/db/effect"
 	"herbst-server/db/effecthook"
 	"herbst-server/db/equipment"
 	"herbst-server/db/equipmenttemplate"
+	"herbst-server/db/eventcursor"
 	"herbst-server/db/faction"
 	"herbst-server/db/factioncategory"
 	"herbst-server/db/factionrequiredtag"
 	"herbst-server/db/gameconfig"
 	"herbst-server/db/gender"
+	"herbst-server/db/house"
+	"herbst-server/db/mail"
 	"herbst-server/db/npcability"
 	"herbst-server/db/npctemplate"
+	"herbst-server/db/outboxevent"
+	"herbst-server/db/party"
+	"herbst-server/db/partyinvite"
 	"herbst-server/db/quest"
 	"herbst-server/db/questprogress"
 	"herbst-server/db/race"
+	"herbst-server/db/reputation"
 	"herbst-server/db/room"
 	"herbst-server/db/shopitem"
 	"herbst-server/db/shoptemplate"
 	"herbst-server/db/skill"
 	"herbst-server/db/socialcommand"
+	"herbst-server/db/stash"
 	"herbst-server/db/systemlog"
 	"herbst-server/db/tag"
 	"herbst-server/db/tellqueue"
@@ -58,6 +70,7 @@ import (
 	"herbst-server/db/user"
 	"herbst-server/db/world"
 	"herbst-server/db/zone"
+	"herbst-server/db/zoneinstance"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -80,12 +93,16 @@ type Client struct {
 	ActiveEffect *ActiveEffectClient
 	// AppLog is the client for interacting with the AppLog builders.
 	AppLog *AppLogClient
+	// Auction is the client for interacting with the Auction builders.
+	Auction *AuctionClient
 	// ChannelConfig is the client for interacting with the ChannelConfig builders.
 	ChannelConfig *ChannelConfigClient
 	// Character is the client for interacting with the Character builders.
 	Character *CharacterClient
 	// CharacterAbility is the client for interacting with the CharacterAbility builders.
 	CharacterAbility *CharacterAbilityClient
+	// CharacterAchievement is the client for interacting with the CharacterAchievement builders.
+	CharacterAchievement *CharacterAchievementClient
 	// CharacterChannel is the client for interacting with the CharacterChannel builders.
 	CharacterChannel *CharacterChannelClient
 	// CharacterClassHistory is the client for interacting with the CharacterClassHistory builders.
@@ -110,8 +127,12 @@ type Client struct {
 	CraftingRecipe *CraftingRecipeClient
 	// DamageLog is the client for interacting with the DamageLog builders.
 	DamageLog *DamageLogClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
 	// DialogNode is the client for interacting with the DialogNode builders.
 	DialogNode *DialogNodeClient
+	// DialogState is the client for interacting with the DialogState builders.
+	DialogState *DialogStateClient
 	// Effect is the client for interacting with the Effect builders.
 	Effect *EffectClient
 	// EffectHook is the client for interacting with the EffectHook builders.
@@ -120,6 +141,8 @@ type Client struct {
 	Equipment *EquipmentClient
 	// EquipmentTemplate is the client for interacting with the EquipmentTemplate builders.
 	EquipmentTemplate *EquipmentTemplateClient
+	// EventCursor is the client for interacting with the EventCursor builders.
+	EventCursor *EventCursorClient
 	// Faction is the client for interacting with the Faction builders.
 	Faction *FactionClient
 	// FactionCategory is the client for interacting with the FactionCategory builders.
@@ -130,16 +153,28 @@ type Client struct {
 	GameConfig *GameConfigClient
 	// Gender is the client for interacting with the Gender builders.
 	Gender *GenderClient
+	// House is the client for interacting with the House builders.
+	House *HouseClient
+	// Mail is the client for interacting with the Mail builders.
+	Mail *MailClient
 	// NPCAbility is the client for interacting with the NPCAbility builders.
 	NPCAbility *NPCAbilityClient
 	// NPCTemplate is the client for interacting with the NPCTemplate builders.
 	NPCTemplate *NPCTemplateClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// Party is the client for interacting with the Party builders.
+	Party *PartyClient
+	// PartyInvite is the client for interacting with the PartyInvite builders.
+	PartyInvite *PartyInviteClient
 	// Quest is the client for interacting with the Quest builders.
 	Quest *QuestClient
 	// QuestProgress is the client for interacting with the QuestProgress builders.
 	QuestProgress *QuestProgressClient
 	// Race is the client for interacting with the Race builders.
 	Race *RaceClient
+	// Reputation is the client for interacting with the Reputation builders.
+	Reputation *ReputationClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
 	// ShopItem is the client for interacting with the ShopItem builders.
@@ -150,6 +185,8 @@ type Client struct {
 	Skill *SkillClient
 	// SocialCommand is the client for interacting with the SocialCommand builders.
 	SocialCommand *SocialCommandClient
+	// Stash is the client for interacting with the Stash builders.
+	Stash *StashClient
 	// SystemLog is the client for interacting with the SystemLog builders.
 	SystemLog *SystemLogClient
 	// Tag is the client for interacting with the Tag builders.
@@ -164,6 +201,8 @@ type Client struct {
 	World *WorldClient
 	// Zone is the client for interacting with the Zone builders.
 	Zone *ZoneClient
+	// ZoneInstance is the client for interacting with the ZoneInstance builders.
+	ZoneInstance *ZoneInstanceClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -180,9 +219,11 @@ func (c *Client) init() {
 	c.Achievement = NewAchievementClient(c.config)
 	c.ActiveEffect = NewActiveEffectClient(c.config)
 	c.AppLog = NewAppLogClient(c.config)
+	c.Auction = NewAuctionClient(c.config)
 	c.ChannelConfig = NewChannelConfigClient(c.config)
 	c.Character = NewCharacterClient(c.config)
 	c.CharacterAbility = NewCharacterAbilityClient(c.config)
+	c.CharacterAchievement = NewCharacterAchievementClient(c.config)
 	c.CharacterChannel = NewCharacterChannelClient(c.config)
 	c.CharacterClassHistory = NewCharacterClassHistoryClient(c.config)
 	c.CharacterCompetency = NewCharacterCompetencyClient(c.config)
@@ -195,26 +236,36 @@ func (c *Client) init() {
 	c.CompetencyLevelThreshold = NewCompetencyLevelThresholdClient(c.config)
 	c.CraftingRecipe = NewCraftingRecipeClient(c.config)
 	c.DamageLog = NewDamageLogClient(c.config)
+	c.DeadLetter = NewDeadLetterClient(c.config)
 	c.DialogNode = NewDialogNodeClient(c.config)
+	c.DialogState = NewDialogStateClient(c.config)
 	c.Effect = NewEffectClient(c.config)
 	c.EffectHook = NewEffectHookClient(c.config)
 	c.Equipment = NewEquipmentClient(c.config)
 	c.EquipmentTemplate = NewEquipmentTemplateClient(c.config)
+	c.EventCursor = NewEventCursorClient(c.config)
 	c.Faction = NewFactionClient(c.config)
 	c.FactionCategory = NewFactionCategoryClient(c.config)
 	c.FactionRequiredTag = NewFactionRequiredTagClient(c.config)
 	c.GameConfig = NewGameConfigClient(c.config)
 	c.Gender = NewGenderClient(c.config)
+	c.House = NewHouseClient(c.config)
+	c.Mail = NewMailClient(c.config)
 	c.NPCAbility = NewNPCAbilityClient(c.config)
 	c.NPCTemplate = NewNPCTemplateClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
+	c.Party = NewPartyClient(c.config)
+	c.PartyInvite = NewPartyInviteClient(c.config)
 	c.Quest = NewQuestClient(c.config)
 	c.QuestProgress = NewQuestProgressClient(c.config)
 	c.Race = NewRaceClient(c.config)
+	c.Reputation = NewReputationClient(c.config)
 	c.Room = NewRoomClient(c.config)
 	c.ShopItem = NewShopItemClient(c.config)
 	c.ShopTemplate = NewShopTemplateClient(c.config)
 	c.Skill = NewSkillClient(c.config)
 	c.SocialCommand = NewSocialCommandClient(c.config)
+	c.Stash = NewStashClient(c.config)
 	c.SystemLog = NewSystemLogClient(c.config)
 	c.Tag = NewTagClient(c.config)
 	c.TellQueue = NewTellQueueClient(c.config)
@@ -222,6 +273,7 @@ func (c *Client) init() {
 	c.User = NewUserClient(c.config)
 	c.World = NewWorldClient(c.config)
 	c.Zone = NewZoneClient(c.config)
+	c.ZoneInstance = NewZoneInstanceClient(c.config)
 }
 
 type (
@@ -319,9 +371,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Achievement:              NewAchievementClient(cfg),
 		ActiveEffect:             NewActiveEffectClient(cfg),
 		AppLog:                   NewAppLogClient(cfg),
+		Auction:                  NewAuctionClient(cfg),
 		ChannelConfig:            NewChannelConfigClient(cfg),
 		Character:                NewCharacterClient(cfg),
 		CharacterAbility:         NewCharacterAbilityClient(cfg),
+		CharacterAchievement:     NewCharacterAchievementClient(cfg),
 		CharacterChannel:         NewCharacterChannelClient(cfg),
 		CharacterClassHistory:    NewCharacterClassHistoryClient(cfg),
 		CharacterCompetency:      NewCharacterCompetencyClient(cfg),
@@ -334,26 +388,36 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		CompetencyLevelThreshold: NewCompetencyLevelThresholdClient(cfg),
 		CraftingRecipe:           NewCraftingRecipeClient(cfg),
 		DamageLog:                NewDamageLogClient(cfg),
+		DeadLetter:               NewDeadLetterClient(cfg),
 		DialogNode:               NewDialogNodeClient(cfg),
+		DialogState:              NewDialogStateClient(cfg),
 		Effect:                   NewEffectClient(cfg),
 		EffectHook:               NewEffectHookClient(cfg),
 		Equipment:                NewEquipmentClient(cfg),
 		EquipmentTemplate:        NewEquipmentTemplateClient(cfg),
+		EventCursor:              NewEventCursorClient(cfg),
 		Faction:                  NewFactionClient(cfg),
 		FactionCategory:          NewFactionCategoryClient(cfg),
 		FactionRequiredTag:       NewFactionRequiredTagClient(cfg),
 		GameConfig:               NewGameConfigClient(cfg),
 		Gender:                   NewGenderClient(cfg),
+		House:                    NewHouseClient(cfg),
+		Mail:                     NewMailClient(cfg),
 		NPCAbility:               NewNPCAbilityClient(cfg),
 		NPCTemplate:              NewNPCTemplateClient(cfg),
+		OutboxEvent:              NewOutboxEventClient(cfg),
+		Party:                    NewPartyClient(cfg),
+		PartyInvite:              NewPartyInviteClient(cfg),
 		Quest:                    NewQuestClient(cfg),
 		QuestProgress:            NewQuestProgressClient(cfg),
 		Race:                     NewRaceClient(cfg),
+		Reputation:               NewReputationClient(cfg),
 		Room:                     NewRoomClient(cfg),
 		ShopItem:                 NewShopItemClient(cfg),
 		ShopTemplate:             NewShopTemplateClient(cfg),
 		Skill:                    NewSkillClient(cfg),
 		SocialCommand:            NewSocialCommandClient(cfg),
+		Stash:                    NewStashClient(cfg),
 		SystemLog:                NewSystemLogClient(cfg),
 		Tag:                      NewTagClient(cfg),
 		TellQueue:                NewTellQueueClient(cfg),
@@ -361,6 +425,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		User:                     NewUserClient(cfg),
 		World:                    NewWorldClient(cfg),
 		Zone:                     NewZoneClient(cfg),
+		ZoneInstance:             NewZoneInstanceClient(cfg),
 	}, nil
 }
 
@@ -385,9 +450,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Achievement:              NewAchievementClient(cfg),
 		ActiveEffect:             NewActiveEffectClient(cfg),
 		AppLog:                   NewAppLogClient(cfg),
+		Auction:                  NewAuctionClient(cfg),
 		ChannelConfig:            NewChannelConfigClient(cfg),
 		Character:                NewCharacterClient(cfg),
 		CharacterAbility:         NewCharacterAbilityClient(cfg),
+		CharacterAchievement:     NewCharacterAchievementClient(cfg),
 		CharacterChannel:         NewCharacterChannelClient(cfg),
 		CharacterClassHistory:    NewCharacterClassHistoryClient(cfg),
 		CharacterCompetency:      NewCharacterCompetencyClient(cfg),
@@ -400,26 +467,36 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		CompetencyLevelThreshold: NewCompetencyLevelThresholdClient(cfg),
 		CraftingRecipe:           NewCraftingRecipeClient(cfg),
 		DamageLog:                NewDamageLogClient(cfg),
+		DeadLetter:               NewDeadLetterClient(cfg),
 		DialogNode:               NewDialogNodeClient(cfg),
+		DialogState:              NewDialogStateClient(cfg),
 		Effect:                   NewEffectClient(cfg),
 		EffectHook:               NewEffectHookClient(cfg),
 		Equipment:                NewEquipmentClient(cfg),
 		EquipmentTemplate:        NewEquipmentTemplateClient(cfg),
+		EventCursor:              NewEventCursorClient(cfg),
 		Faction:                  NewFactionClient(cfg),
 		FactionCategory:          NewFactionCategoryClient(cfg),
 		FactionRequiredTag:       NewFactionRequiredTagClient(cfg),
 		GameConfig:               NewGameConfigClient(cfg),
 		Gender:                   NewGenderClient(cfg),
+		House:                    NewHouseClient(cfg),
+		Mail:                     NewMailClient(cfg),
 		NPCAbility:               NewNPCAbilityClient(cfg),
 		NPCTemplate:              NewNPCTemplateClient(cfg),
+		OutboxEvent:              NewOutboxEventClient(cfg),
+		Party:                    NewPartyClient(cfg),
+		PartyInvite:              NewPartyInviteClient(cfg),
 		Quest:                    NewQuestClient(cfg),
 		QuestProgress:            NewQuestProgressClient(cfg),
 		Race:                     NewRaceClient(cfg),
+		Reputation:               NewReputationClient(cfg),
 		Room:                     NewRoomClient(cfg),
 		ShopItem:                 NewShopItemClient(cfg),
 		ShopTemplate:             NewShopTemplateClient(cfg),
 		Skill:                    NewSkillClient(cfg),
 		SocialCommand:            NewSocialCommandClient(cfg),
+		Stash:                    NewStashClient(cfg),
 		SystemLog:                NewSystemLogClient(cfg),
 		Tag:                      NewTagClient(cfg),
 		TellQueue:                NewTellQueueClient(cfg),
@@ -427,6 +504,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		User:                     NewUserClient(cfg),
 		World:                    NewWorldClient(cfg),
 		Zone:                     NewZoneClient(cfg),
+		ZoneInstance:             NewZoneInstanceClient(cfg),
 	}, nil
 }
 
@@ -456,16 +534,19 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Ability, c.AbilityEffect, c.Achievement, c.ActiveEffect, c.AppLog,
-		c.ChannelConfig, c.Character, c.CharacterAbility, c.CharacterChannel,
-		c.CharacterClassHistory, c.CharacterCompetency, c.CharacterFaction,
-		c.CharacterIgnore, c.CharacterRaceHistory, c.CharacterSkill, c.CharacterTag,
-		c.CompetencyCategory, c.CompetencyLevelThreshold, c.CraftingRecipe,
-		c.DamageLog, c.DialogNode, c.Effect, c.EffectHook, c.Equipment,
-		c.EquipmentTemplate, c.Faction, c.FactionCategory, c.FactionRequiredTag,
-		c.GameConfig, c.Gender, c.NPCAbility, c.NPCTemplate, c.Quest, c.QuestProgress,
-		c.Race, c.Room, c.ShopItem, c.ShopTemplate, c.Skill, c.SocialCommand,
-		c.SystemLog, c.Tag, c.TellQueue, c.Trigger, c.User, c.World, c.Zone,
+		c.Ability, c.AbilityEffect, c.Achievement, c.ActiveEffect, c.AppLog, c.Auction,
+		c.ChannelConfig, c.Character, c.CharacterAbility, c.CharacterAchievement,
+		c.CharacterChannel, c.CharacterClassHistory, c.CharacterCompetency,
+		c.CharacterFaction, c.CharacterIgnore, c.CharacterRaceHistory,
+		c.CharacterSkill, c.CharacterTag, c.CompetencyCategory,
+		c.CompetencyLevelThreshold, c.CraftingRecipe, c.DamageLog, c.DeadLetter,
+		c.DialogNode, c.DialogState, c.Effect, c.EffectHook, c.Equipment,
+		c.EquipmentTemplate, c.EventCursor, c.Faction, c.FactionCategory,
+		c.FactionRequiredTag, c.GameConfig, c.Gender, c.House, c.Mail, c.NPCAbility,
+		c.NPCTemplate, c.OutboxEvent, c.Party, c.PartyInvite, c.Quest, c.QuestProgress,
+		c.Race, c.Reputation, c.Room, c.ShopItem, c.ShopTemplate, c.Skill,
+		c.SocialCommand, c.Stash, c.SystemLog, c.Tag, c.TellQueue, c.Trigger, c.User,
+		c.World, c.Zone, c.ZoneInstance,
 	} {
 		n.Use(hooks...)
 	}
@@ -475,16 +556,19 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Ability, c.AbilityEffect, c.Achievement, c.ActiveEffect, c.AppLog,
-		c.ChannelConfig, c.Character, c.CharacterAbility, c.CharacterChannel,
-		c.CharacterClassHistory, c.CharacterCompetency, c.CharacterFaction,
-		c.CharacterIgnore, c.CharacterRaceHistory, c.CharacterSkill, c.CharacterTag,
-		c.CompetencyCategory, c.CompetencyLevelThreshold, c.CraftingRecipe,
-		c.DamageLog, c.DialogNode, c.Effect, c.EffectHook, c.Equipment,
-		c.EquipmentTemplate, c.Faction, c.FactionCategory, c.FactionRequiredTag,
-		c.GameConfig, c.Gender, c.NPCAbility, c.NPCTemplate, c.Quest, c.QuestProgress,
-		c.Race, c.Room, c.ShopItem, c.ShopTemplate, c.Skill, c.SocialCommand,
-		c.SystemLog, c.Tag, c.TellQueue, c.Trigger, c.User, c.World, c.Zone,
+		c.Ability, c.AbilityEffect, c.Achievement, c.ActiveEffect, c.AppLog, c.Auction,
+		c.ChannelConfig, c.Character, c.CharacterAbility, c.CharacterAchievement,
+		c.CharacterChannel, c.CharacterClassHistory, c.CharacterCompetency,
+		c.CharacterFaction, c.CharacterIgnore, c.CharacterRaceHistory,
+		c.CharacterSkill, c.CharacterTag, c.CompetencyCategory,
+		c.CompetencyLevelThreshold, c.CraftingRecipe, c.DamageLog, c.DeadLetter,
+		c.DialogNode, c.DialogState, c.Effect, c.EffectHook, c.Equipment,
+		c.EquipmentTemplate, c.EventCursor, c.Faction, c.FactionCategory,
+		c.FactionRequiredTag, c.GameConfig, c.Gender, c.House, c.Mail, c.NPCAbility,
+		c.NPCTemplate, c.OutboxEvent, c.Party, c.PartyInvite, c.Quest, c.QuestProgress,
+		c.Race, c.Reputation, c.Room, c.ShopItem, c.ShopTemplate, c.Skill,
+		c.SocialCommand, c.Stash, c.SystemLog, c.Tag, c.TellQueue, c.Trigger, c.User,
+		c.World, c.Zone, c.ZoneInstance,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -503,12 +587,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ActiveEffect.mutate(ctx, m)
 	case *AppLogMutation:
 		return c.AppLog.mutate(ctx, m)
+	case *AuctionMutation:
+		return c.Auction.mutate(ctx, m)
 	case *ChannelConfigMutation:
 		return c.ChannelConfig.mutate(ctx, m)
 	case *CharacterMutation:
 		return c.Character.mutate(ctx, m)
 	case *CharacterAbilityMutation:
 		return c.CharacterAbility.mutate(ctx, m)
+	case *CharacterAchievementMutation:
+		return c.CharacterAchievement.mutate(ctx, m)
 	case *CharacterChannelMutation:
 		return c.CharacterChannel.mutate(ctx, m)
 	case *CharacterClassHistoryMutation:
@@ -533,8 +621,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.CraftingRecipe.mutate(ctx, m)
 	case *DamageLogMutation:
 		return c.DamageLog.mutate(ctx, m)
+	case *DeadLetterMutation:
+		return c.DeadLetter.mutate(ctx, m)
 	case *DialogNodeMutation:
 		return c.DialogNode.mutate(ctx, m)
+	case *DialogStateMutation:
+		return c.DialogState.mutate(ctx, m)
 	case *EffectMutation:
 		return c.Effect.mutate(ctx, m)
 	case *EffectHookMutation:
@@ -543,6 +635,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Equipment.mutate(ctx, m)
 	case *EquipmentTemplateMutation:
 		return c.EquipmentTemplate.mutate(ctx, m)
+	case *EventCursorMutation:
+		return c.EventCursor.mutate(ctx, m)
 	case *FactionMutation:
 		return c.Faction.mutate(ctx, m)
 	case *FactionCategoryMutation:
@@ -553,16 +647,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.GameConfig.mutate(ctx, m)
 	case *GenderMutation:
 		return c.Gender.mutate(ctx, m)
+	case *HouseMutation:
+		return c.House.mutate(ctx, m)
+	case *MailMutation:
+		return c.Mail.mutate(ctx, m)
 	case *NPCAbilityMutation:
 		return c.NPCAbility.mutate(ctx, m)
 	case *NPCTemplateMutation:
 		return c.NPCTemplate.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
+	case *PartyMutation:
+		return c.Party.mutate(ctx, m)
+	case *PartyInviteMutation:
+		return c.PartyInvite.mutate(ctx, m)
 	case *QuestMutation:
 		return c.Quest.mutate(ctx, m)
 	case *QuestProgressMutation:
 		return c.QuestProgress.mutate(ctx, m)
 	case *RaceMutation:
 		return c.Race.mutate(ctx, m)
+	case *ReputationMutation:
+		return c.Reputation.mutate(ctx, m)
 	case *RoomMutation:
 		return c.Room.mutate(ctx, m)
 	case *ShopItemMutation:
@@ -573,6 +679,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Skill.mutate(ctx, m)
 	case *SocialCommandMutation:
 		return c.SocialCommand.mutate(ctx, m)
+	case *StashMutation:
+		return c.Stash.mutate(ctx, m)
 	case *SystemLogMutation:
 		return c.SystemLog.mutate(ctx, m)
 	case *TagMutation:
@@ -587,6 +695,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.World.mutate(ctx, m)
 	case *ZoneMutation:
 		return c.Zone.mutate(ctx, m)
+	case *ZoneInstanceMutation:
+		return c.ZoneInstance.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("db: unknown mutation type %T", m)
 	}
@@ -1062,6 +1172,22 @@ func (c *AchievementClient) GetX(ctx context.Context, id int) *Achievement {
 	return obj
 }
 
+// QueryCharacterAchievements queries the character_achievements edge of a Achievement.
+func (c *AchievementClient) QueryCharacterAchievements(_m *Achievement) *CharacterAchievementQuery {
+	query := (&CharacterAchievementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(achievement.Table, achievement.FieldID, id),
+			sqlgraph.To(characterachievement.Table, characterachievement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, achievement.CharacterAchievementsTable, achievement.CharacterAchievementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AchievementClient) Hooks() []Hook {
 	return c.hooks.Achievement
@@ -1385,6 +1511,139 @@ func (c *AppLogClient) mutate(ctx context.Context, m *AppLogMutation) (Value, er
 	}
 }
 
+// AuctionClient is a client for the Auction schema.
+type AuctionClient struct {
+	config
+}
+
+// NewAuctionClient returns a client for the Auction from the given config.
+func NewAuctionClient(c config) *AuctionClient {
+	return &AuctionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auction.Hooks(f(g(h())))`.
+func (c *AuctionClient) Use(hooks ...Hook) {
+	c.hooks.Auction = append(c.hooks.Auction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auction.Intercept(f(g(h())))`.
+func (c *AuctionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Auction = append(c.inters.Auction, interceptors...)
+}
+
+// Create returns a builder for creating a Auction entity.
+func (c *AuctionClient) Create() *AuctionCreate {
+	mutation := newAuctionMutation(c.config, OpCreate)
+	return &AuctionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Auction entities.
+func (c *AuctionClient) CreateBulk(builders ...*AuctionCreate) *AuctionCreateBulk {
+	return &AuctionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuctionClient) MapCreateBulk(slice any, setFunc func(*AuctionCreate, int)) *AuctionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuctionCreateBulk{err: fmt.Errorf("calling to AuctionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuctionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuctionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Auction.
+func (c *AuctionClient) Update() *AuctionUpdate {
+	mutation := newAuctionMutation(c.config, OpUpdate)
+	return &AuctionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuctionClient) UpdateOne(_m *Auction) *AuctionUpdateOne {
+	mutation := newAuctionMutation(c.config, OpUpdateOne, withAuction(_m))
+	return &AuctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuctionClient) UpdateOneID(id int) *AuctionUpdateOne {
+	mutation := newAuctionMutation(c.config, OpUpdateOne, withAuctionID(id))
+	return &AuctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Auction.
+func (c *AuctionClient) Delete() *AuctionDelete {
+	mutation := newAuctionMutation(c.config, OpDelete)
+	return &AuctionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuctionClient) DeleteOne(_m *Auction) *AuctionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuctionClient) DeleteOneID(id int) *AuctionDeleteOne {
+	builder := c.Delete().Where(auction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuctionDeleteOne{builder}
+}
+
+// Query returns a query builder for Auction.
+func (c *AuctionClient) Query() *AuctionQuery {
+	return &AuctionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Auction entity by its id.
+func (c *AuctionClient) Get(ctx context.Context, id int) (*Auction, error) {
+	return c.Query().Where(auction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuctionClient) GetX(ctx context.Context, id int) *Auction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuctionClient) Hooks() []Hook {
+	return c.hooks.Auction
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuctionClient) Interceptors() []Interceptor {
+	return c.inters.Auction
+}
+
+func (c *AuctionClient) mutate(ctx context.Context, m *AuctionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuctionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuctionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuctionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Auction mutation op: %q", m.Op())
+	}
+}
+
 // ChannelConfigClient is a client for the ChannelConfig schema.
 type ChannelConfigClient struct {
 	config
@@ -1898,6 +2157,38 @@ func (c *CharacterClient) QueryRaceHistory(_m *Character) *CharacterRaceHistoryQ
 	return query
 }
 
+// QueryAchievements queries the achievements edge of a Character.
+func (c *CharacterClient) QueryAchievements(_m *Character) *CharacterAchievementQuery {
+	query := (&CharacterAchievementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(character.Table, character.FieldID, id),
+			sqlgraph.To(characterachievement.Table, characterachievement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, character.AchievementsTable, character.AchievementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParty queries the party edge of a Character.
+func (c *CharacterClient) QueryParty(_m *Character) *PartyQuery {
+	query := (&PartyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(character.Table, character.FieldID, id),
+			sqlgraph.To(party.Table, party.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, character.PartyTable, character.PartyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CharacterClient) Hooks() []Hook {
 	return c.hooks.Character
@@ -2088,6 +2379,171 @@ func (c *CharacterAbilityClient) mutate(ctx context.Context, m *CharacterAbility
 	}
 }
 
+// CharacterAchievementClient is a client for the CharacterAchievement schema.
+type CharacterAchievementClient struct {
+	config
+}
+
+// NewCharacterAchievementClient returns a client for the CharacterAchievement from the given config.
+func NewCharacterAchievementClient(c config) *CharacterAchievementClient {
+	return &CharacterAchievementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `characterachievement.Hooks(f(g(h())))`.
+func (c *CharacterAchievementClient) Use(hooks ...Hook) {
+	c.hooks.CharacterAchievement = append(c.hooks.CharacterAchievement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `characterachievement.Intercept(f(g(h())))`.
+func (c *CharacterAchievementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CharacterAchievement = append(c.inters.CharacterAchievement, interceptors...)
+}
+
+// Create returns a builder for creating a CharacterAchievement entity.
+func (c *CharacterAchievementClient) Create() *CharacterAchievementCreate {
+	mutation := newCharacterAchievementMutation(c.config, OpCreate)
+	return &CharacterAchievementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CharacterAchievement entities.
+func (c *CharacterAchievementClient) CreateBulk(builders ...*CharacterAchievementCreate) *CharacterAchievementCreateBulk {
+	return &CharacterAchievementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CharacterAchievementClient) MapCreateBulk(slice any, setFunc func(*CharacterAchievementCreate, int)) *CharacterAchievementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CharacterAchievementCreateBulk{err: fmt.Errorf("calling to CharacterAchievementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CharacterAchievementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CharacterAchievementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CharacterAchievement.
+func (c *CharacterAchievementClient) Update() *CharacterAchievementUpdate {
+	mutation := newCharacterAchievementMutation(c.config, OpUpdate)
+	return &CharacterAchievementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CharacterAchievementClient) UpdateOne(_m *CharacterAchievement) *CharacterAchievementUpdateOne {
+	mutation := newCharacterAchievementMutation(c.config, OpUpdateOne, withCharacterAchievement(_m))
+	return &CharacterAchievementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CharacterAchievementClient) UpdateOneID(id int) *CharacterAchievementUpdateOne {
+	mutation := newCharacterAchievementMutation(c.config, OpUpdateOne, withCharacterAchievementID(id))
+	return &CharacterAchievementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CharacterAchievement.
+func (c *CharacterAchievementClient) Delete() *CharacterAchievementDelete {
+	mutation := newCharacterAchievementMutation(c.config, OpDelete)
+	return &CharacterAchievementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CharacterAchievementClient) DeleteOne(_m *CharacterAchievement) *CharacterAchievementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CharacterAchievementClient) DeleteOneID(id int) *CharacterAchievementDeleteOne {
+	builder := c.Delete().Where(characterachievement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CharacterAchievementDeleteOne{builder}
+}
+
+// Query returns a query builder for CharacterAchievement.
+func (c *CharacterAchievementClient) Query() *CharacterAchievementQuery {
+	return &CharacterAchievementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCharacterAchievement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CharacterAchievement entity by its id.
+func (c *CharacterAchievementClient) Get(ctx context.Context, id int) (*CharacterAchievement, error) {
+	return c.Query().Where(characterachievement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CharacterAchievementClient) GetX(ctx context.Context, id int) *CharacterAchievement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCharacter queries the character edge of a CharacterAchievement.
+func (c *CharacterAchievementClient) QueryCharacter(_m *CharacterAchievement) *CharacterQuery {
+	query := (&CharacterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(characterachievement.Table, characterachievement.FieldID, id),
+			sqlgraph.To(character.Table, character.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, characterachievement.CharacterTable, characterachievement.CharacterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAchievement queries the achievement edge of a CharacterAchievement.
+func (c *CharacterAchievementClient) QueryAchievement(_m *CharacterAchievement) *AchievementQuery {
+	query := (&AchievementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(characterachievement.Table, characterachievement.FieldID, id),
+			sqlgraph.To(achievement.Table, achievement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, characterachievement.AchievementTable, characterachievement.AchievementColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CharacterAchievementClient) Hooks() []Hook {
+	return c.hooks.CharacterAchievement
+}
+
+// Interceptors returns the client interceptors.
+func (c *CharacterAchievementClient) Interceptors() []Interceptor {
+	return c.inters.CharacterAchievement
+}
+
+func (c *CharacterAchievementClient) mutate(ctx context.Context, m *CharacterAchievementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CharacterAchievementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CharacterAchievementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CharacterAchievementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CharacterAchievementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown CharacterAchievement mutation op: %q", m.Op())
+	}
+}
+
 // CharacterChannelClient is a client for the CharacterChannel schema.
 type CharacterChannelClient struct {
 	config
@@ -3924,107 +4380,107 @@ func (c *DamageLogClient) mutate(ctx context.Context, m *DamageLogMutation) (Val
 	}
 }
 
-// DialogNodeClient is a client for the DialogNode schema.
-type DialogNodeClient struct {
+// DeadLetterClient is a client for the DeadLetter schema.
+type DeadLetterClient struct {
 	config
 }
 
-// NewDialogNodeClient returns a client for the DialogNode from the given config.
-func NewDialogNodeClient(c config) *DialogNodeClient {
-	return &DialogNodeClient{config: c}
+// NewDeadLetterClient returns a client for the DeadLetter from the given config.
+func NewDeadLetterClient(c config) *DeadLetterClient {
+	return &DeadLetterClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `dialognode.Hooks(f(g(h())))`.
-func (c *DialogNodeClient) Use(hooks ...Hook) {
-	c.hooks.DialogNode = append(c.hooks.DialogNode, hooks...)
+// A call to `Use(f, g, h)` equals to `deadletter.Hooks(f(g(h())))`.
+func (c *DeadLetterClient) Use(hooks ...Hook) {
+	c.hooks.DeadLetter = append(c.hooks.DeadLetter, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `dialognode.Intercept(f(g(h())))`.
-func (c *DialogNodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DialogNode = append(c.inters.DialogNode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deadletter.Intercept(f(g(h())))`.
+func (c *DeadLetterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeadLetter = append(c.inters.DeadLetter, interceptors...)
 }
 
-// Create returns a builder for creating a DialogNode entity.
-func (c *DialogNodeClient) Create() *DialogNodeCreate {
-	mutation := newDialogNodeMutation(c.config, OpCreate)
-	return &DialogNodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeadLetter entity.
+func (c *DeadLetterClient) Create() *DeadLetterCreate {
+	mutation := newDeadLetterMutation(c.config, OpCreate)
+	return &DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DialogNode entities.
-func (c *DialogNodeClient) CreateBulk(builders ...*DialogNodeCreate) *DialogNodeCreateBulk {
-	return &DialogNodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeadLetter entities.
+func (c *DeadLetterClient) CreateBulk(builders ...*DeadLetterCreate) *DeadLetterCreateBulk {
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DialogNodeClient) MapCreateBulk(slice any, setFunc func(*DialogNodeCreate, int)) *DialogNodeCreateBulk {
+func (c *DeadLetterClient) MapCreateBulk(slice any, setFunc func(*DeadLetterCreate, int)) *DeadLetterCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DialogNodeCreateBulk{err: fmt.Errorf("calling to DialogNodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeadLetterCreateBulk{err: fmt.Errorf("calling to DeadLetterClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DialogNodeCreate, rv.Len())
+	builders := make([]*DeadLetterCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DialogNodeCreateBulk{config: c.config, builders: builders}
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DialogNode.
-func (c *DialogNodeClient) Update() *DialogNodeUpdate {
-	mutation := newDialogNodeMutation(c.config, OpUpdate)
-	return &DialogNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeadLetter.
+func (c *DeadLetterClient) Update() *DeadLetterUpdate {
+	mutation := newDeadLetterMutation(c.config, OpUpdate)
+	return &DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DialogNodeClient) UpdateOne(_m *DialogNode) *DialogNodeUpdateOne {
-	mutation := newDialogNodeMutation(c.config, OpUpdateOne, withDialogNode(_m))
-	return &DialogNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeadLetterClient) UpdateOne(_m *DeadLetter) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetter(_m))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DialogNodeClient) UpdateOneID(id string) *DialogNodeUpdateOne {
-	mutation := newDialogNodeMutation(c.config, OpUpdateOne, withDialogNodeID(id))
-	return &DialogNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeadLetterClient) UpdateOneID(id int) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetterID(id))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DialogNode.
-func (c *DialogNodeClient) Delete() *DialogNodeDelete {
-	mutation := newDialogNodeMutation(c.config, OpDelete)
-	return &DialogNodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeadLetter.
+func (c *DeadLetterClient) Delete() *DeadLetterDelete {
+	mutation := newDeadLetterMutation(c.config, OpDelete)
+	return &DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DialogNodeClient) DeleteOne(_m *DialogNode) *DialogNodeDeleteOne {
+func (c *DeadLetterClient) DeleteOne(_m *DeadLetter) *DeadLetterDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DialogNodeClient) DeleteOneID(id string) *DialogNodeDeleteOne {
-	builder := c.Delete().Where(dialognode.ID(id))
+func (c *DeadLetterClient) DeleteOneID(id int) *DeadLetterDeleteOne {
+	builder := c.Delete().Where(deadletter.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DialogNodeDeleteOne{builder}
+	return &DeadLetterDeleteOne{builder}
 }
 
-// Query returns a query builder for DialogNode.
-func (c *DialogNodeClient) Query() *DialogNodeQuery {
-	return &DialogNodeQuery{
+// Query returns a query builder for DeadLetter.
+func (c *DeadLetterClient) Query() *DeadLetterQuery {
+	return &DeadLetterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDialogNode},
+		ctx:    &QueryContext{Type: TypeDeadLetter},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DialogNode entity by its id.
-func (c *DialogNodeClient) Get(ctx context.Context, id string) (*DialogNode, error) {
-	return c.Query().Where(dialognode.ID(id)).Only(ctx)
+// Get returns a DeadLetter entity by its id.
+func (c *DeadLetterClient) Get(ctx context.Context, id int) (*DeadLetter, error) {
+	return c.Query().Where(deadletter.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DialogNodeClient) GetX(ctx context.Context, id string) *DialogNode {
+func (c *DeadLetterClient) GetX(ctx context.Context, id int) *DeadLetter {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4032,10 +4488,143 @@ func (c *DialogNodeClient) GetX(ctx context.Context, id string) *DialogNode {
 	return obj
 }
 
-// QueryNpcTemplate queries the npc_template edge of a DialogNode.
-func (c *DialogNodeClient) QueryNpcTemplate(_m *DialogNode) *NPCTemplateQuery {
-	query := (&NPCTemplateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+// Hooks returns the client hooks.
+func (c *DeadLetterClient) Hooks() []Hook {
+	return c.hooks.DeadLetter
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeadLetterClient) Interceptors() []Interceptor {
+	return c.inters.DeadLetter
+}
+
+func (c *DeadLetterClient) mutate(ctx context.Context, m *DeadLetterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown DeadLetter mutation op: %q", m.Op())
+	}
+}
+
+// DialogNodeClient is a client for the DialogNode schema.
+type DialogNodeClient struct {
+	config
+}
+
+// NewDialogNodeClient returns a client for the DialogNode from the given config.
+func NewDialogNodeClient(c config) *DialogNodeClient {
+	return &DialogNodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dialognode.Hooks(f(g(h())))`.
+func (c *DialogNodeClient) Use(hooks ...Hook) {
+	c.hooks.DialogNode = append(c.hooks.DialogNode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dialognode.Intercept(f(g(h())))`.
+func (c *DialogNodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DialogNode = append(c.inters.DialogNode, interceptors...)
+}
+
+// Create returns a builder for creating a DialogNode entity.
+func (c *DialogNodeClient) Create() *DialogNodeCreate {
+	mutation := newDialogNodeMutation(c.config, OpCreate)
+	return &DialogNodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DialogNode entities.
+func (c *DialogNodeClient) CreateBulk(builders ...*DialogNodeCreate) *DialogNodeCreateBulk {
+	return &DialogNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DialogNodeClient) MapCreateBulk(slice any, setFunc func(*DialogNodeCreate, int)) *DialogNodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DialogNodeCreateBulk{err: fmt.Errorf("calling to DialogNodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DialogNodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DialogNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DialogNode.
+func (c *DialogNodeClient) Update() *DialogNodeUpdate {
+	mutation := newDialogNodeMutation(c.config, OpUpdate)
+	return &DialogNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DialogNodeClient) UpdateOne(_m *DialogNode) *DialogNodeUpdateOne {
+	mutation := newDialogNodeMutation(c.config, OpUpdateOne, withDialogNode(_m))
+	return &DialogNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DialogNodeClient) UpdateOneID(id string) *DialogNodeUpdateOne {
+	mutation := newDialogNodeMutation(c.config, OpUpdateOne, withDialogNodeID(id))
+	return &DialogNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DialogNode.
+func (c *DialogNodeClient) Delete() *DialogNodeDelete {
+	mutation := newDialogNodeMutation(c.config, OpDelete)
+	return &DialogNodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DialogNodeClient) DeleteOne(_m *DialogNode) *DialogNodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DialogNodeClient) DeleteOneID(id string) *DialogNodeDeleteOne {
+	builder := c.Delete().Where(dialognode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DialogNodeDeleteOne{builder}
+}
+
+// Query returns a query builder for DialogNode.
+func (c *DialogNodeClient) Query() *DialogNodeQuery {
+	return &DialogNodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDialogNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DialogNode entity by its id.
+func (c *DialogNodeClient) Get(ctx context.Context, id string) (*DialogNode, error) {
+	return c.Query().Where(dialognode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DialogNodeClient) GetX(ctx context.Context, id string) *DialogNode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNpcTemplate queries the npc_template edge of a DialogNode.
+func (c *DialogNodeClient) QueryNpcTemplate(_m *DialogNode) *NPCTemplateQuery {
+	query := (&NPCTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(dialognode.Table, dialognode.FieldID, id),
@@ -4089,6 +4678,139 @@ func (c *DialogNodeClient) mutate(ctx context.Context, m *DialogNodeMutation) (V
 	}
 }
 
+// DialogStateClient is a client for the DialogState schema.
+type DialogStateClient struct {
+	config
+}
+
+// NewDialogStateClient returns a client for the DialogState from the given config.
+func NewDialogStateClient(c config) *DialogStateClient {
+	return &DialogStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dialogstate.Hooks(f(g(h())))`.
+func (c *DialogStateClient) Use(hooks ...Hook) {
+	c.hooks.DialogState = append(c.hooks.DialogState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dialogstate.Intercept(f(g(h())))`.
+func (c *DialogStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DialogState = append(c.inters.DialogState, interceptors...)
+}
+
+// Create returns a builder for creating a DialogState entity.
+func (c *DialogStateClient) Create() *DialogStateCreate {
+	mutation := newDialogStateMutation(c.config, OpCreate)
+	return &DialogStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DialogState entities.
+func (c *DialogStateClient) CreateBulk(builders ...*DialogStateCreate) *DialogStateCreateBulk {
+	return &DialogStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DialogStateClient) MapCreateBulk(slice any, setFunc func(*DialogStateCreate, int)) *DialogStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DialogStateCreateBulk{err: fmt.Errorf("calling to DialogStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DialogStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DialogStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DialogState.
+func (c *DialogStateClient) Update() *DialogStateUpdate {
+	mutation := newDialogStateMutation(c.config, OpUpdate)
+	return &DialogStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DialogStateClient) UpdateOne(_m *DialogState) *DialogStateUpdateOne {
+	mutation := newDialogStateMutation(c.config, OpUpdateOne, withDialogState(_m))
+	return &DialogStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DialogStateClient) UpdateOneID(id int) *DialogStateUpdateOne {
+	mutation := newDialogStateMutation(c.config, OpUpdateOne, withDialogStateID(id))
+	return &DialogStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DialogState.
+func (c *DialogStateClient) Delete() *DialogStateDelete {
+	mutation := newDialogStateMutation(c.config, OpDelete)
+	return &DialogStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DialogStateClient) DeleteOne(_m *DialogState) *DialogStateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DialogStateClient) DeleteOneID(id int) *DialogStateDeleteOne {
+	builder := c.Delete().Where(dialogstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DialogStateDeleteOne{builder}
+}
+
+// Query returns a query builder for DialogState.
+func (c *DialogStateClient) Query() *DialogStateQuery {
+	return &DialogStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDialogState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DialogState entity by its id.
+func (c *DialogStateClient) Get(ctx context.Context, id int) (*DialogState, error) {
+	return c.Query().Where(dialogstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DialogStateClient) GetX(ctx context.Context, id int) *DialogState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DialogStateClient) Hooks() []Hook {
+	return c.hooks.DialogState
+}
+
+// Interceptors returns the client interceptors.
+func (c *DialogStateClient) Interceptors() []Interceptor {
+	return c.inters.DialogState
+}
+
+func (c *DialogStateClient) mutate(ctx context.Context, m *DialogStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DialogStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DialogStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DialogStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DialogStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown DialogState mutation op: %q", m.Op())
+	}
+}
+
 // EffectClient is a client for the Effect schema.
 type EffectClient struct {
 	config
@@ -4765,107 +5487,107 @@ func (c *EquipmentTemplateClient) mutate(ctx context.Context, m *EquipmentTempla
 	}
 }
 
-// FactionClient is a client for the Faction schema.
-type FactionClient struct {
+// EventCursorClient is a client for the EventCursor schema.
+type EventCursorClient struct {
 	config
 }
 
-// NewFactionClient returns a client for the Faction from the given config.
-func NewFactionClient(c config) *FactionClient {
-	return &FactionClient{config: c}
+// NewEventCursorClient returns a client for the EventCursor from the given config.
+func NewEventCursorClient(c config) *EventCursorClient {
+	return &EventCursorClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `faction.Hooks(f(g(h())))`.
-func (c *FactionClient) Use(hooks ...Hook) {
-	c.hooks.Faction = append(c.hooks.Faction, hooks...)
+// A call to `Use(f, g, h)` equals to `eventcursor.Hooks(f(g(h())))`.
+func (c *EventCursorClient) Use(hooks ...Hook) {
+	c.hooks.EventCursor = append(c.hooks.EventCursor, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `faction.Intercept(f(g(h())))`.
-func (c *FactionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Faction = append(c.inters.Faction, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `eventcursor.Intercept(f(g(h())))`.
+func (c *EventCursorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventCursor = append(c.inters.EventCursor, interceptors...)
 }
 
-// Create returns a builder for creating a Faction entity.
-func (c *FactionClient) Create() *FactionCreate {
-	mutation := newFactionMutation(c.config, OpCreate)
-	return &FactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EventCursor entity.
+func (c *EventCursorClient) Create() *EventCursorCreate {
+	mutation := newEventCursorMutation(c.config, OpCreate)
+	return &EventCursorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Faction entities.
-func (c *FactionClient) CreateBulk(builders ...*FactionCreate) *FactionCreateBulk {
-	return &FactionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EventCursor entities.
+func (c *EventCursorClient) CreateBulk(builders ...*EventCursorCreate) *EventCursorCreateBulk {
+	return &EventCursorCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FactionClient) MapCreateBulk(slice any, setFunc func(*FactionCreate, int)) *FactionCreateBulk {
+func (c *EventCursorClient) MapCreateBulk(slice any, setFunc func(*EventCursorCreate, int)) *EventCursorCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FactionCreateBulk{err: fmt.Errorf("calling to FactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EventCursorCreateBulk{err: fmt.Errorf("calling to EventCursorClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FactionCreate, rv.Len())
+	builders := make([]*EventCursorCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FactionCreateBulk{config: c.config, builders: builders}
+	return &EventCursorCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Faction.
-func (c *FactionClient) Update() *FactionUpdate {
-	mutation := newFactionMutation(c.config, OpUpdate)
-	return &FactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EventCursor.
+func (c *EventCursorClient) Update() *EventCursorUpdate {
+	mutation := newEventCursorMutation(c.config, OpUpdate)
+	return &EventCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FactionClient) UpdateOne(_m *Faction) *FactionUpdateOne {
-	mutation := newFactionMutation(c.config, OpUpdateOne, withFaction(_m))
-	return &FactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EventCursorClient) UpdateOne(_m *EventCursor) *EventCursorUpdateOne {
+	mutation := newEventCursorMutation(c.config, OpUpdateOne, withEventCursor(_m))
+	return &EventCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FactionClient) UpdateOneID(id int) *FactionUpdateOne {
-	mutation := newFactionMutation(c.config, OpUpdateOne, withFactionID(id))
-	return &FactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EventCursorClient) UpdateOneID(id string) *EventCursorUpdateOne {
+	mutation := newEventCursorMutation(c.config, OpUpdateOne, withEventCursorID(id))
+	return &EventCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Faction.
-func (c *FactionClient) Delete() *FactionDelete {
-	mutation := newFactionMutation(c.config, OpDelete)
-	return &FactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EventCursor.
+func (c *EventCursorClient) Delete() *EventCursorDelete {
+	mutation := newEventCursorMutation(c.config, OpDelete)
+	return &EventCursorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FactionClient) DeleteOne(_m *Faction) *FactionDeleteOne {
+func (c *EventCursorClient) DeleteOne(_m *EventCursor) *EventCursorDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FactionClient) DeleteOneID(id int) *FactionDeleteOne {
-	builder := c.Delete().Where(faction.ID(id))
+func (c *EventCursorClient) DeleteOneID(id string) *EventCursorDeleteOne {
+	builder := c.Delete().Where(eventcursor.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FactionDeleteOne{builder}
+	return &EventCursorDeleteOne{builder}
 }
 
-// Query returns a query builder for Faction.
-func (c *FactionClient) Query() *FactionQuery {
-	return &FactionQuery{
+// Query returns a query builder for EventCursor.
+func (c *EventCursorClient) Query() *EventCursorQuery {
+	return &EventCursorQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFaction},
+		ctx:    &QueryContext{Type: TypeEventCursor},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Faction entity by its id.
-func (c *FactionClient) Get(ctx context.Context, id int) (*Faction, error) {
-	return c.Query().Where(faction.ID(id)).Only(ctx)
+// Get returns a EventCursor entity by its id.
+func (c *EventCursorClient) Get(ctx context.Context, id string) (*EventCursor, error) {
+	return c.Query().Where(eventcursor.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FactionClient) GetX(ctx context.Context, id int) *Faction {
+func (c *EventCursorClient) GetX(ctx context.Context, id string) *EventCursor {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4873,12 +5595,145 @@ func (c *FactionClient) GetX(ctx context.Context, id int) *Faction {
 	return obj
 }
 
-// QueryCategory queries the category edge of a Faction.
-func (c *FactionClient) QueryCategory(_m *Faction) *FactionCategoryQuery {
-	query := (&FactionCategoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
+// Hooks returns the client hooks.
+func (c *EventCursorClient) Hooks() []Hook {
+	return c.hooks.EventCursor
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventCursorClient) Interceptors() []Interceptor {
+	return c.inters.EventCursor
+}
+
+func (c *EventCursorClient) mutate(ctx context.Context, m *EventCursorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventCursorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventCursorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown EventCursor mutation op: %q", m.Op())
+	}
+}
+
+// FactionClient is a client for the Faction schema.
+type FactionClient struct {
+	config
+}
+
+// NewFactionClient returns a client for the Faction from the given config.
+func NewFactionClient(c config) *FactionClient {
+	return &FactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `faction.Hooks(f(g(h())))`.
+func (c *FactionClient) Use(hooks ...Hook) {
+	c.hooks.Faction = append(c.hooks.Faction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `faction.Intercept(f(g(h())))`.
+func (c *FactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Faction = append(c.inters.Faction, interceptors...)
+}
+
+// Create returns a builder for creating a Faction entity.
+func (c *FactionClient) Create() *FactionCreate {
+	mutation := newFactionMutation(c.config, OpCreate)
+	return &FactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Faction entities.
+func (c *FactionClient) CreateBulk(builders ...*FactionCreate) *FactionCreateBulk {
+	return &FactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FactionClient) MapCreateBulk(slice any, setFunc func(*FactionCreate, int)) *FactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FactionCreateBulk{err: fmt.Errorf("calling to FactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Faction.
+func (c *FactionClient) Update() *FactionUpdate {
+	mutation := newFactionMutation(c.config, OpUpdate)
+	return &FactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FactionClient) UpdateOne(_m *Faction) *FactionUpdateOne {
+	mutation := newFactionMutation(c.config, OpUpdateOne, withFaction(_m))
+	return &FactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FactionClient) UpdateOneID(id int) *FactionUpdateOne {
+	mutation := newFactionMutation(c.config, OpUpdateOne, withFactionID(id))
+	return &FactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Faction.
+func (c *FactionClient) Delete() *FactionDelete {
+	mutation := newFactionMutation(c.config, OpDelete)
+	return &FactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FactionClient) DeleteOne(_m *Faction) *FactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FactionClient) DeleteOneID(id int) *FactionDeleteOne {
+	builder := c.Delete().Where(faction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FactionDeleteOne{builder}
+}
+
+// Query returns a query builder for Faction.
+func (c *FactionClient) Query() *FactionQuery {
+	return &FactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Faction entity by its id.
+func (c *FactionClient) Get(ctx context.Context, id int) (*Faction, error) {
+	return c.Query().Where(faction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FactionClient) GetX(ctx context.Context, id int) *Faction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCategory queries the category edge of a Faction.
+func (c *FactionClient) QueryCategory(_m *Faction) *FactionCategoryQuery {
+	query := (&FactionCategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
 			sqlgraph.From(faction.Table, faction.FieldID, id),
 			sqlgraph.To(factioncategory.Table, factioncategory.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, faction.CategoryTable, faction.CategoryColumn),
@@ -5542,107 +6397,107 @@ func (c *GenderClient) mutate(ctx context.Context, m *GenderMutation) (Value, er
 	}
 }
 
-// NPCAbilityClient is a client for the NPCAbility schema.
-type NPCAbilityClient struct {
+// HouseClient is a client for the House schema.
+type HouseClient struct {
 	config
 }
 
-// NewNPCAbilityClient returns a client for the NPCAbility from the given config.
-func NewNPCAbilityClient(c config) *NPCAbilityClient {
-	return &NPCAbilityClient{config: c}
+// NewHouseClient returns a client for the House from the given config.
+func NewHouseClient(c config) *HouseClient {
+	return &HouseClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `npcability.Hooks(f(g(h())))`.
-func (c *NPCAbilityClient) Use(hooks ...Hook) {
-	c.hooks.NPCAbility = append(c.hooks.NPCAbility, hooks...)
+// A call to `Use(f, g, h)` equals to `house.Hooks(f(g(h())))`.
+func (c *HouseClient) Use(hooks ...Hook) {
+	c.hooks.House = append(c.hooks.House, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `npcability.Intercept(f(g(h())))`.
-func (c *NPCAbilityClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NPCAbility = append(c.inters.NPCAbility, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `house.Intercept(f(g(h())))`.
+func (c *HouseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.House = append(c.inters.House, interceptors...)
 }
 
-// Create returns a builder for creating a NPCAbility entity.
-func (c *NPCAbilityClient) Create() *NPCAbilityCreate {
-	mutation := newNPCAbilityMutation(c.config, OpCreate)
-	return &NPCAbilityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a House entity.
+func (c *HouseClient) Create() *HouseCreate {
+	mutation := newHouseMutation(c.config, OpCreate)
+	return &HouseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NPCAbility entities.
-func (c *NPCAbilityClient) CreateBulk(builders ...*NPCAbilityCreate) *NPCAbilityCreateBulk {
-	return &NPCAbilityCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of House entities.
+func (c *HouseClient) CreateBulk(builders ...*HouseCreate) *HouseCreateBulk {
+	return &HouseCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NPCAbilityClient) MapCreateBulk(slice any, setFunc func(*NPCAbilityCreate, int)) *NPCAbilityCreateBulk {
+func (c *HouseClient) MapCreateBulk(slice any, setFunc func(*HouseCreate, int)) *HouseCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NPCAbilityCreateBulk{err: fmt.Errorf("calling to NPCAbilityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &HouseCreateBulk{err: fmt.Errorf("calling to HouseClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NPCAbilityCreate, rv.Len())
+	builders := make([]*HouseCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NPCAbilityCreateBulk{config: c.config, builders: builders}
+	return &HouseCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NPCAbility.
-func (c *NPCAbilityClient) Update() *NPCAbilityUpdate {
-	mutation := newNPCAbilityMutation(c.config, OpUpdate)
-	return &NPCAbilityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for House.
+func (c *HouseClient) Update() *HouseUpdate {
+	mutation := newHouseMutation(c.config, OpUpdate)
+	return &HouseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NPCAbilityClient) UpdateOne(_m *NPCAbility) *NPCAbilityUpdateOne {
-	mutation := newNPCAbilityMutation(c.config, OpUpdateOne, withNPCAbility(_m))
-	return &NPCAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HouseClient) UpdateOne(_m *House) *HouseUpdateOne {
+	mutation := newHouseMutation(c.config, OpUpdateOne, withHouse(_m))
+	return &HouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NPCAbilityClient) UpdateOneID(id int) *NPCAbilityUpdateOne {
-	mutation := newNPCAbilityMutation(c.config, OpUpdateOne, withNPCAbilityID(id))
-	return &NPCAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HouseClient) UpdateOneID(id int) *HouseUpdateOne {
+	mutation := newHouseMutation(c.config, OpUpdateOne, withHouseID(id))
+	return &HouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NPCAbility.
-func (c *NPCAbilityClient) Delete() *NPCAbilityDelete {
-	mutation := newNPCAbilityMutation(c.config, OpDelete)
-	return &NPCAbilityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for House.
+func (c *HouseClient) Delete() *HouseDelete {
+	mutation := newHouseMutation(c.config, OpDelete)
+	return &HouseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NPCAbilityClient) DeleteOne(_m *NPCAbility) *NPCAbilityDeleteOne {
+func (c *HouseClient) DeleteOne(_m *House) *HouseDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NPCAbilityClient) DeleteOneID(id int) *NPCAbilityDeleteOne {
-	builder := c.Delete().Where(npcability.ID(id))
+func (c *HouseClient) DeleteOneID(id int) *HouseDeleteOne {
+	builder := c.Delete().Where(house.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NPCAbilityDeleteOne{builder}
+	return &HouseDeleteOne{builder}
 }
 
-// Query returns a query builder for NPCAbility.
-func (c *NPCAbilityClient) Query() *NPCAbilityQuery {
-	return &NPCAbilityQuery{
+// Query returns a query builder for House.
+func (c *HouseClient) Query() *HouseQuery {
+	return &HouseQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNPCAbility},
+		ctx:    &QueryContext{Type: TypeHouse},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NPCAbility entity by its id.
-func (c *NPCAbilityClient) Get(ctx context.Context, id int) (*NPCAbility, error) {
-	return c.Query().Where(npcability.ID(id)).Only(ctx)
+// Get returns a House entity by its id.
+func (c *HouseClient) Get(ctx context.Context, id int) (*House, error) {
+	return c.Query().Where(house.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NPCAbilityClient) GetX(ctx context.Context, id int) *NPCAbility {
+func (c *HouseClient) GetX(ctx context.Context, id int) *House {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -5650,137 +6505,403 @@ func (c *NPCAbilityClient) GetX(ctx context.Context, id int) *NPCAbility {
 	return obj
 }
 
-// QueryNpcTemplate queries the npc_template edge of a NPCAbility.
-func (c *NPCAbilityClient) QueryNpcTemplate(_m *NPCAbility) *NPCTemplateQuery {
-	query := (&NPCTemplateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(npcability.Table, npcability.FieldID, id),
-			sqlgraph.To(npctemplate.Table, npctemplate.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, npcability.NpcTemplateTable, npcability.NpcTemplatePrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAbility queries the ability edge of a NPCAbility.
-func (c *NPCAbilityClient) QueryAbility(_m *NPCAbility) *AbilityQuery {
-	query := (&AbilityClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(npcability.Table, npcability.FieldID, id),
-			sqlgraph.To(ability.Table, ability.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, npcability.AbilityTable, npcability.AbilityPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *NPCAbilityClient) Hooks() []Hook {
-	return c.hooks.NPCAbility
+func (c *HouseClient) Hooks() []Hook {
+	return c.hooks.House
 }
 
 // Interceptors returns the client interceptors.
-func (c *NPCAbilityClient) Interceptors() []Interceptor {
-	return c.inters.NPCAbility
+func (c *HouseClient) Interceptors() []Interceptor {
+	return c.inters.House
 }
 
-func (c *NPCAbilityClient) mutate(ctx context.Context, m *NPCAbilityMutation) (Value, error) {
+func (c *HouseClient) mutate(ctx context.Context, m *HouseMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NPCAbilityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HouseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NPCAbilityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HouseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NPCAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NPCAbilityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&HouseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("db: unknown NPCAbility mutation op: %q", m.Op())
+		return nil, fmt.Errorf("db: unknown House mutation op: %q", m.Op())
 	}
 }
 
-// NPCTemplateClient is a client for the NPCTemplate schema.
-type NPCTemplateClient struct {
+// MailClient is a client for the Mail schema.
+type MailClient struct {
 	config
 }
 
-// NewNPCTemplateClient returns a client for the NPCTemplate from the given config.
-func NewNPCTemplateClient(c config) *NPCTemplateClient {
-	return &NPCTemplateClient{config: c}
+// NewMailClient returns a client for the Mail from the given config.
+func NewMailClient(c config) *MailClient {
+	return &MailClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `npctemplate.Hooks(f(g(h())))`.
-func (c *NPCTemplateClient) Use(hooks ...Hook) {
-	c.hooks.NPCTemplate = append(c.hooks.NPCTemplate, hooks...)
+// A call to `Use(f, g, h)` equals to `mail.Hooks(f(g(h())))`.
+func (c *MailClient) Use(hooks ...Hook) {
+	c.hooks.Mail = append(c.hooks.Mail, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `npctemplate.Intercept(f(g(h())))`.
-func (c *NPCTemplateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NPCTemplate = append(c.inters.NPCTemplate, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `mail.Intercept(f(g(h())))`.
+func (c *MailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Mail = append(c.inters.Mail, interceptors...)
 }
 
-// Create returns a builder for creating a NPCTemplate entity.
-func (c *NPCTemplateClient) Create() *NPCTemplateCreate {
-	mutation := newNPCTemplateMutation(c.config, OpCreate)
-	return &NPCTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Mail entity.
+func (c *MailClient) Create() *MailCreate {
+	mutation := newMailMutation(c.config, OpCreate)
+	return &MailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NPCTemplate entities.
-func (c *NPCTemplateClient) CreateBulk(builders ...*NPCTemplateCreate) *NPCTemplateCreateBulk {
-	return &NPCTemplateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Mail entities.
+func (c *MailClient) CreateBulk(builders ...*MailCreate) *MailCreateBulk {
+	return &MailCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NPCTemplateClient) MapCreateBulk(slice any, setFunc func(*NPCTemplateCreate, int)) *NPCTemplateCreateBulk {
+func (c *MailClient) MapCreateBulk(slice any, setFunc func(*MailCreate, int)) *MailCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NPCTemplateCreateBulk{err: fmt.Errorf("calling to NPCTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MailCreateBulk{err: fmt.Errorf("calling to MailClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NPCTemplateCreate, rv.Len())
+	builders := make([]*MailCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NPCTemplateCreateBulk{config: c.config, builders: builders}
+	return &MailCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NPCTemplate.
-func (c *NPCTemplateClient) Update() *NPCTemplateUpdate {
-	mutation := newNPCTemplateMutation(c.config, OpUpdate)
-	return &NPCTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Mail.
+func (c *MailClient) Update() *MailUpdate {
+	mutation := newMailMutation(c.config, OpUpdate)
+	return &MailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NPCTemplateClient) UpdateOne(_m *NPCTemplate) *NPCTemplateUpdateOne {
-	mutation := newNPCTemplateMutation(c.config, OpUpdateOne, withNPCTemplate(_m))
-	return &NPCTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MailClient) UpdateOne(_m *Mail) *MailUpdateOne {
+	mutation := newMailMutation(c.config, OpUpdateOne, withMail(_m))
+	return &MailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NPCTemplateClient) UpdateOneID(id string) *NPCTemplateUpdateOne {
-	mutation := newNPCTemplateMutation(c.config, OpUpdateOne, withNPCTemplateID(id))
-	return &NPCTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MailClient) UpdateOneID(id int) *MailUpdateOne {
+	mutation := newMailMutation(c.config, OpUpdateOne, withMailID(id))
+	return &MailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NPCTemplate.
-func (c *NPCTemplateClient) Delete() *NPCTemplateDelete {
-	mutation := newNPCTemplateMutation(c.config, OpDelete)
-	return &NPCTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Mail.
+func (c *MailClient) Delete() *MailDelete {
+	mutation := newMailMutation(c.config, OpDelete)
+	return &MailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NPCTemplateClient) DeleteOne(_m *NPCTemplate) *NPCTemplateDeleteOne {
+func (c *MailClient) DeleteOne(_m *Mail) *MailDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MailClient) DeleteOneID(id int) *MailDeleteOne {
+	builder := c.Delete().Where(mail.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MailDeleteOne{builder}
+}
+
+// Query returns a query builder for Mail.
+func (c *MailClient) Query() *MailQuery {
+	return &MailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Mail entity by its id.
+func (c *MailClient) Get(ctx context.Context, id int) (*Mail, error) {
+	return c.Query().Where(mail.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MailClient) GetX(ctx context.Context, id int) *Mail {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MailClient) Hooks() []Hook {
+	return c.hooks.Mail
+}
+
+// Interceptors returns the client interceptors.
+func (c *MailClient) Interceptors() []Interceptor {
+	return c.inters.Mail
+}
+
+func (c *MailClient) mutate(ctx context.Context, m *MailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Mail mutation op: %q", m.Op())
+	}
+}
+
+// NPCAbilityClient is a client for the NPCAbility schema.
+type NPCAbilityClient struct {
+	config
+}
+
+// NewNPCAbilityClient returns a client for the NPCAbility from the given config.
+func NewNPCAbilityClient(c config) *NPCAbilityClient {
+	return &NPCAbilityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `npcability.Hooks(f(g(h())))`.
+func (c *NPCAbilityClient) Use(hooks ...Hook) {
+	c.hooks.NPCAbility = append(c.hooks.NPCAbility, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `npcability.Intercept(f(g(h())))`.
+func (c *NPCAbilityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NPCAbility = append(c.inters.NPCAbility, interceptors...)
+}
+
+// Create returns a builder for creating a NPCAbility entity.
+func (c *NPCAbilityClient) Create() *NPCAbilityCreate {
+	mutation := newNPCAbilityMutation(c.config, OpCreate)
+	return &NPCAbilityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NPCAbility entities.
+func (c *NPCAbilityClient) CreateBulk(builders ...*NPCAbilityCreate) *NPCAbilityCreateBulk {
+	return &NPCAbilityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NPCAbilityClient) MapCreateBulk(slice any, setFunc func(*NPCAbilityCreate, int)) *NPCAbilityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NPCAbilityCreateBulk{err: fmt.Errorf("calling to NPCAbilityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NPCAbilityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NPCAbilityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NPCAbility.
+func (c *NPCAbilityClient) Update() *NPCAbilityUpdate {
+	mutation := newNPCAbilityMutation(c.config, OpUpdate)
+	return &NPCAbilityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NPCAbilityClient) UpdateOne(_m *NPCAbility) *NPCAbilityUpdateOne {
+	mutation := newNPCAbilityMutation(c.config, OpUpdateOne, withNPCAbility(_m))
+	return &NPCAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NPCAbilityClient) UpdateOneID(id int) *NPCAbilityUpdateOne {
+	mutation := newNPCAbilityMutation(c.config, OpUpdateOne, withNPCAbilityID(id))
+	return &NPCAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NPCAbility.
+func (c *NPCAbilityClient) Delete() *NPCAbilityDelete {
+	mutation := newNPCAbilityMutation(c.config, OpDelete)
+	return &NPCAbilityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NPCAbilityClient) DeleteOne(_m *NPCAbility) *NPCAbilityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NPCAbilityClient) DeleteOneID(id int) *NPCAbilityDeleteOne {
+	builder := c.Delete().Where(npcability.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NPCAbilityDeleteOne{builder}
+}
+
+// Query returns a query builder for NPCAbility.
+func (c *NPCAbilityClient) Query() *NPCAbilityQuery {
+	return &NPCAbilityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNPCAbility},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NPCAbility entity by its id.
+func (c *NPCAbilityClient) Get(ctx context.Context, id int) (*NPCAbility, error) {
+	return c.Query().Where(npcability.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NPCAbilityClient) GetX(ctx context.Context, id int) *NPCAbility {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNpcTemplate queries the npc_template edge of a NPCAbility.
+func (c *NPCAbilityClient) QueryNpcTemplate(_m *NPCAbility) *NPCTemplateQuery {
+	query := (&NPCTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(npcability.Table, npcability.FieldID, id),
+			sqlgraph.To(npctemplate.Table, npctemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, npcability.NpcTemplateTable, npcability.NpcTemplatePrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAbility queries the ability edge of a NPCAbility.
+func (c *NPCAbilityClient) QueryAbility(_m *NPCAbility) *AbilityQuery {
+	query := (&AbilityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(npcability.Table, npcability.FieldID, id),
+			sqlgraph.To(ability.Table, ability.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, npcability.AbilityTable, npcability.AbilityPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NPCAbilityClient) Hooks() []Hook {
+	return c.hooks.NPCAbility
+}
+
+// Interceptors returns the client interceptors.
+func (c *NPCAbilityClient) Interceptors() []Interceptor {
+	return c.inters.NPCAbility
+}
+
+func (c *NPCAbilityClient) mutate(ctx context.Context, m *NPCAbilityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NPCAbilityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NPCAbilityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NPCAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NPCAbilityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown NPCAbility mutation op: %q", m.Op())
+	}
+}
+
+// NPCTemplateClient is a client for the NPCTemplate schema.
+type NPCTemplateClient struct {
+	config
+}
+
+// NewNPCTemplateClient returns a client for the NPCTemplate from the given config.
+func NewNPCTemplateClient(c config) *NPCTemplateClient {
+	return &NPCTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `npctemplate.Hooks(f(g(h())))`.
+func (c *NPCTemplateClient) Use(hooks ...Hook) {
+	c.hooks.NPCTemplate = append(c.hooks.NPCTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `npctemplate.Intercept(f(g(h())))`.
+func (c *NPCTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NPCTemplate = append(c.inters.NPCTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a NPCTemplate entity.
+func (c *NPCTemplateClient) Create() *NPCTemplateCreate {
+	mutation := newNPCTemplateMutation(c.config, OpCreate)
+	return &NPCTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NPCTemplate entities.
+func (c *NPCTemplateClient) CreateBulk(builders ...*NPCTemplateCreate) *NPCTemplateCreateBulk {
+	return &NPCTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NPCTemplateClient) MapCreateBulk(slice any, setFunc func(*NPCTemplateCreate, int)) *NPCTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NPCTemplateCreateBulk{err: fmt.Errorf("calling to NPCTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NPCTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NPCTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NPCTemplate.
+func (c *NPCTemplateClient) Update() *NPCTemplateUpdate {
+	mutation := newNPCTemplateMutation(c.config, OpUpdate)
+	return &NPCTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NPCTemplateClient) UpdateOne(_m *NPCTemplate) *NPCTemplateUpdateOne {
+	mutation := newNPCTemplateMutation(c.config, OpUpdateOne, withNPCTemplate(_m))
+	return &NPCTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NPCTemplateClient) UpdateOneID(id string) *NPCTemplateUpdateOne {
+	mutation := newNPCTemplateMutation(c.config, OpUpdateOne, withNPCTemplateID(id))
+	return &NPCTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NPCTemplate.
+func (c *NPCTemplateClient) Delete() *NPCTemplateDelete {
+	mutation := newNPCTemplateMutation(c.config, OpDelete)
+	return &NPCTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NPCTemplateClient) DeleteOne(_m *NPCTemplate) *NPCTemplateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
@@ -5876,47 +6997,462 @@ func (c *NPCTemplateClient) QueryCharacters(_m *NPCTemplate) *CharacterQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// QueryRace queries the race edge of a NPCTemplate.
+func (c *NPCTemplateClient) QueryRace(_m *NPCTemplate) *RaceQuery {
+	query := (&RaceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(npctemplate.Table, npctemplate.FieldID, id),
+			sqlgraph.To(race.Table, race.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, npctemplate.RaceTable, npctemplate.RaceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NPCTemplateClient) Hooks() []Hook {
+	return c.hooks.NPCTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *NPCTemplateClient) Interceptors() []Interceptor {
+	return c.inters.NPCTemplate
+}
+
+func (c *NPCTemplateClient) mutate(ctx context.Context, m *NPCTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NPCTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NPCTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NPCTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NPCTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown NPCTemplate mutation op: %q", m.Op())
+	}
+}
+
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(_m *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(_m))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id int) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(_m *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id int) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id int) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id int) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
+// PartyClient is a client for the Party schema.
+type PartyClient struct {
+	config
+}
+
+// NewPartyClient returns a client for the Party from the given config.
+func NewPartyClient(c config) *PartyClient {
+	return &PartyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `party.Hooks(f(g(h())))`.
+func (c *PartyClient) Use(hooks ...Hook) {
+	c.hooks.Party = append(c.hooks.Party, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `party.Intercept(f(g(h())))`.
+func (c *PartyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Party = append(c.inters.Party, interceptors...)
+}
+
+// Create returns a builder for creating a Party entity.
+func (c *PartyClient) Create() *PartyCreate {
+	mutation := newPartyMutation(c.config, OpCreate)
+	return &PartyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Party entities.
+func (c *PartyClient) CreateBulk(builders ...*PartyCreate) *PartyCreateBulk {
+	return &PartyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PartyClient) MapCreateBulk(slice any, setFunc func(*PartyCreate, int)) *PartyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PartyCreateBulk{err: fmt.Errorf("calling to PartyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PartyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PartyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Party.
+func (c *PartyClient) Update() *PartyUpdate {
+	mutation := newPartyMutation(c.config, OpUpdate)
+	return &PartyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PartyClient) UpdateOne(_m *Party) *PartyUpdateOne {
+	mutation := newPartyMutation(c.config, OpUpdateOne, withParty(_m))
+	return &PartyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PartyClient) UpdateOneID(id int) *PartyUpdateOne {
+	mutation := newPartyMutation(c.config, OpUpdateOne, withPartyID(id))
+	return &PartyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Party.
+func (c *PartyClient) Delete() *PartyDelete {
+	mutation := newPartyMutation(c.config, OpDelete)
+	return &PartyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PartyClient) DeleteOne(_m *Party) *PartyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PartyClient) DeleteOneID(id int) *PartyDeleteOne {
+	builder := c.Delete().Where(party.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PartyDeleteOne{builder}
+}
+
+// Query returns a query builder for Party.
+func (c *PartyClient) Query() *PartyQuery {
+	return &PartyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeParty},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Party entity by its id.
+func (c *PartyClient) Get(ctx context.Context, id int) (*Party, error) {
+	return c.Query().Where(party.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PartyClient) GetX(ctx context.Context, id int) *Party {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMembers queries the members edge of a Party.
+func (c *PartyClient) QueryMembers(_m *Party) *CharacterQuery {
+	query := (&CharacterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(party.Table, party.FieldID, id),
+			sqlgraph.To(character.Table, character.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, party.MembersTable, party.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PartyClient) Hooks() []Hook {
+	return c.hooks.Party
+}
+
+// Interceptors returns the client interceptors.
+func (c *PartyClient) Interceptors() []Interceptor {
+	return c.inters.Party
+}
+
+func (c *PartyClient) mutate(ctx context.Context, m *PartyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PartyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PartyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PartyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PartyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Party mutation op: %q", m.Op())
+	}
+}
+
+// PartyInviteClient is a client for the PartyInvite schema.
+type PartyInviteClient struct {
+	config
+}
+
+// NewPartyInviteClient returns a client for the PartyInvite from the given config.
+func NewPartyInviteClient(c config) *PartyInviteClient {
+	return &PartyInviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `partyinvite.Hooks(f(g(h())))`.
+func (c *PartyInviteClient) Use(hooks ...Hook) {
+	c.hooks.PartyInvite = append(c.hooks.PartyInvite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `partyinvite.Intercept(f(g(h())))`.
+func (c *PartyInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PartyInvite = append(c.inters.PartyInvite, interceptors...)
+}
+
+// Create returns a builder for creating a PartyInvite entity.
+func (c *PartyInviteClient) Create() *PartyInviteCreate {
+	mutation := newPartyInviteMutation(c.config, OpCreate)
+	return &PartyInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PartyInvite entities.
+func (c *PartyInviteClient) CreateBulk(builders ...*PartyInviteCreate) *PartyInviteCreateBulk {
+	return &PartyInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PartyInviteClient) MapCreateBulk(slice any, setFunc func(*PartyInviteCreate, int)) *PartyInviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PartyInviteCreateBulk{err: fmt.Errorf("calling to PartyInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PartyInviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PartyInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PartyInvite.
+func (c *PartyInviteClient) Update() *PartyInviteUpdate {
+	mutation := newPartyInviteMutation(c.config, OpUpdate)
+	return &PartyInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PartyInviteClient) UpdateOne(_m *PartyInvite) *PartyInviteUpdateOne {
+	mutation := newPartyInviteMutation(c.config, OpUpdateOne, withPartyInvite(_m))
+	return &PartyInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PartyInviteClient) UpdateOneID(id int) *PartyInviteUpdateOne {
+	mutation := newPartyInviteMutation(c.config, OpUpdateOne, withPartyInviteID(id))
+	return &PartyInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PartyInvite.
+func (c *PartyInviteClient) Delete() *PartyInviteDelete {
+	mutation := newPartyInviteMutation(c.config, OpDelete)
+	return &PartyInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PartyInviteClient) DeleteOne(_m *PartyInvite) *PartyInviteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PartyInviteClient) DeleteOneID(id int) *PartyInviteDeleteOne {
+	builder := c.Delete().Where(partyinvite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PartyInviteDeleteOne{builder}
+}
+
+// Query returns a query builder for PartyInvite.
+func (c *PartyInviteClient) Query() *PartyInviteQuery {
+	return &PartyInviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePartyInvite},
+		inters: c.Interceptors(),
+	}
 }
 
-// QueryRace queries the race edge of a NPCTemplate.
-func (c *NPCTemplateClient) QueryRace(_m *NPCTemplate) *RaceQuery {
-	query := (&RaceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(npctemplate.Table, npctemplate.FieldID, id),
-			sqlgraph.To(race.Table, race.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, npctemplate.RaceTable, npctemplate.RaceColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a PartyInvite entity by its id.
+func (c *PartyInviteClient) Get(ctx context.Context, id int) (*PartyInvite, error) {
+	return c.Query().Where(partyinvite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PartyInviteClient) GetX(ctx context.Context, id int) *PartyInvite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *NPCTemplateClient) Hooks() []Hook {
-	return c.hooks.NPCTemplate
+func (c *PartyInviteClient) Hooks() []Hook {
+	return c.hooks.PartyInvite
 }
 
 // Interceptors returns the client interceptors.
-func (c *NPCTemplateClient) Interceptors() []Interceptor {
-	return c.inters.NPCTemplate
+func (c *PartyInviteClient) Interceptors() []Interceptor {
+	return c.inters.PartyInvite
 }
 
-func (c *NPCTemplateClient) mutate(ctx context.Context, m *NPCTemplateMutation) (Value, error) {
+func (c *PartyInviteClient) mutate(ctx context.Context, m *PartyInviteMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NPCTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PartyInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NPCTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PartyInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NPCTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PartyInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NPCTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PartyInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("db: unknown NPCTemplate mutation op: %q", m.Op())
+		return nil, fmt.Errorf("db: unknown PartyInvite mutation op: %q", m.Op())
 	}
 }
 
@@ -6415,6 +7951,139 @@ func (c *RaceClient) mutate(ctx context.Context, m *RaceMutation) (Value, error)
 	}
 }
 
+// ReputationClient is a client for the Reputation schema.
+type ReputationClient struct {
+	config
+}
+
+// NewReputationClient returns a client for the Reputation from the given config.
+func NewReputationClient(c config) *ReputationClient {
+	return &ReputationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reputation.Hooks(f(g(h())))`.
+func (c *ReputationClient) Use(hooks ...Hook) {
+	c.hooks.Reputation = append(c.hooks.Reputation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reputation.Intercept(f(g(h())))`.
+func (c *ReputationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reputation = append(c.inters.Reputation, interceptors...)
+}
+
+// Create returns a builder for creating a Reputation entity.
+func (c *ReputationClient) Create() *ReputationCreate {
+	mutation := newReputationMutation(c.config, OpCreate)
+	return &ReputationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reputation entities.
+func (c *ReputationClient) CreateBulk(builders ...*ReputationCreate) *ReputationCreateBulk {
+	return &ReputationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReputationClient) MapCreateBulk(slice any, setFunc func(*ReputationCreate, int)) *ReputationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReputationCreateBulk{err: fmt.Errorf("calling to ReputationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReputationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReputationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reputation.
+func (c *ReputationClient) Update() *ReputationUpdate {
+	mutation := newReputationMutation(c.config, OpUpdate)
+	return &ReputationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReputationClient) UpdateOne(_m *Reputation) *ReputationUpdateOne {
+	mutation := newReputationMutation(c.config, OpUpdateOne, withReputation(_m))
+	return &ReputationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReputationClient) UpdateOneID(id int) *ReputationUpdateOne {
+	mutation := newReputationMutation(c.config, OpUpdateOne, withReputationID(id))
+	return &ReputationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reputation.
+func (c *ReputationClient) Delete() *ReputationDelete {
+	mutation := newReputationMutation(c.config, OpDelete)
+	return &ReputationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReputationClient) DeleteOne(_m *Reputation) *ReputationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReputationClient) DeleteOneID(id int) *ReputationDeleteOne {
+	builder := c.Delete().Where(reputation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReputationDeleteOne{builder}
+}
+
+// Query returns a query builder for Reputation.
+func (c *ReputationClient) Query() *ReputationQuery {
+	return &ReputationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReputation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Reputation entity by its id.
+func (c *ReputationClient) Get(ctx context.Context, id int) (*Reputation, error) {
+	return c.Query().Where(reputation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReputationClient) GetX(ctx context.Context, id int) *Reputation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReputationClient) Hooks() []Hook {
+	return c.hooks.Reputation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReputationClient) Interceptors() []Interceptor {
+	return c.inters.Reputation
+}
+
+func (c *ReputationClient) mutate(ctx context.Context, m *ReputationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReputationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReputationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReputationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReputationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Reputation mutation op: %q", m.Op())
+	}
+}
+
 // RoomClient is a client for the Room schema.
 type RoomClient struct {
 	config
@@ -7224,6 +8893,139 @@ func (c *SocialCommandClient) mutate(ctx context.Context, m *SocialCommandMutati
 	}
 }
 
+// StashClient is a client for the Stash schema.
+type StashClient struct {
+	config
+}
+
+// NewStashClient returns a client for the Stash from the given config.
+func NewStashClient(c config) *StashClient {
+	return &StashClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stash.Hooks(f(g(h())))`.
+func (c *StashClient) Use(hooks ...Hook) {
+	c.hooks.Stash = append(c.hooks.Stash, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stash.Intercept(f(g(h())))`.
+func (c *StashClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Stash = append(c.inters.Stash, interceptors...)
+}
+
+// Create returns a builder for creating a Stash entity.
+func (c *StashClient) Create() *StashCreate {
+	mutation := newStashMutation(c.config, OpCreate)
+	return &StashCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Stash entities.
+func (c *StashClient) CreateBulk(builders ...*StashCreate) *StashCreateBulk {
+	return &StashCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StashClient) MapCreateBulk(slice any, setFunc func(*StashCreate, int)) *StashCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StashCreateBulk{err: fmt.Errorf("calling to StashClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StashCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StashCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Stash.
+func (c *StashClient) Update() *StashUpdate {
+	mutation := newStashMutation(c.config, OpUpdate)
+	return &StashUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StashClient) UpdateOne(_m *Stash) *StashUpdateOne {
+	mutation := newStashMutation(c.config, OpUpdateOne, withStash(_m))
+	return &StashUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StashClient) UpdateOneID(id int) *StashUpdateOne {
+	mutation := newStashMutation(c.config, OpUpdateOne, withStashID(id))
+	return &StashUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Stash.
+func (c *StashClient) Delete() *StashDelete {
+	mutation := newStashMutation(c.config, OpDelete)
+	return &StashDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StashClient) DeleteOne(_m *Stash) *StashDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StashClient) DeleteOneID(id int) *StashDeleteOne {
+	builder := c.Delete().Where(stash.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StashDeleteOne{builder}
+}
+
+// Query returns a query builder for Stash.
+func (c *StashClient) Query() *StashQuery {
+	return &StashQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStash},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Stash entity by its id.
+func (c *StashClient) Get(ctx context.Context, id int) (*Stash, error) {
+	return c.Query().Where(stash.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StashClient) GetX(ctx context.Context, id int) *Stash {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StashClient) Hooks() []Hook {
+	return c.hooks.Stash
+}
+
+// Interceptors returns the client interceptors.
+func (c *StashClient) Interceptors() []Interceptor {
+	return c.inters.Stash
+}
+
+func (c *StashClient) mutate(ctx context.Context, m *StashMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StashCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StashUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StashUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StashDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown Stash mutation op: %q", m.Op())
+	}
+}
+
 // SystemLogClient is a client for the SystemLog schema.
 type SystemLogClient struct {
 	config
@@ -8443,28 +10245,165 @@ func (c *ZoneClient) mutate(ctx context.Context, m *ZoneMutation) (Value, error)
 	}
 }
 
+// ZoneInstanceClient is a client for the ZoneInstance schema.
+type ZoneInstanceClient struct {
+	config
+}
+
+// NewZoneInstanceClient returns a client for the ZoneInstance from the given config.
+func NewZoneInstanceClient(c config) *ZoneInstanceClient {
+	return &ZoneInstanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `zoneinstance.Hooks(f(g(h())))`.
+func (c *ZoneInstanceClient) Use(hooks ...Hook) {
+	c.hooks.ZoneInstance = append(c.hooks.ZoneInstance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `zoneinstance.Intercept(f(g(h())))`.
+func (c *ZoneInstanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ZoneInstance = append(c.inters.ZoneInstance, interceptors...)
+}
+
+// Create returns a builder for creating a ZoneInstance entity.
+func (c *ZoneInstanceClient) Create() *ZoneInstanceCreate {
+	mutation := newZoneInstanceMutation(c.config, OpCreate)
+	return &ZoneInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ZoneInstance entities.
+func (c *ZoneInstanceClient) CreateBulk(builders ...*ZoneInstanceCreate) *ZoneInstanceCreateBulk {
+	return &ZoneInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ZoneInstanceClient) MapCreateBulk(slice any, setFunc func(*ZoneInstanceCreate, int)) *ZoneInstanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ZoneInstanceCreateBulk{err: fmt.Errorf("calling to ZoneInstanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ZoneInstanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ZoneInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ZoneInstance.
+func (c *ZoneInstanceClient) Update() *ZoneInstanceUpdate {
+	mutation := newZoneInstanceMutation(c.config, OpUpdate)
+	return &ZoneInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ZoneInstanceClient) UpdateOne(_m *ZoneInstance) *ZoneInstanceUpdateOne {
+	mutation := newZoneInstanceMutation(c.config, OpUpdateOne, withZoneInstance(_m))
+	return &ZoneInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ZoneInstanceClient) UpdateOneID(id int) *ZoneInstanceUpdateOne {
+	mutation := newZoneInstanceMutation(c.config, OpUpdateOne, withZoneInstanceID(id))
+	return &ZoneInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ZoneInstance.
+func (c *ZoneInstanceClient) Delete() *ZoneInstanceDelete {
+	mutation := newZoneInstanceMutation(c.config, OpDelete)
+	return &ZoneInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ZoneInstanceClient) DeleteOne(_m *ZoneInstance) *ZoneInstanceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ZoneInstanceClient) DeleteOneID(id int) *ZoneInstanceDeleteOne {
+	builder := c.Delete().Where(zoneinstance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ZoneInstanceDeleteOne{builder}
+}
+
+// Query returns a query builder for ZoneInstance.
+func (c *ZoneInstanceClient) Query() *ZoneInstanceQuery {
+	return &ZoneInstanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeZoneInstance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ZoneInstance entity by its id.
+func (c *ZoneInstanceClient) Get(ctx context.Context, id int) (*ZoneInstance, error) {
+	return c.Query().Where(zoneinstance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ZoneInstanceClient) GetX(ctx context.Context, id int) *ZoneInstance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ZoneInstanceClient) Hooks() []Hook {
+	return c.hooks.ZoneInstance
+}
+
+// Interceptors returns the client interceptors.
+func (c *ZoneInstanceClient) Interceptors() []Interceptor {
+	return c.inters.ZoneInstance
+}
+
+func (c *ZoneInstanceClient) mutate(ctx context.Context, m *ZoneInstanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ZoneInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ZoneInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ZoneInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ZoneInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown ZoneInstance mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Ability, AbilityEffect, Achievement, ActiveEffect, AppLog, ChannelConfig,
-		Character, CharacterAbility, CharacterChannel, CharacterClassHistory,
-		CharacterCompetency, CharacterFaction, CharacterIgnore, CharacterRaceHistory,
-		CharacterSkill, CharacterTag, CompetencyCategory, CompetencyLevelThreshold,
-		CraftingRecipe, DamageLog, DialogNode, Effect, EffectHook, Equipment,
-		EquipmentTemplate, Faction, FactionCategory, FactionRequiredTag, GameConfig,
-		Gender, NPCAbility, NPCTemplate, Quest, QuestProgress, Race, Room, ShopItem,
-		ShopTemplate, Skill, SocialCommand, SystemLog, Tag, TellQueue, Trigger, User,
-		World, Zone []ent.Hook
+		Ability, AbilityEffect, Achievement, ActiveEffect, AppLog, Auction,
+		ChannelConfig, Character, CharacterAbility, CharacterAchievement,
+		CharacterChannel, CharacterClassHistory, CharacterCompetency, CharacterFaction,
+		CharacterIgnore, CharacterRaceHistory, CharacterSkill, CharacterTag,
+		CompetencyCategory, CompetencyLevelThreshold, CraftingRecipe, DamageLog,
+		DeadLetter, DialogNode, DialogState, Effect, EffectHook, Equipment,
+		EquipmentTemplate, EventCursor, Faction, FactionCategory, FactionRequiredTag,
+		GameConfig, Gender, House, Mail, NPCAbility, NPCTemplate, OutboxEvent, Party,
+		PartyInvite, Quest, QuestProgress, Race, Reputation, Room, ShopItem,
+		ShopTemplate, Skill, SocialCommand, Stash, SystemLog, Tag, TellQueue, Trigger,
+		User, World, Zone, ZoneInstance []ent.Hook
 	}
 	inters struct {
-		Ability, AbilityEffect, Achievement, ActiveEffect, AppLog, ChannelConfig,
-		Character, CharacterAbility, CharacterChannel, CharacterClassHistory,
-		CharacterCompetency, CharacterFaction, CharacterIgnore, CharacterRaceHistory,
-		CharacterSkill, CharacterTag, CompetencyCategory, CompetencyLevelThreshold,
-		CraftingRecipe, DamageLog, DialogNode, Effect, EffectHook, Equipment,
-		EquipmentTemplate, Faction, FactionCategory, FactionRequiredTag, GameConfig,
-		Gender, NPCAbility, NPCTemplate, Quest, QuestProgress, Race, Room, ShopItem,
-		ShopTemplate, Skill, SocialCommand, SystemLog, Tag, TellQueue, Trigger, User,
-		World, Zone []ent.Interceptor
+		Ability, AbilityEffect, Achievement, ActiveEffect, AppLog, Auction,
+		ChannelConfig, Character, CharacterAbility, CharacterAchievement,
+		CharacterChannel, CharacterClassHistory, CharacterCompetency, CharacterFaction,
+		CharacterIgnore, CharacterRaceHistory, CharacterSkill, CharacterTag,
+		CompetencyCategory, CompetencyLevelThreshold, CraftingRecipe, DamageLog,
+		DeadLetter, DialogNode, DialogState, Effect, EffectHook, Equipment,
+		EquipmentTemplate, EventCursor, Faction, FactionCategory, FactionRequiredTag,
+		GameConfig, Gender, House, Mail, NPCAbility, NPCTemplate, OutboxEvent, Party,
+		PartyInvite, Quest, QuestProgress, Race, Reputation, Room, ShopItem,
+		ShopTemplate, Skill, SocialCommand, Stash, SystemLog, Tag, TellQueue, Trigger,
+		User, World, Zone, ZoneInstance []ent.Interceptor
 	}
 )
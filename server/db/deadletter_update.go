@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/deadletter"
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeadLetterUpdate is the builder for updating DeadLetter entities.
+type DeadLetterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (_u *DeadLetterUpdate) Where(ps ...predicate.DeadLetter) *DeadLetterUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSubscriber sets the "subscriber" field.
+func (_u *DeadLetterUpdate) SetSubscriber(v string) *DeadLetterUpdate {
+	_u.mutation.SetSubscriber(v)
+	return _u
+}
+
+// SetNillableSubscriber sets the "subscriber" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableSubscriber(v *string) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetSubscriber(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *DeadLetterUpdate) SetEventID(v int) *DeadLetterUpdate {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableEventID(v *int) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *DeadLetterUpdate) AddEventID(v int) *DeadLetterUpdate {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *DeadLetterUpdate) SetEventType(v string) *DeadLetterUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableEventType(v *string) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *DeadLetterUpdate) SetPayload(v map[string]interface{}) *DeadLetterUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *DeadLetterUpdate) SetTimestamp(v int64) *DeadLetterUpdate {
+	_u.mutation.ResetTimestamp()
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableTimestamp(v *int64) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// AddTimestamp adds value to the "timestamp" field.
+func (_u *DeadLetterUpdate) AddTimestamp(v int64) *DeadLetterUpdate {
+	_u.mutation.AddTimestamp(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DeadLetterUpdate) SetAttempts(v int) *DeadLetterUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableAttempts(v *int) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DeadLetterUpdate) AddAttempts(v int) *DeadLetterUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DeadLetterUpdate) SetError(v string) *DeadLetterUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableError(v *string) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DeadLetterUpdate) SetCreatedAt(v time.Time) *DeadLetterUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableCreatedAt(v *time.Time) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (_u *DeadLetterUpdate) Mutation() *DeadLetterMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeadLetterUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeadLetterUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeadLetterUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeadLetterUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DeadLetterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Subscriber(); ok {
+		_spec.SetField(deadletter.FieldSubscriber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(deadletter.FieldEventID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(deadletter.FieldEventID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(deadletter.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(deadletter.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTimestamp(); ok {
+		_spec.AddField(deadletter.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletter.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeadLetterUpdateOne is the builder for updating a single DeadLetter entity.
+type DeadLetterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// SetSubscriber sets the "subscriber" field.
+func (_u *DeadLetterUpdateOne) SetSubscriber(v string) *DeadLetterUpdateOne {
+	_u.mutation.SetSubscriber(v)
+	return _u
+}
+
+// SetNillableSubscriber sets the "subscriber" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableSubscriber(v *string) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetSubscriber(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *DeadLetterUpdateOne) SetEventID(v int) *DeadLetterUpdateOne {
+	_u.mutation.ResetEventID()
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableEventID(v *int) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// AddEventID adds value to the "event_id" field.
+func (_u *DeadLetterUpdateOne) AddEventID(v int) *DeadLetterUpdateOne {
+	_u.mutation.AddEventID(v)
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *DeadLetterUpdateOne) SetEventType(v string) *DeadLetterUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableEventType(v *string) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *DeadLetterUpdateOne) SetPayload(v map[string]interface{}) *DeadLetterUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *DeadLetterUpdateOne) SetTimestamp(v int64) *DeadLetterUpdateOne {
+	_u.mutation.ResetTimestamp()
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableTimestamp(v *int64) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// AddTimestamp adds value to the "timestamp" field.
+func (_u *DeadLetterUpdateOne) AddTimestamp(v int64) *DeadLetterUpdateOne {
+	_u.mutation.AddTimestamp(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DeadLetterUpdateOne) SetAttempts(v int) *DeadLetterUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableAttempts(v *int) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DeadLetterUpdateOne) AddAttempts(v int) *DeadLetterUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DeadLetterUpdateOne) SetError(v string) *DeadLetterUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableError(v *string) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DeadLetterUpdateOne) SetCreatedAt(v time.Time) *DeadLetterUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableCreatedAt(v *time.Time) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (_u *DeadLetterUpdateOne) Mutation() *DeadLetterMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (_u *DeadLetterUpdateOne) Where(ps ...predicate.DeadLetter) *DeadLetterUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeadLetterUpdateOne) Select(field string, fields ...string) *DeadLetterUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeadLetter entity.
+func (_u *DeadLetterUpdateOne) Save(ctx context.Context) (*DeadLetter, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeadLetterUpdateOne) SaveX(ctx context.Context) *DeadLetter {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeadLetterUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeadLetterUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DeadLetterUpdateOne) sqlSave(ctx context.Context) (_node *DeadLetter, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "DeadLetter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletter.FieldID)
+		for _, f := range fields {
+			if !deadletter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != deadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Subscriber(); ok {
+		_spec.SetField(deadletter.FieldSubscriber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(deadletter.FieldEventID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventID(); ok {
+		_spec.AddField(deadletter.FieldEventID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(deadletter.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(deadletter.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTimestamp(); ok {
+		_spec.AddField(deadletter.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletter.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &DeadLetter{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
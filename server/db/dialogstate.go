@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"herbst-server/db/dialogstate"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DialogState is the model entity for the DialogState schema.
+type DialogState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Character the NPC remembers
+	CharacterID int `json:"character_id,omitempty"`
+	// NPC template doing the remembering
+	NpcTemplateID string `json:"npc_template_id,omitempty"`
+	// Named on/off facts, e.g. asked_about_brother
+	Flags map[string]bool `json:"flags,omitempty"`
+	// Named tallies, e.g. times_insulted
+	Counters map[string]int `json:"counters,omitempty"`
+	// Conversations started with this NPC
+	TimesTalked int `json:"times_talked,omitempty"`
+	// FirstMetAt holds the value of the "first_met_at" field.
+	FirstMetAt *time.Time `json:"first_met_at,omitempty"`
+	// Last dialog node the character reached
+	LastNodeID string `json:"last_node_id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DialogState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dialogstate.FieldFlags, dialogstate.FieldCounters:
+			values[i] = new([]byte)
+		case dialogstate.FieldID, dialogstate.FieldCharacterID, dialogstate.FieldTimesTalked:
+			values[i] = new(sql.NullInt64)
+		case dialogstate.FieldNpcTemplateID, dialogstate.FieldLastNodeID:
+			values[i] = new(sql.NullString)
+		case dialogstate.FieldFirstMetAt, dialogstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DialogState fields.
+func (_m *DialogState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dialogstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case dialogstate.FieldCharacterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field character_id", values[i])
+			} else if value.Valid {
+				_m.CharacterID = int(value.Int64)
+			}
+		case dialogstate.FieldNpcTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field npc_template_id", values[i])
+			} else if value.Valid {
+				_m.NpcTemplateID = value.String
+			}
+		case dialogstate.FieldFlags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field flags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Flags); err != nil {
+					return fmt.Errorf("unmarshal field flags: %w", err)
+				}
+			}
+		case dialogstate.FieldCounters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field counters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Counters); err != nil {
+					return fmt.Errorf("unmarshal field counters: %w", err)
+				}
+			}
+		case dialogstate.FieldTimesTalked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_talked", values[i])
+			} else if value.Valid {
+				_m.TimesTalked = int(value.Int64)
+			}
+		case dialogstate.FieldFirstMetAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_met_at", values[i])
+			} else if value.Valid {
+				_m.FirstMetAt = new(time.Time)
+				*_m.FirstMetAt = value.Time
+			}
+		case dialogstate.FieldLastNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_node_id", values[i])
+			} else if value.Valid {
+				_m.LastNodeID = value.String
+			}
+		case dialogstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DialogState.
+// This includes values selected through modifiers, order, etc.
+func (_m *DialogState) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DialogState.
+// Note that you need to call DialogState.Unwrap() before calling this method if this DialogState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DialogState) Update() *DialogStateUpdateOne {
+	return NewDialogStateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DialogState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DialogState) Unwrap() *DialogState {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: DialogState is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DialogState) String() string {
+	var builder strings.Builder
+	builder.WriteString("DialogState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("character_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CharacterID))
+	builder.WriteString(", ")
+	builder.WriteString("npc_template_id=")
+	builder.WriteString(_m.NpcTemplateID)
+	builder.WriteString(", ")
+	builder.WriteString("flags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Flags))
+	builder.WriteString(", ")
+	builder.WriteString("counters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Counters))
+	builder.WriteString(", ")
+	builder.WriteString("times_talked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimesTalked))
+	builder.WriteString(", ")
+	if v := _m.FirstMetAt; v != nil {
+		builder.WriteString("first_met_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_node_id=")
+	builder.WriteString(_m.LastNodeID)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DialogStates is a parsable slice of DialogState.
+type DialogStates []*DialogState
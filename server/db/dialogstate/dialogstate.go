@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dialogstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the dialogstate type in the database.
+	Label = "dialog_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCharacterID holds the string denoting the character_id field in the database.
+	FieldCharacterID = "character_id"
+	// FieldNpcTemplateID holds the string denoting the npc_template_id field in the database.
+	FieldNpcTemplateID = "npc_template_id"
+	// FieldFlags holds the string denoting the flags field in the database.
+	FieldFlags = "flags"
+	// FieldCounters holds the string denoting the counters field in the database.
+	FieldCounters = "counters"
+	// FieldTimesTalked holds the string denoting the times_talked field in the database.
+	FieldTimesTalked = "times_talked"
+	// FieldFirstMetAt holds the string denoting the first_met_at field in the database.
+	FieldFirstMetAt = "first_met_at"
+	// FieldLastNodeID holds the string denoting the last_node_id field in the database.
+	FieldLastNodeID = "last_node_id"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the dialogstate in the database.
+	Table = "dialog_states"
+)
+
+// Columns holds all SQL columns for dialogstate fields.
+var Columns = []string{
+	FieldID,
+	FieldCharacterID,
+	FieldNpcTemplateID,
+	FieldFlags,
+	FieldCounters,
+	FieldTimesTalked,
+	FieldFirstMetAt,
+	FieldLastNodeID,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimesTalked holds the default value on creation for the "times_talked" field.
+	DefaultTimesTalked int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DialogState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCharacterID orders the results by the character_id field.
+func ByCharacterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharacterID, opts...).ToFunc()
+}
+
+// ByNpcTemplateID orders the results by the npc_template_id field.
+func ByNpcTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNpcTemplateID, opts...).ToFunc()
+}
+
+// ByTimesTalked orders the results by the times_talked field.
+func ByTimesTalked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesTalked, opts...).ToFunc()
+}
+
+// ByFirstMetAt orders the results by the first_met_at field.
+func ByFirstMetAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstMetAt, opts...).ToFunc()
+}
+
+// ByLastNodeID orders the results by the last_node_id field.
+func ByLastNodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastNodeID, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
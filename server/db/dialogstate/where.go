@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dialogstate
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLTE(FieldID, id))
+}
+
+// CharacterID applies equality check predicate on the "character_id" field. It's identical to CharacterIDEQ.
+func CharacterID(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldCharacterID, v))
+}
+
+// NpcTemplateID applies equality check predicate on the "npc_template_id" field. It's identical to NpcTemplateIDEQ.
+func NpcTemplateID(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldNpcTemplateID, v))
+}
+
+// TimesTalked applies equality check predicate on the "times_talked" field. It's identical to TimesTalkedEQ.
+func TimesTalked(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldTimesTalked, v))
+}
+
+// FirstMetAt applies equality check predicate on the "first_met_at" field. It's identical to FirstMetAtEQ.
+func FirstMetAt(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldFirstMetAt, v))
+}
+
+// LastNodeID applies equality check predicate on the "last_node_id" field. It's identical to LastNodeIDEQ.
+func LastNodeID(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldLastNodeID, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CharacterIDEQ applies the EQ predicate on the "character_id" field.
+func CharacterIDEQ(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldCharacterID, v))
+}
+
+// CharacterIDNEQ applies the NEQ predicate on the "character_id" field.
+func CharacterIDNEQ(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNEQ(FieldCharacterID, v))
+}
+
+// CharacterIDIn applies the In predicate on the "character_id" field.
+func CharacterIDIn(vs ...int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldIn(FieldCharacterID, vs...))
+}
+
+// CharacterIDNotIn applies the NotIn predicate on the "character_id" field.
+func CharacterIDNotIn(vs ...int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotIn(FieldCharacterID, vs...))
+}
+
+// CharacterIDGT applies the GT predicate on the "character_id" field.
+func CharacterIDGT(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGT(FieldCharacterID, v))
+}
+
+// CharacterIDGTE applies the GTE predicate on the "character_id" field.
+func CharacterIDGTE(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGTE(FieldCharacterID, v))
+}
+
+// CharacterIDLT applies the LT predicate on the "character_id" field.
+func CharacterIDLT(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLT(FieldCharacterID, v))
+}
+
+// CharacterIDLTE applies the LTE predicate on the "character_id" field.
+func CharacterIDLTE(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLTE(FieldCharacterID, v))
+}
+
+// NpcTemplateIDEQ applies the EQ predicate on the "npc_template_id" field.
+func NpcTemplateIDEQ(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDNEQ applies the NEQ predicate on the "npc_template_id" field.
+func NpcTemplateIDNEQ(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNEQ(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDIn applies the In predicate on the "npc_template_id" field.
+func NpcTemplateIDIn(vs ...string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldIn(FieldNpcTemplateID, vs...))
+}
+
+// NpcTemplateIDNotIn applies the NotIn predicate on the "npc_template_id" field.
+func NpcTemplateIDNotIn(vs ...string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotIn(FieldNpcTemplateID, vs...))
+}
+
+// NpcTemplateIDGT applies the GT predicate on the "npc_template_id" field.
+func NpcTemplateIDGT(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGT(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDGTE applies the GTE predicate on the "npc_template_id" field.
+func NpcTemplateIDGTE(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGTE(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDLT applies the LT predicate on the "npc_template_id" field.
+func NpcTemplateIDLT(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLT(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDLTE applies the LTE predicate on the "npc_template_id" field.
+func NpcTemplateIDLTE(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLTE(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDContains applies the Contains predicate on the "npc_template_id" field.
+func NpcTemplateIDContains(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldContains(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDHasPrefix applies the HasPrefix predicate on the "npc_template_id" field.
+func NpcTemplateIDHasPrefix(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldHasPrefix(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDHasSuffix applies the HasSuffix predicate on the "npc_template_id" field.
+func NpcTemplateIDHasSuffix(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldHasSuffix(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDEqualFold applies the EqualFold predicate on the "npc_template_id" field.
+func NpcTemplateIDEqualFold(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEqualFold(FieldNpcTemplateID, v))
+}
+
+// NpcTemplateIDContainsFold applies the ContainsFold predicate on the "npc_template_id" field.
+func NpcTemplateIDContainsFold(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldContainsFold(FieldNpcTemplateID, v))
+}
+
+// FlagsIsNil applies the IsNil predicate on the "flags" field.
+func FlagsIsNil() predicate.DialogState {
+	return predicate.DialogState(sql.FieldIsNull(FieldFlags))
+}
+
+// FlagsNotNil applies the NotNil predicate on the "flags" field.
+func FlagsNotNil() predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotNull(FieldFlags))
+}
+
+// CountersIsNil applies the IsNil predicate on the "counters" field.
+func CountersIsNil() predicate.DialogState {
+	return predicate.DialogState(sql.FieldIsNull(FieldCounters))
+}
+
+// CountersNotNil applies the NotNil predicate on the "counters" field.
+func CountersNotNil() predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotNull(FieldCounters))
+}
+
+// TimesTalkedEQ applies the EQ predicate on the "times_talked" field.
+func TimesTalkedEQ(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldTimesTalked, v))
+}
+
+// TimesTalkedNEQ applies the NEQ predicate on the "times_talked" field.
+func TimesTalkedNEQ(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNEQ(FieldTimesTalked, v))
+}
+
+// TimesTalkedIn applies the In predicate on the "times_talked" field.
+func TimesTalkedIn(vs ...int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldIn(FieldTimesTalked, vs...))
+}
+
+// TimesTalkedNotIn applies the NotIn predicate on the "times_talked" field.
+func TimesTalkedNotIn(vs ...int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotIn(FieldTimesTalked, vs...))
+}
+
+// TimesTalkedGT applies the GT predicate on the "times_talked" field.
+func TimesTalkedGT(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGT(FieldTimesTalked, v))
+}
+
+// TimesTalkedGTE applies the GTE predicate on the "times_talked" field.
+func TimesTalkedGTE(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGTE(FieldTimesTalked, v))
+}
+
+// TimesTalkedLT applies the LT predicate on the "times_talked" field.
+func TimesTalkedLT(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLT(FieldTimesTalked, v))
+}
+
+// TimesTalkedLTE applies the LTE predicate on the "times_talked" field.
+func TimesTalkedLTE(v int) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLTE(FieldTimesTalked, v))
+}
+
+// FirstMetAtEQ applies the EQ predicate on the "first_met_at" field.
+func FirstMetAtEQ(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldFirstMetAt, v))
+}
+
+// FirstMetAtNEQ applies the NEQ predicate on the "first_met_at" field.
+func FirstMetAtNEQ(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNEQ(FieldFirstMetAt, v))
+}
+
+// FirstMetAtIn applies the In predicate on the "first_met_at" field.
+func FirstMetAtIn(vs ...time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldIn(FieldFirstMetAt, vs...))
+}
+
+// FirstMetAtNotIn applies the NotIn predicate on the "first_met_at" field.
+func FirstMetAtNotIn(vs ...time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotIn(FieldFirstMetAt, vs...))
+}
+
+// FirstMetAtGT applies the GT predicate on the "first_met_at" field.
+func FirstMetAtGT(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGT(FieldFirstMetAt, v))
+}
+
+// FirstMetAtGTE applies the GTE predicate on the "first_met_at" field.
+func FirstMetAtGTE(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGTE(FieldFirstMetAt, v))
+}
+
+// FirstMetAtLT applies the LT predicate on the "first_met_at" field.
+func FirstMetAtLT(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLT(FieldFirstMetAt, v))
+}
+
+// FirstMetAtLTE applies the LTE predicate on the "first_met_at" field.
+func FirstMetAtLTE(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLTE(FieldFirstMetAt, v))
+}
+
+// FirstMetAtIsNil applies the IsNil predicate on the "first_met_at" field.
+func FirstMetAtIsNil() predicate.DialogState {
+	return predicate.DialogState(sql.FieldIsNull(FieldFirstMetAt))
+}
+
+// FirstMetAtNotNil applies the NotNil predicate on the "first_met_at" field.
+func FirstMetAtNotNil() predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotNull(FieldFirstMetAt))
+}
+
+// LastNodeIDEQ applies the EQ predicate on the "last_node_id" field.
+func LastNodeIDEQ(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldLastNodeID, v))
+}
+
+// LastNodeIDNEQ applies the NEQ predicate on the "last_node_id" field.
+func LastNodeIDNEQ(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNEQ(FieldLastNodeID, v))
+}
+
+// LastNodeIDIn applies the In predicate on the "last_node_id" field.
+func LastNodeIDIn(vs ...string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldIn(FieldLastNodeID, vs...))
+}
+
+// LastNodeIDNotIn applies the NotIn predicate on the "last_node_id" field.
+func LastNodeIDNotIn(vs ...string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotIn(FieldLastNodeID, vs...))
+}
+
+// LastNodeIDGT applies the GT predicate on the "last_node_id" field.
+func LastNodeIDGT(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGT(FieldLastNodeID, v))
+}
+
+// LastNodeIDGTE applies the GTE predicate on the "last_node_id" field.
+func LastNodeIDGTE(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGTE(FieldLastNodeID, v))
+}
+
+// LastNodeIDLT applies the LT predicate on the "last_node_id" field.
+func LastNodeIDLT(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLT(FieldLastNodeID, v))
+}
+
+// LastNodeIDLTE applies the LTE predicate on the "last_node_id" field.
+func LastNodeIDLTE(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLTE(FieldLastNodeID, v))
+}
+
+// LastNodeIDContains applies the Contains predicate on the "last_node_id" field.
+func LastNodeIDContains(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldContains(FieldLastNodeID, v))
+}
+
+// LastNodeIDHasPrefix applies the HasPrefix predicate on the "last_node_id" field.
+func LastNodeIDHasPrefix(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldHasPrefix(FieldLastNodeID, v))
+}
+
+// LastNodeIDHasSuffix applies the HasSuffix predicate on the "last_node_id" field.
+func LastNodeIDHasSuffix(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldHasSuffix(FieldLastNodeID, v))
+}
+
+// LastNodeIDIsNil applies the IsNil predicate on the "last_node_id" field.
+func LastNodeIDIsNil() predicate.DialogState {
+	return predicate.DialogState(sql.FieldIsNull(FieldLastNodeID))
+}
+
+// LastNodeIDNotNil applies the NotNil predicate on the "last_node_id" field.
+func LastNodeIDNotNil() predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotNull(FieldLastNodeID))
+}
+
+// LastNodeIDEqualFold applies the EqualFold predicate on the "last_node_id" field.
+func LastNodeIDEqualFold(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEqualFold(FieldLastNodeID, v))
+}
+
+// LastNodeIDContainsFold applies the ContainsFold predicate on the "last_node_id" field.
+func LastNodeIDContainsFold(v string) predicate.DialogState {
+	return predicate.DialogState(sql.FieldContainsFold(FieldLastNodeID, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DialogState {
+	return predicate.DialogState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DialogState) predicate.DialogState {
+	return predicate.DialogState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DialogState) predicate.DialogState {
+	return predicate.DialogState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DialogState) predicate.DialogState {
+	return predicate.DialogState(sql.NotPredicates(p))
+}
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/dialogstate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DialogStateCreate is the builder for creating a DialogState entity.
+type DialogStateCreate struct {
+	config
+	mutation *DialogStateMutation
+	hooks    []Hook
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_c *DialogStateCreate) SetCharacterID(v int) *DialogStateCreate {
+	_c.mutation.SetCharacterID(v)
+	return _c
+}
+
+// SetNpcTemplateID sets the "npc_template_id" field.
+func (_c *DialogStateCreate) SetNpcTemplateID(v string) *DialogStateCreate {
+	_c.mutation.SetNpcTemplateID(v)
+	return _c
+}
+
+// SetFlags sets the "flags" field.
+func (_c *DialogStateCreate) SetFlags(v map[string]bool) *DialogStateCreate {
+	_c.mutation.SetFlags(v)
+	return _c
+}
+
+// SetCounters sets the "counters" field.
+func (_c *DialogStateCreate) SetCounters(v map[string]int) *DialogStateCreate {
+	_c.mutation.SetCounters(v)
+	return _c
+}
+
+// SetTimesTalked sets the "times_talked" field.
+func (_c *DialogStateCreate) SetTimesTalked(v int) *DialogStateCreate {
+	_c.mutation.SetTimesTalked(v)
+	return _c
+}
+
+// SetNillableTimesTalked sets the "times_talked" field if the given value is not nil.
+func (_c *DialogStateCreate) SetNillableTimesTalked(v *int) *DialogStateCreate {
+	if v != nil {
+		_c.SetTimesTalked(*v)
+	}
+	return _c
+}
+
+// SetFirstMetAt sets the "first_met_at" field.
+func (_c *DialogStateCreate) SetFirstMetAt(v time.Time) *DialogStateCreate {
+	_c.mutation.SetFirstMetAt(v)
+	return _c
+}
+
+// SetNillableFirstMetAt sets the "first_met_at" field if the given value is not nil.
+func (_c *DialogStateCreate) SetNillableFirstMetAt(v *time.Time) *DialogStateCreate {
+	if v != nil {
+		_c.SetFirstMetAt(*v)
+	}
+	return _c
+}
+
+// SetLastNodeID sets the "last_node_id" field.
+func (_c *DialogStateCreate) SetLastNodeID(v string) *DialogStateCreate {
+	_c.mutation.SetLastNodeID(v)
+	return _c
+}
+
+// SetNillableLastNodeID sets the "last_node_id" field if the given value is not nil.
+func (_c *DialogStateCreate) SetNillableLastNodeID(v *string) *DialogStateCreate {
+	if v != nil {
+		_c.SetLastNodeID(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DialogStateCreate) SetUpdatedAt(v time.Time) *DialogStateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DialogStateCreate) SetNillableUpdatedAt(v *time.Time) *DialogStateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DialogStateMutation object of the builder.
+func (_c *DialogStateCreate) Mutation() *DialogStateMutation {
+	return _c.mutation
+}
+
+// Save creates the DialogState in the database.
+func (_c *DialogStateCreate) Save(ctx context.Context) (*DialogState, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DialogStateCreate) SaveX(ctx context.Context) *DialogState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DialogStateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DialogStateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DialogStateCreate) defaults() {
+	if _, ok := _c.mutation.TimesTalked(); !ok {
+		v := dialogstate.DefaultTimesTalked
+		_c.mutation.SetTimesTalked(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := dialogstate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DialogStateCreate) check() error {
+	if _, ok := _c.mutation.CharacterID(); !ok {
+		return &ValidationError{Name: "character_id", err: errors.New(`db: missing required field "DialogState.character_id"`)}
+	}
+	if _, ok := _c.mutation.NpcTemplateID(); !ok {
+		return &ValidationError{Name: "npc_template_id", err: errors.New(`db: missing required field "DialogState.npc_template_id"`)}
+	}
+	if _, ok := _c.mutation.TimesTalked(); !ok {
+		return &ValidationError{Name: "times_talked", err: errors.New(`db: missing required field "DialogState.times_talked"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "DialogState.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DialogStateCreate) sqlSave(ctx context.Context) (*DialogState, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DialogStateCreate) createSpec() (*DialogState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DialogState{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dialogstate.Table, sqlgraph.NewFieldSpec(dialogstate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CharacterID(); ok {
+		_spec.SetField(dialogstate.FieldCharacterID, field.TypeInt, value)
+		_node.CharacterID = value
+	}
+	if value, ok := _c.mutation.NpcTemplateID(); ok {
+		_spec.SetField(dialogstate.FieldNpcTemplateID, field.TypeString, value)
+		_node.NpcTemplateID = value
+	}
+	if value, ok := _c.mutation.Flags(); ok {
+		_spec.SetField(dialogstate.FieldFlags, field.TypeJSON, value)
+		_node.Flags = value
+	}
+	if value, ok := _c.mutation.Counters(); ok {
+		_spec.SetField(dialogstate.FieldCounters, field.TypeJSON, value)
+		_node.Counters = value
+	}
+	if value, ok := _c.mutation.TimesTalked(); ok {
+		_spec.SetField(dialogstate.FieldTimesTalked, field.TypeInt, value)
+		_node.TimesTalked = value
+	}
+	if value, ok := _c.mutation.FirstMetAt(); ok {
+		_spec.SetField(dialogstate.FieldFirstMetAt, field.TypeTime, value)
+		_node.FirstMetAt = &value
+	}
+	if value, ok := _c.mutation.LastNodeID(); ok {
+		_spec.SetField(dialogstate.FieldLastNodeID, field.TypeString, value)
+		_node.LastNodeID = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(dialogstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DialogStateCreateBulk is the builder for creating many DialogState entities in bulk.
+type DialogStateCreateBulk struct {
+	config
+	err      error
+	builders []*DialogStateCreate
+}
+
+// Save creates the DialogState entities in the database.
+func (_c *DialogStateCreateBulk) Save(ctx context.Context) ([]*DialogState, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DialogState, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DialogStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DialogStateCreateBulk) SaveX(ctx context.Context) []*DialogState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DialogStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DialogStateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/dialogstate"
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DialogStateUpdate is the builder for updating DialogState entities.
+type DialogStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DialogStateMutation
+}
+
+// Where appends a list predicates to the DialogStateUpdate builder.
+func (_u *DialogStateUpdate) Where(ps ...predicate.DialogState) *DialogStateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_u *DialogStateUpdate) SetCharacterID(v int) *DialogStateUpdate {
+	_u.mutation.ResetCharacterID()
+	_u.mutation.SetCharacterID(v)
+	return _u
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_u *DialogStateUpdate) SetNillableCharacterID(v *int) *DialogStateUpdate {
+	if v != nil {
+		_u.SetCharacterID(*v)
+	}
+	return _u
+}
+
+// AddCharacterID adds value to the "character_id" field.
+func (_u *DialogStateUpdate) AddCharacterID(v int) *DialogStateUpdate {
+	_u.mutation.AddCharacterID(v)
+	return _u
+}
+
+// SetNpcTemplateID sets the "npc_template_id" field.
+func (_u *DialogStateUpdate) SetNpcTemplateID(v string) *DialogStateUpdate {
+	_u.mutation.SetNpcTemplateID(v)
+	return _u
+}
+
+// SetNillableNpcTemplateID sets the "npc_template_id" field if the given value is not nil.
+func (_u *DialogStateUpdate) SetNillableNpcTemplateID(v *string) *DialogStateUpdate {
+	if v != nil {
+		_u.SetNpcTemplateID(*v)
+	}
+	return _u
+}
+
+// SetFlags sets the "flags" field.
+func (_u *DialogStateUpdate) SetFlags(v map[string]bool) *DialogStateUpdate {
+	_u.mutation.SetFlags(v)
+	return _u
+}
+
+// ClearFlags clears the value of the "flags" field.
+func (_u *DialogStateUpdate) ClearFlags() *DialogStateUpdate {
+	_u.mutation.ClearFlags()
+	return _u
+}
+
+// SetCounters sets the "counters" field.
+func (_u *DialogStateUpdate) SetCounters(v map[string]int) *DialogStateUpdate {
+	_u.mutation.SetCounters(v)
+	return _u
+}
+
+// ClearCounters clears the value of the "counters" field.
+func (_u *DialogStateUpdate) ClearCounters() *DialogStateUpdate {
+	_u.mutation.ClearCounters()
+	return _u
+}
+
+// SetTimesTalked sets the "times_talked" field.
+func (_u *DialogStateUpdate) SetTimesTalked(v int) *DialogStateUpdate {
+	_u.mutation.ResetTimesTalked()
+	_u.mutation.SetTimesTalked(v)
+	return _u
+}
+
+// SetNillableTimesTalked sets the "times_talked" field if the given value is not nil.
+func (_u *DialogStateUpdate) SetNillableTimesTalked(v *int) *DialogStateUpdate {
+	if v != nil {
+		_u.SetTimesTalked(*v)
+	}
+	return _u
+}
+
+// AddTimesTalked adds value to the "times_talked" field.
+func (_u *DialogStateUpdate) AddTimesTalked(v int) *DialogStateUpdate {
+	_u.mutation.AddTimesTalked(v)
+	return _u
+}
+
+// SetFirstMetAt sets the "first_met_at" field.
+func (_u *DialogStateUpdate) SetFirstMetAt(v time.Time) *DialogStateUpdate {
+	_u.mutation.SetFirstMetAt(v)
+	return _u
+}
+
+// SetNillableFirstMetAt sets the "first_met_at" field if the given value is not nil.
+func (_u *DialogStateUpdate) SetNillableFirstMetAt(v *time.Time) *DialogStateUpdate {
+	if v != nil {
+		_u.SetFirstMetAt(*v)
+	}
+	return _u
+}
+
+// ClearFirstMetAt clears the value of the "first_met_at" field.
+func (_u *DialogStateUpdate) ClearFirstMetAt() *DialogStateUpdate {
+	_u.mutation.ClearFirstMetAt()
+	return _u
+}
+
+// SetLastNodeID sets the "last_node_id" field.
+func (_u *DialogStateUpdate) SetLastNodeID(v string) *DialogStateUpdate {
+	_u.mutation.SetLastNodeID(v)
+	return _u
+}
+
+// SetNillableLastNodeID sets the "last_node_id" field if the given value is not nil.
+func (_u *DialogStateUpdate) SetNillableLastNodeID(v *string) *DialogStateUpdate {
+	if v != nil {
+		_u.SetLastNodeID(*v)
+	}
+	return _u
+}
+
+// ClearLastNodeID clears the value of the "last_node_id" field.
+func (_u *DialogStateUpdate) ClearLastNodeID() *DialogStateUpdate {
+	_u.mutation.ClearLastNodeID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DialogStateUpdate) SetUpdatedAt(v time.Time) *DialogStateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DialogStateMutation object of the builder.
+func (_u *DialogStateUpdate) Mutation() *DialogStateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DialogStateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DialogStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DialogStateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DialogStateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DialogStateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := dialogstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *DialogStateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dialogstate.Table, dialogstate.Columns, sqlgraph.NewFieldSpec(dialogstate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CharacterID(); ok {
+		_spec.SetField(dialogstate.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharacterID(); ok {
+		_spec.AddField(dialogstate.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NpcTemplateID(); ok {
+		_spec.SetField(dialogstate.FieldNpcTemplateID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Flags(); ok {
+		_spec.SetField(dialogstate.FieldFlags, field.TypeJSON, value)
+	}
+	if _u.mutation.FlagsCleared() {
+		_spec.ClearField(dialogstate.FieldFlags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Counters(); ok {
+		_spec.SetField(dialogstate.FieldCounters, field.TypeJSON, value)
+	}
+	if _u.mutation.CountersCleared() {
+		_spec.ClearField(dialogstate.FieldCounters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TimesTalked(); ok {
+		_spec.SetField(dialogstate.FieldTimesTalked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimesTalked(); ok {
+		_spec.AddField(dialogstate.FieldTimesTalked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FirstMetAt(); ok {
+		_spec.SetField(dialogstate.FieldFirstMetAt, field.TypeTime, value)
+	}
+	if _u.mutation.FirstMetAtCleared() {
+		_spec.ClearField(dialogstate.FieldFirstMetAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastNodeID(); ok {
+		_spec.SetField(dialogstate.FieldLastNodeID, field.TypeString, value)
+	}
+	if _u.mutation.LastNodeIDCleared() {
+		_spec.ClearField(dialogstate.FieldLastNodeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(dialogstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dialogstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DialogStateUpdateOne is the builder for updating a single DialogState entity.
+type DialogStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DialogStateMutation
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_u *DialogStateUpdateOne) SetCharacterID(v int) *DialogStateUpdateOne {
+	_u.mutation.ResetCharacterID()
+	_u.mutation.SetCharacterID(v)
+	return _u
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_u *DialogStateUpdateOne) SetNillableCharacterID(v *int) *DialogStateUpdateOne {
+	if v != nil {
+		_u.SetCharacterID(*v)
+	}
+	return _u
+}
+
+// AddCharacterID adds value to the "character_id" field.
+func (_u *DialogStateUpdateOne) AddCharacterID(v int) *DialogStateUpdateOne {
+	_u.mutation.AddCharacterID(v)
+	return _u
+}
+
+// SetNpcTemplateID sets the "npc_template_id" field.
+func (_u *DialogStateUpdateOne) SetNpcTemplateID(v string) *DialogStateUpdateOne {
+	_u.mutation.SetNpcTemplateID(v)
+	return _u
+}
+
+// SetNillableNpcTemplateID sets the "npc_template_id" field if the given value is not nil.
+func (_u *DialogStateUpdateOne) SetNillableNpcTemplateID(v *string) *DialogStateUpdateOne {
+	if v != nil {
+		_u.SetNpcTemplateID(*v)
+	}
+	return _u
+}
+
+// SetFlags sets the "flags" field.
+func (_u *DialogStateUpdateOne) SetFlags(v map[string]bool) *DialogStateUpdateOne {
+	_u.mutation.SetFlags(v)
+	return _u
+}
+
+// ClearFlags clears the value of the "flags" field.
+func (_u *DialogStateUpdateOne) ClearFlags() *DialogStateUpdateOne {
+	_u.mutation.ClearFlags()
+	return _u
+}
+
+// SetCounters sets the "counters" field.
+func (_u *DialogStateUpdateOne) SetCounters(v map[string]int) *DialogStateUpdateOne {
+	_u.mutation.SetCounters(v)
+	return _u
+}
+
+// ClearCounters clears the value of the "counters" field.
+func (_u *DialogStateUpdateOne) ClearCounters() *DialogStateUpdateOne {
+	_u.mutation.ClearCounters()
+	return _u
+}
+
+// SetTimesTalked sets the "times_talked" field.
+func (_u *DialogStateUpdateOne) SetTimesTalked(v int) *DialogStateUpdateOne {
+	_u.mutation.ResetTimesTalked()
+	_u.mutation.SetTimesTalked(v)
+	return _u
+}
+
+// SetNillableTimesTalked sets the "times_talked" field if the given value is not nil.
+func (_u *DialogStateUpdateOne) SetNillableTimesTalked(v *int) *DialogStateUpdateOne {
+	if v != nil {
+		_u.SetTimesTalked(*v)
+	}
+	return _u
+}
+
+// AddTimesTalked adds value to the "times_talked" field.
+func (_u *DialogStateUpdateOne) AddTimesTalked(v int) *DialogStateUpdateOne {
+	_u.mutation.AddTimesTalked(v)
+	return _u
+}
+
+// SetFirstMetAt sets the "first_met_at" field.
+func (_u *DialogStateUpdateOne) SetFirstMetAt(v time.Time) *DialogStateUpdateOne {
+	_u.mutation.SetFirstMetAt(v)
+	return _u
+}
+
+// SetNillableFirstMetAt sets the "first_met_at" field if the given value is not nil.
+func (_u *DialogStateUpdateOne) SetNillableFirstMetAt(v *time.Time) *DialogStateUpdateOne {
+	if v != nil {
+		_u.SetFirstMetAt(*v)
+	}
+	return _u
+}
+
+// ClearFirstMetAt clears the value of the "first_met_at" field.
+func (_u *DialogStateUpdateOne) ClearFirstMetAt() *DialogStateUpdateOne {
+	_u.mutation.ClearFirstMetAt()
+	return _u
+}
+
+// SetLastNodeID sets the "last_node_id" field.
+func (_u *DialogStateUpdateOne) SetLastNodeID(v string) *DialogStateUpdateOne {
+	_u.mutation.SetLastNodeID(v)
+	return _u
+}
+
+// SetNillableLastNodeID sets the "last_node_id" field if the given value is not nil.
+func (_u *DialogStateUpdateOne) SetNillableLastNodeID(v *string) *DialogStateUpdateOne {
+	if v != nil {
+		_u.SetLastNodeID(*v)
+	}
+	return _u
+}
+
+// ClearLastNodeID clears the value of the "last_node_id" field.
+func (_u *DialogStateUpdateOne) ClearLastNodeID() *DialogStateUpdateOne {
+	_u.mutation.ClearLastNodeID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DialogStateUpdateOne) SetUpdatedAt(v time.Time) *DialogStateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DialogStateMutation object of the builder.
+func (_u *DialogStateUpdateOne) Mutation() *DialogStateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DialogStateUpdate builder.
+func (_u *DialogStateUpdateOne) Where(ps ...predicate.DialogState) *DialogStateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DialogStateUpdateOne) Select(field string, fields ...string) *DialogStateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DialogState entity.
+func (_u *DialogStateUpdateOne) Save(ctx context.Context) (*DialogState, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DialogStateUpdateOne) SaveX(ctx context.Context) *DialogState {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DialogStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DialogStateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DialogStateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := dialogstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *DialogStateUpdateOne) sqlSave(ctx context.Context) (_node *DialogState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dialogstate.Table, dialogstate.Columns, sqlgraph.NewFieldSpec(dialogstate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "DialogState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dialogstate.FieldID)
+		for _, f := range fields {
+			if !dialogstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != dialogstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CharacterID(); ok {
+		_spec.SetField(dialogstate.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharacterID(); ok {
+		_spec.AddField(dialogstate.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NpcTemplateID(); ok {
+		_spec.SetField(dialogstate.FieldNpcTemplateID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Flags(); ok {
+		_spec.SetField(dialogstate.FieldFlags, field.TypeJSON, value)
+	}
+	if _u.mutation.FlagsCleared() {
+		_spec.ClearField(dialogstate.FieldFlags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Counters(); ok {
+		_spec.SetField(dialogstate.FieldCounters, field.TypeJSON, value)
+	}
+	if _u.mutation.CountersCleared() {
+		_spec.ClearField(dialogstate.FieldCounters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TimesTalked(); ok {
+		_spec.SetField(dialogstate.FieldTimesTalked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimesTalked(); ok {
+		_spec.AddField(dialogstate.FieldTimesTalked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FirstMetAt(); ok {
+		_spec.SetField(dialogstate.FieldFirstMetAt, field.TypeTime, value)
+	}
+	if _u.mutation.FirstMetAtCleared() {
+		_spec.ClearField(dialogstate.FieldFirstMetAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastNodeID(); ok {
+		_spec.SetField(dialogstate.FieldLastNodeID, field.TypeString, value)
+	}
+	if _u.mutation.LastNodeIDCleared() {
+		_spec.ClearField(dialogstate.FieldLastNodeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(dialogstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DialogState{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dialogstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
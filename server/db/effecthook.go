@@ -22,9 +22,9 @@ type EffectHook struct {
 	WorldID string `json:"world_id,omitempty"`
 	// Display name, e.g., 'Death Drain — XP from killer'
 	Name string `json:"name,omitempty"`
-	// on_death|on_hit_received|on_hit_dealt|on_kill|on_enter_room|on_leave_room|on_equip|on_unequip|on_login|on_effect_start|on_effect_end
+	// on_death|on_hit_received|on_hit_dealt|on_kill|on_enter_room|on_leave_room|on_equip|on_unequip|on_login|on_effect_start|on_effect_end|dawn|day|dusk|night
 	Event string `json:"event,omitempty"`
-	// self|attacker|killer|room|owner — who the effect targets
+	// self|attacker|killer|room|room_except_source|owner — who the effect targets
 	Target string `json:"target,omitempty"`
 	// Optional SPICE condition expression (deferred)
 	Condition string `json:"condition,omitempty"`
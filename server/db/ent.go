@@ -11,9 +11,11 @@ import (
 	"herbst-server/db/achievement"
 	"herbst-server/db/activeeffect"
 	"herbst-server/db/applog"
+	"herbst-server/db/auction"
 	"herbst-server/db/channelconfig"
 	"herbst-server/db/character"
 	"herbst-server/db/characterability"
+	"herbst-server/db/characterachievement"
 	"herbst-server/db/characterchannel"
 	"herbst-server/db/characterclasshistory"
 	"herbst-server/db/charactercompetency"
@@ -26,26 +28,36 @@ import (
 	"herbst-server/db/competencylevelthreshold"
 	"herbst-server/db/craftingrecipe"
 	"herbst-server/db/damagelog"
+	"herbst-server/db/deadletter"
 	"herbst-server/db/dialognode"
+	"herbst-server/db/dialogstate"
 	"herbst-server/db/effect"
 	"herbst-server/db/effecthook"
 	"herbst-server/db/equipment"
 	"herbst-server/db/equipmenttemplate"
+	"herbst-server/db/eventcursor"
 	"herbst-server/db/faction"
 	"herbst-server/db/factioncategory"
 	"herbst-server/db/factionrequiredtag"
 	"herbst-server/db/gameconfig"
 	"herbst-server/db/gender"
+	"herbst-server/db/house"
+	"herbst-server/db/mail"
 	"herbst-server/db/npcability"
 	"herbst-server/db/npctemplate"
+	"herbst-server/db/outboxevent"
+	"herbst-server/db/party"
+	"herbst-server/db/partyinvite"
 	"herbst-server/db/quest"
 	"herbst-server/db/questprogress"
 	"herbst-server/db/race"
+	"herbst-server/db/reputation"
 	"herbst-server/db/room"
 	"herbst-server/db/shopitem"
 	"herbst-server/db/shoptemplate"
 	"herbst-server/db/skill"
 	"herbst-server/db/socialcommand"
+	"herbst-server/db/stash"
 	"herbst-server/db/systemlog"
 	"herbst-server/db/tag"
 	"herbst-server/db/tellqueue"
@@ -53,6 +65,7 @@ import (
 	"herbst-server/db/user"
 	"herbst-server/db/world"
 	"herbst-server/db/zone"
+	"herbst-server/db/zoneinstance"
 	"reflect"
 	"sync"
 
@@ -124,9 +137,11 @@ func checkColumn(t, c string) error {
 			achievement.Table:              achievement.ValidColumn,
 			activeeffect.Table:             activeeffect.ValidColumn,
 			applog.Table:                   applog.ValidColumn,
+			auction.Table:                  auction.ValidColumn,
 			channelconfig.Table:            channelconfig.ValidColumn,
 			character.Table:                character.ValidColumn,
 			characterability.Table:         characterability.ValidColumn,
+			characterachievement.Table:     characterachievement.ValidColumn,
 			characterchannel.Table:         characterchannel.ValidColumn,
 			characterclasshistory.Table:    characterclasshistory.ValidColumn,
 			charactercompetency.Table:      charactercompetency.ValidColumn,
@@ -139,26 +154,36 @@ func checkColumn(t, c string) error {
 			competencylevelthreshold.Table: competencylevelthreshold.ValidColumn,
 			craftingrecipe.Table:           craftingrecipe.ValidColumn,
 			damagelog.Table:                damagelog.ValidColumn,
+			deadletter.Table:               deadletter.ValidColumn,
 			dialognode.Table:               dialognode.ValidColumn,
+			dialogstate.Table:              dialogstate.ValidColumn,
 			effect.Table:                   effect.ValidColumn,
 			effecthook.Table:               effecthook.ValidColumn,
 			equipment.Table:                equipment.ValidColumn,
 			equipmenttemplate.Table:        equipmenttemplate.ValidColumn,
+			eventcursor.Table:              eventcursor.ValidColumn,
 			faction.Table:                  faction.ValidColumn,
 			factioncategory.Table:          factioncategory.ValidColumn,
 			factionrequiredtag.Table:       factionrequiredtag.ValidColumn,
 			gameconfig.Table:               gameconfig.ValidColumn,
 			gender.Table:                   gender.ValidColumn,
+			house.Table:                    house.ValidColumn,
+			mail.Table:                     mail.ValidColumn,
 			npcability.Table:               npcability.ValidColumn,
 			npctemplate.Table:              npctemplate.ValidColumn,
+			outboxevent.Table:              outboxevent.ValidColumn,
+			party.Table:                    party.ValidColumn,
+			partyinvite.Table:              partyinvite.ValidColumn,
 			quest.Table:                    quest.ValidColumn,
 			questprogress.Table:            questprogress.ValidColumn,
 			race.Table:                     race.ValidColumn,
+			reputation.Table:               reputation.ValidColumn,
 			room.Table:                     room.ValidColumn,
 			shopitem.Table:                 shopitem.ValidColumn,
 			shoptemplate.Table:             shoptemplate.ValidColumn,
 			skill.Table:                    skill.ValidColumn,
 			socialcommand.Table:            socialcommand.ValidColumn,
+			stash.Table:                    stash.ValidColumn,
 			systemlog.Table:                systemlog.ValidColumn,
 			tag.Table:                      tag.ValidColumn,
 			tellqueue.Table:                tellqueue.ValidColumn,
@@ -166,6 +191,7 @@ func checkColumn(t, c string) error {
 			user.Table:                     user.ValidColumn,
 			world.Table:                    world.ValidColumn,
 			zone.Table:                     zone.ValidColumn,
+			zoneinstance.Table:             zoneinstance.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"herbst-server/db/eventcursor"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EventCursor is the model entity for the EventCursor schema.
+type EventCursor struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Subscriber name
+	ID string `json:"id,omitempty"`
+	// ID of the last outbox event handled
+	LastEventID int `json:"last_event_id,omitempty"`
+	// Failed attempts at the next event
+	Attempts int `json:"attempts,omitempty"`
+	// When to retry the next event after a failure
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// Error from the most recent failed attempt
+	LastError string `json:"last_error,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventCursor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventcursor.FieldLastEventID, eventcursor.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case eventcursor.FieldID, eventcursor.FieldLastError:
+			values[i] = new(sql.NullString)
+		case eventcursor.FieldNextAttemptAt, eventcursor.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventCursor fields.
+func (_m *EventCursor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventcursor.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case eventcursor.FieldLastEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_event_id", values[i])
+			} else if value.Valid {
+				_m.LastEventID = int(value.Int64)
+			}
+		case eventcursor.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case eventcursor.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = new(time.Time)
+				*_m.NextAttemptAt = value.Time
+			}
+		case eventcursor.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case eventcursor.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventCursor.
+// This includes values selected through modifiers, order, etc.
+func (_m *EventCursor) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventCursor.
+// Note that you need to call EventCursor.Unwrap() before calling this method if this EventCursor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EventCursor) Update() *EventCursorUpdateOne {
+	return NewEventCursorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EventCursor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EventCursor) Unwrap() *EventCursor {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: EventCursor is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EventCursor) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventCursor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("last_event_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastEventID))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	if v := _m.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventCursors is a parsable slice of EventCursor.
+type EventCursors []*EventCursor
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventcursor
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldContainsFold(FieldID, id))
+}
+
+// LastEventID applies equality check predicate on the "last_event_id" field. It's identical to LastEventIDEQ.
+func LastEventID(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldLastEventID, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldLastError, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LastEventIDEQ applies the EQ predicate on the "last_event_id" field.
+func LastEventIDEQ(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldLastEventID, v))
+}
+
+// LastEventIDNEQ applies the NEQ predicate on the "last_event_id" field.
+func LastEventIDNEQ(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNEQ(FieldLastEventID, v))
+}
+
+// LastEventIDIn applies the In predicate on the "last_event_id" field.
+func LastEventIDIn(vs ...int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldIn(FieldLastEventID, vs...))
+}
+
+// LastEventIDNotIn applies the NotIn predicate on the "last_event_id" field.
+func LastEventIDNotIn(vs ...int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNotIn(FieldLastEventID, vs...))
+}
+
+// LastEventIDGT applies the GT predicate on the "last_event_id" field.
+func LastEventIDGT(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGT(FieldLastEventID, v))
+}
+
+// LastEventIDGTE applies the GTE predicate on the "last_event_id" field.
+func LastEventIDGTE(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGTE(FieldLastEventID, v))
+}
+
+// LastEventIDLT applies the LT predicate on the "last_event_id" field.
+func LastEventIDLT(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLT(FieldLastEventID, v))
+}
+
+// LastEventIDLTE applies the LTE predicate on the "last_event_id" field.
+func LastEventIDLTE(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLTE(FieldLastEventID, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EventCursor {
+	return predicate.EventCursor(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventCursor) predicate.EventCursor {
+	return predicate.EventCursor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventCursor) predicate.EventCursor {
+	return predicate.EventCursor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventCursor) predicate.EventCursor {
+	return predicate.EventCursor(sql.NotPredicates(p))
+}
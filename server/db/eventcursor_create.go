@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/eventcursor"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventCursorCreate is the builder for creating a EventCursor entity.
+type EventCursorCreate struct {
+	config
+	mutation *EventCursorMutation
+	hooks    []Hook
+}
+
+// SetLastEventID sets the "last_event_id" field.
+func (_c *EventCursorCreate) SetLastEventID(v int) *EventCursorCreate {
+	_c.mutation.SetLastEventID(v)
+	return _c
+}
+
+// SetNillableLastEventID sets the "last_event_id" field if the given value is not nil.
+func (_c *EventCursorCreate) SetNillableLastEventID(v *int) *EventCursorCreate {
+	if v != nil {
+		_c.SetLastEventID(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *EventCursorCreate) SetAttempts(v int) *EventCursorCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *EventCursorCreate) SetNillableAttempts(v *int) *EventCursorCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *EventCursorCreate) SetNextAttemptAt(v time.Time) *EventCursorCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *EventCursorCreate) SetNillableNextAttemptAt(v *time.Time) *EventCursorCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *EventCursorCreate) SetLastError(v string) *EventCursorCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *EventCursorCreate) SetNillableLastError(v *string) *EventCursorCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EventCursorCreate) SetUpdatedAt(v time.Time) *EventCursorCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EventCursorCreate) SetNillableUpdatedAt(v *time.Time) *EventCursorCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EventCursorCreate) SetID(v string) *EventCursorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EventCursorMutation object of the builder.
+func (_c *EventCursorCreate) Mutation() *EventCursorMutation {
+	return _c.mutation
+}
+
+// Save creates the EventCursor in the database.
+func (_c *EventCursorCreate) Save(ctx context.Context) (*EventCursor, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventCursorCreate) SaveX(ctx context.Context) *EventCursor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventCursorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventCursorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventCursorCreate) defaults() {
+	if _, ok := _c.mutation.LastEventID(); !ok {
+		v := eventcursor.DefaultLastEventID
+		_c.mutation.SetLastEventID(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := eventcursor.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := eventcursor.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventCursorCreate) check() error {
+	if _, ok := _c.mutation.LastEventID(); !ok {
+		return &ValidationError{Name: "last_event_id", err: errors.New(`db: missing required field "EventCursor.last_event_id"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`db: missing required field "EventCursor.attempts"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "EventCursor.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventCursorCreate) sqlSave(ctx context.Context) (*EventCursor, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EventCursor.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventCursorCreate) createSpec() (*EventCursor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventCursor{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventcursor.Table, sqlgraph.NewFieldSpec(eventcursor.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.LastEventID(); ok {
+		_spec.SetField(eventcursor.FieldLastEventID, field.TypeInt, value)
+		_node.LastEventID = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(eventcursor.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(eventcursor.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(eventcursor.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventcursor.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EventCursorCreateBulk is the builder for creating many EventCursor entities in bulk.
+type EventCursorCreateBulk struct {
+	config
+	err      error
+	builders []*EventCursorCreate
+}
+
+// Save creates the EventCursor entities in the database.
+func (_c *EventCursorCreateBulk) Save(ctx context.Context) ([]*EventCursor, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventCursor, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventCursorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventCursorCreateBulk) SaveX(ctx context.Context) []*EventCursor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventCursorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventCursorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/eventcursor"
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventCursorUpdate is the builder for updating EventCursor entities.
+type EventCursorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventCursorMutation
+}
+
+// Where appends a list predicates to the EventCursorUpdate builder.
+func (_u *EventCursorUpdate) Where(ps ...predicate.EventCursor) *EventCursorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLastEventID sets the "last_event_id" field.
+func (_u *EventCursorUpdate) SetLastEventID(v int) *EventCursorUpdate {
+	_u.mutation.ResetLastEventID()
+	_u.mutation.SetLastEventID(v)
+	return _u
+}
+
+// SetNillableLastEventID sets the "last_event_id" field if the given value is not nil.
+func (_u *EventCursorUpdate) SetNillableLastEventID(v *int) *EventCursorUpdate {
+	if v != nil {
+		_u.SetLastEventID(*v)
+	}
+	return _u
+}
+
+// AddLastEventID adds value to the "last_event_id" field.
+func (_u *EventCursorUpdate) AddLastEventID(v int) *EventCursorUpdate {
+	_u.mutation.AddLastEventID(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EventCursorUpdate) SetAttempts(v int) *EventCursorUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EventCursorUpdate) SetNillableAttempts(v *int) *EventCursorUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EventCursorUpdate) AddAttempts(v int) *EventCursorUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *EventCursorUpdate) SetNextAttemptAt(v time.Time) *EventCursorUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *EventCursorUpdate) SetNillableNextAttemptAt(v *time.Time) *EventCursorUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *EventCursorUpdate) ClearNextAttemptAt() *EventCursorUpdate {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EventCursorUpdate) SetLastError(v string) *EventCursorUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EventCursorUpdate) SetNillableLastError(v *string) *EventCursorUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EventCursorUpdate) ClearLastError() *EventCursorUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventCursorUpdate) SetUpdatedAt(v time.Time) *EventCursorUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EventCursorMutation object of the builder.
+func (_u *EventCursorUpdate) Mutation() *EventCursorMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventCursorUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventCursorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventCursorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventCursorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventCursorUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := eventcursor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *EventCursorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventcursor.Table, eventcursor.Columns, sqlgraph.NewFieldSpec(eventcursor.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LastEventID(); ok {
+		_spec.SetField(eventcursor.FieldLastEventID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastEventID(); ok {
+		_spec.AddField(eventcursor.FieldLastEventID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(eventcursor.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(eventcursor.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(eventcursor.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(eventcursor.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(eventcursor.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(eventcursor.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventcursor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventCursorUpdateOne is the builder for updating a single EventCursor entity.
+type EventCursorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventCursorMutation
+}
+
+// SetLastEventID sets the "last_event_id" field.
+func (_u *EventCursorUpdateOne) SetLastEventID(v int) *EventCursorUpdateOne {
+	_u.mutation.ResetLastEventID()
+	_u.mutation.SetLastEventID(v)
+	return _u
+}
+
+// SetNillableLastEventID sets the "last_event_id" field if the given value is not nil.
+func (_u *EventCursorUpdateOne) SetNillableLastEventID(v *int) *EventCursorUpdateOne {
+	if v != nil {
+		_u.SetLastEventID(*v)
+	}
+	return _u
+}
+
+// AddLastEventID adds value to the "last_event_id" field.
+func (_u *EventCursorUpdateOne) AddLastEventID(v int) *EventCursorUpdateOne {
+	_u.mutation.AddLastEventID(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EventCursorUpdateOne) SetAttempts(v int) *EventCursorUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EventCursorUpdateOne) SetNillableAttempts(v *int) *EventCursorUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EventCursorUpdateOne) AddAttempts(v int) *EventCursorUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *EventCursorUpdateOne) SetNextAttemptAt(v time.Time) *EventCursorUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *EventCursorUpdateOne) SetNillableNextAttemptAt(v *time.Time) *EventCursorUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *EventCursorUpdateOne) ClearNextAttemptAt() *EventCursorUpdateOne {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EventCursorUpdateOne) SetLastError(v string) *EventCursorUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EventCursorUpdateOne) SetNillableLastError(v *string) *EventCursorUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EventCursorUpdateOne) ClearLastError() *EventCursorUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventCursorUpdateOne) SetUpdatedAt(v time.Time) *EventCursorUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EventCursorMutation object of the builder.
+func (_u *EventCursorUpdateOne) Mutation() *EventCursorMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventCursorUpdate builder.
+func (_u *EventCursorUpdateOne) Where(ps ...predicate.EventCursor) *EventCursorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventCursorUpdateOne) Select(field string, fields ...string) *EventCursorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventCursor entity.
+func (_u *EventCursorUpdateOne) Save(ctx context.Context) (*EventCursor, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventCursorUpdateOne) SaveX(ctx context.Context) *EventCursor {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventCursorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventCursorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventCursorUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := eventcursor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *EventCursorUpdateOne) sqlSave(ctx context.Context) (_node *EventCursor, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventcursor.Table, eventcursor.Columns, sqlgraph.NewFieldSpec(eventcursor.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "EventCursor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventcursor.FieldID)
+		for _, f := range fields {
+			if !eventcursor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != eventcursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LastEventID(); ok {
+		_spec.SetField(eventcursor.FieldLastEventID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastEventID(); ok {
+		_spec.AddField(eventcursor.FieldLastEventID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(eventcursor.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(eventcursor.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(eventcursor.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(eventcursor.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(eventcursor.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(eventcursor.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventcursor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EventCursor{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -68,6 +68,18 @@ func (f AppLogFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AppLogMutation", m)
 }
 
+// The AuctionFunc type is an adapter to allow the use of ordinary
+// function as Auction mutator.
+type AuctionFunc func(context.Context, *db.AuctionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuctionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.AuctionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AuctionMutation", m)
+}
+
 // The ChannelConfigFunc type is an adapter to allow the use of ordinary
 // function as ChannelConfig mutator.
 type ChannelConfigFunc func(context.Context, *db.ChannelConfigMutation) (db.Value, error)
@@ -104,6 +116,18 @@ func (f CharacterAbilityFunc) Mutate(ctx context.Context, m db.Mutation) (db.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CharacterAbilityMutation", m)
 }
 
+// The CharacterAchievementFunc type is an adapter to allow the use of ordinary
+// function as CharacterAchievement mutator.
+type CharacterAchievementFunc func(context.Context, *db.CharacterAchievementMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CharacterAchievementFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.CharacterAchievementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CharacterAchievementMutation", m)
+}
+
 // The CharacterChannelFunc type is an adapter to allow the use of ordinary
 // function as CharacterChannel mutator.
 type CharacterChannelFunc func(context.Context, *db.CharacterChannelMutation) (db.Value, error)
@@ -248,6 +272,18 @@ func (f DamageLogFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DamageLogMutation", m)
 }
 
+// The DeadLetterFunc type is an adapter to allow the use of ordinary
+// function as DeadLetter mutator.
+type DeadLetterFunc func(context.Context, *db.DeadLetterMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeadLetterFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.DeadLetterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DeadLetterMutation", m)
+}
+
 // The DialogNodeFunc type is an adapter to allow the use of ordinary
 // function as DialogNode mutator.
 type DialogNodeFunc func(context.Context, *db.DialogNodeMutation) (db.Value, error)
@@ -260,6 +296,18 @@ func (f DialogNodeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DialogNodeMutation", m)
 }
 
+// The DialogStateFunc type is an adapter to allow the use of ordinary
+// function as DialogState mutator.
+type DialogStateFunc func(context.Context, *db.DialogStateMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DialogStateFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.DialogStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DialogStateMutation", m)
+}
+
 // The EffectFunc type is an adapter to allow the use of ordinary
 // function as Effect mutator.
 type EffectFunc func(context.Context, *db.EffectMutation) (db.Value, error)
@@ -308,6 +356,18 @@ func (f EquipmentTemplateFunc) Mutate(ctx context.Context, m db.Mutation) (db.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.EquipmentTemplateMutation", m)
 }
 
+// The EventCursorFunc type is an adapter to allow the use of ordinary
+// function as EventCursor mutator.
+type EventCursorFunc func(context.Context, *db.EventCursorMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventCursorFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.EventCursorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.EventCursorMutation", m)
+}
+
 // The FactionFunc type is an adapter to allow the use of ordinary
 // function as Faction mutator.
 type FactionFunc func(context.Context, *db.FactionMutation) (db.Value, error)
@@ -368,6 +428,30 @@ func (f GenderFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.GenderMutation", m)
 }
 
+// The HouseFunc type is an adapter to allow the use of ordinary
+// function as House mutator.
+type HouseFunc func(context.Context, *db.HouseMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HouseFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.HouseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.HouseMutation", m)
+}
+
+// The MailFunc type is an adapter to allow the use of ordinary
+// function as Mail mutator.
+type MailFunc func(context.Context, *db.MailMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MailFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.MailMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.MailMutation", m)
+}
+
 // The NPCAbilityFunc type is an adapter to allow the use of ordinary
 // function as NPCAbility mutator.
 type NPCAbilityFunc func(context.Context, *db.NPCAbilityMutation) (db.Value, error)
@@ -392,6 +476,42 @@ func (f NPCTemplateFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.NPCTemplateMutation", m)
 }
 
+// The OutboxEventFunc type is an adapter to allow the use of ordinary
+// function as OutboxEvent mutator.
+type OutboxEventFunc func(context.Context, *db.OutboxEventMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxEventFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.OutboxEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.OutboxEventMutation", m)
+}
+
+// The PartyFunc type is an adapter to allow the use of ordinary
+// function as Party mutator.
+type PartyFunc func(context.Context, *db.PartyMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PartyFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.PartyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PartyMutation", m)
+}
+
+// The PartyInviteFunc type is an adapter to allow the use of ordinary
+// function as PartyInvite mutator.
+type PartyInviteFunc func(context.Context, *db.PartyInviteMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PartyInviteFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.PartyInviteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PartyInviteMutation", m)
+}
+
 // The QuestFunc type is an adapter to allow the use of ordinary
 // function as Quest mutator.
 type QuestFunc func(context.Context, *db.QuestMutation) (db.Value, error)
@@ -428,6 +548,18 @@ func (f RaceFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.RaceMutation", m)
 }
 
+// The ReputationFunc type is an adapter to allow the use of ordinary
+// function as Reputation mutator.
+type ReputationFunc func(context.Context, *db.ReputationMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReputationFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ReputationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ReputationMutation", m)
+}
+
 // The RoomFunc type is an adapter to allow the use of ordinary
 // function as Room mutator.
 type RoomFunc func(context.Context, *db.RoomMutation) (db.Value, error)
@@ -488,6 +620,18 @@ func (f SocialCommandFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SocialCommandMutation", m)
 }
 
+// The StashFunc type is an adapter to allow the use of ordinary
+// function as Stash mutator.
+type StashFunc func(context.Context, *db.StashMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StashFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.StashMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.StashMutation", m)
+}
+
 // The SystemLogFunc type is an adapter to allow the use of ordinary
 // function as SystemLog mutator.
 type SystemLogFunc func(context.Context, *db.SystemLogMutation) (db.Value, error)
@@ -572,6 +716,18 @@ func (f ZoneFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ZoneMutation", m)
 }
 
+// The ZoneInstanceFunc type is an adapter to allow the use of ordinary
+// function as ZoneInstance mutator.
+type ZoneInstanceFunc func(context.Context, *db.ZoneInstanceMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ZoneInstanceFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ZoneInstanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ZoneInstanceMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, db.Mutation) bool
 
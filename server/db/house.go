@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"herbst-server/db/house"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// House is the model entity for the House schema.
+type House struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Zone the plot is in
+	ZoneID string `json:"zone_id,omitempty"`
+	// Public room the house is entered from
+	EntranceRoomID int `json:"entrance_room_id,omitempty"`
+	// Exit from the entrance room into the house
+	Direction string `json:"direction,omitempty"`
+	// Gold the plot sells for
+	Price int `json:"price,omitempty"`
+	// Rooms the house is built with when bought
+	RoomCount int `json:"room_count,omitempty"`
+	// Character who owns the house; 0 while the plot is for sale
+	OwnerID int `json:"owner_id,omitempty"`
+	// House rooms, starting with the one the entrance leads to
+	RoomIds []int `json:"room_ids,omitempty"`
+	// Characters the owner lets in
+	GuestIds []int `json:"guest_ids,omitempty"`
+	// Items placed as decoration -> whether they were immovable before
+	Decorations map[int]bool `json:"decorations,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// PurchasedAt holds the value of the "purchased_at" field.
+	PurchasedAt  *time.Time `json:"purchased_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*House) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case house.FieldRoomIds, house.FieldGuestIds, house.FieldDecorations:
+			values[i] = new([]byte)
+		case house.FieldID, house.FieldEntranceRoomID, house.FieldPrice, house.FieldRoomCount, house.FieldOwnerID:
+			values[i] = new(sql.NullInt64)
+		case house.FieldZoneID, house.FieldDirection:
+			values[i] = new(sql.NullString)
+		case house.FieldCreatedAt, house.FieldPurchasedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the House fields.
+func (_m *House) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case house.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case house.FieldZoneID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field zone_id", values[i])
+			} else if value.Valid {
+				_m.ZoneID = value.String
+			}
+		case house.FieldEntranceRoomID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entrance_room_id", values[i])
+			} else if value.Valid {
+				_m.EntranceRoomID = int(value.Int64)
+			}
+		case house.FieldDirection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direction", values[i])
+			} else if value.Valid {
+				_m.Direction = value.String
+			}
+		case house.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				_m.Price = int(value.Int64)
+			}
+		case house.FieldRoomCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field room_count", values[i])
+			} else if value.Valid {
+				_m.RoomCount = int(value.Int64)
+			}
+		case house.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = int(value.Int64)
+			}
+		case house.FieldRoomIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field room_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RoomIds); err != nil {
+					return fmt.Errorf("unmarshal field room_ids: %w", err)
+				}
+			}
+		case house.FieldGuestIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GuestIds); err != nil {
+					return fmt.Errorf("unmarshal field guest_ids: %w", err)
+				}
+			}
+		case house.FieldDecorations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field decorations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Decorations); err != nil {
+					return fmt.Errorf("unmarshal field decorations: %w", err)
+				}
+			}
+		case house.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case house.FieldPurchasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field purchased_at", values[i])
+			} else if value.Valid {
+				_m.PurchasedAt = new(time.Time)
+				*_m.PurchasedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the House.
+// This includes values selected through modifiers, order, etc.
+func (_m *House) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this House.
+// Note that you need to call House.Unwrap() before calling this method if this House
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *House) Update() *HouseUpdateOne {
+	return NewHouseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the House entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *House) Unwrap() *House {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: House is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *House) String() string {
+	var builder strings.Builder
+	builder.WriteString("House(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("zone_id=")
+	builder.WriteString(_m.ZoneID)
+	builder.WriteString(", ")
+	builder.WriteString("entrance_room_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntranceRoomID))
+	builder.WriteString(", ")
+	builder.WriteString("direction=")
+	builder.WriteString(_m.Direction)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("room_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoomCount))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("room_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoomIds))
+	builder.WriteString(", ")
+	builder.WriteString("guest_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GuestIds))
+	builder.WriteString(", ")
+	builder.WriteString("decorations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Decorations))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.PurchasedAt; v != nil {
+		builder.WriteString("purchased_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Houses is a parsable slice of House.
+type Houses []*House
@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package house
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the house type in the database.
+	Label = "house"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldZoneID holds the string denoting the zone_id field in the database.
+	FieldZoneID = "zone_id"
+	// FieldEntranceRoomID holds the string denoting the entrance_room_id field in the database.
+	FieldEntranceRoomID = "entrance_room_id"
+	// FieldDirection holds the string denoting the direction field in the database.
+	FieldDirection = "direction"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldRoomCount holds the string denoting the room_count field in the database.
+	FieldRoomCount = "room_count"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldRoomIds holds the string denoting the room_ids field in the database.
+	FieldRoomIds = "room_ids"
+	// FieldGuestIds holds the string denoting the guest_ids field in the database.
+	FieldGuestIds = "guest_ids"
+	// FieldDecorations holds the string denoting the decorations field in the database.
+	FieldDecorations = "decorations"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldPurchasedAt holds the string denoting the purchased_at field in the database.
+	FieldPurchasedAt = "purchased_at"
+	// Table holds the table name of the house in the database.
+	Table = "houses"
+)
+
+// Columns holds all SQL columns for house fields.
+var Columns = []string{
+	FieldID,
+	FieldZoneID,
+	FieldEntranceRoomID,
+	FieldDirection,
+	FieldPrice,
+	FieldRoomCount,
+	FieldOwnerID,
+	FieldRoomIds,
+	FieldGuestIds,
+	FieldDecorations,
+	FieldCreatedAt,
+	FieldPurchasedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRoomCount holds the default value on creation for the "room_count" field.
+	DefaultRoomCount int
+	// DefaultOwnerID holds the default value on creation for the "owner_id" field.
+	DefaultOwnerID int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the House queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByZoneID orders the results by the zone_id field.
+func ByZoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldZoneID, opts...).ToFunc()
+}
+
+// ByEntranceRoomID orders the results by the entrance_room_id field.
+func ByEntranceRoomID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntranceRoomID, opts...).ToFunc()
+}
+
+// ByDirection orders the results by the direction field.
+func ByDirection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirection, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByRoomCount orders the results by the room_count field.
+func ByRoomCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoomCount, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPurchasedAt orders the results by the purchased_at field.
+func ByPurchasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurchasedAt, opts...).ToFunc()
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package house
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.House {
+	return predicate.House(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.House {
+	return predicate.House(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.House {
+	return predicate.House(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldID, id))
+}
+
+// ZoneID applies equality check predicate on the "zone_id" field. It's identical to ZoneIDEQ.
+func ZoneID(v string) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldZoneID, v))
+}
+
+// EntranceRoomID applies equality check predicate on the "entrance_room_id" field. It's identical to EntranceRoomIDEQ.
+func EntranceRoomID(v int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldEntranceRoomID, v))
+}
+
+// Direction applies equality check predicate on the "direction" field. It's identical to DirectionEQ.
+func Direction(v string) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldDirection, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldPrice, v))
+}
+
+// RoomCount applies equality check predicate on the "room_count" field. It's identical to RoomCountEQ.
+func RoomCount(v int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldRoomCount, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PurchasedAt applies equality check predicate on the "purchased_at" field. It's identical to PurchasedAtEQ.
+func PurchasedAt(v time.Time) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldPurchasedAt, v))
+}
+
+// ZoneIDEQ applies the EQ predicate on the "zone_id" field.
+func ZoneIDEQ(v string) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldZoneID, v))
+}
+
+// ZoneIDNEQ applies the NEQ predicate on the "zone_id" field.
+func ZoneIDNEQ(v string) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldZoneID, v))
+}
+
+// ZoneIDIn applies the In predicate on the "zone_id" field.
+func ZoneIDIn(vs ...string) predicate.House {
+	return predicate.House(sql.FieldIn(FieldZoneID, vs...))
+}
+
+// ZoneIDNotIn applies the NotIn predicate on the "zone_id" field.
+func ZoneIDNotIn(vs ...string) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldZoneID, vs...))
+}
+
+// ZoneIDGT applies the GT predicate on the "zone_id" field.
+func ZoneIDGT(v string) predicate.House {
+	return predicate.House(sql.FieldGT(FieldZoneID, v))
+}
+
+// ZoneIDGTE applies the GTE predicate on the "zone_id" field.
+func ZoneIDGTE(v string) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldZoneID, v))
+}
+
+// ZoneIDLT applies the LT predicate on the "zone_id" field.
+func ZoneIDLT(v string) predicate.House {
+	return predicate.House(sql.FieldLT(FieldZoneID, v))
+}
+
+// ZoneIDLTE applies the LTE predicate on the "zone_id" field.
+func ZoneIDLTE(v string) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldZoneID, v))
+}
+
+// ZoneIDContains applies the Contains predicate on the "zone_id" field.
+func ZoneIDContains(v string) predicate.House {
+	return predicate.House(sql.FieldContains(FieldZoneID, v))
+}
+
+// ZoneIDHasPrefix applies the HasPrefix predicate on the "zone_id" field.
+func ZoneIDHasPrefix(v string) predicate.House {
+	return predicate.House(sql.FieldHasPrefix(FieldZoneID, v))
+}
+
+// ZoneIDHasSuffix applies the HasSuffix predicate on the "zone_id" field.
+func ZoneIDHasSuffix(v string) predicate.House {
+	return predicate.House(sql.FieldHasSuffix(FieldZoneID, v))
+}
+
+// ZoneIDEqualFold applies the EqualFold predicate on the "zone_id" field.
+func ZoneIDEqualFold(v string) predicate.House {
+	return predicate.House(sql.FieldEqualFold(FieldZoneID, v))
+}
+
+// ZoneIDContainsFold applies the ContainsFold predicate on the "zone_id" field.
+func ZoneIDContainsFold(v string) predicate.House {
+	return predicate.House(sql.FieldContainsFold(FieldZoneID, v))
+}
+
+// EntranceRoomIDEQ applies the EQ predicate on the "entrance_room_id" field.
+func EntranceRoomIDEQ(v int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldEntranceRoomID, v))
+}
+
+// EntranceRoomIDNEQ applies the NEQ predicate on the "entrance_room_id" field.
+func EntranceRoomIDNEQ(v int) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldEntranceRoomID, v))
+}
+
+// EntranceRoomIDIn applies the In predicate on the "entrance_room_id" field.
+func EntranceRoomIDIn(vs ...int) predicate.House {
+	return predicate.House(sql.FieldIn(FieldEntranceRoomID, vs...))
+}
+
+// EntranceRoomIDNotIn applies the NotIn predicate on the "entrance_room_id" field.
+func EntranceRoomIDNotIn(vs ...int) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldEntranceRoomID, vs...))
+}
+
+// EntranceRoomIDGT applies the GT predicate on the "entrance_room_id" field.
+func EntranceRoomIDGT(v int) predicate.House {
+	return predicate.House(sql.FieldGT(FieldEntranceRoomID, v))
+}
+
+// EntranceRoomIDGTE applies the GTE predicate on the "entrance_room_id" field.
+func EntranceRoomIDGTE(v int) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldEntranceRoomID, v))
+}
+
+// EntranceRoomIDLT applies the LT predicate on the "entrance_room_id" field.
+func EntranceRoomIDLT(v int) predicate.House {
+	return predicate.House(sql.FieldLT(FieldEntranceRoomID, v))
+}
+
+// EntranceRoomIDLTE applies the LTE predicate on the "entrance_room_id" field.
+func EntranceRoomIDLTE(v int) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldEntranceRoomID, v))
+}
+
+// DirectionEQ applies the EQ predicate on the "direction" field.
+func DirectionEQ(v string) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldDirection, v))
+}
+
+// DirectionNEQ applies the NEQ predicate on the "direction" field.
+func DirectionNEQ(v string) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldDirection, v))
+}
+
+// DirectionIn applies the In predicate on the "direction" field.
+func DirectionIn(vs ...string) predicate.House {
+	return predicate.House(sql.FieldIn(FieldDirection, vs...))
+}
+
+// DirectionNotIn applies the NotIn predicate on the "direction" field.
+func DirectionNotIn(vs ...string) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldDirection, vs...))
+}
+
+// DirectionGT applies the GT predicate on the "direction" field.
+func DirectionGT(v string) predicate.House {
+	return predicate.House(sql.FieldGT(FieldDirection, v))
+}
+
+// DirectionGTE applies the GTE predicate on the "direction" field.
+func DirectionGTE(v string) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldDirection, v))
+}
+
+// DirectionLT applies the LT predicate on the "direction" field.
+func DirectionLT(v string) predicate.House {
+	return predicate.House(sql.FieldLT(FieldDirection, v))
+}
+
+// DirectionLTE applies the LTE predicate on the "direction" field.
+func DirectionLTE(v string) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldDirection, v))
+}
+
+// DirectionContains applies the Contains predicate on the "direction" field.
+func DirectionContains(v string) predicate.House {
+	return predicate.House(sql.FieldContains(FieldDirection, v))
+}
+
+// DirectionHasPrefix applies the HasPrefix predicate on the "direction" field.
+func DirectionHasPrefix(v string) predicate.House {
+	return predicate.House(sql.FieldHasPrefix(FieldDirection, v))
+}
+
+// DirectionHasSuffix applies the HasSuffix predicate on the "direction" field.
+func DirectionHasSuffix(v string) predicate.House {
+	return predicate.House(sql.FieldHasSuffix(FieldDirection, v))
+}
+
+// DirectionEqualFold applies the EqualFold predicate on the "direction" field.
+func DirectionEqualFold(v string) predicate.House {
+	return predicate.House(sql.FieldEqualFold(FieldDirection, v))
+}
+
+// DirectionContainsFold applies the ContainsFold predicate on the "direction" field.
+func DirectionContainsFold(v string) predicate.House {
+	return predicate.House(sql.FieldContainsFold(FieldDirection, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int) predicate.House {
+	return predicate.House(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int) predicate.House {
+	return predicate.House(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int) predicate.House {
+	return predicate.House(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldPrice, v))
+}
+
+// RoomCountEQ applies the EQ predicate on the "room_count" field.
+func RoomCountEQ(v int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldRoomCount, v))
+}
+
+// RoomCountNEQ applies the NEQ predicate on the "room_count" field.
+func RoomCountNEQ(v int) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldRoomCount, v))
+}
+
+// RoomCountIn applies the In predicate on the "room_count" field.
+func RoomCountIn(vs ...int) predicate.House {
+	return predicate.House(sql.FieldIn(FieldRoomCount, vs...))
+}
+
+// RoomCountNotIn applies the NotIn predicate on the "room_count" field.
+func RoomCountNotIn(vs ...int) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldRoomCount, vs...))
+}
+
+// RoomCountGT applies the GT predicate on the "room_count" field.
+func RoomCountGT(v int) predicate.House {
+	return predicate.House(sql.FieldGT(FieldRoomCount, v))
+}
+
+// RoomCountGTE applies the GTE predicate on the "room_count" field.
+func RoomCountGTE(v int) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldRoomCount, v))
+}
+
+// RoomCountLT applies the LT predicate on the "room_count" field.
+func RoomCountLT(v int) predicate.House {
+	return predicate.House(sql.FieldLT(FieldRoomCount, v))
+}
+
+// RoomCountLTE applies the LTE predicate on the "room_count" field.
+func RoomCountLTE(v int) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldRoomCount, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v int) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v int) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...int) predicate.House {
+	return predicate.House(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...int) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v int) predicate.House {
+	return predicate.House(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v int) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v int) predicate.House {
+	return predicate.House(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v int) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// RoomIdsIsNil applies the IsNil predicate on the "room_ids" field.
+func RoomIdsIsNil() predicate.House {
+	return predicate.House(sql.FieldIsNull(FieldRoomIds))
+}
+
+// RoomIdsNotNil applies the NotNil predicate on the "room_ids" field.
+func RoomIdsNotNil() predicate.House {
+	return predicate.House(sql.FieldNotNull(FieldRoomIds))
+}
+
+// GuestIdsIsNil applies the IsNil predicate on the "guest_ids" field.
+func GuestIdsIsNil() predicate.House {
+	return predicate.House(sql.FieldIsNull(FieldGuestIds))
+}
+
+// GuestIdsNotNil applies the NotNil predicate on the "guest_ids" field.
+func GuestIdsNotNil() predicate.House {
+	return predicate.House(sql.FieldNotNull(FieldGuestIds))
+}
+
+// DecorationsIsNil applies the IsNil predicate on the "decorations" field.
+func DecorationsIsNil() predicate.House {
+	return predicate.House(sql.FieldIsNull(FieldDecorations))
+}
+
+// DecorationsNotNil applies the NotNil predicate on the "decorations" field.
+func DecorationsNotNil() predicate.House {
+	return predicate.House(sql.FieldNotNull(FieldDecorations))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.House {
+	return predicate.House(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.House {
+	return predicate.House(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.House {
+	return predicate.House(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// PurchasedAtEQ applies the EQ predicate on the "purchased_at" field.
+func PurchasedAtEQ(v time.Time) predicate.House {
+	return predicate.House(sql.FieldEQ(FieldPurchasedAt, v))
+}
+
+// PurchasedAtNEQ applies the NEQ predicate on the "purchased_at" field.
+func PurchasedAtNEQ(v time.Time) predicate.House {
+	return predicate.House(sql.FieldNEQ(FieldPurchasedAt, v))
+}
+
+// PurchasedAtIn applies the In predicate on the "purchased_at" field.
+func PurchasedAtIn(vs ...time.Time) predicate.House {
+	return predicate.House(sql.FieldIn(FieldPurchasedAt, vs...))
+}
+
+// PurchasedAtNotIn applies the NotIn predicate on the "purchased_at" field.
+func PurchasedAtNotIn(vs ...time.Time) predicate.House {
+	return predicate.House(sql.FieldNotIn(FieldPurchasedAt, vs...))
+}
+
+// PurchasedAtGT applies the GT predicate on the "purchased_at" field.
+func PurchasedAtGT(v time.Time) predicate.House {
+	return predicate.House(sql.FieldGT(FieldPurchasedAt, v))
+}
+
+// PurchasedAtGTE applies the GTE predicate on the "purchased_at" field.
+func PurchasedAtGTE(v time.Time) predicate.House {
+	return predicate.House(sql.FieldGTE(FieldPurchasedAt, v))
+}
+
+// PurchasedAtLT applies the LT predicate on the "purchased_at" field.
+func PurchasedAtLT(v time.Time) predicate.House {
+	return predicate.House(sql.FieldLT(FieldPurchasedAt, v))
+}
+
+// PurchasedAtLTE applies the LTE predicate on the "purchased_at" field.
+func PurchasedAtLTE(v time.Time) predicate.House {
+	return predicate.House(sql.FieldLTE(FieldPurchasedAt, v))
+}
+
+// PurchasedAtIsNil applies the IsNil predicate on the "purchased_at" field.
+func PurchasedAtIsNil() predicate.House {
+	return predicate.House(sql.FieldIsNull(FieldPurchasedAt))
+}
+
+// PurchasedAtNotNil applies the NotNil predicate on the "purchased_at" field.
+func PurchasedAtNotNil() predicate.House {
+	return predicate.House(sql.FieldNotNull(FieldPurchasedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.House) predicate.House {
+	return predicate.House(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.House) predicate.House {
+	return predicate.House(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.House) predicate.House {
+	return predicate.House(sql.NotPredicates(p))
+}
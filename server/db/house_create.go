@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/house"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HouseCreate is the builder for creating a House entity.
+type HouseCreate struct {
+	config
+	mutation *HouseMutation
+	hooks    []Hook
+}
+
+// SetZoneID sets the "zone_id" field.
+func (_c *HouseCreate) SetZoneID(v string) *HouseCreate {
+	_c.mutation.SetZoneID(v)
+	return _c
+}
+
+// SetEntranceRoomID sets the "entrance_room_id" field.
+func (_c *HouseCreate) SetEntranceRoomID(v int) *HouseCreate {
+	_c.mutation.SetEntranceRoomID(v)
+	return _c
+}
+
+// SetDirection sets the "direction" field.
+func (_c *HouseCreate) SetDirection(v string) *HouseCreate {
+	_c.mutation.SetDirection(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *HouseCreate) SetPrice(v int) *HouseCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetRoomCount sets the "room_count" field.
+func (_c *HouseCreate) SetRoomCount(v int) *HouseCreate {
+	_c.mutation.SetRoomCount(v)
+	return _c
+}
+
+// SetNillableRoomCount sets the "room_count" field if the given value is not nil.
+func (_c *HouseCreate) SetNillableRoomCount(v *int) *HouseCreate {
+	if v != nil {
+		_c.SetRoomCount(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *HouseCreate) SetOwnerID(v int) *HouseCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *HouseCreate) SetNillableOwnerID(v *int) *HouseCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetRoomIds sets the "room_ids" field.
+func (_c *HouseCreate) SetRoomIds(v []int) *HouseCreate {
+	_c.mutation.SetRoomIds(v)
+	return _c
+}
+
+// SetGuestIds sets the "guest_ids" field.
+func (_c *HouseCreate) SetGuestIds(v []int) *HouseCreate {
+	_c.mutation.SetGuestIds(v)
+	return _c
+}
+
+// SetDecorations sets the "decorations" field.
+func (_c *HouseCreate) SetDecorations(v map[int]bool) *HouseCreate {
+	_c.mutation.SetDecorations(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HouseCreate) SetCreatedAt(v time.Time) *HouseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HouseCreate) SetNillableCreatedAt(v *time.Time) *HouseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_c *HouseCreate) SetPurchasedAt(v time.Time) *HouseCreate {
+	_c.mutation.SetPurchasedAt(v)
+	return _c
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_c *HouseCreate) SetNillablePurchasedAt(v *time.Time) *HouseCreate {
+	if v != nil {
+		_c.SetPurchasedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the HouseMutation object of the builder.
+func (_c *HouseCreate) Mutation() *HouseMutation {
+	return _c.mutation
+}
+
+// Save creates the House in the database.
+func (_c *HouseCreate) Save(ctx context.Context) (*House, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HouseCreate) SaveX(ctx context.Context) *House {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HouseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HouseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HouseCreate) defaults() {
+	if _, ok := _c.mutation.RoomCount(); !ok {
+		v := house.DefaultRoomCount
+		_c.mutation.SetRoomCount(v)
+	}
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		v := house.DefaultOwnerID
+		_c.mutation.SetOwnerID(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := house.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HouseCreate) check() error {
+	if _, ok := _c.mutation.ZoneID(); !ok {
+		return &ValidationError{Name: "zone_id", err: errors.New(`db: missing required field "House.zone_id"`)}
+	}
+	if _, ok := _c.mutation.EntranceRoomID(); !ok {
+		return &ValidationError{Name: "entrance_room_id", err: errors.New(`db: missing required field "House.entrance_room_id"`)}
+	}
+	if _, ok := _c.mutation.Direction(); !ok {
+		return &ValidationError{Name: "direction", err: errors.New(`db: missing required field "House.direction"`)}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`db: missing required field "House.price"`)}
+	}
+	if _, ok := _c.mutation.RoomCount(); !ok {
+		return &ValidationError{Name: "room_count", err: errors.New(`db: missing required field "House.room_count"`)}
+	}
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`db: missing required field "House.owner_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "House.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *HouseCreate) sqlSave(ctx context.Context) (*House, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HouseCreate) createSpec() (*House, *sqlgraph.CreateSpec) {
+	var (
+		_node = &House{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(house.Table, sqlgraph.NewFieldSpec(house.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ZoneID(); ok {
+		_spec.SetField(house.FieldZoneID, field.TypeString, value)
+		_node.ZoneID = value
+	}
+	if value, ok := _c.mutation.EntranceRoomID(); ok {
+		_spec.SetField(house.FieldEntranceRoomID, field.TypeInt, value)
+		_node.EntranceRoomID = value
+	}
+	if value, ok := _c.mutation.Direction(); ok {
+		_spec.SetField(house.FieldDirection, field.TypeString, value)
+		_node.Direction = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(house.FieldPrice, field.TypeInt, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.RoomCount(); ok {
+		_spec.SetField(house.FieldRoomCount, field.TypeInt, value)
+		_node.RoomCount = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(house.FieldOwnerID, field.TypeInt, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.RoomIds(); ok {
+		_spec.SetField(house.FieldRoomIds, field.TypeJSON, value)
+		_node.RoomIds = value
+	}
+	if value, ok := _c.mutation.GuestIds(); ok {
+		_spec.SetField(house.FieldGuestIds, field.TypeJSON, value)
+		_node.GuestIds = value
+	}
+	if value, ok := _c.mutation.Decorations(); ok {
+		_spec.SetField(house.FieldDecorations, field.TypeJSON, value)
+		_node.Decorations = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(house.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.PurchasedAt(); ok {
+		_spec.SetField(house.FieldPurchasedAt, field.TypeTime, value)
+		_node.PurchasedAt = &value
+	}
+	return _node, _spec
+}
+
+// HouseCreateBulk is the builder for creating many House entities in bulk.
+type HouseCreateBulk struct {
+	config
+	err      error
+	builders []*HouseCreate
+}
+
+// Save creates the House entities in the database.
+func (_c *HouseCreateBulk) Save(ctx context.Context) ([]*House, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*House, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HouseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HouseCreateBulk) SaveX(ctx context.Context) []*House {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HouseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HouseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
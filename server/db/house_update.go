@@ -0,0 +1,699 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/house"
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// HouseUpdate is the builder for updating House entities.
+type HouseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HouseMutation
+}
+
+// Where appends a list predicates to the HouseUpdate builder.
+func (_u *HouseUpdate) Where(ps ...predicate.House) *HouseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetZoneID sets the "zone_id" field.
+func (_u *HouseUpdate) SetZoneID(v string) *HouseUpdate {
+	_u.mutation.SetZoneID(v)
+	return _u
+}
+
+// SetNillableZoneID sets the "zone_id" field if the given value is not nil.
+func (_u *HouseUpdate) SetNillableZoneID(v *string) *HouseUpdate {
+	if v != nil {
+		_u.SetZoneID(*v)
+	}
+	return _u
+}
+
+// SetEntranceRoomID sets the "entrance_room_id" field.
+func (_u *HouseUpdate) SetEntranceRoomID(v int) *HouseUpdate {
+	_u.mutation.ResetEntranceRoomID()
+	_u.mutation.SetEntranceRoomID(v)
+	return _u
+}
+
+// SetNillableEntranceRoomID sets the "entrance_room_id" field if the given value is not nil.
+func (_u *HouseUpdate) SetNillableEntranceRoomID(v *int) *HouseUpdate {
+	if v != nil {
+		_u.SetEntranceRoomID(*v)
+	}
+	return _u
+}
+
+// AddEntranceRoomID adds value to the "entrance_room_id" field.
+func (_u *HouseUpdate) AddEntranceRoomID(v int) *HouseUpdate {
+	_u.mutation.AddEntranceRoomID(v)
+	return _u
+}
+
+// SetDirection sets the "direction" field.
+func (_u *HouseUpdate) SetDirection(v string) *HouseUpdate {
+	_u.mutation.SetDirection(v)
+	return _u
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (_u *HouseUpdate) SetNillableDirection(v *string) *HouseUpdate {
+	if v != nil {
+		_u.SetDirection(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *HouseUpdate) SetPrice(v int) *HouseUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *HouseUpdate) SetNillablePrice(v *int) *HouseUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *HouseUpdate) AddPrice(v int) *HouseUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetRoomCount sets the "room_count" field.
+func (_u *HouseUpdate) SetRoomCount(v int) *HouseUpdate {
+	_u.mutation.ResetRoomCount()
+	_u.mutation.SetRoomCount(v)
+	return _u
+}
+
+// SetNillableRoomCount sets the "room_count" field if the given value is not nil.
+func (_u *HouseUpdate) SetNillableRoomCount(v *int) *HouseUpdate {
+	if v != nil {
+		_u.SetRoomCount(*v)
+	}
+	return _u
+}
+
+// AddRoomCount adds value to the "room_count" field.
+func (_u *HouseUpdate) AddRoomCount(v int) *HouseUpdate {
+	_u.mutation.AddRoomCount(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *HouseUpdate) SetOwnerID(v int) *HouseUpdate {
+	_u.mutation.ResetOwnerID()
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *HouseUpdate) SetNillableOwnerID(v *int) *HouseUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// AddOwnerID adds value to the "owner_id" field.
+func (_u *HouseUpdate) AddOwnerID(v int) *HouseUpdate {
+	_u.mutation.AddOwnerID(v)
+	return _u
+}
+
+// SetRoomIds sets the "room_ids" field.
+func (_u *HouseUpdate) SetRoomIds(v []int) *HouseUpdate {
+	_u.mutation.SetRoomIds(v)
+	return _u
+}
+
+// AppendRoomIds appends value to the "room_ids" field.
+func (_u *HouseUpdate) AppendRoomIds(v []int) *HouseUpdate {
+	_u.mutation.AppendRoomIds(v)
+	return _u
+}
+
+// ClearRoomIds clears the value of the "room_ids" field.
+func (_u *HouseUpdate) ClearRoomIds() *HouseUpdate {
+	_u.mutation.ClearRoomIds()
+	return _u
+}
+
+// SetGuestIds sets the "guest_ids" field.
+func (_u *HouseUpdate) SetGuestIds(v []int) *HouseUpdate {
+	_u.mutation.SetGuestIds(v)
+	return _u
+}
+
+// AppendGuestIds appends value to the "guest_ids" field.
+func (_u *HouseUpdate) AppendGuestIds(v []int) *HouseUpdate {
+	_u.mutation.AppendGuestIds(v)
+	return _u
+}
+
+// ClearGuestIds clears the value of the "guest_ids" field.
+func (_u *HouseUpdate) ClearGuestIds() *HouseUpdate {
+	_u.mutation.ClearGuestIds()
+	return _u
+}
+
+// SetDecorations sets the "decorations" field.
+func (_u *HouseUpdate) SetDecorations(v map[int]bool) *HouseUpdate {
+	_u.mutation.SetDecorations(v)
+	return _u
+}
+
+// ClearDecorations clears the value of the "decorations" field.
+func (_u *HouseUpdate) ClearDecorations() *HouseUpdate {
+	_u.mutation.ClearDecorations()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *HouseUpdate) SetCreatedAt(v time.Time) *HouseUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *HouseUpdate) SetNillableCreatedAt(v *time.Time) *HouseUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_u *HouseUpdate) SetPurchasedAt(v time.Time) *HouseUpdate {
+	_u.mutation.SetPurchasedAt(v)
+	return _u
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_u *HouseUpdate) SetNillablePurchasedAt(v *time.Time) *HouseUpdate {
+	if v != nil {
+		_u.SetPurchasedAt(*v)
+	}
+	return _u
+}
+
+// ClearPurchasedAt clears the value of the "purchased_at" field.
+func (_u *HouseUpdate) ClearPurchasedAt() *HouseUpdate {
+	_u.mutation.ClearPurchasedAt()
+	return _u
+}
+
+// Mutation returns the HouseMutation object of the builder.
+func (_u *HouseUpdate) Mutation() *HouseMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HouseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HouseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HouseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HouseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *HouseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(house.Table, house.Columns, sqlgraph.NewFieldSpec(house.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ZoneID(); ok {
+		_spec.SetField(house.FieldZoneID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntranceRoomID(); ok {
+		_spec.SetField(house.FieldEntranceRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntranceRoomID(); ok {
+		_spec.AddField(house.FieldEntranceRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Direction(); ok {
+		_spec.SetField(house.FieldDirection, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(house.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(house.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RoomCount(); ok {
+		_spec.SetField(house.FieldRoomCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRoomCount(); ok {
+		_spec.AddField(house.FieldRoomCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(house.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerID(); ok {
+		_spec.AddField(house.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RoomIds(); ok {
+		_spec.SetField(house.FieldRoomIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoomIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, house.FieldRoomIds, value)
+		})
+	}
+	if _u.mutation.RoomIdsCleared() {
+		_spec.ClearField(house.FieldRoomIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GuestIds(); ok {
+		_spec.SetField(house.FieldGuestIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGuestIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, house.FieldGuestIds, value)
+		})
+	}
+	if _u.mutation.GuestIdsCleared() {
+		_spec.ClearField(house.FieldGuestIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Decorations(); ok {
+		_spec.SetField(house.FieldDecorations, field.TypeJSON, value)
+	}
+	if _u.mutation.DecorationsCleared() {
+		_spec.ClearField(house.FieldDecorations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(house.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PurchasedAt(); ok {
+		_spec.SetField(house.FieldPurchasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PurchasedAtCleared() {
+		_spec.ClearField(house.FieldPurchasedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{house.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HouseUpdateOne is the builder for updating a single House entity.
+type HouseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HouseMutation
+}
+
+// SetZoneID sets the "zone_id" field.
+func (_u *HouseUpdateOne) SetZoneID(v string) *HouseUpdateOne {
+	_u.mutation.SetZoneID(v)
+	return _u
+}
+
+// SetNillableZoneID sets the "zone_id" field if the given value is not nil.
+func (_u *HouseUpdateOne) SetNillableZoneID(v *string) *HouseUpdateOne {
+	if v != nil {
+		_u.SetZoneID(*v)
+	}
+	return _u
+}
+
+// SetEntranceRoomID sets the "entrance_room_id" field.
+func (_u *HouseUpdateOne) SetEntranceRoomID(v int) *HouseUpdateOne {
+	_u.mutation.ResetEntranceRoomID()
+	_u.mutation.SetEntranceRoomID(v)
+	return _u
+}
+
+// SetNillableEntranceRoomID sets the "entrance_room_id" field if the given value is not nil.
+func (_u *HouseUpdateOne) SetNillableEntranceRoomID(v *int) *HouseUpdateOne {
+	if v != nil {
+		_u.SetEntranceRoomID(*v)
+	}
+	return _u
+}
+
+// AddEntranceRoomID adds value to the "entrance_room_id" field.
+func (_u *HouseUpdateOne) AddEntranceRoomID(v int) *HouseUpdateOne {
+	_u.mutation.AddEntranceRoomID(v)
+	return _u
+}
+
+// SetDirection sets the "direction" field.
+func (_u *HouseUpdateOne) SetDirection(v string) *HouseUpdateOne {
+	_u.mutation.SetDirection(v)
+	return _u
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (_u *HouseUpdateOne) SetNillableDirection(v *string) *HouseUpdateOne {
+	if v != nil {
+		_u.SetDirection(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *HouseUpdateOne) SetPrice(v int) *HouseUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *HouseUpdateOne) SetNillablePrice(v *int) *HouseUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *HouseUpdateOne) AddPrice(v int) *HouseUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetRoomCount sets the "room_count" field.
+func (_u *HouseUpdateOne) SetRoomCount(v int) *HouseUpdateOne {
+	_u.mutation.ResetRoomCount()
+	_u.mutation.SetRoomCount(v)
+	return _u
+}
+
+// SetNillableRoomCount sets the "room_count" field if the given value is not nil.
+func (_u *HouseUpdateOne) SetNillableRoomCount(v *int) *HouseUpdateOne {
+	if v != nil {
+		_u.SetRoomCount(*v)
+	}
+	return _u
+}
+
+// AddRoomCount adds value to the "room_count" field.
+func (_u *HouseUpdateOne) AddRoomCount(v int) *HouseUpdateOne {
+	_u.mutation.AddRoomCount(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *HouseUpdateOne) SetOwnerID(v int) *HouseUpdateOne {
+	_u.mutation.ResetOwnerID()
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *HouseUpdateOne) SetNillableOwnerID(v *int) *HouseUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// AddOwnerID adds value to the "owner_id" field.
+func (_u *HouseUpdateOne) AddOwnerID(v int) *HouseUpdateOne {
+	_u.mutation.AddOwnerID(v)
+	return _u
+}
+
+// SetRoomIds sets the "room_ids" field.
+func (_u *HouseUpdateOne) SetRoomIds(v []int) *HouseUpdateOne {
+	_u.mutation.SetRoomIds(v)
+	return _u
+}
+
+// AppendRoomIds appends value to the "room_ids" field.
+func (_u *HouseUpdateOne) AppendRoomIds(v []int) *HouseUpdateOne {
+	_u.mutation.AppendRoomIds(v)
+	return _u
+}
+
+// ClearRoomIds clears the value of the "room_ids" field.
+func (_u *HouseUpdateOne) ClearRoomIds() *HouseUpdateOne {
+	_u.mutation.ClearRoomIds()
+	return _u
+}
+
+// SetGuestIds sets the "guest_ids" field.
+func (_u *HouseUpdateOne) SetGuestIds(v []int) *HouseUpdateOne {
+	_u.mutation.SetGuestIds(v)
+	return _u
+}
+
+// AppendGuestIds appends value to the "guest_ids" field.
+func (_u *HouseUpdateOne) AppendGuestIds(v []int) *HouseUpdateOne {
+	_u.mutation.AppendGuestIds(v)
+	return _u
+}
+
+// ClearGuestIds clears the value of the "guest_ids" field.
+func (_u *HouseUpdateOne) ClearGuestIds() *HouseUpdateOne {
+	_u.mutation.ClearGuestIds()
+	return _u
+}
+
+// SetDecorations sets the "decorations" field.
+func (_u *HouseUpdateOne) SetDecorations(v map[int]bool) *HouseUpdateOne {
+	_u.mutation.SetDecorations(v)
+	return _u
+}
+
+// ClearDecorations clears the value of the "decorations" field.
+func (_u *HouseUpdateOne) ClearDecorations() *HouseUpdateOne {
+	_u.mutation.ClearDecorations()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *HouseUpdateOne) SetCreatedAt(v time.Time) *HouseUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *HouseUpdateOne) SetNillableCreatedAt(v *time.Time) *HouseUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_u *HouseUpdateOne) SetPurchasedAt(v time.Time) *HouseUpdateOne {
+	_u.mutation.SetPurchasedAt(v)
+	return _u
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_u *HouseUpdateOne) SetNillablePurchasedAt(v *time.Time) *HouseUpdateOne {
+	if v != nil {
+		_u.SetPurchasedAt(*v)
+	}
+	return _u
+}
+
+// ClearPurchasedAt clears the value of the "purchased_at" field.
+func (_u *HouseUpdateOne) ClearPurchasedAt() *HouseUpdateOne {
+	_u.mutation.ClearPurchasedAt()
+	return _u
+}
+
+// Mutation returns the HouseMutation object of the builder.
+func (_u *HouseUpdateOne) Mutation() *HouseMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the HouseUpdate builder.
+func (_u *HouseUpdateOne) Where(ps ...predicate.House) *HouseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HouseUpdateOne) Select(field string, fields ...string) *HouseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated House entity.
+func (_u *HouseUpdateOne) Save(ctx context.Context) (*House, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HouseUpdateOne) SaveX(ctx context.Context) *House {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HouseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HouseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *HouseUpdateOne) sqlSave(ctx context.Context) (_node *House, err error) {
+	_spec := sqlgraph.NewUpdateSpec(house.Table, house.Columns, sqlgraph.NewFieldSpec(house.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "House.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, house.FieldID)
+		for _, f := range fields {
+			if !house.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != house.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ZoneID(); ok {
+		_spec.SetField(house.FieldZoneID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntranceRoomID(); ok {
+		_spec.SetField(house.FieldEntranceRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntranceRoomID(); ok {
+		_spec.AddField(house.FieldEntranceRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Direction(); ok {
+		_spec.SetField(house.FieldDirection, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(house.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(house.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RoomCount(); ok {
+		_spec.SetField(house.FieldRoomCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRoomCount(); ok {
+		_spec.AddField(house.FieldRoomCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(house.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerID(); ok {
+		_spec.AddField(house.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RoomIds(); ok {
+		_spec.SetField(house.FieldRoomIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoomIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, house.FieldRoomIds, value)
+		})
+	}
+	if _u.mutation.RoomIdsCleared() {
+		_spec.ClearField(house.FieldRoomIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GuestIds(); ok {
+		_spec.SetField(house.FieldGuestIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGuestIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, house.FieldGuestIds, value)
+		})
+	}
+	if _u.mutation.GuestIdsCleared() {
+		_spec.ClearField(house.FieldGuestIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Decorations(); ok {
+		_spec.SetField(house.FieldDecorations, field.TypeJSON, value)
+	}
+	if _u.mutation.DecorationsCleared() {
+		_spec.ClearField(house.FieldDecorations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(house.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PurchasedAt(); ok {
+		_spec.SetField(house.FieldPurchasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PurchasedAtCleared() {
+		_spec.ClearField(house.FieldPurchasedAt, field.TypeTime)
+	}
+	_node = &House{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{house.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
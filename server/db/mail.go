@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"herbst-server/db/mail"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Mail is the model entity for the Mail schema.
+type Mail struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Character who sent the mail
+	SenderID int `json:"sender_id,omitempty"`
+	// Name of sender (denormalized for when sender is deleted)
+	SenderName string `json:"sender_name,omitempty"`
+	// Character whose inbox the mail is in
+	RecipientID int `json:"recipient_id,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Gold attached, not yet collected
+	Gold int `json:"gold,omitempty"`
+	// Escrowed equipment attached, not yet collected
+	ItemIds []int `json:"item_ids,omitempty"`
+	// Cash on delivery: gold the recipient pays the sender to collect the items
+	Cod int `json:"cod,omitempty"`
+	// IsRead holds the value of the "is_read" field.
+	IsRead bool `json:"is_read,omitempty"`
+	// Mail bounced back to its sender; deleted with its attachments when it expires again
+	Returned bool `json:"returned,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt time.Time `json:"sent_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Mail) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mail.FieldItemIds:
+			values[i] = new([]byte)
+		case mail.FieldIsRead, mail.FieldReturned:
+			values[i] = new(sql.NullBool)
+		case mail.FieldID, mail.FieldSenderID, mail.FieldRecipientID, mail.FieldGold, mail.FieldCod:
+			values[i] = new(sql.NullInt64)
+		case mail.FieldSenderName, mail.FieldSubject, mail.FieldBody:
+			values[i] = new(sql.NullString)
+		case mail.FieldSentAt, mail.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Mail fields.
+func (_m *Mail) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mail.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case mail.FieldSenderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value.Valid {
+				_m.SenderID = int(value.Int64)
+			}
+		case mail.FieldSenderName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_name", values[i])
+			} else if value.Valid {
+				_m.SenderName = value.String
+			}
+		case mail.FieldRecipientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_id", values[i])
+			} else if value.Valid {
+				_m.RecipientID = int(value.Int64)
+			}
+		case mail.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case mail.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case mail.FieldGold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gold", values[i])
+			} else if value.Valid {
+				_m.Gold = int(value.Int64)
+			}
+		case mail.FieldItemIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field item_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ItemIds); err != nil {
+					return fmt.Errorf("unmarshal field item_ids: %w", err)
+				}
+			}
+		case mail.FieldCod:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cod", values[i])
+			} else if value.Valid {
+				_m.Cod = int(value.Int64)
+			}
+		case mail.FieldIsRead:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_read", values[i])
+			} else if value.Valid {
+				_m.IsRead = value.Bool
+			}
+		case mail.FieldReturned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field returned", values[i])
+			} else if value.Valid {
+				_m.Returned = value.Bool
+			}
+		case mail.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = value.Time
+			}
+		case mail.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Mail.
+// This includes values selected through modifiers, order, etc.
+func (_m *Mail) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Mail.
+// Note that you need to call Mail.Unwrap() before calling this method if this Mail
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Mail) Update() *MailUpdateOne {
+	return NewMailClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Mail entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Mail) Unwrap() *Mail {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: Mail is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Mail) String() string {
+	var builder strings.Builder
+	builder.WriteString("Mail(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("sender_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SenderID))
+	builder.WriteString(", ")
+	builder.WriteString("sender_name=")
+	builder.WriteString(_m.SenderName)
+	builder.WriteString(", ")
+	builder.WriteString("recipient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecipientID))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("gold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Gold))
+	builder.WriteString(", ")
+	builder.WriteString("item_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemIds))
+	builder.WriteString(", ")
+	builder.WriteString("cod=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cod))
+	builder.WriteString(", ")
+	builder.WriteString("is_read=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsRead))
+	builder.WriteString(", ")
+	builder.WriteString("returned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Returned))
+	builder.WriteString(", ")
+	builder.WriteString("sent_at=")
+	builder.WriteString(_m.SentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Mails is a parsable slice of Mail.
+type Mails []*Mail
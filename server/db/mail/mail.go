@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mail
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the mail type in the database.
+	Label = "mail"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSenderID holds the string denoting the sender_id field in the database.
+	FieldSenderID = "sender_id"
+	// FieldSenderName holds the string denoting the sender_name field in the database.
+	FieldSenderName = "sender_name"
+	// FieldRecipientID holds the string denoting the recipient_id field in the database.
+	FieldRecipientID = "recipient_id"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldGold holds the string denoting the gold field in the database.
+	FieldGold = "gold"
+	// FieldItemIds holds the string denoting the item_ids field in the database.
+	FieldItemIds = "item_ids"
+	// FieldCod holds the string denoting the cod field in the database.
+	FieldCod = "cod"
+	// FieldIsRead holds the string denoting the is_read field in the database.
+	FieldIsRead = "is_read"
+	// FieldReturned holds the string denoting the returned field in the database.
+	FieldReturned = "returned"
+	// FieldSentAt holds the string denoting the sent_at field in the database.
+	FieldSentAt = "sent_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the mail in the database.
+	Table = "mails"
+)
+
+// Columns holds all SQL columns for mail fields.
+var Columns = []string{
+	FieldID,
+	FieldSenderID,
+	FieldSenderName,
+	FieldRecipientID,
+	FieldSubject,
+	FieldBody,
+	FieldGold,
+	FieldItemIds,
+	FieldCod,
+	FieldIsRead,
+	FieldReturned,
+	FieldSentAt,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultBody holds the default value on creation for the "body" field.
+	DefaultBody string
+	// DefaultGold holds the default value on creation for the "gold" field.
+	DefaultGold int
+	// DefaultCod holds the default value on creation for the "cod" field.
+	DefaultCod int
+	// DefaultIsRead holds the default value on creation for the "is_read" field.
+	DefaultIsRead bool
+	// DefaultReturned holds the default value on creation for the "returned" field.
+	DefaultReturned bool
+	// DefaultSentAt holds the default value on creation for the "sent_at" field.
+	DefaultSentAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Mail queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySenderID orders the results by the sender_id field.
+func BySenderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderID, opts...).ToFunc()
+}
+
+// BySenderName orders the results by the sender_name field.
+func BySenderName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderName, opts...).ToFunc()
+}
+
+// ByRecipientID orders the results by the recipient_id field.
+func ByRecipientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecipientID, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByGold orders the results by the gold field.
+func ByGold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGold, opts...).ToFunc()
+}
+
+// ByCod orders the results by the cod field.
+func ByCod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCod, opts...).ToFunc()
+}
+
+// ByIsRead orders the results by the is_read field.
+func ByIsRead(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsRead, opts...).ToFunc()
+}
+
+// ByReturned orders the results by the returned field.
+func ByReturned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturned, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sent_at field.
+func BySentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mail
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldID, id))
+}
+
+// SenderID applies equality check predicate on the "sender_id" field. It's identical to SenderIDEQ.
+func SenderID(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldSenderID, v))
+}
+
+// SenderName applies equality check predicate on the "sender_name" field. It's identical to SenderNameEQ.
+func SenderName(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldSenderName, v))
+}
+
+// RecipientID applies equality check predicate on the "recipient_id" field. It's identical to RecipientIDEQ.
+func RecipientID(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldSubject, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldBody, v))
+}
+
+// Gold applies equality check predicate on the "gold" field. It's identical to GoldEQ.
+func Gold(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldGold, v))
+}
+
+// Cod applies equality check predicate on the "cod" field. It's identical to CodEQ.
+func Cod(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldCod, v))
+}
+
+// IsRead applies equality check predicate on the "is_read" field. It's identical to IsReadEQ.
+func IsRead(v bool) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldIsRead, v))
+}
+
+// Returned applies equality check predicate on the "returned" field. It's identical to ReturnedEQ.
+func Returned(v bool) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldReturned, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldSentAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// SenderIDEQ applies the EQ predicate on the "sender_id" field.
+func SenderIDEQ(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldSenderID, v))
+}
+
+// SenderIDNEQ applies the NEQ predicate on the "sender_id" field.
+func SenderIDNEQ(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldSenderID, v))
+}
+
+// SenderIDIn applies the In predicate on the "sender_id" field.
+func SenderIDIn(vs ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldSenderID, vs...))
+}
+
+// SenderIDNotIn applies the NotIn predicate on the "sender_id" field.
+func SenderIDNotIn(vs ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldSenderID, vs...))
+}
+
+// SenderIDGT applies the GT predicate on the "sender_id" field.
+func SenderIDGT(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldSenderID, v))
+}
+
+// SenderIDGTE applies the GTE predicate on the "sender_id" field.
+func SenderIDGTE(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldSenderID, v))
+}
+
+// SenderIDLT applies the LT predicate on the "sender_id" field.
+func SenderIDLT(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldSenderID, v))
+}
+
+// SenderIDLTE applies the LTE predicate on the "sender_id" field.
+func SenderIDLTE(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldSenderID, v))
+}
+
+// SenderNameEQ applies the EQ predicate on the "sender_name" field.
+func SenderNameEQ(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldSenderName, v))
+}
+
+// SenderNameNEQ applies the NEQ predicate on the "sender_name" field.
+func SenderNameNEQ(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldSenderName, v))
+}
+
+// SenderNameIn applies the In predicate on the "sender_name" field.
+func SenderNameIn(vs ...string) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldSenderName, vs...))
+}
+
+// SenderNameNotIn applies the NotIn predicate on the "sender_name" field.
+func SenderNameNotIn(vs ...string) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldSenderName, vs...))
+}
+
+// SenderNameGT applies the GT predicate on the "sender_name" field.
+func SenderNameGT(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldSenderName, v))
+}
+
+// SenderNameGTE applies the GTE predicate on the "sender_name" field.
+func SenderNameGTE(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldSenderName, v))
+}
+
+// SenderNameLT applies the LT predicate on the "sender_name" field.
+func SenderNameLT(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldSenderName, v))
+}
+
+// SenderNameLTE applies the LTE predicate on the "sender_name" field.
+func SenderNameLTE(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldSenderName, v))
+}
+
+// SenderNameContains applies the Contains predicate on the "sender_name" field.
+func SenderNameContains(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldContains(FieldSenderName, v))
+}
+
+// SenderNameHasPrefix applies the HasPrefix predicate on the "sender_name" field.
+func SenderNameHasPrefix(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldHasPrefix(FieldSenderName, v))
+}
+
+// SenderNameHasSuffix applies the HasSuffix predicate on the "sender_name" field.
+func SenderNameHasSuffix(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldHasSuffix(FieldSenderName, v))
+}
+
+// SenderNameEqualFold applies the EqualFold predicate on the "sender_name" field.
+func SenderNameEqualFold(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEqualFold(FieldSenderName, v))
+}
+
+// SenderNameContainsFold applies the ContainsFold predicate on the "sender_name" field.
+func SenderNameContainsFold(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldContainsFold(FieldSenderName, v))
+}
+
+// RecipientIDEQ applies the EQ predicate on the "recipient_id" field.
+func RecipientIDEQ(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// RecipientIDNEQ applies the NEQ predicate on the "recipient_id" field.
+func RecipientIDNEQ(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldRecipientID, v))
+}
+
+// RecipientIDIn applies the In predicate on the "recipient_id" field.
+func RecipientIDIn(vs ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDNotIn applies the NotIn predicate on the "recipient_id" field.
+func RecipientIDNotIn(vs ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDGT applies the GT predicate on the "recipient_id" field.
+func RecipientIDGT(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldRecipientID, v))
+}
+
+// RecipientIDGTE applies the GTE predicate on the "recipient_id" field.
+func RecipientIDGTE(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldRecipientID, v))
+}
+
+// RecipientIDLT applies the LT predicate on the "recipient_id" field.
+func RecipientIDLT(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldRecipientID, v))
+}
+
+// RecipientIDLTE applies the LTE predicate on the "recipient_id" field.
+func RecipientIDLTE(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldRecipientID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.Mail {
+	return predicate.Mail(sql.FieldContainsFold(FieldBody, v))
+}
+
+// GoldEQ applies the EQ predicate on the "gold" field.
+func GoldEQ(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldGold, v))
+}
+
+// GoldNEQ applies the NEQ predicate on the "gold" field.
+func GoldNEQ(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldGold, v))
+}
+
+// GoldIn applies the In predicate on the "gold" field.
+func GoldIn(vs ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldGold, vs...))
+}
+
+// GoldNotIn applies the NotIn predicate on the "gold" field.
+func GoldNotIn(vs ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldGold, vs...))
+}
+
+// GoldGT applies the GT predicate on the "gold" field.
+func GoldGT(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldGold, v))
+}
+
+// GoldGTE applies the GTE predicate on the "gold" field.
+func GoldGTE(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldGold, v))
+}
+
+// GoldLT applies the LT predicate on the "gold" field.
+func GoldLT(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldGold, v))
+}
+
+// GoldLTE applies the LTE predicate on the "gold" field.
+func GoldLTE(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldGold, v))
+}
+
+// ItemIdsIsNil applies the IsNil predicate on the "item_ids" field.
+func ItemIdsIsNil() predicate.Mail {
+	return predicate.Mail(sql.FieldIsNull(FieldItemIds))
+}
+
+// ItemIdsNotNil applies the NotNil predicate on the "item_ids" field.
+func ItemIdsNotNil() predicate.Mail {
+	return predicate.Mail(sql.FieldNotNull(FieldItemIds))
+}
+
+// CodEQ applies the EQ predicate on the "cod" field.
+func CodEQ(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldCod, v))
+}
+
+// CodNEQ applies the NEQ predicate on the "cod" field.
+func CodNEQ(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldCod, v))
+}
+
+// CodIn applies the In predicate on the "cod" field.
+func CodIn(vs ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldCod, vs...))
+}
+
+// CodNotIn applies the NotIn predicate on the "cod" field.
+func CodNotIn(vs ...int) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldCod, vs...))
+}
+
+// CodGT applies the GT predicate on the "cod" field.
+func CodGT(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldCod, v))
+}
+
+// CodGTE applies the GTE predicate on the "cod" field.
+func CodGTE(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldCod, v))
+}
+
+// CodLT applies the LT predicate on the "cod" field.
+func CodLT(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldCod, v))
+}
+
+// CodLTE applies the LTE predicate on the "cod" field.
+func CodLTE(v int) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldCod, v))
+}
+
+// IsReadEQ applies the EQ predicate on the "is_read" field.
+func IsReadEQ(v bool) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldIsRead, v))
+}
+
+// IsReadNEQ applies the NEQ predicate on the "is_read" field.
+func IsReadNEQ(v bool) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldIsRead, v))
+}
+
+// ReturnedEQ applies the EQ predicate on the "returned" field.
+func ReturnedEQ(v bool) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldReturned, v))
+}
+
+// ReturnedNEQ applies the NEQ predicate on the "returned" field.
+func ReturnedNEQ(v bool) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldReturned, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldSentAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Mail {
+	return predicate.Mail(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Mail) predicate.Mail {
+	return predicate.Mail(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Mail) predicate.Mail {
+	return predicate.Mail(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Mail) predicate.Mail {
+	return predicate.Mail(sql.NotPredicates(p))
+}
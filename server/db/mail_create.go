@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/mail"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MailCreate is the builder for creating a Mail entity.
+type MailCreate struct {
+	config
+	mutation *MailMutation
+	hooks    []Hook
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_c *MailCreate) SetSenderID(v int) *MailCreate {
+	_c.mutation.SetSenderID(v)
+	return _c
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_c *MailCreate) SetSenderName(v string) *MailCreate {
+	_c.mutation.SetSenderName(v)
+	return _c
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (_c *MailCreate) SetRecipientID(v int) *MailCreate {
+	_c.mutation.SetRecipientID(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *MailCreate) SetSubject(v string) *MailCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *MailCreate) SetBody(v string) *MailCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_c *MailCreate) SetNillableBody(v *string) *MailCreate {
+	if v != nil {
+		_c.SetBody(*v)
+	}
+	return _c
+}
+
+// SetGold sets the "gold" field.
+func (_c *MailCreate) SetGold(v int) *MailCreate {
+	_c.mutation.SetGold(v)
+	return _c
+}
+
+// SetNillableGold sets the "gold" field if the given value is not nil.
+func (_c *MailCreate) SetNillableGold(v *int) *MailCreate {
+	if v != nil {
+		_c.SetGold(*v)
+	}
+	return _c
+}
+
+// SetItemIds sets the "item_ids" field.
+func (_c *MailCreate) SetItemIds(v []int) *MailCreate {
+	_c.mutation.SetItemIds(v)
+	return _c
+}
+
+// SetCod sets the "cod" field.
+func (_c *MailCreate) SetCod(v int) *MailCreate {
+	_c.mutation.SetCod(v)
+	return _c
+}
+
+// SetNillableCod sets the "cod" field if the given value is not nil.
+func (_c *MailCreate) SetNillableCod(v *int) *MailCreate {
+	if v != nil {
+		_c.SetCod(*v)
+	}
+	return _c
+}
+
+// SetIsRead sets the "is_read" field.
+func (_c *MailCreate) SetIsRead(v bool) *MailCreate {
+	_c.mutation.SetIsRead(v)
+	return _c
+}
+
+// SetNillableIsRead sets the "is_read" field if the given value is not nil.
+func (_c *MailCreate) SetNillableIsRead(v *bool) *MailCreate {
+	if v != nil {
+		_c.SetIsRead(*v)
+	}
+	return _c
+}
+
+// SetReturned sets the "returned" field.
+func (_c *MailCreate) SetReturned(v bool) *MailCreate {
+	_c.mutation.SetReturned(v)
+	return _c
+}
+
+// SetNillableReturned sets the "returned" field if the given value is not nil.
+func (_c *MailCreate) SetNillableReturned(v *bool) *MailCreate {
+	if v != nil {
+		_c.SetReturned(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *MailCreate) SetSentAt(v time.Time) *MailCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *MailCreate) SetNillableSentAt(v *time.Time) *MailCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *MailCreate) SetExpiresAt(v time.Time) *MailCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// Mutation returns the MailMutation object of the builder.
+func (_c *MailCreate) Mutation() *MailMutation {
+	return _c.mutation
+}
+
+// Save creates the Mail in the database.
+func (_c *MailCreate) Save(ctx context.Context) (*Mail, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MailCreate) SaveX(ctx context.Context) *Mail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MailCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MailCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MailCreate) defaults() {
+	if _, ok := _c.mutation.Body(); !ok {
+		v := mail.DefaultBody
+		_c.mutation.SetBody(v)
+	}
+	if _, ok := _c.mutation.Gold(); !ok {
+		v := mail.DefaultGold
+		_c.mutation.SetGold(v)
+	}
+	if _, ok := _c.mutation.Cod(); !ok {
+		v := mail.DefaultCod
+		_c.mutation.SetCod(v)
+	}
+	if _, ok := _c.mutation.IsRead(); !ok {
+		v := mail.DefaultIsRead
+		_c.mutation.SetIsRead(v)
+	}
+	if _, ok := _c.mutation.Returned(); !ok {
+		v := mail.DefaultReturned
+		_c.mutation.SetReturned(v)
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		v := mail.DefaultSentAt()
+		_c.mutation.SetSentAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MailCreate) check() error {
+	if _, ok := _c.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`db: missing required field "Mail.sender_id"`)}
+	}
+	if _, ok := _c.mutation.SenderName(); !ok {
+		return &ValidationError{Name: "sender_name", err: errors.New(`db: missing required field "Mail.sender_name"`)}
+	}
+	if _, ok := _c.mutation.RecipientID(); !ok {
+		return &ValidationError{Name: "recipient_id", err: errors.New(`db: missing required field "Mail.recipient_id"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`db: missing required field "Mail.subject"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`db: missing required field "Mail.body"`)}
+	}
+	if _, ok := _c.mutation.Gold(); !ok {
+		return &ValidationError{Name: "gold", err: errors.New(`db: missing required field "Mail.gold"`)}
+	}
+	if _, ok := _c.mutation.Cod(); !ok {
+		return &ValidationError{Name: "cod", err: errors.New(`db: missing required field "Mail.cod"`)}
+	}
+	if _, ok := _c.mutation.IsRead(); !ok {
+		return &ValidationError{Name: "is_read", err: errors.New(`db: missing required field "Mail.is_read"`)}
+	}
+	if _, ok := _c.mutation.Returned(); !ok {
+		return &ValidationError{Name: "returned", err: errors.New(`db: missing required field "Mail.returned"`)}
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		return &ValidationError{Name: "sent_at", err: errors.New(`db: missing required field "Mail.sent_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`db: missing required field "Mail.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *MailCreate) sqlSave(ctx context.Context) (*Mail, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MailCreate) createSpec() (*Mail, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Mail{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mail.Table, sqlgraph.NewFieldSpec(mail.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SenderID(); ok {
+		_spec.SetField(mail.FieldSenderID, field.TypeInt, value)
+		_node.SenderID = value
+	}
+	if value, ok := _c.mutation.SenderName(); ok {
+		_spec.SetField(mail.FieldSenderName, field.TypeString, value)
+		_node.SenderName = value
+	}
+	if value, ok := _c.mutation.RecipientID(); ok {
+		_spec.SetField(mail.FieldRecipientID, field.TypeInt, value)
+		_node.RecipientID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(mail.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(mail.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.Gold(); ok {
+		_spec.SetField(mail.FieldGold, field.TypeInt, value)
+		_node.Gold = value
+	}
+	if value, ok := _c.mutation.ItemIds(); ok {
+		_spec.SetField(mail.FieldItemIds, field.TypeJSON, value)
+		_node.ItemIds = value
+	}
+	if value, ok := _c.mutation.Cod(); ok {
+		_spec.SetField(mail.FieldCod, field.TypeInt, value)
+		_node.Cod = value
+	}
+	if value, ok := _c.mutation.IsRead(); ok {
+		_spec.SetField(mail.FieldIsRead, field.TypeBool, value)
+		_node.IsRead = value
+	}
+	if value, ok := _c.mutation.Returned(); ok {
+		_spec.SetField(mail.FieldReturned, field.TypeBool, value)
+		_node.Returned = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(mail.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(mail.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// MailCreateBulk is the builder for creating many Mail entities in bulk.
+type MailCreateBulk struct {
+	config
+	err      error
+	builders []*MailCreate
+}
+
+// Save creates the Mail entities in the database.
+func (_c *MailCreateBulk) Save(ctx context.Context) ([]*Mail, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Mail, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MailMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MailCreateBulk) SaveX(ctx context.Context) []*Mail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MailCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MailCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/mail"
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MailUpdate is the builder for updating Mail entities.
+type MailUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MailMutation
+}
+
+// Where appends a list predicates to the MailUpdate builder.
+func (_u *MailUpdate) Where(ps ...predicate.Mail) *MailUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *MailUpdate) SetSenderID(v int) *MailUpdate {
+	_u.mutation.ResetSenderID()
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableSenderID(v *int) *MailUpdate {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// AddSenderID adds value to the "sender_id" field.
+func (_u *MailUpdate) AddSenderID(v int) *MailUpdate {
+	_u.mutation.AddSenderID(v)
+	return _u
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_u *MailUpdate) SetSenderName(v string) *MailUpdate {
+	_u.mutation.SetSenderName(v)
+	return _u
+}
+
+// SetNillableSenderName sets the "sender_name" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableSenderName(v *string) *MailUpdate {
+	if v != nil {
+		_u.SetSenderName(*v)
+	}
+	return _u
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (_u *MailUpdate) SetRecipientID(v int) *MailUpdate {
+	_u.mutation.ResetRecipientID()
+	_u.mutation.SetRecipientID(v)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableRecipientID(v *int) *MailUpdate {
+	if v != nil {
+		_u.SetRecipientID(*v)
+	}
+	return _u
+}
+
+// AddRecipientID adds value to the "recipient_id" field.
+func (_u *MailUpdate) AddRecipientID(v int) *MailUpdate {
+	_u.mutation.AddRecipientID(v)
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *MailUpdate) SetSubject(v string) *MailUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableSubject(v *string) *MailUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *MailUpdate) SetBody(v string) *MailUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableBody(v *string) *MailUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetGold sets the "gold" field.
+func (_u *MailUpdate) SetGold(v int) *MailUpdate {
+	_u.mutation.ResetGold()
+	_u.mutation.SetGold(v)
+	return _u
+}
+
+// SetNillableGold sets the "gold" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableGold(v *int) *MailUpdate {
+	if v != nil {
+		_u.SetGold(*v)
+	}
+	return _u
+}
+
+// AddGold adds value to the "gold" field.
+func (_u *MailUpdate) AddGold(v int) *MailUpdate {
+	_u.mutation.AddGold(v)
+	return _u
+}
+
+// SetItemIds sets the "item_ids" field.
+func (_u *MailUpdate) SetItemIds(v []int) *MailUpdate {
+	_u.mutation.SetItemIds(v)
+	return _u
+}
+
+// AppendItemIds appends value to the "item_ids" field.
+func (_u *MailUpdate) AppendItemIds(v []int) *MailUpdate {
+	_u.mutation.AppendItemIds(v)
+	return _u
+}
+
+// ClearItemIds clears the value of the "item_ids" field.
+func (_u *MailUpdate) ClearItemIds() *MailUpdate {
+	_u.mutation.ClearItemIds()
+	return _u
+}
+
+// SetCod sets the "cod" field.
+func (_u *MailUpdate) SetCod(v int) *MailUpdate {
+	_u.mutation.ResetCod()
+	_u.mutation.SetCod(v)
+	return _u
+}
+
+// SetNillableCod sets the "cod" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableCod(v *int) *MailUpdate {
+	if v != nil {
+		_u.SetCod(*v)
+	}
+	return _u
+}
+
+// AddCod adds value to the "cod" field.
+func (_u *MailUpdate) AddCod(v int) *MailUpdate {
+	_u.mutation.AddCod(v)
+	return _u
+}
+
+// SetIsRead sets the "is_read" field.
+func (_u *MailUpdate) SetIsRead(v bool) *MailUpdate {
+	_u.mutation.SetIsRead(v)
+	return _u
+}
+
+// SetNillableIsRead sets the "is_read" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableIsRead(v *bool) *MailUpdate {
+	if v != nil {
+		_u.SetIsRead(*v)
+	}
+	return _u
+}
+
+// SetReturned sets the "returned" field.
+func (_u *MailUpdate) SetReturned(v bool) *MailUpdate {
+	_u.mutation.SetReturned(v)
+	return _u
+}
+
+// SetNillableReturned sets the "returned" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableReturned(v *bool) *MailUpdate {
+	if v != nil {
+		_u.SetReturned(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *MailUpdate) SetSentAt(v time.Time) *MailUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableSentAt(v *time.Time) *MailUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MailUpdate) SetExpiresAt(v time.Time) *MailUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MailUpdate) SetNillableExpiresAt(v *time.Time) *MailUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MailMutation object of the builder.
+func (_u *MailUpdate) Mutation() *MailMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MailUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MailUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MailUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MailUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *MailUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(mail.Table, mail.Columns, sqlgraph.NewFieldSpec(mail.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SenderID(); ok {
+		_spec.SetField(mail.FieldSenderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSenderID(); ok {
+		_spec.AddField(mail.FieldSenderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SenderName(); ok {
+		_spec.SetField(mail.FieldSenderName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecipientID(); ok {
+		_spec.SetField(mail.FieldRecipientID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecipientID(); ok {
+		_spec.AddField(mail.FieldRecipientID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(mail.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(mail.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Gold(); ok {
+		_spec.SetField(mail.FieldGold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGold(); ok {
+		_spec.AddField(mail.FieldGold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemIds(); ok {
+		_spec.SetField(mail.FieldItemIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedItemIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, mail.FieldItemIds, value)
+		})
+	}
+	if _u.mutation.ItemIdsCleared() {
+		_spec.ClearField(mail.FieldItemIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Cod(); ok {
+		_spec.SetField(mail.FieldCod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCod(); ok {
+		_spec.AddField(mail.FieldCod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsRead(); ok {
+		_spec.SetField(mail.FieldIsRead, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Returned(); ok {
+		_spec.SetField(mail.FieldReturned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(mail.FieldSentAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(mail.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MailUpdateOne is the builder for updating a single Mail entity.
+type MailUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MailMutation
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *MailUpdateOne) SetSenderID(v int) *MailUpdateOne {
+	_u.mutation.ResetSenderID()
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableSenderID(v *int) *MailUpdateOne {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// AddSenderID adds value to the "sender_id" field.
+func (_u *MailUpdateOne) AddSenderID(v int) *MailUpdateOne {
+	_u.mutation.AddSenderID(v)
+	return _u
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_u *MailUpdateOne) SetSenderName(v string) *MailUpdateOne {
+	_u.mutation.SetSenderName(v)
+	return _u
+}
+
+// SetNillableSenderName sets the "sender_name" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableSenderName(v *string) *MailUpdateOne {
+	if v != nil {
+		_u.SetSenderName(*v)
+	}
+	return _u
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (_u *MailUpdateOne) SetRecipientID(v int) *MailUpdateOne {
+	_u.mutation.ResetRecipientID()
+	_u.mutation.SetRecipientID(v)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableRecipientID(v *int) *MailUpdateOne {
+	if v != nil {
+		_u.SetRecipientID(*v)
+	}
+	return _u
+}
+
+// AddRecipientID adds value to the "recipient_id" field.
+func (_u *MailUpdateOne) AddRecipientID(v int) *MailUpdateOne {
+	_u.mutation.AddRecipientID(v)
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *MailUpdateOne) SetSubject(v string) *MailUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableSubject(v *string) *MailUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *MailUpdateOne) SetBody(v string) *MailUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableBody(v *string) *MailUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetGold sets the "gold" field.
+func (_u *MailUpdateOne) SetGold(v int) *MailUpdateOne {
+	_u.mutation.ResetGold()
+	_u.mutation.SetGold(v)
+	return _u
+}
+
+// SetNillableGold sets the "gold" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableGold(v *int) *MailUpdateOne {
+	if v != nil {
+		_u.SetGold(*v)
+	}
+	return _u
+}
+
+// AddGold adds value to the "gold" field.
+func (_u *MailUpdateOne) AddGold(v int) *MailUpdateOne {
+	_u.mutation.AddGold(v)
+	return _u
+}
+
+// SetItemIds sets the "item_ids" field.
+func (_u *MailUpdateOne) SetItemIds(v []int) *MailUpdateOne {
+	_u.mutation.SetItemIds(v)
+	return _u
+}
+
+// AppendItemIds appends value to the "item_ids" field.
+func (_u *MailUpdateOne) AppendItemIds(v []int) *MailUpdateOne {
+	_u.mutation.AppendItemIds(v)
+	return _u
+}
+
+// ClearItemIds clears the value of the "item_ids" field.
+func (_u *MailUpdateOne) ClearItemIds() *MailUpdateOne {
+	_u.mutation.ClearItemIds()
+	return _u
+}
+
+// SetCod sets the "cod" field.
+func (_u *MailUpdateOne) SetCod(v int) *MailUpdateOne {
+	_u.mutation.ResetCod()
+	_u.mutation.SetCod(v)
+	return _u
+}
+
+// SetNillableCod sets the "cod" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableCod(v *int) *MailUpdateOne {
+	if v != nil {
+		_u.SetCod(*v)
+	}
+	return _u
+}
+
+// AddCod adds value to the "cod" field.
+func (_u *MailUpdateOne) AddCod(v int) *MailUpdateOne {
+	_u.mutation.AddCod(v)
+	return _u
+}
+
+// SetIsRead sets the "is_read" field.
+func (_u *MailUpdateOne) SetIsRead(v bool) *MailUpdateOne {
+	_u.mutation.SetIsRead(v)
+	return _u
+}
+
+// SetNillableIsRead sets the "is_read" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableIsRead(v *bool) *MailUpdateOne {
+	if v != nil {
+		_u.SetIsRead(*v)
+	}
+	return _u
+}
+
+// SetReturned sets the "returned" field.
+func (_u *MailUpdateOne) SetReturned(v bool) *MailUpdateOne {
+	_u.mutation.SetReturned(v)
+	return _u
+}
+
+// SetNillableReturned sets the "returned" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableReturned(v *bool) *MailUpdateOne {
+	if v != nil {
+		_u.SetReturned(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *MailUpdateOne) SetSentAt(v time.Time) *MailUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableSentAt(v *time.Time) *MailUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MailUpdateOne) SetExpiresAt(v time.Time) *MailUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MailUpdateOne) SetNillableExpiresAt(v *time.Time) *MailUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MailMutation object of the builder.
+func (_u *MailUpdateOne) Mutation() *MailMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MailUpdate builder.
+func (_u *MailUpdateOne) Where(ps ...predicate.Mail) *MailUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MailUpdateOne) Select(field string, fields ...string) *MailUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Mail entity.
+func (_u *MailUpdateOne) Save(ctx context.Context) (*Mail, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MailUpdateOne) SaveX(ctx context.Context) *Mail {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MailUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MailUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *MailUpdateOne) sqlSave(ctx context.Context) (_node *Mail, err error) {
+	_spec := sqlgraph.NewUpdateSpec(mail.Table, mail.Columns, sqlgraph.NewFieldSpec(mail.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "Mail.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mail.FieldID)
+		for _, f := range fields {
+			if !mail.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != mail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SenderID(); ok {
+		_spec.SetField(mail.FieldSenderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSenderID(); ok {
+		_spec.AddField(mail.FieldSenderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SenderName(); ok {
+		_spec.SetField(mail.FieldSenderName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecipientID(); ok {
+		_spec.SetField(mail.FieldRecipientID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRecipientID(); ok {
+		_spec.AddField(mail.FieldRecipientID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(mail.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(mail.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Gold(); ok {
+		_spec.SetField(mail.FieldGold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGold(); ok {
+		_spec.AddField(mail.FieldGold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemIds(); ok {
+		_spec.SetField(mail.FieldItemIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedItemIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, mail.FieldItemIds, value)
+		})
+	}
+	if _u.mutation.ItemIdsCleared() {
+		_spec.ClearField(mail.FieldItemIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Cod(); ok {
+		_spec.SetField(mail.FieldCod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCod(); ok {
+		_spec.AddField(mail.FieldCod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsRead(); ok {
+		_spec.SetField(mail.FieldIsRead, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Returned(); ok {
+		_spec.SetField(mail.FieldReturned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(mail.FieldSentAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(mail.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &Mail{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
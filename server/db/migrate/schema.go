@@ -86,6 +86,7 @@ var (
 		{Name: "icon", Type: field.TypeString, Nullable: true},
 		{Name: "xp_reward", Type: field.TypeInt, Default: 0},
 		{Name: "criteria", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
 	}
 	// AchievementsTable holds the schema information for the "achievements" table.
 	AchievementsTable = &schema.Table{
@@ -160,6 +161,40 @@ var (
 			},
 		},
 	}
+	// AuctionsColumns holds the columns for the "auctions" table.
+	AuctionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "seller_id", Type: field.TypeInt},
+		{Name: "seller_name", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeInt},
+		{Name: "item_name", Type: field.TypeString},
+		{Name: "start_bid", Type: field.TypeInt},
+		{Name: "buyout", Type: field.TypeInt, Default: 0},
+		{Name: "bid", Type: field.TypeInt, Default: 0},
+		{Name: "bidder_id", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "sold"}, Default: "open"},
+		{Name: "proceeds", Type: field.TypeInt, Default: 0},
+		{Name: "listed_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// AuctionsTable holds the schema information for the "auctions" table.
+	AuctionsTable = &schema.Table{
+		Name:       "auctions",
+		Columns:    AuctionsColumns,
+		PrimaryKey: []*schema.Column{AuctionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auction_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuctionsColumns[9], AuctionsColumns[12]},
+			},
+			{
+				Name:    "auction_seller_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuctionsColumns[1]},
+			},
+		},
+	}
 	// ChannelConfigsColumns holds the columns for the "channel_configs" table.
 	ChannelConfigsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -214,8 +249,13 @@ var (
 		{Name: "wisdom", Type: field.TypeInt, Default: 10},
 		{Name: "charisma", Type: field.TypeInt, Default: 10},
 		{Name: "kill_counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "visited_rooms", Type: field.TypeJSON, Nullable: true},
+		{Name: "found_exits", Type: field.TypeJSON, Nullable: true},
+		{Name: "crafted_counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
 		{Name: "current_room_id", Type: field.TypeInt},
 		{Name: "npc_template_id", Type: field.TypeString, Nullable: true},
+		{Name: "party_members", Type: field.TypeInt, Nullable: true},
 		{Name: "room_characters", Type: field.TypeInt, Nullable: true},
 		{Name: "user_characters", Type: field.TypeInt, Nullable: true},
 		{Name: "world_id", Type: field.TypeInt, Nullable: true},
@@ -228,31 +268,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "characters_rooms_room",
-				Columns:    []*schema.Column{CharactersColumns[36]},
+				Columns:    []*schema.Column{CharactersColumns[40]},
 				RefColumns: []*schema.Column{RoomsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "characters_npc_templates_npcTemplate",
-				Columns:    []*schema.Column{CharactersColumns[37]},
+				Columns:    []*schema.Column{CharactersColumns[41]},
 				RefColumns: []*schema.Column{NpcTemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "characters_parties_members",
+				Columns:    []*schema.Column{CharactersColumns[42]},
+				RefColumns: []*schema.Column{PartiesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "characters_rooms_characters",
-				Columns:    []*schema.Column{CharactersColumns[38]},
+				Columns:    []*schema.Column{CharactersColumns[43]},
 				RefColumns: []*schema.Column{RoomsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "characters_users_characters",
-				Columns:    []*schema.Column{CharactersColumns[39]},
+				Columns:    []*schema.Column{CharactersColumns[44]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "characters_worlds_characters",
-				Columns:    []*schema.Column{CharactersColumns[40]},
+				Columns:    []*schema.Column{CharactersColumns[45]},
 				RefColumns: []*schema.Column{WorldsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -285,6 +331,43 @@ var (
 			},
 		},
 	}
+	// CharacterAchievementsColumns holds the columns for the "character_achievements" table.
+	CharacterAchievementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "progress", Type: field.TypeInt, Default: 0},
+		{Name: "target", Type: field.TypeInt, Default: 0},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "achievement_id", Type: field.TypeInt},
+		{Name: "character_id", Type: field.TypeInt},
+	}
+	// CharacterAchievementsTable holds the schema information for the "character_achievements" table.
+	CharacterAchievementsTable = &schema.Table{
+		Name:       "character_achievements",
+		Columns:    CharacterAchievementsColumns,
+		PrimaryKey: []*schema.Column{CharacterAchievementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "character_achievements_achievements_character_achievements",
+				Columns:    []*schema.Column{CharacterAchievementsColumns[5]},
+				RefColumns: []*schema.Column{AchievementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "character_achievements_characters_achievements",
+				Columns:    []*schema.Column{CharacterAchievementsColumns[6]},
+				RefColumns: []*schema.Column{CharactersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "characterachievement_character_id_achievement_id",
+				Unique:  true,
+				Columns: []*schema.Column{CharacterAchievementsColumns[6], CharacterAchievementsColumns[5]},
+			},
+		},
+	}
 	// CharacterChannelsColumns holds the columns for the "character_channels" table.
 	CharacterChannelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -561,6 +644,31 @@ var (
 		Columns:    DamageLogsColumns,
 		PrimaryKey: []*schema.Column{DamageLogsColumns[0]},
 	}
+	// DeadLettersColumns holds the columns for the "dead_letters" table.
+	DeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "subscriber", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeInt},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "timestamp", Type: field.TypeInt64},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "error", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DeadLettersTable holds the schema information for the "dead_letters" table.
+	DeadLettersTable = &schema.Table{
+		Name:       "dead_letters",
+		Columns:    DeadLettersColumns,
+		PrimaryKey: []*schema.Column{DeadLettersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deadletter_subscriber",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLettersColumns[1]},
+			},
+		},
+	}
 	// DialogNodesColumns holds the columns for the "dialog_nodes" table.
 	DialogNodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -586,6 +694,31 @@ var (
 			},
 		},
 	}
+	// DialogStatesColumns holds the columns for the "dialog_states" table.
+	DialogStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "character_id", Type: field.TypeInt},
+		{Name: "npc_template_id", Type: field.TypeString},
+		{Name: "flags", Type: field.TypeJSON, Nullable: true},
+		{Name: "counters", Type: field.TypeJSON, Nullable: true},
+		{Name: "times_talked", Type: field.TypeInt, Default: 0},
+		{Name: "first_met_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_node_id", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DialogStatesTable holds the schema information for the "dialog_states" table.
+	DialogStatesTable = &schema.Table{
+		Name:       "dialog_states",
+		Columns:    DialogStatesColumns,
+		PrimaryKey: []*schema.Column{DialogStatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dialogstate_character_id_npc_template_id",
+				Unique:  true,
+				Columns: []*schema.Column{DialogStatesColumns[1], DialogStatesColumns[2]},
+			},
+		},
+	}
 	// EffectsColumns holds the columns for the "effects" table.
 	EffectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -759,6 +892,21 @@ var (
 			},
 		},
 	}
+	// EventCursorsColumns holds the columns for the "event_cursors" table.
+	EventCursorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "last_event_id", Type: field.TypeInt, Default: 0},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EventCursorsTable holds the schema information for the "event_cursors" table.
+	EventCursorsTable = &schema.Table{
+		Name:       "event_cursors",
+		Columns:    EventCursorsColumns,
+		PrimaryKey: []*schema.Column{EventCursorsColumns[0]},
+	}
 	// FactionsColumns holds the columns for the "factions" table.
 	FactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -866,6 +1014,73 @@ var (
 			},
 		},
 	}
+	// HousesColumns holds the columns for the "houses" table.
+	HousesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "zone_id", Type: field.TypeString},
+		{Name: "entrance_room_id", Type: field.TypeInt},
+		{Name: "direction", Type: field.TypeString},
+		{Name: "price", Type: field.TypeInt},
+		{Name: "room_count", Type: field.TypeInt, Default: 1},
+		{Name: "owner_id", Type: field.TypeInt, Default: 0},
+		{Name: "room_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "guest_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "decorations", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "purchased_at", Type: field.TypeTime, Nullable: true},
+	}
+	// HousesTable holds the schema information for the "houses" table.
+	HousesTable = &schema.Table{
+		Name:       "houses",
+		Columns:    HousesColumns,
+		PrimaryKey: []*schema.Column{HousesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "house_entrance_room_id_direction",
+				Unique:  true,
+				Columns: []*schema.Column{HousesColumns[2], HousesColumns[3]},
+			},
+			{
+				Name:    "house_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{HousesColumns[6]},
+			},
+		},
+	}
+	// MailsColumns holds the columns for the "mails" table.
+	MailsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sender_id", Type: field.TypeInt},
+		{Name: "sender_name", Type: field.TypeString},
+		{Name: "recipient_id", Type: field.TypeInt},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "gold", Type: field.TypeInt, Default: 0},
+		{Name: "item_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "cod", Type: field.TypeInt, Default: 0},
+		{Name: "is_read", Type: field.TypeBool, Default: false},
+		{Name: "returned", Type: field.TypeBool, Default: false},
+		{Name: "sent_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// MailsTable holds the schema information for the "mails" table.
+	MailsTable = &schema.Table{
+		Name:       "mails",
+		Columns:    MailsColumns,
+		PrimaryKey: []*schema.Column{MailsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mail_recipient_id",
+				Unique:  false,
+				Columns: []*schema.Column{MailsColumns[3]},
+			},
+			{
+				Name:    "mail_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{MailsColumns[12]},
+			},
+		},
+	}
 	// NpcAbilitiesColumns holds the columns for the "npc_abilities" table.
 	NpcAbilitiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -884,7 +1099,7 @@ var (
 		{Name: "world_id", Type: field.TypeString, Default: "1"},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Size: 2147483647},
-		{Name: "disposition", Type: field.TypeEnum, Enums: []string{"hostile", "friendly", "neutral"}, Default: "neutral"},
+		{Name: "disposition", Type: field.TypeEnum, Enums: []string{"hostile", "friendly", "neutral", "shopkeeper"}, Default: "neutral"},
 		{Name: "level", Type: field.TypeInt, Default: 1},
 		{Name: "xp_value", Type: field.TypeInt, Default: 0},
 		{Name: "xp_multiplier", Type: field.TypeFloat64, Default: 1},
@@ -900,6 +1115,8 @@ var (
 		{Name: "roam_pause_max_seconds", Type: field.TypeInt, Nullable: true, Default: 120},
 		{Name: "last_moved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "notify_on_enter", Type: field.TypeBool, Nullable: true, Default: false},
+		{Name: "loot_table", Type: field.TypeJSON, Nullable: true},
+		{Name: "behavior", Type: field.TypeJSON, Nullable: true},
 		{Name: "race_id", Type: field.TypeInt, Nullable: true},
 	}
 	// NpcTemplatesTable holds the schema information for the "npc_templates" table.
@@ -910,12 +1127,76 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "npc_templates_races_npc_templates",
-				Columns:    []*schema.Column{NpcTemplatesColumns[21]},
+				Columns:    []*schema.Column{NpcTemplatesColumns[23]},
 				RefColumns: []*schema.Column{RacesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "timestamp", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[3]},
+			},
+			{
+				Name:    "outboxevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[4]},
+			},
+		},
+	}
+	// PartiesColumns holds the columns for the "parties" table.
+	PartiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "leader_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PartiesTable holds the schema information for the "parties" table.
+	PartiesTable = &schema.Table{
+		Name:       "parties",
+		Columns:    PartiesColumns,
+		PrimaryKey: []*schema.Column{PartiesColumns[0]},
+	}
+	// PartyInvitesColumns holds the columns for the "party_invites" table.
+	PartyInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "inviter_id", Type: field.TypeInt},
+		{Name: "invitee_id", Type: field.TypeInt},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PartyInvitesTable holds the schema information for the "party_invites" table.
+	PartyInvitesTable = &schema.Table{
+		Name:       "party_invites",
+		Columns:    PartyInvitesColumns,
+		PrimaryKey: []*schema.Column{PartyInvitesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "partyinvite_invitee_id",
+				Unique:  false,
+				Columns: []*schema.Column{PartyInvitesColumns[2]},
+			},
+			{
+				Name:    "partyinvite_inviter_id",
+				Unique:  false,
+				Columns: []*schema.Column{PartyInvitesColumns[1]},
+			},
+		},
+	}
 	// QuestsColumns holds the columns for the "quests" table.
 	QuestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -996,6 +1277,27 @@ var (
 			},
 		},
 	}
+	// ReputationsColumns holds the columns for the "reputations" table.
+	ReputationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "character_id", Type: field.TypeInt},
+		{Name: "faction", Type: field.TypeString},
+		{Name: "standing", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ReputationsTable holds the schema information for the "reputations" table.
+	ReputationsTable = &schema.Table{
+		Name:       "reputations",
+		Columns:    ReputationsColumns,
+		PrimaryKey: []*schema.Column{ReputationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reputation_character_id_faction",
+				Unique:  true,
+				Columns: []*schema.Column{ReputationsColumns[1], ReputationsColumns[2]},
+			},
+		},
+	}
 	// RoomsColumns holds the columns for the "rooms" table.
 	RoomsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1005,6 +1307,7 @@ var (
 		{Name: "is_starting_room", Type: field.TypeBool, Default: false},
 		{Name: "is_root_room", Type: field.TypeBool, Default: false},
 		{Name: "exits", Type: field.TypeJSON},
+		{Name: "exit_states", Type: field.TypeJSON, Nullable: true},
 		{Name: "atmosphere", Type: field.TypeEnum, Enums: []string{"air", "water", "wind"}, Default: "air"},
 		{Name: "posx", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "posy", Type: field.TypeInt, Nullable: true, Default: 0},
@@ -1012,6 +1315,8 @@ var (
 		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "zone_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "instance_id", Type: field.TypeInt, Nullable: true},
+		{Name: "house_id", Type: field.TypeInt, Nullable: true},
 	}
 	// RoomsTable holds the schema information for the "rooms" table.
 	RoomsTable = &schema.Table{
@@ -1065,6 +1370,8 @@ var (
 		{Name: "gold_reserves", Type: field.TypeInt, Default: 1000},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "last_restocked", Type: field.TypeTime, Nullable: true},
+		{Name: "restock_interval_seconds", Type: field.TypeInt, Default: 3600},
+		{Name: "buyback_ratio", Type: field.TypeFloat64, Default: 0.5},
 		{Name: "character_shop_template", Type: field.TypeInt, Nullable: true},
 	}
 	// ShopTemplatesTable holds the schema information for the "shop_templates" table.
@@ -1075,7 +1382,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "shop_templates_characters_shop_template",
-				Columns:    []*schema.Column{ShopTemplatesColumns[9]},
+				Columns:    []*schema.Column{ShopTemplatesColumns[11]},
 				RefColumns: []*schema.Column{CharactersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1155,6 +1462,30 @@ var (
 			},
 		},
 	}
+	// StashesColumns holds the columns for the "stashes" table.
+	StashesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "character_id", Type: field.TypeInt, Default: 0},
+		{Name: "user_id", Type: field.TypeInt, Default: 0},
+		{Name: "world_id", Type: field.TypeInt, Default: 0},
+		{Name: "gold", Type: field.TypeInt, Default: 0},
+		{Name: "item_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "slots", Type: field.TypeInt},
+		{Name: "version", Type: field.TypeInt, Default: 0},
+	}
+	// StashesTable holds the schema information for the "stashes" table.
+	StashesTable = &schema.Table{
+		Name:       "stashes",
+		Columns:    StashesColumns,
+		PrimaryKey: []*schema.Column{StashesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stash_character_id_user_id_world_id",
+				Unique:  true,
+				Columns: []*schema.Column{StashesColumns[1], StashesColumns[2], StashesColumns[3]},
+			},
+		},
+	}
 	// SystemLogsColumns holds the columns for the "system_logs" table.
 	SystemLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1243,6 +1574,9 @@ var (
 		{Name: "room_id", Type: field.TypeInt, Nullable: true},
 		{Name: "equipment_id", Type: field.TypeInt, Nullable: true},
 		{Name: "condition", Type: field.TypeString, Nullable: true},
+		{Name: "keyword", Type: field.TypeString, Nullable: true},
+		{Name: "interval_secs", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "affects", Type: field.TypeString, Nullable: true, Default: "self"},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "crafting_recipe_triggers", Type: field.TypeInt, Nullable: true},
 		{Name: "dialog_node_triggers", Type: field.TypeString, Nullable: true},
@@ -1256,19 +1590,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "triggers_crafting_recipes_triggers",
-				Columns:    []*schema.Column{TriggersColumns[11]},
+				Columns:    []*schema.Column{TriggersColumns[14]},
 				RefColumns: []*schema.Column{CraftingRecipesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "triggers_dialog_nodes_triggers",
-				Columns:    []*schema.Column{TriggersColumns[12]},
+				Columns:    []*schema.Column{TriggersColumns[15]},
 				RefColumns: []*schema.Column{DialogNodesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "triggers_effects_triggers",
-				Columns:    []*schema.Column{TriggersColumns[13]},
+				Columns:    []*schema.Column{TriggersColumns[16]},
 				RefColumns: []*schema.Column{EffectsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1315,6 +1649,14 @@ var (
 		{Name: "min_level", Type: field.TypeInt, Default: 1},
 		{Name: "color", Type: field.TypeString, Nullable: true},
 		{Name: "room_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "resets", Type: field.TypeJSON, Nullable: true},
+		{Name: "reset_interval_secs", Type: field.TypeInt, Default: 0},
+		{Name: "last_reset_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_reset_report", Type: field.TypeJSON, Nullable: true},
+		{Name: "weather", Type: field.TypeJSON, Nullable: true},
+		{Name: "current_weather", Type: field.TypeString, Nullable: true},
+		{Name: "weather_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "instance", Type: field.TypeJSON, Nullable: true},
 		{Name: "parent_zone_id", Type: field.TypeString, Nullable: true},
 	}
 	// ZonesTable holds the schema information for the "zones" table.
@@ -1325,12 +1667,45 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "zones_zones_children",
-				Columns:    []*schema.Column{ZonesColumns[7]},
+				Columns:    []*schema.Column{ZonesColumns[15]},
 				RefColumns: []*schema.Column{ZonesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ZoneInstancesColumns holds the columns for the "zone_instances" table.
+	ZoneInstancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "zone_id", Type: field.TypeString},
+		{Name: "owner_id", Type: field.TypeInt},
+		{Name: "party_id", Type: field.TypeInt, Nullable: true},
+		{Name: "room_map", Type: field.TypeJSON},
+		{Name: "item_map", Type: field.TypeJSON, Nullable: true},
+		{Name: "entry_room_id", Type: field.TypeInt},
+		{Name: "return_room_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_reset_at", Type: field.TypeTime, Nullable: true},
+		{Name: "empty_since", Type: field.TypeTime, Nullable: true},
+	}
+	// ZoneInstancesTable holds the schema information for the "zone_instances" table.
+	ZoneInstancesTable = &schema.Table{
+		Name:       "zone_instances",
+		Columns:    ZoneInstancesColumns,
+		PrimaryKey: []*schema.Column{ZoneInstancesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "zoneinstance_zone_id_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{ZoneInstancesColumns[1], ZoneInstancesColumns[2]},
+			},
+			{
+				Name:    "zoneinstance_zone_id_party_id",
+				Unique:  false,
+				Columns: []*schema.Column{ZoneInstancesColumns[1], ZoneInstancesColumns[3]},
+			},
+		},
+	}
 	// AbilityNpcAbilitiesColumns holds the columns for the "ability_npc_abilities" table.
 	AbilityNpcAbilitiesColumns = []*schema.Column{
 		{Name: "ability_id", Type: field.TypeInt},
@@ -1563,9 +1938,11 @@ var (
 		AchievementsTable,
 		ActiveEffectsTable,
 		AppLogsTable,
+		AuctionsTable,
 		ChannelConfigsTable,
 		CharactersTable,
 		CharacterAbilitiesTable,
+		CharacterAchievementsTable,
 		CharacterChannelsTable,
 		CharacterClassHistoriesTable,
 		CharacterCompetenciesTable,
@@ -1578,26 +1955,36 @@ var (
 		CompetencyLevelThresholdsTable,
 		CraftingRecipesTable,
 		DamageLogsTable,
+		DeadLettersTable,
 		DialogNodesTable,
+		DialogStatesTable,
 		EffectsTable,
 		EffectHooksTable,
 		EquipmentTable,
 		EquipmentTemplatesTable,
+		EventCursorsTable,
 		FactionsTable,
 		FactionCategoriesTable,
 		FactionRequiredTagsTable,
 		GameConfigsTable,
 		GendersTable,
+		HousesTable,
+		MailsTable,
 		NpcAbilitiesTable,
 		NpcTemplatesTable,
+		OutboxEventsTable,
+		PartiesTable,
+		PartyInvitesTable,
 		QuestsTable,
 		QuestProgressesTable,
 		RacesTable,
+		ReputationsTable,
 		RoomsTable,
 		ShopItemsTable,
 		ShopTemplatesTable,
 		SkillsTable,
 		SocialCommandsTable,
+		StashesTable,
 		SystemLogsTable,
 		TagsTable,
 		TellQueuesTable,
@@ -1605,6 +1992,7 @@ var (
 		UsersTable,
 		WorldsTable,
 		ZonesTable,
+		ZoneInstancesTable,
 		AbilityNpcAbilitiesTable,
 		NpcTemplateNpcAbilitiesTable,
 		RoomZonesTable,
@@ -1625,11 +2013,14 @@ func init() {
 	ActiveEffectsTable.ForeignKeys[1].RefTable = EffectsTable
 	CharactersTable.ForeignKeys[0].RefTable = RoomsTable
 	CharactersTable.ForeignKeys[1].RefTable = NpcTemplatesTable
-	CharactersTable.ForeignKeys[2].RefTable = RoomsTable
-	CharactersTable.ForeignKeys[3].RefTable = UsersTable
-	CharactersTable.ForeignKeys[4].RefTable = WorldsTable
+	CharactersTable.ForeignKeys[2].RefTable = PartiesTable
+	CharactersTable.ForeignKeys[3].RefTable = RoomsTable
+	CharactersTable.ForeignKeys[4].RefTable = UsersTable
+	CharactersTable.ForeignKeys[5].RefTable = WorldsTable
 	CharacterAbilitiesTable.ForeignKeys[0].RefTable = AbilitiesTable
 	CharacterAbilitiesTable.ForeignKeys[1].RefTable = CharactersTable
+	CharacterAchievementsTable.ForeignKeys[0].RefTable = AchievementsTable
+	CharacterAchievementsTable.ForeignKeys[1].RefTable = CharactersTable
 	CharacterChannelsTable.ForeignKeys[0].RefTable = CharactersTable
 	CharacterClassHistoriesTable.ForeignKeys[0].RefTable = CharactersTable
 	CharacterCompetenciesTable.ForeignKeys[0].RefTable = CharactersTable
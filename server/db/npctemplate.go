@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"herbst-server/db/npctemplate"
 	"herbst-server/db/race"
+	"herbst-server/db/schema"
 	"strings"
 	"time"
 
@@ -61,6 +62,10 @@ type NPCTemplate struct {
 	LastMovedAt *time.Time `json:"last_moved_at,omitempty"`
 	// If true, emit a chat/notification event when this NPC enters a room.
 	NotifyOnEnter bool `json:"notify_on_enter,omitempty"`
+	// Items this NPC can drop on death; each drop rolls a rarity tier and random affixes
+	LootTable schema.LootTable `json:"loot_table,omitempty"`
+	// Aggro, fleeing, assisting allies and daily schedule for instances of this NPC
+	Behavior schema.NPCBehavior `json:"behavior,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NPCTemplateQuery when eager-loading is set.
 	Edges        NPCTemplateEdges `json:"edges"`
@@ -136,7 +141,7 @@ func (*NPCTemplate) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case npctemplate.FieldSkills, npctemplate.FieldTradesWith, npctemplate.FieldRespawnRooms, npctemplate.FieldRoamZoneIds:
+		case npctemplate.FieldSkills, npctemplate.FieldTradesWith, npctemplate.FieldRespawnRooms, npctemplate.FieldRoamZoneIds, npctemplate.FieldLootTable, npctemplate.FieldBehavior:
 			values[i] = new([]byte)
 		case npctemplate.FieldNotifyOnEnter:
 			values[i] = new(sql.NullBool)
@@ -304,6 +309,22 @@ func (_m *NPCTemplate) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.NotifyOnEnter = value.Bool
 			}
+		case npctemplate.FieldLootTable:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field loot_table", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LootTable); err != nil {
+					return fmt.Errorf("unmarshal field loot_table: %w", err)
+				}
+			}
+		case npctemplate.FieldBehavior:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field behavior", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Behavior); err != nil {
+					return fmt.Errorf("unmarshal field behavior: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -429,6 +450,12 @@ func (_m *NPCTemplate) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("notify_on_enter=")
 	builder.WriteString(fmt.Sprintf("%v", _m.NotifyOnEnter))
+	builder.WriteString(", ")
+	builder.WriteString("loot_table=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LootTable))
+	builder.WriteString(", ")
+	builder.WriteString("behavior=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Behavior))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -56,6 +56,10 @@ const (
 	FieldLastMovedAt = "last_moved_at"
 	// FieldNotifyOnEnter holds the string denoting the notify_on_enter field in the database.
 	FieldNotifyOnEnter = "notify_on_enter"
+	// FieldLootTable holds the string denoting the loot_table field in the database.
+	FieldLootTable = "loot_table"
+	// FieldBehavior holds the string denoting the behavior field in the database.
+	FieldBehavior = "behavior"
 	// EdgeNpcAbilities holds the string denoting the npc_abilities edge name in mutations.
 	EdgeNpcAbilities = "npc_abilities"
 	// EdgeHooks holds the string denoting the hooks edge name in mutations.
@@ -127,6 +131,8 @@ var Columns = []string{
 	FieldRoamPauseMaxSeconds,
 	FieldLastMovedAt,
 	FieldNotifyOnEnter,
+	FieldLootTable,
+	FieldBehavior,
 }
 
 var (
@@ -174,9 +180,10 @@ const DefaultDisposition = DispositionNeutral
 
 // Disposition values.
 const (
-	DispositionHostile  Disposition = "hostile"
-	DispositionFriendly Disposition = "friendly"
-	DispositionNeutral  Disposition = "neutral"
+	DispositionHostile    Disposition = "hostile"
+	DispositionFriendly   Disposition = "friendly"
+	DispositionNeutral    Disposition = "neutral"
+	DispositionShopkeeper Disposition = "shopkeeper"
 )
 
 func (d Disposition) String() string {
@@ -186,7 +193,7 @@ func (d Disposition) String() string {
 // DispositionValidator is a validator for the "disposition" field enum values. It is called by the builders before save.
 func DispositionValidator(d Disposition) error {
 	switch d {
-	case DispositionHostile, DispositionFriendly, DispositionNeutral:
+	case DispositionHostile, DispositionFriendly, DispositionNeutral, DispositionShopkeeper:
 		return nil
 	default:
 		return fmt.Errorf("npctemplate: invalid enum value for disposition field: %q", d)
@@ -955,6 +955,26 @@ func NotifyOnEnterNotNil() predicate.NPCTemplate {
 	return predicate.NPCTemplate(sql.FieldNotNull(FieldNotifyOnEnter))
 }
 
+// LootTableIsNil applies the IsNil predicate on the "loot_table" field.
+func LootTableIsNil() predicate.NPCTemplate {
+	return predicate.NPCTemplate(sql.FieldIsNull(FieldLootTable))
+}
+
+// LootTableNotNil applies the NotNil predicate on the "loot_table" field.
+func LootTableNotNil() predicate.NPCTemplate {
+	return predicate.NPCTemplate(sql.FieldNotNull(FieldLootTable))
+}
+
+// BehaviorIsNil applies the IsNil predicate on the "behavior" field.
+func BehaviorIsNil() predicate.NPCTemplate {
+	return predicate.NPCTemplate(sql.FieldIsNull(FieldBehavior))
+}
+
+// BehaviorNotNil applies the NotNil predicate on the "behavior" field.
+func BehaviorNotNil() predicate.NPCTemplate {
+	return predicate.NPCTemplate(sql.FieldNotNull(FieldBehavior))
+}
+
 // HasNpcAbilities applies the HasEdge predicate on the "npc_abilities" edge.
 func HasNpcAbilities() predicate.NPCTemplate {
 	return predicate.NPCTemplate(func(s *sql.Selector) {
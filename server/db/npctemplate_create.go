@@ -12,6 +12,7 @@ import (
 	"herbst-server/db/npcability"
 	"herbst-server/db/npctemplate"
 	"herbst-server/db/race"
+	"herbst-server/db/schema"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -263,6 +264,34 @@ func (_c *NPCTemplateCreate) SetNillableNotifyOnEnter(v *bool) *NPCTemplateCreat
 	return _c
 }
 
+// SetLootTable sets the "loot_table" field.
+func (_c *NPCTemplateCreate) SetLootTable(v schema.LootTable) *NPCTemplateCreate {
+	_c.mutation.SetLootTable(v)
+	return _c
+}
+
+// SetNillableLootTable sets the "loot_table" field if the given value is not nil.
+func (_c *NPCTemplateCreate) SetNillableLootTable(v *schema.LootTable) *NPCTemplateCreate {
+	if v != nil {
+		_c.SetLootTable(*v)
+	}
+	return _c
+}
+
+// SetBehavior sets the "behavior" field.
+func (_c *NPCTemplateCreate) SetBehavior(v schema.NPCBehavior) *NPCTemplateCreate {
+	_c.mutation.SetBehavior(v)
+	return _c
+}
+
+// SetNillableBehavior sets the "behavior" field if the given value is not nil.
+func (_c *NPCTemplateCreate) SetNillableBehavior(v *schema.NPCBehavior) *NPCTemplateCreate {
+	if v != nil {
+		_c.SetBehavior(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *NPCTemplateCreate) SetID(v string) *NPCTemplateCreate {
 	_c.mutation.SetID(v)
@@ -575,6 +604,14 @@ func (_c *NPCTemplateCreate) createSpec() (*NPCTemplate, *sqlgraph.CreateSpec) {
 		_spec.SetField(npctemplate.FieldNotifyOnEnter, field.TypeBool, value)
 		_node.NotifyOnEnter = value
 	}
+	if value, ok := _c.mutation.LootTable(); ok {
+		_spec.SetField(npctemplate.FieldLootTable, field.TypeJSON, value)
+		_node.LootTable = value
+	}
+	if value, ok := _c.mutation.Behavior(); ok {
+		_spec.SetField(npctemplate.FieldBehavior, field.TypeJSON, value)
+		_node.Behavior = value
+	}
 	if nodes := _c.mutation.NpcAbilitiesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
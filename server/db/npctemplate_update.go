@@ -13,6 +13,7 @@ import (
 	"herbst-server/db/npctemplate"
 	"herbst-server/db/predicate"
 	"herbst-server/db/race"
+	"herbst-server/db/schema"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -423,6 +424,46 @@ func (_u *NPCTemplateUpdate) ClearNotifyOnEnter() *NPCTemplateUpdate {
 	return _u
 }
 
+// SetLootTable sets the "loot_table" field.
+func (_u *NPCTemplateUpdate) SetLootTable(v schema.LootTable) *NPCTemplateUpdate {
+	_u.mutation.SetLootTable(v)
+	return _u
+}
+
+// SetNillableLootTable sets the "loot_table" field if the given value is not nil.
+func (_u *NPCTemplateUpdate) SetNillableLootTable(v *schema.LootTable) *NPCTemplateUpdate {
+	if v != nil {
+		_u.SetLootTable(*v)
+	}
+	return _u
+}
+
+// ClearLootTable clears the value of the "loot_table" field.
+func (_u *NPCTemplateUpdate) ClearLootTable() *NPCTemplateUpdate {
+	_u.mutation.ClearLootTable()
+	return _u
+}
+
+// SetBehavior sets the "behavior" field.
+func (_u *NPCTemplateUpdate) SetBehavior(v schema.NPCBehavior) *NPCTemplateUpdate {
+	_u.mutation.SetBehavior(v)
+	return _u
+}
+
+// SetNillableBehavior sets the "behavior" field if the given value is not nil.
+func (_u *NPCTemplateUpdate) SetNillableBehavior(v *schema.NPCBehavior) *NPCTemplateUpdate {
+	if v != nil {
+		_u.SetBehavior(*v)
+	}
+	return _u
+}
+
+// ClearBehavior clears the value of the "behavior" field.
+func (_u *NPCTemplateUpdate) ClearBehavior() *NPCTemplateUpdate {
+	_u.mutation.ClearBehavior()
+	return _u
+}
+
 // AddNpcAbilityIDs adds the "npc_abilities" edge to the NPCAbility entity by IDs.
 func (_u *NPCTemplateUpdate) AddNpcAbilityIDs(ids ...int) *NPCTemplateUpdate {
 	_u.mutation.AddNpcAbilityIDs(ids...)
@@ -760,6 +801,18 @@ func (_u *NPCTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.NotifyOnEnterCleared() {
 		_spec.ClearField(npctemplate.FieldNotifyOnEnter, field.TypeBool)
 	}
+	if value, ok := _u.mutation.LootTable(); ok {
+		_spec.SetField(npctemplate.FieldLootTable, field.TypeJSON, value)
+	}
+	if _u.mutation.LootTableCleared() {
+		_spec.ClearField(npctemplate.FieldLootTable, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Behavior(); ok {
+		_spec.SetField(npctemplate.FieldBehavior, field.TypeJSON, value)
+	}
+	if _u.mutation.BehaviorCleared() {
+		_spec.ClearField(npctemplate.FieldBehavior, field.TypeJSON)
+	}
 	if _u.mutation.NpcAbilitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1378,6 +1431,46 @@ func (_u *NPCTemplateUpdateOne) ClearNotifyOnEnter() *NPCTemplateUpdateOne {
 	return _u
 }
 
+// SetLootTable sets the "loot_table" field.
+func (_u *NPCTemplateUpdateOne) SetLootTable(v schema.LootTable) *NPCTemplateUpdateOne {
+	_u.mutation.SetLootTable(v)
+	return _u
+}
+
+// SetNillableLootTable sets the "loot_table" field if the given value is not nil.
+func (_u *NPCTemplateUpdateOne) SetNillableLootTable(v *schema.LootTable) *NPCTemplateUpdateOne {
+	if v != nil {
+		_u.SetLootTable(*v)
+	}
+	return _u
+}
+
+// ClearLootTable clears the value of the "loot_table" field.
+func (_u *NPCTemplateUpdateOne) ClearLootTable() *NPCTemplateUpdateOne {
+	_u.mutation.ClearLootTable()
+	return _u
+}
+
+// SetBehavior sets the "behavior" field.
+func (_u *NPCTemplateUpdateOne) SetBehavior(v schema.NPCBehavior) *NPCTemplateUpdateOne {
+	_u.mutation.SetBehavior(v)
+	return _u
+}
+
+// SetNillableBehavior sets the "behavior" field if the given value is not nil.
+func (_u *NPCTemplateUpdateOne) SetNillableBehavior(v *schema.NPCBehavior) *NPCTemplateUpdateOne {
+	if v != nil {
+		_u.SetBehavior(*v)
+	}
+	return _u
+}
+
+// ClearBehavior clears the value of the "behavior" field.
+func (_u *NPCTemplateUpdateOne) ClearBehavior() *NPCTemplateUpdateOne {
+	_u.mutation.ClearBehavior()
+	return _u
+}
+
 // AddNpcAbilityIDs adds the "npc_abilities" edge to the NPCAbility entity by IDs.
 func (_u *NPCTemplateUpdateOne) AddNpcAbilityIDs(ids ...int) *NPCTemplateUpdateOne {
 	_u.mutation.AddNpcAbilityIDs(ids...)
@@ -1745,6 +1838,18 @@ func (_u *NPCTemplateUpdateOne) sqlSave(ctx context.Context) (_node *NPCTemplate
 	if _u.mutation.NotifyOnEnterCleared() {
 		_spec.ClearField(npctemplate.FieldNotifyOnEnter, field.TypeBool)
 	}
+	if value, ok := _u.mutation.LootTable(); ok {
+		_spec.SetField(npctemplate.FieldLootTable, field.TypeJSON, value)
+	}
+	if _u.mutation.LootTableCleared() {
+		_spec.ClearField(npctemplate.FieldLootTable, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Behavior(); ok {
+		_spec.SetField(npctemplate.FieldBehavior, field.TypeJSON, value)
+	}
+	if _u.mutation.BehaviorCleared() {
+		_spec.ClearField(npctemplate.FieldBehavior, field.TypeJSON)
+	}
 	if _u.mutation.NpcAbilitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
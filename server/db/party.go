@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"herbst-server/db/party"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Party is the model entity for the Party schema.
+type Party struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Character ID of the party leader
+	LeaderID int `json:"leader_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PartyQuery when eager-loading is set.
+	Edges        PartyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PartyEdges holds the relations/edges for other nodes in the graph.
+type PartyEdges struct {
+	// Members holds the value of the members edge.
+	Members []*Character `json:"members,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e PartyEdges) MembersOrErr() ([]*Character, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Party) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case party.FieldID, party.FieldLeaderID:
+			values[i] = new(sql.NullInt64)
+		case party.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Party fields.
+func (_m *Party) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case party.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case party.FieldLeaderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field leader_id", values[i])
+			} else if value.Valid {
+				_m.LeaderID = int(value.Int64)
+			}
+		case party.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Party.
+// This includes values selected through modifiers, order, etc.
+func (_m *Party) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Party entity.
+func (_m *Party) QueryMembers() *CharacterQuery {
+	return NewPartyClient(_m.config).QueryMembers(_m)
+}
+
+// Update returns a builder for updating this Party.
+// Note that you need to call Party.Unwrap() before calling this method if this Party
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Party) Update() *PartyUpdateOne {
+	return NewPartyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Party entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Party) Unwrap() *Party {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: Party is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Party) String() string {
+	var builder strings.Builder
+	builder.WriteString("Party(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("leader_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeaderID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Parties is a parsable slice of Party.
+type Parties []*Party
@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/character"
+	"herbst-server/db/party"
+	"herbst-server/db/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PartyUpdate is the builder for updating Party entities.
+type PartyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PartyMutation
+}
+
+// Where appends a list predicates to the PartyUpdate builder.
+func (_u *PartyUpdate) Where(ps ...predicate.Party) *PartyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLeaderID sets the "leader_id" field.
+func (_u *PartyUpdate) SetLeaderID(v int) *PartyUpdate {
+	_u.mutation.ResetLeaderID()
+	_u.mutation.SetLeaderID(v)
+	return _u
+}
+
+// SetNillableLeaderID sets the "leader_id" field if the given value is not nil.
+func (_u *PartyUpdate) SetNillableLeaderID(v *int) *PartyUpdate {
+	if v != nil {
+		_u.SetLeaderID(*v)
+	}
+	return _u
+}
+
+// AddLeaderID adds value to the "leader_id" field.
+func (_u *PartyUpdate) AddLeaderID(v int) *PartyUpdate {
+	_u.mutation.AddLeaderID(v)
+	return _u
+}
+
+// AddMemberIDs adds the "members" edge to the Character entity by IDs.
+func (_u *PartyUpdate) AddMemberIDs(ids ...int) *PartyUpdate {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the Character entity.
+func (_u *PartyUpdate) AddMembers(v ...*Character) *PartyUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// Mutation returns the PartyMutation object of the builder.
+func (_u *PartyUpdate) Mutation() *PartyMutation {
+	return _u.mutation
+}
+
+// ClearMembers clears all "members" edges to the Character entity.
+func (_u *PartyUpdate) ClearMembers() *PartyUpdate {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to Character entities by IDs.
+func (_u *PartyUpdate) RemoveMemberIDs(ids ...int) *PartyUpdate {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to Character entities.
+func (_u *PartyUpdate) RemoveMembers(v ...*Character) *PartyUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PartyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PartyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PartyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PartyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PartyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(party.Table, party.Columns, sqlgraph.NewFieldSpec(party.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LeaderID(); ok {
+		_spec.SetField(party.FieldLeaderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeaderID(); ok {
+		_spec.AddField(party.FieldLeaderID, field.TypeInt, value)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   party.MembersTable,
+			Columns: []string{party.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   party.MembersTable,
+			Columns: []string{party.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   party.MembersTable,
+			Columns: []string{party.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{party.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PartyUpdateOne is the builder for updating a single Party entity.
+type PartyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PartyMutation
+}
+
+// SetLeaderID sets the "leader_id" field.
+func (_u *PartyUpdateOne) SetLeaderID(v int) *PartyUpdateOne {
+	_u.mutation.ResetLeaderID()
+	_u.mutation.SetLeaderID(v)
+	return _u
+}
+
+// SetNillableLeaderID sets the "leader_id" field if the given value is not nil.
+func (_u *PartyUpdateOne) SetNillableLeaderID(v *int) *PartyUpdateOne {
+	if v != nil {
+		_u.SetLeaderID(*v)
+	}
+	return _u
+}
+
+// AddLeaderID adds value to the "leader_id" field.
+func (_u *PartyUpdateOne) AddLeaderID(v int) *PartyUpdateOne {
+	_u.mutation.AddLeaderID(v)
+	return _u
+}
+
+// AddMemberIDs adds the "members" edge to the Character entity by IDs.
+func (_u *PartyUpdateOne) AddMemberIDs(ids ...int) *PartyUpdateOne {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the Character entity.
+func (_u *PartyUpdateOne) AddMembers(v ...*Character) *PartyUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// Mutation returns the PartyMutation object of the builder.
+func (_u *PartyUpdateOne) Mutation() *PartyMutation {
+	return _u.mutation
+}
+
+// ClearMembers clears all "members" edges to the Character entity.
+func (_u *PartyUpdateOne) ClearMembers() *PartyUpdateOne {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to Character entities by IDs.
+func (_u *PartyUpdateOne) RemoveMemberIDs(ids ...int) *PartyUpdateOne {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to Character entities.
+func (_u *PartyUpdateOne) RemoveMembers(v ...*Character) *PartyUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// Where appends a list predicates to the PartyUpdate builder.
+func (_u *PartyUpdateOne) Where(ps ...predicate.Party) *PartyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PartyUpdateOne) Select(field string, fields ...string) *PartyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Party entity.
+func (_u *PartyUpdateOne) Save(ctx context.Context) (*Party, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PartyUpdateOne) SaveX(ctx context.Context) *Party {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PartyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PartyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PartyUpdateOne) sqlSave(ctx context.Context) (_node *Party, err error) {
+	_spec := sqlgraph.NewUpdateSpec(party.Table, party.Columns, sqlgraph.NewFieldSpec(party.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "Party.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, party.FieldID)
+		for _, f := range fields {
+			if !party.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != party.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LeaderID(); ok {
+		_spec.SetField(party.FieldLeaderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeaderID(); ok {
+		_spec.AddField(party.FieldLeaderID, field.TypeInt, value)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   party.MembersTable,
+			Columns: []string{party.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   party.MembersTable,
+			Columns: []string{party.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   party.MembersTable,
+			Columns: []string{party.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(character.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Party{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{party.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"herbst-server/db/partyinvite"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PartyInvite is the model entity for the PartyInvite schema.
+type PartyInvite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Character who sent the invite
+	InviterID int `json:"inviter_id,omitempty"`
+	// Character who may accept it
+	InviteeID int `json:"invitee_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PartyInvite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case partyinvite.FieldID, partyinvite.FieldInviterID, partyinvite.FieldInviteeID:
+			values[i] = new(sql.NullInt64)
+		case partyinvite.FieldExpiresAt, partyinvite.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PartyInvite fields.
+func (_m *PartyInvite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case partyinvite.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case partyinvite.FieldInviterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field inviter_id", values[i])
+			} else if value.Valid {
+				_m.InviterID = int(value.Int64)
+			}
+		case partyinvite.FieldInviteeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invitee_id", values[i])
+			} else if value.Valid {
+				_m.InviteeID = int(value.Int64)
+			}
+		case partyinvite.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case partyinvite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PartyInvite.
+// This includes values selected through modifiers, order, etc.
+func (_m *PartyInvite) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PartyInvite.
+// Note that you need to call PartyInvite.Unwrap() before calling this method if this PartyInvite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PartyInvite) Update() *PartyInviteUpdateOne {
+	return NewPartyInviteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PartyInvite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PartyInvite) Unwrap() *PartyInvite {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: PartyInvite is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PartyInvite) String() string {
+	var builder strings.Builder
+	builder.WriteString("PartyInvite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("inviter_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InviterID))
+	builder.WriteString(", ")
+	builder.WriteString("invitee_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InviteeID))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PartyInvites is a parsable slice of PartyInvite.
+type PartyInvites []*PartyInvite
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package partyinvite
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLTE(FieldID, id))
+}
+
+// InviterID applies equality check predicate on the "inviter_id" field. It's identical to InviterIDEQ.
+func InviterID(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldInviterID, v))
+}
+
+// InviteeID applies equality check predicate on the "invitee_id" field. It's identical to InviteeIDEQ.
+func InviteeID(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldInviteeID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// InviterIDEQ applies the EQ predicate on the "inviter_id" field.
+func InviterIDEQ(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldInviterID, v))
+}
+
+// InviterIDNEQ applies the NEQ predicate on the "inviter_id" field.
+func InviterIDNEQ(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNEQ(FieldInviterID, v))
+}
+
+// InviterIDIn applies the In predicate on the "inviter_id" field.
+func InviterIDIn(vs ...int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldIn(FieldInviterID, vs...))
+}
+
+// InviterIDNotIn applies the NotIn predicate on the "inviter_id" field.
+func InviterIDNotIn(vs ...int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNotIn(FieldInviterID, vs...))
+}
+
+// InviterIDGT applies the GT predicate on the "inviter_id" field.
+func InviterIDGT(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGT(FieldInviterID, v))
+}
+
+// InviterIDGTE applies the GTE predicate on the "inviter_id" field.
+func InviterIDGTE(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGTE(FieldInviterID, v))
+}
+
+// InviterIDLT applies the LT predicate on the "inviter_id" field.
+func InviterIDLT(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLT(FieldInviterID, v))
+}
+
+// InviterIDLTE applies the LTE predicate on the "inviter_id" field.
+func InviterIDLTE(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLTE(FieldInviterID, v))
+}
+
+// InviteeIDEQ applies the EQ predicate on the "invitee_id" field.
+func InviteeIDEQ(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldInviteeID, v))
+}
+
+// InviteeIDNEQ applies the NEQ predicate on the "invitee_id" field.
+func InviteeIDNEQ(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNEQ(FieldInviteeID, v))
+}
+
+// InviteeIDIn applies the In predicate on the "invitee_id" field.
+func InviteeIDIn(vs ...int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldIn(FieldInviteeID, vs...))
+}
+
+// InviteeIDNotIn applies the NotIn predicate on the "invitee_id" field.
+func InviteeIDNotIn(vs ...int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNotIn(FieldInviteeID, vs...))
+}
+
+// InviteeIDGT applies the GT predicate on the "invitee_id" field.
+func InviteeIDGT(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGT(FieldInviteeID, v))
+}
+
+// InviteeIDGTE applies the GTE predicate on the "invitee_id" field.
+func InviteeIDGTE(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGTE(FieldInviteeID, v))
+}
+
+// InviteeIDLT applies the LT predicate on the "invitee_id" field.
+func InviteeIDLT(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLT(FieldInviteeID, v))
+}
+
+// InviteeIDLTE applies the LTE predicate on the "invitee_id" field.
+func InviteeIDLTE(v int) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLTE(FieldInviteeID, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PartyInvite) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PartyInvite) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PartyInvite) predicate.PartyInvite {
+	return predicate.PartyInvite(sql.NotPredicates(p))
+}
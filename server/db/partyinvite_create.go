@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/partyinvite"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PartyInviteCreate is the builder for creating a PartyInvite entity.
+type PartyInviteCreate struct {
+	config
+	mutation *PartyInviteMutation
+	hooks    []Hook
+}
+
+// SetInviterID sets the "inviter_id" field.
+func (_c *PartyInviteCreate) SetInviterID(v int) *PartyInviteCreate {
+	_c.mutation.SetInviterID(v)
+	return _c
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_c *PartyInviteCreate) SetInviteeID(v int) *PartyInviteCreate {
+	_c.mutation.SetInviteeID(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PartyInviteCreate) SetExpiresAt(v time.Time) *PartyInviteCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PartyInviteCreate) SetCreatedAt(v time.Time) *PartyInviteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PartyInviteCreate) SetNillableCreatedAt(v *time.Time) *PartyInviteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PartyInviteMutation object of the builder.
+func (_c *PartyInviteCreate) Mutation() *PartyInviteMutation {
+	return _c.mutation
+}
+
+// Save creates the PartyInvite in the database.
+func (_c *PartyInviteCreate) Save(ctx context.Context) (*PartyInvite, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PartyInviteCreate) SaveX(ctx context.Context) *PartyInvite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PartyInviteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PartyInviteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PartyInviteCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := partyinvite.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PartyInviteCreate) check() error {
+	if _, ok := _c.mutation.InviterID(); !ok {
+		return &ValidationError{Name: "inviter_id", err: errors.New(`db: missing required field "PartyInvite.inviter_id"`)}
+	}
+	if _, ok := _c.mutation.InviteeID(); !ok {
+		return &ValidationError{Name: "invitee_id", err: errors.New(`db: missing required field "PartyInvite.invitee_id"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`db: missing required field "PartyInvite.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "PartyInvite.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PartyInviteCreate) sqlSave(ctx context.Context) (*PartyInvite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PartyInviteCreate) createSpec() (*PartyInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PartyInvite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(partyinvite.Table, sqlgraph.NewFieldSpec(partyinvite.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.InviterID(); ok {
+		_spec.SetField(partyinvite.FieldInviterID, field.TypeInt, value)
+		_node.InviterID = value
+	}
+	if value, ok := _c.mutation.InviteeID(); ok {
+		_spec.SetField(partyinvite.FieldInviteeID, field.TypeInt, value)
+		_node.InviteeID = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(partyinvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(partyinvite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PartyInviteCreateBulk is the builder for creating many PartyInvite entities in bulk.
+type PartyInviteCreateBulk struct {
+	config
+	err      error
+	builders []*PartyInviteCreate
+}
+
+// Save creates the PartyInvite entities in the database.
+func (_c *PartyInviteCreateBulk) Save(ctx context.Context) ([]*PartyInvite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PartyInvite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PartyInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PartyInviteCreateBulk) SaveX(ctx context.Context) []*PartyInvite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PartyInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PartyInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
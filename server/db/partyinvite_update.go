@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/partyinvite"
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PartyInviteUpdate is the builder for updating PartyInvite entities.
+type PartyInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PartyInviteMutation
+}
+
+// Where appends a list predicates to the PartyInviteUpdate builder.
+func (_u *PartyInviteUpdate) Where(ps ...predicate.PartyInvite) *PartyInviteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetInviterID sets the "inviter_id" field.
+func (_u *PartyInviteUpdate) SetInviterID(v int) *PartyInviteUpdate {
+	_u.mutation.ResetInviterID()
+	_u.mutation.SetInviterID(v)
+	return _u
+}
+
+// SetNillableInviterID sets the "inviter_id" field if the given value is not nil.
+func (_u *PartyInviteUpdate) SetNillableInviterID(v *int) *PartyInviteUpdate {
+	if v != nil {
+		_u.SetInviterID(*v)
+	}
+	return _u
+}
+
+// AddInviterID adds value to the "inviter_id" field.
+func (_u *PartyInviteUpdate) AddInviterID(v int) *PartyInviteUpdate {
+	_u.mutation.AddInviterID(v)
+	return _u
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_u *PartyInviteUpdate) SetInviteeID(v int) *PartyInviteUpdate {
+	_u.mutation.ResetInviteeID()
+	_u.mutation.SetInviteeID(v)
+	return _u
+}
+
+// SetNillableInviteeID sets the "invitee_id" field if the given value is not nil.
+func (_u *PartyInviteUpdate) SetNillableInviteeID(v *int) *PartyInviteUpdate {
+	if v != nil {
+		_u.SetInviteeID(*v)
+	}
+	return _u
+}
+
+// AddInviteeID adds value to the "invitee_id" field.
+func (_u *PartyInviteUpdate) AddInviteeID(v int) *PartyInviteUpdate {
+	_u.mutation.AddInviteeID(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PartyInviteUpdate) SetExpiresAt(v time.Time) *PartyInviteUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PartyInviteUpdate) SetNillableExpiresAt(v *time.Time) *PartyInviteUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PartyInviteMutation object of the builder.
+func (_u *PartyInviteUpdate) Mutation() *PartyInviteMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PartyInviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PartyInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PartyInviteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PartyInviteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PartyInviteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(partyinvite.Table, partyinvite.Columns, sqlgraph.NewFieldSpec(partyinvite.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.InviterID(); ok {
+		_spec.SetField(partyinvite.FieldInviterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviterID(); ok {
+		_spec.AddField(partyinvite.FieldInviterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InviteeID(); ok {
+		_spec.SetField(partyinvite.FieldInviteeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviteeID(); ok {
+		_spec.AddField(partyinvite.FieldInviteeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(partyinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{partyinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PartyInviteUpdateOne is the builder for updating a single PartyInvite entity.
+type PartyInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PartyInviteMutation
+}
+
+// SetInviterID sets the "inviter_id" field.
+func (_u *PartyInviteUpdateOne) SetInviterID(v int) *PartyInviteUpdateOne {
+	_u.mutation.ResetInviterID()
+	_u.mutation.SetInviterID(v)
+	return _u
+}
+
+// SetNillableInviterID sets the "inviter_id" field if the given value is not nil.
+func (_u *PartyInviteUpdateOne) SetNillableInviterID(v *int) *PartyInviteUpdateOne {
+	if v != nil {
+		_u.SetInviterID(*v)
+	}
+	return _u
+}
+
+// AddInviterID adds value to the "inviter_id" field.
+func (_u *PartyInviteUpdateOne) AddInviterID(v int) *PartyInviteUpdateOne {
+	_u.mutation.AddInviterID(v)
+	return _u
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_u *PartyInviteUpdateOne) SetInviteeID(v int) *PartyInviteUpdateOne {
+	_u.mutation.ResetInviteeID()
+	_u.mutation.SetInviteeID(v)
+	return _u
+}
+
+// SetNillableInviteeID sets the "invitee_id" field if the given value is not nil.
+func (_u *PartyInviteUpdateOne) SetNillableInviteeID(v *int) *PartyInviteUpdateOne {
+	if v != nil {
+		_u.SetInviteeID(*v)
+	}
+	return _u
+}
+
+// AddInviteeID adds value to the "invitee_id" field.
+func (_u *PartyInviteUpdateOne) AddInviteeID(v int) *PartyInviteUpdateOne {
+	_u.mutation.AddInviteeID(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PartyInviteUpdateOne) SetExpiresAt(v time.Time) *PartyInviteUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PartyInviteUpdateOne) SetNillableExpiresAt(v *time.Time) *PartyInviteUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PartyInviteMutation object of the builder.
+func (_u *PartyInviteUpdateOne) Mutation() *PartyInviteMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PartyInviteUpdate builder.
+func (_u *PartyInviteUpdateOne) Where(ps ...predicate.PartyInvite) *PartyInviteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PartyInviteUpdateOne) Select(field string, fields ...string) *PartyInviteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PartyInvite entity.
+func (_u *PartyInviteUpdateOne) Save(ctx context.Context) (*PartyInvite, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PartyInviteUpdateOne) SaveX(ctx context.Context) *PartyInvite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PartyInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PartyInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PartyInviteUpdateOne) sqlSave(ctx context.Context) (_node *PartyInvite, err error) {
+	_spec := sqlgraph.NewUpdateSpec(partyinvite.Table, partyinvite.Columns, sqlgraph.NewFieldSpec(partyinvite.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "PartyInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, partyinvite.FieldID)
+		for _, f := range fields {
+			if !partyinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != partyinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.InviterID(); ok {
+		_spec.SetField(partyinvite.FieldInviterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviterID(); ok {
+		_spec.AddField(partyinvite.FieldInviterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InviteeID(); ok {
+		_spec.SetField(partyinvite.FieldInviteeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviteeID(); ok {
+		_spec.AddField(partyinvite.FieldInviteeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(partyinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &PartyInvite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{partyinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
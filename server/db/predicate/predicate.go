@@ -21,6 +21,9 @@ type ActiveEffect func(*sql.Selector)
 // AppLog is the predicate function for applog builders.
 type AppLog func(*sql.Selector)
 
+// Auction is the predicate function for auction builders.
+type Auction func(*sql.Selector)
+
 // ChannelConfig is the predicate function for channelconfig builders.
 type ChannelConfig func(*sql.Selector)
 
@@ -30,6 +33,9 @@ type Character func(*sql.Selector)
 // CharacterAbility is the predicate function for characterability builders.
 type CharacterAbility func(*sql.Selector)
 
+// CharacterAchievement is the predicate function for characterachievement builders.
+type CharacterAchievement func(*sql.Selector)
+
 // CharacterChannel is the predicate function for characterchannel builders.
 type CharacterChannel func(*sql.Selector)
 
@@ -66,9 +72,15 @@ type CraftingRecipe func(*sql.Selector)
 // DamageLog is the predicate function for damagelog builders.
 type DamageLog func(*sql.Selector)
 
+// DeadLetter is the predicate function for deadletter builders.
+type DeadLetter func(*sql.Selector)
+
 // DialogNode is the predicate function for dialognode builders.
 type DialogNode func(*sql.Selector)
 
+// DialogState is the predicate function for dialogstate builders.
+type DialogState func(*sql.Selector)
+
 // Effect is the predicate function for effect builders.
 type Effect func(*sql.Selector)
 
@@ -81,6 +93,9 @@ type Equipment func(*sql.Selector)
 // EquipmentTemplate is the predicate function for equipmenttemplate builders.
 type EquipmentTemplate func(*sql.Selector)
 
+// EventCursor is the predicate function for eventcursor builders.
+type EventCursor func(*sql.Selector)
+
 // Faction is the predicate function for faction builders.
 type Faction func(*sql.Selector)
 
@@ -96,12 +111,27 @@ type GameConfig func(*sql.Selector)
 // Gender is the predicate function for gender builders.
 type Gender func(*sql.Selector)
 
+// House is the predicate function for house builders.
+type House func(*sql.Selector)
+
+// Mail is the predicate function for mail builders.
+type Mail func(*sql.Selector)
+
 // NPCAbility is the predicate function for npcability builders.
 type NPCAbility func(*sql.Selector)
 
 // NPCTemplate is the predicate function for npctemplate builders.
 type NPCTemplate func(*sql.Selector)
 
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// Party is the predicate function for party builders.
+type Party func(*sql.Selector)
+
+// PartyInvite is the predicate function for partyinvite builders.
+type PartyInvite func(*sql.Selector)
+
 // Quest is the predicate function for quest builders.
 type Quest func(*sql.Selector)
 
@@ -111,6 +141,9 @@ type QuestProgress func(*sql.Selector)
 // Race is the predicate function for race builders.
 type Race func(*sql.Selector)
 
+// Reputation is the predicate function for reputation builders.
+type Reputation func(*sql.Selector)
+
 // Room is the predicate function for room builders.
 type Room func(*sql.Selector)
 
@@ -126,6 +159,9 @@ type Skill func(*sql.Selector)
 // SocialCommand is the predicate function for socialcommand builders.
 type SocialCommand func(*sql.Selector)
 
+// Stash is the predicate function for stash builders.
+type Stash func(*sql.Selector)
+
 // SystemLog is the predicate function for systemlog builders.
 type SystemLog func(*sql.Selector)
 
@@ -146,3 +182,6 @@ type World func(*sql.Selector)
 
 // Zone is the predicate function for zone builders.
 type Zone func(*sql.Selector)
+
+// ZoneInstance is the predicate function for zoneinstance builders.
+type ZoneInstance func(*sql.Selector)
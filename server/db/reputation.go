@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"herbst-server/db/reputation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Reputation is the model entity for the Reputation schema.
+type Reputation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CharacterID holds the value of the "character_id" field.
+	CharacterID int `json:"character_id,omitempty"`
+	// Faction name, matched case-insensitively
+	Faction string `json:"faction,omitempty"`
+	// -1000 (hostile) to 1000 (exalted)
+	Standing int `json:"standing,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reputation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reputation.FieldID, reputation.FieldCharacterID, reputation.FieldStanding:
+			values[i] = new(sql.NullInt64)
+		case reputation.FieldFaction:
+			values[i] = new(sql.NullString)
+		case reputation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reputation fields.
+func (_m *Reputation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reputation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case reputation.FieldCharacterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field character_id", values[i])
+			} else if value.Valid {
+				_m.CharacterID = int(value.Int64)
+			}
+		case reputation.FieldFaction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field faction", values[i])
+			} else if value.Valid {
+				_m.Faction = value.String
+			}
+		case reputation.FieldStanding:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field standing", values[i])
+			} else if value.Valid {
+				_m.Standing = int(value.Int64)
+			}
+		case reputation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reputation.
+// This includes values selected through modifiers, order, etc.
+func (_m *Reputation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Reputation.
+// Note that you need to call Reputation.Unwrap() before calling this method if this Reputation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Reputation) Update() *ReputationUpdateOne {
+	return NewReputationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Reputation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Reputation) Unwrap() *Reputation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: Reputation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Reputation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reputation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("character_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CharacterID))
+	builder.WriteString(", ")
+	builder.WriteString("faction=")
+	builder.WriteString(_m.Faction)
+	builder.WriteString(", ")
+	builder.WriteString("standing=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Standing))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reputations is a parsable slice of Reputation.
+type Reputations []*Reputation
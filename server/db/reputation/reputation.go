@@ -0,0 +1,82 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reputation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the reputation type in the database.
+	Label = "reputation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCharacterID holds the string denoting the character_id field in the database.
+	FieldCharacterID = "character_id"
+	// FieldFaction holds the string denoting the faction field in the database.
+	FieldFaction = "faction"
+	// FieldStanding holds the string denoting the standing field in the database.
+	FieldStanding = "standing"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the reputation in the database.
+	Table = "reputations"
+)
+
+// Columns holds all SQL columns for reputation fields.
+var Columns = []string{
+	FieldID,
+	FieldCharacterID,
+	FieldFaction,
+	FieldStanding,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStanding holds the default value on creation for the "standing" field.
+	DefaultStanding int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Reputation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCharacterID orders the results by the character_id field.
+func ByCharacterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharacterID, opts...).ToFunc()
+}
+
+// ByFaction orders the results by the faction field.
+func ByFaction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFaction, opts...).ToFunc()
+}
+
+// ByStanding orders the results by the standing field.
+func ByStanding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStanding, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
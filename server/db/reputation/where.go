@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reputation
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLTE(FieldID, id))
+}
+
+// CharacterID applies equality check predicate on the "character_id" field. It's identical to CharacterIDEQ.
+func CharacterID(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldCharacterID, v))
+}
+
+// Faction applies equality check predicate on the "faction" field. It's identical to FactionEQ.
+func Faction(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldFaction, v))
+}
+
+// Standing applies equality check predicate on the "standing" field. It's identical to StandingEQ.
+func Standing(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldStanding, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CharacterIDEQ applies the EQ predicate on the "character_id" field.
+func CharacterIDEQ(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldCharacterID, v))
+}
+
+// CharacterIDNEQ applies the NEQ predicate on the "character_id" field.
+func CharacterIDNEQ(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNEQ(FieldCharacterID, v))
+}
+
+// CharacterIDIn applies the In predicate on the "character_id" field.
+func CharacterIDIn(vs ...int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldIn(FieldCharacterID, vs...))
+}
+
+// CharacterIDNotIn applies the NotIn predicate on the "character_id" field.
+func CharacterIDNotIn(vs ...int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNotIn(FieldCharacterID, vs...))
+}
+
+// CharacterIDGT applies the GT predicate on the "character_id" field.
+func CharacterIDGT(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGT(FieldCharacterID, v))
+}
+
+// CharacterIDGTE applies the GTE predicate on the "character_id" field.
+func CharacterIDGTE(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGTE(FieldCharacterID, v))
+}
+
+// CharacterIDLT applies the LT predicate on the "character_id" field.
+func CharacterIDLT(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLT(FieldCharacterID, v))
+}
+
+// CharacterIDLTE applies the LTE predicate on the "character_id" field.
+func CharacterIDLTE(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLTE(FieldCharacterID, v))
+}
+
+// FactionEQ applies the EQ predicate on the "faction" field.
+func FactionEQ(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldFaction, v))
+}
+
+// FactionNEQ applies the NEQ predicate on the "faction" field.
+func FactionNEQ(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNEQ(FieldFaction, v))
+}
+
+// FactionIn applies the In predicate on the "faction" field.
+func FactionIn(vs ...string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldIn(FieldFaction, vs...))
+}
+
+// FactionNotIn applies the NotIn predicate on the "faction" field.
+func FactionNotIn(vs ...string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNotIn(FieldFaction, vs...))
+}
+
+// FactionGT applies the GT predicate on the "faction" field.
+func FactionGT(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGT(FieldFaction, v))
+}
+
+// FactionGTE applies the GTE predicate on the "faction" field.
+func FactionGTE(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGTE(FieldFaction, v))
+}
+
+// FactionLT applies the LT predicate on the "faction" field.
+func FactionLT(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLT(FieldFaction, v))
+}
+
+// FactionLTE applies the LTE predicate on the "faction" field.
+func FactionLTE(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLTE(FieldFaction, v))
+}
+
+// FactionContains applies the Contains predicate on the "faction" field.
+func FactionContains(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldContains(FieldFaction, v))
+}
+
+// FactionHasPrefix applies the HasPrefix predicate on the "faction" field.
+func FactionHasPrefix(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldHasPrefix(FieldFaction, v))
+}
+
+// FactionHasSuffix applies the HasSuffix predicate on the "faction" field.
+func FactionHasSuffix(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldHasSuffix(FieldFaction, v))
+}
+
+// FactionEqualFold applies the EqualFold predicate on the "faction" field.
+func FactionEqualFold(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEqualFold(FieldFaction, v))
+}
+
+// FactionContainsFold applies the ContainsFold predicate on the "faction" field.
+func FactionContainsFold(v string) predicate.Reputation {
+	return predicate.Reputation(sql.FieldContainsFold(FieldFaction, v))
+}
+
+// StandingEQ applies the EQ predicate on the "standing" field.
+func StandingEQ(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldStanding, v))
+}
+
+// StandingNEQ applies the NEQ predicate on the "standing" field.
+func StandingNEQ(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNEQ(FieldStanding, v))
+}
+
+// StandingIn applies the In predicate on the "standing" field.
+func StandingIn(vs ...int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldIn(FieldStanding, vs...))
+}
+
+// StandingNotIn applies the NotIn predicate on the "standing" field.
+func StandingNotIn(vs ...int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNotIn(FieldStanding, vs...))
+}
+
+// StandingGT applies the GT predicate on the "standing" field.
+func StandingGT(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGT(FieldStanding, v))
+}
+
+// StandingGTE applies the GTE predicate on the "standing" field.
+func StandingGTE(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGTE(FieldStanding, v))
+}
+
+// StandingLT applies the LT predicate on the "standing" field.
+func StandingLT(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLT(FieldStanding, v))
+}
+
+// StandingLTE applies the LTE predicate on the "standing" field.
+func StandingLTE(v int) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLTE(FieldStanding, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Reputation {
+	return predicate.Reputation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Reputation) predicate.Reputation {
+	return predicate.Reputation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Reputation) predicate.Reputation {
+	return predicate.Reputation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Reputation) predicate.Reputation {
+	return predicate.Reputation(sql.NotPredicates(p))
+}
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/reputation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReputationCreate is the builder for creating a Reputation entity.
+type ReputationCreate struct {
+	config
+	mutation *ReputationMutation
+	hooks    []Hook
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_c *ReputationCreate) SetCharacterID(v int) *ReputationCreate {
+	_c.mutation.SetCharacterID(v)
+	return _c
+}
+
+// SetFaction sets the "faction" field.
+func (_c *ReputationCreate) SetFaction(v string) *ReputationCreate {
+	_c.mutation.SetFaction(v)
+	return _c
+}
+
+// SetStanding sets the "standing" field.
+func (_c *ReputationCreate) SetStanding(v int) *ReputationCreate {
+	_c.mutation.SetStanding(v)
+	return _c
+}
+
+// SetNillableStanding sets the "standing" field if the given value is not nil.
+func (_c *ReputationCreate) SetNillableStanding(v *int) *ReputationCreate {
+	if v != nil {
+		_c.SetStanding(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReputationCreate) SetUpdatedAt(v time.Time) *ReputationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReputationCreate) SetNillableUpdatedAt(v *time.Time) *ReputationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ReputationMutation object of the builder.
+func (_c *ReputationCreate) Mutation() *ReputationMutation {
+	return _c.mutation
+}
+
+// Save creates the Reputation in the database.
+func (_c *ReputationCreate) Save(ctx context.Context) (*Reputation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReputationCreate) SaveX(ctx context.Context) *Reputation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReputationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReputationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReputationCreate) defaults() {
+	if _, ok := _c.mutation.Standing(); !ok {
+		v := reputation.DefaultStanding
+		_c.mutation.SetStanding(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reputation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReputationCreate) check() error {
+	if _, ok := _c.mutation.CharacterID(); !ok {
+		return &ValidationError{Name: "character_id", err: errors.New(`db: missing required field "Reputation.character_id"`)}
+	}
+	if _, ok := _c.mutation.Faction(); !ok {
+		return &ValidationError{Name: "faction", err: errors.New(`db: missing required field "Reputation.faction"`)}
+	}
+	if _, ok := _c.mutation.Standing(); !ok {
+		return &ValidationError{Name: "standing", err: errors.New(`db: missing required field "Reputation.standing"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "Reputation.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReputationCreate) sqlSave(ctx context.Context) (*Reputation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReputationCreate) createSpec() (*Reputation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reputation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reputation.Table, sqlgraph.NewFieldSpec(reputation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CharacterID(); ok {
+		_spec.SetField(reputation.FieldCharacterID, field.TypeInt, value)
+		_node.CharacterID = value
+	}
+	if value, ok := _c.mutation.Faction(); ok {
+		_spec.SetField(reputation.FieldFaction, field.TypeString, value)
+		_node.Faction = value
+	}
+	if value, ok := _c.mutation.Standing(); ok {
+		_spec.SetField(reputation.FieldStanding, field.TypeInt, value)
+		_node.Standing = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reputation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ReputationCreateBulk is the builder for creating many Reputation entities in bulk.
+type ReputationCreateBulk struct {
+	config
+	err      error
+	builders []*ReputationCreate
+}
+
+// Save creates the Reputation entities in the database.
+func (_c *ReputationCreateBulk) Save(ctx context.Context) ([]*Reputation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Reputation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReputationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReputationCreateBulk) SaveX(ctx context.Context) []*Reputation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReputationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReputationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
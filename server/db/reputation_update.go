@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/predicate"
+	"herbst-server/db/reputation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReputationUpdate is the builder for updating Reputation entities.
+type ReputationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReputationMutation
+}
+
+// Where appends a list predicates to the ReputationUpdate builder.
+func (_u *ReputationUpdate) Where(ps ...predicate.Reputation) *ReputationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_u *ReputationUpdate) SetCharacterID(v int) *ReputationUpdate {
+	_u.mutation.ResetCharacterID()
+	_u.mutation.SetCharacterID(v)
+	return _u
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_u *ReputationUpdate) SetNillableCharacterID(v *int) *ReputationUpdate {
+	if v != nil {
+		_u.SetCharacterID(*v)
+	}
+	return _u
+}
+
+// AddCharacterID adds value to the "character_id" field.
+func (_u *ReputationUpdate) AddCharacterID(v int) *ReputationUpdate {
+	_u.mutation.AddCharacterID(v)
+	return _u
+}
+
+// SetFaction sets the "faction" field.
+func (_u *ReputationUpdate) SetFaction(v string) *ReputationUpdate {
+	_u.mutation.SetFaction(v)
+	return _u
+}
+
+// SetNillableFaction sets the "faction" field if the given value is not nil.
+func (_u *ReputationUpdate) SetNillableFaction(v *string) *ReputationUpdate {
+	if v != nil {
+		_u.SetFaction(*v)
+	}
+	return _u
+}
+
+// SetStanding sets the "standing" field.
+func (_u *ReputationUpdate) SetStanding(v int) *ReputationUpdate {
+	_u.mutation.ResetStanding()
+	_u.mutation.SetStanding(v)
+	return _u
+}
+
+// SetNillableStanding sets the "standing" field if the given value is not nil.
+func (_u *ReputationUpdate) SetNillableStanding(v *int) *ReputationUpdate {
+	if v != nil {
+		_u.SetStanding(*v)
+	}
+	return _u
+}
+
+// AddStanding adds value to the "standing" field.
+func (_u *ReputationUpdate) AddStanding(v int) *ReputationUpdate {
+	_u.mutation.AddStanding(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReputationUpdate) SetUpdatedAt(v time.Time) *ReputationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReputationMutation object of the builder.
+func (_u *ReputationUpdate) Mutation() *ReputationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReputationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReputationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReputationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReputationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReputationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reputation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ReputationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reputation.Table, reputation.Columns, sqlgraph.NewFieldSpec(reputation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CharacterID(); ok {
+		_spec.SetField(reputation.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharacterID(); ok {
+		_spec.AddField(reputation.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Faction(); ok {
+		_spec.SetField(reputation.FieldFaction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Standing(); ok {
+		_spec.SetField(reputation.FieldStanding, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStanding(); ok {
+		_spec.AddField(reputation.FieldStanding, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reputation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reputation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReputationUpdateOne is the builder for updating a single Reputation entity.
+type ReputationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReputationMutation
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_u *ReputationUpdateOne) SetCharacterID(v int) *ReputationUpdateOne {
+	_u.mutation.ResetCharacterID()
+	_u.mutation.SetCharacterID(v)
+	return _u
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_u *ReputationUpdateOne) SetNillableCharacterID(v *int) *ReputationUpdateOne {
+	if v != nil {
+		_u.SetCharacterID(*v)
+	}
+	return _u
+}
+
+// AddCharacterID adds value to the "character_id" field.
+func (_u *ReputationUpdateOne) AddCharacterID(v int) *ReputationUpdateOne {
+	_u.mutation.AddCharacterID(v)
+	return _u
+}
+
+// SetFaction sets the "faction" field.
+func (_u *ReputationUpdateOne) SetFaction(v string) *ReputationUpdateOne {
+	_u.mutation.SetFaction(v)
+	return _u
+}
+
+// SetNillableFaction sets the "faction" field if the given value is not nil.
+func (_u *ReputationUpdateOne) SetNillableFaction(v *string) *ReputationUpdateOne {
+	if v != nil {
+		_u.SetFaction(*v)
+	}
+	return _u
+}
+
+// SetStanding sets the "standing" field.
+func (_u *ReputationUpdateOne) SetStanding(v int) *ReputationUpdateOne {
+	_u.mutation.ResetStanding()
+	_u.mutation.SetStanding(v)
+	return _u
+}
+
+// SetNillableStanding sets the "standing" field if the given value is not nil.
+func (_u *ReputationUpdateOne) SetNillableStanding(v *int) *ReputationUpdateOne {
+	if v != nil {
+		_u.SetStanding(*v)
+	}
+	return _u
+}
+
+// AddStanding adds value to the "standing" field.
+func (_u *ReputationUpdateOne) AddStanding(v int) *ReputationUpdateOne {
+	_u.mutation.AddStanding(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReputationUpdateOne) SetUpdatedAt(v time.Time) *ReputationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReputationMutation object of the builder.
+func (_u *ReputationUpdateOne) Mutation() *ReputationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReputationUpdate builder.
+func (_u *ReputationUpdateOne) Where(ps ...predicate.Reputation) *ReputationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReputationUpdateOne) Select(field string, fields ...string) *ReputationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Reputation entity.
+func (_u *ReputationUpdateOne) Save(ctx context.Context) (*Reputation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReputationUpdateOne) SaveX(ctx context.Context) *Reputation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReputationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReputationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReputationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reputation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ReputationUpdateOne) sqlSave(ctx context.Context) (_node *Reputation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reputation.Table, reputation.Columns, sqlgraph.NewFieldSpec(reputation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "Reputation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reputation.FieldID)
+		for _, f := range fields {
+			if !reputation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != reputation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CharacterID(); ok {
+		_spec.SetField(reputation.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharacterID(); ok {
+		_spec.AddField(reputation.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Faction(); ok {
+		_spec.SetField(reputation.FieldFaction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Standing(); ok {
+		_spec.SetField(reputation.FieldStanding, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStanding(); ok {
+		_spec.AddField(reputation.FieldStanding, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reputation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Reputation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reputation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"herbst-server/db/room"
+	"herbst-server/db/schema"
 	"strings"
 
 	"entgo.io/ent"
@@ -29,6 +30,8 @@ type Room struct {
 	IsRootRoom bool `json:"isRootRoom,omitempty"`
 	// Exits holds the value of the "exits" field.
 	Exits map[string]int `json:"exits,omitempty"`
+	// Doors, locks and hidden exits, keyed by exit direction
+	ExitStates map[string]schema.ExitState `json:"exit_states,omitempty"`
 	// Atmosphere holds the value of the "atmosphere" field.
 	Atmosphere room.Atmosphere `json:"atmosphere,omitempty"`
 	// PosX holds the value of the "posX" field.
@@ -43,6 +46,10 @@ type Room struct {
 	Tags []string `json:"tags,omitempty"`
 	// Zone memberships for this room. First entry is the primary zone. Sub-zone membership is just appending the sub-zone ID.
 	ZoneIds []string `json:"zone_ids,omitempty"`
+	// Zone instance this room is a private copy for; nil for the shared world
+	InstanceID *int `json:"instance_id,omitempty"`
+	// Player house this room belongs to; nil for public rooms
+	HouseID *int `json:"house_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoomQuery when eager-loading is set.
 	Edges        RoomEdges `json:"edges"`
@@ -94,11 +101,11 @@ func (*Room) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case room.FieldExits, room.FieldTags, room.FieldZoneIds:
+		case room.FieldExits, room.FieldExitStates, room.FieldTags, room.FieldZoneIds:
 			values[i] = new([]byte)
 		case room.FieldIsStartingRoom, room.FieldIsRootRoom:
 			values[i] = new(sql.NullBool)
-		case room.FieldID, room.FieldPosX, room.FieldPosY, room.FieldPosZ, room.FieldVersion:
+		case room.FieldID, room.FieldPosX, room.FieldPosY, room.FieldPosZ, room.FieldVersion, room.FieldInstanceID, room.FieldHouseID:
 			values[i] = new(sql.NullInt64)
 		case room.FieldName, room.FieldWorldID, room.FieldDescription, room.FieldAtmosphere:
 			values[i] = new(sql.NullString)
@@ -161,6 +168,14 @@ func (_m *Room) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field exits: %w", err)
 				}
 			}
+		case room.FieldExitStates:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exit_states", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ExitStates); err != nil {
+					return fmt.Errorf("unmarshal field exit_states: %w", err)
+				}
+			}
 		case room.FieldAtmosphere:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field atmosphere", values[i])
@@ -207,6 +222,20 @@ func (_m *Room) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field zone_ids: %w", err)
 				}
 			}
+		case room.FieldInstanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_id", values[i])
+			} else if value.Valid {
+				_m.InstanceID = new(int)
+				*_m.InstanceID = int(value.Int64)
+			}
+		case room.FieldHouseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field house_id", values[i])
+			} else if value.Valid {
+				_m.HouseID = new(int)
+				*_m.HouseID = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -276,6 +305,9 @@ func (_m *Room) String() string {
 	builder.WriteString("exits=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Exits))
 	builder.WriteString(", ")
+	builder.WriteString("exit_states=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExitStates))
+	builder.WriteString(", ")
 	builder.WriteString("atmosphere=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Atmosphere))
 	builder.WriteString(", ")
@@ -296,6 +328,16 @@ func (_m *Room) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("zone_ids=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ZoneIds))
+	builder.WriteString(", ")
+	if v := _m.InstanceID; v != nil {
+		builder.WriteString("instance_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HouseID; v != nil {
+		builder.WriteString("house_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -26,6 +26,8 @@ const (
 	FieldIsRootRoom = "is_root_room"
 	// FieldExits holds the string denoting the exits field in the database.
 	FieldExits = "exits"
+	// FieldExitStates holds the string denoting the exit_states field in the database.
+	FieldExitStates = "exit_states"
 	// FieldAtmosphere holds the string denoting the atmosphere field in the database.
 	FieldAtmosphere = "atmosphere"
 	// FieldPosX holds the string denoting the posx field in the database.
@@ -40,6 +42,10 @@ const (
 	FieldTags = "tags"
 	// FieldZoneIds holds the string denoting the zone_ids field in the database.
 	FieldZoneIds = "zone_ids"
+	// FieldInstanceID holds the string denoting the instance_id field in the database.
+	FieldInstanceID = "instance_id"
+	// FieldHouseID holds the string denoting the house_id field in the database.
+	FieldHouseID = "house_id"
 	// EdgeCharacters holds the string denoting the characters edge name in mutations.
 	EdgeCharacters = "characters"
 	// EdgeEquipment holds the string denoting the equipment edge name in mutations.
@@ -78,6 +84,7 @@ var Columns = []string{
 	FieldIsStartingRoom,
 	FieldIsRootRoom,
 	FieldExits,
+	FieldExitStates,
 	FieldAtmosphere,
 	FieldPosX,
 	FieldPosY,
@@ -85,6 +92,8 @@ var Columns = []string{
 	FieldVersion,
 	FieldTags,
 	FieldZoneIds,
+	FieldInstanceID,
+	FieldHouseID,
 }
 
 var (
@@ -205,6 +214,16 @@ func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVersion, opts...).ToFunc()
 }
 
+// ByInstanceID orders the results by the instance_id field.
+func ByInstanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstanceID, opts...).ToFunc()
+}
+
+// ByHouseID orders the results by the house_id field.
+func ByHouseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHouseID, opts...).ToFunc()
+}
+
 // ByCharactersCount orders the results by characters count.
 func ByCharactersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -99,6 +99,16 @@ func Version(v int) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldVersion, v))
 }
 
+// InstanceID applies equality check predicate on the "instance_id" field. It's identical to InstanceIDEQ.
+func InstanceID(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldInstanceID, v))
+}
+
+// HouseID applies equality check predicate on the "house_id" field. It's identical to HouseIDEQ.
+func HouseID(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldHouseID, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldName, v))
@@ -314,6 +324,16 @@ func IsRootRoomNEQ(v bool) predicate.Room {
 	return predicate.Room(sql.FieldNEQ(FieldIsRootRoom, v))
 }
 
+// ExitStatesIsNil applies the IsNil predicate on the "exit_states" field.
+func ExitStatesIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldExitStates))
+}
+
+// ExitStatesNotNil applies the NotNil predicate on the "exit_states" field.
+func ExitStatesNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldExitStates))
+}
+
 // AtmosphereEQ applies the EQ predicate on the "atmosphere" field.
 func AtmosphereEQ(v Atmosphere) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldAtmosphere, v))
@@ -544,6 +564,106 @@ func ZoneIdsNotNil() predicate.Room {
 	return predicate.Room(sql.FieldNotNull(FieldZoneIds))
 }
 
+// InstanceIDEQ applies the EQ predicate on the "instance_id" field.
+func InstanceIDEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldInstanceID, v))
+}
+
+// InstanceIDNEQ applies the NEQ predicate on the "instance_id" field.
+func InstanceIDNEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldInstanceID, v))
+}
+
+// InstanceIDIn applies the In predicate on the "instance_id" field.
+func InstanceIDIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldInstanceID, vs...))
+}
+
+// InstanceIDNotIn applies the NotIn predicate on the "instance_id" field.
+func InstanceIDNotIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldInstanceID, vs...))
+}
+
+// InstanceIDGT applies the GT predicate on the "instance_id" field.
+func InstanceIDGT(v int) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldInstanceID, v))
+}
+
+// InstanceIDGTE applies the GTE predicate on the "instance_id" field.
+func InstanceIDGTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldInstanceID, v))
+}
+
+// InstanceIDLT applies the LT predicate on the "instance_id" field.
+func InstanceIDLT(v int) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldInstanceID, v))
+}
+
+// InstanceIDLTE applies the LTE predicate on the "instance_id" field.
+func InstanceIDLTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldInstanceID, v))
+}
+
+// InstanceIDIsNil applies the IsNil predicate on the "instance_id" field.
+func InstanceIDIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldInstanceID))
+}
+
+// InstanceIDNotNil applies the NotNil predicate on the "instance_id" field.
+func InstanceIDNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldInstanceID))
+}
+
+// HouseIDEQ applies the EQ predicate on the "house_id" field.
+func HouseIDEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldHouseID, v))
+}
+
+// HouseIDNEQ applies the NEQ predicate on the "house_id" field.
+func HouseIDNEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldHouseID, v))
+}
+
+// HouseIDIn applies the In predicate on the "house_id" field.
+func HouseIDIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldHouseID, vs...))
+}
+
+// HouseIDNotIn applies the NotIn predicate on the "house_id" field.
+func HouseIDNotIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldHouseID, vs...))
+}
+
+// HouseIDGT applies the GT predicate on the "house_id" field.
+func HouseIDGT(v int) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldHouseID, v))
+}
+
+// HouseIDGTE applies the GTE predicate on the "house_id" field.
+func HouseIDGTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldHouseID, v))
+}
+
+// HouseIDLT applies the LT predicate on the "house_id" field.
+func HouseIDLT(v int) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldHouseID, v))
+}
+
+// HouseIDLTE applies the LTE predicate on the "house_id" field.
+func HouseIDLTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldHouseID, v))
+}
+
+// HouseIDIsNil applies the IsNil predicate on the "house_id" field.
+func HouseIDIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldHouseID))
+}
+
+// HouseIDNotNil applies the NotNil predicate on the "house_id" field.
+func HouseIDNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldHouseID))
+}
+
 // HasCharacters applies the HasEdge predicate on the "characters" edge.
 func HasCharacters() predicate.Room {
 	return predicate.Room(func(s *sql.Selector) {
@@ -9,6 +9,7 @@ import (
 	"herbst-server/db/character"
 	"herbst-server/db/equipment"
 	"herbst-server/db/room"
+	"herbst-server/db/schema"
 	"herbst-server/db/zone"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -82,6 +83,12 @@ func (_c *RoomCreate) SetExits(v map[string]int) *RoomCreate {
 	return _c
 }
 
+// SetExitStates sets the "exit_states" field.
+func (_c *RoomCreate) SetExitStates(v map[string]schema.ExitState) *RoomCreate {
+	_c.mutation.SetExitStates(v)
+	return _c
+}
+
 // SetAtmosphere sets the "atmosphere" field.
 func (_c *RoomCreate) SetAtmosphere(v room.Atmosphere) *RoomCreate {
 	_c.mutation.SetAtmosphere(v)
@@ -164,6 +171,34 @@ func (_c *RoomCreate) SetZoneIds(v []string) *RoomCreate {
 	return _c
 }
 
+// SetInstanceID sets the "instance_id" field.
+func (_c *RoomCreate) SetInstanceID(v int) *RoomCreate {
+	_c.mutation.SetInstanceID(v)
+	return _c
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (_c *RoomCreate) SetNillableInstanceID(v *int) *RoomCreate {
+	if v != nil {
+		_c.SetInstanceID(*v)
+	}
+	return _c
+}
+
+// SetHouseID sets the "house_id" field.
+func (_c *RoomCreate) SetHouseID(v int) *RoomCreate {
+	_c.mutation.SetHouseID(v)
+	return _c
+}
+
+// SetNillableHouseID sets the "house_id" field if the given value is not nil.
+func (_c *RoomCreate) SetNillableHouseID(v *int) *RoomCreate {
+	if v != nil {
+		_c.SetHouseID(*v)
+	}
+	return _c
+}
+
 // AddCharacterIDs adds the "characters" edge to the Character entity by IDs.
 func (_c *RoomCreate) AddCharacterIDs(ids ...int) *RoomCreate {
 	_c.mutation.AddCharacterIDs(ids...)
@@ -359,6 +394,10 @@ func (_c *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		_spec.SetField(room.FieldExits, field.TypeJSON, value)
 		_node.Exits = value
 	}
+	if value, ok := _c.mutation.ExitStates(); ok {
+		_spec.SetField(room.FieldExitStates, field.TypeJSON, value)
+		_node.ExitStates = value
+	}
 	if value, ok := _c.mutation.Atmosphere(); ok {
 		_spec.SetField(room.FieldAtmosphere, field.TypeEnum, value)
 		_node.Atmosphere = value
@@ -387,6 +426,14 @@ func (_c *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		_spec.SetField(room.FieldZoneIds, field.TypeJSON, value)
 		_node.ZoneIds = value
 	}
+	if value, ok := _c.mutation.InstanceID(); ok {
+		_spec.SetField(room.FieldInstanceID, field.TypeInt, value)
+		_node.InstanceID = &value
+	}
+	if value, ok := _c.mutation.HouseID(); ok {
+		_spec.SetField(room.FieldHouseID, field.TypeInt, value)
+		_node.HouseID = &value
+	}
 	if nodes := _c.mutation.CharactersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -10,6 +10,7 @@ import (
 	"herbst-server/db/equipment"
 	"herbst-server/db/predicate"
 	"herbst-server/db/room"
+	"herbst-server/db/schema"
 	"herbst-server/db/zone"
 
 	"entgo.io/ent/dialect/sql"
@@ -107,6 +108,18 @@ func (_u *RoomUpdate) SetExits(v map[string]int) *RoomUpdate {
 	return _u
 }
 
+// SetExitStates sets the "exit_states" field.
+func (_u *RoomUpdate) SetExitStates(v map[string]schema.ExitState) *RoomUpdate {
+	_u.mutation.SetExitStates(v)
+	return _u
+}
+
+// ClearExitStates clears the value of the "exit_states" field.
+func (_u *RoomUpdate) ClearExitStates() *RoomUpdate {
+	_u.mutation.ClearExitStates()
+	return _u
+}
+
 // SetAtmosphere sets the "atmosphere" field.
 func (_u *RoomUpdate) SetAtmosphere(v room.Atmosphere) *RoomUpdate {
 	_u.mutation.SetAtmosphere(v)
@@ -259,6 +272,60 @@ func (_u *RoomUpdate) ClearZoneIds() *RoomUpdate {
 	return _u
 }
 
+// SetInstanceID sets the "instance_id" field.
+func (_u *RoomUpdate) SetInstanceID(v int) *RoomUpdate {
+	_u.mutation.ResetInstanceID()
+	_u.mutation.SetInstanceID(v)
+	return _u
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (_u *RoomUpdate) SetNillableInstanceID(v *int) *RoomUpdate {
+	if v != nil {
+		_u.SetInstanceID(*v)
+	}
+	return _u
+}
+
+// AddInstanceID adds value to the "instance_id" field.
+func (_u *RoomUpdate) AddInstanceID(v int) *RoomUpdate {
+	_u.mutation.AddInstanceID(v)
+	return _u
+}
+
+// ClearInstanceID clears the value of the "instance_id" field.
+func (_u *RoomUpdate) ClearInstanceID() *RoomUpdate {
+	_u.mutation.ClearInstanceID()
+	return _u
+}
+
+// SetHouseID sets the "house_id" field.
+func (_u *RoomUpdate) SetHouseID(v int) *RoomUpdate {
+	_u.mutation.ResetHouseID()
+	_u.mutation.SetHouseID(v)
+	return _u
+}
+
+// SetNillableHouseID sets the "house_id" field if the given value is not nil.
+func (_u *RoomUpdate) SetNillableHouseID(v *int) *RoomUpdate {
+	if v != nil {
+		_u.SetHouseID(*v)
+	}
+	return _u
+}
+
+// AddHouseID adds value to the "house_id" field.
+func (_u *RoomUpdate) AddHouseID(v int) *RoomUpdate {
+	_u.mutation.AddHouseID(v)
+	return _u
+}
+
+// ClearHouseID clears the value of the "house_id" field.
+func (_u *RoomUpdate) ClearHouseID() *RoomUpdate {
+	_u.mutation.ClearHouseID()
+	return _u
+}
+
 // AddCharacterIDs adds the "characters" edge to the Character entity by IDs.
 func (_u *RoomUpdate) AddCharacterIDs(ids ...int) *RoomUpdate {
 	_u.mutation.AddCharacterIDs(ids...)
@@ -439,6 +506,12 @@ func (_u *RoomUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Exits(); ok {
 		_spec.SetField(room.FieldExits, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.ExitStates(); ok {
+		_spec.SetField(room.FieldExitStates, field.TypeJSON, value)
+	}
+	if _u.mutation.ExitStatesCleared() {
+		_spec.ClearField(room.FieldExitStates, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Atmosphere(); ok {
 		_spec.SetField(room.FieldAtmosphere, field.TypeEnum, value)
 	}
@@ -497,6 +570,24 @@ func (_u *RoomUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ZoneIdsCleared() {
 		_spec.ClearField(room.FieldZoneIds, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.InstanceID(); ok {
+		_spec.SetField(room.FieldInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInstanceID(); ok {
+		_spec.AddField(room.FieldInstanceID, field.TypeInt, value)
+	}
+	if _u.mutation.InstanceIDCleared() {
+		_spec.ClearField(room.FieldInstanceID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HouseID(); ok {
+		_spec.SetField(room.FieldHouseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHouseID(); ok {
+		_spec.AddField(room.FieldHouseID, field.TypeInt, value)
+	}
+	if _u.mutation.HouseIDCleared() {
+		_spec.ClearField(room.FieldHouseID, field.TypeInt)
+	}
 	if _u.mutation.CharactersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -728,6 +819,18 @@ func (_u *RoomUpdateOne) SetExits(v map[string]int) *RoomUpdateOne {
 	return _u
 }
 
+// SetExitStates sets the "exit_states" field.
+func (_u *RoomUpdateOne) SetExitStates(v map[string]schema.ExitState) *RoomUpdateOne {
+	_u.mutation.SetExitStates(v)
+	return _u
+}
+
+// ClearExitStates clears the value of the "exit_states" field.
+func (_u *RoomUpdateOne) ClearExitStates() *RoomUpdateOne {
+	_u.mutation.ClearExitStates()
+	return _u
+}
+
 // SetAtmosphere sets the "atmosphere" field.
 func (_u *RoomUpdateOne) SetAtmosphere(v room.Atmosphere) *RoomUpdateOne {
 	_u.mutation.SetAtmosphere(v)
@@ -880,6 +983,60 @@ func (_u *RoomUpdateOne) ClearZoneIds() *RoomUpdateOne {
 	return _u
 }
 
+// SetInstanceID sets the "instance_id" field.
+func (_u *RoomUpdateOne) SetInstanceID(v int) *RoomUpdateOne {
+	_u.mutation.ResetInstanceID()
+	_u.mutation.SetInstanceID(v)
+	return _u
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (_u *RoomUpdateOne) SetNillableInstanceID(v *int) *RoomUpdateOne {
+	if v != nil {
+		_u.SetInstanceID(*v)
+	}
+	return _u
+}
+
+// AddInstanceID adds value to the "instance_id" field.
+func (_u *RoomUpdateOne) AddInstanceID(v int) *RoomUpdateOne {
+	_u.mutation.AddInstanceID(v)
+	return _u
+}
+
+// ClearInstanceID clears the value of the "instance_id" field.
+func (_u *RoomUpdateOne) ClearInstanceID() *RoomUpdateOne {
+	_u.mutation.ClearInstanceID()
+	return _u
+}
+
+// SetHouseID sets the "house_id" field.
+func (_u *RoomUpdateOne) SetHouseID(v int) *RoomUpdateOne {
+	_u.mutation.ResetHouseID()
+	_u.mutation.SetHouseID(v)
+	return _u
+}
+
+// SetNillableHouseID sets the "house_id" field if the given value is not nil.
+func (_u *RoomUpdateOne) SetNillableHouseID(v *int) *RoomUpdateOne {
+	if v != nil {
+		_u.SetHouseID(*v)
+	}
+	return _u
+}
+
+// AddHouseID adds value to the "house_id" field.
+func (_u *RoomUpdateOne) AddHouseID(v int) *RoomUpdateOne {
+	_u.mutation.AddHouseID(v)
+	return _u
+}
+
+// ClearHouseID clears the value of the "house_id" field.
+func (_u *RoomUpdateOne) ClearHouseID() *RoomUpdateOne {
+	_u.mutation.ClearHouseID()
+	return _u
+}
+
 // AddCharacterIDs adds the "characters" edge to the Character entity by IDs.
 func (_u *RoomUpdateOne) AddCharacterIDs(ids ...int) *RoomUpdateOne {
 	_u.mutation.AddCharacterIDs(ids...)
@@ -1090,6 +1247,12 @@ func (_u *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error) {
 	if value, ok := _u.mutation.Exits(); ok {
 		_spec.SetField(room.FieldExits, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.ExitStates(); ok {
+		_spec.SetField(room.FieldExitStates, field.TypeJSON, value)
+	}
+	if _u.mutation.ExitStatesCleared() {
+		_spec.ClearField(room.FieldExitStates, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Atmosphere(); ok {
 		_spec.SetField(room.FieldAtmosphere, field.TypeEnum, value)
 	}
@@ -1148,6 +1311,24 @@ func (_u *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error) {
 	if _u.mutation.ZoneIdsCleared() {
 		_spec.ClearField(room.FieldZoneIds, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.InstanceID(); ok {
+		_spec.SetField(room.FieldInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInstanceID(); ok {
+		_spec.AddField(room.FieldInstanceID, field.TypeInt, value)
+	}
+	if _u.mutation.InstanceIDCleared() {
+		_spec.ClearField(room.FieldInstanceID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HouseID(); ok {
+		_spec.SetField(room.FieldHouseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHouseID(); ok {
+		_spec.AddField(room.FieldHouseID, field.TypeInt, value)
+	}
+	if _u.mutation.HouseIDCleared() {
+		_spec.ClearField(room.FieldHouseID, field.TypeInt)
+	}
 	if _u.mutation.CharactersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
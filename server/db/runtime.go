@@ -8,8 +8,10 @@ import (
 	"herbst-server/db/achievement"
 	"herbst-server/db/activeeffect"
 	"herbst-server/db/applog"
+	"herbst-server/db/auction"
 	"herbst-server/db/channelconfig"
 	"herbst-server/db/character"
+	"herbst-server/db/characterachievement"
 	"herbst-server/db/characterchannel"
 	"herbst-server/db/characterclasshistory"
 	"herbst-server/db/charactercompetency"
@@ -22,24 +24,34 @@ import (
 	"herbst-server/db/competencylevelthreshold"
 	"herbst-server/db/craftingrecipe"
 	"herbst-server/db/damagelog"
+	"herbst-server/db/deadletter"
 	"herbst-server/db/dialognode"
+	"herbst-server/db/dialogstate"
 	"herbst-server/db/effect"
 	"herbst-server/db/effecthook"
 	"herbst-server/db/equipment"
 	"herbst-server/db/equipmenttemplate"
+	"herbst-server/db/eventcursor"
 	"herbst-server/db/faction"
 	"herbst-server/db/factioncategory"
 	"herbst-server/db/gender"
+	"herbst-server/db/house"
+	"herbst-server/db/mail"
 	"herbst-server/db/npctemplate"
+	"herbst-server/db/outboxevent"
+	"herbst-server/db/party"
+	"herbst-server/db/partyinvite"
 	"herbst-server/db/quest"
 	"herbst-server/db/questprogress"
 	"herbst-server/db/race"
+	"herbst-server/db/reputation"
 	"herbst-server/db/room"
 	"herbst-server/db/schema"
 	"herbst-server/db/shopitem"
 	"herbst-server/db/shoptemplate"
 	"herbst-server/db/skill"
 	"herbst-server/db/socialcommand"
+	"herbst-server/db/stash"
 	"herbst-server/db/systemlog"
 	"herbst-server/db/tag"
 	"herbst-server/db/tellqueue"
@@ -47,6 +59,7 @@ import (
 	"herbst-server/db/user"
 	"herbst-server/db/world"
 	"herbst-server/db/zone"
+	"herbst-server/db/zoneinstance"
 	"time"
 )
 
@@ -156,6 +169,28 @@ func init() {
 	applogDescCreatedAt := applogFields[9].Descriptor()
 	// applog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	applog.DefaultCreatedAt = applogDescCreatedAt.Default.(func() time.Time)
+	auctionFields := schema.Auction{}.Fields()
+	_ = auctionFields
+	// auctionDescBuyout is the schema descriptor for buyout field.
+	auctionDescBuyout := auctionFields[5].Descriptor()
+	// auction.DefaultBuyout holds the default value on creation for the buyout field.
+	auction.DefaultBuyout = auctionDescBuyout.Default.(int)
+	// auctionDescBid is the schema descriptor for bid field.
+	auctionDescBid := auctionFields[6].Descriptor()
+	// auction.DefaultBid holds the default value on creation for the bid field.
+	auction.DefaultBid = auctionDescBid.Default.(int)
+	// auctionDescBidderID is the schema descriptor for bidder_id field.
+	auctionDescBidderID := auctionFields[7].Descriptor()
+	// auction.DefaultBidderID holds the default value on creation for the bidder_id field.
+	auction.DefaultBidderID = auctionDescBidderID.Default.(int)
+	// auctionDescProceeds is the schema descriptor for proceeds field.
+	auctionDescProceeds := auctionFields[9].Descriptor()
+	// auction.DefaultProceeds holds the default value on creation for the proceeds field.
+	auction.DefaultProceeds = auctionDescProceeds.Default.(int)
+	// auctionDescListedAt is the schema descriptor for listed_at field.
+	auctionDescListedAt := auctionFields[10].Descriptor()
+	// auction.DefaultListedAt holds the default value on creation for the listed_at field.
+	auction.DefaultListedAt = auctionDescListedAt.Default.(func() time.Time)
 	channelconfigFields := schema.ChannelConfig{}.Fields()
 	_ = channelconfigFields
 	// channelconfigDescDescription is the schema descriptor for description field.
@@ -284,6 +319,22 @@ func init() {
 	characterDescCharisma := characterFields[36].Descriptor()
 	// character.DefaultCharisma holds the default value on creation for the charisma field.
 	character.DefaultCharisma = characterDescCharisma.Default.(int)
+	characterachievementFields := schema.CharacterAchievement{}.Fields()
+	_ = characterachievementFields
+	// characterachievementDescProgress is the schema descriptor for progress field.
+	characterachievementDescProgress := characterachievementFields[2].Descriptor()
+	// characterachievement.DefaultProgress holds the default value on creation for the progress field.
+	characterachievement.DefaultProgress = characterachievementDescProgress.Default.(int)
+	// characterachievementDescTarget is the schema descriptor for target field.
+	characterachievementDescTarget := characterachievementFields[3].Descriptor()
+	// characterachievement.DefaultTarget holds the default value on creation for the target field.
+	characterachievement.DefaultTarget = characterachievementDescTarget.Default.(int)
+	// characterachievementDescUpdatedAt is the schema descriptor for updated_at field.
+	characterachievementDescUpdatedAt := characterachievementFields[5].Descriptor()
+	// characterachievement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	characterachievement.DefaultUpdatedAt = characterachievementDescUpdatedAt.Default.(func() time.Time)
+	// characterachievement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	characterachievement.UpdateDefaultUpdatedAt = characterachievementDescUpdatedAt.UpdateDefault.(func() time.Time)
 	characterchannelFields := schema.CharacterChannel{}.Fields()
 	_ = characterchannelFields
 	// characterchannelDescChatEnabled is the schema descriptor for chatEnabled field.
@@ -428,6 +479,12 @@ func init() {
 	damagelogDescCreatedAt := damagelogFields[3].Descriptor()
 	// damagelog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	damagelog.DefaultCreatedAt = damagelogDescCreatedAt.Default.(func() time.Time)
+	deadletterFields := schema.DeadLetter{}.Fields()
+	_ = deadletterFields
+	// deadletterDescCreatedAt is the schema descriptor for created_at field.
+	deadletterDescCreatedAt := deadletterFields[7].Descriptor()
+	// deadletter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deadletter.DefaultCreatedAt = deadletterDescCreatedAt.Default.(func() time.Time)
 	dialognodeFields := schema.DialogNode{}.Fields()
 	_ = dialognodeFields
 	// dialognodeDescWorldID is the schema descriptor for world_id field.
@@ -438,6 +495,18 @@ func init() {
 	dialognodeDescIsEntry := dialognodeFields[4].Descriptor()
 	// dialognode.DefaultIsEntry holds the default value on creation for the is_entry field.
 	dialognode.DefaultIsEntry = dialognodeDescIsEntry.Default.(bool)
+	dialogstateFields := schema.DialogState{}.Fields()
+	_ = dialogstateFields
+	// dialogstateDescTimesTalked is the schema descriptor for times_talked field.
+	dialogstateDescTimesTalked := dialogstateFields[4].Descriptor()
+	// dialogstate.DefaultTimesTalked holds the default value on creation for the times_talked field.
+	dialogstate.DefaultTimesTalked = dialogstateDescTimesTalked.Default.(int)
+	// dialogstateDescUpdatedAt is the schema descriptor for updated_at field.
+	dialogstateDescUpdatedAt := dialogstateFields[7].Descriptor()
+	// dialogstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dialogstate.DefaultUpdatedAt = dialogstateDescUpdatedAt.Default.(func() time.Time)
+	// dialogstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dialogstate.UpdateDefaultUpdatedAt = dialogstateDescUpdatedAt.UpdateDefault.(func() time.Time)
 	effectFields := schema.Effect{}.Fields()
 	_ = effectFields
 	// effectDescDescription is the schema descriptor for description field.
@@ -718,6 +787,22 @@ func init() {
 	equipmenttemplateDescIsTwoHanded := equipmenttemplateFields[32].Descriptor()
 	// equipmenttemplate.DefaultIsTwoHanded holds the default value on creation for the is_two_handed field.
 	equipmenttemplate.DefaultIsTwoHanded = equipmenttemplateDescIsTwoHanded.Default.(bool)
+	eventcursorFields := schema.EventCursor{}.Fields()
+	_ = eventcursorFields
+	// eventcursorDescLastEventID is the schema descriptor for last_event_id field.
+	eventcursorDescLastEventID := eventcursorFields[1].Descriptor()
+	// eventcursor.DefaultLastEventID holds the default value on creation for the last_event_id field.
+	eventcursor.DefaultLastEventID = eventcursorDescLastEventID.Default.(int)
+	// eventcursorDescAttempts is the schema descriptor for attempts field.
+	eventcursorDescAttempts := eventcursorFields[2].Descriptor()
+	// eventcursor.DefaultAttempts holds the default value on creation for the attempts field.
+	eventcursor.DefaultAttempts = eventcursorDescAttempts.Default.(int)
+	// eventcursorDescUpdatedAt is the schema descriptor for updated_at field.
+	eventcursorDescUpdatedAt := eventcursorFields[5].Descriptor()
+	// eventcursor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventcursor.DefaultUpdatedAt = eventcursorDescUpdatedAt.Default.(func() time.Time)
+	// eventcursor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventcursor.UpdateDefaultUpdatedAt = eventcursorDescUpdatedAt.UpdateDefault.(func() time.Time)
 	factionFields := schema.Faction{}.Fields()
 	_ = factionFields
 	// factionDescWorldID is the schema descriptor for world_id field.
@@ -748,6 +833,46 @@ func init() {
 	genderDescWorldID := genderFields[5].Descriptor()
 	// gender.DefaultWorldID holds the default value on creation for the world_id field.
 	gender.DefaultWorldID = genderDescWorldID.Default.(string)
+	houseFields := schema.House{}.Fields()
+	_ = houseFields
+	// houseDescRoomCount is the schema descriptor for room_count field.
+	houseDescRoomCount := houseFields[4].Descriptor()
+	// house.DefaultRoomCount holds the default value on creation for the room_count field.
+	house.DefaultRoomCount = houseDescRoomCount.Default.(int)
+	// houseDescOwnerID is the schema descriptor for owner_id field.
+	houseDescOwnerID := houseFields[5].Descriptor()
+	// house.DefaultOwnerID holds the default value on creation for the owner_id field.
+	house.DefaultOwnerID = houseDescOwnerID.Default.(int)
+	// houseDescCreatedAt is the schema descriptor for created_at field.
+	houseDescCreatedAt := houseFields[9].Descriptor()
+	// house.DefaultCreatedAt holds the default value on creation for the created_at field.
+	house.DefaultCreatedAt = houseDescCreatedAt.Default.(func() time.Time)
+	mailFields := schema.Mail{}.Fields()
+	_ = mailFields
+	// mailDescBody is the schema descriptor for body field.
+	mailDescBody := mailFields[4].Descriptor()
+	// mail.DefaultBody holds the default value on creation for the body field.
+	mail.DefaultBody = mailDescBody.Default.(string)
+	// mailDescGold is the schema descriptor for gold field.
+	mailDescGold := mailFields[5].Descriptor()
+	// mail.DefaultGold holds the default value on creation for the gold field.
+	mail.DefaultGold = mailDescGold.Default.(int)
+	// mailDescCod is the schema descriptor for cod field.
+	mailDescCod := mailFields[7].Descriptor()
+	// mail.DefaultCod holds the default value on creation for the cod field.
+	mail.DefaultCod = mailDescCod.Default.(int)
+	// mailDescIsRead is the schema descriptor for is_read field.
+	mailDescIsRead := mailFields[8].Descriptor()
+	// mail.DefaultIsRead holds the default value on creation for the is_read field.
+	mail.DefaultIsRead = mailDescIsRead.Default.(bool)
+	// mailDescReturned is the schema descriptor for returned field.
+	mailDescReturned := mailFields[9].Descriptor()
+	// mail.DefaultReturned holds the default value on creation for the returned field.
+	mail.DefaultReturned = mailDescReturned.Default.(bool)
+	// mailDescSentAt is the schema descriptor for sent_at field.
+	mailDescSentAt := mailFields[10].Descriptor()
+	// mail.DefaultSentAt holds the default value on creation for the sent_at field.
+	mail.DefaultSentAt = mailDescSentAt.Default.(func() time.Time)
 	npctemplateFields := schema.NPCTemplate{}.Fields()
 	_ = npctemplateFields
 	// npctemplateDescWorldID is the schema descriptor for world_id field.
@@ -786,6 +911,24 @@ func init() {
 	npctemplateDescNotifyOnEnter := npctemplateFields[21].Descriptor()
 	// npctemplate.DefaultNotifyOnEnter holds the default value on creation for the notify_on_enter field.
 	npctemplate.DefaultNotifyOnEnter = npctemplateDescNotifyOnEnter.Default.(bool)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[4].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	partyFields := schema.Party{}.Fields()
+	_ = partyFields
+	// partyDescCreatedAt is the schema descriptor for created_at field.
+	partyDescCreatedAt := partyFields[1].Descriptor()
+	// party.DefaultCreatedAt holds the default value on creation for the created_at field.
+	party.DefaultCreatedAt = partyDescCreatedAt.Default.(func() time.Time)
+	partyinviteFields := schema.PartyInvite{}.Fields()
+	_ = partyinviteFields
+	// partyinviteDescCreatedAt is the schema descriptor for created_at field.
+	partyinviteDescCreatedAt := partyinviteFields[3].Descriptor()
+	// partyinvite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	partyinvite.DefaultCreatedAt = partyinviteDescCreatedAt.Default.(func() time.Time)
 	questFields := schema.Quest{}.Fields()
 	_ = questFields
 	// questDescWorldID is the schema descriptor for world_id field.
@@ -820,6 +963,18 @@ func init() {
 	raceDescRequirementTags := raceFields[7].Descriptor()
 	// race.DefaultRequirementTags holds the default value on creation for the requirement_tags field.
 	race.DefaultRequirementTags = raceDescRequirementTags.Default.([]string)
+	reputationFields := schema.Reputation{}.Fields()
+	_ = reputationFields
+	// reputationDescStanding is the schema descriptor for standing field.
+	reputationDescStanding := reputationFields[2].Descriptor()
+	// reputation.DefaultStanding holds the default value on creation for the standing field.
+	reputation.DefaultStanding = reputationDescStanding.Default.(int)
+	// reputationDescUpdatedAt is the schema descriptor for updated_at field.
+	reputationDescUpdatedAt := reputationFields[3].Descriptor()
+	// reputation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reputation.DefaultUpdatedAt = reputationDescUpdatedAt.Default.(func() time.Time)
+	// reputation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reputation.UpdateDefaultUpdatedAt = reputationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	roomFields := schema.Room{}.Fields()
 	_ = roomFields
 	// roomDescWorldID is the schema descriptor for world_id field.
@@ -835,19 +990,19 @@ func init() {
 	// room.DefaultIsRootRoom holds the default value on creation for the isRootRoom field.
 	room.DefaultIsRootRoom = roomDescIsRootRoom.Default.(bool)
 	// roomDescPosX is the schema descriptor for posX field.
-	roomDescPosX := roomFields[7].Descriptor()
+	roomDescPosX := roomFields[8].Descriptor()
 	// room.DefaultPosX holds the default value on creation for the posX field.
 	room.DefaultPosX = roomDescPosX.Default.(int)
 	// roomDescPosY is the schema descriptor for posY field.
-	roomDescPosY := roomFields[8].Descriptor()
+	roomDescPosY := roomFields[9].Descriptor()
 	// room.DefaultPosY holds the default value on creation for the posY field.
 	room.DefaultPosY = roomDescPosY.Default.(int)
 	// roomDescPosZ is the schema descriptor for posZ field.
-	roomDescPosZ := roomFields[9].Descriptor()
+	roomDescPosZ := roomFields[10].Descriptor()
 	// room.DefaultPosZ holds the default value on creation for the posZ field.
 	room.DefaultPosZ = roomDescPosZ.Default.(int)
 	// roomDescVersion is the schema descriptor for version field.
-	roomDescVersion := roomFields[10].Descriptor()
+	roomDescVersion := roomFields[11].Descriptor()
 	// room.DefaultVersion holds the default value on creation for the version field.
 	room.DefaultVersion = roomDescVersion.Default.(int)
 	shopitemFields := schema.ShopItem{}.Fields()
@@ -886,6 +1041,14 @@ func init() {
 	shoptemplateDescIsActive := shoptemplateFields[6].Descriptor()
 	// shoptemplate.DefaultIsActive holds the default value on creation for the is_active field.
 	shoptemplate.DefaultIsActive = shoptemplateDescIsActive.Default.(bool)
+	// shoptemplateDescRestockIntervalSeconds is the schema descriptor for restock_interval_seconds field.
+	shoptemplateDescRestockIntervalSeconds := shoptemplateFields[8].Descriptor()
+	// shoptemplate.DefaultRestockIntervalSeconds holds the default value on creation for the restock_interval_seconds field.
+	shoptemplate.DefaultRestockIntervalSeconds = shoptemplateDescRestockIntervalSeconds.Default.(int)
+	// shoptemplateDescBuybackRatio is the schema descriptor for buyback_ratio field.
+	shoptemplateDescBuybackRatio := shoptemplateFields[9].Descriptor()
+	// shoptemplate.DefaultBuybackRatio holds the default value on creation for the buyback_ratio field.
+	shoptemplate.DefaultBuybackRatio = shoptemplateDescBuybackRatio.Default.(float64)
 	skillFields := schema.Skill{}.Fields()
 	_ = skillFields
 	// skillDescCategory is the schema descriptor for category field.
@@ -914,6 +1077,28 @@ func init() {
 	socialcommandDescIsEmote := socialcommandFields[9].Descriptor()
 	// socialcommand.DefaultIsEmote holds the default value on creation for the isEmote field.
 	socialcommand.DefaultIsEmote = socialcommandDescIsEmote.Default.(bool)
+	stashFields := schema.Stash{}.Fields()
+	_ = stashFields
+	// stashDescCharacterID is the schema descriptor for character_id field.
+	stashDescCharacterID := stashFields[0].Descriptor()
+	// stash.DefaultCharacterID holds the default value on creation for the character_id field.
+	stash.DefaultCharacterID = stashDescCharacterID.Default.(int)
+	// stashDescUserID is the schema descriptor for user_id field.
+	stashDescUserID := stashFields[1].Descriptor()
+	// stash.DefaultUserID holds the default value on creation for the user_id field.
+	stash.DefaultUserID = stashDescUserID.Default.(int)
+	// stashDescWorldID is the schema descriptor for world_id field.
+	stashDescWorldID := stashFields[2].Descriptor()
+	// stash.DefaultWorldID holds the default value on creation for the world_id field.
+	stash.DefaultWorldID = stashDescWorldID.Default.(int)
+	// stashDescGold is the schema descriptor for gold field.
+	stashDescGold := stashFields[3].Descriptor()
+	// stash.DefaultGold holds the default value on creation for the gold field.
+	stash.DefaultGold = stashDescGold.Default.(int)
+	// stashDescVersion is the schema descriptor for version field.
+	stashDescVersion := stashFields[6].Descriptor()
+	// stash.DefaultVersion holds the default value on creation for the version field.
+	stash.DefaultVersion = stashDescVersion.Default.(int)
 	systemlogFields := schema.SystemLog{}.Fields()
 	_ = systemlogFields
 	// systemlogDescTimestamp is the schema descriptor for timestamp field.
@@ -946,8 +1131,16 @@ func init() {
 	triggerDescExamineWeight := triggerFields[3].Descriptor()
 	// trigger.DefaultExamineWeight holds the default value on creation for the examine_weight field.
 	trigger.DefaultExamineWeight = triggerDescExamineWeight.Default.(int)
+	// triggerDescIntervalSecs is the schema descriptor for interval_secs field.
+	triggerDescIntervalSecs := triggerFields[10].Descriptor()
+	// trigger.DefaultIntervalSecs holds the default value on creation for the interval_secs field.
+	trigger.DefaultIntervalSecs = triggerDescIntervalSecs.Default.(int)
+	// triggerDescAffects is the schema descriptor for affects field.
+	triggerDescAffects := triggerFields[11].Descriptor()
+	// trigger.DefaultAffects holds the default value on creation for the affects field.
+	trigger.DefaultAffects = triggerDescAffects.Default.(string)
 	// triggerDescEnabled is the schema descriptor for enabled field.
-	triggerDescEnabled := triggerFields[9].Descriptor()
+	triggerDescEnabled := triggerFields[12].Descriptor()
 	// trigger.DefaultEnabled holds the default value on creation for the enabled field.
 	trigger.DefaultEnabled = triggerDescEnabled.Default.(bool)
 	userFields := schema.User{}.Fields()
@@ -986,4 +1179,14 @@ func init() {
 	zoneDescMinLevel := zoneFields[4].Descriptor()
 	// zone.DefaultMinLevel holds the default value on creation for the min_level field.
 	zone.DefaultMinLevel = zoneDescMinLevel.Default.(int)
+	// zoneDescResetIntervalSecs is the schema descriptor for reset_interval_secs field.
+	zoneDescResetIntervalSecs := zoneFields[9].Descriptor()
+	// zone.DefaultResetIntervalSecs holds the default value on creation for the reset_interval_secs field.
+	zone.DefaultResetIntervalSecs = zoneDescResetIntervalSecs.Default.(int)
+	zoneinstanceFields := schema.ZoneInstance{}.Fields()
+	_ = zoneinstanceFields
+	// zoneinstanceDescCreatedAt is the schema descriptor for created_at field.
+	zoneinstanceDescCreatedAt := zoneinstanceFields[7].Descriptor()
+	// zoneinstance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	zoneinstance.DefaultCreatedAt = zoneinstanceDescCreatedAt.Default.(func() time.Time)
 }
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -26,5 +27,15 @@ func (Achievement) Fields() []ent.Field {
 		field.String("criteria").
 			Optional().
 			Comment("JSON criteria describing how the achievement is earned"),
+		field.String("title").
+			Optional().
+			Comment("Title granted on completion, shown in who and examine"),
+	}
+}
+
+// Edges of the Achievement.
+func (Achievement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("character_achievements", CharacterAchievement.Type),
 	}
 }
\ No newline at end of file
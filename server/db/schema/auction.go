@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Auction holds the schema definition for the Auction entity: one item
+// listed on the auction house. The item is held in escrow — owned by nobody
+// and in no room — while it is listed, and the high bid is held from the
+// bidder's gold until they are outbid or win.
+type Auction struct {
+	ent.Schema
+}
+
+// Fields of the Auction.
+func (Auction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("seller_id").
+			Comment("Character who listed the item"),
+		field.String("seller_name").
+			Comment("Name of seller (denormalized for when seller is deleted)"),
+		field.Int("item_id").
+			Comment("Escrowed equipment for sale"),
+		field.String("item_name").
+			Comment("Name of the item (denormalized for browsing)"),
+		field.Int("start_bid").
+			Comment("Lowest first bid"),
+		field.Int("buyout").
+			Default(0).
+			Comment("Price that ends the auction at once; 0 for none"),
+		field.Int("bid").
+			Default(0).
+			Comment("Current high bid, 0 before the first bid"),
+		field.Int("bidder_id").
+			Default(0).
+			Comment("Character holding the high bid, 0 before the first bid"),
+		field.Enum("status").Values("open", "sold").Default("open"),
+		field.Int("proceeds").
+			Default(0).
+			Comment("Gold owed to the seller after sales tax, once sold"),
+		field.Time("listed_at").
+			Default(time.Now),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the Auction.
+func (Auction) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Auction.
+func (Auction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "expires_at"),
+		index.Fields("seller_id"),
+	}
+}
@@ -107,6 +107,18 @@ func (Character) Fields() []ent.Field {
 		field.JSON("kill_counts", map[string]int{}).
 			Optional().
 			Comment("Anti-grind: kill counts per NPC template ID"),
+		field.JSON("visited_rooms", []int{}).
+			Optional().
+			Comment("IDs of every room the character has entered"),
+		field.JSON("found_exits", []string{}).
+			Optional().
+			Comment("Hidden exits the character has found, as \"<room id>:<direction>\""),
+		field.JSON("crafted_counts", map[string]int{}).
+			Optional().
+			Comment("Successful crafts per recipe name"),
+		field.String("title").
+			Optional().
+			Comment("Achievement title shown after the name in who and examine"),
 	}
 }
 
@@ -141,5 +153,9 @@ func (Character) Edges() []ent.Edge {
 		edge.To("character_skills", CharacterSkill.Type),
 		edge.To("class_history", CharacterClassHistory.Type),
 		edge.To("race_history", CharacterRaceHistory.Type),
+		edge.To("achievements", CharacterAchievement.Type),
+		edge.From("party", Party.Type).
+			Ref("members").
+			Unique(),
 	}
 }
\ No newline at end of file
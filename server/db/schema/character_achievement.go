@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CharacterAchievement holds the schema definition for the CharacterAchievement
+// join entity: how far a character is towards an achievement, and when they
+// earned it.
+type CharacterAchievement struct {
+	ent.Schema
+}
+
+func (CharacterAchievement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("character_id").
+			Comment("FK to character"),
+		field.Int("achievement_id").
+			Comment("FK to achievement"),
+		field.Int("progress").
+			Default(0).
+			Comment("Current value towards the criteria threshold"),
+		field.Int("target").
+			Default(0).
+			Comment("Criteria threshold when progress was last recorded"),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (CharacterAchievement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("character", Character.Type).
+			Ref("achievements").
+			Field("character_id").
+			Unique().
+			Required(),
+		edge.From("achievement", Achievement.Type).
+			Ref("character_achievements").
+			Field("achievement_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (CharacterAchievement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("character_id", "achievement_id").Unique(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeadLetter holds the schema definition for the DeadLetter entity.
+// An event lands here when a durable subscriber has failed it too many
+// times; the subscriber's cursor moves past it so later events still flow.
+type DeadLetter struct {
+	ent.Schema
+}
+
+// Fields of the DeadLetter.
+func (DeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("subscriber").
+			Comment("Name of the subscriber that gave up"),
+		field.Int("event_id").
+			Comment("Outbox event ID"),
+		field.String("event_type"),
+		field.JSON("payload", map[string]interface{}{}),
+		field.Int64("timestamp").
+			Comment("Event timestamp in Unix milliseconds"),
+		field.Int("attempts"),
+		field.String("error").
+			Comment("Error from the final attempt"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the DeadLetter.
+func (DeadLetter) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DeadLetter.
+func (DeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subscriber"),
+	}
+}
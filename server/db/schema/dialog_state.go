@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DialogState holds the schema definition for the DialogState entity: what
+// an NPC template remembers about one character between conversations.
+// Dialog conditions read it as the "dialog" root and dialog effects write
+// its flags and counters.
+type DialogState struct {
+	ent.Schema
+}
+
+// Fields of the DialogState.
+func (DialogState) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("character_id").
+			Comment("Character the NPC remembers"),
+		field.String("npc_template_id").
+			Comment("NPC template doing the remembering"),
+		field.JSON("flags", map[string]bool{}).
+			Optional().
+			Comment("Named on/off facts, e.g. asked_about_brother"),
+		field.JSON("counters", map[string]int{}).
+			Optional().
+			Comment("Named tallies, e.g. times_insulted"),
+		field.Int("times_talked").
+			Default(0).
+			Comment("Conversations started with this NPC"),
+		field.Time("first_met_at").
+			Optional().
+			Nillable(),
+		field.String("last_node_id").
+			Optional().
+			Comment("Last dialog node the character reached"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DialogState.
+func (DialogState) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DialogState.
+func (DialogState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("character_id", "npc_template_id").Unique(),
+	}
+}
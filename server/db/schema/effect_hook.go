@@ -22,10 +22,10 @@ func (EffectHook) Fields() []ent.Field {
 		field.String("name").
 			Comment("Display name, e.g., 'Death Drain — XP from killer'"),
 		field.String("event").
-			Comment("on_death|on_hit_received|on_hit_dealt|on_kill|on_enter_room|on_leave_room|on_equip|on_unequip|on_login|on_effect_start|on_effect_end"),
+			Comment("on_death|on_hit_received|on_hit_dealt|on_kill|on_enter_room|on_leave_room|on_equip|on_unequip|on_login|on_effect_start|on_effect_end|dawn|day|dusk|night"),
 		field.String("target").
 			Default("self").
-			Comment("self|attacker|killer|room|owner — who the effect targets"),
+			Comment("self|attacker|killer|room|room_except_source|owner — who the effect targets"),
 		field.String("condition").
 			Optional().
 			Comment("Optional SPICE condition expression (deferred)"),
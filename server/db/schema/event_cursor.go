@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// EventCursor holds the schema definition for the EventCursor entity.
+// There is one row per durable subscriber, recording how far through the
+// outbox it has got and the state of any retry in progress.
+type EventCursor struct {
+	ent.Schema
+}
+
+// Fields of the EventCursor.
+func (EventCursor) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Comment("Subscriber name"),
+		field.Int("last_event_id").
+			Default(0).
+			Comment("ID of the last outbox event handled"),
+		field.Int("attempts").
+			Default(0).
+			Comment("Failed attempts at the next event"),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When to retry the next event after a failure"),
+		field.String("last_error").
+			Optional().
+			Comment("Error from the most recent failed attempt"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the EventCursor.
+func (EventCursor) Edges() []ent.Edge {
+	return nil
+}
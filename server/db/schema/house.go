@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// House holds the schema definition for the House entity: a plot in a zone
+// that a player can buy with gold. Buying it creates the house's private
+// rooms, tagged with the house's ID, and opens an exit to them from the
+// plot's public entrance room.
+type House struct {
+	ent.Schema
+}
+
+// Fields of the House.
+func (House) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("zone_id").
+			Comment("Zone the plot is in"),
+		field.Int("entrance_room_id").
+			Comment("Public room the house is entered from"),
+		field.String("direction").
+			Comment("Exit from the entrance room into the house"),
+		field.Int("price").
+			Comment("Gold the plot sells for"),
+		field.Int("room_count").
+			Default(1).
+			Comment("Rooms the house is built with when bought"),
+		field.Int("owner_id").
+			Default(0).
+			Comment("Character who owns the house; 0 while the plot is for sale"),
+		field.JSON("room_ids", []int{}).
+			Optional().
+			Comment("House rooms, starting with the one the entrance leads to"),
+		field.JSON("guest_ids", []int{}).
+			Optional().
+			Comment("Characters the owner lets in"),
+		field.JSON("decorations", map[int]bool{}).
+			Optional().
+			Comment("Items placed as decoration -> whether they were immovable before"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("purchased_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the House.
+func (House) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the House.
+func (House) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entrance_room_id", "direction").Unique(),
+		index.Fields("owner_id"),
+	}
+}
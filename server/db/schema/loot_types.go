@@ -0,0 +1,15 @@
+package schema
+
+// LootTable is what an NPC template can drop when it dies.
+type LootTable struct {
+	Drops  []LootDrop     `json:"drops"`            // items rolled independently
+	Rarity map[string]int `json:"rarity,omitempty"` // rarity tier -> weight (default: world loot config)
+}
+
+// LootDrop is one possible item in a loot table.
+type LootDrop struct {
+	Template string `json:"template"`  // equipment template slug
+	Chance   int    `json:"chance"`    // percent chance to drop (default 100)
+	CountMin int    `json:"count_min"` // default 1
+	CountMax int    `json:"count_max"` // default count_min
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Mail holds the schema definition for the Mail entity: a letter between
+// players, optionally carrying gold and items. Attached items are held in
+// escrow — owned by nobody and in no room — until they are collected.
+type Mail struct {
+	ent.Schema
+}
+
+// Fields of the Mail.
+func (Mail) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("sender_id").
+			Comment("Character who sent the mail"),
+		field.String("sender_name").
+			Comment("Name of sender (denormalized for when sender is deleted)"),
+		field.Int("recipient_id").
+			Comment("Character whose inbox the mail is in"),
+		field.String("subject"),
+		field.Text("body").
+			Default(""),
+		field.Int("gold").
+			Default(0).
+			Comment("Gold attached, not yet collected"),
+		field.JSON("item_ids", []int{}).
+			Optional().
+			Comment("Escrowed equipment attached, not yet collected"),
+		field.Int("cod").
+			Default(0).
+			Comment("Cash on delivery: gold the recipient pays the sender to collect the items"),
+		field.Bool("is_read").
+			Default(false),
+		field.Bool("returned").
+			Default(false).
+			Comment("Mail bounced back to its sender; deleted with its attachments when it expires again"),
+		field.Time("sent_at").
+			Default(time.Now),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the Mail.
+func (Mail) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Mail.
+func (Mail) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("recipient_id"),
+		index.Fields("expires_at"),
+	}
+}
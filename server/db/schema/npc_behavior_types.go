@@ -0,0 +1,30 @@
+package schema
+
+// NPCBehavior scripts what an NPC does on its own, beyond roaming.
+type NPCBehavior struct {
+	Faction      string             `json:"faction,omitempty"`        // NPCs sharing a faction are allies
+	Aggro        *NPCAggro          `json:"aggro,omitempty"`          // attack players in the room (nil = never start fights)
+	FleeBelowPct int                `json:"flee_below_pct,omitempty"` // try to flee a fight below this % of max HP (0 = never)
+	AssistAllies bool               `json:"assist_allies,omitempty"`  // join fights allies in the same room are in
+	Schedule     []NPCScheduleEntry `json:"schedule,omitempty"`       // where to be at each time of day; overrides roam_pattern
+	// KillReputation is the standing per faction the player who kills the
+	// NPC gains or loses. When empty, killing an NPC with a faction costs
+	// standing with that faction.
+	KillReputation map[string]int `json:"kill_reputation,omitempty"`
+}
+
+// NPCAggro filters which players an aggressive NPC attacks. Members of the
+// NPC's own faction are always left alone.
+type NPCAggro struct {
+	MinLevel       int      `json:"min_level,omitempty"`       // ignore players below this level
+	MaxLevel       int      `json:"max_level,omitempty"`       // ignore players above this level (0 = no limit)
+	IgnoreFactions []string `json:"ignore_factions,omitempty"` // leave members of these factions alone
+}
+
+// NPCScheduleEntry sends the NPC to a room between two hours of the game
+// day, on its world's clock.
+type NPCScheduleEntry struct {
+	From   int `json:"from"`    // hour 0-23, inclusive
+	To     int `json:"to"`      // hour 0-23, exclusive; wraps past midnight when To <= From
+	RoomID int `json:"room_id"` // room the NPC walks to
+}
@@ -32,7 +32,7 @@ func (NPCTemplate) Fields() []ent.Field {
 			Optional().
 			Comment("FK to Race.id — NPC's race"),
 		field.Enum("disposition").
-			Values("hostile", "friendly", "neutral").
+			Values("hostile", "friendly", "neutral", "shopkeeper").
 			Default("neutral"),
 		field.Int("level").
 			Default(1),
@@ -79,6 +79,12 @@ func (NPCTemplate) Fields() []ent.Field {
 			Optional().
 			Default(false).
 			Comment("If true, emit a chat/notification event when this NPC enters a room."),
+		field.JSON("loot_table", LootTable{}).
+			Optional().
+			Comment("Items this NPC can drop on death; each drop rolls a rarity tier and random affixes"),
+		field.JSON("behavior", NPCBehavior{}).
+			Optional().
+			Comment("Aggro, fleeing, assisting allies and daily schedule for instances of this NPC"),
 	}
 }
 
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// Every event published on the bus is appended here so durable
+// subscribers can consume it at least once, even across restarts.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Comment("Auto-incremented, gives events a total order"),
+		field.String("type").
+			Comment("Event type, e.g. npc_defeated"),
+		field.JSON("payload", map[string]interface{}{}).
+			Comment("Event payload as published"),
+		field.Int64("timestamp").
+			Comment("Event timestamp in Unix milliseconds"),
+		field.Time("created_at").
+			Default(time.Now).
+			Comment("When the event was written to the outbox"),
+	}
+}
+
+// Edges of the OutboxEvent.
+func (OutboxEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("timestamp"),
+		index.Fields("created_at"),
+	}
+}
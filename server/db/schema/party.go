@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Party holds the schema definition for the Party entity: a group of
+// player characters who share XP and kill credit and have their own chat
+// channel.
+type Party struct {
+	ent.Schema
+}
+
+// Fields of the Party.
+func (Party) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("leader_id").
+			Comment("Character ID of the party leader"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Party.
+func (Party) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", Character.Type),
+	}
+}
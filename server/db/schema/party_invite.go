@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PartyInvite holds the schema definition for the PartyInvite entity: an
+// outstanding invitation that the invitee can accept until it expires.
+// Accepting joins the inviter's party, forming one if the inviter is not
+// in a party yet.
+type PartyInvite struct {
+	ent.Schema
+}
+
+// Fields of the PartyInvite.
+func (PartyInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("inviter_id").
+			Comment("Character who sent the invite"),
+		field.Int("invitee_id").
+			Comment("Character who may accept it"),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PartyInvite.
+func (PartyInvite) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PartyInvite.
+func (PartyInvite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invitee_id"),
+		index.Fields("inviter_id"),
+	}
+}
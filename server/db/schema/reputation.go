@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reputation holds the schema definition for the Reputation entity: a
+// character's standing with one faction. Factions are named as in
+// Faction.name and NPC behavior, so a character can have standing with a
+// faction they don't belong to. A missing row means neutral (0).
+type Reputation struct {
+	ent.Schema
+}
+
+// Fields of the Reputation.
+func (Reputation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("character_id"),
+		field.String("faction").
+			Comment("Faction name, matched case-insensitively"),
+		field.Int("standing").
+			Default(0).
+			Comment("-1000 (hostile) to 1000 (exalted)"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Reputation.
+func (Reputation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Reputation.
+func (Reputation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("character_id", "faction").Unique(),
+	}
+}
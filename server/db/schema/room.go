@@ -34,6 +34,9 @@ func (Room) Fields() []ent.Field {
 			Default(false).
 			Comment("Only one room can be root; new characters spawn here"),
 		field.JSON("exits", map[string]int{}),
+		field.JSON("exit_states", map[string]ExitState{}).
+			Optional().
+			Comment("Doors, locks and hidden exits, keyed by exit direction"),
 		field.Enum("atmosphere").
 			Values("air", "water", "wind").
 			Default("air"),
@@ -58,6 +61,14 @@ func (Room) Fields() []ent.Field {
 		field.Strings("zone_ids").
 			Optional().
 			Comment("Zone memberships for this room. First entry is the primary zone. Sub-zone membership is just appending the sub-zone ID."),
+		field.Int("instance_id").
+			Optional().
+			Nillable().
+			Comment("Zone instance this room is a private copy for; nil for the shared world"),
+		field.Int("house_id").
+			Optional().
+			Nillable().
+			Comment("Player house this room belongs to; nil for public rooms"),
 	}
 }
 
@@ -0,0 +1,13 @@
+package schema
+
+// ExitState describes a room exit that is more than an open passage. Exits
+// without a state are always open and visible.
+type ExitState struct {
+	Door            bool   `json:"door,omitempty"`             // can be opened and closed
+	Closed          bool   `json:"closed,omitempty"`           // the door is shut
+	Locked          bool   `json:"locked,omitempty"`           // the door is locked; implies closed
+	Key             string `json:"key,omitempty"`              // equipment template slug of the key that locks and unlocks it
+	Hidden          bool   `json:"hidden,omitempty"`           // not shown or usable until found with search
+	HiddenThreshold int    `json:"hidden_threshold,omitempty"` // examine level a search needs to find it
+	OneWay          bool   `json:"one_way,omitempty"`          // opening or locking it doesn't touch the exit back
+}
@@ -39,6 +39,12 @@ func (ShopTemplate) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("When the shop was last restocked (nil = never)"),
+		field.Int("restock_interval_seconds").
+			Default(3600).
+			Comment("Seconds between restocks; 0 disables automatic restocking"),
+		field.Float("buyback_ratio").
+			Default(0.5).
+			Comment("Fraction of an item's value the shopkeeper pays when buying from players"),
 	}
 }
 
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Stash holds the schema definition for the Stash entity: gold and items
+// banked at a bank. A stash belongs either to one character, or to a user's
+// account in one world and is shared by all their characters there. Stored
+// items are held in escrow — owned by nobody and in no room — until they
+// are withdrawn.
+type Stash struct {
+	ent.Schema
+}
+
+// Fields of the Stash.
+func (Stash) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("character_id").
+			Default(0).
+			Comment("Character the stash belongs to; 0 for an account stash"),
+		field.Int("user_id").
+			Default(0).
+			Comment("User whose account stash this is; 0 for a character stash"),
+		field.Int("world_id").
+			Default(0).
+			Comment("World an account stash is shared in; 0 for a character stash"),
+		field.Int("gold").
+			Default(0),
+		field.JSON("item_ids", []int{}).
+			Optional().
+			Comment("Escrowed equipment in the stash"),
+		field.Int("slots").
+			Comment("How many items the stash holds"),
+		field.Int("version").
+			Default(0).
+			Comment("Bumped on every change so concurrent changes can't overwrite each other"),
+	}
+}
+
+// Edges of the Stash.
+func (Stash) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Stash.
+func (Stash) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("character_id", "user_id", "world_id").Unique(),
+	}
+}
@@ -9,7 +9,9 @@ import (
 // Trigger holds the schema definition for the Trigger entity.
 // Triggers link objects (rooms, equipment) to actions (recipes, effects, dialog nodes).
 // When a player interacts with an object via "use", "touch", or "press",
-// the corresponding trigger fires and executes its target action.
+// the corresponding trigger fires and executes its target action. Room
+// triggers of type enter, leave, say, drop and timer are fired by the server
+// itself and run their effect.
 type Trigger struct {
 	ent.Schema
 }
@@ -23,7 +25,7 @@ func (Trigger) Fields() []ent.Field {
 			Default("1").
 			Comment("World this trigger belongs to (for multi-world support)"),
 		field.String("trigger_type").
-			Comment("use|touch|press|enter|examine - what action triggers this"),
+			Comment("use|touch|press|examine|enter|leave|say|drop|timer - what action triggers this"),
 		field.Int("examine_weight").
 			Optional().
 			Default(0).
@@ -44,6 +46,17 @@ func (Trigger) Fields() []ent.Field {
 		field.String("condition").
 			Optional().
 			Comment("SPICE expression for conditional trigger firing"),
+		field.String("keyword").
+			Optional().
+			Comment("For say triggers, the word or phrase to listen for; for drop triggers, part of the item's name. Empty matches anything."),
+		field.Int("interval_secs").
+			Optional().
+			Default(0).
+			Comment("For timer triggers, how often the trigger fires while players are in the room"),
+		field.String("affects").
+			Optional().
+			Default("self").
+			Comment("self|room|room_except_source - who a server-fired trigger's effect lands on. Timers always affect the room."),
 		field.Bool("enabled").
 			Default(true).
 			Comment("If false, trigger does not fire"),
@@ -33,6 +33,30 @@ func (Zone) Fields() []ent.Field {
 		field.Ints("room_ids").
 			Optional().
 			Comment("Explicit list of room IDs in this zone. Edge Zone.rooms is the joinable view; room_ids is the persistent membership list, including rooms that may have been removed (shown as 'ghost' / red chips in admin)."),
+		field.JSON("resets", []ZoneReset{}).
+			Optional().
+			Comment("Reset script: NPC spawners, item placement, NPC equipment and container locks, run in order on every reset"),
+		field.Int("reset_interval_secs").
+			Default(0).
+			Comment("Seconds between automatic resets (0 = only when reset from the admin API)"),
+		field.Time("last_reset_at").
+			Optional().
+			Nillable(),
+		field.JSON("last_reset_report", ZoneResetReport{}).
+			Optional().
+			Comment("What the last reset did, per command"),
+		field.JSON("weather", ZoneWeather{}).
+			Optional().
+			Comment("Weather table rolled for the zone's outdoor rooms"),
+		field.String("current_weather").
+			Optional().
+			Comment("Weather currently in effect, e.g. rain; empty until first rolled"),
+		field.Time("weather_changed_at").
+			Optional().
+			Nillable(),
+		field.JSON("instance", ZoneInstancing{}).
+			Optional().
+			Comment("Portal and lifetime for private copies; set portal_room_id to make the zone instanced"),
 	}
 }
 
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ZoneInstance holds the schema definition for the ZoneInstance entity: one
+// private copy of an instanced zone, opened by a character for themselves or
+// their party. Its rooms are copies tagged with the instance's ID.
+type ZoneInstance struct {
+	ent.Schema
+}
+
+// Fields of the ZoneInstance.
+func (ZoneInstance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("zone_id").
+			Comment("Instanced zone this is a copy of"),
+		field.Int("owner_id").
+			Comment("Character who opened the copy"),
+		field.Int("party_id").
+			Optional().
+			Nillable().
+			Comment("Party the copy belongs to; nil for a solo copy"),
+		field.JSON("room_map", map[int]int{}).
+			Comment("Zone room ID -> copy room ID"),
+		field.JSON("item_map", map[int]int{}).
+			Optional().
+			Comment("Zone item ID -> copy item ID, so reset scripts can find copied containers"),
+		field.Int("entry_room_id").
+			Comment("Copy room players arrive in"),
+		field.Int("return_room_id").
+			Comment("Room players are sent back to when the copy closes"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("expires_at"),
+		field.Time("last_reset_at").
+			Optional().
+			Nillable(),
+		field.Time("empty_since").
+			Optional().
+			Nillable().
+			Comment("When the last player left; nil while anyone is inside"),
+	}
+}
+
+// Edges of the ZoneInstance.
+func (ZoneInstance) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ZoneInstance.
+func (ZoneInstance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("zone_id", "owner_id"),
+		index.Fields("zone_id", "party_id"),
+	}
+}
@@ -0,0 +1,12 @@
+package schema
+
+// ZoneInstancing makes a zone a template for private copies. Walking through
+// the portal exit puts a player, and later their party, into their own copy
+// of the zone instead of the zone itself.
+type ZoneInstancing struct {
+	PortalRoomID int    `json:"portal_room_id"`          // room outside the zone holding the portal
+	PortalExit   string `json:"portal_exit"`             // exit in the portal room that leads into a copy
+	EntryRoomID  int    `json:"entry_room_id"`           // zone room whose copy players arrive in
+	LifetimeMins int    `json:"lifetime_mins,omitempty"` // a copy is torn down this long after it opens (default 120)
+	IdleMins     int    `json:"idle_mins,omitempty"`     // or once it has been empty this long (default 15)
+}
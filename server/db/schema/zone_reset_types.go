@@ -0,0 +1,35 @@
+package schema
+
+import "time"
+
+// ZoneReset is one command in a zone's reset script. Commands run in order
+// each time the zone resets.
+type ZoneReset struct {
+	Type          string `json:"type"`                      // npc | item | equip | lock
+	NPCTemplateID string `json:"npc_template_id,omitempty"` // npc, equip
+	Item          string `json:"item,omitempty"`            // equipment template slug (item, equip)
+	RoomIDs       []int  `json:"room_ids,omitempty"`        // npc: rooms to keep populated; item: rooms to stock; equip: rooms searched (default: the zone's rooms)
+	ContainerID   int    `json:"container_id,omitempty"`    // item: container to stock instead of a room; lock: container to re-lock
+	Count         int    `json:"count,omitempty"`           // npc: living instances to keep in room_ids; item: copies per room or container (default 1)
+	Max           int    `json:"max,omitempty"`             // npc: cap on living instances of the template in the world (default count)
+}
+
+// ZoneResetReport describes what the last reset of a zone did.
+type ZoneResetReport struct {
+	ZoneID   string            `json:"zone_id"`
+	At       time.Time         `json:"at"`
+	Commands []ZoneResetResult `json:"commands"`
+}
+
+// ZoneResetResult is the outcome of one reset command.
+type ZoneResetResult struct {
+	Index    int    `json:"index"`
+	Type     string `json:"type"`
+	Revived  int    `json:"revived,omitempty"`
+	Spawned  int    `json:"spawned,omitempty"`
+	Placed   int    `json:"placed,omitempty"`
+	Equipped int    `json:"equipped,omitempty"`
+	Locked   bool   `json:"locked,omitempty"`
+	Capped   bool   `json:"capped,omitempty"` // the population cap stopped spawning early
+	Error    string `json:"error,omitempty"`
+}
@@ -0,0 +1,14 @@
+package schema
+
+// ZoneWeather configures how a zone's weather changes. Every change_mins
+// real minutes a new weather is rolled from the weighted patterns.
+type ZoneWeather struct {
+	Patterns   []WeatherChance `json:"patterns"`
+	ChangeMins int             `json:"change_mins,omitempty"` // default 30
+}
+
+// WeatherChance is one weighted entry in a zone's weather table.
+type WeatherChance struct {
+	Weather string `json:"weather"` // clear | cloudy | rain | storm | fog | snow
+	Weight  int    `json:"weight"`
+}
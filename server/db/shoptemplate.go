@@ -32,7 +32,11 @@ type ShopTemplate struct {
 	// If false, shop is closed but retains inventory
 	IsActive bool `json:"is_active,omitempty"`
 	// When the shop was last restocked (nil = never)
-	LastRestocked           *time.Time `json:"last_restocked,omitempty"`
+	LastRestocked *time.Time `json:"last_restocked,omitempty"`
+	// Seconds between restocks; 0 disables automatic restocking
+	RestockIntervalSeconds int `json:"restock_interval_seconds,omitempty"`
+	// Fraction of an item's value the shopkeeper pays when buying from players
+	BuybackRatio            float64 `json:"buyback_ratio,omitempty"`
 	character_shop_template *int
 	selectValues            sql.SelectValues
 }
@@ -44,7 +48,9 @@ func (*ShopTemplate) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case shoptemplate.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case shoptemplate.FieldID, shoptemplate.FieldCurrencyItemType, shoptemplate.FieldMaxInventory, shoptemplate.FieldGoldReserves:
+		case shoptemplate.FieldBuybackRatio:
+			values[i] = new(sql.NullFloat64)
+		case shoptemplate.FieldID, shoptemplate.FieldCurrencyItemType, shoptemplate.FieldMaxInventory, shoptemplate.FieldGoldReserves, shoptemplate.FieldRestockIntervalSeconds:
 			values[i] = new(sql.NullInt64)
 		case shoptemplate.FieldName, shoptemplate.FieldWorldID, shoptemplate.FieldNpcTemplateID:
 			values[i] = new(sql.NullString)
@@ -122,6 +128,18 @@ func (_m *ShopTemplate) assignValues(columns []string, values []any) error {
 				_m.LastRestocked = new(time.Time)
 				*_m.LastRestocked = value.Time
 			}
+		case shoptemplate.FieldRestockIntervalSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field restock_interval_seconds", values[i])
+			} else if value.Valid {
+				_m.RestockIntervalSeconds = int(value.Int64)
+			}
+		case shoptemplate.FieldBuybackRatio:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field buyback_ratio", values[i])
+			} else if value.Valid {
+				_m.BuybackRatio = value.Float64
+			}
 		case shoptemplate.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field character_shop_template", value)
@@ -190,6 +208,12 @@ func (_m *ShopTemplate) String() string {
 		builder.WriteString("last_restocked=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("restock_interval_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestockIntervalSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("buyback_ratio=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BuybackRatio))
 	builder.WriteByte(')')
 	return builder.String()
 }
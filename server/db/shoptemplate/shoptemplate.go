@@ -27,6 +27,10 @@ const (
 	FieldIsActive = "is_active"
 	// FieldLastRestocked holds the string denoting the last_restocked field in the database.
 	FieldLastRestocked = "last_restocked"
+	// FieldRestockIntervalSeconds holds the string denoting the restock_interval_seconds field in the database.
+	FieldRestockIntervalSeconds = "restock_interval_seconds"
+	// FieldBuybackRatio holds the string denoting the buyback_ratio field in the database.
+	FieldBuybackRatio = "buyback_ratio"
 	// Table holds the table name of the shoptemplate in the database.
 	Table = "shop_templates"
 )
@@ -42,6 +46,8 @@ var Columns = []string{
 	FieldGoldReserves,
 	FieldIsActive,
 	FieldLastRestocked,
+	FieldRestockIntervalSeconds,
+	FieldBuybackRatio,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "shop_templates"
@@ -74,6 +80,10 @@ var (
 	DefaultGoldReserves int
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultRestockIntervalSeconds holds the default value on creation for the "restock_interval_seconds" field.
+	DefaultRestockIntervalSeconds int
+	// DefaultBuybackRatio holds the default value on creation for the "buyback_ratio" field.
+	DefaultBuybackRatio float64
 )
 
 // OrderOption defines the ordering options for the ShopTemplate queries.
@@ -123,3 +133,13 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 func ByLastRestocked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastRestocked, opts...).ToFunc()
 }
+
+// ByRestockIntervalSeconds orders the results by the restock_interval_seconds field.
+func ByRestockIntervalSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestockIntervalSeconds, opts...).ToFunc()
+}
+
+// ByBuybackRatio orders the results by the buyback_ratio field.
+func ByBuybackRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuybackRatio, opts...).ToFunc()
+}
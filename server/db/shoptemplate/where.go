@@ -94,6 +94,16 @@ func LastRestocked(v time.Time) predicate.ShopTemplate {
 	return predicate.ShopTemplate(sql.FieldEQ(FieldLastRestocked, v))
 }
 
+// RestockIntervalSeconds applies equality check predicate on the "restock_interval_seconds" field. It's identical to RestockIntervalSecondsEQ.
+func RestockIntervalSeconds(v int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldEQ(FieldRestockIntervalSeconds, v))
+}
+
+// BuybackRatio applies equality check predicate on the "buyback_ratio" field. It's identical to BuybackRatioEQ.
+func BuybackRatio(v float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldEQ(FieldBuybackRatio, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.ShopTemplate {
 	return predicate.ShopTemplate(sql.FieldEQ(FieldName, v))
@@ -489,6 +499,86 @@ func LastRestockedNotNil() predicate.ShopTemplate {
 	return predicate.ShopTemplate(sql.FieldNotNull(FieldLastRestocked))
 }
 
+// RestockIntervalSecondsEQ applies the EQ predicate on the "restock_interval_seconds" field.
+func RestockIntervalSecondsEQ(v int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldEQ(FieldRestockIntervalSeconds, v))
+}
+
+// RestockIntervalSecondsNEQ applies the NEQ predicate on the "restock_interval_seconds" field.
+func RestockIntervalSecondsNEQ(v int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldNEQ(FieldRestockIntervalSeconds, v))
+}
+
+// RestockIntervalSecondsIn applies the In predicate on the "restock_interval_seconds" field.
+func RestockIntervalSecondsIn(vs ...int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldIn(FieldRestockIntervalSeconds, vs...))
+}
+
+// RestockIntervalSecondsNotIn applies the NotIn predicate on the "restock_interval_seconds" field.
+func RestockIntervalSecondsNotIn(vs ...int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldNotIn(FieldRestockIntervalSeconds, vs...))
+}
+
+// RestockIntervalSecondsGT applies the GT predicate on the "restock_interval_seconds" field.
+func RestockIntervalSecondsGT(v int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldGT(FieldRestockIntervalSeconds, v))
+}
+
+// RestockIntervalSecondsGTE applies the GTE predicate on the "restock_interval_seconds" field.
+func RestockIntervalSecondsGTE(v int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldGTE(FieldRestockIntervalSeconds, v))
+}
+
+// RestockIntervalSecondsLT applies the LT predicate on the "restock_interval_seconds" field.
+func RestockIntervalSecondsLT(v int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldLT(FieldRestockIntervalSeconds, v))
+}
+
+// RestockIntervalSecondsLTE applies the LTE predicate on the "restock_interval_seconds" field.
+func RestockIntervalSecondsLTE(v int) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldLTE(FieldRestockIntervalSeconds, v))
+}
+
+// BuybackRatioEQ applies the EQ predicate on the "buyback_ratio" field.
+func BuybackRatioEQ(v float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldEQ(FieldBuybackRatio, v))
+}
+
+// BuybackRatioNEQ applies the NEQ predicate on the "buyback_ratio" field.
+func BuybackRatioNEQ(v float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldNEQ(FieldBuybackRatio, v))
+}
+
+// BuybackRatioIn applies the In predicate on the "buyback_ratio" field.
+func BuybackRatioIn(vs ...float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldIn(FieldBuybackRatio, vs...))
+}
+
+// BuybackRatioNotIn applies the NotIn predicate on the "buyback_ratio" field.
+func BuybackRatioNotIn(vs ...float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldNotIn(FieldBuybackRatio, vs...))
+}
+
+// BuybackRatioGT applies the GT predicate on the "buyback_ratio" field.
+func BuybackRatioGT(v float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldGT(FieldBuybackRatio, v))
+}
+
+// BuybackRatioGTE applies the GTE predicate on the "buyback_ratio" field.
+func BuybackRatioGTE(v float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldGTE(FieldBuybackRatio, v))
+}
+
+// BuybackRatioLT applies the LT predicate on the "buyback_ratio" field.
+func BuybackRatioLT(v float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldLT(FieldBuybackRatio, v))
+}
+
+// BuybackRatioLTE applies the LTE predicate on the "buyback_ratio" field.
+func BuybackRatioLTE(v float64) predicate.ShopTemplate {
+	return predicate.ShopTemplate(sql.FieldLTE(FieldBuybackRatio, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ShopTemplate) predicate.ShopTemplate {
 	return predicate.ShopTemplate(sql.AndPredicates(predicates...))
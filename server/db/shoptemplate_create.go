@@ -124,6 +124,34 @@ func (_c *ShopTemplateCreate) SetNillableLastRestocked(v *time.Time) *ShopTempla
 	return _c
 }
 
+// SetRestockIntervalSeconds sets the "restock_interval_seconds" field.
+func (_c *ShopTemplateCreate) SetRestockIntervalSeconds(v int) *ShopTemplateCreate {
+	_c.mutation.SetRestockIntervalSeconds(v)
+	return _c
+}
+
+// SetNillableRestockIntervalSeconds sets the "restock_interval_seconds" field if the given value is not nil.
+func (_c *ShopTemplateCreate) SetNillableRestockIntervalSeconds(v *int) *ShopTemplateCreate {
+	if v != nil {
+		_c.SetRestockIntervalSeconds(*v)
+	}
+	return _c
+}
+
+// SetBuybackRatio sets the "buyback_ratio" field.
+func (_c *ShopTemplateCreate) SetBuybackRatio(v float64) *ShopTemplateCreate {
+	_c.mutation.SetBuybackRatio(v)
+	return _c
+}
+
+// SetNillableBuybackRatio sets the "buyback_ratio" field if the given value is not nil.
+func (_c *ShopTemplateCreate) SetNillableBuybackRatio(v *float64) *ShopTemplateCreate {
+	if v != nil {
+		_c.SetBuybackRatio(*v)
+	}
+	return _c
+}
+
 // Mutation returns the ShopTemplateMutation object of the builder.
 func (_c *ShopTemplateCreate) Mutation() *ShopTemplateMutation {
 	return _c.mutation
@@ -175,6 +203,14 @@ func (_c *ShopTemplateCreate) defaults() {
 		v := shoptemplate.DefaultIsActive
 		_c.mutation.SetIsActive(v)
 	}
+	if _, ok := _c.mutation.RestockIntervalSeconds(); !ok {
+		v := shoptemplate.DefaultRestockIntervalSeconds
+		_c.mutation.SetRestockIntervalSeconds(v)
+	}
+	if _, ok := _c.mutation.BuybackRatio(); !ok {
+		v := shoptemplate.DefaultBuybackRatio
+		_c.mutation.SetBuybackRatio(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -194,6 +230,12 @@ func (_c *ShopTemplateCreate) check() error {
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`db: missing required field "ShopTemplate.is_active"`)}
 	}
+	if _, ok := _c.mutation.RestockIntervalSeconds(); !ok {
+		return &ValidationError{Name: "restock_interval_seconds", err: errors.New(`db: missing required field "ShopTemplate.restock_interval_seconds"`)}
+	}
+	if _, ok := _c.mutation.BuybackRatio(); !ok {
+		return &ValidationError{Name: "buyback_ratio", err: errors.New(`db: missing required field "ShopTemplate.buyback_ratio"`)}
+	}
 	return nil
 }
 
@@ -252,6 +294,14 @@ func (_c *ShopTemplateCreate) createSpec() (*ShopTemplate, *sqlgraph.CreateSpec)
 		_spec.SetField(shoptemplate.FieldLastRestocked, field.TypeTime, value)
 		_node.LastRestocked = &value
 	}
+	if value, ok := _c.mutation.RestockIntervalSeconds(); ok {
+		_spec.SetField(shoptemplate.FieldRestockIntervalSeconds, field.TypeInt, value)
+		_node.RestockIntervalSeconds = value
+	}
+	if value, ok := _c.mutation.BuybackRatio(); ok {
+		_spec.SetField(shoptemplate.FieldBuybackRatio, field.TypeFloat64, value)
+		_node.BuybackRatio = value
+	}
 	return _node, _spec
 }
 
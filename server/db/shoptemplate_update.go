@@ -179,6 +179,48 @@ func (_u *ShopTemplateUpdate) ClearLastRestocked() *ShopTemplateUpdate {
 	return _u
 }
 
+// SetRestockIntervalSeconds sets the "restock_interval_seconds" field.
+func (_u *ShopTemplateUpdate) SetRestockIntervalSeconds(v int) *ShopTemplateUpdate {
+	_u.mutation.ResetRestockIntervalSeconds()
+	_u.mutation.SetRestockIntervalSeconds(v)
+	return _u
+}
+
+// SetNillableRestockIntervalSeconds sets the "restock_interval_seconds" field if the given value is not nil.
+func (_u *ShopTemplateUpdate) SetNillableRestockIntervalSeconds(v *int) *ShopTemplateUpdate {
+	if v != nil {
+		_u.SetRestockIntervalSeconds(*v)
+	}
+	return _u
+}
+
+// AddRestockIntervalSeconds adds value to the "restock_interval_seconds" field.
+func (_u *ShopTemplateUpdate) AddRestockIntervalSeconds(v int) *ShopTemplateUpdate {
+	_u.mutation.AddRestockIntervalSeconds(v)
+	return _u
+}
+
+// SetBuybackRatio sets the "buyback_ratio" field.
+func (_u *ShopTemplateUpdate) SetBuybackRatio(v float64) *ShopTemplateUpdate {
+	_u.mutation.ResetBuybackRatio()
+	_u.mutation.SetBuybackRatio(v)
+	return _u
+}
+
+// SetNillableBuybackRatio sets the "buyback_ratio" field if the given value is not nil.
+func (_u *ShopTemplateUpdate) SetNillableBuybackRatio(v *float64) *ShopTemplateUpdate {
+	if v != nil {
+		_u.SetBuybackRatio(*v)
+	}
+	return _u
+}
+
+// AddBuybackRatio adds value to the "buyback_ratio" field.
+func (_u *ShopTemplateUpdate) AddBuybackRatio(v float64) *ShopTemplateUpdate {
+	_u.mutation.AddBuybackRatio(v)
+	return _u
+}
+
 // Mutation returns the ShopTemplateMutation object of the builder.
 func (_u *ShopTemplateUpdate) Mutation() *ShopTemplateMutation {
 	return _u.mutation
@@ -262,6 +304,18 @@ func (_u *ShopTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.LastRestockedCleared() {
 		_spec.ClearField(shoptemplate.FieldLastRestocked, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RestockIntervalSeconds(); ok {
+		_spec.SetField(shoptemplate.FieldRestockIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRestockIntervalSeconds(); ok {
+		_spec.AddField(shoptemplate.FieldRestockIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BuybackRatio(); ok {
+		_spec.SetField(shoptemplate.FieldBuybackRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBuybackRatio(); ok {
+		_spec.AddField(shoptemplate.FieldBuybackRatio, field.TypeFloat64, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{shoptemplate.Label}
@@ -433,6 +487,48 @@ func (_u *ShopTemplateUpdateOne) ClearLastRestocked() *ShopTemplateUpdateOne {
 	return _u
 }
 
+// SetRestockIntervalSeconds sets the "restock_interval_seconds" field.
+func (_u *ShopTemplateUpdateOne) SetRestockIntervalSeconds(v int) *ShopTemplateUpdateOne {
+	_u.mutation.ResetRestockIntervalSeconds()
+	_u.mutation.SetRestockIntervalSeconds(v)
+	return _u
+}
+
+// SetNillableRestockIntervalSeconds sets the "restock_interval_seconds" field if the given value is not nil.
+func (_u *ShopTemplateUpdateOne) SetNillableRestockIntervalSeconds(v *int) *ShopTemplateUpdateOne {
+	if v != nil {
+		_u.SetRestockIntervalSeconds(*v)
+	}
+	return _u
+}
+
+// AddRestockIntervalSeconds adds value to the "restock_interval_seconds" field.
+func (_u *ShopTemplateUpdateOne) AddRestockIntervalSeconds(v int) *ShopTemplateUpdateOne {
+	_u.mutation.AddRestockIntervalSeconds(v)
+	return _u
+}
+
+// SetBuybackRatio sets the "buyback_ratio" field.
+func (_u *ShopTemplateUpdateOne) SetBuybackRatio(v float64) *ShopTemplateUpdateOne {
+	_u.mutation.ResetBuybackRatio()
+	_u.mutation.SetBuybackRatio(v)
+	return _u
+}
+
+// SetNillableBuybackRatio sets the "buyback_ratio" field if the given value is not nil.
+func (_u *ShopTemplateUpdateOne) SetNillableBuybackRatio(v *float64) *ShopTemplateUpdateOne {
+	if v != nil {
+		_u.SetBuybackRatio(*v)
+	}
+	return _u
+}
+
+// AddBuybackRatio adds value to the "buyback_ratio" field.
+func (_u *ShopTemplateUpdateOne) AddBuybackRatio(v float64) *ShopTemplateUpdateOne {
+	_u.mutation.AddBuybackRatio(v)
+	return _u
+}
+
 // Mutation returns the ShopTemplateMutation object of the builder.
 func (_u *ShopTemplateUpdateOne) Mutation() *ShopTemplateMutation {
 	return _u.mutation
@@ -546,6 +642,18 @@ func (_u *ShopTemplateUpdateOne) sqlSave(ctx context.Context) (_node *ShopTempla
 	if _u.mutation.LastRestockedCleared() {
 		_spec.ClearField(shoptemplate.FieldLastRestocked, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RestockIntervalSeconds(); ok {
+		_spec.SetField(shoptemplate.FieldRestockIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRestockIntervalSeconds(); ok {
+		_spec.AddField(shoptemplate.FieldRestockIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BuybackRatio(); ok {
+		_spec.SetField(shoptemplate.FieldBuybackRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBuybackRatio(); ok {
+		_spec.AddField(shoptemplate.FieldBuybackRatio, field.TypeFloat64, value)
+	}
 	_node = &ShopTemplate{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"herbst-server/db/stash"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Stash is the model entity for the Stash schema.
+type Stash struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Character the stash belongs to; 0 for an account stash
+	CharacterID int `json:"character_id,omitempty"`
+	// User whose account stash this is; 0 for a character stash
+	UserID int `json:"user_id,omitempty"`
+	// World an account stash is shared in; 0 for a character stash
+	WorldID int `json:"world_id,omitempty"`
+	// Gold holds the value of the "gold" field.
+	Gold int `json:"gold,omitempty"`
+	// Escrowed equipment in the stash
+	ItemIds []int `json:"item_ids,omitempty"`
+	// How many items the stash holds
+	Slots int `json:"slots,omitempty"`
+	// Bumped on every change so concurrent changes can't overwrite each other
+	Version      int `json:"version,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Stash) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stash.FieldItemIds:
+			values[i] = new([]byte)
+		case stash.FieldID, stash.FieldCharacterID, stash.FieldUserID, stash.FieldWorldID, stash.FieldGold, stash.FieldSlots, stash.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Stash fields.
+func (_m *Stash) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stash.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case stash.FieldCharacterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field character_id", values[i])
+			} else if value.Valid {
+				_m.CharacterID = int(value.Int64)
+			}
+		case stash.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case stash.FieldWorldID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field world_id", values[i])
+			} else if value.Valid {
+				_m.WorldID = int(value.Int64)
+			}
+		case stash.FieldGold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gold", values[i])
+			} else if value.Valid {
+				_m.Gold = int(value.Int64)
+			}
+		case stash.FieldItemIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field item_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ItemIds); err != nil {
+					return fmt.Errorf("unmarshal field item_ids: %w", err)
+				}
+			}
+		case stash.FieldSlots:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slots", values[i])
+			} else if value.Valid {
+				_m.Slots = int(value.Int64)
+			}
+		case stash.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Stash.
+// This includes values selected through modifiers, order, etc.
+func (_m *Stash) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Stash.
+// Note that you need to call Stash.Unwrap() before calling this method if this Stash
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Stash) Update() *StashUpdateOne {
+	return NewStashClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Stash entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Stash) Unwrap() *Stash {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: Stash is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Stash) String() string {
+	var builder strings.Builder
+	builder.WriteString("Stash(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("character_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CharacterID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("world_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorldID))
+	builder.WriteString(", ")
+	builder.WriteString("gold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Gold))
+	builder.WriteString(", ")
+	builder.WriteString("item_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemIds))
+	builder.WriteString(", ")
+	builder.WriteString("slots=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Slots))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Stashes is a parsable slice of Stash.
+type Stashes []*Stash
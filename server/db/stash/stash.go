@@ -0,0 +1,103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stash
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the stash type in the database.
+	Label = "stash"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCharacterID holds the string denoting the character_id field in the database.
+	FieldCharacterID = "character_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldWorldID holds the string denoting the world_id field in the database.
+	FieldWorldID = "world_id"
+	// FieldGold holds the string denoting the gold field in the database.
+	FieldGold = "gold"
+	// FieldItemIds holds the string denoting the item_ids field in the database.
+	FieldItemIds = "item_ids"
+	// FieldSlots holds the string denoting the slots field in the database.
+	FieldSlots = "slots"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// Table holds the table name of the stash in the database.
+	Table = "stashes"
+)
+
+// Columns holds all SQL columns for stash fields.
+var Columns = []string{
+	FieldID,
+	FieldCharacterID,
+	FieldUserID,
+	FieldWorldID,
+	FieldGold,
+	FieldItemIds,
+	FieldSlots,
+	FieldVersion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCharacterID holds the default value on creation for the "character_id" field.
+	DefaultCharacterID int
+	// DefaultUserID holds the default value on creation for the "user_id" field.
+	DefaultUserID int
+	// DefaultWorldID holds the default value on creation for the "world_id" field.
+	DefaultWorldID int
+	// DefaultGold holds the default value on creation for the "gold" field.
+	DefaultGold int
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+)
+
+// OrderOption defines the ordering options for the Stash queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCharacterID orders the results by the character_id field.
+func ByCharacterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharacterID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByWorldID orders the results by the world_id field.
+func ByWorldID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorldID, opts...).ToFunc()
+}
+
+// ByGold orders the results by the gold field.
+func ByGold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGold, opts...).ToFunc()
+}
+
+// BySlots orders the results by the slots field.
+func BySlots(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlots, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stash
+
+import (
+	"herbst-server/db/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Stash {
+	return predicate.Stash(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Stash {
+	return predicate.Stash(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Stash {
+	return predicate.Stash(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Stash {
+	return predicate.Stash(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Stash {
+	return predicate.Stash(sql.FieldLTE(FieldID, id))
+}
+
+// CharacterID applies equality check predicate on the "character_id" field. It's identical to CharacterIDEQ.
+func CharacterID(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldCharacterID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldUserID, v))
+}
+
+// WorldID applies equality check predicate on the "world_id" field. It's identical to WorldIDEQ.
+func WorldID(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldWorldID, v))
+}
+
+// Gold applies equality check predicate on the "gold" field. It's identical to GoldEQ.
+func Gold(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldGold, v))
+}
+
+// Slots applies equality check predicate on the "slots" field. It's identical to SlotsEQ.
+func Slots(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldSlots, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldVersion, v))
+}
+
+// CharacterIDEQ applies the EQ predicate on the "character_id" field.
+func CharacterIDEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldCharacterID, v))
+}
+
+// CharacterIDNEQ applies the NEQ predicate on the "character_id" field.
+func CharacterIDNEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldNEQ(FieldCharacterID, v))
+}
+
+// CharacterIDIn applies the In predicate on the "character_id" field.
+func CharacterIDIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldIn(FieldCharacterID, vs...))
+}
+
+// CharacterIDNotIn applies the NotIn predicate on the "character_id" field.
+func CharacterIDNotIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldNotIn(FieldCharacterID, vs...))
+}
+
+// CharacterIDGT applies the GT predicate on the "character_id" field.
+func CharacterIDGT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGT(FieldCharacterID, v))
+}
+
+// CharacterIDGTE applies the GTE predicate on the "character_id" field.
+func CharacterIDGTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGTE(FieldCharacterID, v))
+}
+
+// CharacterIDLT applies the LT predicate on the "character_id" field.
+func CharacterIDLT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLT(FieldCharacterID, v))
+}
+
+// CharacterIDLTE applies the LTE predicate on the "character_id" field.
+func CharacterIDLTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLTE(FieldCharacterID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLTE(FieldUserID, v))
+}
+
+// WorldIDEQ applies the EQ predicate on the "world_id" field.
+func WorldIDEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldWorldID, v))
+}
+
+// WorldIDNEQ applies the NEQ predicate on the "world_id" field.
+func WorldIDNEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldNEQ(FieldWorldID, v))
+}
+
+// WorldIDIn applies the In predicate on the "world_id" field.
+func WorldIDIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldIn(FieldWorldID, vs...))
+}
+
+// WorldIDNotIn applies the NotIn predicate on the "world_id" field.
+func WorldIDNotIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldNotIn(FieldWorldID, vs...))
+}
+
+// WorldIDGT applies the GT predicate on the "world_id" field.
+func WorldIDGT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGT(FieldWorldID, v))
+}
+
+// WorldIDGTE applies the GTE predicate on the "world_id" field.
+func WorldIDGTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGTE(FieldWorldID, v))
+}
+
+// WorldIDLT applies the LT predicate on the "world_id" field.
+func WorldIDLT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLT(FieldWorldID, v))
+}
+
+// WorldIDLTE applies the LTE predicate on the "world_id" field.
+func WorldIDLTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLTE(FieldWorldID, v))
+}
+
+// GoldEQ applies the EQ predicate on the "gold" field.
+func GoldEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldGold, v))
+}
+
+// GoldNEQ applies the NEQ predicate on the "gold" field.
+func GoldNEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldNEQ(FieldGold, v))
+}
+
+// GoldIn applies the In predicate on the "gold" field.
+func GoldIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldIn(FieldGold, vs...))
+}
+
+// GoldNotIn applies the NotIn predicate on the "gold" field.
+func GoldNotIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldNotIn(FieldGold, vs...))
+}
+
+// GoldGT applies the GT predicate on the "gold" field.
+func GoldGT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGT(FieldGold, v))
+}
+
+// GoldGTE applies the GTE predicate on the "gold" field.
+func GoldGTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGTE(FieldGold, v))
+}
+
+// GoldLT applies the LT predicate on the "gold" field.
+func GoldLT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLT(FieldGold, v))
+}
+
+// GoldLTE applies the LTE predicate on the "gold" field.
+func GoldLTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLTE(FieldGold, v))
+}
+
+// ItemIdsIsNil applies the IsNil predicate on the "item_ids" field.
+func ItemIdsIsNil() predicate.Stash {
+	return predicate.Stash(sql.FieldIsNull(FieldItemIds))
+}
+
+// ItemIdsNotNil applies the NotNil predicate on the "item_ids" field.
+func ItemIdsNotNil() predicate.Stash {
+	return predicate.Stash(sql.FieldNotNull(FieldItemIds))
+}
+
+// SlotsEQ applies the EQ predicate on the "slots" field.
+func SlotsEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldSlots, v))
+}
+
+// SlotsNEQ applies the NEQ predicate on the "slots" field.
+func SlotsNEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldNEQ(FieldSlots, v))
+}
+
+// SlotsIn applies the In predicate on the "slots" field.
+func SlotsIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldIn(FieldSlots, vs...))
+}
+
+// SlotsNotIn applies the NotIn predicate on the "slots" field.
+func SlotsNotIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldNotIn(FieldSlots, vs...))
+}
+
+// SlotsGT applies the GT predicate on the "slots" field.
+func SlotsGT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGT(FieldSlots, v))
+}
+
+// SlotsGTE applies the GTE predicate on the "slots" field.
+func SlotsGTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGTE(FieldSlots, v))
+}
+
+// SlotsLT applies the LT predicate on the "slots" field.
+func SlotsLT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLT(FieldSlots, v))
+}
+
+// SlotsLTE applies the LTE predicate on the "slots" field.
+func SlotsLTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLTE(FieldSlots, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Stash {
+	return predicate.Stash(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Stash {
+	return predicate.Stash(sql.FieldLTE(FieldVersion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Stash) predicate.Stash {
+	return predicate.Stash(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Stash) predicate.Stash {
+	return predicate.Stash(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Stash) predicate.Stash {
+	return predicate.Stash(sql.NotPredicates(p))
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/stash"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StashCreate is the builder for creating a Stash entity.
+type StashCreate struct {
+	config
+	mutation *StashMutation
+	hooks    []Hook
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_c *StashCreate) SetCharacterID(v int) *StashCreate {
+	_c.mutation.SetCharacterID(v)
+	return _c
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_c *StashCreate) SetNillableCharacterID(v *int) *StashCreate {
+	if v != nil {
+		_c.SetCharacterID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *StashCreate) SetUserID(v int) *StashCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *StashCreate) SetNillableUserID(v *int) *StashCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetWorldID sets the "world_id" field.
+func (_c *StashCreate) SetWorldID(v int) *StashCreate {
+	_c.mutation.SetWorldID(v)
+	return _c
+}
+
+// SetNillableWorldID sets the "world_id" field if the given value is not nil.
+func (_c *StashCreate) SetNillableWorldID(v *int) *StashCreate {
+	if v != nil {
+		_c.SetWorldID(*v)
+	}
+	return _c
+}
+
+// SetGold sets the "gold" field.
+func (_c *StashCreate) SetGold(v int) *StashCreate {
+	_c.mutation.SetGold(v)
+	return _c
+}
+
+// SetNillableGold sets the "gold" field if the given value is not nil.
+func (_c *StashCreate) SetNillableGold(v *int) *StashCreate {
+	if v != nil {
+		_c.SetGold(*v)
+	}
+	return _c
+}
+
+// SetItemIds sets the "item_ids" field.
+func (_c *StashCreate) SetItemIds(v []int) *StashCreate {
+	_c.mutation.SetItemIds(v)
+	return _c
+}
+
+// SetSlots sets the "slots" field.
+func (_c *StashCreate) SetSlots(v int) *StashCreate {
+	_c.mutation.SetSlots(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *StashCreate) SetVersion(v int) *StashCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *StashCreate) SetNillableVersion(v *int) *StashCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// Mutation returns the StashMutation object of the builder.
+func (_c *StashCreate) Mutation() *StashMutation {
+	return _c.mutation
+}
+
+// Save creates the Stash in the database.
+func (_c *StashCreate) Save(ctx context.Context) (*Stash, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StashCreate) SaveX(ctx context.Context) *Stash {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StashCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StashCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StashCreate) defaults() {
+	if _, ok := _c.mutation.CharacterID(); !ok {
+		v := stash.DefaultCharacterID
+		_c.mutation.SetCharacterID(v)
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		v := stash.DefaultUserID
+		_c.mutation.SetUserID(v)
+	}
+	if _, ok := _c.mutation.WorldID(); !ok {
+		v := stash.DefaultWorldID
+		_c.mutation.SetWorldID(v)
+	}
+	if _, ok := _c.mutation.Gold(); !ok {
+		v := stash.DefaultGold
+		_c.mutation.SetGold(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := stash.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StashCreate) check() error {
+	if _, ok := _c.mutation.CharacterID(); !ok {
+		return &ValidationError{Name: "character_id", err: errors.New(`db: missing required field "Stash.character_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`db: missing required field "Stash.user_id"`)}
+	}
+	if _, ok := _c.mutation.WorldID(); !ok {
+		return &ValidationError{Name: "world_id", err: errors.New(`db: missing required field "Stash.world_id"`)}
+	}
+	if _, ok := _c.mutation.Gold(); !ok {
+		return &ValidationError{Name: "gold", err: errors.New(`db: missing required field "Stash.gold"`)}
+	}
+	if _, ok := _c.mutation.Slots(); !ok {
+		return &ValidationError{Name: "slots", err: errors.New(`db: missing required field "Stash.slots"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`db: missing required field "Stash.version"`)}
+	}
+	return nil
+}
+
+func (_c *StashCreate) sqlSave(ctx context.Context) (*Stash, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StashCreate) createSpec() (*Stash, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Stash{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stash.Table, sqlgraph.NewFieldSpec(stash.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CharacterID(); ok {
+		_spec.SetField(stash.FieldCharacterID, field.TypeInt, value)
+		_node.CharacterID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(stash.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.WorldID(); ok {
+		_spec.SetField(stash.FieldWorldID, field.TypeInt, value)
+		_node.WorldID = value
+	}
+	if value, ok := _c.mutation.Gold(); ok {
+		_spec.SetField(stash.FieldGold, field.TypeInt, value)
+		_node.Gold = value
+	}
+	if value, ok := _c.mutation.ItemIds(); ok {
+		_spec.SetField(stash.FieldItemIds, field.TypeJSON, value)
+		_node.ItemIds = value
+	}
+	if value, ok := _c.mutation.Slots(); ok {
+		_spec.SetField(stash.FieldSlots, field.TypeInt, value)
+		_node.Slots = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(stash.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	return _node, _spec
+}
+
+// StashCreateBulk is the builder for creating many Stash entities in bulk.
+type StashCreateBulk struct {
+	config
+	err      error
+	builders []*StashCreate
+}
+
+// Save creates the Stash entities in the database.
+func (_c *StashCreateBulk) Save(ctx context.Context) ([]*Stash, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Stash, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StashMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StashCreateBulk) SaveX(ctx context.Context) []*Stash {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StashCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StashCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
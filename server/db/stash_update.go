@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/predicate"
+	"herbst-server/db/stash"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// StashUpdate is the builder for updating Stash entities.
+type StashUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StashMutation
+}
+
+// Where appends a list predicates to the StashUpdate builder.
+func (_u *StashUpdate) Where(ps ...predicate.Stash) *StashUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_u *StashUpdate) SetCharacterID(v int) *StashUpdate {
+	_u.mutation.ResetCharacterID()
+	_u.mutation.SetCharacterID(v)
+	return _u
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_u *StashUpdate) SetNillableCharacterID(v *int) *StashUpdate {
+	if v != nil {
+		_u.SetCharacterID(*v)
+	}
+	return _u
+}
+
+// AddCharacterID adds value to the "character_id" field.
+func (_u *StashUpdate) AddCharacterID(v int) *StashUpdate {
+	_u.mutation.AddCharacterID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *StashUpdate) SetUserID(v int) *StashUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *StashUpdate) SetNillableUserID(v *int) *StashUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *StashUpdate) AddUserID(v int) *StashUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetWorldID sets the "world_id" field.
+func (_u *StashUpdate) SetWorldID(v int) *StashUpdate {
+	_u.mutation.ResetWorldID()
+	_u.mutation.SetWorldID(v)
+	return _u
+}
+
+// SetNillableWorldID sets the "world_id" field if the given value is not nil.
+func (_u *StashUpdate) SetNillableWorldID(v *int) *StashUpdate {
+	if v != nil {
+		_u.SetWorldID(*v)
+	}
+	return _u
+}
+
+// AddWorldID adds value to the "world_id" field.
+func (_u *StashUpdate) AddWorldID(v int) *StashUpdate {
+	_u.mutation.AddWorldID(v)
+	return _u
+}
+
+// SetGold sets the "gold" field.
+func (_u *StashUpdate) SetGold(v int) *StashUpdate {
+	_u.mutation.ResetGold()
+	_u.mutation.SetGold(v)
+	return _u
+}
+
+// SetNillableGold sets the "gold" field if the given value is not nil.
+func (_u *StashUpdate) SetNillableGold(v *int) *StashUpdate {
+	if v != nil {
+		_u.SetGold(*v)
+	}
+	return _u
+}
+
+// AddGold adds value to the "gold" field.
+func (_u *StashUpdate) AddGold(v int) *StashUpdate {
+	_u.mutation.AddGold(v)
+	return _u
+}
+
+// SetItemIds sets the "item_ids" field.
+func (_u *StashUpdate) SetItemIds(v []int) *StashUpdate {
+	_u.mutation.SetItemIds(v)
+	return _u
+}
+
+// AppendItemIds appends value to the "item_ids" field.
+func (_u *StashUpdate) AppendItemIds(v []int) *StashUpdate {
+	_u.mutation.AppendItemIds(v)
+	return _u
+}
+
+// ClearItemIds clears the value of the "item_ids" field.
+func (_u *StashUpdate) ClearItemIds() *StashUpdate {
+	_u.mutation.ClearItemIds()
+	return _u
+}
+
+// SetSlots sets the "slots" field.
+func (_u *StashUpdate) SetSlots(v int) *StashUpdate {
+	_u.mutation.ResetSlots()
+	_u.mutation.SetSlots(v)
+	return _u
+}
+
+// SetNillableSlots sets the "slots" field if the given value is not nil.
+func (_u *StashUpdate) SetNillableSlots(v *int) *StashUpdate {
+	if v != nil {
+		_u.SetSlots(*v)
+	}
+	return _u
+}
+
+// AddSlots adds value to the "slots" field.
+func (_u *StashUpdate) AddSlots(v int) *StashUpdate {
+	_u.mutation.AddSlots(v)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *StashUpdate) SetVersion(v int) *StashUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *StashUpdate) SetNillableVersion(v *int) *StashUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *StashUpdate) AddVersion(v int) *StashUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// Mutation returns the StashMutation object of the builder.
+func (_u *StashUpdate) Mutation() *StashMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StashUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StashUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StashUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StashUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *StashUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(stash.Table, stash.Columns, sqlgraph.NewFieldSpec(stash.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CharacterID(); ok {
+		_spec.SetField(stash.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharacterID(); ok {
+		_spec.AddField(stash.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(stash.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(stash.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorldID(); ok {
+		_spec.SetField(stash.FieldWorldID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorldID(); ok {
+		_spec.AddField(stash.FieldWorldID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Gold(); ok {
+		_spec.SetField(stash.FieldGold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGold(); ok {
+		_spec.AddField(stash.FieldGold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemIds(); ok {
+		_spec.SetField(stash.FieldItemIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedItemIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, stash.FieldItemIds, value)
+		})
+	}
+	if _u.mutation.ItemIdsCleared() {
+		_spec.ClearField(stash.FieldItemIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Slots(); ok {
+		_spec.SetField(stash.FieldSlots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlots(); ok {
+		_spec.AddField(stash.FieldSlots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(stash.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(stash.FieldVersion, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stash.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StashUpdateOne is the builder for updating a single Stash entity.
+type StashUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StashMutation
+}
+
+// SetCharacterID sets the "character_id" field.
+func (_u *StashUpdateOne) SetCharacterID(v int) *StashUpdateOne {
+	_u.mutation.ResetCharacterID()
+	_u.mutation.SetCharacterID(v)
+	return _u
+}
+
+// SetNillableCharacterID sets the "character_id" field if the given value is not nil.
+func (_u *StashUpdateOne) SetNillableCharacterID(v *int) *StashUpdateOne {
+	if v != nil {
+		_u.SetCharacterID(*v)
+	}
+	return _u
+}
+
+// AddCharacterID adds value to the "character_id" field.
+func (_u *StashUpdateOne) AddCharacterID(v int) *StashUpdateOne {
+	_u.mutation.AddCharacterID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *StashUpdateOne) SetUserID(v int) *StashUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *StashUpdateOne) SetNillableUserID(v *int) *StashUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *StashUpdateOne) AddUserID(v int) *StashUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetWorldID sets the "world_id" field.
+func (_u *StashUpdateOne) SetWorldID(v int) *StashUpdateOne {
+	_u.mutation.ResetWorldID()
+	_u.mutation.SetWorldID(v)
+	return _u
+}
+
+// SetNillableWorldID sets the "world_id" field if the given value is not nil.
+func (_u *StashUpdateOne) SetNillableWorldID(v *int) *StashUpdateOne {
+	if v != nil {
+		_u.SetWorldID(*v)
+	}
+	return _u
+}
+
+// AddWorldID adds value to the "world_id" field.
+func (_u *StashUpdateOne) AddWorldID(v int) *StashUpdateOne {
+	_u.mutation.AddWorldID(v)
+	return _u
+}
+
+// SetGold sets the "gold" field.
+func (_u *StashUpdateOne) SetGold(v int) *StashUpdateOne {
+	_u.mutation.ResetGold()
+	_u.mutation.SetGold(v)
+	return _u
+}
+
+// SetNillableGold sets the "gold" field if the given value is not nil.
+func (_u *StashUpdateOne) SetNillableGold(v *int) *StashUpdateOne {
+	if v != nil {
+		_u.SetGold(*v)
+	}
+	return _u
+}
+
+// AddGold adds value to the "gold" field.
+func (_u *StashUpdateOne) AddGold(v int) *StashUpdateOne {
+	_u.mutation.AddGold(v)
+	return _u
+}
+
+// SetItemIds sets the "item_ids" field.
+func (_u *StashUpdateOne) SetItemIds(v []int) *StashUpdateOne {
+	_u.mutation.SetItemIds(v)
+	return _u
+}
+
+// AppendItemIds appends value to the "item_ids" field.
+func (_u *StashUpdateOne) AppendItemIds(v []int) *StashUpdateOne {
+	_u.mutation.AppendItemIds(v)
+	return _u
+}
+
+// ClearItemIds clears the value of the "item_ids" field.
+func (_u *StashUpdateOne) ClearItemIds() *StashUpdateOne {
+	_u.mutation.ClearItemIds()
+	return _u
+}
+
+// SetSlots sets the "slots" field.
+func (_u *StashUpdateOne) SetSlots(v int) *StashUpdateOne {
+	_u.mutation.ResetSlots()
+	_u.mutation.SetSlots(v)
+	return _u
+}
+
+// SetNillableSlots sets the "slots" field if the given value is not nil.
+func (_u *StashUpdateOne) SetNillableSlots(v *int) *StashUpdateOne {
+	if v != nil {
+		_u.SetSlots(*v)
+	}
+	return _u
+}
+
+// AddSlots adds value to the "slots" field.
+func (_u *StashUpdateOne) AddSlots(v int) *StashUpdateOne {
+	_u.mutation.AddSlots(v)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *StashUpdateOne) SetVersion(v int) *StashUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *StashUpdateOne) SetNillableVersion(v *int) *StashUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *StashUpdateOne) AddVersion(v int) *StashUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// Mutation returns the StashMutation object of the builder.
+func (_u *StashUpdateOne) Mutation() *StashMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the StashUpdate builder.
+func (_u *StashUpdateOne) Where(ps ...predicate.Stash) *StashUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StashUpdateOne) Select(field string, fields ...string) *StashUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Stash entity.
+func (_u *StashUpdateOne) Save(ctx context.Context) (*Stash, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StashUpdateOne) SaveX(ctx context.Context) *Stash {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StashUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StashUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *StashUpdateOne) sqlSave(ctx context.Context) (_node *Stash, err error) {
+	_spec := sqlgraph.NewUpdateSpec(stash.Table, stash.Columns, sqlgraph.NewFieldSpec(stash.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "Stash.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stash.FieldID)
+		for _, f := range fields {
+			if !stash.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != stash.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CharacterID(); ok {
+		_spec.SetField(stash.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharacterID(); ok {
+		_spec.AddField(stash.FieldCharacterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(stash.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(stash.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorldID(); ok {
+		_spec.SetField(stash.FieldWorldID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorldID(); ok {
+		_spec.AddField(stash.FieldWorldID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Gold(); ok {
+		_spec.SetField(stash.FieldGold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGold(); ok {
+		_spec.AddField(stash.FieldGold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemIds(); ok {
+		_spec.SetField(stash.FieldItemIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedItemIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, stash.FieldItemIds, value)
+		})
+	}
+	if _u.mutation.ItemIdsCleared() {
+		_spec.ClearField(stash.FieldItemIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Slots(); ok {
+		_spec.SetField(stash.FieldSlots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlots(); ok {
+		_spec.AddField(stash.FieldSlots, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(stash.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(stash.FieldVersion, field.TypeInt, value)
+	}
+	_node = &Stash{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stash.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
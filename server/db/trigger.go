@@ -23,7 +23,7 @@ type Trigger struct {
 	Name string `json:"name,omitempty"`
 	// World this trigger belongs to (for multi-world support)
 	WorldID string `json:"world_id,omitempty"`
-	// use|touch|press|enter|examine - what action triggers this
+	// use|touch|press|examine|enter|leave|say|drop|timer - what action triggers this
 	TriggerType string `json:"trigger_type,omitempty"`
 	// For examine-type triggers, the player level required to see this trigger. 0 = always show.
 	ExamineWeight int `json:"examine_weight,omitempty"`
@@ -37,6 +37,12 @@ type Trigger struct {
 	EquipmentID *int `json:"equipment_id,omitempty"`
 	// SPICE expression for conditional trigger firing
 	Condition string `json:"condition,omitempty"`
+	// For say triggers, the word or phrase to listen for; for drop triggers, part of the item's name. Empty matches anything.
+	Keyword string `json:"keyword,omitempty"`
+	// For timer triggers, how often the trigger fires while players are in the room
+	IntervalSecs int `json:"interval_secs,omitempty"`
+	// self|room|room_except_source - who a server-fired trigger's effect lands on. Timers always affect the room.
+	Affects string `json:"affects,omitempty"`
 	// If false, trigger does not fire
 	Enabled bool `json:"enabled,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -101,9 +107,9 @@ func (*Trigger) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case trigger.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case trigger.FieldID, trigger.FieldExamineWeight, trigger.FieldTargetID, trigger.FieldRoomID, trigger.FieldEquipmentID:
+		case trigger.FieldID, trigger.FieldExamineWeight, trigger.FieldTargetID, trigger.FieldRoomID, trigger.FieldEquipmentID, trigger.FieldIntervalSecs:
 			values[i] = new(sql.NullInt64)
-		case trigger.FieldName, trigger.FieldWorldID, trigger.FieldTriggerType, trigger.FieldTargetType, trigger.FieldCondition:
+		case trigger.FieldName, trigger.FieldWorldID, trigger.FieldTriggerType, trigger.FieldTargetType, trigger.FieldCondition, trigger.FieldKeyword, trigger.FieldAffects:
 			values[i] = new(sql.NullString)
 		case trigger.ForeignKeys[0]: // crafting_recipe_triggers
 			values[i] = new(sql.NullInt64)
@@ -188,6 +194,24 @@ func (_m *Trigger) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Condition = value.String
 			}
+		case trigger.FieldKeyword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field keyword", values[i])
+			} else if value.Valid {
+				_m.Keyword = value.String
+			}
+		case trigger.FieldIntervalSecs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval_secs", values[i])
+			} else if value.Valid {
+				_m.IntervalSecs = int(value.Int64)
+			}
+		case trigger.FieldAffects:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field affects", values[i])
+			} else if value.Valid {
+				_m.Affects = value.String
+			}
 		case trigger.FieldEnabled:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enabled", values[i])
@@ -297,6 +321,15 @@ func (_m *Trigger) String() string {
 	builder.WriteString("condition=")
 	builder.WriteString(_m.Condition)
 	builder.WriteString(", ")
+	builder.WriteString("keyword=")
+	builder.WriteString(_m.Keyword)
+	builder.WriteString(", ")
+	builder.WriteString("interval_secs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IntervalSecs))
+	builder.WriteString(", ")
+	builder.WriteString("affects=")
+	builder.WriteString(_m.Affects)
+	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
 	builder.WriteByte(')')
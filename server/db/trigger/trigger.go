@@ -30,6 +30,12 @@ const (
 	FieldEquipmentID = "equipment_id"
 	// FieldCondition holds the string denoting the condition field in the database.
 	FieldCondition = "condition"
+	// FieldKeyword holds the string denoting the keyword field in the database.
+	FieldKeyword = "keyword"
+	// FieldIntervalSecs holds the string denoting the interval_secs field in the database.
+	FieldIntervalSecs = "interval_secs"
+	// FieldAffects holds the string denoting the affects field in the database.
+	FieldAffects = "affects"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
 	// EdgeEffect holds the string denoting the effect edge name in mutations.
@@ -75,6 +81,9 @@ var Columns = []string{
 	FieldRoomID,
 	FieldEquipmentID,
 	FieldCondition,
+	FieldKeyword,
+	FieldIntervalSecs,
+	FieldAffects,
 	FieldEnabled,
 }
 
@@ -106,6 +115,10 @@ var (
 	DefaultWorldID string
 	// DefaultExamineWeight holds the default value on creation for the "examine_weight" field.
 	DefaultExamineWeight int
+	// DefaultIntervalSecs holds the default value on creation for the "interval_secs" field.
+	DefaultIntervalSecs int
+	// DefaultAffects holds the default value on creation for the "affects" field.
+	DefaultAffects string
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
 )
@@ -163,6 +176,21 @@ func ByCondition(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCondition, opts...).ToFunc()
 }
 
+// ByKeyword orders the results by the keyword field.
+func ByKeyword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyword, opts...).ToFunc()
+}
+
+// ByIntervalSecs orders the results by the interval_secs field.
+func ByIntervalSecs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntervalSecs, opts...).ToFunc()
+}
+
+// ByAffects orders the results by the affects field.
+func ByAffects(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAffects, opts...).ToFunc()
+}
+
 // ByEnabled orders the results by the enabled field.
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
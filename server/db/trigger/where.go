@@ -99,6 +99,21 @@ func Condition(v string) predicate.Trigger {
 	return predicate.Trigger(sql.FieldEQ(FieldCondition, v))
 }
 
+// Keyword applies equality check predicate on the "keyword" field. It's identical to KeywordEQ.
+func Keyword(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldEQ(FieldKeyword, v))
+}
+
+// IntervalSecs applies equality check predicate on the "interval_secs" field. It's identical to IntervalSecsEQ.
+func IntervalSecs(v int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldEQ(FieldIntervalSecs, v))
+}
+
+// Affects applies equality check predicate on the "affects" field. It's identical to AffectsEQ.
+func Affects(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldEQ(FieldAffects, v))
+}
+
 // Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
 func Enabled(v bool) predicate.Trigger {
 	return predicate.Trigger(sql.FieldEQ(FieldEnabled, v))
@@ -639,6 +654,206 @@ func ConditionContainsFold(v string) predicate.Trigger {
 	return predicate.Trigger(sql.FieldContainsFold(FieldCondition, v))
 }
 
+// KeywordEQ applies the EQ predicate on the "keyword" field.
+func KeywordEQ(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldEQ(FieldKeyword, v))
+}
+
+// KeywordNEQ applies the NEQ predicate on the "keyword" field.
+func KeywordNEQ(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldNEQ(FieldKeyword, v))
+}
+
+// KeywordIn applies the In predicate on the "keyword" field.
+func KeywordIn(vs ...string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldIn(FieldKeyword, vs...))
+}
+
+// KeywordNotIn applies the NotIn predicate on the "keyword" field.
+func KeywordNotIn(vs ...string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldNotIn(FieldKeyword, vs...))
+}
+
+// KeywordGT applies the GT predicate on the "keyword" field.
+func KeywordGT(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldGT(FieldKeyword, v))
+}
+
+// KeywordGTE applies the GTE predicate on the "keyword" field.
+func KeywordGTE(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldGTE(FieldKeyword, v))
+}
+
+// KeywordLT applies the LT predicate on the "keyword" field.
+func KeywordLT(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldLT(FieldKeyword, v))
+}
+
+// KeywordLTE applies the LTE predicate on the "keyword" field.
+func KeywordLTE(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldLTE(FieldKeyword, v))
+}
+
+// KeywordContains applies the Contains predicate on the "keyword" field.
+func KeywordContains(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldContains(FieldKeyword, v))
+}
+
+// KeywordHasPrefix applies the HasPrefix predicate on the "keyword" field.
+func KeywordHasPrefix(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldHasPrefix(FieldKeyword, v))
+}
+
+// KeywordHasSuffix applies the HasSuffix predicate on the "keyword" field.
+func KeywordHasSuffix(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldHasSuffix(FieldKeyword, v))
+}
+
+// KeywordIsNil applies the IsNil predicate on the "keyword" field.
+func KeywordIsNil() predicate.Trigger {
+	return predicate.Trigger(sql.FieldIsNull(FieldKeyword))
+}
+
+// KeywordNotNil applies the NotNil predicate on the "keyword" field.
+func KeywordNotNil() predicate.Trigger {
+	return predicate.Trigger(sql.FieldNotNull(FieldKeyword))
+}
+
+// KeywordEqualFold applies the EqualFold predicate on the "keyword" field.
+func KeywordEqualFold(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldEqualFold(FieldKeyword, v))
+}
+
+// KeywordContainsFold applies the ContainsFold predicate on the "keyword" field.
+func KeywordContainsFold(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldContainsFold(FieldKeyword, v))
+}
+
+// IntervalSecsEQ applies the EQ predicate on the "interval_secs" field.
+func IntervalSecsEQ(v int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldEQ(FieldIntervalSecs, v))
+}
+
+// IntervalSecsNEQ applies the NEQ predicate on the "interval_secs" field.
+func IntervalSecsNEQ(v int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldNEQ(FieldIntervalSecs, v))
+}
+
+// IntervalSecsIn applies the In predicate on the "interval_secs" field.
+func IntervalSecsIn(vs ...int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldIn(FieldIntervalSecs, vs...))
+}
+
+// IntervalSecsNotIn applies the NotIn predicate on the "interval_secs" field.
+func IntervalSecsNotIn(vs ...int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldNotIn(FieldIntervalSecs, vs...))
+}
+
+// IntervalSecsGT applies the GT predicate on the "interval_secs" field.
+func IntervalSecsGT(v int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldGT(FieldIntervalSecs, v))
+}
+
+// IntervalSecsGTE applies the GTE predicate on the "interval_secs" field.
+func IntervalSecsGTE(v int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldGTE(FieldIntervalSecs, v))
+}
+
+// IntervalSecsLT applies the LT predicate on the "interval_secs" field.
+func IntervalSecsLT(v int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldLT(FieldIntervalSecs, v))
+}
+
+// IntervalSecsLTE applies the LTE predicate on the "interval_secs" field.
+func IntervalSecsLTE(v int) predicate.Trigger {
+	return predicate.Trigger(sql.FieldLTE(FieldIntervalSecs, v))
+}
+
+// IntervalSecsIsNil applies the IsNil predicate on the "interval_secs" field.
+func IntervalSecsIsNil() predicate.Trigger {
+	return predicate.Trigger(sql.FieldIsNull(FieldIntervalSecs))
+}
+
+// IntervalSecsNotNil applies the NotNil predicate on the "interval_secs" field.
+func IntervalSecsNotNil() predicate.Trigger {
+	return predicate.Trigger(sql.FieldNotNull(FieldIntervalSecs))
+}
+
+// AffectsEQ applies the EQ predicate on the "affects" field.
+func AffectsEQ(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldEQ(FieldAffects, v))
+}
+
+// AffectsNEQ applies the NEQ predicate on the "affects" field.
+func AffectsNEQ(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldNEQ(FieldAffects, v))
+}
+
+// AffectsIn applies the In predicate on the "affects" field.
+func AffectsIn(vs ...string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldIn(FieldAffects, vs...))
+}
+
+// AffectsNotIn applies the NotIn predicate on the "affects" field.
+func AffectsNotIn(vs ...string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldNotIn(FieldAffects, vs...))
+}
+
+// AffectsGT applies the GT predicate on the "affects" field.
+func AffectsGT(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldGT(FieldAffects, v))
+}
+
+// AffectsGTE applies the GTE predicate on the "affects" field.
+func AffectsGTE(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldGTE(FieldAffects, v))
+}
+
+// AffectsLT applies the LT predicate on the "affects" field.
+func AffectsLT(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldLT(FieldAffects, v))
+}
+
+// AffectsLTE applies the LTE predicate on the "affects" field.
+func AffectsLTE(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldLTE(FieldAffects, v))
+}
+
+// AffectsContains applies the Contains predicate on the "affects" field.
+func AffectsContains(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldContains(FieldAffects, v))
+}
+
+// AffectsHasPrefix applies the HasPrefix predicate on the "affects" field.
+func AffectsHasPrefix(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldHasPrefix(FieldAffects, v))
+}
+
+// AffectsHasSuffix applies the HasSuffix predicate on the "affects" field.
+func AffectsHasSuffix(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldHasSuffix(FieldAffects, v))
+}
+
+// AffectsIsNil applies the IsNil predicate on the "affects" field.
+func AffectsIsNil() predicate.Trigger {
+	return predicate.Trigger(sql.FieldIsNull(FieldAffects))
+}
+
+// AffectsNotNil applies the NotNil predicate on the "affects" field.
+func AffectsNotNil() predicate.Trigger {
+	return predicate.Trigger(sql.FieldNotNull(FieldAffects))
+}
+
+// AffectsEqualFold applies the EqualFold predicate on the "affects" field.
+func AffectsEqualFold(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldEqualFold(FieldAffects, v))
+}
+
+// AffectsContainsFold applies the ContainsFold predicate on the "affects" field.
+func AffectsContainsFold(v string) predicate.Trigger {
+	return predicate.Trigger(sql.FieldContainsFold(FieldAffects, v))
+}
+
 // EnabledEQ applies the EQ predicate on the "enabled" field.
 func EnabledEQ(v bool) predicate.Trigger {
 	return predicate.Trigger(sql.FieldEQ(FieldEnabled, v))
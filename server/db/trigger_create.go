@@ -124,6 +124,48 @@ func (_c *TriggerCreate) SetNillableCondition(v *string) *TriggerCreate {
 	return _c
 }
 
+// SetKeyword sets the "keyword" field.
+func (_c *TriggerCreate) SetKeyword(v string) *TriggerCreate {
+	_c.mutation.SetKeyword(v)
+	return _c
+}
+
+// SetNillableKeyword sets the "keyword" field if the given value is not nil.
+func (_c *TriggerCreate) SetNillableKeyword(v *string) *TriggerCreate {
+	if v != nil {
+		_c.SetKeyword(*v)
+	}
+	return _c
+}
+
+// SetIntervalSecs sets the "interval_secs" field.
+func (_c *TriggerCreate) SetIntervalSecs(v int) *TriggerCreate {
+	_c.mutation.SetIntervalSecs(v)
+	return _c
+}
+
+// SetNillableIntervalSecs sets the "interval_secs" field if the given value is not nil.
+func (_c *TriggerCreate) SetNillableIntervalSecs(v *int) *TriggerCreate {
+	if v != nil {
+		_c.SetIntervalSecs(*v)
+	}
+	return _c
+}
+
+// SetAffects sets the "affects" field.
+func (_c *TriggerCreate) SetAffects(v string) *TriggerCreate {
+	_c.mutation.SetAffects(v)
+	return _c
+}
+
+// SetNillableAffects sets the "affects" field if the given value is not nil.
+func (_c *TriggerCreate) SetNillableAffects(v *string) *TriggerCreate {
+	if v != nil {
+		_c.SetAffects(*v)
+	}
+	return _c
+}
+
 // SetEnabled sets the "enabled" field.
 func (_c *TriggerCreate) SetEnabled(v bool) *TriggerCreate {
 	_c.mutation.SetEnabled(v)
@@ -238,6 +280,14 @@ func (_c *TriggerCreate) defaults() {
 		v := trigger.DefaultExamineWeight
 		_c.mutation.SetExamineWeight(v)
 	}
+	if _, ok := _c.mutation.IntervalSecs(); !ok {
+		v := trigger.DefaultIntervalSecs
+		_c.mutation.SetIntervalSecs(v)
+	}
+	if _, ok := _c.mutation.Affects(); !ok {
+		v := trigger.DefaultAffects
+		_c.mutation.SetAffects(v)
+	}
 	if _, ok := _c.mutation.Enabled(); !ok {
 		v := trigger.DefaultEnabled
 		_c.mutation.SetEnabled(v)
@@ -323,6 +373,18 @@ func (_c *TriggerCreate) createSpec() (*Trigger, *sqlgraph.CreateSpec) {
 		_spec.SetField(trigger.FieldCondition, field.TypeString, value)
 		_node.Condition = value
 	}
+	if value, ok := _c.mutation.Keyword(); ok {
+		_spec.SetField(trigger.FieldKeyword, field.TypeString, value)
+		_node.Keyword = value
+	}
+	if value, ok := _c.mutation.IntervalSecs(); ok {
+		_spec.SetField(trigger.FieldIntervalSecs, field.TypeInt, value)
+		_node.IntervalSecs = value
+	}
+	if value, ok := _c.mutation.Affects(); ok {
+		_spec.SetField(trigger.FieldAffects, field.TypeString, value)
+		_node.Affects = value
+	}
 	if value, ok := _c.mutation.Enabled(); ok {
 		_spec.SetField(trigger.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
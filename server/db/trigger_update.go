@@ -214,6 +214,73 @@ func (_u *TriggerUpdate) ClearCondition() *TriggerUpdate {
 	return _u
 }
 
+// SetKeyword sets the "keyword" field.
+func (_u *TriggerUpdate) SetKeyword(v string) *TriggerUpdate {
+	_u.mutation.SetKeyword(v)
+	return _u
+}
+
+// SetNillableKeyword sets the "keyword" field if the given value is not nil.
+func (_u *TriggerUpdate) SetNillableKeyword(v *string) *TriggerUpdate {
+	if v != nil {
+		_u.SetKeyword(*v)
+	}
+	return _u
+}
+
+// ClearKeyword clears the value of the "keyword" field.
+func (_u *TriggerUpdate) ClearKeyword() *TriggerUpdate {
+	_u.mutation.ClearKeyword()
+	return _u
+}
+
+// SetIntervalSecs sets the "interval_secs" field.
+func (_u *TriggerUpdate) SetIntervalSecs(v int) *TriggerUpdate {
+	_u.mutation.ResetIntervalSecs()
+	_u.mutation.SetIntervalSecs(v)
+	return _u
+}
+
+// SetNillableIntervalSecs sets the "interval_secs" field if the given value is not nil.
+func (_u *TriggerUpdate) SetNillableIntervalSecs(v *int) *TriggerUpdate {
+	if v != nil {
+		_u.SetIntervalSecs(*v)
+	}
+	return _u
+}
+
+// AddIntervalSecs adds value to the "interval_secs" field.
+func (_u *TriggerUpdate) AddIntervalSecs(v int) *TriggerUpdate {
+	_u.mutation.AddIntervalSecs(v)
+	return _u
+}
+
+// ClearIntervalSecs clears the value of the "interval_secs" field.
+func (_u *TriggerUpdate) ClearIntervalSecs() *TriggerUpdate {
+	_u.mutation.ClearIntervalSecs()
+	return _u
+}
+
+// SetAffects sets the "affects" field.
+func (_u *TriggerUpdate) SetAffects(v string) *TriggerUpdate {
+	_u.mutation.SetAffects(v)
+	return _u
+}
+
+// SetNillableAffects sets the "affects" field if the given value is not nil.
+func (_u *TriggerUpdate) SetNillableAffects(v *string) *TriggerUpdate {
+	if v != nil {
+		_u.SetAffects(*v)
+	}
+	return _u
+}
+
+// ClearAffects clears the value of the "affects" field.
+func (_u *TriggerUpdate) ClearAffects() *TriggerUpdate {
+	_u.mutation.ClearAffects()
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *TriggerUpdate) SetEnabled(v bool) *TriggerUpdate {
 	_u.mutation.SetEnabled(v)
@@ -398,6 +465,27 @@ func (_u *TriggerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ConditionCleared() {
 		_spec.ClearField(trigger.FieldCondition, field.TypeString)
 	}
+	if value, ok := _u.mutation.Keyword(); ok {
+		_spec.SetField(trigger.FieldKeyword, field.TypeString, value)
+	}
+	if _u.mutation.KeywordCleared() {
+		_spec.ClearField(trigger.FieldKeyword, field.TypeString)
+	}
+	if value, ok := _u.mutation.IntervalSecs(); ok {
+		_spec.SetField(trigger.FieldIntervalSecs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIntervalSecs(); ok {
+		_spec.AddField(trigger.FieldIntervalSecs, field.TypeInt, value)
+	}
+	if _u.mutation.IntervalSecsCleared() {
+		_spec.ClearField(trigger.FieldIntervalSecs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Affects(); ok {
+		_spec.SetField(trigger.FieldAffects, field.TypeString, value)
+	}
+	if _u.mutation.AffectsCleared() {
+		_spec.ClearField(trigger.FieldAffects, field.TypeString)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(trigger.FieldEnabled, field.TypeBool, value)
 	}
@@ -692,6 +780,73 @@ func (_u *TriggerUpdateOne) ClearCondition() *TriggerUpdateOne {
 	return _u
 }
 
+// SetKeyword sets the "keyword" field.
+func (_u *TriggerUpdateOne) SetKeyword(v string) *TriggerUpdateOne {
+	_u.mutation.SetKeyword(v)
+	return _u
+}
+
+// SetNillableKeyword sets the "keyword" field if the given value is not nil.
+func (_u *TriggerUpdateOne) SetNillableKeyword(v *string) *TriggerUpdateOne {
+	if v != nil {
+		_u.SetKeyword(*v)
+	}
+	return _u
+}
+
+// ClearKeyword clears the value of the "keyword" field.
+func (_u *TriggerUpdateOne) ClearKeyword() *TriggerUpdateOne {
+	_u.mutation.ClearKeyword()
+	return _u
+}
+
+// SetIntervalSecs sets the "interval_secs" field.
+func (_u *TriggerUpdateOne) SetIntervalSecs(v int) *TriggerUpdateOne {
+	_u.mutation.ResetIntervalSecs()
+	_u.mutation.SetIntervalSecs(v)
+	return _u
+}
+
+// SetNillableIntervalSecs sets the "interval_secs" field if the given value is not nil.
+func (_u *TriggerUpdateOne) SetNillableIntervalSecs(v *int) *TriggerUpdateOne {
+	if v != nil {
+		_u.SetIntervalSecs(*v)
+	}
+	return _u
+}
+
+// AddIntervalSecs adds value to the "interval_secs" field.
+func (_u *TriggerUpdateOne) AddIntervalSecs(v int) *TriggerUpdateOne {
+	_u.mutation.AddIntervalSecs(v)
+	return _u
+}
+
+// ClearIntervalSecs clears the value of the "interval_secs" field.
+func (_u *TriggerUpdateOne) ClearIntervalSecs() *TriggerUpdateOne {
+	_u.mutation.ClearIntervalSecs()
+	return _u
+}
+
+// SetAffects sets the "affects" field.
+func (_u *TriggerUpdateOne) SetAffects(v string) *TriggerUpdateOne {
+	_u.mutation.SetAffects(v)
+	return _u
+}
+
+// SetNillableAffects sets the "affects" field if the given value is not nil.
+func (_u *TriggerUpdateOne) SetNillableAffects(v *string) *TriggerUpdateOne {
+	if v != nil {
+		_u.SetAffects(*v)
+	}
+	return _u
+}
+
+// ClearAffects clears the value of the "affects" field.
+func (_u *TriggerUpdateOne) ClearAffects() *TriggerUpdateOne {
+	_u.mutation.ClearAffects()
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *TriggerUpdateOne) SetEnabled(v bool) *TriggerUpdateOne {
 	_u.mutation.SetEnabled(v)
@@ -906,6 +1061,27 @@ func (_u *TriggerUpdateOne) sqlSave(ctx context.Context) (_node *Trigger, err er
 	if _u.mutation.ConditionCleared() {
 		_spec.ClearField(trigger.FieldCondition, field.TypeString)
 	}
+	if value, ok := _u.mutation.Keyword(); ok {
+		_spec.SetField(trigger.FieldKeyword, field.TypeString, value)
+	}
+	if _u.mutation.KeywordCleared() {
+		_spec.ClearField(trigger.FieldKeyword, field.TypeString)
+	}
+	if value, ok := _u.mutation.IntervalSecs(); ok {
+		_spec.SetField(trigger.FieldIntervalSecs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIntervalSecs(); ok {
+		_spec.AddField(trigger.FieldIntervalSecs, field.TypeInt, value)
+	}
+	if _u.mutation.IntervalSecsCleared() {
+		_spec.ClearField(trigger.FieldIntervalSecs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Affects(); ok {
+		_spec.SetField(trigger.FieldAffects, field.TypeString, value)
+	}
+	if _u.mutation.AffectsCleared() {
+		_spec.ClearField(trigger.FieldAffects, field.TypeString)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(trigger.FieldEnabled, field.TypeBool, value)
 	}
@@ -22,12 +22,16 @@ type Tx struct {
 	ActiveEffect *ActiveEffectClient
 	// AppLog is the client for interacting with the AppLog builders.
 	AppLog *AppLogClient
+	// Auction is the client for interacting with the Auction builders.
+	Auction *AuctionClient
 	// ChannelConfig is the client for interacting with the ChannelConfig builders.
 	ChannelConfig *ChannelConfigClient
 	// Character is the client for interacting with the Character builders.
 	Character *CharacterClient
 	// CharacterAbility is the client for interacting with the CharacterAbility builders.
 	CharacterAbility *CharacterAbilityClient
+	// CharacterAchievement is the client for interacting with the CharacterAchievement builders.
+	CharacterAchievement *CharacterAchievementClient
 	// CharacterChannel is the client for interacting with the CharacterChannel builders.
 	CharacterChannel *CharacterChannelClient
 	// CharacterClassHistory is the client for interacting with the CharacterClassHistory builders.
@@ -52,8 +56,12 @@ type Tx struct {
 	CraftingRecipe *CraftingRecipeClient
 	// DamageLog is the client for interacting with the DamageLog builders.
 	DamageLog *DamageLogClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
 	// DialogNode is the client for interacting with the DialogNode builders.
 	DialogNode *DialogNodeClient
+	// DialogState is the client for interacting with the DialogState builders.
+	DialogState *DialogStateClient
 	// Effect is the client for interacting with the Effect builders.
 	Effect *EffectClient
 	// EffectHook is the client for interacting with the EffectHook builders.
@@ -62,6 +70,8 @@ type Tx struct {
 	Equipment *EquipmentClient
 	// EquipmentTemplate is the client for interacting with the EquipmentTemplate builders.
 	EquipmentTemplate *EquipmentTemplateClient
+	// EventCursor is the client for interacting with the EventCursor builders.
+	EventCursor *EventCursorClient
 	// Faction is the client for interacting with the Faction builders.
 	Faction *FactionClient
 	// FactionCategory is the client for interacting with the FactionCategory builders.
@@ -72,16 +82,28 @@ type Tx struct {
 	GameConfig *GameConfigClient
 	// Gender is the client for interacting with the Gender builders.
 	Gender *GenderClient
+	// House is the client for interacting with the House builders.
+	House *HouseClient
+	// Mail is the client for interacting with the Mail builders.
+	Mail *MailClient
 	// NPCAbility is the client for interacting with the NPCAbility builders.
 	NPCAbility *NPCAbilityClient
 	// NPCTemplate is the client for interacting with the NPCTemplate builders.
 	NPCTemplate *NPCTemplateClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// Party is the client for interacting with the Party builders.
+	Party *PartyClient
+	// PartyInvite is the client for interacting with the PartyInvite builders.
+	PartyInvite *PartyInviteClient
 	// Quest is the client for interacting with the Quest builders.
 	Quest *QuestClient
 	// QuestProgress is the client for interacting with the QuestProgress builders.
 	QuestProgress *QuestProgressClient
 	// Race is the client for interacting with the Race builders.
 	Race *RaceClient
+	// Reputation is the client for interacting with the Reputation builders.
+	Reputation *ReputationClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
 	// ShopItem is the client for interacting with the ShopItem builders.
@@ -92,6 +114,8 @@ type Tx struct {
 	Skill *SkillClient
 	// SocialCommand is the client for interacting with the SocialCommand builders.
 	SocialCommand *SocialCommandClient
+	// Stash is the client for interacting with the Stash builders.
+	Stash *StashClient
 	// SystemLog is the client for interacting with the SystemLog builders.
 	SystemLog *SystemLogClient
 	// Tag is the client for interacting with the Tag builders.
@@ -106,6 +130,8 @@ type Tx struct {
 	World *WorldClient
 	// Zone is the client for interacting with the Zone builders.
 	Zone *ZoneClient
+	// ZoneInstance is the client for interacting with the ZoneInstance builders.
+	ZoneInstance *ZoneInstanceClient
 
 	// lazily loaded.
 	client     *Client
@@ -242,9 +268,11 @@ func (tx *Tx) init() {
 	tx.Achievement = NewAchievementClient(tx.config)
 	tx.ActiveEffect = NewActiveEffectClient(tx.config)
 	tx.AppLog = NewAppLogClient(tx.config)
+	tx.Auction = NewAuctionClient(tx.config)
 	tx.ChannelConfig = NewChannelConfigClient(tx.config)
 	tx.Character = NewCharacterClient(tx.config)
 	tx.CharacterAbility = NewCharacterAbilityClient(tx.config)
+	tx.CharacterAchievement = NewCharacterAchievementClient(tx.config)
 	tx.CharacterChannel = NewCharacterChannelClient(tx.config)
 	tx.CharacterClassHistory = NewCharacterClassHistoryClient(tx.config)
 	tx.CharacterCompetency = NewCharacterCompetencyClient(tx.config)
@@ -257,26 +285,36 @@ func (tx *Tx) init() {
 	tx.CompetencyLevelThreshold = NewCompetencyLevelThresholdClient(tx.config)
 	tx.CraftingRecipe = NewCraftingRecipeClient(tx.config)
 	tx.DamageLog = NewDamageLogClient(tx.config)
+	tx.DeadLetter = NewDeadLetterClient(tx.config)
 	tx.DialogNode = NewDialogNodeClient(tx.config)
+	tx.DialogState = NewDialogStateClient(tx.config)
 	tx.Effect = NewEffectClient(tx.config)
 	tx.EffectHook = NewEffectHookClient(tx.config)
 	tx.Equipment = NewEquipmentClient(tx.config)
 	tx.EquipmentTemplate = NewEquipmentTemplateClient(tx.config)
+	tx.EventCursor = NewEventCursorClient(tx.config)
 	tx.Faction = NewFactionClient(tx.config)
 	tx.FactionCategory = NewFactionCategoryClient(tx.config)
 	tx.FactionRequiredTag = NewFactionRequiredTagClient(tx.config)
 	tx.GameConfig = NewGameConfigClient(tx.config)
 	tx.Gender = NewGenderClient(tx.config)
+	tx.House = NewHouseClient(tx.config)
+	tx.Mail = NewMailClient(tx.config)
 	tx.NPCAbility = NewNPCAbilityClient(tx.config)
 	tx.NPCTemplate = NewNPCTemplateClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
+	tx.Party = NewPartyClient(tx.config)
+	tx.PartyInvite = NewPartyInviteClient(tx.config)
 	tx.Quest = NewQuestClient(tx.config)
 	tx.QuestProgress = NewQuestProgressClient(tx.config)
 	tx.Race = NewRaceClient(tx.config)
+	tx.Reputation = NewReputationClient(tx.config)
 	tx.Room = NewRoomClient(tx.config)
 	tx.ShopItem = NewShopItemClient(tx.config)
 	tx.ShopTemplate = NewShopTemplateClient(tx.config)
 	tx.Skill = NewSkillClient(tx.config)
 	tx.SocialCommand = NewSocialCommandClient(tx.config)
+	tx.Stash = NewStashClient(tx.config)
 	tx.SystemLog = NewSystemLogClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
 	tx.TellQueue = NewTellQueueClient(tx.config)
@@ -284,6 +322,7 @@ func (tx *Tx) init() {
 	tx.User = NewUserClient(tx.config)
 	tx.World = NewWorldClient(tx.config)
 	tx.Zone = NewZoneClient(tx.config)
+	tx.ZoneInstance = NewZoneInstanceClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
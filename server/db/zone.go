@@ -5,8 +5,10 @@ package db
 import (
 	"encoding/json"
 	"fmt"
+	"herbst-server/db/schema"
 	"herbst-server/db/zone"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -31,6 +33,22 @@ type Zone struct {
 	Color string `json:"color,omitempty"`
 	// Explicit list of room IDs in this zone. Edge Zone.rooms is the joinable view; room_ids is the persistent membership list, including rooms that may have been removed (shown as 'ghost' / red chips in admin).
 	RoomIds []int `json:"room_ids,omitempty"`
+	// Reset script: NPC spawners, item placement, NPC equipment and container locks, run in order on every reset
+	Resets []schema.ZoneReset `json:"resets,omitempty"`
+	// Seconds between automatic resets (0 = only when reset from the admin API)
+	ResetIntervalSecs int `json:"reset_interval_secs,omitempty"`
+	// LastResetAt holds the value of the "last_reset_at" field.
+	LastResetAt *time.Time `json:"last_reset_at,omitempty"`
+	// What the last reset did, per command
+	LastResetReport schema.ZoneResetReport `json:"last_reset_report,omitempty"`
+	// Weather table rolled for the zone's outdoor rooms
+	Weather schema.ZoneWeather `json:"weather,omitempty"`
+	// Weather currently in effect, e.g. rain; empty until first rolled
+	CurrentWeather string `json:"current_weather,omitempty"`
+	// WeatherChangedAt holds the value of the "weather_changed_at" field.
+	WeatherChangedAt *time.Time `json:"weather_changed_at,omitempty"`
+	// Portal and lifetime for private copies; set portal_room_id to make the zone instanced
+	Instance schema.ZoneInstancing `json:"instance,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ZoneQuery when eager-loading is set.
 	Edges        ZoneEdges `json:"edges"`
@@ -84,12 +102,14 @@ func (*Zone) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case zone.FieldRoomIds:
+		case zone.FieldRoomIds, zone.FieldResets, zone.FieldLastResetReport, zone.FieldWeather, zone.FieldInstance:
 			values[i] = new([]byte)
-		case zone.FieldMinLevel:
+		case zone.FieldMinLevel, zone.FieldResetIntervalSecs:
 			values[i] = new(sql.NullInt64)
-		case zone.FieldID, zone.FieldWorldID, zone.FieldName, zone.FieldDescription, zone.FieldParentZoneID, zone.FieldColor:
+		case zone.FieldID, zone.FieldWorldID, zone.FieldName, zone.FieldDescription, zone.FieldParentZoneID, zone.FieldColor, zone.FieldCurrentWeather:
 			values[i] = new(sql.NullString)
+		case zone.FieldLastResetAt, zone.FieldWeatherChangedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -155,6 +175,64 @@ func (_m *Zone) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field room_ids: %w", err)
 				}
 			}
+		case zone.FieldResets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field resets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Resets); err != nil {
+					return fmt.Errorf("unmarshal field resets: %w", err)
+				}
+			}
+		case zone.FieldResetIntervalSecs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reset_interval_secs", values[i])
+			} else if value.Valid {
+				_m.ResetIntervalSecs = int(value.Int64)
+			}
+		case zone.FieldLastResetAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_reset_at", values[i])
+			} else if value.Valid {
+				_m.LastResetAt = new(time.Time)
+				*_m.LastResetAt = value.Time
+			}
+		case zone.FieldLastResetReport:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field last_reset_report", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LastResetReport); err != nil {
+					return fmt.Errorf("unmarshal field last_reset_report: %w", err)
+				}
+			}
+		case zone.FieldWeather:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field weather", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Weather); err != nil {
+					return fmt.Errorf("unmarshal field weather: %w", err)
+				}
+			}
+		case zone.FieldCurrentWeather:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field current_weather", values[i])
+			} else if value.Valid {
+				_m.CurrentWeather = value.String
+			}
+		case zone.FieldWeatherChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field weather_changed_at", values[i])
+			} else if value.Valid {
+				_m.WeatherChangedAt = new(time.Time)
+				*_m.WeatherChangedAt = value.Time
+			}
+		case zone.FieldInstance:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field instance", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Instance); err != nil {
+					return fmt.Errorf("unmarshal field instance: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -226,6 +304,34 @@ func (_m *Zone) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("room_ids=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RoomIds))
+	builder.WriteString(", ")
+	builder.WriteString("resets=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Resets))
+	builder.WriteString(", ")
+	builder.WriteString("reset_interval_secs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResetIntervalSecs))
+	builder.WriteString(", ")
+	if v := _m.LastResetAt; v != nil {
+		builder.WriteString("last_reset_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_reset_report=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastResetReport))
+	builder.WriteString(", ")
+	builder.WriteString("weather=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weather))
+	builder.WriteString(", ")
+	builder.WriteString("current_weather=")
+	builder.WriteString(_m.CurrentWeather)
+	builder.WriteString(", ")
+	if v := _m.WeatherChangedAt; v != nil {
+		builder.WriteString("weather_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("instance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Instance))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,7 @@ package zone
 
 import (
 	"herbst-server/db/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -94,6 +95,26 @@ func Color(v string) predicate.Zone {
 	return predicate.Zone(sql.FieldEQ(FieldColor, v))
 }
 
+// ResetIntervalSecs applies equality check predicate on the "reset_interval_secs" field. It's identical to ResetIntervalSecsEQ.
+func ResetIntervalSecs(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldResetIntervalSecs, v))
+}
+
+// LastResetAt applies equality check predicate on the "last_reset_at" field. It's identical to LastResetAtEQ.
+func LastResetAt(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldLastResetAt, v))
+}
+
+// CurrentWeather applies equality check predicate on the "current_weather" field. It's identical to CurrentWeatherEQ.
+func CurrentWeather(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldCurrentWeather, v))
+}
+
+// WeatherChangedAt applies equality check predicate on the "weather_changed_at" field. It's identical to WeatherChangedAtEQ.
+func WeatherChangedAt(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldWeatherChangedAt, v))
+}
+
 // WorldIDEQ applies the EQ predicate on the "world_id" field.
 func WorldIDEQ(v string) predicate.Zone {
 	return predicate.Zone(sql.FieldEQ(FieldWorldID, v))
@@ -499,6 +520,261 @@ func RoomIdsNotNil() predicate.Zone {
 	return predicate.Zone(sql.FieldNotNull(FieldRoomIds))
 }
 
+// ResetsIsNil applies the IsNil predicate on the "resets" field.
+func ResetsIsNil() predicate.Zone {
+	return predicate.Zone(sql.FieldIsNull(FieldResets))
+}
+
+// ResetsNotNil applies the NotNil predicate on the "resets" field.
+func ResetsNotNil() predicate.Zone {
+	return predicate.Zone(sql.FieldNotNull(FieldResets))
+}
+
+// ResetIntervalSecsEQ applies the EQ predicate on the "reset_interval_secs" field.
+func ResetIntervalSecsEQ(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldResetIntervalSecs, v))
+}
+
+// ResetIntervalSecsNEQ applies the NEQ predicate on the "reset_interval_secs" field.
+func ResetIntervalSecsNEQ(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldNEQ(FieldResetIntervalSecs, v))
+}
+
+// ResetIntervalSecsIn applies the In predicate on the "reset_interval_secs" field.
+func ResetIntervalSecsIn(vs ...int) predicate.Zone {
+	return predicate.Zone(sql.FieldIn(FieldResetIntervalSecs, vs...))
+}
+
+// ResetIntervalSecsNotIn applies the NotIn predicate on the "reset_interval_secs" field.
+func ResetIntervalSecsNotIn(vs ...int) predicate.Zone {
+	return predicate.Zone(sql.FieldNotIn(FieldResetIntervalSecs, vs...))
+}
+
+// ResetIntervalSecsGT applies the GT predicate on the "reset_interval_secs" field.
+func ResetIntervalSecsGT(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldGT(FieldResetIntervalSecs, v))
+}
+
+// ResetIntervalSecsGTE applies the GTE predicate on the "reset_interval_secs" field.
+func ResetIntervalSecsGTE(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldGTE(FieldResetIntervalSecs, v))
+}
+
+// ResetIntervalSecsLT applies the LT predicate on the "reset_interval_secs" field.
+func ResetIntervalSecsLT(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldLT(FieldResetIntervalSecs, v))
+}
+
+// ResetIntervalSecsLTE applies the LTE predicate on the "reset_interval_secs" field.
+func ResetIntervalSecsLTE(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldLTE(FieldResetIntervalSecs, v))
+}
+
+// LastResetAtEQ applies the EQ predicate on the "last_reset_at" field.
+func LastResetAtEQ(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldLastResetAt, v))
+}
+
+// LastResetAtNEQ applies the NEQ predicate on the "last_reset_at" field.
+func LastResetAtNEQ(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldNEQ(FieldLastResetAt, v))
+}
+
+// LastResetAtIn applies the In predicate on the "last_reset_at" field.
+func LastResetAtIn(vs ...time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldIn(FieldLastResetAt, vs...))
+}
+
+// LastResetAtNotIn applies the NotIn predicate on the "last_reset_at" field.
+func LastResetAtNotIn(vs ...time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldNotIn(FieldLastResetAt, vs...))
+}
+
+// LastResetAtGT applies the GT predicate on the "last_reset_at" field.
+func LastResetAtGT(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldGT(FieldLastResetAt, v))
+}
+
+// LastResetAtGTE applies the GTE predicate on the "last_reset_at" field.
+func LastResetAtGTE(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldGTE(FieldLastResetAt, v))
+}
+
+// LastResetAtLT applies the LT predicate on the "last_reset_at" field.
+func LastResetAtLT(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldLT(FieldLastResetAt, v))
+}
+
+// LastResetAtLTE applies the LTE predicate on the "last_reset_at" field.
+func LastResetAtLTE(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldLTE(FieldLastResetAt, v))
+}
+
+// LastResetAtIsNil applies the IsNil predicate on the "last_reset_at" field.
+func LastResetAtIsNil() predicate.Zone {
+	return predicate.Zone(sql.FieldIsNull(FieldLastResetAt))
+}
+
+// LastResetAtNotNil applies the NotNil predicate on the "last_reset_at" field.
+func LastResetAtNotNil() predicate.Zone {
+	return predicate.Zone(sql.FieldNotNull(FieldLastResetAt))
+}
+
+// LastResetReportIsNil applies the IsNil predicate on the "last_reset_report" field.
+func LastResetReportIsNil() predicate.Zone {
+	return predicate.Zone(sql.FieldIsNull(FieldLastResetReport))
+}
+
+// LastResetReportNotNil applies the NotNil predicate on the "last_reset_report" field.
+func LastResetReportNotNil() predicate.Zone {
+	return predicate.Zone(sql.FieldNotNull(FieldLastResetReport))
+}
+
+// WeatherIsNil applies the IsNil predicate on the "weather" field.
+func WeatherIsNil() predicate.Zone {
+	return predicate.Zone(sql.FieldIsNull(FieldWeather))
+}
+
+// WeatherNotNil applies the NotNil predicate on the "weather" field.
+func WeatherNotNil() predicate.Zone {
+	return predicate.Zone(sql.FieldNotNull(FieldWeather))
+}
+
+// CurrentWeatherEQ applies the EQ predicate on the "current_weather" field.
+func CurrentWeatherEQ(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherNEQ applies the NEQ predicate on the "current_weather" field.
+func CurrentWeatherNEQ(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldNEQ(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherIn applies the In predicate on the "current_weather" field.
+func CurrentWeatherIn(vs ...string) predicate.Zone {
+	return predicate.Zone(sql.FieldIn(FieldCurrentWeather, vs...))
+}
+
+// CurrentWeatherNotIn applies the NotIn predicate on the "current_weather" field.
+func CurrentWeatherNotIn(vs ...string) predicate.Zone {
+	return predicate.Zone(sql.FieldNotIn(FieldCurrentWeather, vs...))
+}
+
+// CurrentWeatherGT applies the GT predicate on the "current_weather" field.
+func CurrentWeatherGT(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldGT(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherGTE applies the GTE predicate on the "current_weather" field.
+func CurrentWeatherGTE(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldGTE(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherLT applies the LT predicate on the "current_weather" field.
+func CurrentWeatherLT(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldLT(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherLTE applies the LTE predicate on the "current_weather" field.
+func CurrentWeatherLTE(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldLTE(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherContains applies the Contains predicate on the "current_weather" field.
+func CurrentWeatherContains(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldContains(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherHasPrefix applies the HasPrefix predicate on the "current_weather" field.
+func CurrentWeatherHasPrefix(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldHasPrefix(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherHasSuffix applies the HasSuffix predicate on the "current_weather" field.
+func CurrentWeatherHasSuffix(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldHasSuffix(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherIsNil applies the IsNil predicate on the "current_weather" field.
+func CurrentWeatherIsNil() predicate.Zone {
+	return predicate.Zone(sql.FieldIsNull(FieldCurrentWeather))
+}
+
+// CurrentWeatherNotNil applies the NotNil predicate on the "current_weather" field.
+func CurrentWeatherNotNil() predicate.Zone {
+	return predicate.Zone(sql.FieldNotNull(FieldCurrentWeather))
+}
+
+// CurrentWeatherEqualFold applies the EqualFold predicate on the "current_weather" field.
+func CurrentWeatherEqualFold(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldEqualFold(FieldCurrentWeather, v))
+}
+
+// CurrentWeatherContainsFold applies the ContainsFold predicate on the "current_weather" field.
+func CurrentWeatherContainsFold(v string) predicate.Zone {
+	return predicate.Zone(sql.FieldContainsFold(FieldCurrentWeather, v))
+}
+
+// WeatherChangedAtEQ applies the EQ predicate on the "weather_changed_at" field.
+func WeatherChangedAtEQ(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldWeatherChangedAt, v))
+}
+
+// WeatherChangedAtNEQ applies the NEQ predicate on the "weather_changed_at" field.
+func WeatherChangedAtNEQ(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldNEQ(FieldWeatherChangedAt, v))
+}
+
+// WeatherChangedAtIn applies the In predicate on the "weather_changed_at" field.
+func WeatherChangedAtIn(vs ...time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldIn(FieldWeatherChangedAt, vs...))
+}
+
+// WeatherChangedAtNotIn applies the NotIn predicate on the "weather_changed_at" field.
+func WeatherChangedAtNotIn(vs ...time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldNotIn(FieldWeatherChangedAt, vs...))
+}
+
+// WeatherChangedAtGT applies the GT predicate on the "weather_changed_at" field.
+func WeatherChangedAtGT(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldGT(FieldWeatherChangedAt, v))
+}
+
+// WeatherChangedAtGTE applies the GTE predicate on the "weather_changed_at" field.
+func WeatherChangedAtGTE(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldGTE(FieldWeatherChangedAt, v))
+}
+
+// WeatherChangedAtLT applies the LT predicate on the "weather_changed_at" field.
+func WeatherChangedAtLT(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldLT(FieldWeatherChangedAt, v))
+}
+
+// WeatherChangedAtLTE applies the LTE predicate on the "weather_changed_at" field.
+func WeatherChangedAtLTE(v time.Time) predicate.Zone {
+	return predicate.Zone(sql.FieldLTE(FieldWeatherChangedAt, v))
+}
+
+// WeatherChangedAtIsNil applies the IsNil predicate on the "weather_changed_at" field.
+func WeatherChangedAtIsNil() predicate.Zone {
+	return predicate.Zone(sql.FieldIsNull(FieldWeatherChangedAt))
+}
+
+// WeatherChangedAtNotNil applies the NotNil predicate on the "weather_changed_at" field.
+func WeatherChangedAtNotNil() predicate.Zone {
+	return predicate.Zone(sql.FieldNotNull(FieldWeatherChangedAt))
+}
+
+// InstanceIsNil applies the IsNil predicate on the "instance" field.
+func InstanceIsNil() predicate.Zone {
+	return predicate.Zone(sql.FieldIsNull(FieldInstance))
+}
+
+// InstanceNotNil applies the NotNil predicate on the "instance" field.
+func InstanceNotNil() predicate.Zone {
+	return predicate.Zone(sql.FieldNotNull(FieldInstance))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Zone {
 	return predicate.Zone(func(s *sql.Selector) {
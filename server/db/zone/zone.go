@@ -26,6 +26,22 @@ const (
 	FieldColor = "color"
 	// FieldRoomIds holds the string denoting the room_ids field in the database.
 	FieldRoomIds = "room_ids"
+	// FieldResets holds the string denoting the resets field in the database.
+	FieldResets = "resets"
+	// FieldResetIntervalSecs holds the string denoting the reset_interval_secs field in the database.
+	FieldResetIntervalSecs = "reset_interval_secs"
+	// FieldLastResetAt holds the string denoting the last_reset_at field in the database.
+	FieldLastResetAt = "last_reset_at"
+	// FieldLastResetReport holds the string denoting the last_reset_report field in the database.
+	FieldLastResetReport = "last_reset_report"
+	// FieldWeather holds the string denoting the weather field in the database.
+	FieldWeather = "weather"
+	// FieldCurrentWeather holds the string denoting the current_weather field in the database.
+	FieldCurrentWeather = "current_weather"
+	// FieldWeatherChangedAt holds the string denoting the weather_changed_at field in the database.
+	FieldWeatherChangedAt = "weather_changed_at"
+	// FieldInstance holds the string denoting the instance field in the database.
+	FieldInstance = "instance"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -59,6 +75,14 @@ var Columns = []string{
 	FieldParentZoneID,
 	FieldColor,
 	FieldRoomIds,
+	FieldResets,
+	FieldResetIntervalSecs,
+	FieldLastResetAt,
+	FieldLastResetReport,
+	FieldWeather,
+	FieldCurrentWeather,
+	FieldWeatherChangedAt,
+	FieldInstance,
 }
 
 var (
@@ -82,6 +106,8 @@ var (
 	DefaultWorldID string
 	// DefaultMinLevel holds the default value on creation for the "min_level" field.
 	DefaultMinLevel int
+	// DefaultResetIntervalSecs holds the default value on creation for the "reset_interval_secs" field.
+	DefaultResetIntervalSecs int
 )
 
 // OrderOption defines the ordering options for the Zone queries.
@@ -122,6 +148,26 @@ func ByColor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldColor, opts...).ToFunc()
 }
 
+// ByResetIntervalSecs orders the results by the reset_interval_secs field.
+func ByResetIntervalSecs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResetIntervalSecs, opts...).ToFunc()
+}
+
+// ByLastResetAt orders the results by the last_reset_at field.
+func ByLastResetAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastResetAt, opts...).ToFunc()
+}
+
+// ByCurrentWeather orders the results by the current_weather field.
+func ByCurrentWeather(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentWeather, opts...).ToFunc()
+}
+
+// ByWeatherChangedAt orders the results by the weather_changed_at field.
+func ByWeatherChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeatherChangedAt, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
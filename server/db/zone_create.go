@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"herbst-server/db/room"
+	"herbst-server/db/schema"
 	"herbst-server/db/zone"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -102,6 +104,110 @@ func (_c *ZoneCreate) SetRoomIds(v []int) *ZoneCreate {
 	return _c
 }
 
+// SetResets sets the "resets" field.
+func (_c *ZoneCreate) SetResets(v []schema.ZoneReset) *ZoneCreate {
+	_c.mutation.SetResets(v)
+	return _c
+}
+
+// SetResetIntervalSecs sets the "reset_interval_secs" field.
+func (_c *ZoneCreate) SetResetIntervalSecs(v int) *ZoneCreate {
+	_c.mutation.SetResetIntervalSecs(v)
+	return _c
+}
+
+// SetNillableResetIntervalSecs sets the "reset_interval_secs" field if the given value is not nil.
+func (_c *ZoneCreate) SetNillableResetIntervalSecs(v *int) *ZoneCreate {
+	if v != nil {
+		_c.SetResetIntervalSecs(*v)
+	}
+	return _c
+}
+
+// SetLastResetAt sets the "last_reset_at" field.
+func (_c *ZoneCreate) SetLastResetAt(v time.Time) *ZoneCreate {
+	_c.mutation.SetLastResetAt(v)
+	return _c
+}
+
+// SetNillableLastResetAt sets the "last_reset_at" field if the given value is not nil.
+func (_c *ZoneCreate) SetNillableLastResetAt(v *time.Time) *ZoneCreate {
+	if v != nil {
+		_c.SetLastResetAt(*v)
+	}
+	return _c
+}
+
+// SetLastResetReport sets the "last_reset_report" field.
+func (_c *ZoneCreate) SetLastResetReport(v schema.ZoneResetReport) *ZoneCreate {
+	_c.mutation.SetLastResetReport(v)
+	return _c
+}
+
+// SetNillableLastResetReport sets the "last_reset_report" field if the given value is not nil.
+func (_c *ZoneCreate) SetNillableLastResetReport(v *schema.ZoneResetReport) *ZoneCreate {
+	if v != nil {
+		_c.SetLastResetReport(*v)
+	}
+	return _c
+}
+
+// SetWeather sets the "weather" field.
+func (_c *ZoneCreate) SetWeather(v schema.ZoneWeather) *ZoneCreate {
+	_c.mutation.SetWeather(v)
+	return _c
+}
+
+// SetNillableWeather sets the "weather" field if the given value is not nil.
+func (_c *ZoneCreate) SetNillableWeather(v *schema.ZoneWeather) *ZoneCreate {
+	if v != nil {
+		_c.SetWeather(*v)
+	}
+	return _c
+}
+
+// SetCurrentWeather sets the "current_weather" field.
+func (_c *ZoneCreate) SetCurrentWeather(v string) *ZoneCreate {
+	_c.mutation.SetCurrentWeather(v)
+	return _c
+}
+
+// SetNillableCurrentWeather sets the "current_weather" field if the given value is not nil.
+func (_c *ZoneCreate) SetNillableCurrentWeather(v *string) *ZoneCreate {
+	if v != nil {
+		_c.SetCurrentWeather(*v)
+	}
+	return _c
+}
+
+// SetWeatherChangedAt sets the "weather_changed_at" field.
+func (_c *ZoneCreate) SetWeatherChangedAt(v time.Time) *ZoneCreate {
+	_c.mutation.SetWeatherChangedAt(v)
+	return _c
+}
+
+// SetNillableWeatherChangedAt sets the "weather_changed_at" field if the given value is not nil.
+func (_c *ZoneCreate) SetNillableWeatherChangedAt(v *time.Time) *ZoneCreate {
+	if v != nil {
+		_c.SetWeatherChangedAt(*v)
+	}
+	return _c
+}
+
+// SetInstance sets the "instance" field.
+func (_c *ZoneCreate) SetInstance(v schema.ZoneInstancing) *ZoneCreate {
+	_c.mutation.SetInstance(v)
+	return _c
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (_c *ZoneCreate) SetNillableInstance(v *schema.ZoneInstancing) *ZoneCreate {
+	if v != nil {
+		_c.SetInstance(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ZoneCreate) SetID(v string) *ZoneCreate {
 	_c.mutation.SetID(v)
@@ -200,6 +306,10 @@ func (_c *ZoneCreate) defaults() {
 		v := zone.DefaultMinLevel
 		_c.mutation.SetMinLevel(v)
 	}
+	if _, ok := _c.mutation.ResetIntervalSecs(); !ok {
+		v := zone.DefaultResetIntervalSecs
+		_c.mutation.SetResetIntervalSecs(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -213,6 +323,9 @@ func (_c *ZoneCreate) check() error {
 	if _, ok := _c.mutation.MinLevel(); !ok {
 		return &ValidationError{Name: "min_level", err: errors.New(`db: missing required field "Zone.min_level"`)}
 	}
+	if _, ok := _c.mutation.ResetIntervalSecs(); !ok {
+		return &ValidationError{Name: "reset_interval_secs", err: errors.New(`db: missing required field "Zone.reset_interval_secs"`)}
+	}
 	return nil
 }
 
@@ -272,6 +385,38 @@ func (_c *ZoneCreate) createSpec() (*Zone, *sqlgraph.CreateSpec) {
 		_spec.SetField(zone.FieldRoomIds, field.TypeJSON, value)
 		_node.RoomIds = value
 	}
+	if value, ok := _c.mutation.Resets(); ok {
+		_spec.SetField(zone.FieldResets, field.TypeJSON, value)
+		_node.Resets = value
+	}
+	if value, ok := _c.mutation.ResetIntervalSecs(); ok {
+		_spec.SetField(zone.FieldResetIntervalSecs, field.TypeInt, value)
+		_node.ResetIntervalSecs = value
+	}
+	if value, ok := _c.mutation.LastResetAt(); ok {
+		_spec.SetField(zone.FieldLastResetAt, field.TypeTime, value)
+		_node.LastResetAt = &value
+	}
+	if value, ok := _c.mutation.LastResetReport(); ok {
+		_spec.SetField(zone.FieldLastResetReport, field.TypeJSON, value)
+		_node.LastResetReport = value
+	}
+	if value, ok := _c.mutation.Weather(); ok {
+		_spec.SetField(zone.FieldWeather, field.TypeJSON, value)
+		_node.Weather = value
+	}
+	if value, ok := _c.mutation.CurrentWeather(); ok {
+		_spec.SetField(zone.FieldCurrentWeather, field.TypeString, value)
+		_node.CurrentWeather = value
+	}
+	if value, ok := _c.mutation.WeatherChangedAt(); ok {
+		_spec.SetField(zone.FieldWeatherChangedAt, field.TypeTime, value)
+		_node.WeatherChangedAt = &value
+	}
+	if value, ok := _c.mutation.Instance(); ok {
+		_spec.SetField(zone.FieldInstance, field.TypeJSON, value)
+		_node.Instance = value
+	}
 	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"herbst-server/db/predicate"
 	"herbst-server/db/room"
+	"herbst-server/db/schema"
 	"herbst-server/db/zone"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -156,6 +158,165 @@ func (_u *ZoneUpdate) ClearRoomIds() *ZoneUpdate {
 	return _u
 }
 
+// SetResets sets the "resets" field.
+func (_u *ZoneUpdate) SetResets(v []schema.ZoneReset) *ZoneUpdate {
+	_u.mutation.SetResets(v)
+	return _u
+}
+
+// AppendResets appends value to the "resets" field.
+func (_u *ZoneUpdate) AppendResets(v []schema.ZoneReset) *ZoneUpdate {
+	_u.mutation.AppendResets(v)
+	return _u
+}
+
+// ClearResets clears the value of the "resets" field.
+func (_u *ZoneUpdate) ClearResets() *ZoneUpdate {
+	_u.mutation.ClearResets()
+	return _u
+}
+
+// SetResetIntervalSecs sets the "reset_interval_secs" field.
+func (_u *ZoneUpdate) SetResetIntervalSecs(v int) *ZoneUpdate {
+	_u.mutation.ResetResetIntervalSecs()
+	_u.mutation.SetResetIntervalSecs(v)
+	return _u
+}
+
+// SetNillableResetIntervalSecs sets the "reset_interval_secs" field if the given value is not nil.
+func (_u *ZoneUpdate) SetNillableResetIntervalSecs(v *int) *ZoneUpdate {
+	if v != nil {
+		_u.SetResetIntervalSecs(*v)
+	}
+	return _u
+}
+
+// AddResetIntervalSecs adds value to the "reset_interval_secs" field.
+func (_u *ZoneUpdate) AddResetIntervalSecs(v int) *ZoneUpdate {
+	_u.mutation.AddResetIntervalSecs(v)
+	return _u
+}
+
+// SetLastResetAt sets the "last_reset_at" field.
+func (_u *ZoneUpdate) SetLastResetAt(v time.Time) *ZoneUpdate {
+	_u.mutation.SetLastResetAt(v)
+	return _u
+}
+
+// SetNillableLastResetAt sets the "last_reset_at" field if the given value is not nil.
+func (_u *ZoneUpdate) SetNillableLastResetAt(v *time.Time) *ZoneUpdate {
+	if v != nil {
+		_u.SetLastResetAt(*v)
+	}
+	return _u
+}
+
+// ClearLastResetAt clears the value of the "last_reset_at" field.
+func (_u *ZoneUpdate) ClearLastResetAt() *ZoneUpdate {
+	_u.mutation.ClearLastResetAt()
+	return _u
+}
+
+// SetLastResetReport sets the "last_reset_report" field.
+func (_u *ZoneUpdate) SetLastResetReport(v schema.ZoneResetReport) *ZoneUpdate {
+	_u.mutation.SetLastResetReport(v)
+	return _u
+}
+
+// SetNillableLastResetReport sets the "last_reset_report" field if the given value is not nil.
+func (_u *ZoneUpdate) SetNillableLastResetReport(v *schema.ZoneResetReport) *ZoneUpdate {
+	if v != nil {
+		_u.SetLastResetReport(*v)
+	}
+	return _u
+}
+
+// ClearLastResetReport clears the value of the "last_reset_report" field.
+func (_u *ZoneUpdate) ClearLastResetReport() *ZoneUpdate {
+	_u.mutation.ClearLastResetReport()
+	return _u
+}
+
+// SetWeather sets the "weather" field.
+func (_u *ZoneUpdate) SetWeather(v schema.ZoneWeather) *ZoneUpdate {
+	_u.mutation.SetWeather(v)
+	return _u
+}
+
+// SetNillableWeather sets the "weather" field if the given value is not nil.
+func (_u *ZoneUpdate) SetNillableWeather(v *schema.ZoneWeather) *ZoneUpdate {
+	if v != nil {
+		_u.SetWeather(*v)
+	}
+	return _u
+}
+
+// ClearWeather clears the value of the "weather" field.
+func (_u *ZoneUpdate) ClearWeather() *ZoneUpdate {
+	_u.mutation.ClearWeather()
+	return _u
+}
+
+// SetCurrentWeather sets the "current_weather" field.
+func (_u *ZoneUpdate) SetCurrentWeather(v string) *ZoneUpdate {
+	_u.mutation.SetCurrentWeather(v)
+	return _u
+}
+
+// SetNillableCurrentWeather sets the "current_weather" field if the given value is not nil.
+func (_u *ZoneUpdate) SetNillableCurrentWeather(v *string) *ZoneUpdate {
+	if v != nil {
+		_u.SetCurrentWeather(*v)
+	}
+	return _u
+}
+
+// ClearCurrentWeather clears the value of the "current_weather" field.
+func (_u *ZoneUpdate) ClearCurrentWeather() *ZoneUpdate {
+	_u.mutation.ClearCurrentWeather()
+	return _u
+}
+
+// SetWeatherChangedAt sets the "weather_changed_at" field.
+func (_u *ZoneUpdate) SetWeatherChangedAt(v time.Time) *ZoneUpdate {
+	_u.mutation.SetWeatherChangedAt(v)
+	return _u
+}
+
+// SetNillableWeatherChangedAt sets the "weather_changed_at" field if the given value is not nil.
+func (_u *ZoneUpdate) SetNillableWeatherChangedAt(v *time.Time) *ZoneUpdate {
+	if v != nil {
+		_u.SetWeatherChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearWeatherChangedAt clears the value of the "weather_changed_at" field.
+func (_u *ZoneUpdate) ClearWeatherChangedAt() *ZoneUpdate {
+	_u.mutation.ClearWeatherChangedAt()
+	return _u
+}
+
+// SetInstance sets the "instance" field.
+func (_u *ZoneUpdate) SetInstance(v schema.ZoneInstancing) *ZoneUpdate {
+	_u.mutation.SetInstance(v)
+	return _u
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (_u *ZoneUpdate) SetNillableInstance(v *schema.ZoneInstancing) *ZoneUpdate {
+	if v != nil {
+		_u.SetInstance(*v)
+	}
+	return _u
+}
+
+// ClearInstance clears the value of the "instance" field.
+func (_u *ZoneUpdate) ClearInstance() *ZoneUpdate {
+	_u.mutation.ClearInstance()
+	return _u
+}
+
 // SetParentID sets the "parent" edge to the Zone entity by ID.
 func (_u *ZoneUpdate) SetParentID(id string) *ZoneUpdate {
 	_u.mutation.SetParentID(id)
@@ -329,6 +490,59 @@ func (_u *ZoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RoomIdsCleared() {
 		_spec.ClearField(zone.FieldRoomIds, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Resets(); ok {
+		_spec.SetField(zone.FieldResets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, zone.FieldResets, value)
+		})
+	}
+	if _u.mutation.ResetsCleared() {
+		_spec.ClearField(zone.FieldResets, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ResetIntervalSecs(); ok {
+		_spec.SetField(zone.FieldResetIntervalSecs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResetIntervalSecs(); ok {
+		_spec.AddField(zone.FieldResetIntervalSecs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastResetAt(); ok {
+		_spec.SetField(zone.FieldLastResetAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastResetAtCleared() {
+		_spec.ClearField(zone.FieldLastResetAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastResetReport(); ok {
+		_spec.SetField(zone.FieldLastResetReport, field.TypeJSON, value)
+	}
+	if _u.mutation.LastResetReportCleared() {
+		_spec.ClearField(zone.FieldLastResetReport, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Weather(); ok {
+		_spec.SetField(zone.FieldWeather, field.TypeJSON, value)
+	}
+	if _u.mutation.WeatherCleared() {
+		_spec.ClearField(zone.FieldWeather, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CurrentWeather(); ok {
+		_spec.SetField(zone.FieldCurrentWeather, field.TypeString, value)
+	}
+	if _u.mutation.CurrentWeatherCleared() {
+		_spec.ClearField(zone.FieldCurrentWeather, field.TypeString)
+	}
+	if value, ok := _u.mutation.WeatherChangedAt(); ok {
+		_spec.SetField(zone.FieldWeatherChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.WeatherChangedAtCleared() {
+		_spec.ClearField(zone.FieldWeatherChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Instance(); ok {
+		_spec.SetField(zone.FieldInstance, field.TypeJSON, value)
+	}
+	if _u.mutation.InstanceCleared() {
+		_spec.ClearField(zone.FieldInstance, field.TypeJSON)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -595,6 +809,165 @@ func (_u *ZoneUpdateOne) ClearRoomIds() *ZoneUpdateOne {
 	return _u
 }
 
+// SetResets sets the "resets" field.
+func (_u *ZoneUpdateOne) SetResets(v []schema.ZoneReset) *ZoneUpdateOne {
+	_u.mutation.SetResets(v)
+	return _u
+}
+
+// AppendResets appends value to the "resets" field.
+func (_u *ZoneUpdateOne) AppendResets(v []schema.ZoneReset) *ZoneUpdateOne {
+	_u.mutation.AppendResets(v)
+	return _u
+}
+
+// ClearResets clears the value of the "resets" field.
+func (_u *ZoneUpdateOne) ClearResets() *ZoneUpdateOne {
+	_u.mutation.ClearResets()
+	return _u
+}
+
+// SetResetIntervalSecs sets the "reset_interval_secs" field.
+func (_u *ZoneUpdateOne) SetResetIntervalSecs(v int) *ZoneUpdateOne {
+	_u.mutation.ResetResetIntervalSecs()
+	_u.mutation.SetResetIntervalSecs(v)
+	return _u
+}
+
+// SetNillableResetIntervalSecs sets the "reset_interval_secs" field if the given value is not nil.
+func (_u *ZoneUpdateOne) SetNillableResetIntervalSecs(v *int) *ZoneUpdateOne {
+	if v != nil {
+		_u.SetResetIntervalSecs(*v)
+	}
+	return _u
+}
+
+// AddResetIntervalSecs adds value to the "reset_interval_secs" field.
+func (_u *ZoneUpdateOne) AddResetIntervalSecs(v int) *ZoneUpdateOne {
+	_u.mutation.AddResetIntervalSecs(v)
+	return _u
+}
+
+// SetLastResetAt sets the "last_reset_at" field.
+func (_u *ZoneUpdateOne) SetLastResetAt(v time.Time) *ZoneUpdateOne {
+	_u.mutation.SetLastResetAt(v)
+	return _u
+}
+
+// SetNillableLastResetAt sets the "last_reset_at" field if the given value is not nil.
+func (_u *ZoneUpdateOne) SetNillableLastResetAt(v *time.Time) *ZoneUpdateOne {
+	if v != nil {
+		_u.SetLastResetAt(*v)
+	}
+	return _u
+}
+
+// ClearLastResetAt clears the value of the "last_reset_at" field.
+func (_u *ZoneUpdateOne) ClearLastResetAt() *ZoneUpdateOne {
+	_u.mutation.ClearLastResetAt()
+	return _u
+}
+
+// SetLastResetReport sets the "last_reset_report" field.
+func (_u *ZoneUpdateOne) SetLastResetReport(v schema.ZoneResetReport) *ZoneUpdateOne {
+	_u.mutation.SetLastResetReport(v)
+	return _u
+}
+
+// SetNillableLastResetReport sets the "last_reset_report" field if the given value is not nil.
+func (_u *ZoneUpdateOne) SetNillableLastResetReport(v *schema.ZoneResetReport) *ZoneUpdateOne {
+	if v != nil {
+		_u.SetLastResetReport(*v)
+	}
+	return _u
+}
+
+// ClearLastResetReport clears the value of the "last_reset_report" field.
+func (_u *ZoneUpdateOne) ClearLastResetReport() *ZoneUpdateOne {
+	_u.mutation.ClearLastResetReport()
+	return _u
+}
+
+// SetWeather sets the "weather" field.
+func (_u *ZoneUpdateOne) SetWeather(v schema.ZoneWeather) *ZoneUpdateOne {
+	_u.mutation.SetWeather(v)
+	return _u
+}
+
+// SetNillableWeather sets the "weather" field if the given value is not nil.
+func (_u *ZoneUpdateOne) SetNillableWeather(v *schema.ZoneWeather) *ZoneUpdateOne {
+	if v != nil {
+		_u.SetWeather(*v)
+	}
+	return _u
+}
+
+// ClearWeather clears the value of the "weather" field.
+func (_u *ZoneUpdateOne) ClearWeather() *ZoneUpdateOne {
+	_u.mutation.ClearWeather()
+	return _u
+}
+
+// SetCurrentWeather sets the "current_weather" field.
+func (_u *ZoneUpdateOne) SetCurrentWeather(v string) *ZoneUpdateOne {
+	_u.mutation.SetCurrentWeather(v)
+	return _u
+}
+
+// SetNillableCurrentWeather sets the "current_weather" field if the given value is not nil.
+func (_u *ZoneUpdateOne) SetNillableCurrentWeather(v *string) *ZoneUpdateOne {
+	if v != nil {
+		_u.SetCurrentWeather(*v)
+	}
+	return _u
+}
+
+// ClearCurrentWeather clears the value of the "current_weather" field.
+func (_u *ZoneUpdateOne) ClearCurrentWeather() *ZoneUpdateOne {
+	_u.mutation.ClearCurrentWeather()
+	return _u
+}
+
+// SetWeatherChangedAt sets the "weather_changed_at" field.
+func (_u *ZoneUpdateOne) SetWeatherChangedAt(v time.Time) *ZoneUpdateOne {
+	_u.mutation.SetWeatherChangedAt(v)
+	return _u
+}
+
+// SetNillableWeatherChangedAt sets the "weather_changed_at" field if the given value is not nil.
+func (_u *ZoneUpdateOne) SetNillableWeatherChangedAt(v *time.Time) *ZoneUpdateOne {
+	if v != nil {
+		_u.SetWeatherChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearWeatherChangedAt clears the value of the "weather_changed_at" field.
+func (_u *ZoneUpdateOne) ClearWeatherChangedAt() *ZoneUpdateOne {
+	_u.mutation.ClearWeatherChangedAt()
+	return _u
+}
+
+// SetInstance sets the "instance" field.
+func (_u *ZoneUpdateOne) SetInstance(v schema.ZoneInstancing) *ZoneUpdateOne {
+	_u.mutation.SetInstance(v)
+	return _u
+}
+
+// SetNillableInstance sets the "instance" field if the given value is not nil.
+func (_u *ZoneUpdateOne) SetNillableInstance(v *schema.ZoneInstancing) *ZoneUpdateOne {
+	if v != nil {
+		_u.SetInstance(*v)
+	}
+	return _u
+}
+
+// ClearInstance clears the value of the "instance" field.
+func (_u *ZoneUpdateOne) ClearInstance() *ZoneUpdateOne {
+	_u.mutation.ClearInstance()
+	return _u
+}
+
 // SetParentID sets the "parent" edge to the Zone entity by ID.
 func (_u *ZoneUpdateOne) SetParentID(id string) *ZoneUpdateOne {
 	_u.mutation.SetParentID(id)
@@ -798,6 +1171,59 @@ func (_u *ZoneUpdateOne) sqlSave(ctx context.Context) (_node *Zone, err error) {
 	if _u.mutation.RoomIdsCleared() {
 		_spec.ClearField(zone.FieldRoomIds, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Resets(); ok {
+		_spec.SetField(zone.FieldResets, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResets(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, zone.FieldResets, value)
+		})
+	}
+	if _u.mutation.ResetsCleared() {
+		_spec.ClearField(zone.FieldResets, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ResetIntervalSecs(); ok {
+		_spec.SetField(zone.FieldResetIntervalSecs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResetIntervalSecs(); ok {
+		_spec.AddField(zone.FieldResetIntervalSecs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastResetAt(); ok {
+		_spec.SetField(zone.FieldLastResetAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastResetAtCleared() {
+		_spec.ClearField(zone.FieldLastResetAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastResetReport(); ok {
+		_spec.SetField(zone.FieldLastResetReport, field.TypeJSON, value)
+	}
+	if _u.mutation.LastResetReportCleared() {
+		_spec.ClearField(zone.FieldLastResetReport, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Weather(); ok {
+		_spec.SetField(zone.FieldWeather, field.TypeJSON, value)
+	}
+	if _u.mutation.WeatherCleared() {
+		_spec.ClearField(zone.FieldWeather, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CurrentWeather(); ok {
+		_spec.SetField(zone.FieldCurrentWeather, field.TypeString, value)
+	}
+	if _u.mutation.CurrentWeatherCleared() {
+		_spec.ClearField(zone.FieldCurrentWeather, field.TypeString)
+	}
+	if value, ok := _u.mutation.WeatherChangedAt(); ok {
+		_spec.SetField(zone.FieldWeatherChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.WeatherChangedAtCleared() {
+		_spec.ClearField(zone.FieldWeatherChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Instance(); ok {
+		_spec.SetField(zone.FieldInstance, field.TypeJSON, value)
+	}
+	if _u.mutation.InstanceCleared() {
+		_spec.ClearField(zone.FieldInstance, field.TypeJSON)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
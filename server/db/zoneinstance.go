@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"herbst-server/db/zoneinstance"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ZoneInstance is the model entity for the ZoneInstance schema.
+type ZoneInstance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Instanced zone this is a copy of
+	ZoneID string `json:"zone_id,omitempty"`
+	// Character who opened the copy
+	OwnerID int `json:"owner_id,omitempty"`
+	// Party the copy belongs to; nil for a solo copy
+	PartyID *int `json:"party_id,omitempty"`
+	// Zone room ID -> copy room ID
+	RoomMap map[int]int `json:"room_map,omitempty"`
+	// Zone item ID -> copy item ID, so reset scripts can find copied containers
+	ItemMap map[int]int `json:"item_map,omitempty"`
+	// Copy room players arrive in
+	EntryRoomID int `json:"entry_room_id,omitempty"`
+	// Room players are sent back to when the copy closes
+	ReturnRoomID int `json:"return_room_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// LastResetAt holds the value of the "last_reset_at" field.
+	LastResetAt *time.Time `json:"last_reset_at,omitempty"`
+	// When the last player left; nil while anyone is inside
+	EmptySince   *time.Time `json:"empty_since,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ZoneInstance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case zoneinstance.FieldRoomMap, zoneinstance.FieldItemMap:
+			values[i] = new([]byte)
+		case zoneinstance.FieldID, zoneinstance.FieldOwnerID, zoneinstance.FieldPartyID, zoneinstance.FieldEntryRoomID, zoneinstance.FieldReturnRoomID:
+			values[i] = new(sql.NullInt64)
+		case zoneinstance.FieldZoneID:
+			values[i] = new(sql.NullString)
+		case zoneinstance.FieldCreatedAt, zoneinstance.FieldExpiresAt, zoneinstance.FieldLastResetAt, zoneinstance.FieldEmptySince:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ZoneInstance fields.
+func (_m *ZoneInstance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case zoneinstance.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case zoneinstance.FieldZoneID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field zone_id", values[i])
+			} else if value.Valid {
+				_m.ZoneID = value.String
+			}
+		case zoneinstance.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = int(value.Int64)
+			}
+		case zoneinstance.FieldPartyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field party_id", values[i])
+			} else if value.Valid {
+				_m.PartyID = new(int)
+				*_m.PartyID = int(value.Int64)
+			}
+		case zoneinstance.FieldRoomMap:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field room_map", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RoomMap); err != nil {
+					return fmt.Errorf("unmarshal field room_map: %w", err)
+				}
+			}
+		case zoneinstance.FieldItemMap:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field item_map", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ItemMap); err != nil {
+					return fmt.Errorf("unmarshal field item_map: %w", err)
+				}
+			}
+		case zoneinstance.FieldEntryRoomID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_room_id", values[i])
+			} else if value.Valid {
+				_m.EntryRoomID = int(value.Int64)
+			}
+		case zoneinstance.FieldReturnRoomID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field return_room_id", values[i])
+			} else if value.Valid {
+				_m.ReturnRoomID = int(value.Int64)
+			}
+		case zoneinstance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case zoneinstance.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case zoneinstance.FieldLastResetAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_reset_at", values[i])
+			} else if value.Valid {
+				_m.LastResetAt = new(time.Time)
+				*_m.LastResetAt = value.Time
+			}
+		case zoneinstance.FieldEmptySince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field empty_since", values[i])
+			} else if value.Valid {
+				_m.EmptySince = new(time.Time)
+				*_m.EmptySince = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ZoneInstance.
+// This includes values selected through modifiers, order, etc.
+func (_m *ZoneInstance) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ZoneInstance.
+// Note that you need to call ZoneInstance.Unwrap() before calling this method if this ZoneInstance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ZoneInstance) Update() *ZoneInstanceUpdateOne {
+	return NewZoneInstanceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ZoneInstance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ZoneInstance) Unwrap() *ZoneInstance {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ZoneInstance is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ZoneInstance) String() string {
+	var builder strings.Builder
+	builder.WriteString("ZoneInstance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("zone_id=")
+	builder.WriteString(_m.ZoneID)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerID))
+	builder.WriteString(", ")
+	if v := _m.PartyID; v != nil {
+		builder.WriteString("party_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("room_map=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoomMap))
+	builder.WriteString(", ")
+	builder.WriteString("item_map=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemMap))
+	builder.WriteString(", ")
+	builder.WriteString("entry_room_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntryRoomID))
+	builder.WriteString(", ")
+	builder.WriteString("return_room_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReturnRoomID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.LastResetAt; v != nil {
+		builder.WriteString("last_reset_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EmptySince; v != nil {
+		builder.WriteString("empty_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ZoneInstances is a parsable slice of ZoneInstance.
+type ZoneInstances []*ZoneInstance
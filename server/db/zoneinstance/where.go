@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package zoneinstance
+
+import (
+	"herbst-server/db/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldID, id))
+}
+
+// ZoneID applies equality check predicate on the "zone_id" field. It's identical to ZoneIDEQ.
+func ZoneID(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldZoneID, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// PartyID applies equality check predicate on the "party_id" field. It's identical to PartyIDEQ.
+func PartyID(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldPartyID, v))
+}
+
+// EntryRoomID applies equality check predicate on the "entry_room_id" field. It's identical to EntryRoomIDEQ.
+func EntryRoomID(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldEntryRoomID, v))
+}
+
+// ReturnRoomID applies equality check predicate on the "return_room_id" field. It's identical to ReturnRoomIDEQ.
+func ReturnRoomID(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldReturnRoomID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// LastResetAt applies equality check predicate on the "last_reset_at" field. It's identical to LastResetAtEQ.
+func LastResetAt(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldLastResetAt, v))
+}
+
+// EmptySince applies equality check predicate on the "empty_since" field. It's identical to EmptySinceEQ.
+func EmptySince(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldEmptySince, v))
+}
+
+// ZoneIDEQ applies the EQ predicate on the "zone_id" field.
+func ZoneIDEQ(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldZoneID, v))
+}
+
+// ZoneIDNEQ applies the NEQ predicate on the "zone_id" field.
+func ZoneIDNEQ(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldZoneID, v))
+}
+
+// ZoneIDIn applies the In predicate on the "zone_id" field.
+func ZoneIDIn(vs ...string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldZoneID, vs...))
+}
+
+// ZoneIDNotIn applies the NotIn predicate on the "zone_id" field.
+func ZoneIDNotIn(vs ...string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldZoneID, vs...))
+}
+
+// ZoneIDGT applies the GT predicate on the "zone_id" field.
+func ZoneIDGT(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldZoneID, v))
+}
+
+// ZoneIDGTE applies the GTE predicate on the "zone_id" field.
+func ZoneIDGTE(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldZoneID, v))
+}
+
+// ZoneIDLT applies the LT predicate on the "zone_id" field.
+func ZoneIDLT(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldZoneID, v))
+}
+
+// ZoneIDLTE applies the LTE predicate on the "zone_id" field.
+func ZoneIDLTE(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldZoneID, v))
+}
+
+// ZoneIDContains applies the Contains predicate on the "zone_id" field.
+func ZoneIDContains(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldContains(FieldZoneID, v))
+}
+
+// ZoneIDHasPrefix applies the HasPrefix predicate on the "zone_id" field.
+func ZoneIDHasPrefix(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldHasPrefix(FieldZoneID, v))
+}
+
+// ZoneIDHasSuffix applies the HasSuffix predicate on the "zone_id" field.
+func ZoneIDHasSuffix(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldHasSuffix(FieldZoneID, v))
+}
+
+// ZoneIDEqualFold applies the EqualFold predicate on the "zone_id" field.
+func ZoneIDEqualFold(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEqualFold(FieldZoneID, v))
+}
+
+// ZoneIDContainsFold applies the ContainsFold predicate on the "zone_id" field.
+func ZoneIDContainsFold(v string) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldContainsFold(FieldZoneID, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// PartyIDEQ applies the EQ predicate on the "party_id" field.
+func PartyIDEQ(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldPartyID, v))
+}
+
+// PartyIDNEQ applies the NEQ predicate on the "party_id" field.
+func PartyIDNEQ(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldPartyID, v))
+}
+
+// PartyIDIn applies the In predicate on the "party_id" field.
+func PartyIDIn(vs ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldPartyID, vs...))
+}
+
+// PartyIDNotIn applies the NotIn predicate on the "party_id" field.
+func PartyIDNotIn(vs ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldPartyID, vs...))
+}
+
+// PartyIDGT applies the GT predicate on the "party_id" field.
+func PartyIDGT(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldPartyID, v))
+}
+
+// PartyIDGTE applies the GTE predicate on the "party_id" field.
+func PartyIDGTE(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldPartyID, v))
+}
+
+// PartyIDLT applies the LT predicate on the "party_id" field.
+func PartyIDLT(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldPartyID, v))
+}
+
+// PartyIDLTE applies the LTE predicate on the "party_id" field.
+func PartyIDLTE(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldPartyID, v))
+}
+
+// PartyIDIsNil applies the IsNil predicate on the "party_id" field.
+func PartyIDIsNil() predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIsNull(FieldPartyID))
+}
+
+// PartyIDNotNil applies the NotNil predicate on the "party_id" field.
+func PartyIDNotNil() predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotNull(FieldPartyID))
+}
+
+// ItemMapIsNil applies the IsNil predicate on the "item_map" field.
+func ItemMapIsNil() predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIsNull(FieldItemMap))
+}
+
+// ItemMapNotNil applies the NotNil predicate on the "item_map" field.
+func ItemMapNotNil() predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotNull(FieldItemMap))
+}
+
+// EntryRoomIDEQ applies the EQ predicate on the "entry_room_id" field.
+func EntryRoomIDEQ(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldEntryRoomID, v))
+}
+
+// EntryRoomIDNEQ applies the NEQ predicate on the "entry_room_id" field.
+func EntryRoomIDNEQ(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldEntryRoomID, v))
+}
+
+// EntryRoomIDIn applies the In predicate on the "entry_room_id" field.
+func EntryRoomIDIn(vs ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldEntryRoomID, vs...))
+}
+
+// EntryRoomIDNotIn applies the NotIn predicate on the "entry_room_id" field.
+func EntryRoomIDNotIn(vs ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldEntryRoomID, vs...))
+}
+
+// EntryRoomIDGT applies the GT predicate on the "entry_room_id" field.
+func EntryRoomIDGT(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldEntryRoomID, v))
+}
+
+// EntryRoomIDGTE applies the GTE predicate on the "entry_room_id" field.
+func EntryRoomIDGTE(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldEntryRoomID, v))
+}
+
+// EntryRoomIDLT applies the LT predicate on the "entry_room_id" field.
+func EntryRoomIDLT(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldEntryRoomID, v))
+}
+
+// EntryRoomIDLTE applies the LTE predicate on the "entry_room_id" field.
+func EntryRoomIDLTE(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldEntryRoomID, v))
+}
+
+// ReturnRoomIDEQ applies the EQ predicate on the "return_room_id" field.
+func ReturnRoomIDEQ(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldReturnRoomID, v))
+}
+
+// ReturnRoomIDNEQ applies the NEQ predicate on the "return_room_id" field.
+func ReturnRoomIDNEQ(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldReturnRoomID, v))
+}
+
+// ReturnRoomIDIn applies the In predicate on the "return_room_id" field.
+func ReturnRoomIDIn(vs ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldReturnRoomID, vs...))
+}
+
+// ReturnRoomIDNotIn applies the NotIn predicate on the "return_room_id" field.
+func ReturnRoomIDNotIn(vs ...int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldReturnRoomID, vs...))
+}
+
+// ReturnRoomIDGT applies the GT predicate on the "return_room_id" field.
+func ReturnRoomIDGT(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldReturnRoomID, v))
+}
+
+// ReturnRoomIDGTE applies the GTE predicate on the "return_room_id" field.
+func ReturnRoomIDGTE(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldReturnRoomID, v))
+}
+
+// ReturnRoomIDLT applies the LT predicate on the "return_room_id" field.
+func ReturnRoomIDLT(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldReturnRoomID, v))
+}
+
+// ReturnRoomIDLTE applies the LTE predicate on the "return_room_id" field.
+func ReturnRoomIDLTE(v int) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldReturnRoomID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// LastResetAtEQ applies the EQ predicate on the "last_reset_at" field.
+func LastResetAtEQ(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldLastResetAt, v))
+}
+
+// LastResetAtNEQ applies the NEQ predicate on the "last_reset_at" field.
+func LastResetAtNEQ(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldLastResetAt, v))
+}
+
+// LastResetAtIn applies the In predicate on the "last_reset_at" field.
+func LastResetAtIn(vs ...time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldLastResetAt, vs...))
+}
+
+// LastResetAtNotIn applies the NotIn predicate on the "last_reset_at" field.
+func LastResetAtNotIn(vs ...time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldLastResetAt, vs...))
+}
+
+// LastResetAtGT applies the GT predicate on the "last_reset_at" field.
+func LastResetAtGT(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldLastResetAt, v))
+}
+
+// LastResetAtGTE applies the GTE predicate on the "last_reset_at" field.
+func LastResetAtGTE(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldLastResetAt, v))
+}
+
+// LastResetAtLT applies the LT predicate on the "last_reset_at" field.
+func LastResetAtLT(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldLastResetAt, v))
+}
+
+// LastResetAtLTE applies the LTE predicate on the "last_reset_at" field.
+func LastResetAtLTE(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldLastResetAt, v))
+}
+
+// LastResetAtIsNil applies the IsNil predicate on the "last_reset_at" field.
+func LastResetAtIsNil() predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIsNull(FieldLastResetAt))
+}
+
+// LastResetAtNotNil applies the NotNil predicate on the "last_reset_at" field.
+func LastResetAtNotNil() predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotNull(FieldLastResetAt))
+}
+
+// EmptySinceEQ applies the EQ predicate on the "empty_since" field.
+func EmptySinceEQ(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldEQ(FieldEmptySince, v))
+}
+
+// EmptySinceNEQ applies the NEQ predicate on the "empty_since" field.
+func EmptySinceNEQ(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNEQ(FieldEmptySince, v))
+}
+
+// EmptySinceIn applies the In predicate on the "empty_since" field.
+func EmptySinceIn(vs ...time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIn(FieldEmptySince, vs...))
+}
+
+// EmptySinceNotIn applies the NotIn predicate on the "empty_since" field.
+func EmptySinceNotIn(vs ...time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotIn(FieldEmptySince, vs...))
+}
+
+// EmptySinceGT applies the GT predicate on the "empty_since" field.
+func EmptySinceGT(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGT(FieldEmptySince, v))
+}
+
+// EmptySinceGTE applies the GTE predicate on the "empty_since" field.
+func EmptySinceGTE(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldGTE(FieldEmptySince, v))
+}
+
+// EmptySinceLT applies the LT predicate on the "empty_since" field.
+func EmptySinceLT(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLT(FieldEmptySince, v))
+}
+
+// EmptySinceLTE applies the LTE predicate on the "empty_since" field.
+func EmptySinceLTE(v time.Time) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldLTE(FieldEmptySince, v))
+}
+
+// EmptySinceIsNil applies the IsNil predicate on the "empty_since" field.
+func EmptySinceIsNil() predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldIsNull(FieldEmptySince))
+}
+
+// EmptySinceNotNil applies the NotNil predicate on the "empty_since" field.
+func EmptySinceNotNil() predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.FieldNotNull(FieldEmptySince))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ZoneInstance) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ZoneInstance) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ZoneInstance) predicate.ZoneInstance {
+	return predicate.ZoneInstance(sql.NotPredicates(p))
+}
@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package zoneinstance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the zoneinstance type in the database.
+	Label = "zone_instance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldZoneID holds the string denoting the zone_id field in the database.
+	FieldZoneID = "zone_id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldPartyID holds the string denoting the party_id field in the database.
+	FieldPartyID = "party_id"
+	// FieldRoomMap holds the string denoting the room_map field in the database.
+	FieldRoomMap = "room_map"
+	// FieldItemMap holds the string denoting the item_map field in the database.
+	FieldItemMap = "item_map"
+	// FieldEntryRoomID holds the string denoting the entry_room_id field in the database.
+	FieldEntryRoomID = "entry_room_id"
+	// FieldReturnRoomID holds the string denoting the return_room_id field in the database.
+	FieldReturnRoomID = "return_room_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldLastResetAt holds the string denoting the last_reset_at field in the database.
+	FieldLastResetAt = "last_reset_at"
+	// FieldEmptySince holds the string denoting the empty_since field in the database.
+	FieldEmptySince = "empty_since"
+	// Table holds the table name of the zoneinstance in the database.
+	Table = "zone_instances"
+)
+
+// Columns holds all SQL columns for zoneinstance fields.
+var Columns = []string{
+	FieldID,
+	FieldZoneID,
+	FieldOwnerID,
+	FieldPartyID,
+	FieldRoomMap,
+	FieldItemMap,
+	FieldEntryRoomID,
+	FieldReturnRoomID,
+	FieldCreatedAt,
+	FieldExpiresAt,
+	FieldLastResetAt,
+	FieldEmptySince,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ZoneInstance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByZoneID orders the results by the zone_id field.
+func ByZoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldZoneID, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByPartyID orders the results by the party_id field.
+func ByPartyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartyID, opts...).ToFunc()
+}
+
+// ByEntryRoomID orders the results by the entry_room_id field.
+func ByEntryRoomID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntryRoomID, opts...).ToFunc()
+}
+
+// ByReturnRoomID orders the results by the return_room_id field.
+func ByReturnRoomID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturnRoomID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByLastResetAt orders the results by the last_reset_at field.
+func ByLastResetAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastResetAt, opts...).ToFunc()
+}
+
+// ByEmptySince orders the results by the empty_since field.
+func ByEmptySince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmptySince, opts...).ToFunc()
+}
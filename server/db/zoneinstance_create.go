@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/zoneinstance"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ZoneInstanceCreate is the builder for creating a ZoneInstance entity.
+type ZoneInstanceCreate struct {
+	config
+	mutation *ZoneInstanceMutation
+	hooks    []Hook
+}
+
+// SetZoneID sets the "zone_id" field.
+func (_c *ZoneInstanceCreate) SetZoneID(v string) *ZoneInstanceCreate {
+	_c.mutation.SetZoneID(v)
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *ZoneInstanceCreate) SetOwnerID(v int) *ZoneInstanceCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetPartyID sets the "party_id" field.
+func (_c *ZoneInstanceCreate) SetPartyID(v int) *ZoneInstanceCreate {
+	_c.mutation.SetPartyID(v)
+	return _c
+}
+
+// SetNillablePartyID sets the "party_id" field if the given value is not nil.
+func (_c *ZoneInstanceCreate) SetNillablePartyID(v *int) *ZoneInstanceCreate {
+	if v != nil {
+		_c.SetPartyID(*v)
+	}
+	return _c
+}
+
+// SetRoomMap sets the "room_map" field.
+func (_c *ZoneInstanceCreate) SetRoomMap(v map[int]int) *ZoneInstanceCreate {
+	_c.mutation.SetRoomMap(v)
+	return _c
+}
+
+// SetItemMap sets the "item_map" field.
+func (_c *ZoneInstanceCreate) SetItemMap(v map[int]int) *ZoneInstanceCreate {
+	_c.mutation.SetItemMap(v)
+	return _c
+}
+
+// SetEntryRoomID sets the "entry_room_id" field.
+func (_c *ZoneInstanceCreate) SetEntryRoomID(v int) *ZoneInstanceCreate {
+	_c.mutation.SetEntryRoomID(v)
+	return _c
+}
+
+// SetReturnRoomID sets the "return_room_id" field.
+func (_c *ZoneInstanceCreate) SetReturnRoomID(v int) *ZoneInstanceCreate {
+	_c.mutation.SetReturnRoomID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ZoneInstanceCreate) SetCreatedAt(v time.Time) *ZoneInstanceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ZoneInstanceCreate) SetNillableCreatedAt(v *time.Time) *ZoneInstanceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ZoneInstanceCreate) SetExpiresAt(v time.Time) *ZoneInstanceCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetLastResetAt sets the "last_reset_at" field.
+func (_c *ZoneInstanceCreate) SetLastResetAt(v time.Time) *ZoneInstanceCreate {
+	_c.mutation.SetLastResetAt(v)
+	return _c
+}
+
+// SetNillableLastResetAt sets the "last_reset_at" field if the given value is not nil.
+func (_c *ZoneInstanceCreate) SetNillableLastResetAt(v *time.Time) *ZoneInstanceCreate {
+	if v != nil {
+		_c.SetLastResetAt(*v)
+	}
+	return _c
+}
+
+// SetEmptySince sets the "empty_since" field.
+func (_c *ZoneInstanceCreate) SetEmptySince(v time.Time) *ZoneInstanceCreate {
+	_c.mutation.SetEmptySince(v)
+	return _c
+}
+
+// SetNillableEmptySince sets the "empty_since" field if the given value is not nil.
+func (_c *ZoneInstanceCreate) SetNillableEmptySince(v *time.Time) *ZoneInstanceCreate {
+	if v != nil {
+		_c.SetEmptySince(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ZoneInstanceMutation object of the builder.
+func (_c *ZoneInstanceCreate) Mutation() *ZoneInstanceMutation {
+	return _c.mutation
+}
+
+// Save creates the ZoneInstance in the database.
+func (_c *ZoneInstanceCreate) Save(ctx context.Context) (*ZoneInstance, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ZoneInstanceCreate) SaveX(ctx context.Context) *ZoneInstance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ZoneInstanceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ZoneInstanceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ZoneInstanceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := zoneinstance.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ZoneInstanceCreate) check() error {
+	if _, ok := _c.mutation.ZoneID(); !ok {
+		return &ValidationError{Name: "zone_id", err: errors.New(`db: missing required field "ZoneInstance.zone_id"`)}
+	}
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`db: missing required field "ZoneInstance.owner_id"`)}
+	}
+	if _, ok := _c.mutation.RoomMap(); !ok {
+		return &ValidationError{Name: "room_map", err: errors.New(`db: missing required field "ZoneInstance.room_map"`)}
+	}
+	if _, ok := _c.mutation.EntryRoomID(); !ok {
+		return &ValidationError{Name: "entry_room_id", err: errors.New(`db: missing required field "ZoneInstance.entry_room_id"`)}
+	}
+	if _, ok := _c.mutation.ReturnRoomID(); !ok {
+		return &ValidationError{Name: "return_room_id", err: errors.New(`db: missing required field "ZoneInstance.return_room_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ZoneInstance.created_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`db: missing required field "ZoneInstance.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *ZoneInstanceCreate) sqlSave(ctx context.Context) (*ZoneInstance, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ZoneInstanceCreate) createSpec() (*ZoneInstance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ZoneInstance{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(zoneinstance.Table, sqlgraph.NewFieldSpec(zoneinstance.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ZoneID(); ok {
+		_spec.SetField(zoneinstance.FieldZoneID, field.TypeString, value)
+		_node.ZoneID = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(zoneinstance.FieldOwnerID, field.TypeInt, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.PartyID(); ok {
+		_spec.SetField(zoneinstance.FieldPartyID, field.TypeInt, value)
+		_node.PartyID = &value
+	}
+	if value, ok := _c.mutation.RoomMap(); ok {
+		_spec.SetField(zoneinstance.FieldRoomMap, field.TypeJSON, value)
+		_node.RoomMap = value
+	}
+	if value, ok := _c.mutation.ItemMap(); ok {
+		_spec.SetField(zoneinstance.FieldItemMap, field.TypeJSON, value)
+		_node.ItemMap = value
+	}
+	if value, ok := _c.mutation.EntryRoomID(); ok {
+		_spec.SetField(zoneinstance.FieldEntryRoomID, field.TypeInt, value)
+		_node.EntryRoomID = value
+	}
+	if value, ok := _c.mutation.ReturnRoomID(); ok {
+		_spec.SetField(zoneinstance.FieldReturnRoomID, field.TypeInt, value)
+		_node.ReturnRoomID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(zoneinstance.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(zoneinstance.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.LastResetAt(); ok {
+		_spec.SetField(zoneinstance.FieldLastResetAt, field.TypeTime, value)
+		_node.LastResetAt = &value
+	}
+	if value, ok := _c.mutation.EmptySince(); ok {
+		_spec.SetField(zoneinstance.FieldEmptySince, field.TypeTime, value)
+		_node.EmptySince = &value
+	}
+	return _node, _spec
+}
+
+// ZoneInstanceCreateBulk is the builder for creating many ZoneInstance entities in bulk.
+type ZoneInstanceCreateBulk struct {
+	config
+	err      error
+	builders []*ZoneInstanceCreate
+}
+
+// Save creates the ZoneInstance entities in the database.
+func (_c *ZoneInstanceCreateBulk) Save(ctx context.Context) ([]*ZoneInstance, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ZoneInstance, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ZoneInstanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ZoneInstanceCreateBulk) SaveX(ctx context.Context) []*ZoneInstance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ZoneInstanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ZoneInstanceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"herbst-server/db/predicate"
+	"herbst-server/db/zoneinstance"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ZoneInstanceUpdate is the builder for updating ZoneInstance entities.
+type ZoneInstanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ZoneInstanceMutation
+}
+
+// Where appends a list predicates to the ZoneInstanceUpdate builder.
+func (_u *ZoneInstanceUpdate) Where(ps ...predicate.ZoneInstance) *ZoneInstanceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetZoneID sets the "zone_id" field.
+func (_u *ZoneInstanceUpdate) SetZoneID(v string) *ZoneInstanceUpdate {
+	_u.mutation.SetZoneID(v)
+	return _u
+}
+
+// SetNillableZoneID sets the "zone_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillableZoneID(v *string) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetZoneID(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *ZoneInstanceUpdate) SetOwnerID(v int) *ZoneInstanceUpdate {
+	_u.mutation.ResetOwnerID()
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillableOwnerID(v *int) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// AddOwnerID adds value to the "owner_id" field.
+func (_u *ZoneInstanceUpdate) AddOwnerID(v int) *ZoneInstanceUpdate {
+	_u.mutation.AddOwnerID(v)
+	return _u
+}
+
+// SetPartyID sets the "party_id" field.
+func (_u *ZoneInstanceUpdate) SetPartyID(v int) *ZoneInstanceUpdate {
+	_u.mutation.ResetPartyID()
+	_u.mutation.SetPartyID(v)
+	return _u
+}
+
+// SetNillablePartyID sets the "party_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillablePartyID(v *int) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetPartyID(*v)
+	}
+	return _u
+}
+
+// AddPartyID adds value to the "party_id" field.
+func (_u *ZoneInstanceUpdate) AddPartyID(v int) *ZoneInstanceUpdate {
+	_u.mutation.AddPartyID(v)
+	return _u
+}
+
+// ClearPartyID clears the value of the "party_id" field.
+func (_u *ZoneInstanceUpdate) ClearPartyID() *ZoneInstanceUpdate {
+	_u.mutation.ClearPartyID()
+	return _u
+}
+
+// SetRoomMap sets the "room_map" field.
+func (_u *ZoneInstanceUpdate) SetRoomMap(v map[int]int) *ZoneInstanceUpdate {
+	_u.mutation.SetRoomMap(v)
+	return _u
+}
+
+// SetItemMap sets the "item_map" field.
+func (_u *ZoneInstanceUpdate) SetItemMap(v map[int]int) *ZoneInstanceUpdate {
+	_u.mutation.SetItemMap(v)
+	return _u
+}
+
+// ClearItemMap clears the value of the "item_map" field.
+func (_u *ZoneInstanceUpdate) ClearItemMap() *ZoneInstanceUpdate {
+	_u.mutation.ClearItemMap()
+	return _u
+}
+
+// SetEntryRoomID sets the "entry_room_id" field.
+func (_u *ZoneInstanceUpdate) SetEntryRoomID(v int) *ZoneInstanceUpdate {
+	_u.mutation.ResetEntryRoomID()
+	_u.mutation.SetEntryRoomID(v)
+	return _u
+}
+
+// SetNillableEntryRoomID sets the "entry_room_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillableEntryRoomID(v *int) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetEntryRoomID(*v)
+	}
+	return _u
+}
+
+// AddEntryRoomID adds value to the "entry_room_id" field.
+func (_u *ZoneInstanceUpdate) AddEntryRoomID(v int) *ZoneInstanceUpdate {
+	_u.mutation.AddEntryRoomID(v)
+	return _u
+}
+
+// SetReturnRoomID sets the "return_room_id" field.
+func (_u *ZoneInstanceUpdate) SetReturnRoomID(v int) *ZoneInstanceUpdate {
+	_u.mutation.ResetReturnRoomID()
+	_u.mutation.SetReturnRoomID(v)
+	return _u
+}
+
+// SetNillableReturnRoomID sets the "return_room_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillableReturnRoomID(v *int) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetReturnRoomID(*v)
+	}
+	return _u
+}
+
+// AddReturnRoomID adds value to the "return_room_id" field.
+func (_u *ZoneInstanceUpdate) AddReturnRoomID(v int) *ZoneInstanceUpdate {
+	_u.mutation.AddReturnRoomID(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ZoneInstanceUpdate) SetCreatedAt(v time.Time) *ZoneInstanceUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillableCreatedAt(v *time.Time) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ZoneInstanceUpdate) SetExpiresAt(v time.Time) *ZoneInstanceUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillableExpiresAt(v *time.Time) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetLastResetAt sets the "last_reset_at" field.
+func (_u *ZoneInstanceUpdate) SetLastResetAt(v time.Time) *ZoneInstanceUpdate {
+	_u.mutation.SetLastResetAt(v)
+	return _u
+}
+
+// SetNillableLastResetAt sets the "last_reset_at" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillableLastResetAt(v *time.Time) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetLastResetAt(*v)
+	}
+	return _u
+}
+
+// ClearLastResetAt clears the value of the "last_reset_at" field.
+func (_u *ZoneInstanceUpdate) ClearLastResetAt() *ZoneInstanceUpdate {
+	_u.mutation.ClearLastResetAt()
+	return _u
+}
+
+// SetEmptySince sets the "empty_since" field.
+func (_u *ZoneInstanceUpdate) SetEmptySince(v time.Time) *ZoneInstanceUpdate {
+	_u.mutation.SetEmptySince(v)
+	return _u
+}
+
+// SetNillableEmptySince sets the "empty_since" field if the given value is not nil.
+func (_u *ZoneInstanceUpdate) SetNillableEmptySince(v *time.Time) *ZoneInstanceUpdate {
+	if v != nil {
+		_u.SetEmptySince(*v)
+	}
+	return _u
+}
+
+// ClearEmptySince clears the value of the "empty_since" field.
+func (_u *ZoneInstanceUpdate) ClearEmptySince() *ZoneInstanceUpdate {
+	_u.mutation.ClearEmptySince()
+	return _u
+}
+
+// Mutation returns the ZoneInstanceMutation object of the builder.
+func (_u *ZoneInstanceUpdate) Mutation() *ZoneInstanceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ZoneInstanceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ZoneInstanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ZoneInstanceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ZoneInstanceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ZoneInstanceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(zoneinstance.Table, zoneinstance.Columns, sqlgraph.NewFieldSpec(zoneinstance.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ZoneID(); ok {
+		_spec.SetField(zoneinstance.FieldZoneID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(zoneinstance.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerID(); ok {
+		_spec.AddField(zoneinstance.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PartyID(); ok {
+		_spec.SetField(zoneinstance.FieldPartyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartyID(); ok {
+		_spec.AddField(zoneinstance.FieldPartyID, field.TypeInt, value)
+	}
+	if _u.mutation.PartyIDCleared() {
+		_spec.ClearField(zoneinstance.FieldPartyID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RoomMap(); ok {
+		_spec.SetField(zoneinstance.FieldRoomMap, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.ItemMap(); ok {
+		_spec.SetField(zoneinstance.FieldItemMap, field.TypeJSON, value)
+	}
+	if _u.mutation.ItemMapCleared() {
+		_spec.ClearField(zoneinstance.FieldItemMap, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EntryRoomID(); ok {
+		_spec.SetField(zoneinstance.FieldEntryRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntryRoomID(); ok {
+		_spec.AddField(zoneinstance.FieldEntryRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReturnRoomID(); ok {
+		_spec.SetField(zoneinstance.FieldReturnRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReturnRoomID(); ok {
+		_spec.AddField(zoneinstance.FieldReturnRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(zoneinstance.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(zoneinstance.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastResetAt(); ok {
+		_spec.SetField(zoneinstance.FieldLastResetAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastResetAtCleared() {
+		_spec.ClearField(zoneinstance.FieldLastResetAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmptySince(); ok {
+		_spec.SetField(zoneinstance.FieldEmptySince, field.TypeTime, value)
+	}
+	if _u.mutation.EmptySinceCleared() {
+		_spec.ClearField(zoneinstance.FieldEmptySince, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{zoneinstance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ZoneInstanceUpdateOne is the builder for updating a single ZoneInstance entity.
+type ZoneInstanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ZoneInstanceMutation
+}
+
+// SetZoneID sets the "zone_id" field.
+func (_u *ZoneInstanceUpdateOne) SetZoneID(v string) *ZoneInstanceUpdateOne {
+	_u.mutation.SetZoneID(v)
+	return _u
+}
+
+// SetNillableZoneID sets the "zone_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillableZoneID(v *string) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetZoneID(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *ZoneInstanceUpdateOne) SetOwnerID(v int) *ZoneInstanceUpdateOne {
+	_u.mutation.ResetOwnerID()
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillableOwnerID(v *int) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// AddOwnerID adds value to the "owner_id" field.
+func (_u *ZoneInstanceUpdateOne) AddOwnerID(v int) *ZoneInstanceUpdateOne {
+	_u.mutation.AddOwnerID(v)
+	return _u
+}
+
+// SetPartyID sets the "party_id" field.
+func (_u *ZoneInstanceUpdateOne) SetPartyID(v int) *ZoneInstanceUpdateOne {
+	_u.mutation.ResetPartyID()
+	_u.mutation.SetPartyID(v)
+	return _u
+}
+
+// SetNillablePartyID sets the "party_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillablePartyID(v *int) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetPartyID(*v)
+	}
+	return _u
+}
+
+// AddPartyID adds value to the "party_id" field.
+func (_u *ZoneInstanceUpdateOne) AddPartyID(v int) *ZoneInstanceUpdateOne {
+	_u.mutation.AddPartyID(v)
+	return _u
+}
+
+// ClearPartyID clears the value of the "party_id" field.
+func (_u *ZoneInstanceUpdateOne) ClearPartyID() *ZoneInstanceUpdateOne {
+	_u.mutation.ClearPartyID()
+	return _u
+}
+
+// SetRoomMap sets the "room_map" field.
+func (_u *ZoneInstanceUpdateOne) SetRoomMap(v map[int]int) *ZoneInstanceUpdateOne {
+	_u.mutation.SetRoomMap(v)
+	return _u
+}
+
+// SetItemMap sets the "item_map" field.
+func (_u *ZoneInstanceUpdateOne) SetItemMap(v map[int]int) *ZoneInstanceUpdateOne {
+	_u.mutation.SetItemMap(v)
+	return _u
+}
+
+// ClearItemMap clears the value of the "item_map" field.
+func (_u *ZoneInstanceUpdateOne) ClearItemMap() *ZoneInstanceUpdateOne {
+	_u.mutation.ClearItemMap()
+	return _u
+}
+
+// SetEntryRoomID sets the "entry_room_id" field.
+func (_u *ZoneInstanceUpdateOne) SetEntryRoomID(v int) *ZoneInstanceUpdateOne {
+	_u.mutation.ResetEntryRoomID()
+	_u.mutation.SetEntryRoomID(v)
+	return _u
+}
+
+// SetNillableEntryRoomID sets the "entry_room_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillableEntryRoomID(v *int) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetEntryRoomID(*v)
+	}
+	return _u
+}
+
+// AddEntryRoomID adds value to the "entry_room_id" field.
+func (_u *ZoneInstanceUpdateOne) AddEntryRoomID(v int) *ZoneInstanceUpdateOne {
+	_u.mutation.AddEntryRoomID(v)
+	return _u
+}
+
+// SetReturnRoomID sets the "return_room_id" field.
+func (_u *ZoneInstanceUpdateOne) SetReturnRoomID(v int) *ZoneInstanceUpdateOne {
+	_u.mutation.ResetReturnRoomID()
+	_u.mutation.SetReturnRoomID(v)
+	return _u
+}
+
+// SetNillableReturnRoomID sets the "return_room_id" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillableReturnRoomID(v *int) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetReturnRoomID(*v)
+	}
+	return _u
+}
+
+// AddReturnRoomID adds value to the "return_room_id" field.
+func (_u *ZoneInstanceUpdateOne) AddReturnRoomID(v int) *ZoneInstanceUpdateOne {
+	_u.mutation.AddReturnRoomID(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ZoneInstanceUpdateOne) SetCreatedAt(v time.Time) *ZoneInstanceUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillableCreatedAt(v *time.Time) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ZoneInstanceUpdateOne) SetExpiresAt(v time.Time) *ZoneInstanceUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillableExpiresAt(v *time.Time) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetLastResetAt sets the "last_reset_at" field.
+func (_u *ZoneInstanceUpdateOne) SetLastResetAt(v time.Time) *ZoneInstanceUpdateOne {
+	_u.mutation.SetLastResetAt(v)
+	return _u
+}
+
+// SetNillableLastResetAt sets the "last_reset_at" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillableLastResetAt(v *time.Time) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetLastResetAt(*v)
+	}
+	return _u
+}
+
+// ClearLastResetAt clears the value of the "last_reset_at" field.
+func (_u *ZoneInstanceUpdateOne) ClearLastResetAt() *ZoneInstanceUpdateOne {
+	_u.mutation.ClearLastResetAt()
+	return _u
+}
+
+// SetEmptySince sets the "empty_since" field.
+func (_u *ZoneInstanceUpdateOne) SetEmptySince(v time.Time) *ZoneInstanceUpdateOne {
+	_u.mutation.SetEmptySince(v)
+	return _u
+}
+
+// SetNillableEmptySince sets the "empty_since" field if the given value is not nil.
+func (_u *ZoneInstanceUpdateOne) SetNillableEmptySince(v *time.Time) *ZoneInstanceUpdateOne {
+	if v != nil {
+		_u.SetEmptySince(*v)
+	}
+	return _u
+}
+
+// ClearEmptySince clears the value of the "empty_since" field.
+func (_u *ZoneInstanceUpdateOne) ClearEmptySince() *ZoneInstanceUpdateOne {
+	_u.mutation.ClearEmptySince()
+	return _u
+}
+
+// Mutation returns the ZoneInstanceMutation object of the builder.
+func (_u *ZoneInstanceUpdateOne) Mutation() *ZoneInstanceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ZoneInstanceUpdate builder.
+func (_u *ZoneInstanceUpdateOne) Where(ps ...predicate.ZoneInstance) *ZoneInstanceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ZoneInstanceUpdateOne) Select(field string, fields ...string) *ZoneInstanceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ZoneInstance entity.
+func (_u *ZoneInstanceUpdateOne) Save(ctx context.Context) (*ZoneInstance, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ZoneInstanceUpdateOne) SaveX(ctx context.Context) *ZoneInstance {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ZoneInstanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ZoneInstanceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ZoneInstanceUpdateOne) sqlSave(ctx context.Context) (_node *ZoneInstance, err error) {
+	_spec := sqlgraph.NewUpdateSpec(zoneinstance.Table, zoneinstance.Columns, sqlgraph.NewFieldSpec(zoneinstance.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "ZoneInstance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, zoneinstance.FieldID)
+		for _, f := range fields {
+			if !zoneinstance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != zoneinstance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ZoneID(); ok {
+		_spec.SetField(zoneinstance.FieldZoneID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(zoneinstance.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerID(); ok {
+		_spec.AddField(zoneinstance.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PartyID(); ok {
+		_spec.SetField(zoneinstance.FieldPartyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPartyID(); ok {
+		_spec.AddField(zoneinstance.FieldPartyID, field.TypeInt, value)
+	}
+	if _u.mutation.PartyIDCleared() {
+		_spec.ClearField(zoneinstance.FieldPartyID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RoomMap(); ok {
+		_spec.SetField(zoneinstance.FieldRoomMap, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.ItemMap(); ok {
+		_spec.SetField(zoneinstance.FieldItemMap, field.TypeJSON, value)
+	}
+	if _u.mutation.ItemMapCleared() {
+		_spec.ClearField(zoneinstance.FieldItemMap, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EntryRoomID(); ok {
+		_spec.SetField(zoneinstance.FieldEntryRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntryRoomID(); ok {
+		_spec.AddField(zoneinstance.FieldEntryRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReturnRoomID(); ok {
+		_spec.SetField(zoneinstance.FieldReturnRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReturnRoomID(); ok {
+		_spec.AddField(zoneinstance.FieldReturnRoomID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(zoneinstance.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(zoneinstance.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastResetAt(); ok {
+		_spec.SetField(zoneinstance.FieldLastResetAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastResetAtCleared() {
+		_spec.ClearField(zoneinstance.FieldLastResetAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmptySince(); ok {
+		_spec.SetField(zoneinstance.FieldEmptySince, field.TypeTime, value)
+	}
+	if _u.mutation.EmptySinceCleared() {
+		_spec.ClearField(zoneinstance.FieldEmptySince, field.TypeTime)
+	}
+	_node = &ZoneInstance{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{zoneinstance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
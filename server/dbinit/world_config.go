@@ -26,6 +26,16 @@ var defaultWorldConfig = map[string]interface{}{
 		"usage_cap_per_hour":         100,
 		"anti_grind_kill_threshold": 20,
 	},
+	"party": map[string]interface{}{
+		"xp_split":                 "equal",
+		"bonus_percent_per_member": 10,
+		"max_size":                 6,
+	},
+	// Affixes are drawn from the built-in pool unless "affixes" is set.
+	"loot": map[string]interface{}{
+		"rarity":      map[string]interface{}{"common": 70, "uncommon": 20, "rare": 8, "epic": 2},
+		"affix_count": map[string]interface{}{"common": 0, "uncommon": 1, "rare": 2, "epic": 3, "legendary": 4},
+	},
 }
 
 // SeedDefaultWorldConfig seeds the default world config for worlds that
@@ -3,90 +3,115 @@ package events
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
-	"strconv"
 	"strings"
 	"time"
 
 	"herbst-server/db"
-	"herbst-server/dblog"
+	"herbst-server/db/character"
+	"herbst-server/db/characterachievement"
+	"herbst-server/db/questprogress"
 	"herbst-server/db/systemlog"
+	"herbst-server/dblog"
+	"herbst-server/stream"
+)
+
+// Achievement criteria types.
+const (
+	CriteriaLevel           = "level"
+	CriteriaXP              = "xp"
+	CriteriaSkillLevel      = "skill_level"
+	CriteriaReclass         = "reclass"
+	CriteriaRerace          = "rerace"
+	CriteriaKills           = "kills"
+	CriteriaQuestsCompleted = "quests_completed"
+	CriteriaRoomsVisited    = "rooms_visited"
+	CriteriaItemsCrafted    = "items_crafted"
 )
 
-// AchievementSubscriber returns a subscriber that checks achievement criteria against
-// game events. It subscribes to xp.gained, level.up, skill.leveled_up, reclass, and rerace events.
-// For each event, it queries achievements whose criteria match the event type and checks
-// if the character has met the threshold. Completed achievements are logged to system_logs
-// (action="achievement.completed") to avoid duplicate awards.
+// criteriaByEvent maps each event the achievement subscriber listens to onto
+// the criteria type it can advance.
+var criteriaByEvent = map[EventType]string{
+	EventLevelUp:        CriteriaLevel,
+	EventXPGained:       CriteriaXP,
+	EventSkillLeveledUp: CriteriaSkillLevel,
+	EventReclass:        CriteriaReclass,
+	EventRerace:         CriteriaRerace,
+	EventKillCounted:    CriteriaKills,
+	EventQuestComplete:  CriteriaQuestsCompleted,
+	EventRoomVisited:    CriteriaRoomsVisited,
+	EventItemCrafted:    CriteriaItemsCrafted,
+}
+
+// AchievementCriteria is the decoded form of Achievement.Criteria.
 //
-// Criteria JSON format (stored in Achievement.Criteria field):
-//   {"type": "level", "threshold": 10}        — reach level 10
-//   {"type": "xp", "threshold": 1000}        — accumulate 1000 total XP
-//   {"type": "skill_level", "threshold": 30} — level a skill to 30
-//   {"type": "reclass", "threshold": 1}      — reclass N times
-//   {"type": "rerace", "threshold": 1}       — rerace N times
-func AchievementSubscriber(client *db.Client, logger *slog.Logger) Subscriber {
+//	{"type": "level", "threshold": 10}                                  — reach level 10
+//	{"type": "xp", "threshold": 1000}                                   — accumulate 1000 total XP
+//	{"type": "skill_level", "threshold": 30}                            — level a skill to 30
+//	{"type": "reclass", "threshold": 1}                                 — reclass N times
+//	{"type": "rerace", "threshold": 1}                                  — rerace N times
+//	{"type": "kills", "threshold": 50, "npc_template_id": "giant_rat"}  — kill 50 giant rats (any NPC if omitted)
+//	{"type": "quests_completed", "threshold": 5}                        — complete 5 quests
+//	{"type": "rooms_visited", "threshold": 25}                          — enter 25 different rooms
+//	{"type": "items_crafted", "threshold": 10, "recipe": "iron_sword"}  — craft 10 iron swords (any recipe if omitted)
+type AchievementCriteria struct {
+	Type          string `json:"type"`
+	Threshold     int    `json:"threshold"`
+	NPCTemplateID string `json:"npc_template_id,omitempty"`
+	Recipe        string `json:"recipe,omitempty"`
+}
+
+// ErrInvalidCriteria is returned by ParseAchievementCriteria.
+var ErrInvalidCriteria = errors.New("invalid achievement criteria")
+
+// ParseAchievementCriteria decodes criteria JSON and checks that the type
+// is known and the threshold positive.
+func ParseAchievementCriteria(raw string) (AchievementCriteria, error) {
+	var c AchievementCriteria
+	if err := json.Unmarshal([]byte(raw), &c); err != nil {
+		return c, fmt.Errorf("%w: %v", ErrInvalidCriteria, err)
+	}
+	known := false
+	for _, t := range criteriaByEvent {
+		if t == c.Type {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return c, fmt.Errorf("%w: unknown type %q", ErrInvalidCriteria, c.Type)
+	}
+	if c.Threshold < 1 {
+		return c, fmt.Errorf("%w: threshold must be at least 1", ErrInvalidCriteria)
+	}
+	return c, nil
+}
+
+// AchievementSubscriber returns a subscriber that advances achievement
+// progress from game events. Level, XP, skill, reclass and rerace criteria
+// read their value from the event payload; kills, quests, rooms and crafting
+// criteria recount from the character's stored data, so a replayed event
+// never double-counts.
+//
+// Progress is kept in character_achievements, one row per character and
+// achievement. When progress reaches the threshold the row is completed, the
+// achievement's XP reward is paid, its title becomes the character's title
+// if they don't have one yet, and achievement.completed is published.
+func AchievementSubscriber(client *db.Client, xpSvc XPAwarder, logger *slog.Logger) Subscriber {
 	return func(event Event) error {
 		ctx := context.Background()
 
-		charID, ok := event.Payload["character_id"].(float64)
+		characterID, ok := payloadInt(event.Payload, "character_id")
 		if !ok {
 			return nil // no character to credit
 		}
-		characterID := int(charID)
-
-		// Map event type to criteria type
-		var criteriaType string
-		switch event.Type {
-		case EventLevelUp:
-			criteriaType = "level"
-		case EventXPGained:
-			criteriaType = "xp"
-		case EventSkillLeveledUp:
-			criteriaType = "skill_level"
-		case EventReclass:
-			criteriaType = "reclass"
-		case EventRerace:
-			criteriaType = "rerace"
-		default:
+		criteriaType, ok := criteriaByEvent[event.Type]
+		if !ok {
 			return nil
 		}
 
-		// Extract the current value from the event payload
-		var currentValue int
-		switch event.Type {
-		case EventLevelUp:
-			if v, ok := event.Payload["new_level"].(float64); ok {
-				currentValue = int(v)
-			}
-		case EventXPGained:
-			if v, ok := event.Payload["total_xp"].(float64); ok {
-				currentValue = int(v)
-			} else if v, ok := event.Payload["xp"].(float64); ok {
-				currentValue = int(v)
-			}
-		case EventSkillLeveledUp:
-			if v, ok := event.Payload["new_level"].(float64); ok {
-				currentValue = int(v)
-			} else if v, ok := event.Payload["skill_level"].(float64); ok {
-				currentValue = int(v)
-			}
-		case EventReclass:
-			if v, ok := event.Payload["reclass_count"].(float64); ok {
-				currentValue = int(v)
-			} else {
-				currentValue = 1 // at least one reclass happened
-			}
-		case EventRerace:
-			if v, ok := event.Payload["rerace_count"].(float64); ok {
-				currentValue = int(v)
-			} else {
-				currentValue = 1
-			}
-		}
-
-		// Query all achievements
 		achievements, err := client.Achievement.Query().All(ctx)
 		if err != nil {
 			dblog.Error("failed to query achievements", err,
@@ -96,66 +121,51 @@ func AchievementSubscriber(client *db.Client, logger *slog.Logger) Subscriber {
 			return fmt.Errorf("query achievements: %w", err)
 		}
 
+		var char *db.Character
 		for _, ach := range achievements {
 			if ach.Criteria == "" {
 				continue
 			}
-
-			var criteria map[string]interface{}
-			if err := json.Unmarshal([]byte(ach.Criteria), &criteria); err != nil {
-				logger.Warn("achievement has invalid criteria JSON",
+			criteria, err := ParseAchievementCriteria(ach.Criteria)
+			if err != nil {
+				logger.Warn("achievement has invalid criteria",
 					"achievement_id", ach.ID,
 					"name", ach.Name,
 					"criteria", ach.Criteria,
+					"error", err,
 				)
 				continue
 			}
-
-			cType, ok := criteria["type"].(string)
-			if !ok || cType != criteriaType {
-				continue
-			}
-
-			thresholdF, ok := criteria["threshold"].(float64)
-			if !ok {
+			if criteria.Type != criteriaType {
 				continue
 			}
-			threshold := int(thresholdF)
 
-			if currentValue < threshold {
-				continue
+			if char == nil {
+				if char, err = client.Character.Get(ctx, characterID); err != nil {
+					if db.IsNotFound(err) {
+						return nil
+					}
+					return fmt.Errorf("load character: %w", err)
+				}
+				if char.IsNPC {
+					return nil
+				}
 			}
-
-			// Check if this achievement was already completed by this character
-			alreadyCompleted, err := isAchievementCompleted(ctx, client, characterID, ach.ID)
+			value, err := criteriaValue(ctx, client, char, criteria, event)
 			if err != nil {
-				logger.Warn("failed to check achievement completion status",
-					"achievement_id", ach.ID,
-					"character_id", characterID,
-					"error", err,
-				)
-				continue
+				return fmt.Errorf("achievement %d progress: %w", ach.ID, err)
 			}
-			if alreadyCompleted {
-				continue
-			}
-
-			// Mark achievement as completed by logging to system_logs
-			details := fmt.Sprintf(`{"achievement_id":%d,"name":%q,"xp_reward":%d,"criteria_type":%q,"threshold":%d,"value":%d}`,
-				ach.ID, ach.Name, ach.XpReward, criteriaType, threshold, currentValue)
 
-			_, err = client.SystemLog.Create().
-				SetAction("achievement.completed").
-				SetCharacterID(characterID).
-				SetDetails(details).
-				SetTimestamp(time.Now()).
-				Save(ctx)
+			completed, err := recordAchievementProgress(ctx, client, characterID, ach.ID, criteria.Threshold, value)
 			if err != nil {
-				dblog.Error("failed to log achievement completion", err,
+				dblog.Error("failed to record achievement progress", err,
 					slog.String("service", "events"),
 					slog.Int("achievement_id", ach.ID),
 					slog.Int("character_id", characterID),
 				)
+				return fmt.Errorf("record achievement progress: %w", err)
+			}
+			if !completed {
 				continue
 			}
 
@@ -164,32 +174,199 @@ func AchievementSubscriber(client *db.Client, logger *slog.Logger) Subscriber {
 				"name", ach.Name,
 				"character_id", characterID,
 				"criteria_type", criteriaType,
-				"threshold", threshold,
-				"current_value", currentValue,
+				"threshold", criteria.Threshold,
+				"current_value", value,
 				"xp_reward", ach.XpReward,
 			)
+			rewardAchievement(ctx, client, xpSvc, char, ach, event.Timestamp, logger)
+		}
+
+		return nil
+	}
+}
 
-			// Emit achievement.completed event
+// criteriaValue returns the character's current value for criteria.
+func criteriaValue(ctx context.Context, client *db.Client, char *db.Character, criteria AchievementCriteria, event Event) (int, error) {
+	switch criteria.Type {
+	case CriteriaKills:
+		if criteria.NPCTemplateID != "" {
+			return char.KillCounts[criteria.NPCTemplateID], nil
+		}
+		return sumCounts(char.KillCounts), nil
+	case CriteriaItemsCrafted:
+		if criteria.Recipe != "" {
+			return char.CraftedCounts[criteria.Recipe], nil
+		}
+		return sumCounts(char.CraftedCounts), nil
+	case CriteriaRoomsVisited:
+		return len(char.VisitedRooms), nil
+	case CriteriaQuestsCompleted:
+		return client.QuestProgress.Query().
+			Where(
+				questprogress.HasCharacterWith(character.ID(char.ID)),
+				questprogress.StatusEQ(questprogress.StatusCompleted),
+			).
+			Count(ctx)
+	case CriteriaLevel:
+		v, _ := payloadInt(event.Payload, "new_level")
+		return v, nil
+	case CriteriaXP:
+		if v, ok := payloadInt(event.Payload, "total_xp"); ok {
+			return v, nil
+		}
+		v, _ := payloadInt(event.Payload, "xp")
+		return v, nil
+	case CriteriaSkillLevel:
+		if v, ok := payloadInt(event.Payload, "new_level"); ok {
+			return v, nil
+		}
+		v, _ := payloadInt(event.Payload, "skill_level")
+		return v, nil
+	case CriteriaReclass:
+		if v, ok := payloadInt(event.Payload, "reclass_count"); ok {
+			return v, nil
+		}
+		return 1, nil // at least one reclass happened
+	case CriteriaRerace:
+		if v, ok := payloadInt(event.Payload, "rerace_count"); ok {
+			return v, nil
+		}
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// recordAchievementProgress stores value (capped at threshold) as the
+// character's progress. Progress never goes down, so losing XP to a death
+// penalty doesn't undo it. It reports whether this call completed the
+// achievement.
+func recordAchievementProgress(ctx context.Context, client *db.Client, characterID, achievementID, threshold, value int) (bool, error) {
+	row, err := client.CharacterAchievement.Query().
+		Where(
+			characterachievement.CharacterID(characterID),
+			characterachievement.AchievementID(achievementID),
+		).
+		Only(ctx)
+	if db.IsNotFound(err) {
+		row, err = createAchievementProgress(ctx, client, characterID, achievementID, threshold)
+	}
+	if err != nil {
+		return false, err
+	}
+	if row.CompletedAt != nil {
+		return false, nil
+	}
+
+	progress := value
+	if progress > threshold {
+		progress = threshold
+	}
+	if progress < row.Progress {
+		progress = row.Progress
+	}
+	done := progress >= threshold
+	if progress == row.Progress && threshold == row.Target && !done {
+		return false, nil
+	}
+
+	update := client.CharacterAchievement.UpdateOne(row).
+		SetProgress(progress).
+		SetTarget(threshold)
+	if done {
+		update.SetCompletedAt(time.Now())
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return false, err
+	}
+	return done, nil
+}
+
+// createAchievementProgress creates the character's progress row. An
+// achievement already recorded as completed in system_logs (the record kept
+// before character_achievements existed) is carried over as complete, so
+// its reward isn't paid twice.
+func createAchievementProgress(ctx context.Context, client *db.Client, characterID, achievementID, threshold int) (*db.CharacterAchievement, error) {
+	create := client.CharacterAchievement.Create().
+		SetCharacterID(characterID).
+		SetAchievementID(achievementID).
+		SetTarget(threshold)
+	legacy, err := isLegacyAchievementCompleted(ctx, client, characterID, achievementID)
+	if err != nil {
+		return nil, err
+	}
+	if legacy {
+		create.SetProgress(threshold).SetCompletedAt(time.Now())
+	}
+	row, err := create.Save(ctx)
+	if db.IsConstraintError(err) {
+		// Lost a race with another event for the same character.
+		return client.CharacterAchievement.Query().
+			Where(
+				characterachievement.CharacterID(characterID),
+				characterachievement.AchievementID(achievementID),
+			).
+			Only(ctx)
+	}
+	return row, err
+}
+
+// rewardAchievement pays out a newly completed achievement and tells the
+// player. Failures are logged; the completion itself is already saved.
+func rewardAchievement(ctx context.Context, client *db.Client, xpSvc XPAwarder, char *db.Character, ach *db.Achievement, timestamp int64, logger *slog.Logger) {
+	if ach.XpReward > 0 && xpSvc != nil {
+		newXP, newLevel, leveledUp, err := xpSvc.AwardXPWithSource(ctx, char.ID, ach.XpReward, "achievement")
+		if err != nil {
+			dblog.Error("failed to award achievement XP", err,
+				slog.String("service", "events"),
+				slog.Int("achievement_id", ach.ID),
+				slog.Int("character_id", char.ID),
+			)
+		} else if leveledUp {
 			Publish(Event{
-				Type: EventType("achievement.completed"),
+				Type: EventLevelUp,
 				Payload: map[string]interface{}{
-					"character_id":  characterID,
-					"achievement_id": ach.ID,
-					"name":          ach.Name,
-					"xp_reward":     ach.XpReward,
+					"character_id": char.ID,
+					"new_level":    newLevel,
+					"total_xp":     newXP,
 				},
-				Timestamp: event.Timestamp,
+				Timestamp: timestamp,
 			})
 		}
+	}
 
-		return nil
+	text := fmt.Sprintf("Achievement unlocked: %s!", ach.Name)
+	if ach.Title != "" {
+		text += fmt.Sprintf(" You may now be known as %s %s.", char.Name, ach.Title)
+		if char.Title == "" {
+			if err := client.Character.UpdateOneID(char.ID).SetTitle(ach.Title).Exec(ctx); err != nil {
+				logger.Warn("failed to grant achievement title",
+					"achievement_id", ach.ID,
+					"character_id", char.ID,
+					"error", err,
+				)
+			} else {
+				char.Title = ach.Title
+			}
+		}
 	}
+	stream.Default().Send(char.ID, stream.Event{Type: stream.TypeAchievement, Text: text})
+
+	Publish(Event{
+		Type: EventAchievementCompleted,
+		Payload: map[string]interface{}{
+			"character_id":   char.ID,
+			"achievement_id": ach.ID,
+			"name":           ach.Name,
+			"xp_reward":      ach.XpReward,
+			"title":          ach.Title,
+		},
+		Timestamp: timestamp,
+	})
 }
 
-// isAchievementCompleted checks the system_logs table for a prior achievement.completed
-// entry for this character + achievement combination.
-func isAchievementCompleted(ctx context.Context, client *db.Client, characterID, achievementID int) (bool, error) {
-	// Query system_logs for action="achievement.completed" with this character_id
+// isLegacyAchievementCompleted checks the system_logs table for a prior
+// achievement.completed entry for this character + achievement combination.
+func isLegacyAchievementCompleted(ctx context.Context, client *db.Client, characterID, achievementID int) (bool, error) {
 	logs, err := client.SystemLog.Query().
 		Where(systemlog.ActionEQ("achievement.completed")).
 		Where(systemlog.CharacterIDEQ(characterID)).
@@ -198,7 +375,7 @@ func isAchievementCompleted(ctx context.Context, client *db.Client, characterID,
 		return false, err
 	}
 
-	target := fmt.Sprintf(`"achievement_id":%d`, achievementID)
+	target := fmt.Sprintf(`"achievement_id":%d,`, achievementID)
 	for _, log := range logs {
 		if strings.Contains(log.Details, target) {
 			return true, nil
@@ -207,15 +384,31 @@ func isAchievementCompleted(ctx context.Context, client *db.Client, characterID,
 	return false, nil
 }
 
-// RegisterAchievementSubscriber subscribes the achievement subscriber to all relevant event types.
-func RegisterAchievementSubscriber(client *db.Client, logger *slog.Logger) {
-	sub := AchievementSubscriber(client, logger)
-	Subscribe(EventXPGained, sub)
-	Subscribe(EventLevelUp, sub)
-	Subscribe(EventSkillLeveledUp, sub)
-	Subscribe(EventReclass, sub)
-	Subscribe(EventRerace, sub)
+// payloadInt reads an integer from an event payload. Payloads delivered
+// through the outbox have been through JSON, so numbers arrive as float64.
+func payloadInt(payload map[string]interface{}, key string) (int, bool) {
+	switch v := payload[key].(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	}
+	return 0, false
+}
+
+func sumCounts(counts map[string]int) int {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	return total
 }
 
-// strconv import guard — used for potential future int parsing
-var _ = strconv.Itoa
\ No newline at end of file
+// RegisterAchievementSubscriber subscribes the achievement subscriber to every
+// event that can advance a criteria type.
+func RegisterAchievementSubscriber(client *db.Client, xpSvc XPAwarder, logger *slog.Logger) {
+	sub := AchievementSubscriber(client, xpSvc, logger)
+	for eventType := range criteriaByEvent {
+		SubscribeDurable("achievements", eventType, sub)
+	}
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"herbst-server/db"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "herbst-server/db/runtime"
+)
+
+func TestParseAchievementCriteria(t *testing.T) {
+	c, err := ParseAchievementCriteria(`{"type":"kills","threshold":50,"npc_template_id":"giant_rat"}`)
+	if err != nil || c.Type != CriteriaKills || c.Threshold != 50 || c.NPCTemplateID != "giant_rat" {
+		t.Errorf("kills criteria: got %+v, %v", c, err)
+	}
+	for _, raw := range []string{`not json`, `{"type":"dance","threshold":1}`, `{"type":"level","threshold":0}`} {
+		if _, err := ParseAchievementCriteria(raw); !errors.Is(err, ErrInvalidCriteria) {
+			t.Errorf("%s: got %v, want ErrInvalidCriteria", raw, err)
+		}
+	}
+}
+
+func TestRecordAchievementProgress(t *testing.T) {
+	client, err := db.Open("sqlite3", "file:"+t.Name()+"?mode=memory&_foreign_keys=on")
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	room := client.Room.Create().SetName("Cellar").SetDescription("Cellar").SetWorldID("test").SetExits(map[string]int{}).SaveX(ctx).ID
+	char := client.Character.Create().
+		SetName("Ana").
+		SetCurrentRoomId(room).
+		SetStartingRoomId(room).
+		SetRespawnRoomId(room).
+		SaveX(ctx)
+	ach := client.Achievement.Create().SetName("Ratcatcher").SetCriteria(`{"type":"kills","threshold":3}`).SaveX(ctx)
+
+	steps := []struct {
+		value    int
+		done     bool
+		progress int
+	}{
+		{1, false, 1},
+		{0, false, 1}, // never goes down
+		{5, true, 3},  // capped at the threshold
+		{6, false, 3}, // already complete
+	}
+	for i, s := range steps {
+		done, err := recordAchievementProgress(ctx, client, char.ID, ach.ID, 3, s.value)
+		if err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+		row := client.CharacterAchievement.Query().OnlyX(ctx)
+		if done != s.done || row.Progress != s.progress {
+			t.Errorf("step %d: got done=%v progress=%d, want done=%v progress=%d", i, done, row.Progress, s.done, s.progress)
+		}
+	}
+	if row := client.CharacterAchievement.Query().OnlyX(ctx); row.CompletedAt == nil || row.Target != 3 {
+		t.Errorf("completed row: got %+v", row)
+	}
+}
@@ -18,7 +18,8 @@ import (
 
 // DefeatXPSubscriber returns a subscriber that awards split XP on npc_defeated events.
 // It queries the damage_log to determine proportional damage contribution per attacker,
-// then applies a level-gap penalty before awarding XP.
+// then applies a level-gap penalty. Attackers in a party pool their XP with the
+// members in the NPC's room (see sharePartyXP) before it is awarded.
 func DefeatXPSubscriber(xpSvc XPAwarder, client *db.Client, logger *slog.Logger) Subscriber {
 	return func(event Event) error {
 		if event.Type != EventNPCDefeated {
@@ -93,6 +94,8 @@ func DefeatXPSubscriber(xpSvc XPAwarder, client *db.Client, logger *slog.Logger)
 			totalDamage += dmg
 		}
 
+		awards := make(map[int]int, len(attackerDamage))
+		attackers := make(map[int]*db.Character, len(attackerDamage))
 		for attackerID, dmg := range attackerDamage {
 			share := float64(dmg) / float64(totalDamage) * float64(baseXP)
 
@@ -129,36 +132,68 @@ func DefeatXPSubscriber(xpSvc XPAwarder, client *db.Client, logger *slog.Logger)
 			if adjustedXP < 1 {
 				adjustedXP = 1
 			}
+			awards[attackerID] = adjustedXP
+			attackers[attackerID] = attacker
 
-			newXP, newLevel, leveledUp, err := xpSvc.AwardXPWithSource(ctx, attackerID, adjustedXP, "kill")
+			logger.Info("defeat xp earned",
+				"attacker_id", attackerID,
+				"npc_id", npcID,
+				"damage_dealt", dmg,
+				"damage_share_pct", fmt.Sprintf("%.1f", float64(dmg)/float64(totalDamage)*100),
+				"level_diff", levelDiff,
+				"penalty_pct", fmt.Sprintf("%.0f", penaltyPercent*100),
+				"base_share", int(share),
+				"final_xp", adjustedXP,
+			)
+		}
+
+		// Party members standing where the NPC fell share in the kill.
+		npcRoom := 0
+		if npc, err := client.Character.Get(ctx, npcID); err == nil {
+			npcRoom = npc.CurrentRoomId
+		}
+		awards = sharePartyXP(ctx, client, awards, npcRoom, logger)
+
+		for charID, xp := range awards {
+			newXP, newLevel, leveledUp, err := xpSvc.AwardXPWithSource(ctx, charID, xp, "kill")
 			if err != nil {
 				dblog.Error("failed to award defeat XP",
-					err, slog.String("service", "events"), slog.Int("attacker_id", attackerID))
+					err, slog.String("service", "events"), slog.Int("attacker_id", charID))
 				continue
 			}
 
 			// Increment kill_counts for anti-grind tracking
-			if npcTemplateID != "" {
+			if attacker := attackers[charID]; attacker != nil && npcTemplateID != "" {
 				killCounts := attacker.KillCounts
 				if killCounts == nil {
 					killCounts = make(map[string]int)
 				}
 				killCounts[npcTemplateID]++
-				_, _ = client.Character.UpdateOne(attacker).
+				if _, err := client.Character.UpdateOne(attacker).
 					SetKillCounts(killCounts).
-					Save(ctx)
+					Save(ctx); err == nil {
+					Publish(Event{
+						Type: EventKillCounted,
+						Payload: map[string]interface{}{
+							"character_id":    charID,
+							"npc_template_id": npcTemplateID,
+							"kills":           killCounts[npcTemplateID],
+						},
+						Timestamp: event.Timestamp,
+					})
+				}
 			}
 
 			if leveledUp {
 				logger.Info("character leveled up from defeat",
-					"attacker_id", attackerID,
+					"attacker_id", charID,
 					"new_level", newLevel,
 					"total_xp", newXP,
 				)
 				Publish(Event{
 					Type: EventLevelUp,
 					Payload: map[string]interface{}{
-						"character_id": attackerID,
+						"character_id": charID,
 						"new_level":    newLevel,
 						"total_xp":     newXP,
 					},
@@ -167,14 +202,9 @@ func DefeatXPSubscriber(xpSvc XPAwarder, client *db.Client, logger *slog.Logger)
 			}
 
 			logger.Info("defeat xp awarded",
-				"attacker_id", attackerID,
+				"character_id", charID,
 				"npc_id", npcID,
-				"damage_dealt", dmg,
-				"damage_share_pct", fmt.Sprintf("%.1f", float64(dmg)/float64(totalDamage)*100),
-				"level_diff", levelDiff,
-				"penalty_pct", fmt.Sprintf("%.0f", penaltyPercent*100),
-				"base_share", int(share),
-				"final_xp", adjustedXP,
+				"xp", xp,
 			)
 		}
 
@@ -69,8 +69,12 @@ func RegisterEventLogSubscriber(client *db.Client, logger *slog.Logger) {
 		EventCharacterDied,
 		EventQuestComplete,
 		EventSkillLearned,
+		EventKillCounted,
+		EventRoomVisited,
+		EventItemCrafted,
+		EventAchievementCompleted,
 	}
 	for _, et := range allEventTypes {
-		Subscribe(et, sub)
+		SubscribeDurable("event_log", et, sub)
 	}
 }
\ No newline at end of file
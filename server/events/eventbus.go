@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+
+	"herbst-server/db"
 )
 
 // EventType is a string identifier for an event kind.
@@ -12,17 +14,21 @@ type EventType string
 
 // Built-in event types.
 const (
-	EventNPCDefeated   EventType = "npc_defeated"
-	EventCharacterDied EventType = "character_died"
-	EventLevelUp       EventType = "level_up"
-	EventQuestComplete EventType = "quest_complete"
-	EventSkillLearned  EventType = "skill_learned"
-	EventXPGained          EventType = "xp.gained"
-	EventSkillLeveledUp    EventType = "skill.leveled_up"
+	EventNPCDefeated          EventType = "npc_defeated"
+	EventCharacterDied        EventType = "character_died"
+	EventLevelUp              EventType = "level_up"
+	EventQuestComplete        EventType = "quest_complete"
+	EventSkillLearned         EventType = "skill_learned"
+	EventXPGained             EventType = "xp.gained"
+	EventSkillLeveledUp       EventType = "skill.leveled_up"
 	EventSkillAbilityUnlocked EventType = "skill.ability_unlocked"
-	EventSkillXPGained     EventType = "skill_xp.gained"
-	EventReclass           EventType = "reclass"
-	EventRerace            EventType = "rerace"
+	EventSkillXPGained        EventType = "skill_xp.gained"
+	EventReclass              EventType = "reclass"
+	EventRerace               EventType = "rerace"
+	EventKillCounted          EventType = "kill.counted"
+	EventRoomVisited          EventType = "room.visited"
+	EventItemCrafted          EventType = "item.crafted"
+	EventAchievementCompleted EventType = "achievement.completed"
 )
 
 // XPAwarder abstracts the XP award logic so subscribers don't need a concrete service import.
@@ -34,7 +40,7 @@ type XPAwarder interface {
 
 // Event is the payload published to the bus.
 type Event struct {
-	Type      EventType             `json:"type"`
+	Type      EventType              `json:"type"`
 	Payload   map[string]interface{} `json:"payload"`
 	Timestamp int64                  `json:"timestamp"`
 }
@@ -47,6 +53,7 @@ type Subscriber func(Event) error
 type Bus struct {
 	mu          sync.RWMutex
 	subscribers map[EventType][]Subscriber
+	outbox      *Outbox
 	logger      *slog.Logger
 }
 
@@ -70,15 +77,58 @@ func (b *Bus) Subscribe(eventType EventType, fn Subscriber) {
 	b.logger.Info("subscriber registered", "event", eventType)
 }
 
+// UseOutbox switches the bus to durable delivery: published events are
+// written to the outbox and SubscribeDurable subscribers read them from it.
+func (b *Bus) UseOutbox(o *Outbox) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.outbox = o
+}
+
+// Outbox returns the bus's outbox, or nil if durable delivery is off.
+func (b *Bus) Outbox() *Outbox {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.outbox
+}
+
+// SubscribeDurable registers a named handler that gets at-least-once
+// delivery through the outbox. Without an outbox it behaves like Subscribe.
+// Handlers may see an event more than once and should tolerate that.
+func (b *Bus) SubscribeDurable(name string, eventType EventType, fn Subscriber) {
+	if o := b.Outbox(); o != nil {
+		o.subscribe(name, eventType, fn)
+		return
+	}
+	b.Subscribe(eventType, fn)
+}
+
 // Publish dispatches an event to all subscribers of that event type.
-// Each subscriber runs in its own goroutine and is fire-and-forget.
+// Each in-memory subscriber runs in its own goroutine and is fire-and-forget.
+// With an outbox the event is also persisted for durable subscribers; if
+// that write fails they are called directly instead, without retries.
 func (b *Bus) Publish(event Event) {
 	b.mu.RLock()
-	subs, ok := b.subscribers[event.Type]
+	subs := append([]Subscriber(nil), b.subscribers[event.Type]...)
+	outbox := b.outbox
 	b.mu.RUnlock()
 
-	if !ok || len(subs) == 0 {
-		b.logger.Debug("no subscribers for event", "type", event.Type)
+	if outbox != nil {
+		if err := outbox.Append(context.Background(), event); err != nil {
+			b.logger.Error("outbox append failed, delivering directly",
+				"event", event.Type,
+				"error", err,
+			)
+			for _, s := range outbox.subscribersFor(event.Type) {
+				subs = append(subs, s.fn)
+			}
+		}
+	}
+
+	if len(subs) == 0 {
+		if outbox == nil {
+			b.logger.Debug("no subscribers for event", "type", event.Type)
+		}
 		return
 	}
 
@@ -120,6 +170,20 @@ func Subscribe(eventType EventType, fn Subscriber) {
 	Default().Subscribe(eventType, fn)
 }
 
+// SubscribeDurable is a package-level convenience helper.
+func SubscribeDurable(name string, eventType EventType, fn Subscriber) {
+	Default().SubscribeDurable(name, eventType, fn)
+}
+
+// EnableOutbox turns on durable delivery for the global bus. Call it after
+// Init and before any SubscribeDurable, then Start the returned outbox once
+// every subscriber is registered.
+func EnableOutbox(client *db.Client, logger *slog.Logger) *Outbox {
+	o := NewOutbox(client, logger)
+	Default().UseOutbox(o)
+	return o
+}
+
 // Validate checks that the event has a non-empty type and non-nil payload.
 func (e Event) Validate() error {
 	if e.Type == "" {
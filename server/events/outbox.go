@@ -0,0 +1,510 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"herbst-server/db"
+	"herbst-server/db/deadletter"
+	"herbst-server/db/eventcursor"
+	"herbst-server/db/outboxevent"
+	"herbst-server/dblog"
+)
+
+const (
+	outboxPollInterval = time.Second
+	outboxBatchSize    = 100
+	// outboxMaxAttempts is how many times an event is tried before it is
+	// dead-lettered.
+	outboxMaxAttempts = 5
+	outboxBaseBackoff = time.Second
+	outboxMaxBackoff  = time.Minute
+	// outboxSettleDelay keeps subscribers a little behind the head of the
+	// outbox. IDs are allocated before commit, so a slow insert can become
+	// visible after a higher ID; reading only settled rows stops a cursor
+	// from skipping past it.
+	outboxSettleDelay = 500 * time.Millisecond
+	// outboxRetention is how long delivered events are kept for replay.
+	outboxRetention     = 7 * 24 * time.Hour
+	outboxPruneInterval = time.Hour
+)
+
+// ErrUnknownSubscriber is returned for a subscriber name that was never
+// registered as durable.
+var ErrUnknownSubscriber = errors.New("unknown durable subscriber")
+
+// durableSub is a named subscriber fed from the outbox.
+type durableSub struct {
+	name  string
+	types map[EventType]bool
+	fn    Subscriber
+	wake  chan struct{}
+
+	mu sync.Mutex // serialises delivery and cursor changes
+}
+
+// Outbox persists every published event and delivers it to durable
+// subscribers at least once. Each subscriber has its own cursor, retries a
+// failing event with exponential backoff and, after outboxMaxAttempts,
+// moves it to the dead-letter table and carries on.
+type Outbox struct {
+	client *db.Client
+	logger *slog.Logger
+	settle time.Duration
+
+	mu   sync.RWMutex
+	subs map[string]*durableSub
+	stop chan struct{}
+}
+
+// NewOutbox creates an outbox backed by the outbox_events table.
+func NewOutbox(client *db.Client, logger *slog.Logger) *Outbox {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Outbox{
+		client: client,
+		logger: logger,
+		settle: outboxSettleDelay,
+		subs:   make(map[string]*durableSub),
+	}
+}
+
+// subscribe registers fn under name for eventType. Registering the same
+// name for several types adds to one subscriber with one cursor. A
+// subscriber added after Start gets its delivery loop straight away.
+func (o *Outbox) subscribe(name string, eventType EventType, fn Subscriber) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	s, ok := o.subs[name]
+	if !ok {
+		s = &durableSub{name: name, types: make(map[EventType]bool), fn: fn, wake: make(chan struct{}, 1)}
+		o.subs[name] = s
+		if o.stop != nil {
+			go func() {
+				if err := o.ensureCursor(context.Background(), s.name); err != nil {
+					dblog.Error("outbox: failed to start subscriber", err, slog.String("service", "events"), slog.String("subscriber", s.name))
+					return
+				}
+				o.run(s)
+			}()
+		}
+	}
+	s.types[eventType] = true
+	o.logger.Info("durable subscriber registered", "subscriber", name, "event", eventType)
+}
+
+// subscribersFor returns the durable subscribers interested in eventType.
+func (o *Outbox) subscribersFor(eventType EventType) []*durableSub {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	var out []*durableSub
+	for _, s := range o.subs {
+		if s.types[eventType] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func (o *Outbox) sub(name string) (*durableSub, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	s, ok := o.subs[name]
+	if !ok {
+		return nil, ErrUnknownSubscriber
+	}
+	return s, nil
+}
+
+// Append writes an event to the outbox and wakes its subscribers.
+func (o *Outbox) Append(ctx context.Context, event Event) error {
+	_, err := o.client.OutboxEvent.Create().
+		SetType(string(event.Type)).
+		SetPayload(event.Payload).
+		SetTimestamp(event.Timestamp).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("append to outbox: %w", err)
+	}
+	for _, s := range o.subscribersFor(event.Type) {
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Start creates missing cursors and starts one delivery loop per durable
+// subscriber plus the pruning loop. A subscriber seen for the first time
+// starts at the current head of the outbox; use Replay to backfill it.
+func (o *Outbox) Start() error {
+	ctx := context.Background()
+	o.mu.Lock()
+	o.stop = make(chan struct{})
+	subs := make([]*durableSub, 0, len(o.subs))
+	for _, s := range o.subs {
+		subs = append(subs, s)
+	}
+	o.mu.Unlock()
+
+	for _, s := range subs {
+		if err := o.ensureCursor(ctx, s.name); err != nil {
+			return err
+		}
+		go o.run(s)
+	}
+	go o.pruneLoop()
+	return nil
+}
+
+// ensureCursor creates the subscriber's cursor at the head of the outbox
+// if it does not exist yet.
+func (o *Outbox) ensureCursor(ctx context.Context, name string) error {
+	exists, err := o.client.EventCursor.Query().Where(eventcursor.ID(name)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check cursor %s: %w", name, err)
+	}
+	if exists {
+		return nil
+	}
+	head, err := o.head(ctx)
+	if err != nil {
+		return err
+	}
+	if err := o.client.EventCursor.Create().SetID(name).SetLastEventID(head).Exec(ctx); err != nil {
+		return fmt.Errorf("create cursor %s: %w", name, err)
+	}
+	return nil
+}
+
+// Stop ends the delivery loops.
+func (o *Outbox) Stop() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.stop != nil {
+		close(o.stop)
+		o.stop = nil
+	}
+}
+
+func (o *Outbox) head(ctx context.Context) (int, error) {
+	last, err := o.client.OutboxEvent.Query().
+		Order(outboxevent.ByID(sql.OrderDesc())).
+		First(ctx)
+	if db.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("read outbox head: %w", err)
+	}
+	return last.ID, nil
+}
+
+func (o *Outbox) run(s *durableSub) {
+	o.mu.RLock()
+	stop := o.stop
+	o.mu.RUnlock()
+
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-s.wake:
+		case <-ticker.C:
+		}
+		for o.deliver(context.Background(), s) {
+		}
+	}
+}
+
+// deliver hands the next batch of events to s. It reports whether a full
+// batch was handled, i.e. whether more may be waiting.
+func (o *Outbox) deliver(ctx context.Context, s *durableSub) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cur, err := o.client.EventCursor.Get(ctx, s.name)
+	if err != nil {
+		dblog.Error("outbox: failed to read cursor", err, slog.String("service", "events"), slog.String("subscriber", s.name))
+		return false
+	}
+	now := time.Now()
+	if cur.NextAttemptAt != nil && now.Before(*cur.NextAttemptAt) {
+		return false
+	}
+
+	batch, err := o.client.OutboxEvent.Query().
+		Where(
+			outboxevent.IDGT(cur.LastEventID),
+			outboxevent.CreatedAtLT(now.Add(-o.settle)),
+		).
+		Order(outboxevent.ByID(sql.OrderAsc())).
+		Limit(outboxBatchSize).
+		All(ctx)
+	if err != nil {
+		dblog.Error("outbox: failed to read events", err, slog.String("service", "events"), slog.String("subscriber", s.name))
+		return false
+	}
+	if len(batch) == 0 {
+		return false
+	}
+
+	last, saved := cur.LastEventID, cur.LastEventID
+	attempts := cur.Attempts
+	for _, row := range batch {
+		if !s.types[EventType(row.Type)] {
+			last = row.ID
+			continue
+		}
+		err := callSubscriber(s.fn, eventFromRow(row))
+		if err == nil {
+			last, attempts = row.ID, 0
+			o.saveCursor(ctx, s.name, last, 0, nil, "")
+			saved = last
+			continue
+		}
+
+		attempts++
+		if attempts < outboxMaxAttempts {
+			next := now.Add(backoff(attempts))
+			o.logger.Warn("outbox: delivery failed, will retry",
+				"subscriber", s.name, "event_id", row.ID, "attempt", attempts, "retry_at", next, "error", err)
+			o.saveCursor(ctx, s.name, last, attempts, &next, err.Error())
+			return false
+		}
+
+		o.deadLetter(ctx, s.name, row, attempts, err)
+		last, attempts = row.ID, 0
+		o.saveCursor(ctx, s.name, last, 0, nil, err.Error())
+		saved = last
+	}
+	if last != saved {
+		o.saveCursor(ctx, s.name, last, 0, nil, "")
+	}
+	return len(batch) == outboxBatchSize
+}
+
+func (o *Outbox) saveCursor(ctx context.Context, name string, last, attempts int, next *time.Time, lastErr string) {
+	upd := o.client.EventCursor.UpdateOneID(name).
+		SetLastEventID(last).
+		SetAttempts(attempts).
+		SetLastError(lastErr)
+	if next != nil {
+		upd.SetNextAttemptAt(*next)
+	} else {
+		upd.ClearNextAttemptAt()
+	}
+	if err := upd.Exec(ctx); err != nil {
+		dblog.Error("outbox: failed to save cursor", err, slog.String("service", "events"), slog.String("subscriber", name))
+	}
+}
+
+func (o *Outbox) deadLetter(ctx context.Context, name string, row *db.OutboxEvent, attempts int, cause error) {
+	o.logger.Error("outbox: event dead-lettered",
+		"subscriber", name, "event_id", row.ID, "type", row.Type, "attempts", attempts, "error", cause)
+	err := o.client.DeadLetter.Create().
+		SetSubscriber(name).
+		SetEventID(row.ID).
+		SetEventType(row.Type).
+		SetPayload(row.Payload).
+		SetTimestamp(row.Timestamp).
+		SetAttempts(attempts).
+		SetError(cause.Error()).
+		Exec(ctx)
+	if err != nil {
+		dblog.Error("outbox: failed to write dead letter", err,
+			slog.String("service", "events"), slog.String("subscriber", name), slog.Int("event_id", row.ID))
+	}
+}
+
+// backoff doubles from outboxBaseBackoff for each failed attempt, capped at
+// outboxMaxBackoff.
+func backoff(attempt int) time.Duration {
+	d := outboxBaseBackoff << (attempt - 1)
+	if d <= 0 || d > outboxMaxBackoff {
+		return outboxMaxBackoff
+	}
+	return d
+}
+
+// callSubscriber runs fn, turning a panic into an error so one bad event
+// cannot kill a delivery loop.
+func callSubscriber(fn Subscriber, event Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("subscriber panicked: %v", r)
+		}
+	}()
+	return fn(event)
+}
+
+func eventFromRow(row *db.OutboxEvent) Event {
+	return Event{Type: EventType(row.Type), Payload: row.Payload, Timestamp: row.Timestamp}
+}
+
+// Replay rewinds a subscriber's cursor so every event with a timestamp at
+// or after since (Unix milliseconds) is delivered to it again, along with
+// everything published after those. It returns how many events are queued.
+func (o *Outbox) Replay(ctx context.Context, name string, since int64) (int, error) {
+	s, err := o.sub(name)
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	first, err := o.client.OutboxEvent.Query().
+		Where(outboxevent.TimestampGTE(since)).
+		Order(outboxevent.ByID(sql.OrderAsc())).
+		First(ctx)
+	if db.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("find replay start: %w", err)
+	}
+	cursor := first.ID - 1
+
+	count, err := o.client.OutboxEvent.Query().Where(outboxevent.IDGT(cursor)).Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count replay events: %w", err)
+	}
+	o.saveCursor(ctx, name, cursor, 0, nil, "")
+	o.logger.Info("outbox: replay started", "subscriber", name, "since", since, "from_event_id", first.ID, "events", count)
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return count, nil
+}
+
+// SubscriberStatus describes a durable subscriber's progress.
+type SubscriberStatus struct {
+	Name          string      `json:"name"`
+	EventTypes    []EventType `json:"event_types"`
+	LastEventID   int         `json:"last_event_id"`
+	Lag           int         `json:"lag"`
+	Attempts      int         `json:"attempts"`
+	NextAttemptAt *time.Time  `json:"next_attempt_at,omitempty"`
+	LastError     string      `json:"last_error,omitempty"`
+}
+
+// Status reports every durable subscriber's cursor.
+func (o *Outbox) Status(ctx context.Context) ([]SubscriberStatus, error) {
+	head, err := o.head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o.mu.RLock()
+	subs := make([]*durableSub, 0, len(o.subs))
+	for _, s := range o.subs {
+		subs = append(subs, s)
+	}
+	o.mu.RUnlock()
+	sort.Slice(subs, func(i, j int) bool { return subs[i].name < subs[j].name })
+
+	out := make([]SubscriberStatus, 0, len(subs))
+	for _, s := range subs {
+		st := SubscriberStatus{Name: s.name}
+		for t := range s.types {
+			st.EventTypes = append(st.EventTypes, t)
+		}
+		sort.Slice(st.EventTypes, func(i, j int) bool { return st.EventTypes[i] < st.EventTypes[j] })
+		cur, err := o.client.EventCursor.Get(ctx, s.name)
+		if err != nil && !db.IsNotFound(err) {
+			return nil, fmt.Errorf("read cursor %s: %w", s.name, err)
+		}
+		if cur != nil {
+			st.LastEventID = cur.LastEventID
+			st.Lag = head - cur.LastEventID
+			st.Attempts = cur.Attempts
+			st.NextAttemptAt = cur.NextAttemptAt
+			st.LastError = cur.LastError
+		}
+		out = append(out, st)
+	}
+	return out, nil
+}
+
+// DeadLetters lists dead-lettered events, newest first. An empty
+// subscriber lists all of them.
+func (o *Outbox) DeadLetters(ctx context.Context, subscriber string, limit int) ([]*db.DeadLetter, error) {
+	q := o.client.DeadLetter.Query().
+		Order(deadletter.ByID(sql.OrderDesc())).
+		Limit(limit)
+	if subscriber != "" {
+		q = q.Where(deadletter.SubscriberEQ(subscriber))
+	}
+	return q.All(ctx)
+}
+
+// RetryDeadLetter delivers a dead-lettered event to its subscriber once
+// more and deletes it on success.
+func (o *Outbox) RetryDeadLetter(ctx context.Context, id int) error {
+	dl, err := o.client.DeadLetter.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	s, err := o.sub(dl.Subscriber)
+	if err != nil {
+		return err
+	}
+	event := Event{Type: EventType(dl.EventType), Payload: dl.Payload, Timestamp: dl.Timestamp}
+	if err := callSubscriber(s.fn, event); err != nil {
+		_ = o.client.DeadLetter.UpdateOneID(id).AddAttempts(1).SetError(err.Error()).Exec(ctx)
+		return err
+	}
+	return o.client.DeadLetter.DeleteOneID(id).Exec(ctx)
+}
+
+func (o *Outbox) pruneLoop() {
+	o.mu.RLock()
+	stop := o.stop
+	o.mu.RUnlock()
+
+	ticker := time.NewTicker(outboxPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if n, err := o.prune(context.Background()); err != nil {
+				dblog.Error("outbox: prune failed", err, slog.String("service", "events"))
+			} else if n > 0 {
+				o.logger.Info("outbox: pruned events", "count", n)
+			}
+		}
+	}
+}
+
+// prune deletes events past outboxRetention that every subscriber has
+// already handled.
+func (o *Outbox) prune(ctx context.Context) (int, error) {
+	oldest, err := o.client.EventCursor.Query().
+		Order(eventcursor.ByLastEventID(sql.OrderAsc())).
+		First(ctx)
+	if db.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return o.client.OutboxEvent.Delete().
+		Where(
+			outboxevent.IDLTE(oldest.LastEventID),
+			outboxevent.CreatedAtLT(time.Now().Add(-outboxRetention)),
+		).
+		Exec(ctx)
+}
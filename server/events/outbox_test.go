@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"herbst-server/db"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "herbst-server/db/runtime"
+)
+
+func newTestOutbox(t *testing.T) (*Outbox, *db.Client) {
+	t.Helper()
+	client, err := db.Open("sqlite3", "file:"+t.Name()+"?mode=memory&_foreign_keys=on")
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	o := NewOutbox(client, nil)
+	o.settle = 0
+	return o, client
+}
+
+func TestOutboxRetriesThenDeadLetters(t *testing.T) {
+	o, client := newTestOutbox(t)
+	ctx := context.Background()
+
+	calls := 0
+	o.subscribe("flaky", EventLevelUp, func(Event) error {
+		calls++
+		return errors.New("boom")
+	})
+	if err := o.ensureCursor(ctx, "flaky"); err != nil {
+		t.Fatalf("cursor: %v", err)
+	}
+	o.Append(ctx, Event{Type: EventLevelUp, Payload: map[string]interface{}{"character_id": 1}, Timestamp: 100})
+	o.Append(ctx, Event{Type: EventQuestComplete, Payload: map[string]interface{}{}, Timestamp: 101})
+
+	for i := 0; i < outboxMaxAttempts; i++ {
+		o.deliver(ctx, o.subs["flaky"])
+		// Skip the backoff wait.
+		client.EventCursor.UpdateOneID("flaky").ClearNextAttemptAt().ExecX(ctx)
+	}
+	if calls != outboxMaxAttempts {
+		t.Fatalf("expected %d attempts, got %d", outboxMaxAttempts, calls)
+	}
+	letters, err := o.DeadLetters(ctx, "flaky", 10)
+	if err != nil || len(letters) != 1 {
+		t.Fatalf("expected one dead letter, got %v (%v)", letters, err)
+	}
+	if letters[0].Payload["character_id"] != float64(1) {
+		t.Fatalf("unexpected payload: %v", letters[0].Payload)
+	}
+	cur := client.EventCursor.GetX(ctx, "flaky")
+	if cur.LastEventID != 2 || cur.Attempts != 0 {
+		t.Fatalf("cursor should move past both events, got %+v", cur)
+	}
+}
+
+func TestOutboxReplay(t *testing.T) {
+	o, _ := newTestOutbox(t)
+	ctx := context.Background()
+
+	var seen []int64
+	o.subscribe("xp", EventNPCDefeated, func(e Event) error {
+		seen = append(seen, e.Timestamp)
+		return nil
+	})
+	o.ensureCursor(ctx, "xp")
+	for _, ts := range []int64{100, 200, 300} {
+		o.Append(ctx, Event{Type: EventNPCDefeated, Payload: map[string]interface{}{}, Timestamp: ts})
+	}
+	o.deliver(ctx, o.subs["xp"])
+	if len(seen) != 3 {
+		t.Fatalf("expected 3 deliveries, got %v", seen)
+	}
+
+	n, err := o.Replay(ctx, "xp", 200)
+	if err != nil || n != 2 {
+		t.Fatalf("replay: n=%d err=%v", n, err)
+	}
+	o.deliver(ctx, o.subs["xp"])
+	if len(seen) != 5 || seen[3] != 200 || seen[4] != 300 {
+		t.Fatalf("expected 200 and 300 replayed, got %v", seen)
+	}
+
+	if _, err := o.Replay(ctx, "nope", 0); !errors.Is(err, ErrUnknownSubscriber) {
+		t.Fatalf("expected ErrUnknownSubscriber, got %v", err)
+	}
+}
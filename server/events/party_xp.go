@@ -0,0 +1,143 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+
+	"herbst-server/db"
+	"herbst-server/db/character"
+	"herbst-server/db/party"
+	"herbst-server/db/world"
+)
+
+// Party XP split formulas (world config party.xp_split).
+const (
+	PartySplitEqual = "equal" // every eligible member gets the same share
+	PartySplitLevel = "level" // shares are weighted by character level
+	PartySplitNone  = "none"  // no sharing: everyone keeps what they earned
+)
+
+// PartyConfig is the "party" section of a world's config.
+type PartyConfig struct {
+	// XPSplit is one of the PartySplit* formulas.
+	XPSplit string
+	// BonusPercent is added to the pooled XP for every eligible member
+	// beyond the first, so grouping up is never a loss.
+	BonusPercent float64
+	// MaxSize caps the number of members in a party.
+	MaxSize int
+}
+
+// DefaultPartyConfig is used for worlds without a party section.
+var DefaultPartyConfig = PartyConfig{XPSplit: PartySplitEqual, BonusPercent: 10, MaxSize: 6}
+
+// ParsePartyConfig reads the party section of a world config map, falling
+// back to DefaultPartyConfig for anything missing or invalid.
+func ParsePartyConfig(worldCfg map[string]interface{}) PartyConfig {
+	cfg := DefaultPartyConfig
+	section, ok := worldCfg["party"].(map[string]interface{})
+	if !ok {
+		return cfg
+	}
+	switch split, _ := section["xp_split"].(string); split {
+	case PartySplitEqual, PartySplitLevel, PartySplitNone:
+		cfg.XPSplit = split
+	}
+	if bonus, ok := section["bonus_percent_per_member"].(float64); ok && bonus >= 0 {
+		cfg.BonusPercent = bonus
+	}
+	if size, ok := section["max_size"].(float64); ok && size >= 2 {
+		cfg.MaxSize = int(size)
+	}
+	return cfg
+}
+
+// loadPartyConfig reads the party config of a character's world.
+func loadPartyConfig(ctx context.Context, client *db.Client, worldID int) PartyConfig {
+	if worldID == 0 {
+		return DefaultPartyConfig
+	}
+	w, err := client.World.Query().Where(world.IDEQ(worldID)).Only(ctx)
+	if err != nil {
+		return DefaultPartyConfig
+	}
+	return ParsePartyConfig(w.Config)
+}
+
+// sharePartyXP pools the XP each party earned from a kill and splits it
+// across every member standing in the room where the NPC fell, using the
+// world's split formula. Attackers outside a party keep their own award.
+// The result maps character ID to the XP they should receive.
+func sharePartyXP(ctx context.Context, client *db.Client, awards map[int]int, roomID int, logger *slog.Logger) map[int]int {
+	out := make(map[int]int, len(awards))
+	done := make(map[int]bool)
+	for charID, xp := range awards {
+		if done[charID] {
+			continue
+		}
+		p, err := client.Party.Query().
+			Where(party.HasMembersWith(character.ID(charID))).
+			WithMembers().
+			Only(ctx)
+		if err != nil {
+			out[charID] += xp
+			continue
+		}
+
+		var eligible []*db.Character
+		pool := 0
+		for _, m := range p.Edges.Members {
+			done[m.ID] = true
+			pool += awards[m.ID]
+			if m.CurrentRoomId == roomID || awards[m.ID] > 0 {
+				eligible = append(eligible, m)
+			}
+		}
+		cfg := loadPartyConfig(ctx, client, eligible[0].WorldID)
+		if cfg.XPSplit == PartySplitNone || len(eligible) < 2 {
+			for _, m := range p.Edges.Members {
+				if awards[m.ID] > 0 {
+					out[m.ID] += awards[m.ID]
+				}
+			}
+			continue
+		}
+
+		pool = int(float64(pool) * (1 + cfg.BonusPercent/100*float64(len(eligible)-1)))
+		shares := splitPartyXP(pool, eligible, cfg.XPSplit)
+		for id, share := range shares {
+			out[id] += share
+		}
+		logger.Info("party xp shared",
+			"party_id", p.ID,
+			"room_id", roomID,
+			"members", len(eligible),
+			"split", cfg.XPSplit,
+			"pool", pool,
+		)
+	}
+	return out
+}
+
+// splitPartyXP divides pool between members. Every member gets at least 1.
+func splitPartyXP(pool int, members []*db.Character, split string) map[int]int {
+	shares := make(map[int]int, len(members))
+	weights := make([]int, len(members))
+	total := 0
+	for i, m := range members {
+		w := 1
+		if split == PartySplitLevel && m.Level > 1 {
+			w = m.Level
+		}
+		weights[i] = w
+		total += w
+	}
+	for i, m := range members {
+		share := pool * weights[i] / total
+		if share < 1 {
+			share = 1
+		}
+		shares[m.ID] = share
+	}
+	return shares
+}
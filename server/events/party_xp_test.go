@@ -0,0 +1,65 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"herbst-server/db"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "herbst-server/db/runtime"
+)
+
+func TestSharePartyXP(t *testing.T) {
+	client, err := db.Open("sqlite3", "file:"+t.Name()+"?mode=memory&_foreign_keys=on")
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	newRoom := func(name string) int {
+		return client.Room.Create().SetName(name).SetDescription(name).SetWorldID("test").SetExits(map[string]int{}).SaveX(ctx).ID
+	}
+	room, other := newRoom("Clearing"), newRoom("Road")
+
+	newChar := func(name string, room int) *db.Character {
+		return client.Character.Create().
+			SetName(name).
+			SetCurrentRoomId(room).
+			SetStartingRoomId(room).
+			SetRespawnRoomId(room).
+			SaveX(ctx)
+	}
+	leader := newChar("Ana", room)
+	healer := newChar("Bo", room)
+	away := newChar("Cy", other)
+	solo := newChar("Di", room)
+	client.Party.Create().SetLeaderID(leader.ID).AddMembers(leader, healer, away).SaveX(ctx)
+
+	got := sharePartyXP(ctx, client, map[int]int{leader.ID: 100, solo.ID: 40}, room, slog.Default())
+
+	// 100 XP pooled with a 10% bonus for the second member in the room,
+	// split equally; the member in another room gets nothing.
+	if got[leader.ID] != 55 || got[healer.ID] != 55 {
+		t.Fatalf("expected 55 each for the room's members, got %v", got)
+	}
+	if got[away.ID] != 0 {
+		t.Fatalf("member outside the room should get nothing, got %d", got[away.ID])
+	}
+	if got[solo.ID] != 40 {
+		t.Fatalf("attacker outside a party should keep their award, got %d", got[solo.ID])
+	}
+}
+
+func TestSplitPartyXPByLevel(t *testing.T) {
+	members := []*db.Character{{ID: 1, Level: 3}, {ID: 2, Level: 1}}
+	got := splitPartyXP(100, members, PartySplitLevel)
+	if got[1] != 75 || got[2] != 25 {
+		t.Fatalf("expected a 75/25 split, got %v", got)
+	}
+}
@@ -261,8 +261,8 @@ func matchEventToObjective(event Event, obj interface{}) bool {
 // RegisterQuestTriggerSubscriber subscribes the quest trigger subscriber to all relevant event types.
 func RegisterQuestTriggerSubscriber(client *db.Client, logger *slog.Logger) {
 	sub := QuestTriggerSubscriber(client, logger)
-	Subscribe(EventXPGained, sub)
-	Subscribe(EventLevelUp, sub)
-	Subscribe(EventSkillLeveledUp, sub)
-	Subscribe(EventNPCDefeated, sub)
+	SubscribeDurable("quest_triggers", EventXPGained, sub)
+	SubscribeDurable("quest_triggers", EventLevelUp, sub)
+	SubscribeDurable("quest_triggers", EventSkillLeveledUp, sub)
+	SubscribeDurable("quest_triggers", EventNPCDefeated, sub)
 }
\ No newline at end of file
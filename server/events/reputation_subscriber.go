@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// ReputationAwarder applies the reputation a character earns for kills and
+// finished quests.
+type ReputationAwarder interface {
+	AwardKill(ctx context.Context, charID int, npcTemplateID string) error
+	AwardQuest(ctx context.Context, charID, questID int) error
+}
+
+// ReputationSubscriber returns a subscriber that changes a character's
+// faction standings when they are credited with a kill (kill.counted) or
+// finish a quest (quest_complete).
+func ReputationSubscriber(rep ReputationAwarder, logger *slog.Logger) Subscriber {
+	return func(event Event) error {
+		ctx := context.Background()
+		charID, ok := payloadInt(event.Payload, "character_id")
+		if !ok {
+			return nil
+		}
+		switch event.Type {
+		case EventKillCounted:
+			tmplID, _ := event.Payload["npc_template_id"].(string)
+			if tmplID == "" {
+				return nil
+			}
+			if err := rep.AwardKill(ctx, charID, tmplID); err != nil {
+				return fmt.Errorf("award kill reputation: %w", err)
+			}
+		case EventQuestComplete:
+			questID, ok := payloadInt(event.Payload, "quest_id")
+			if !ok {
+				return nil
+			}
+			if err := rep.AwardQuest(ctx, charID, questID); err != nil {
+				return fmt.Errorf("award quest reputation: %w", err)
+			}
+		default:
+			return nil
+		}
+		logger.Debug("reputation event applied", "type", event.Type, "character_id", charID)
+		return nil
+	}
+}
+
+// RegisterReputationSubscriber wires the reputation subscriber into the
+// default bus.
+func RegisterReputationSubscriber(rep ReputationAwarder, logger *slog.Logger) {
+	sub := ReputationSubscriber(rep, logger)
+	SubscribeDurable("reputation", EventKillCounted, sub)
+	SubscribeDurable("reputation", EventQuestComplete, sub)
+}
@@ -11,13 +11,15 @@ import (
 	"herbst-server/db/character"
 	"herbst-server/db/npctemplate"
 	"herbst-server/dblog"
+	"herbst-server/stream"
 )
 
 // RoamingService periodically moves NPC instances between rooms based on
-// each NPC template's roam_pattern. Templates with roam_pattern=static are
-// skipped. The cadence is per-template (roam_interval_seconds); a random
-// pause between moves is drawn from [roam_pause_min_seconds,
-// roam_pause_max_seconds] for variety across NPCs in the same world.
+// each NPC template's roam_pattern. Templates with roam_pattern=static, or
+// with a behavior schedule (which moves them instead), are skipped. The
+// cadence is per-template (roam_interval_seconds); a random pause between
+// moves is drawn from [roam_pause_min_seconds, roam_pause_max_seconds] for
+// variety across NPCs in the same world.
 //
 // Patterns:
 //   - static:      never moves (skipped)
@@ -93,7 +95,7 @@ func (s *RoamingService) processWander() error {
 		if tmpl == nil {
 			continue
 		}
-		if tmpl.RoamPattern == npctemplate.RoamPatternStatic {
+		if tmpl.RoamPattern == npctemplate.RoamPatternStatic || len(tmpl.Behavior.Schedule) > 0 {
 			skipped++
 			continue
 		}
@@ -197,9 +199,18 @@ func (s *RoamingService) moveOne(ctx context.Context, npc *db.Character, tmpl *d
 		// Not a direct neighbor; refuse (no multi-step walks).
 		return nil
 	}
-	if _, err := s.client.Room.Get(ctx, dest); err != nil {
+	destRoom, err := s.client.Room.Get(ctx, dest)
+	if err != nil {
 		return fmt.Errorf("load destination room %d: %w", dest, err)
 	}
+	// NPCs in a zone copy stay inside it.
+	if currentRoom.InstanceID != nil && (destRoom.InstanceID == nil || *destRoom.InstanceID != *currentRoom.InstanceID) {
+		return nil
+	}
+	// Nor do they wander into player houses.
+	if destRoom.HouseID != nil && currentRoom.HouseID == nil {
+		return nil
+	}
 
 	// Apply the move.
 	_, err = s.client.Character.UpdateOneID(npc.ID).
@@ -209,6 +220,19 @@ func (s *RoamingService) moveOne(ctx context.Context, npc *db.Character, tmpl *d
 		return fmt.Errorf("update npc %d room: %w", npc.ID, err)
 	}
 
+	// Players in either room see the NPC come and go.
+	hub := stream.Default()
+	hub.ToRoom(ctx, npc.CurrentRoomId, stream.Event{
+		Type:    stream.TypeNPC,
+		Text:    fmt.Sprintf("%s leaves %s.", npc.Name, exitKeyFor(currentRoom.Exits, dest)),
+		ActorID: npc.ID,
+	})
+	hub.ToRoom(ctx, dest, stream.Event{
+		Type:    stream.TypeNPC,
+		Text:    fmt.Sprintf("%s arrives.", npc.Name),
+		ActorID: npc.ID,
+	})
+
 	// Optional entry notification — only the in-memory log for now;
 	// the chat-layer integration lands when npc_entered_room event
 	// subscribers are wired (out of scope for this ticket).
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"herbst-server/service"
+)
+
+// startMailExpiry runs a background goroutine that returns or deletes mail
+// left unclaimed past its expiry.
+func startMailExpiry(services *service.Container) {
+	interval := 5 * time.Minute
+	log.Printf("[mail] running: checking for expired mail every %s", interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			n, err := services.Mail.ExpireDue(context.Background(), time.Now())
+			if err != nil {
+				log.Printf("[mail] expiry error: %v", err)
+			}
+			if n > 0 {
+				log.Printf("[mail] returned or deleted %d expired mail(s)", n)
+			}
+		}
+	}()
+}
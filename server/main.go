@@ -15,6 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/ulule/limiter/v3"
 	"github.com/ulule/limiter/v3/drivers/store/memory"
+	"herbst-server/combat"
 	"herbst-server/content"
 	"herbst-server/db"
 	"herbst-server/db/applog"
@@ -306,8 +307,11 @@ func main() {
 	// Register backup routes
 	routes.RegisterBackupRoutes(router, client)
 
-	// Initialize the event bus.
+	// Initialize the event bus. Durable subscribers are fed from the
+	// outbox_events table so XP, quest and achievement handlers survive a
+	// crash; the outbox starts once they are registered below.
 	events.Init(slog.Default())
+	outbox := events.EnableOutbox(client, slog.Default())
 
 	// Register faction routes
 	routes.RegisterFactionRoutes(router, repos, client)
@@ -316,7 +320,16 @@ func main() {
 	routes.RegisterCraftingRecipeRoutes(router, repos, client)
 
 	// Register craft endpoint (CRAFT-004)
-	routes.RegisterCraftRoutes(router, repos)
+	routes.RegisterCraftRoutes(router, services, repos)
+
+	// Register shop admin + buy/sell routes
+	routes.RegisterShopRoutes(router, services, repos)
+
+	// Register hook/trigger condition validate + evaluate routes
+	routes.RegisterConditionRoutes(router, services)
+
+	// Register server-authoritative combat routes
+	routes.RegisterCombatRoutes(router, services, repos)
 
 	// Register social command routes
 	routes.RegisterSocialRoutes(router, client)
@@ -364,7 +377,7 @@ func main() {
 	routes.RegisterQuestProgressRoutes(router, repos, services, client)
 
 	// Register chat/messaging routes (RFC-009)
-	routes.RegisterChatRoutes(router, services)
+	routes.RegisterChatRoutes(router, services, repos)
 
 	// Register reclass/rerace routes (Phase 4)
 	routes.RegisterReclassRoutes(router, services)
@@ -372,8 +385,15 @@ func main() {
 	// Register dialog node routes
 	routes.RegisterDialogNodeRoutes(router, repos, client)
 
+	// Load the keys the SSH server signs its requests with
+	watchServiceKeys()
+
 	// Register event routes (HTTP bridge for game server → event bus)
 	routes.RegisterEventRoutes(router, client, slog.Default())
+	events.RegisterReputationSubscriber(services.Reputation, slog.Default())
+	if err := outbox.Start(); err != nil {
+		log.Fatalf("failed starting event outbox: %v", err)
+	}
 
 	// Start the respawn ticker (checks dead NPCs every 10s)
 	respawnSvc := events.NewRespawnService(client, slog.Default())
@@ -405,7 +425,7 @@ func main() {
 	routes.RegisterLogRoutes(router, protected, client)
 
 	// Register debug log routes — SSH client debug messages flow to applogs
-	routes.RegisterDebugLogRoutes(protected)
+	routes.RegisterDebugLogRoutes(router.Group("/api", middleware.ServiceAuthMiddleware(middleware.ScopeDebugLog)))
 
 	// Start daily log cleanup goroutine (LOGS-005)
 	go startLogCleanup(client)
@@ -416,6 +436,34 @@ func main() {
 	// Start regeneration service
 	StartRegenService(repos, services, client)
 
+	// Start shop restock background goroutine
+	startShopRestock(services)
+
+	// Start zone reset background goroutine
+	startZoneResets(services)
+
+	// Start zone instance resets and cleanup
+	startZoneInstances(services)
+
+	// Start returning and deleting expired mail
+	startMailExpiry(services)
+
+	// Start settling finished auctions
+	startAuctionSettlement(services)
+
+	// Start the world clock and zone weather
+	startWorldTime(services)
+
+	// Start firing timer triggers in occupied rooms
+	startRoomTriggers(services)
+
+	// Start NPC behavior (schedules, aggro, assisting allies)
+	syncNPCBehaviors(contentManager, repos)
+	startNPCBehavior(services)
+
+	// Start the combat tick loop
+	services.CombatEngine.Start(combat.DefaultTickInterval)
+
 	// Healthz endpoint
 	router.GET("/healthz", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
@@ -454,8 +502,47 @@ func main() {
 		c.String(http.StatusOK, swaggerUI)
 	})
 
+	// Register per-character live event stream + move endpoint
+	routes.RegisterStreamRoutes(router, repos, services)
+
+	// Register party invite/accept/leave/kick endpoints
+	routes.RegisterPartyRoutes(router, services, repos)
+
+	// Register room-targeted hook effects
+	routes.RegisterRoomEffectRoutes(router, services, repos)
+
+	// Register NPC conversations with per-character dialog state
+	routes.RegisterConversationRoutes(router, services, repos)
+
+	// Register character achievements, titles and the who list
+	routes.RegisterCharacterAchievementRoutes(router, services, repos)
+
+	// Register player mail with gold and item attachments
+	routes.RegisterMailRoutes(router, services, repos)
+
+	// Register player-to-player trades
+	routes.RegisterTradeRoutes(router, services, repos)
+
+	// Register the auction house
+	routes.RegisterAuctionRoutes(router, services, repos)
+
+	// Register the bank and character stashes
+	routes.RegisterBankRoutes(router, services, repos)
+
+	// Register doors, locks and searching for hidden exits
+	routes.RegisterExitRoutes(router, services, repos, client)
+
+	// Register faction reputation standings
+	routes.RegisterReputationRoutes(router, services, repos)
+
+	// Register player housing and the admin plot listings
+	routes.RegisterHouseRoutes(router, services, repos)
+
+	// Register service-signed vitals, XP, bind point and teleport changes
+	routes.RegisterCharacterVitalsRoutes(router, services, repos)
+
 	// Register WebSocket endpoint (Phase 4)
-	routes.RegisterWSRoutes(router, repos, client)
+	routes.RegisterWSRoutes(router, repos, services, client)
 
 	// Start the server
 	router.Run("0.0.0.0:8080")
@@ -0,0 +1,303 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Scopes a service key can be granted.
+const (
+	ScopeEventsPublish   = "events:publish"
+	ScopeCharacterVitals = "characters:vitals"
+	ScopeDebugLog        = "debug:log"
+	ScopeEffectsApply    = "effects:apply"
+	ScopeConditionsEval  = "conditions:evaluate"
+)
+
+// allScopes is what the development key gets.
+var allScopes = []string{ScopeEventsPublish, ScopeCharacterVitals, ScopeDebugLog, ScopeEffectsApply, ScopeConditionsEval}
+
+// Headers on a signed service request.
+const (
+	HeaderServiceKey       = "X-Service-Key"
+	HeaderServiceTimestamp = "X-Service-Timestamp"
+	HeaderServiceNonce     = "X-Service-Nonce"
+	HeaderServiceSignature = "X-Service-Signature"
+)
+
+// maxServiceNonce is the longest nonce accepted, so the replay cache can't
+// be filled with huge keys.
+const maxServiceNonce = 64
+
+// MaxServiceClockSkew is how far a signed request's timestamp may be from
+// the server's clock. Older requests are refused, so a captured request
+// can't be replayed later.
+const MaxServiceClockSkew = 2 * time.Minute
+
+// devServiceKey is used when no keys are configured and SERVICE_KEYS_DEV
+// is set, for local development. The SSH server signs with the same
+// default under the same flag.
+var devServiceKey = ServiceKey{
+	ID:     "dev",
+	Secret: []byte("dev-service-key-not-for-production-use-only"),
+	Scopes: allScopes,
+}
+
+// ServiceKey is one key a service signs its requests with.
+type ServiceKey struct {
+	ID     string
+	Secret []byte
+	Scopes []string
+}
+
+// HasScope reports whether the key grants scope.
+func (k ServiceKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceKeyring holds the accepted service keys. Several keys can be live
+// at once, which is how keys are rotated: add the new key, move the
+// services over to it, then remove the old one.
+type ServiceKeyring struct {
+	mu   sync.RWMutex
+	keys map[string]ServiceKey
+	// seen remembers recent key IDs and nonces so a request can't be
+	// replayed while its timestamp is still fresh.
+	seen map[string]time.Time
+}
+
+// NewServiceKeyring creates a keyring holding keys.
+func NewServiceKeyring(keys []ServiceKey) *ServiceKeyring {
+	r := &ServiceKeyring{seen: make(map[string]time.Time)}
+	r.Set(keys)
+	return r
+}
+
+// Set replaces the accepted keys.
+func (r *ServiceKeyring) Set(keys []ServiceKey) {
+	m := make(map[string]ServiceKey, len(keys))
+	for _, k := range keys {
+		m[k.ID] = k
+	}
+	r.mu.Lock()
+	r.keys = m
+	r.mu.Unlock()
+}
+
+// Key looks up a key by ID.
+func (r *ServiceKeyring) Key(id string) (ServiceKey, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	k, ok := r.keys[id]
+	return k, ok
+}
+
+// IDs lists the accepted key IDs.
+func (r *ServiceKeyring) IDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]string, 0, len(r.keys))
+	for id := range r.keys {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// firstUse records a key's nonce, reporting false if it was already used.
+func (r *ServiceKeyring) firstUse(keyID, nonce string, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, at := range r.seen {
+		if now.Sub(at) > 2*MaxServiceClockSkew {
+			delete(r.seen, k)
+		}
+	}
+	k := keyID + "\n" + nonce
+	if _, ok := r.seen[k]; ok {
+		return false
+	}
+	r.seen[k] = now
+	return true
+}
+
+var (
+	serviceKeysOnce sync.Once
+	serviceKeys     *ServiceKeyring
+)
+
+// ServiceKeys returns the process-wide keyring, loaded on first use by
+// LoadServiceKeys.
+func ServiceKeys() *ServiceKeyring {
+	serviceKeysOnce.Do(func() {
+		keys, err := LoadServiceKeys()
+		if err != nil {
+			log.Printf("Warning: failed loading service keys: %v; refusing all signed requests", err)
+		}
+		serviceKeys = NewServiceKeyring(keys)
+	})
+	return serviceKeys
+}
+
+// ReloadServiceKeys reads the keys again, so a rotated SERVICE_KEYS_FILE
+// takes effect without a restart. On error the current keys stay.
+func ReloadServiceKeys() error {
+	keys, err := LoadServiceKeys()
+	if err != nil {
+		return err
+	}
+	ServiceKeys().Set(keys)
+	return nil
+}
+
+// LoadServiceKeys reads the service keys from the file named by
+// SERVICE_KEYS_FILE, or else from SERVICE_KEYS, with entries separated by
+// newlines or semicolons:
+//
+//	herbst-2026-10 <secret> events:publish,characters:vitals,debug:log
+//
+// With neither set, no key is accepted unless SERVICE_KEYS_DEV is set, in
+// which case only the development key is. A forgotten variable in
+// production thus refuses signed requests rather than trusting a secret
+// anyone can read in the source.
+func LoadServiceKeys() ([]ServiceKey, error) {
+	spec := os.Getenv("SERVICE_KEYS")
+	if path := os.Getenv("SERVICE_KEYS_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		spec = string(data)
+	} else if spec == "" {
+		if DevServiceKeysEnabled() {
+			return []ServiceKey{devServiceKey}, nil
+		}
+		return nil, nil
+	}
+	return ParseServiceKeys(spec)
+}
+
+// DevServiceKeysEnabled reports whether SERVICE_KEYS_DEV allows the
+// development key.
+func DevServiceKeysEnabled() bool {
+	dev, _ := strconv.ParseBool(os.Getenv("SERVICE_KEYS_DEV"))
+	return dev
+}
+
+// ParseServiceKeys parses service key entries of the form
+// "<id> <secret> <scope>,<scope>". Blank lines and lines starting with #
+// are skipped.
+func ParseServiceKeys(spec string) ([]ServiceKey, error) {
+	var keys []ServiceKey
+	sc := bufio.NewScanner(strings.NewReader(strings.ReplaceAll(spec, ";", "\n")))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.Fields(line)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("service key entry %d: want \"<id> <secret> <scopes>\"", n)
+		}
+		if len(parts[1]) < 16 {
+			return nil, fmt.Errorf("service key %q: secret must be at least 16 characters", parts[0])
+		}
+		keys = append(keys, ServiceKey{
+			ID:     parts[0],
+			Secret: []byte(parts[1]),
+			Scopes: strings.Split(parts[2], ","),
+		})
+	}
+	return keys, sc.Err()
+}
+
+// ServiceSignature is the hex HMAC-SHA256 a service sends in
+// X-Service-Signature. It covers the method, the path and query, the
+// timestamp, the nonce and a SHA-256 of the body, one per line.
+func ServiceSignature(secret []byte, method, uri, timestamp, nonce string, body []byte) string {
+	sum := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s\n%s", method, uri, timestamp, nonce, hex.EncodeToString(sum[:]))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ServiceAuthMiddleware only lets through requests signed with a service
+// key that grants scope. It sits next to AuthMiddleware, which is for
+// players and admins; this one is for the SSH server and other services.
+func ServiceAuthMiddleware(scope string) gin.HandlerFunc {
+	return serviceAuth(ServiceKeys, scope)
+}
+
+func serviceAuth(keyring func() *ServiceKeyring, scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderServiceKey)
+		ts := c.GetHeader(HeaderServiceTimestamp)
+		nonce := c.GetHeader(HeaderServiceNonce)
+		sig := c.GetHeader(HeaderServiceSignature)
+		if id == "" || ts == "" || nonce == "" || sig == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Service signature required"})
+			return
+		}
+		if len(nonce) > maxServiceNonce {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Service nonce too long"})
+			return
+		}
+
+		ring := keyring()
+		key, ok := ring.Key(id)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unknown service key"})
+			return
+		}
+		unix, err := strconv.ParseInt(ts, 10, 64)
+		now := time.Now()
+		if err != nil || now.Sub(time.Unix(unix, 0)).Abs() > MaxServiceClockSkew {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Service request expired"})
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			body, err = io.ReadAll(c.Request.Body)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		want := ServiceSignature(key.Secret, c.Request.Method, c.Request.URL.RequestURI(), ts, nonce, body)
+		if !hmac.Equal([]byte(want), []byte(strings.ToLower(sig))) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid service signature"})
+			return
+		}
+		if !ring.firstUse(key.ID, nonce, now) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Service request already used"})
+			return
+		}
+		if !key.HasScope(scope) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Service key lacks scope " + scope})
+			return
+		}
+
+		c.Set("service_key", key.ID)
+		c.Next()
+	}
+}
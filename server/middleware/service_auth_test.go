@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serviceTestRouter(ring *ServiceKeyring, scope string) *gin.Engine {
+	r := gin.New()
+	r.POST("/api/events", serviceAuth(func() *ServiceKeyring { return ring }, scope), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"key": c.GetString("service_key")})
+	})
+	return r
+}
+
+func signedRequest(keyID string, secret []byte, body string, at time.Time, nonce string) *http.Request {
+	req := httptest.NewRequest("POST", "/api/events?source=ssh", strings.NewReader(body))
+	ts := strconv.FormatInt(at.Unix(), 10)
+	req.Header.Set(HeaderServiceKey, keyID)
+	req.Header.Set(HeaderServiceTimestamp, ts)
+	req.Header.Set(HeaderServiceNonce, nonce)
+	req.Header.Set(HeaderServiceSignature, ServiceSignature(secret, "POST", "/api/events?source=ssh", ts, nonce, []byte(body)))
+	return req
+}
+
+func TestServiceAuthMiddleware(t *testing.T) {
+	secret := []byte("0123456789abcdef-secret")
+	ring := NewServiceKeyring([]ServiceKey{{ID: "herbst", Secret: secret, Scopes: []string{ScopeEventsPublish}}})
+	r := serviceTestRouter(ring, ScopeEventsPublish)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("herbst", secret, `{"type":"x"}`, time.Now(), "n1"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"key":"herbst"`)
+
+	cases := []struct {
+		name string
+		req  *http.Request
+		code int
+	}{
+		{"unsigned", httptest.NewRequest("POST", "/api/events", nil), http.StatusUnauthorized},
+		{"unknown key", signedRequest("other", secret, "{}", time.Now(), "n2"), http.StatusUnauthorized},
+		{"wrong secret", signedRequest("herbst", []byte("wrong-secret-0123456789"), "{}", time.Now(), "n3"), http.StatusUnauthorized},
+		{"expired", signedRequest("herbst", secret, "{}", time.Now().Add(-10*time.Minute), "n4"), http.StatusUnauthorized},
+		{"no nonce", signedRequest("herbst", secret, "{}", time.Now(), ""), http.StatusUnauthorized},
+		{"long nonce", signedRequest("herbst", secret, "{}", time.Now(), strings.Repeat("n", maxServiceNonce+1)), http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, tc.req)
+		assert.Equal(t, tc.code, w.Code, tc.name)
+	}
+
+	// A nonce changed after signing fails.
+	req := signedRequest("herbst", secret, "{}", time.Now(), "n6")
+	req.Header.Set(HeaderServiceNonce, "n7")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// So does a changed body.
+	req = signedRequest("herbst", secret, `{"xp":1}`, time.Now(), "n5")
+	req.Body = httptest.NewRequest("POST", "/", strings.NewReader(`{"xp":9999}`)).Body
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestServiceAuthMiddleware_ReplayAndScope(t *testing.T) {
+	secret := []byte("0123456789abcdef-secret")
+	ring := NewServiceKeyring([]ServiceKey{{ID: "herbst", Secret: secret, Scopes: []string{ScopeDebugLog}}})
+	r := serviceTestRouter(ring, ScopeDebugLog)
+
+	at := time.Now()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("herbst", secret, "{}", at, "a"))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// The same signed request a second time is refused.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("herbst", secret, "{}", at, "a"))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// An identical request in the same second with its own nonce is a new
+	// request, like a second damage-over-time tick.
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("herbst", secret, "{}", at, "b"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// A key without the route's scope is forbidden.
+	w = httptest.NewRecorder()
+	serviceTestRouter(ring, ScopeEventsPublish).ServeHTTP(w, signedRequest("herbst", secret, `{"n":1}`, at, "c"))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	// Rotating keys: once the old key is removed, it stops working.
+	ring.Set([]ServiceKey{{ID: "herbst-2", Secret: secret, Scopes: []string{ScopeDebugLog}}})
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("herbst", secret, `{"n":2}`, at, "d"))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestParseServiceKeys(t *testing.T) {
+	keys, err := ParseServiceKeys("# herbst keys\nold 0123456789abcdef events:publish\n\nnew fedcba9876543210 events:publish,debug:log")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "new", keys[1].ID)
+	assert.True(t, keys[1].HasScope(ScopeDebugLog))
+	assert.False(t, keys[0].HasScope(ScopeDebugLog))
+
+	keys, err = ParseServiceKeys("a 0123456789abcdef events:publish;b fedcba9876543210 debug:log")
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	_, err = ParseServiceKeys("short secret events:publish")
+	assert.Error(t, err)
+	_, err = ParseServiceKeys("missing-scopes 0123456789abcdef")
+	assert.Error(t, err)
+}
+
+func TestLoadServiceKeysFailsClosed(t *testing.T) {
+	t.Setenv("SERVICE_KEYS", "")
+	t.Setenv("SERVICE_KEYS_FILE", "")
+	t.Setenv("SERVICE_KEYS_DEV", "")
+	keys, err := LoadServiceKeys()
+	require.NoError(t, err)
+	assert.Empty(t, keys, "no keys configured must accept none")
+
+	t.Setenv("SERVICE_KEYS_DEV", "true")
+	keys, err = LoadServiceKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, devServiceKey.ID, keys[0].ID)
+
+	t.Setenv("SERVICE_KEYS", "herbst 0123456789abcdef events:publish")
+	keys, err = LoadServiceKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "herbst", keys[0].ID, "configured keys replace the development key")
+}
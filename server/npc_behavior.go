@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"herbst-server/content"
+	"herbst-server/db/schema"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// startNPCBehavior launches the background goroutine that runs NPC behavior
+// scripts: schedules, aggressive NPCs and allies joining fights. Aggro on
+// players walking in is handled straight away by the move handlers; the
+// ticker catches NPCs that arrive, spawn or wake up next to players.
+func startNPCBehavior(services *service.Container) {
+	interval := 3 * time.Second
+	log.Printf("[npc-behavior] running: every %s", interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := services.NPCBehavior.Tick(context.Background(), time.Now()); err != nil {
+				log.Printf("[npc-behavior] tick error: %v", err)
+			}
+		}
+	}()
+}
+
+// syncNPCBehaviors copies the behavior blocks of content NPC YAML onto the
+// NPC templates with the same ID. Templates that don't exist are skipped.
+func syncNPCBehaviors(mgr *content.Manager, repos *repository.Container) {
+	if mgr == nil {
+		return
+	}
+	ctx := context.Background()
+	synced := 0
+	for _, npc := range mgr.NPCs.GetAll() {
+		if npc.Behavior == nil {
+			continue
+		}
+		if _, err := repos.NPCTemplate.Get(ctx, npc.ID); err != nil {
+			continue
+		}
+		b := behaviorFromContent(npc.Behavior)
+		if err := service.ValidateNPCBehavior(&b); err != nil {
+			log.Printf("[npc-behavior] %s: invalid behavior: %v", npc.ID, err)
+			continue
+		}
+		if _, err := repos.NPCTemplate.Update(ctx, npc.ID, repository.NPCTemplateUpdates{Behavior: &b}); err != nil {
+			log.Printf("[npc-behavior] %s: failed to save behavior: %v", npc.ID, err)
+			continue
+		}
+		synced++
+	}
+	if synced > 0 {
+		log.Printf("[npc-behavior] loaded behavior for %d NPC template(s) from content", synced)
+	}
+}
+
+func behaviorFromContent(def *content.BehaviorDef) schema.NPCBehavior {
+	b := schema.NPCBehavior{
+		Faction:      def.Faction,
+		FleeBelowPct: def.FleeBelowPct,
+		AssistAllies: def.AssistAllies,
+	}
+	if def.Aggro != nil {
+		b.Aggro = &schema.NPCAggro{
+			MinLevel:       def.Aggro.MinLevel,
+			MaxLevel:       def.Aggro.MaxLevel,
+			IgnoreFactions: def.Aggro.IgnoreFactions,
+		}
+	}
+	for _, e := range def.Schedule {
+		b.Schedule = append(b.Schedule, schema.NPCScheduleEntry{From: e.From, To: e.To, RoomID: e.RoomID})
+	}
+	return b
+}
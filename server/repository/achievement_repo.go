@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"herbst-server/db"
+	"herbst-server/db/characterachievement"
 )
 
 type entAchievementRepo struct {
@@ -33,6 +34,9 @@ func (r *entAchievementRepo) Create(ctx context.Context, input CreateAchievement
 	if input.Icon != "" {
 		builder = builder.SetIcon(input.Icon)
 	}
+	if input.Title != "" {
+		builder = builder.SetTitle(input.Title)
+	}
 	return builder.Save(ctx)
 }
 
@@ -53,9 +57,19 @@ func (r *entAchievementRepo) Update(ctx context.Context, id int, updates Achieve
 	if updates.Criteria != nil {
 		builder = builder.SetCriteria(*updates.Criteria)
 	}
+	if updates.Title != nil {
+		builder = builder.SetTitle(*updates.Title)
+	}
 	return builder.Save(ctx)
 }
 
+// Delete removes the achievement along with every character's progress
+// towards it.
 func (r *entAchievementRepo) Delete(ctx context.Context, id int) error {
+	if _, err := r.client.CharacterAchievement.Delete().
+		Where(characterachievement.AchievementID(id)).
+		Exec(ctx); err != nil {
+		return err
+	}
 	return r.client.Achievement.DeleteOneID(id).Exec(ctx)
 }
\ No newline at end of file
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/auction"
+)
+
+// AuctionRepo defines data access for auction house listings. Bids, sales
+// and the gold and items they move are done by the auction service in a
+// transaction.
+type AuctionRepo interface {
+	Get(ctx context.Context, id int) (*db.Auction, error)
+	ListOpen(ctx context.Context, search string) ([]*db.Auction, error)
+	ListBySeller(ctx context.Context, sellerID int) ([]*db.Auction, error)
+	ListByBidder(ctx context.Context, bidderID int) ([]*db.Auction, error)
+	CountOpenBySeller(ctx context.Context, sellerID int) (int, error)
+	ListDue(ctx context.Context, now time.Time) ([]*db.Auction, error)
+}
+
+type entAuctionRepo struct {
+	client *db.Client
+}
+
+func NewEntAuctionRepo(client *db.Client) AuctionRepo {
+	return &entAuctionRepo{client: client}
+}
+
+func (r *entAuctionRepo) Get(ctx context.Context, id int) (*db.Auction, error) {
+	return r.client.Auction.Get(ctx, id)
+}
+
+// ListOpen returns the open listings whose item name contains search (all of
+// them when search is empty), ending soonest first.
+func (r *entAuctionRepo) ListOpen(ctx context.Context, search string) ([]*db.Auction, error) {
+	q := r.client.Auction.Query().Where(auction.StatusEQ(auction.StatusOpen))
+	if search != "" {
+		q = q.Where(auction.ItemNameContainsFold(search))
+	}
+	return q.Order(db.Asc(auction.FieldExpiresAt), db.Asc(auction.FieldID)).All(ctx)
+}
+
+// ListBySeller returns a seller's open and sold listings, newest first.
+func (r *entAuctionRepo) ListBySeller(ctx context.Context, sellerID int) ([]*db.Auction, error) {
+	return r.client.Auction.Query().
+		Where(auction.SellerID(sellerID)).
+		Order(db.Desc(auction.FieldListedAt), db.Desc(auction.FieldID)).
+		All(ctx)
+}
+
+// ListByBidder returns the open listings the character holds the high bid on.
+func (r *entAuctionRepo) ListByBidder(ctx context.Context, bidderID int) ([]*db.Auction, error) {
+	return r.client.Auction.Query().
+		Where(auction.BidderID(bidderID), auction.StatusEQ(auction.StatusOpen)).
+		Order(db.Asc(auction.FieldExpiresAt)).
+		All(ctx)
+}
+
+func (r *entAuctionRepo) CountOpenBySeller(ctx context.Context, sellerID int) (int, error) {
+	return r.client.Auction.Query().
+		Where(auction.SellerID(sellerID), auction.StatusEQ(auction.StatusOpen)).
+		Count(ctx)
+}
+
+// ListDue returns the open listings that have run out of time.
+func (r *entAuctionRepo) ListDue(ctx context.Context, now time.Time) ([]*db.Auction, error) {
+	return r.client.Auction.Query().
+		Where(auction.StatusEQ(auction.StatusOpen), auction.ExpiresAtLTE(now)).
+		All(ctx)
+}
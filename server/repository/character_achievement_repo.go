@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"herbst-server/db"
+	"herbst-server/db/characterachievement"
+)
+
+// CharacterAchievementRepo defines data access for a character's progress
+// towards achievements. Rows are returned with their achievement loaded.
+type CharacterAchievementRepo interface {
+	ListByCharacter(ctx context.Context, charID int) ([]*db.CharacterAchievement, error)
+	ListCompleted(ctx context.Context, charID int) ([]*db.CharacterAchievement, error)
+}
+
+type entCharacterAchievementRepo struct {
+	client *db.Client
+}
+
+func NewEntCharacterAchievementRepo(client *db.Client) CharacterAchievementRepo {
+	return &entCharacterAchievementRepo{client: client}
+}
+
+func (r *entCharacterAchievementRepo) ListByCharacter(ctx context.Context, charID int) ([]*db.CharacterAchievement, error) {
+	return r.client.CharacterAchievement.Query().
+		Where(characterachievement.CharacterID(charID)).
+		WithAchievement().
+		All(ctx)
+}
+
+func (r *entCharacterAchievementRepo) ListCompleted(ctx context.Context, charID int) ([]*db.CharacterAchievement, error) {
+	return r.client.CharacterAchievement.Query().
+		Where(characterachievement.CharacterID(charID), characterachievement.CompletedAtNotNil()).
+		WithAchievement().
+		All(ctx)
+}
@@ -28,7 +28,11 @@ func (r *entCharacterRepo) Create(ctx context.Context, input CreateCharacterInpu
 		SetRace(input.Race).
 		SetGender(input.Gender).
 		SetClass(input.Class).
-		SetUserID(input.UserID)
+		SetIsInstance(input.IsInstance).
+		SetInstanceNumber(input.InstanceNumber)
+	if input.UserID > 0 {
+		builder.SetUserID(input.UserID)
+	}
 	if input.NPCTemplateID != "" {
 		builder.SetNillableNpcTemplateID(&input.NPCTemplateID)
 	}
@@ -143,5 +147,20 @@ func (r *entCharacterRepo) Update(ctx context.Context, id int, updates Character
 	if updates.DiedAt != nil {
 		builder = builder.SetDiedAt(*updates.DiedAt)
 	}
+	if updates.ClearDiedAt {
+		builder = builder.ClearDiedAt()
+	}
+	if updates.Title != nil {
+		builder = builder.SetTitle(*updates.Title)
+	}
+	if updates.VisitedRooms != nil {
+		builder = builder.SetVisitedRooms(updates.VisitedRooms)
+	}
+	if updates.CraftedCounts != nil {
+		builder = builder.SetCraftedCounts(updates.CraftedCounts)
+	}
+	if updates.FoundExits != nil {
+		builder = builder.SetFoundExits(updates.FoundExits)
+	}
 	return builder.Save(ctx)
 }
\ No newline at end of file
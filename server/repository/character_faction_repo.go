@@ -20,6 +20,7 @@ func NewEntCharacterFactionRepo(client *db.Client) CharacterFactionRepo {
 func (r *entCharacterFactionRepo) ListByCharacter(ctx context.Context, charID int) ([]*db.CharacterFaction, error) {
 	return r.client.CharacterFaction.Query().
 		Where(characterfaction.HasCharacterWith(character.ID(charID))).
+		WithFaction().
 		All(ctx)
 }
 
@@ -34,6 +34,14 @@ func (r *entCharacterRepo) ListByUser(ctx context.Context, userID int) ([]*db.Ch
 		All(ctx)
 }
 
+// GetUserID returns the ID of the user who owns a player character.
+func (r *entCharacterRepo) GetUserID(ctx context.Context, charID int) (int, error) {
+	return r.client.Character.Query().
+		Where(character.ID(charID)).
+		QueryUser().
+		OnlyID(ctx)
+}
+
 func (r *entCharacterRepo) ListByRoom(ctx context.Context, roomID int) ([]*db.Character, error) {
 	return r.client.Character.Query().
 		Where(character.CurrentRoomIdEQ(roomID)).
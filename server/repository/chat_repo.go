@@ -18,6 +18,7 @@ type ChannelSubscription struct {
 type OfflineTell struct {
 	ID            int
 	FromID        int
+	FromName      string
 	RecipientID   int
 	RecipientName string
 	Message       string
@@ -48,6 +49,7 @@ type OfflineTellRepo interface {
 	ListByRecipient(ctx context.Context, recipientID int) ([]*OfflineTell, error)
 	ListByRecipientName(ctx context.Context, recipientName string) ([]*OfflineTell, error)
 	Create(ctx context.Context, fromID int, recipientName string, message string) (*OfflineTell, error)
+	CreateNotice(ctx context.Context, recipientID int, fromName string, message string) (*OfflineTell, error)
 	Delete(ctx context.Context, id int) error
 	DeleteByRecipient(ctx context.Context, recipientID int) error
 }
@@ -146,6 +146,7 @@ func (r *entOfflineTellRepo) ListByRecipient(ctx context.Context, recipientID in
 		result[i] = &OfflineTell{
 			ID:            t.ID,
 			FromID:        t.SenderId,
+			FromName:      t.SenderName,
 			RecipientID:   recipientID,
 			RecipientName: t.SenderName,
 			Message:       t.Message,
@@ -197,6 +198,28 @@ func (r *entOfflineTellRepo) Create(ctx context.Context, fromID int, recipientNa
 	}, nil
 }
 
+// CreateNotice queues a tell from the game rather than from a character,
+// such as an outbid notice from the auction house.
+func (r *entOfflineTellRepo) CreateNotice(ctx context.Context, recipientID int, fromName string, message string) (*OfflineTell, error) {
+	tell, err := r.client.TellQueue.Create().
+		SetSenderId(0).
+		SetSenderName(fromName).
+		SetMessage(message).
+		SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
+		SetRecipientID(recipientID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &OfflineTell{
+		ID:          tell.ID,
+		FromName:    fromName,
+		RecipientID: recipientID,
+		Message:     tell.Message,
+		QueuedAt:    tell.SentAt,
+	}, nil
+}
+
 func (r *entOfflineTellRepo) Delete(ctx context.Context, id int) error {
 	return r.client.TellQueue.DeleteOneID(id).Exec(ctx)
 }
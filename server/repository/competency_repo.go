@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"herbst-server/db"
+	"herbst-server/db/character"
 	"herbst-server/db/charactercompetency"
 	"herbst-server/db/competencycategory"
 )
@@ -37,6 +38,7 @@ func (r *entCompetencyRepo) CreateCategory(ctx context.Context, input CreateComp
 func (r *entCompetencyRepo) GetCharacterCompetency(ctx context.Context, charID int, categoryID string) (*db.CharacterCompetency, error) {
 	return r.client.CharacterCompetency.Query().
 		Where(
+			charactercompetency.HasCharacterWith(character.ID(charID)),
 			charactercompetency.HasCategoryWith(competencycategory.ID(categoryID)),
 		).
 		Only(ctx)
@@ -40,6 +40,17 @@ type Container struct {
 	World                WorldRepo
 	CraftingRecipe       CraftingRecipeRepo
 	Trigger              TriggerRepo
+	ShopTemplate         ShopTemplateRepo
+	ShopItem             ShopItemRepo
+	Party                PartyRepo
+	DialogState          DialogStateRepo
+	CharacterAchievement CharacterAchievementRepo
+	ZoneInstance         ZoneInstanceRepo
+	Mail                 MailRepo
+	Auction              AuctionRepo
+	Stash                StashRepo
+	Reputation           ReputationRepo
+	House                HouseRepo
 }
 
 // NewContainer creates all ent-backed repositories.
@@ -79,5 +90,16 @@ func NewContainer(client *db.Client) *Container {
 		World:                NewEntWorldRepo(client),
 		CraftingRecipe:       NewEntCraftingRecipeRepo(client),
 		Trigger:              NewEntTriggerRepo(client),
+		ShopTemplate:         NewShopTemplateRepo(client),
+		ShopItem:             NewShopItemRepo(client),
+		Party:                NewPartyRepo(client),
+		DialogState:          NewEntDialogStateRepo(client),
+		CharacterAchievement: NewEntCharacterAchievementRepo(client),
+		ZoneInstance:         NewEntZoneInstanceRepo(client),
+		Mail:                 NewEntMailRepo(client),
+		Auction:              NewEntAuctionRepo(client),
+		Stash:                NewEntStashRepo(client),
+		Reputation:           NewEntReputationRepo(client),
+		House:                NewEntHouseRepo(client),
 	}
 }
\ No newline at end of file
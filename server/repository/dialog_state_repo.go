@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/dialogstate"
+)
+
+// DialogStateRepo defines data access for per-character NPC conversation
+// memory.
+type DialogStateRepo interface {
+	Get(ctx context.Context, charID int, npcTemplateID string) (*db.DialogState, error)
+	GetOrCreate(ctx context.Context, charID int, npcTemplateID string) (*db.DialogState, error)
+	Update(ctx context.Context, id int, updates DialogStateUpdates) (*db.DialogState, error)
+	ListByCharacter(ctx context.Context, charID int) ([]*db.DialogState, error)
+}
+
+// DialogStateUpdates holds optional fields for updating a DialogState.
+// Flags and Counters replace the stored maps.
+type DialogStateUpdates struct {
+	Flags       map[string]bool
+	Counters    map[string]int
+	TimesTalked *int
+	FirstMetAt  *time.Time
+	LastNodeID  *string
+}
+
+type entDialogStateRepo struct {
+	client *db.Client
+}
+
+func NewEntDialogStateRepo(client *db.Client) DialogStateRepo {
+	return &entDialogStateRepo{client: client}
+}
+
+func (r *entDialogStateRepo) Get(ctx context.Context, charID int, npcTemplateID string) (*db.DialogState, error) {
+	return r.client.DialogState.Query().
+		Where(dialogstate.CharacterID(charID), dialogstate.NpcTemplateID(npcTemplateID)).
+		Only(ctx)
+}
+
+// GetOrCreate returns the character's state with the NPC template, creating
+// an empty one the first time they talk.
+func (r *entDialogStateRepo) GetOrCreate(ctx context.Context, charID int, npcTemplateID string) (*db.DialogState, error) {
+	state, err := r.Get(ctx, charID, npcTemplateID)
+	if err == nil || !db.IsNotFound(err) {
+		return state, err
+	}
+	state, err = r.client.DialogState.Create().
+		SetCharacterID(charID).
+		SetNpcTemplateID(npcTemplateID).
+		SetFlags(map[string]bool{}).
+		SetCounters(map[string]int{}).
+		Save(ctx)
+	if db.IsConstraintError(err) {
+		// Lost a race with another request creating the same row.
+		return r.Get(ctx, charID, npcTemplateID)
+	}
+	return state, err
+}
+
+func (r *entDialogStateRepo) Update(ctx context.Context, id int, updates DialogStateUpdates) (*db.DialogState, error) {
+	builder := r.client.DialogState.UpdateOneID(id)
+	if updates.Flags != nil {
+		builder.SetFlags(updates.Flags)
+	}
+	if updates.Counters != nil {
+		builder.SetCounters(updates.Counters)
+	}
+	if updates.TimesTalked != nil {
+		builder.SetTimesTalked(*updates.TimesTalked)
+	}
+	if updates.FirstMetAt != nil {
+		builder.SetFirstMetAt(*updates.FirstMetAt)
+	}
+	if updates.LastNodeID != nil {
+		builder.SetLastNodeID(*updates.LastNodeID)
+	}
+	return builder.Save(ctx)
+}
+
+func (r *entDialogStateRepo) ListByCharacter(ctx context.Context, charID int) ([]*db.DialogState, error) {
+	return r.client.DialogState.Query().
+		Where(dialogstate.CharacterID(charID)).
+		All(ctx)
+}
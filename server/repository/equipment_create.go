@@ -7,7 +7,17 @@ import (
 )
 
 func (r *entEquipmentRepo) Create(ctx context.Context, input CreateEquipmentInput) (*db.Equipment, error) {
-	builder := r.client.Equipment.Create().
+	return newEquipment(r.client.Equipment, input).Save(ctx)
+}
+
+// CreateEquipmentTx creates an item inside tx, for services that make items
+// as part of a larger change.
+func CreateEquipmentTx(ctx context.Context, tx *db.Tx, input CreateEquipmentInput) (*db.Equipment, error) {
+	return newEquipment(tx.Equipment, input).Save(ctx)
+}
+
+func newEquipment(c *db.EquipmentClient, input CreateEquipmentInput) *db.EquipmentCreate {
+	builder := c.Create().
 		SetName(input.Name).
 		SetDescription(input.Description).
 		SetSlot(input.Slot).
@@ -54,5 +64,5 @@ func (r *entEquipmentRepo) Create(ctx context.Context, input CreateEquipmentInpu
 	if input.ExpiresAt != nil {
 		builder = builder.SetNillableExpiresAt(input.ExpiresAt)
 	}
-	return builder.Save(ctx)
+	return builder
 }
\ No newline at end of file
@@ -122,6 +122,15 @@ func (r *entEquipmentRepo) Update(ctx context.Context, id int, updates Equipment
 	if updates.ExpiresAt != nil {
 		builder = builder.SetExpiresAt(*updates.ExpiresAt)
 	}
+	if updates.Quantity != nil {
+		builder = builder.SetQuantity(*updates.Quantity)
+	}
+	if updates.IsLocked != nil {
+		builder = builder.SetIsLocked(*updates.IsLocked)
+	}
+	if updates.ContainedItems != nil {
+		builder = builder.SetContainedItems(*updates.ContainedItems)
+	}
 	return builder.Save(ctx)
 }
 
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"herbst-server/db"
+	"herbst-server/db/house"
+)
+
+// HouseRepo defines data access for house plots and the houses built on
+// them. Buying a plot is done by the house service in a transaction.
+type HouseRepo interface {
+	Get(ctx context.Context, id int) (*db.House, error)
+	GetByOwner(ctx context.Context, ownerID int) (*db.House, error)
+	List(ctx context.Context) ([]*db.House, error)
+	ListByEntrance(ctx context.Context, roomID int) ([]*db.House, error)
+	Create(ctx context.Context, input CreateHouseInput) (*db.House, error)
+	Delete(ctx context.Context, id int) error
+	SetGuests(ctx context.Context, id int, guestIDs []int) (*db.House, error)
+}
+
+// CreateHouseInput holds the fields for putting a plot up for sale.
+type CreateHouseInput struct {
+	ZoneID         string
+	EntranceRoomID int
+	Direction      string
+	Price          int
+	RoomCount      int
+}
+
+type entHouseRepo struct {
+	client *db.Client
+}
+
+func NewEntHouseRepo(client *db.Client) HouseRepo {
+	return &entHouseRepo{client: client}
+}
+
+func (r *entHouseRepo) Get(ctx context.Context, id int) (*db.House, error) {
+	return r.client.House.Get(ctx, id)
+}
+
+func (r *entHouseRepo) GetByOwner(ctx context.Context, ownerID int) (*db.House, error) {
+	return r.client.House.Query().
+		Where(house.OwnerID(ownerID)).
+		First(ctx)
+}
+
+func (r *entHouseRepo) List(ctx context.Context) ([]*db.House, error) {
+	return r.client.House.Query().
+		Order(db.Asc(house.FieldID)).
+		All(ctx)
+}
+
+func (r *entHouseRepo) ListByEntrance(ctx context.Context, roomID int) ([]*db.House, error) {
+	return r.client.House.Query().
+		Where(house.EntranceRoomID(roomID)).
+		Order(db.Asc(house.FieldDirection)).
+		All(ctx)
+}
+
+func (r *entHouseRepo) Create(ctx context.Context, input CreateHouseInput) (*db.House, error) {
+	return r.client.House.Create().
+		SetZoneID(input.ZoneID).
+		SetEntranceRoomID(input.EntranceRoomID).
+		SetDirection(input.Direction).
+		SetPrice(input.Price).
+		SetRoomCount(input.RoomCount).
+		Save(ctx)
+}
+
+func (r *entHouseRepo) Delete(ctx context.Context, id int) error {
+	return r.client.House.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *entHouseRepo) SetGuests(ctx context.Context, id int, guestIDs []int) (*db.House, error) {
+	return r.client.House.UpdateOneID(id).
+		SetGuestIds(guestIDs).
+		Save(ctx)
+}
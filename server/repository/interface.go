@@ -15,6 +15,7 @@ type CharacterRepo interface {
 	Get(ctx context.Context, id int) (*db.Character, error)
 	GetByName(ctx context.Context, name string) (*db.Character, error)
 	ListByUser(ctx context.Context, userID int) ([]*db.Character, error)
+	GetUserID(ctx context.Context, charID int) (int, error)
 	ListByRoom(ctx context.Context, roomID int) ([]*db.Character, error)
 	ListNPCsByRoom(ctx context.Context, roomID int) ([]*db.Character, error)
 	ListAllNPCs(ctx context.Context) ([]*db.Character, error)
@@ -331,6 +332,8 @@ type CreateCharacterInput struct {
 	IsAdmin          bool
 	IsNPC            bool
 	NPCTemplateID    string
+	IsInstance       bool
+	InstanceNumber   int
 	Strength         int
 	Dexterity        int
 	Constitution     int
@@ -383,6 +386,11 @@ type CharacterUpdates struct {
 	Wisdom          *int
 	Charisma        *int
 	DiedAt          *time.Time
+	ClearDiedAt     bool
+	Title           *string
+	VisitedRooms    []int
+	CraftedCounts   map[string]int
+	FoundExits      []string
 }
 
 type CreateRoomInput struct {
@@ -391,12 +399,15 @@ type CreateRoomInput struct {
 	IsStartingRoom bool
 	IsRootRoom     bool
 	Exits          map[string]int
+	ExitStates     map[string]schema.ExitState
 	Atmosphere     string
 	PosX           int
 	PosY           int
 	PosZ           int
 	WorldID        string
 	ZoneIDs        []string
+	Tags           []string
+	InstanceID     *int
 }
 
 type RoomUpdates struct {
@@ -405,6 +416,7 @@ type RoomUpdates struct {
 	IsStartingRoom *bool
 	IsRootRoom     *bool
 	Exits          *map[string]int
+	ExitStates     *map[string]schema.ExitState
 	Atmosphere     *string
 	PosX           *int
 	PosY           *int
@@ -523,6 +535,9 @@ type EquipmentUpdates struct {
 	WeaponType                *string
 	IsTwoHanded               *bool
 	ExpiresAt                 *time.Time
+	Quantity                  *int
+	IsLocked                  *bool
+	ContainedItems            *string
 }
 
 type CreateNPCTemplateInput struct {
@@ -540,6 +555,8 @@ type CreateNPCTemplateInput struct {
 	RespawnRooms     []string
 	RespawnCooldown  *int
 	WorldID          string
+	LootTable        *schema.LootTable
+	Behavior         *schema.NPCBehavior
 }
 
 type NPCTemplateUpdates struct {
@@ -556,6 +573,8 @@ type NPCTemplateUpdates struct {
 	RespawnRooms     *[]string
 	RespawnCooldown  *int
 	WorldID          *string
+	LootTable        *schema.LootTable
+	Behavior         *schema.NPCBehavior
 }
 
 type CreateAbilityInput struct {
@@ -729,6 +748,7 @@ type CreateAchievementInput struct {
 	Icon        string
 	XPReward    int
 	Criteria    string
+	Title       string
 }
 
 type AchievementUpdates struct {
@@ -737,6 +757,7 @@ type AchievementUpdates struct {
 	Icon        *string
 	XPReward    *int
 	Criteria    *string
+	Title       *string
 }
 
 type CreateCompetencyInput struct {
@@ -869,28 +890,34 @@ type WorldUpdates struct {
 
 // CreateTriggerInput defines the input for creating a trigger.
 type CreateTriggerInput struct {
-	Name        string
-	WorldID     string
-	TriggerType string
-	TargetType  string
-	TargetID    int
-	RoomID      *int
-	EquipmentID *int
-	Condition   string
-	Enabled     bool
+	Name         string
+	WorldID      string
+	TriggerType  string
+	TargetType   string
+	TargetID     int
+	RoomID       *int
+	EquipmentID  *int
+	Condition    string
+	Keyword      string
+	IntervalSecs int
+	Affects      string
+	Enabled      bool
 }
 
 // TriggerUpdates defines the updates for a trigger.
 type TriggerUpdates struct {
-	Name        *string
-	WorldID     *string
-	TriggerType *string
-	TargetType  *string
-	TargetID    *int
-	RoomID      *int
-	EquipmentID *int
-	Condition   *string
-	Enabled     *bool
+	Name         *string
+	WorldID      *string
+	TriggerType  *string
+	TargetType   *string
+	TargetID     *int
+	RoomID       *int
+	EquipmentID  *int
+	Condition    *string
+	Keyword      *string
+	IntervalSecs *int
+	Affects      *string
+	Enabled      *bool
 }
 
 type CreateGenderInput struct {
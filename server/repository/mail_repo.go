@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/mail"
+)
+
+// MailRepo defines data access for player mail. Moving gold and items in
+// and out of escrow is done by the mail service in a transaction.
+type MailRepo interface {
+	Get(ctx context.Context, id int) (*db.Mail, error)
+	ListByRecipient(ctx context.Context, recipientID int) ([]*db.Mail, error)
+	ListExpired(ctx context.Context, now time.Time) ([]*db.Mail, error)
+	Update(ctx context.Context, id int, updates MailUpdates) (*db.Mail, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// MailUpdates holds optional fields for updating a Mail.
+type MailUpdates struct {
+	SenderID    *int
+	SenderName  *string
+	RecipientID *int
+	Cod         *int
+	IsRead      *bool
+	Returned    *bool
+	ExpiresAt   *time.Time
+}
+
+type entMailRepo struct {
+	client *db.Client
+}
+
+func NewEntMailRepo(client *db.Client) MailRepo {
+	return &entMailRepo{client: client}
+}
+
+func (r *entMailRepo) Get(ctx context.Context, id int) (*db.Mail, error) {
+	return r.client.Mail.Get(ctx, id)
+}
+
+// ListByRecipient returns a character's inbox, newest first.
+func (r *entMailRepo) ListByRecipient(ctx context.Context, recipientID int) ([]*db.Mail, error) {
+	return r.client.Mail.Query().
+		Where(mail.RecipientID(recipientID)).
+		Order(db.Desc(mail.FieldSentAt), db.Desc(mail.FieldID)).
+		All(ctx)
+}
+
+func (r *entMailRepo) ListExpired(ctx context.Context, now time.Time) ([]*db.Mail, error) {
+	return r.client.Mail.Query().
+		Where(mail.ExpiresAtLTE(now)).
+		All(ctx)
+}
+
+func (r *entMailRepo) Update(ctx context.Context, id int, updates MailUpdates) (*db.Mail, error) {
+	builder := r.client.Mail.UpdateOneID(id)
+	if updates.SenderID != nil {
+		builder.SetSenderID(*updates.SenderID)
+	}
+	if updates.SenderName != nil {
+		builder.SetSenderName(*updates.SenderName)
+	}
+	if updates.RecipientID != nil {
+		builder.SetRecipientID(*updates.RecipientID)
+	}
+	if updates.Cod != nil {
+		builder.SetCod(*updates.Cod)
+	}
+	if updates.IsRead != nil {
+		builder.SetIsRead(*updates.IsRead)
+	}
+	if updates.Returned != nil {
+		builder.SetReturned(*updates.Returned)
+	}
+	if updates.ExpiresAt != nil {
+		builder.SetExpiresAt(*updates.ExpiresAt)
+	}
+	return builder.Save(ctx)
+}
+
+func (r *entMailRepo) Delete(ctx context.Context, id int) error {
+	return r.client.Mail.DeleteOneID(id).Exec(ctx)
+}
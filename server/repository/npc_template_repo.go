@@ -106,6 +106,12 @@ func (r *entNPCTemplateRepo) Create(ctx context.Context, input CreateNPCTemplate
 	if input.RespawnCooldown != nil {
 		builder = builder.SetNillableRespawnCooldown(input.RespawnCooldown)
 	}
+	if input.LootTable != nil {
+		builder = builder.SetLootTable(*input.LootTable)
+	}
+	if input.Behavior != nil {
+		builder = builder.SetBehavior(*input.Behavior)
+	}
 	return builder.Save(ctx)
 }
 
@@ -150,6 +156,12 @@ func (r *entNPCTemplateRepo) Update(ctx context.Context, id string, updates NPCT
 	if updates.WorldID != nil {
 		builder = builder.SetWorldID(*updates.WorldID)
 	}
+	if updates.LootTable != nil {
+		builder = builder.SetLootTable(*updates.LootTable)
+	}
+	if updates.Behavior != nil {
+		builder = builder.SetBehavior(*updates.Behavior)
+	}
 	return builder.Save(ctx)
 }
 
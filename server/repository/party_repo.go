@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"herbst-server/db"
+)
+
+// PartyRepo defines the repository interface for Party and PartyInvite.
+// Parties are always returned with their members loaded.
+type PartyRepo interface {
+	Get(ctx context.Context, id int) (*db.Party, error)
+	GetForCharacter(ctx context.Context, charID int) (*db.Party, error)
+	Create(ctx context.Context, leaderID int) (*db.Party, error)
+	AddMember(ctx context.Context, partyID, charID int) error
+	RemoveMember(ctx context.Context, partyID, charID int) error
+	SetLeader(ctx context.Context, partyID, charID int) error
+	Delete(ctx context.Context, id int) error
+
+	CreateInvite(ctx context.Context, inviterID, inviteeID int, expiresAt time.Time) (*db.PartyInvite, error)
+	LatestInvite(ctx context.Context, inviteeID int, now time.Time) (*db.PartyInvite, error)
+	DeleteInvites(ctx context.Context, inviteeID int) error
+	DeleteInvitesFrom(ctx context.Context, inviterID int) error
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/character"
+	"herbst-server/db/party"
+	"herbst-server/db/partyinvite"
+	"herbst-server/dblog"
+)
+
+// partyRepo implements PartyRepo.
+type partyRepo struct {
+	client *db.Client
+}
+
+// NewPartyRepo creates a new PartyRepo.
+func NewPartyRepo(client *db.Client) PartyRepo {
+	return &partyRepo{client: client}
+}
+
+// Get returns a Party and its members by ID.
+func (r *partyRepo) Get(ctx context.Context, id int) (*db.Party, error) {
+	p, err := r.client.Party.Query().
+		Where(party.ID(id)).
+		WithMembers().
+		Only(ctx)
+	if err != nil {
+		if !db.IsNotFound(err) {
+			dblog.Error("failed to get party", err, slog.Int("party_id", id))
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// GetForCharacter returns the Party a character belongs to.
+func (r *partyRepo) GetForCharacter(ctx context.Context, charID int) (*db.Party, error) {
+	p, err := r.client.Party.Query().
+		Where(party.HasMembersWith(character.ID(charID))).
+		WithMembers().
+		Only(ctx)
+	if err != nil {
+		if !db.IsNotFound(err) {
+			dblog.Error("failed to get party for character", err, slog.Int("character_id", charID))
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// Create starts a new Party led by (and containing) leaderID.
+func (r *partyRepo) Create(ctx context.Context, leaderID int) (*db.Party, error) {
+	p, err := r.client.Party.Create().
+		SetLeaderID(leaderID).
+		AddMemberIDs(leaderID).
+		Save(ctx)
+	if err != nil {
+		dblog.Error("failed to create party", err, slog.Int("leader_id", leaderID))
+		return nil, err
+	}
+	return r.Get(ctx, p.ID)
+}
+
+// AddMember adds a character to a Party.
+func (r *partyRepo) AddMember(ctx context.Context, partyID, charID int) error {
+	err := r.client.Party.UpdateOneID(partyID).AddMemberIDs(charID).Exec(ctx)
+	if err != nil {
+		dblog.Error("failed to add party member", err, slog.Int("party_id", partyID), slog.Int("character_id", charID))
+	}
+	return err
+}
+
+// RemoveMember removes a character from a Party.
+func (r *partyRepo) RemoveMember(ctx context.Context, partyID, charID int) error {
+	err := r.client.Party.UpdateOneID(partyID).RemoveMemberIDs(charID).Exec(ctx)
+	if err != nil {
+		dblog.Error("failed to remove party member", err, slog.Int("party_id", partyID), slog.Int("character_id", charID))
+	}
+	return err
+}
+
+// SetLeader hands leadership of a Party to charID.
+func (r *partyRepo) SetLeader(ctx context.Context, partyID, charID int) error {
+	err := r.client.Party.UpdateOneID(partyID).SetLeaderID(charID).Exec(ctx)
+	if err != nil {
+		dblog.Error("failed to set party leader", err, slog.Int("party_id", partyID), slog.Int("character_id", charID))
+	}
+	return err
+}
+
+// Delete disbands a Party. Its members are released by the foreign key.
+func (r *partyRepo) Delete(ctx context.Context, id int) error {
+	err := r.client.Party.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		dblog.Error("failed to delete party", err, slog.Int("party_id", id))
+	}
+	return err
+}
+
+// CreateInvite records an invitation to join the inviter's party.
+func (r *partyRepo) CreateInvite(ctx context.Context, inviterID, inviteeID int, expiresAt time.Time) (*db.PartyInvite, error) {
+	inv, err := r.client.PartyInvite.Create().
+		SetInviterID(inviterID).
+		SetInviteeID(inviteeID).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		dblog.Error("failed to create party invite", err, slog.Int("inviter_id", inviterID), slog.Int("invitee_id", inviteeID))
+		return nil, err
+	}
+	return inv, nil
+}
+
+// LatestInvite returns the newest unexpired invite for a character.
+func (r *partyRepo) LatestInvite(ctx context.Context, inviteeID int, now time.Time) (*db.PartyInvite, error) {
+	inv, err := r.client.PartyInvite.Query().
+		Where(
+			partyinvite.InviteeIDEQ(inviteeID),
+			partyinvite.ExpiresAtGT(now),
+		).
+		Order(db.Desc(partyinvite.FieldCreatedAt), db.Desc(partyinvite.FieldID)).
+		First(ctx)
+	if err != nil {
+		if !db.IsNotFound(err) {
+			dblog.Error("failed to get party invite", err, slog.Int("invitee_id", inviteeID))
+		}
+		return nil, err
+	}
+	return inv, nil
+}
+
+// DeleteInvites clears every invite addressed to a character.
+func (r *partyRepo) DeleteInvites(ctx context.Context, inviteeID int) error {
+	_, err := r.client.PartyInvite.Delete().
+		Where(partyinvite.InviteeIDEQ(inviteeID)).
+		Exec(ctx)
+	if err != nil {
+		dblog.Error("failed to delete party invites", err, slog.Int("invitee_id", inviteeID))
+	}
+	return err
+}
+
+// DeleteInvitesFrom clears every outstanding invite a character has sent.
+func (r *partyRepo) DeleteInvitesFrom(ctx context.Context, inviterID int) error {
+	_, err := r.client.PartyInvite.Delete().
+		Where(partyinvite.InviterIDEQ(inviterID)).
+		Exec(ctx)
+	if err != nil {
+		dblog.Error("failed to delete sent party invites", err, slog.Int("inviter_id", inviterID))
+	}
+	return err
+}
@@ -32,6 +32,7 @@ func (r *entQuestProgressRepo) GetWithRelations(ctx context.Context, id int) (*d
 func (r *entQuestProgressRepo) ListByCharacter(ctx context.Context, charID int) ([]*db.QuestProgress, error) {
 	return r.client.QuestProgress.Query().
 		Where(questprogress.HasCharacterWith(character.ID(charID))).
+		WithQuest().
 		All(ctx)
 }
 
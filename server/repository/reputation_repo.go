@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"herbst-server/db"
+	"herbst-server/db/reputation"
+)
+
+// ReputationRepo defines data access for characters' faction standings.
+type ReputationRepo interface {
+	Get(ctx context.Context, charID int, faction string) (*db.Reputation, error)
+	ListByCharacter(ctx context.Context, charID int) ([]*db.Reputation, error)
+	Create(ctx context.Context, charID int, faction string, standing int) (*db.Reputation, error)
+	SetStanding(ctx context.Context, id, standing int) (*db.Reputation, error)
+}
+
+type entReputationRepo struct {
+	client *db.Client
+}
+
+func NewEntReputationRepo(client *db.Client) ReputationRepo {
+	return &entReputationRepo{client: client}
+}
+
+func (r *entReputationRepo) Get(ctx context.Context, charID int, faction string) (*db.Reputation, error) {
+	return r.client.Reputation.Query().
+		Where(reputation.CharacterID(charID), reputation.FactionEqualFold(faction)).
+		First(ctx)
+}
+
+func (r *entReputationRepo) ListByCharacter(ctx context.Context, charID int) ([]*db.Reputation, error) {
+	return r.client.Reputation.Query().
+		Where(reputation.CharacterID(charID)).
+		Order(db.Asc(reputation.FieldFaction)).
+		All(ctx)
+}
+
+func (r *entReputationRepo) Create(ctx context.Context, charID int, faction string, standing int) (*db.Reputation, error) {
+	return r.client.Reputation.Create().
+		SetCharacterID(charID).
+		SetFaction(faction).
+		SetStanding(standing).
+		Save(ctx)
+}
+
+func (r *entReputationRepo) SetStanding(ctx context.Context, id, standing int) (*db.Reputation, error) {
+	return r.client.Reputation.UpdateOneID(id).
+		SetStanding(standing).
+		Save(ctx)
+}
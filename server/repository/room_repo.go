@@ -20,7 +20,8 @@ func (r *entRoomRepo) Get(ctx context.Context, id int) (*db.Room, error) {
 }
 
 func (r *entRoomRepo) List(ctx context.Context, worldID string) ([]*db.Room, error) {
-	query := r.client.Room.Query()
+	// Instance copies are private and left out of world listings.
+	query := r.client.Room.Query().Where(room.InstanceIDIsNil())
 	if worldID != "" {
 		query = query.Where(room.WorldID(worldID))
 	}
@@ -50,6 +51,15 @@ func (r *entRoomRepo) Create(ctx context.Context, input CreateRoomInput) (*db.Ro
 	if len(input.ZoneIDs) > 0 {
 		builder = builder.SetZoneIds(input.ZoneIDs)
 	}
+	if len(input.Tags) > 0 {
+		builder = builder.SetTags(input.Tags)
+	}
+	if len(input.ExitStates) > 0 {
+		builder = builder.SetExitStates(input.ExitStates)
+	}
+	if input.InstanceID != nil {
+		builder = builder.SetInstanceID(*input.InstanceID)
+	}
 	return builder.Save(ctx)
 }
 
@@ -70,6 +80,9 @@ func (r *entRoomRepo) Update(ctx context.Context, id int, updates RoomUpdates) (
 	if updates.Exits != nil {
 		builder = builder.SetExits(*updates.Exits)
 	}
+	if updates.ExitStates != nil {
+		builder = builder.SetExitStates(*updates.ExitStates)
+	}
 	if updates.Atmosphere != nil {
 		builder = builder.SetAtmosphere(room.Atmosphere(*updates.Atmosphere))
 	}
@@ -10,6 +10,8 @@ import (
 type ShopTemplateRepo interface {
 	Get(ctx context.Context, id int) (*db.ShopTemplate, error)
 	List(ctx context.Context, worldID string) ([]*db.ShopTemplate, error)
+	ListActive(ctx context.Context) ([]*db.ShopTemplate, error)
+	GetForNPC(ctx context.Context, npcID int, npcTemplateID string) (*db.ShopTemplate, error)
 	Create(ctx context.Context, input CreateShopTemplateInput) (*db.ShopTemplate, error)
 	Update(ctx context.Context, id int, updates ShopTemplateUpdates) (*db.ShopTemplate, error)
 	Delete(ctx context.Context, id int) error
@@ -17,22 +19,26 @@ type ShopTemplateRepo interface {
 
 // CreateShopTemplateInput is the input for creating a ShopTemplate.
 type CreateShopTemplateInput struct {
-	Name             string `json:"name"`
-	WorldID          string `json:"world_id"`
-	NPCTemplateID    string `json:"npc_template_id,omitempty"`
-	CurrencyItemType int    `json:"currency_item_type,omitempty"`
-	MaxInventory     int    `json:"max_inventory"`
-	GoldReserves     int    `json:"gold_reserves"`
-	IsActive         bool   `json:"is_active"`
+	Name             string  `json:"name"`
+	WorldID          string  `json:"world_id"`
+	NPCTemplateID    string  `json:"npc_template_id,omitempty"`
+	CurrencyItemType int     `json:"currency_item_type,omitempty"`
+	MaxInventory     int     `json:"max_inventory"`
+	GoldReserves     int     `json:"gold_reserves"`
+	IsActive         bool    `json:"is_active"`
+	RestockInterval  int     `json:"restock_interval_seconds"`
+	BuybackRatio     float64 `json:"buyback_ratio"`
 }
 
 // ShopTemplateUpdates is the input for updating a ShopTemplate.
 type ShopTemplateUpdates struct {
-	Name             *string `json:"name,omitempty"`
-	WorldID          *string `json:"world_id,omitempty"`
-	NPCTemplateID    *string `json:"npc_template_id,omitempty"`
-	CurrencyItemType *int    `json:"currency_item_type,omitempty"`
-	MaxInventory     *int    `json:"max_inventory,omitempty"`
-	GoldReserves     *int    `json:"gold_reserves,omitempty"`
-	IsActive         *bool   `json:"is_active,omitempty"`
+	Name             *string  `json:"name,omitempty"`
+	WorldID          *string  `json:"world_id,omitempty"`
+	NPCTemplateID    *string  `json:"npc_template_id,omitempty"`
+	CurrencyItemType *int     `json:"currency_item_type,omitempty"`
+	MaxInventory     *int     `json:"max_inventory,omitempty"`
+	GoldReserves     *int     `json:"gold_reserves,omitempty"`
+	IsActive         *bool    `json:"is_active,omitempty"`
+	RestockInterval  *int     `json:"restock_interval_seconds,omitempty"`
+	BuybackRatio     *float64 `json:"buyback_ratio,omitempty"`
 }
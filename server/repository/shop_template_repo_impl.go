@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"herbst-server/db"
+	"herbst-server/db/character"
 	"herbst-server/db/shoptemplate"
 	"herbst-server/dblog"
 )
@@ -43,6 +44,34 @@ func (r *shopTemplateRepo) List(ctx context.Context, worldID string) ([]*db.Shop
 	return templates, nil
 }
 
+// ListActive returns all open shops across every world.
+func (r *shopTemplateRepo) ListActive(ctx context.Context) ([]*db.ShopTemplate, error) {
+	templates, err := r.client.ShopTemplate.Query().
+		Where(shoptemplate.IsActiveEQ(true)).
+		All(ctx)
+	if err != nil {
+		dblog.Error("failed to list active shop templates", err)
+		return nil, err
+	}
+	return templates, nil
+}
+
+// GetForNPC returns the open shop an NPC instance serves. A shop linked
+// directly to the instance wins over one linked to its NPC template.
+func (r *shopTemplateRepo) GetForNPC(ctx context.Context, npcID int, npcTemplateID string) (*db.ShopTemplate, error) {
+	shop, err := r.client.Character.Query().
+		Where(character.ID(npcID)).
+		QueryShopTemplate().
+		Where(shoptemplate.IsActiveEQ(true)).
+		First(ctx)
+	if err == nil || !db.IsNotFound(err) || npcTemplateID == "" {
+		return shop, err
+	}
+	return r.client.ShopTemplate.Query().
+		Where(shoptemplate.NpcTemplateIDEQ(npcTemplateID), shoptemplate.IsActiveEQ(true)).
+		First(ctx)
+}
+
 // Create creates a new ShopTemplate.
 func (r *shopTemplateRepo) Create(ctx context.Context, input CreateShopTemplateInput) (*db.ShopTemplate, error) {
 	builder := r.client.ShopTemplate.Create().
@@ -59,6 +88,12 @@ func (r *shopTemplateRepo) Create(ctx context.Context, input CreateShopTemplateI
 	if input.CurrencyItemType != 0 {
 		builder = builder.SetCurrencyItemType(input.CurrencyItemType)
 	}
+	if input.RestockInterval > 0 {
+		builder = builder.SetRestockIntervalSeconds(input.RestockInterval)
+	}
+	if input.BuybackRatio > 0 {
+		builder = builder.SetBuybackRatio(input.BuybackRatio)
+	}
 
 	template, err := builder.Save(ctx)
 	if err != nil {
@@ -93,6 +128,12 @@ func (r *shopTemplateRepo) Update(ctx context.Context, id int, updates ShopTempl
 	if updates.IsActive != nil {
 		query.SetIsActive(*updates.IsActive)
 	}
+	if updates.RestockInterval != nil {
+		query.SetRestockIntervalSeconds(*updates.RestockInterval)
+	}
+	if updates.BuybackRatio != nil {
+		query.SetBuybackRatio(*updates.BuybackRatio)
+	}
 
 	template, err := query.Save(ctx)
 	if err != nil {
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"herbst-server/db"
+	"herbst-server/db/stash"
+)
+
+// StashRepo defines data access for bank stashes. Moving gold and items in
+// and out of a stash is done by the bank service in a transaction.
+type StashRepo interface {
+	GetForCharacter(ctx context.Context, charID int) (*db.Stash, error)
+	GetForAccount(ctx context.Context, userID, worldID int) (*db.Stash, error)
+	Create(ctx context.Context, input CreateStashInput) (*db.Stash, error)
+}
+
+// CreateStashInput holds the fields for creating a Stash. Set CharacterID
+// for a character stash, or UserID and WorldID for an account stash.
+type CreateStashInput struct {
+	CharacterID int
+	UserID      int
+	WorldID     int
+	Slots       int
+}
+
+type entStashRepo struct {
+	client *db.Client
+}
+
+func NewEntStashRepo(client *db.Client) StashRepo {
+	return &entStashRepo{client: client}
+}
+
+func (r *entStashRepo) GetForCharacter(ctx context.Context, charID int) (*db.Stash, error) {
+	return r.client.Stash.Query().
+		Where(stash.CharacterID(charID), stash.UserID(0), stash.WorldID(0)).
+		Only(ctx)
+}
+
+func (r *entStashRepo) GetForAccount(ctx context.Context, userID, worldID int) (*db.Stash, error) {
+	return r.client.Stash.Query().
+		Where(stash.CharacterID(0), stash.UserID(userID), stash.WorldID(worldID)).
+		Only(ctx)
+}
+
+func (r *entStashRepo) Create(ctx context.Context, input CreateStashInput) (*db.Stash, error) {
+	return r.client.Stash.Create().
+		SetCharacterID(input.CharacterID).
+		SetUserID(input.UserID).
+		SetWorldID(input.WorldID).
+		SetSlots(input.Slots).
+		Save(ctx)
+}
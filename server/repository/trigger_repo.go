@@ -94,6 +94,15 @@ func (r *entTriggerRepo) Create(ctx context.Context, input CreateTriggerInput) (
 	if input.Condition != "" {
 		builder = builder.SetCondition(input.Condition)
 	}
+	if input.Keyword != "" {
+		builder = builder.SetKeyword(input.Keyword)
+	}
+	if input.IntervalSecs != 0 {
+		builder = builder.SetIntervalSecs(input.IntervalSecs)
+	}
+	if input.Affects != "" {
+		builder = builder.SetAffects(input.Affects)
+	}
 	return builder.Save(ctx)
 }
 
@@ -131,6 +140,15 @@ func (r *entTriggerRepo) Update(ctx context.Context, id int, updates TriggerUpda
 	if updates.Condition != nil {
 		builder = builder.SetCondition(*updates.Condition)
 	}
+	if updates.Keyword != nil {
+		builder = builder.SetKeyword(*updates.Keyword)
+	}
+	if updates.IntervalSecs != nil {
+		builder = builder.SetIntervalSecs(*updates.IntervalSecs)
+	}
+	if updates.Affects != nil {
+		builder = builder.SetAffects(*updates.Affects)
+	}
 	if updates.Enabled != nil {
 		builder = builder.SetEnabled(*updates.Enabled)
 	}
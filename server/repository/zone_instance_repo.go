@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/zoneinstance"
+)
+
+// ZoneInstanceRepo defines data access for private copies of instanced
+// zones.
+type ZoneInstanceRepo interface {
+	Get(ctx context.Context, id int) (*db.ZoneInstance, error)
+	ListAll(ctx context.Context) ([]*db.ZoneInstance, error)
+	ListByZone(ctx context.Context, zoneID string) ([]*db.ZoneInstance, error)
+	FindForParty(ctx context.Context, zoneID string, partyID int) (*db.ZoneInstance, error)
+	FindSolo(ctx context.Context, zoneID string, ownerID int) (*db.ZoneInstance, error)
+	Create(ctx context.Context, input CreateZoneInstanceInput) (*db.ZoneInstance, error)
+	Update(ctx context.Context, id int, updates ZoneInstanceUpdates) (*db.ZoneInstance, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// CreateZoneInstanceInput holds the fields for opening a copy. Rooms are
+// copied afterwards and recorded with Update.
+type CreateZoneInstanceInput struct {
+	ZoneID       string
+	OwnerID      int
+	PartyID      *int
+	ReturnRoomID int
+	ExpiresAt    time.Time
+}
+
+// ZoneInstanceUpdates holds optional fields for updating a ZoneInstance.
+type ZoneInstanceUpdates struct {
+	RoomMap         map[int]int
+	ItemMap         map[int]int
+	EntryRoomID     *int
+	LastResetAt     *time.Time
+	EmptySince      *time.Time
+	ClearEmptySince bool
+}
+
+type entZoneInstanceRepo struct {
+	client *db.Client
+}
+
+func NewEntZoneInstanceRepo(client *db.Client) ZoneInstanceRepo {
+	return &entZoneInstanceRepo{client: client}
+}
+
+func (r *entZoneInstanceRepo) Get(ctx context.Context, id int) (*db.ZoneInstance, error) {
+	return r.client.ZoneInstance.Get(ctx, id)
+}
+
+func (r *entZoneInstanceRepo) ListAll(ctx context.Context) ([]*db.ZoneInstance, error) {
+	return r.client.ZoneInstance.Query().All(ctx)
+}
+
+func (r *entZoneInstanceRepo) ListByZone(ctx context.Context, zoneID string) ([]*db.ZoneInstance, error) {
+	return r.client.ZoneInstance.Query().
+		Where(zoneinstance.ZoneID(zoneID)).
+		Order(db.Asc(zoneinstance.FieldCreatedAt)).
+		All(ctx)
+}
+
+func (r *entZoneInstanceRepo) FindForParty(ctx context.Context, zoneID string, partyID int) (*db.ZoneInstance, error) {
+	return r.client.ZoneInstance.Query().
+		Where(zoneinstance.ZoneID(zoneID), zoneinstance.PartyID(partyID)).
+		First(ctx)
+}
+
+// FindSolo returns the character's own copy of the zone, ignoring copies
+// they opened for a party.
+func (r *entZoneInstanceRepo) FindSolo(ctx context.Context, zoneID string, ownerID int) (*db.ZoneInstance, error) {
+	return r.client.ZoneInstance.Query().
+		Where(zoneinstance.ZoneID(zoneID), zoneinstance.OwnerID(ownerID), zoneinstance.PartyIDIsNil()).
+		First(ctx)
+}
+
+func (r *entZoneInstanceRepo) Create(ctx context.Context, input CreateZoneInstanceInput) (*db.ZoneInstance, error) {
+	return r.client.ZoneInstance.Create().
+		SetZoneID(input.ZoneID).
+		SetOwnerID(input.OwnerID).
+		SetNillablePartyID(input.PartyID).
+		SetRoomMap(map[int]int{}).
+		SetEntryRoomID(0).
+		SetReturnRoomID(input.ReturnRoomID).
+		SetExpiresAt(input.ExpiresAt).
+		Save(ctx)
+}
+
+func (r *entZoneInstanceRepo) Update(ctx context.Context, id int, updates ZoneInstanceUpdates) (*db.ZoneInstance, error) {
+	builder := r.client.ZoneInstance.UpdateOneID(id)
+	if updates.RoomMap != nil {
+		builder.SetRoomMap(updates.RoomMap)
+	}
+	if updates.ItemMap != nil {
+		builder.SetItemMap(updates.ItemMap)
+	}
+	if updates.EntryRoomID != nil {
+		builder.SetEntryRoomID(*updates.EntryRoomID)
+	}
+	if updates.LastResetAt != nil {
+		builder.SetLastResetAt(*updates.LastResetAt)
+	}
+	if updates.EmptySince != nil {
+		builder.SetEmptySince(*updates.EmptySince)
+	}
+	if updates.ClearEmptySince {
+		builder.ClearEmptySince()
+	}
+	return builder.Save(ctx)
+}
+
+func (r *entZoneInstanceRepo) Delete(ctx context.Context, id int) error {
+	return r.client.ZoneInstance.DeleteOneID(id).Exec(ctx)
+}
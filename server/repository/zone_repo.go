@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"herbst-server/db"
+	"herbst-server/db/schema"
 	"herbst-server/db/zone"
 )
 
@@ -18,6 +20,7 @@ type ZoneRepository interface {
 	Get(ctx context.Context, id string) (*db.Zone, error)
 	GetByName(ctx context.Context, name, worldID string) (*db.Zone, error)
 	ListByWorld(ctx context.Context, worldID string) ([]*db.Zone, error)
+	ListAll(ctx context.Context) ([]*db.Zone, error)
 	Update(ctx context.Context, id string, updates ZoneUpdates) (*db.Zone, error)
 	Delete(ctx context.Context, id string) error
 	GetByParent(ctx context.Context, parentZoneID string) ([]*db.Zone, error)
@@ -51,6 +54,16 @@ func (r *entZoneRepo) Create(ctx context.Context, input CreateZoneInput) (*db.Zo
 	if len(input.RoomIDs) > 0 {
 		builder = builder.SetRoomIds(input.RoomIDs)
 	}
+	if len(input.Resets) > 0 {
+		builder = builder.SetResets(input.Resets)
+	}
+	builder = builder.SetResetIntervalSecs(input.ResetIntervalSecs)
+	if input.Weather != nil {
+		builder = builder.SetWeather(*input.Weather)
+	}
+	if input.Instance != nil {
+		builder = builder.SetInstance(*input.Instance)
+	}
 	return builder.Save(ctx)
 }
 
@@ -70,6 +83,10 @@ func (r *entZoneRepo) ListByWorld(ctx context.Context, worldID string) ([]*db.Zo
 		All(ctx)
 }
 
+func (r *entZoneRepo) ListAll(ctx context.Context) ([]*db.Zone, error) {
+	return r.client.Zone.Query().All(ctx)
+}
+
 func (r *entZoneRepo) Update(ctx context.Context, id string, updates ZoneUpdates) (*db.Zone, error) {
 	builder := r.client.Zone.UpdateOneID(id)
 	if updates.Name != nil {
@@ -90,6 +107,30 @@ func (r *entZoneRepo) Update(ctx context.Context, id string, updates ZoneUpdates
 	if updates.RoomIDs != nil {
 		builder = builder.SetRoomIds(*updates.RoomIDs)
 	}
+	if updates.Resets != nil {
+		builder = builder.SetResets(*updates.Resets)
+	}
+	if updates.ResetIntervalSecs != nil {
+		builder = builder.SetResetIntervalSecs(*updates.ResetIntervalSecs)
+	}
+	if updates.LastResetAt != nil {
+		builder = builder.SetLastResetAt(*updates.LastResetAt)
+	}
+	if updates.LastResetReport != nil {
+		builder = builder.SetLastResetReport(*updates.LastResetReport)
+	}
+	if updates.Weather != nil {
+		builder = builder.SetWeather(*updates.Weather)
+	}
+	if updates.CurrentWeather != nil {
+		builder = builder.SetCurrentWeather(*updates.CurrentWeather)
+	}
+	if updates.WeatherChangedAt != nil {
+		builder = builder.SetWeatherChangedAt(*updates.WeatherChangedAt)
+	}
+	if updates.Instance != nil {
+		builder = builder.SetInstance(*updates.Instance)
+	}
 	return builder.Save(ctx)
 }
 
@@ -150,21 +191,33 @@ func (r *entZoneRepo) GetByParent(ctx context.Context, parentZoneID string) ([]*
 }
 
 type CreateZoneInput struct {
-	ID           string
-	WorldID      string
-	Name         string
-	Description  string
-	MinLevel     int
-	ParentZoneID string
-	Color        string
-	RoomIDs      []int
+	ID                string
+	WorldID           string
+	Name              string
+	Description       string
+	MinLevel          int
+	ParentZoneID      string
+	Color             string
+	RoomIDs           []int
+	Resets            []schema.ZoneReset
+	ResetIntervalSecs int
+	Weather           *schema.ZoneWeather
+	Instance          *schema.ZoneInstancing
 }
 
 type ZoneUpdates struct {
-	Name         *string
-	Description  *string
-	MinLevel     *int
-	ParentZoneID *string
-	Color        *string
-	RoomIDs      *[]int
+	Name              *string
+	Description       *string
+	MinLevel          *int
+	ParentZoneID      *string
+	Color             *string
+	RoomIDs           *[]int
+	Resets            *[]schema.ZoneReset
+	ResetIntervalSecs *int
+	LastResetAt       *time.Time
+	LastResetReport   *schema.ZoneResetReport
+	Weather           *schema.ZoneWeather
+	CurrentWeather    *string
+	WeatherChangedAt  *time.Time
+	Instance          *schema.ZoneInstancing
 }
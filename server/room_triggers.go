@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"herbst-server/service"
+)
+
+// startRoomTriggers runs a background goroutine that fires timer triggers.
+// Timer intervals are whole seconds, so checking every 5s keeps ambient
+// echoes close to their cadence without hammering the database.
+func startRoomTriggers(services *service.Container) {
+	interval := 5 * time.Second
+	log.Printf("[room-triggers] running: checking timer triggers every %s", interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := services.RoomTrigger.Tick(context.Background(), time.Now()); err != nil {
+				log.Printf("[room-triggers] tick error: %v", err)
+			}
+			<-ticker.C
+		}
+	}()
+}
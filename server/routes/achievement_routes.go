@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"herbst-server/db"
 	"herbst-server/dblog"
+	"herbst-server/events"
 	"herbst-server/middleware"
 	"herbst-server/repository"
 )
@@ -20,6 +21,7 @@ type achievementView struct {
 	Icon      string `json:"icon"`
 	XPReward  int    `json:"xp_reward"`
 	Criteria  string `json:"criteria"`
+	Title     string `json:"title,omitempty"`
 }
 
 // achievementInput is the request body for create and update.
@@ -29,6 +31,7 @@ type achievementInput struct {
 	Icon     string `json:"icon"`
 	XPReward *int  `json:"xp_reward"`
 	Criteria string `json:"criteria"`
+	Title    string `json:"title"`
 }
 
 func achievementToView(a *db.Achievement) achievementView {
@@ -39,9 +42,24 @@ func achievementToView(a *db.Achievement) achievementView {
 		Icon:     a.Icon,
 		XPReward: a.XpReward,
 		Criteria: a.Criteria,
+		Title:    a.Title,
 	}
 }
 
+// validCriteria rejects criteria the achievement subscriber could never
+// match. Empty criteria are allowed; such achievements are never awarded.
+func validCriteria(c *gin.Context, criteria string) bool {
+	if criteria == "" {
+		return true
+	}
+	if _, err := events.ParseAchievementCriteria(criteria); err != nil {
+		slog.Warn("bad request", slog.String("service", "achievements"), slog.String("reason", err.Error()), slog.String("client_ip", c.ClientIP()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
 // RegisterAchievementRoutes registers REST endpoints for achievements.
 func RegisterAchievementRoutes(r *gin.Engine, repos *repository.Container) {
 	achievements := r.Group("/api")
@@ -87,6 +105,10 @@ func createAchievement(repos *repository.Container) gin.HandlerFunc {
 			return
 		}
 
+		if !validCriteria(c, input.Criteria) {
+			return
+		}
+
 		xpReward := 0
 		if input.XPReward != nil {
 			xpReward = *input.XPReward
@@ -98,6 +120,7 @@ func createAchievement(repos *repository.Container) gin.HandlerFunc {
 			Icon:        input.Icon,
 			XPReward:    xpReward,
 			Criteria:    input.Criteria,
+			Title:       input.Title,
 		})
 		if err != nil {
 			dblog.Error("create achievement failed", err, slog.String("service", "achievements"))
@@ -151,10 +174,15 @@ func updateAchievement(repos *repository.Container) gin.HandlerFunc {
 			return
 		}
 
+		if !validCriteria(c, input.Criteria) {
+			return
+		}
+
 		updates := repository.AchievementUpdates{
 			Description: &input.Desc,
 			Icon:        &input.Icon,
 			Criteria:    &input.Criteria,
+			Title:       &input.Title,
 		}
 		if input.Name != "" {
 			updates.Name = &input.Name
@@ -0,0 +1,205 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterAuctionRoutes registers the auction house endpoints: browsing open
+// auctions and the character's own, listing an item, bidding, buying out,
+// cancelling, and collecting the proceeds of sales.
+func RegisterAuctionRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/auctions", auctionBrowseHandler(svc, repos))
+		chars.GET("/:id/auctions/mine", auctionMineHandler(svc, repos))
+		chars.POST("/:id/auctions", auctionListHandler(svc, repos))
+		chars.POST("/:id/auctions/collect", auctionCollectHandler(svc, repos))
+		chars.POST("/:id/auctions/:auction_id/bid", auctionBidHandler(svc, repos))
+		chars.POST("/:id/auctions/:auction_id/buyout", auctionBuyoutHandler(svc, repos))
+		chars.DELETE("/:id/auctions/:auction_id", auctionCancelHandler(svc, repos))
+	}
+}
+
+// auctionErrorStatus maps auction service errors to HTTP status codes.
+func auctionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrAuctionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNotAtAuctionHouse),
+		errors.Is(err, service.ErrInsufficientGold),
+		errors.Is(err, service.ErrTooManyAuctions),
+		errors.Is(err, service.ErrAuctionClosed),
+		errors.Is(err, service.ErrAuctionChanged),
+		errors.Is(err, service.ErrAuctionHasBids),
+		errors.Is(err, service.ErrNoAuctionProceeds):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrNotAuctionSeller):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrAuctionItem),
+		errors.Is(err, service.ErrAuctionPrice),
+		errors.Is(err, service.ErrAuctionDuration),
+		errors.Is(err, service.ErrAuctionOwnBid),
+		errors.Is(err, service.ErrBidTooLow),
+		errors.Is(err, service.ErrNoBuyout):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondAuctionError(c *gin.Context, err error, charID int) {
+	status := auctionErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("auction request failed", err, slog.String("service", "auction"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+// auctionID parses the :auction_id path parameter.
+func auctionID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("auction_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid auction id"})
+		return 0, false
+	}
+	return id, true
+}
+
+func auctionBrowseHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		auctions, err := svc.Auction.Browse(c.Request.Context(), ch.ID, c.Query("q"))
+		if err != nil {
+			respondAuctionError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"auctions": auctions})
+	}
+}
+
+func auctionMineHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		mine, err := svc.Auction.Mine(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondAuctionError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, mine)
+	}
+}
+
+func auctionListHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req service.ListAuctionInput
+		if err := c.ShouldBindJSON(&req); err != nil || req.ItemID == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "item_id is required"})
+			return
+		}
+		listed, err := svc.Auction.List(c.Request.Context(), ch.ID, req)
+		if err != nil {
+			respondAuctionError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, listed)
+	}
+}
+
+func auctionCollectHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		gold, err := svc.Auction.Collect(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondAuctionError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"gold": gold})
+	}
+}
+
+func auctionBidHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		id, ok := auctionID(c)
+		if !ok {
+			return
+		}
+		var req struct {
+			Amount int `json:"amount"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil || req.Amount <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "amount is required"})
+			return
+		}
+		view, err := svc.Auction.Bid(c.Request.Context(), ch.ID, id, req.Amount)
+		if err != nil {
+			respondAuctionError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+func auctionBuyoutHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		id, ok := auctionID(c)
+		if !ok {
+			return
+		}
+		view, err := svc.Auction.Buyout(c.Request.Context(), ch.ID, id)
+		if err != nil {
+			respondAuctionError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+func auctionCancelHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		id, ok := auctionID(c)
+		if !ok {
+			return
+		}
+		if err := svc.Auction.Cancel(c.Request.Context(), ch.ID, id); err != nil {
+			respondAuctionError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "auction cancelled"})
+	}
+}
@@ -0,0 +1,124 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterBankRoutes registers the bank endpoints: viewing the character's
+// stash, depositing and withdrawing gold and items, and buying more slots.
+func RegisterBankRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/bank", bankViewHandler(svc, repos))
+		chars.POST("/:id/bank/deposit", bankDepositHandler(svc, repos))
+		chars.POST("/:id/bank/withdraw", bankWithdrawHandler(svc, repos))
+		chars.POST("/:id/bank/expand", bankExpandHandler(svc, repos))
+	}
+}
+
+// bankErrorStatus maps bank service errors to HTTP status codes.
+func bankErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrNoAccountStash),
+		errors.Is(err, service.ErrNotInStash):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNotAtBank),
+		errors.Is(err, service.ErrInsufficientGold),
+		errors.Is(err, service.ErrBankGold),
+		errors.Is(err, service.ErrStashFull),
+		errors.Is(err, service.ErrStashMaxed),
+		errors.Is(err, service.ErrBankBusy):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrBankAmount),
+		errors.Is(err, service.ErrBankNothing),
+		errors.Is(err, service.ErrBankItem),
+		errors.Is(err, service.ErrBankBoundItem):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondBankError(c *gin.Context, err error, charID int) {
+	status := bankErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("bank request failed", err, slog.String("service", "bank"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+func bankViewHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		view, err := svc.Bank.View(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondBankError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+func bankDepositHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return bankTransferHandler(repos, svc.Bank.Deposit)
+}
+
+func bankWithdrawHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return bankTransferHandler(repos, svc.Bank.Withdraw)
+}
+
+// bankTransferHandler binds a BankTransferInput and hands it to a deposit
+// or withdrawal.
+func bankTransferHandler(repos *repository.Container, transfer func(ctx context.Context, charID int, in service.BankTransferInput) (*service.BankView, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req service.BankTransferInput
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "gold or item_ids is required"})
+			return
+		}
+		view, err := transfer(c.Request.Context(), ch.ID, req)
+		if err != nil {
+			respondBankError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+func bankExpandHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req struct {
+			Account bool `json:"account"`
+		}
+		// The body is optional; without one the character's own stash grows.
+		_ = c.ShouldBindJSON(&req)
+		view, err := svc.Bank.Expand(c.Request.Context(), ch.ID, req.Account)
+		if err != nil {
+			respondBankError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
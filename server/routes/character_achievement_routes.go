@@ -0,0 +1,134 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// RegisterCharacterAchievementRoutes registers the endpoints for listing a
+// character's achievements and choosing the title they earned, and the who
+// list of characters online.
+func RegisterCharacterAchievementRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	api := r.Group("/api")
+	api.Use(middleware.AuthMiddleware(nil))
+	{
+		api.GET("/characters/:id/achievements", characterAchievementsHandler(svc, repos))
+		api.PUT("/characters/:id/title", characterTitleHandler(svc, repos))
+		api.GET("/who", whoHandler(repos))
+	}
+}
+
+func respondAchievementError(c *gin.Context, err error, charID int) {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrTitleNotEarned):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		dblog.Error("achievement request failed", err, slog.String("service", "achievements"), slog.Int("character_id", charID))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func characterAchievementsHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		view, err := svc.Achievement.List(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondAchievementError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+// titleRequest is the body for PUT /characters/:id/title.
+type titleRequest struct {
+	Title string `json:"title"`
+}
+
+func characterTitleHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req titleRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+			return
+		}
+		title, err := svc.Achievement.SetTitle(c.Request.Context(), ch.ID, req.Title)
+		if err != nil {
+			respondAchievementError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"title": title})
+	}
+}
+
+// whoEntry is one line of the who list.
+type whoEntry struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Title string `json:"title,omitempty"`
+	Level int    `json:"level"`
+	Race  string `json:"race,omitempty"`
+	Class string `json:"class,omitempty"`
+}
+
+func whoHandler(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		players, err := onlinePlayers(c.Request.Context(), repos)
+		if err != nil {
+			dblog.Error("who list failed", err, slog.String("service", "characters"))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load players"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"players": players})
+	}
+}
+
+// onlinePlayers lists player characters that are connected over WebSocket,
+// listening to their event stream, or were seen in the last two minutes.
+func onlinePlayers(ctx context.Context, repos *repository.Container) ([]whoEntry, error) {
+	chars, err := repos.Character.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	connected := connectedCharacterIDs()
+	players := []whoEntry{}
+	for _, ch := range chars {
+		if ch.IsNPC {
+			continue
+		}
+		if !connected[ch.ID] && !stream.Default().Listening(ch.ID) &&
+			(ch.LastSeenAt == nil || time.Since(*ch.LastSeenAt) > 2*time.Minute) {
+			continue
+		}
+		players = append(players, whoEntry{
+			ID:    ch.ID,
+			Name:  ch.Name,
+			Title: ch.Title,
+			Level: ch.Level,
+			Race:  ch.Race,
+			Class: ch.Class,
+		})
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i].Name < players[j].Name })
+	return players, nil
+}
@@ -32,6 +32,7 @@ type EquipmentItem struct {
 
 type CharacterExamineResponse struct {
 	Name            string           `json:"name,omitempty"`
+	Title           string           `json:"title,omitempty"`
 	Description     string           `json:"description,omitempty"`
 	Race            string           `json:"race,omitempty"`
 	Level           int              `json:"level,omitempty"`
@@ -124,6 +125,7 @@ func buildCharacterExamineResponse(c *gin.Context, char *db.Character, config Ex
 
 	if config.ShowName {
 		response.Name = char.Name
+		response.Title = char.Title
 	}
 	if config.ShowDescription && char.Description != "" {
 		response.Description = char.Description
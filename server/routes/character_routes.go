@@ -23,7 +23,6 @@ func RegisterCharacterRoutes(router *gin.Engine, svc *service.Container, repos *
 		chars.POST("", createCharacter(svc, repos))
 		chars.GET("", listCharacters(repos))
 		chars.GET("/:id", getCharacter(repos))
-		chars.PUT("/:id", updateCharacter(repos))
 		chars.DELETE("/:id", deleteCharacter(repos))
 				chars.GET("/:id/class", getCharacterClass(repos))
 		chars.PUT("/:id/class", updateCharacterClass(repos))
@@ -52,11 +51,16 @@ func RegisterCharacterRoutes(router *gin.Engine, svc *service.Container, repos *
 	// NPC routes
 	router.GET("/npcs/room/:id", getNPCsByRoom(repos))
 	router.GET("/npcs", listAllNPCs(repos))
-	// Character combat routes
-	router.POST("/characters/:id/damage", applyDamage(svc))
-	router.POST("/characters/:id/heal", healCharacter(svc))
-	router.POST("/characters/:id/stamina", adjustStamina(svc))
-	router.POST("/characters/:id/mana", adjustMana(svc))
+	// Character combat routes. Player combat goes through the combat engine
+	// (see RegisterCombatRoutes); raw damage is admin-only.
+	router.POST("/characters/:id/damage", middleware.AuthMiddleware(nil), middleware.AdminMiddleware(), applyDamage(svc))
+	// Vitals changes come from the game, so they need a service signature.
+	router.POST("/characters/:id/heal", middleware.ServiceAuthMiddleware(middleware.ScopeCharacterVitals), healCharacter(svc))
+	router.POST("/characters/:id/stamina", middleware.ServiceAuthMiddleware(middleware.ScopeCharacterVitals), adjustStamina(svc))
+	router.POST("/characters/:id/mana", middleware.ServiceAuthMiddleware(middleware.ScopeCharacterVitals), adjustMana(svc))
+	// Players may edit their own characters; only admins may change flags,
+	// level, experience or vitals.
+	router.PUT("/characters/:id", middleware.AuthMiddleware(nil), updateCharacter(svc, repos))
 	// NPC heal routes
 	router.POST("/rooms/:id/npcs/heal", healNPCsInRoom(svc))
 	router.POST("/rooms/:id/npcs/passive-heal", passiveHealNPCsInRoom(svc))
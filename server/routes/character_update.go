@@ -1,6 +1,8 @@
 package routes
 
 import (
+	"context"
+	"herbst-server/db"
 	"herbst-server/dblog"
 	"log/slog"
 	"net/http"
@@ -8,13 +10,14 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // updateCharacter handles PUT /characters/:id.
-func updateCharacter(repos *repository.Container) gin.HandlerFunc {
+func updateCharacter(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		id, ok := getIDParam(c)
-		if !ok {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
 			return
 		}
 		var req struct {
@@ -41,6 +44,34 @@ func updateCharacter(repos *repository.Container) gin.HandlerFunc {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
+		privileged := req.IsNPC != nil || req.IsAdmin != nil || req.IsTest != nil ||
+			req.Level != nil || req.XP != nil || req.HP != nil || req.MaxHP != nil ||
+			req.Stamina != nil || req.MaxStamina != nil || req.Mana != nil || req.MaxMana != nil
+		if privileged && !c.GetBool("is_admin") {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only admins can change a character's flags, level, experience or vitals"})
+			return
+		}
+		if !c.GetBool("is_admin") {
+			// Players walk between rooms through the move endpoint, which
+			// checks the exits. Here they may only bind where they stand and
+			// go back to where login and respawn put them.
+			if req.StartingRoom != nil || (req.RespawnRoom != nil && *req.RespawnRoom != ch.CurrentRoomId) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "you can only bind to the room you are in"})
+				return
+			}
+			if req.CurrentRoom != nil {
+				ok, err := relocationAllowed(c.Request.Context(), svc, repos, ch, *req.CurrentRoom)
+				if err != nil {
+					dblog.Error("failed to check relocation", err, slog.String("service", "characters"), slog.Int("character_id", ch.ID))
+					c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update character"})
+					return
+				}
+				if !ok {
+					c.JSON(http.StatusForbidden, gin.H{"error": "characters move between rooms through their exits"})
+					return
+				}
+			}
+		}
 		updates := repository.CharacterUpdates{
 			Name: strPtr(req.Name), Gender: strPtr(req.Gender), Description: strPtr(req.Description),
 			IsNPC: req.IsNPC, CurrentRoomID: req.CurrentRoom, StartingRoomID: req.StartingRoom,
@@ -62,7 +93,7 @@ func updateCharacter(repos *repository.Container) gin.HandlerFunc {
 		if req.Description == "" {
 			updates.Description = nil
 		}
-		char, err := repos.Character.Update(c.Request.Context(), id, updates)
+		char, err := repos.Character.Update(c.Request.Context(), ch.ID, updates)
 		if err != nil {
 			dblog.Error("update character failed", err, slog.String("service", "characters"))
 			c.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
@@ -70,4 +101,34 @@ func updateCharacter(repos *repository.Container) gin.HandlerFunc {
 		}
 		c.JSON(http.StatusOK, char)
 	}
-}
\ No newline at end of file
+}
+
+// relocationAllowed reports whether a player may put ch in roomID without
+// walking there: its current room, its bind point, its starting room or a
+// root room, which is everywhere login and respawn send a character. A bind
+// point in a house the character has since been shut out of doesn't count.
+func relocationAllowed(ctx context.Context, svc *service.Container, repos *repository.Container, ch *db.Character, roomID int) (bool, error) {
+	if roomID == ch.CurrentRoomId {
+		return true, nil
+	}
+	ok, err := svc.House.MayEnter(ctx, ch.ID, roomID)
+	if db.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil || !ok {
+		return false, err
+	}
+	if roomID == ch.RespawnRoomId || roomID == ch.StartingRoomId {
+		return true, nil
+	}
+	roots, err := repos.Room.GetRoot(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, rm := range roots {
+		if rm.ID == roomID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
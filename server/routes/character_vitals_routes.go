@@ -0,0 +1,155 @@
+package routes
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterCharacterVitalsRoutes registers PATCH /api/characters/:id, which
+// the SSH server uses to apply effect damage and healing, and PATCH
+// /api/characters/:id/effects/character for the XP, bind point and
+// teleport effects. They take a service signature with the
+// characters:vitals and effects:apply scopes, not a player token.
+func RegisterCharacterVitalsRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	r.PATCH("/api/characters/:id", middleware.ServiceAuthMiddleware(middleware.ScopeCharacterVitals), patchCharacterVitals(repos))
+	r.PATCH("/api/characters/:id/effects/character", middleware.ServiceAuthMiddleware(middleware.ScopeEffectsApply), patchCharacterEffects(svc, repos))
+}
+
+// vitalsPatch changes a character's current hit points, stamina and mana
+// by the given amounts. Results are kept between 0 and the maximum.
+type vitalsPatch struct {
+	HPDelta      int `json:"hp_delta"`
+	StaminaDelta int `json:"stamina_delta"`
+	ManaDelta    int `json:"mana_delta"`
+}
+
+// patchCharacterVitals handles PATCH /api/characters/:id.
+func patchCharacterVitals(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		var req vitalsPatch
+		dec := json.NewDecoder(c.Request.Body)
+		// Only vitals may be patched; anything else is refused rather than
+		// silently ignored.
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "only hp_delta, stamina_delta and mana_delta can be patched"})
+			return
+		}
+		ctx := c.Request.Context()
+		char, err := repos.Character.Get(ctx, id)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "character not found"})
+			return
+		}
+		hp := clampVital(char.Hitpoints+req.HPDelta, char.MaxHitpoints)
+		stamina := clampVital(char.Stamina+req.StaminaDelta, char.MaxStamina)
+		mana := clampVital(char.Mana+req.ManaDelta, char.MaxMana)
+		updated, err := repos.Character.Update(ctx, id, repository.CharacterUpdates{
+			Hitpoints: &hp, Stamina: &stamina, Mana: &mana,
+		})
+		if err != nil {
+			dblog.Error("failed to patch character vitals", err, slog.String("service", "characters"), slog.Int("character_id", id))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		slog.Info("character vitals patched", slog.String("service", "characters"), slog.Int("character_id", id),
+			slog.String("service_key", c.GetString("service_key")),
+			slog.Int("hp_delta", req.HPDelta), slog.Int("stamina_delta", req.StaminaDelta), slog.Int("mana_delta", req.ManaDelta))
+		c.JSON(http.StatusOK, gin.H{
+			"id": updated.ID, "hp": updated.Hitpoints, "maxHp": updated.MaxHitpoints,
+			"stamina": updated.Stamina, "mana": updated.Mana,
+		})
+	}
+}
+
+// effectPatch is what the XP, bind point and teleport effects change. XP
+// gains go through the XP service so they level the character up; drains
+// and XP set outright don't take levels away.
+type effectPatch struct {
+	XPDelta       int  `json:"xp_delta"`
+	XP            *int `json:"xp"`
+	RespawnRoomID *int `json:"respawn_room_id"`
+	CurrentRoomID *int `json:"current_room_id"`
+}
+
+// patchCharacterEffects handles PATCH /api/characters/:id/effects/character.
+func patchCharacterEffects(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		var req effectPatch
+		dec := json.NewDecoder(c.Request.Body)
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "only xp_delta, xp, respawn_room_id and current_room_id can be patched"})
+			return
+		}
+		ctx := c.Request.Context()
+		char, err := repos.Character.Get(ctx, id)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "character not found"})
+			return
+		}
+		for _, roomID := range []*int{req.RespawnRoomID, req.CurrentRoomID} {
+			if roomID == nil {
+				continue
+			}
+			if _, err := repos.Room.Get(ctx, *roomID); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "room not found"})
+				return
+			}
+		}
+
+		updates := repository.CharacterUpdates{RespawnRoomID: req.RespawnRoomID, CurrentRoomID: req.CurrentRoomID}
+		switch {
+		case req.XP != nil:
+			xp := max(0, *req.XP)
+			updates.Xp = &xp
+		case req.XPDelta < 0:
+			xp := max(0, char.Xp+req.XPDelta)
+			updates.Xp = &xp
+		case req.XPDelta > 0:
+			if _, _, _, err := svc.XP.AwardXPWithSource(ctx, id, req.XPDelta, "effect"); err != nil {
+				dblog.Error("failed to award effect xp", err, slog.String("service", "characters"), slog.Int("character_id", id))
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+		}
+		updated, err := repos.Character.Update(ctx, id, updates)
+		if err != nil {
+			dblog.Error("failed to patch character effects", err, slog.String("service", "characters"), slog.Int("character_id", id))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		slog.Info("character effects patched", slog.String("service", "characters"), slog.Int("character_id", id),
+			slog.String("service_key", c.GetString("service_key")), slog.Int("xp_delta", req.XPDelta))
+		c.JSON(http.StatusOK, gin.H{
+			"id": updated.ID, "xp": updated.Xp, "level": updated.Level,
+			"respawnRoomId": updated.RespawnRoomId, "currentRoomId": updated.CurrentRoomId,
+		})
+	}
+}
+
+// clampVital keeps a vital between 0 and its maximum.
+func clampVital(v, max int) int {
+	if v < 0 {
+		return 0
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
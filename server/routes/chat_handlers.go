@@ -1,13 +1,16 @@
 package routes
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"herbst-server/dblog"
+	"herbst-server/repository"
 	"herbst-server/service"
+	"herbst-server/stream"
 )
 
 // --- Logging helper ---
@@ -17,11 +20,13 @@ func logRequest(ctx *gin.Context, format string, args ...any) {
 
 // --- Messaging handlers ---
 
-func sendSay(svc *service.Container) gin.HandlerFunc {
+// sendSay speaks as the caller's character in the room it is in. The body's
+// room_id is ignored so nobody can talk into, or fire the triggers of, a
+// room they aren't standing in.
+func sendSay(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input struct {
 			CharacterID int    `json:"character_id"`
-			RoomID      int    `json:"room_id"`
 			Message     string `json:"message"`
 		}
 		if err := c.ShouldBindJSON(&input); err != nil {
@@ -29,13 +34,20 @@ func sendSay(svc *service.Container) gin.HandlerFunc {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
-		result, err := svc.Chat.SendSay(c.Request.Context(), input.CharacterID, input.RoomID, input.Message)
+		ch := authorizeCharacterID(c, repos, input.CharacterID)
+		if ch == nil {
+			return
+		}
+		result, err := svc.Chat.SendSay(c.Request.Context(), ch.ID, ch.CurrentRoomId, input.Message)
 		if err != nil {
-			dblog.Error("failed to send say", err, slog.String("service", "chat"), slog.Int("character_id", input.CharacterID), slog.Int("room_id", input.RoomID))
+			dblog.Error("failed to send say", err, slog.String("service", "chat"), slog.Int("character_id", ch.ID), slog.Int("room_id", ch.CurrentRoomId))
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		slog.Info("say sent", slog.Int("character_id", input.CharacterID), slog.String("user_email", c.GetString("email")), slog.String("service", "chat"))
+		announceToRoom(c.Request.Context(), ch.CurrentRoomId, ch.ID, stream.TypeSay,
+			fmt.Sprintf("%s says, \"%s\"", result.FromCharacterName, input.Message))
+		svc.RoomTrigger.Said(c.Request.Context(), ch.ID, ch.CurrentRoomId, input.Message)
+		slog.Info("say sent", slog.Int("character_id", ch.ID), slog.String("user_email", c.GetString("email")), slog.String("service", "chat"))
 		c.JSON(http.StatusOK, result)
 	}
 }
@@ -180,6 +192,8 @@ func sendEmote(svc *service.Container) gin.HandlerFunc {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
+		announceToRoom(c.Request.Context(), derefInt(result.RoomID), input.CharacterID, stream.TypeEmote,
+			fmt.Sprintf("%s %s", result.FromCharacterName, input.Action))
 		slog.Info("emote sent", slog.Int("character_id", input.CharacterID), slog.String("user_email", c.GetString("email")), slog.String("service", "chat"))
 		c.JSON(http.StatusOK, result)
 	}
@@ -198,11 +212,22 @@ func sendChannel(svc *service.Container) gin.HandlerFunc {
 			return
 		}
 		result, err := svc.Chat.SendChannel(c.Request.Context(), input.Channel, input.Message, input.CharacterID)
+		if errors.Is(err, service.ErrNotInParty) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		if err != nil {
 			dblog.Error("failed to send channel message", err, slog.String("service", "chat"), slog.Int("character_id", input.CharacterID), slog.String("channel", input.Channel))
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
+		for _, id := range result.ToCharacterIDs {
+			stream.Default().Send(id, stream.Event{
+				Type:    stream.TypeChannel,
+				Text:    fmt.Sprintf("[%s] %s: %s", result.Channel, result.FromCharacterName, input.Message),
+				ActorID: input.CharacterID,
+			})
+		}
 		slog.Info("channel message sent", slog.Int("character_id", input.CharacterID), slog.String("channel", input.Channel), slog.String("user_email", c.GetString("email")), slog.String("service", "chat"))
 		c.JSON(http.StatusOK, result)
 	}
@@ -3,16 +3,17 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 	"herbst-server/middleware"
+	"herbst-server/repository"
 	"herbst-server/service"
 )
 
 // RegisterChatRoutes registers chat and messaging endpoints.
-func RegisterChatRoutes(r *gin.Engine, svc *service.Container) {
+func RegisterChatRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
 	chat := r.Group("/api/chat")
 	chat.Use(middleware.AuthMiddleware(nil))
 	{
 		// messaging
-		chat.POST("/say", sendSay(svc))
+		chat.POST("/say", sendSay(svc, repos))
 		chat.POST("/yell", sendYell(svc))
 		chat.POST("/shout", sendShout(svc))
 		chat.POST("/tell", sendTell(svc))
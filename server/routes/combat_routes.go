@@ -0,0 +1,124 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/combat"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterCombatRoutes registers the server-authoritative combat endpoints.
+// Clients engage a target and queue actions; the combat engine resolves
+// them every tick and clients read the results back (or receive them over
+// the WebSocket).
+func RegisterCombatRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/combat", combatStateHandler(svc, repos))
+		chars.POST("/:id/combat", combatEngageHandler(svc, repos))
+		chars.POST("/:id/combat/action", combatActionHandler(svc, repos))
+	}
+}
+
+// combatErrorStatus maps combat engine errors to HTTP status codes.
+func combatErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, combat.ErrNotInCombat),
+		errors.Is(err, combat.ErrAlreadyInCombat),
+		errors.Is(err, combat.ErrTargetDefeated),
+		errors.Is(err, combat.ErrAttackerDefeated),
+		errors.Is(err, combat.ErrSkillOnCooldown):
+		return http.StatusConflict
+	case errors.Is(err, combat.ErrInvalidTarget),
+		errors.Is(err, combat.ErrTargetNotHere),
+		errors.Is(err, combat.ErrInvalidAction):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondCombatError(c *gin.Context, err error, charID int) {
+	status := combatErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("combat request failed", err, slog.String("service", "combat"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+// combatStateHandler handles GET /api/characters/:id/combat?since=<tick>.
+// It returns the character's current fight (if any) and every tick result
+// newer than since.
+func combatStateHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		charID := ch.ID
+		since, _ := strconv.Atoi(c.DefaultQuery("since", "0"))
+		view, inCombat := svc.CombatEngine.View(charID)
+		c.JSON(http.StatusOK, gin.H{
+			"in_combat": inCombat,
+			"fight":     view,
+			"results":   svc.CombatEngine.Results(charID, since),
+		})
+	}
+}
+
+// combatEngageHandler handles POST /api/characters/:id/combat {"target_id"}.
+func combatEngageHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		charID := ch.ID
+		var req struct {
+			TargetID int `json:"target_id"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil || req.TargetID <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "target_id is required"})
+			return
+		}
+		view, err := svc.CombatEngine.Engage(c.Request.Context(), charID, req.TargetID)
+		if err != nil {
+			respondCombatError(c, err, charID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+// combatActionHandler handles POST /api/characters/:id/combat/action
+// {"action": "attack|defend|skill|flee|wait", "skill_id"}. The action runs
+// on the next tick.
+func combatActionHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		charID := ch.ID
+		var action combat.Action
+		if err := c.ShouldBindJSON(&action); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := svc.CombatEngine.Queue(charID, action); err != nil {
+			respondCombatError(c, err, charID)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"queued": action.Type})
+	}
+}
@@ -0,0 +1,80 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/db"
+	"herbst-server/middleware"
+	"herbst-server/service"
+)
+
+// RegisterConditionRoutes registers endpoints for checking and evaluating
+// EffectHook/Trigger condition expressions.
+func RegisterConditionRoutes(r *gin.Engine, svc *service.Container) {
+	// Admin: syntax check for the hook/trigger editors
+	r.POST("/api/conditions/validate", middleware.AuthMiddleware(nil), middleware.AdminMiddleware(), validateCondition(svc))
+
+	// Service: the SSH server evaluates hook and trigger conditions with the
+	// character as source. Players can't probe other characters' state.
+	r.POST("/api/characters/:id/conditions/evaluate", middleware.ServiceAuthMiddleware(middleware.ScopeConditionsEval), evaluateCondition(svc))
+}
+
+type conditionRequest struct {
+	Condition     string                 `json:"condition"`
+	TargetID      int                    `json:"target_id"`
+	RoomID        int                    `json:"room_id"`
+	NPCTemplateID string                 `json:"npc_template_id"`
+	Extras        map[string]interface{} `json:"extras"`
+}
+
+func validateCondition(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req conditionRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := svc.Condition.Validate(req.Condition); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"valid": false, "error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"valid": true})
+	}
+}
+
+func evaluateCondition(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		charID, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		var req conditionRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		result, err := svc.Condition.Evaluate(c.Request.Context(), req.Condition, service.ConditionInput{
+			SourceID:      charID,
+			TargetID:      req.TargetID,
+			RoomID:        req.RoomID,
+			NPCTemplateID: req.NPCTemplateID,
+			Extras:        req.Extras,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidCondition):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			case db.IsNotFound(err):
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			default:
+				slog.Warn("condition evaluation failed", slog.String("service", "conditions"), slog.Int("character_id", charID), slog.String("error", err.Error()))
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			}
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"result": result})
+	}
+}
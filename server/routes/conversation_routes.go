@@ -0,0 +1,103 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterConversationRoutes registers the endpoints the game client uses to
+// talk to NPCs. The server picks the entry node, hides gated responses and
+// keeps what the NPC remembers about the character.
+func RegisterConversationRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.POST("/:id/conversations", startConversationHandler(svc, repos))
+		chars.POST("/:id/conversations/choose", chooseDialogResponseHandler(svc, repos))
+	}
+}
+
+// startConversationRequest is the body for POST /api/characters/:id/conversations.
+type startConversationRequest struct {
+	NPCTemplateID string `json:"npc_template_id"`
+}
+
+// chooseDialogRequest is the body for POST /api/characters/:id/conversations/choose.
+// Choice is 1-based over the visible responses; 0 picks the first.
+type chooseDialogRequest struct {
+	NPCTemplateID string `json:"npc_template_id"`
+	NodeID        string `json:"node_id"`
+	Choice        int    `json:"choice"`
+}
+
+// conversationErrorStatus maps conversation service errors to HTTP status codes.
+func conversationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrNPCTemplateNotFound),
+		errors.Is(err, service.ErrDialogNodeNotFound),
+		errors.Is(err, service.ErrNoDialog):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNoDialogEntry),
+		errors.Is(err, service.ErrNPCHostile):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrInvalidDialogChoice):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func startConversationHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req startConversationRequest
+		if err := c.ShouldBindJSON(&req); err != nil || req.NPCTemplateID == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "npc_template_id is required"})
+			return
+		}
+		conv, err := svc.Conversation.Start(c.Request.Context(), ch.ID, req.NPCTemplateID)
+		if err != nil {
+			status := conversationErrorStatus(err)
+			if status == http.StatusInternalServerError {
+				dblog.Error("start conversation failed", err, slog.String("service", "dialog"), slog.Int("character_id", ch.ID), slog.String("npc_template_id", req.NPCTemplateID))
+			}
+			c.JSON(status, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, conv)
+	}
+}
+
+func chooseDialogResponseHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req chooseDialogRequest
+		if err := c.ShouldBindJSON(&req); err != nil || req.NPCTemplateID == "" || req.NodeID == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "npc_template_id and node_id are required"})
+			return
+		}
+		result, err := svc.Conversation.Choose(c.Request.Context(), ch.ID, req.NPCTemplateID, req.NodeID, req.Choice)
+		if err != nil {
+			status := conversationErrorStatus(err)
+			if status == http.StatusInternalServerError {
+				dblog.Error("dialog choice failed", err, slog.String("service", "dialog"), slog.Int("character_id", ch.ID), slog.String("node_id", req.NodeID))
+			}
+			c.JSON(status, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, result)
+	}
+}
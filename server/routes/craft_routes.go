@@ -1,21 +1,29 @@
 package routes
 
 import (
-	"fmt"
+	"errors"
+	"log/slog"
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"herbst-server/db"
 	"herbst-server/dblog"
+	"herbst-server/middleware"
 	"herbst-server/repository"
-	"log/slog"
+	"herbst-server/service"
+	"herbst-server/stream"
 )
 
-func RegisterCraftRoutes(r *gin.Engine, repos *repository.Container) {
+// RegisterCraftRoutes registers the endpoints for starting a craft, checking
+// on the running one and stopping it.
+func RegisterCraftRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	svc.Crafting.SetNotifier(pushCraftUpdate)
+
 	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
 	{
-		chars.POST("/:id/craft", craftHandler(repos))
+		chars.POST("/:id/craft", craftHandler(svc, repos))
+		chars.GET("/:id/craft", craftStatusHandler(svc, repos))
+		chars.DELETE("/:id/craft", craftCancelHandler(svc, repos))
 	}
 }
 
@@ -23,17 +31,60 @@ type craftRequest struct {
 	Recipe string `json:"recipe"`
 }
 
-type outputItem struct {
-	Name       string `json:"name"`
-	InstanceID int    `json:"instance_id"`
+// pushCraftUpdate streams crafting progress to the crafter's session.
+func pushCraftUpdate(charID int, update service.CraftUpdate) {
+	stream.Default().Send(charID, stream.Event{
+		Type:    stream.TypeCraft,
+		Text:    update.Message,
+		ActorID: charID,
+	})
 }
 
-func craftHandler(repos *repository.Container) gin.HandlerFunc {
+// craftErrorStatus maps crafting service errors to HTTP status codes.
+func craftErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrAlreadyCrafting),
+		errors.Is(err, service.ErrCraftInCombat):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrNotCrafting):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrRecipeNotFound),
+		errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrCraftRoomNotFound),
+		errors.Is(err, service.ErrNoCraftingStation),
+		errors.Is(err, service.ErrCraftWrongClass),
+		errors.Is(err, service.ErrCraftSkillTooLow),
+		errors.Is(err, service.ErrMissingIngredient):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// craftErrorText strips the sentinel prefix from detailed requirement
+// errors so the player sees e.g. "this room does not have a forge".
+func craftErrorText(err error) string {
+	msg := err.Error()
+	for _, sentinel := range []error{service.ErrNoCraftingStation, service.ErrCraftWrongClass, service.ErrCraftSkillTooLow} {
+		if errors.Is(err, sentinel) {
+			prefix := sentinel.Error() + ": "
+			if len(msg) > len(prefix) && msg[:len(prefix)] == prefix {
+				return msg[len(prefix):]
+			}
+		}
+	}
+	return msg
+}
+
+// craftHandler starts crafting a recipe. Timed recipes answer 202 with the
+// job; progress and the result arrive on the character's event stream.
+func craftHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		charID, ok := parseIDParam(c)
-		if !ok {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
 			return
 		}
+		charID := ch.ID
 
 		var req craftRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
@@ -48,180 +99,55 @@ func craftHandler(repos *repository.Container) gin.HandlerFunc {
 			return
 		}
 
-		ctx := c.Request.Context()
-
-		recipe, err := repos.CraftingRecipe.Get(ctx, req.Recipe)
+		job, result, err := svc.Crafting.Start(c.Request.Context(), charID, req.Recipe)
 		if err != nil {
-			slog.Warn("craft recipe not found", slog.String("service", "crafting"), slog.String("recipe", req.Recipe))
-			c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "recipe not found"})
+			status := craftErrorStatus(err)
+			if status == http.StatusInternalServerError {
+				dblog.Error("failed to craft", err, slog.String("service", "crafting"), slog.Int("character_id", charID), slog.String("recipe", req.Recipe))
+			} else {
+				slog.Warn("craft rejected", slog.String("service", "crafting"), slog.Int("character_id", charID), slog.String("recipe", req.Recipe), slog.String("reason", err.Error()))
+			}
+			c.JSON(status, gin.H{"success": false, "error": craftErrorText(err)})
 			return
 		}
-
-		char, err := repos.Character.Get(ctx, charID)
-		if err != nil {
-			slog.Warn("craft character not found", slog.String("service", "crafting"), slog.Int("character_id", charID))
-			c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "character not found"})
+		if result != nil {
+			c.JSON(http.StatusOK, gin.H{"success": true, "outcome": result.Outcome, "outputs": result.Outputs, "result": result})
 			return
 		}
+		slog.Info("craft started", slog.String("service", "crafting"), slog.Int("character_id", charID), slog.String("recipe", job.Recipe), slog.Int("duration_secs", job.DurationSecs))
+		c.JSON(http.StatusAccepted, gin.H{"success": true, "job": job})
+	}
+}
 
-		room, err := repos.Room.Get(ctx, char.CurrentRoomId)
-		if err != nil {
-			slog.Warn("craft room not found", slog.String("service", "crafting"), slog.Int("character_id", charID))
-			c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "room not found"})
+// craftStatusHandler returns the character's running job.
+func craftStatusHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
 			return
 		}
-
-		if !roomHasTag(room, recipe.RequiredStationTag) {
-			slog.Warn("craft missing station", slog.String("service", "crafting"), slog.String("required_tag", recipe.RequiredStationTag))
-			c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "this room does not have a " + recipe.RequiredStationTag})
+		job, ok := svc.Crafting.Active(ch.ID)
+		if !ok {
+			c.JSON(http.StatusNotFound, gin.H{"error": service.ErrNotCrafting.Error()})
 			return
 		}
+		c.JSON(http.StatusOK, job)
+	}
+}
 
-		if recipe.RequiredClass != "" && char.Class != recipe.RequiredClass {
-			slog.Warn("craft wrong class", slog.String("service", "crafting"), slog.String("required_class", recipe.RequiredClass))
-			c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "you don't have the required class: " + recipe.RequiredClass})
+// craftCancelHandler stops the character's running job without using up
+// any materials.
+func craftCancelHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
 			return
 		}
-
-		if recipe.RequiredSkill != "" {
-			comp, err := repos.Competency.GetCharacterCompetency(ctx, charID, recipe.RequiredSkill)
-			if err != nil || comp == nil || comp.Level < recipe.RequiredSkillLevel {
-				level := 0
-				if comp != nil {
-					level = comp.Level
-				}
-				slog.Warn("craft insufficient skill", slog.String("service", "crafting"), slog.String("skill", recipe.RequiredSkill), slog.Int("required_level", recipe.RequiredSkillLevel), slog.Int("current_level", level))
-				c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": fmt.Sprintf("you don't have enough skill in %s (need %d, have %d)", recipe.RequiredSkill, recipe.RequiredSkillLevel, level)})
-				return
-			}
-		}
-
-		inventory, err := repos.Equipment.ListByOwner(ctx, charID)
+		job, err := svc.Crafting.Cancel(ch.ID, "You stop crafting.")
 		if err != nil {
-			dblog.Error("failed to check inventory for crafting", err, slog.String("service", "crafting"), slog.Int("character_id", charID))
-			c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "failed to check inventory"})
+			c.JSON(craftErrorStatus(err), gin.H{"error": err.Error()})
 			return
 		}
-
-		available := make(map[int]int)
-		for _, item := range inventory {
-			if item.EquipmentTemplateID > 0 {
-				available[item.EquipmentTemplateID] += item.Quantity
-			}
-		}
-
-		// Resolve input slugs to template IDs
-		type inputSlot struct {
-			TemplateID int
-			Quantity   int
-			Consumed   bool
-		}
-		var resolvedInputs []inputSlot
-		for _, input := range recipe.Inputs {
-			tmpl, err := repos.EquipmentTemplate.GetBySlug(ctx, input.EquipmentTemplateSlug, "")
-			if err != nil {
-				slog.Warn("craft missing ingredient template", slog.String("service", "crafting"), slog.String("slug", input.EquipmentTemplateSlug))
-				c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "missing ingredient template: " + input.EquipmentTemplateSlug})
-				return
-			}
-			if available[tmpl.ID] < input.Quantity {
-				slog.Warn("craft missing ingredient", slog.String("service", "crafting"), slog.String("name", tmpl.Name))
-				c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "missing ingredient: " + tmpl.Name})
-				return
-			}
-			resolvedInputs = append(resolvedInputs, inputSlot{TemplateID: tmpl.ID, Quantity: input.Quantity, Consumed: input.Consumed})
-		}
-
-		// Consume ingredients from inventory
-		for _, input := range resolvedInputs {
-			need := input.Quantity
-			for _, item := range inventory {
-				if item.EquipmentTemplateID != input.TemplateID || need <= 0 {
-					continue
-				}
-				if item.Quantity <= need {
-					need -= item.Quantity
-					repos.Equipment.Delete(ctx, item.ID)
-				} else {
-					newQty := item.Quantity - need
-					need = 0
-					repos.Equipment.Update(ctx, item.ID, repository.EquipmentUpdates{Level: &newQty})
-				}
-			}
-		}
-
-		var outputs []outputItem
-		for _, output := range recipe.Outputs {
-			template, err := repos.EquipmentTemplate.GetBySlug(ctx, output.EquipmentTemplateSlug, "")
-			if err != nil {
-				dblog.Error("failed to load output template for crafting", err, slog.String("service", "crafting"), slog.String("slug", output.EquipmentTemplateSlug))
-				c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "failed to load template: " + output.EquipmentTemplateSlug})
-				return
-			}
-
-			for i := 0; i < output.Quantity; i++ {
-				ownerID := charID
-				created, err := repos.Equipment.Create(ctx, repository.CreateEquipmentInput{
-					Name:                  template.Name,
-					Description:           template.Description,
-					Slot:                  template.Slot,
-					Level:                 template.Level,
-					ItemType:              template.ItemType,
-					ArmorRating:           template.ArmorRating,
-					ArmorType:             template.ArmorType,
-					DamageDiceCount:       template.DamageDiceCount,
-					DamageDiceSides:       template.DamageDiceSides,
-					DamageBonus:           template.DamageBonus,
-					DamageType:            template.DamageType,
-					WeaponType:            template.WeaponType,
-					IsTwoHanded:           template.IsTwoHanded,
-					Stats:                 template.Stats,
-					Rarity:                template.Rarity,
-					SkillRequirement:       template.SkillRequirement,
-					SkillRequirementLevel:  template.SkillRequirementLevel,
-					Weight:                template.Weight,
-					IsEquipped:            false,
-					IsImmovable:           template.IsImmovable,
-					Color:                 template.Color,
-					IsVisible:             template.IsVisible,
-					EffectType:            template.EffectType,
-					EffectValue:           template.EffectValue,
-					EffectDuration:        template.EffectDuration,
-					EquipmentTemplateID:   &template.ID,
-					OwnerID:               &ownerID,
-				})
-				if err != nil {
-					dblog.Error("failed to create crafted item", err, slog.String("service", "crafting"), slog.String("name", template.Name))
-					c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "failed to create item: " + template.Name})
-					return
-				}
-				outputs = append(outputs, outputItem{
-					Name:       template.Name,
-					InstanceID: created.ID,
-				})
-			}
-		}
-
-		slog.Info("item crafted", slog.String("service", "crafting"), slog.Int("character_id", charID), slog.Int("output_count", len(outputs)))
-		c.JSON(http.StatusOK, gin.H{"success": true, "outputs": outputs})
+		c.JSON(http.StatusOK, gin.H{"cancelled": true, "job": job})
 	}
 }
-
-func roomHasTag(room *db.Room, tag string) bool {
-	for _, t := range room.Tags {
-		if t == tag {
-			return true
-		}
-	}
-	return false
-}
-
-func parseIDParam(c *gin.Context) (int, bool) {
-	id, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		slog.Warn("invalid character id", slog.String("service", "crafting"), slog.String("error", err.Error()))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
-		return 0, false
-	}
-	return id, true
-}
\ No newline at end of file
@@ -37,10 +37,20 @@ func createDialogNode(repos *repository.Container, client *db.Client) gin.Handle
 			return
 		}
 
+		if err := validateDialogConditions(input); err != nil {
+			slog.Warn("bad request", slog.String("service", "dialog_nodes"), slog.String("reason", "invalid condition"), slog.String("error", err.Error()), slog.String("client_ip", c.ClientIP()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
 		var responses []schema.DialogResponse
 		if input.Responses != nil {
 			responses = responsesToSchema(*input.Responses)
 		}
+		entryCondition := ""
+		if input.EntryCondition != nil {
+			entryCondition = *input.EntryCondition
+		}
 		var onEnterEffects []int
 		if input.OnEnterEffects != nil {
 			onEnterEffects = *input.OnEnterEffects
@@ -52,7 +62,7 @@ func createDialogNode(repos *repository.Container, client *db.Client) gin.Handle
 			NPCText:        *input.NpcText,
 			Responses:      responses,
 			IsEntry:        input.IsEntry != nil && *input.IsEntry,
-			EntryCondition: "",
+			EntryCondition: entryCondition,
 			OnEnterEffects: onEnterEffects,
 		})
 		if err != nil {
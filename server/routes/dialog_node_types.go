@@ -1,6 +1,9 @@
 package routes
 
 import (
+	"fmt"
+
+	"herbst-server/condition"
 	"herbst-server/db"
 	"herbst-server/db/schema"
 )
@@ -94,4 +97,21 @@ func responsesToSchema(in []DialogResponseInput) []schema.DialogResponse {
 		}
 	}
 	return out
-}
\ No newline at end of file
+}
+// validateDialogConditions compiles the entry condition and every response
+// condition in input, so broken expressions are rejected at save time.
+func validateDialogConditions(input dialogNodeInput) error {
+	if input.EntryCondition != nil {
+		if err := condition.Validate(*input.EntryCondition); err != nil {
+			return fmt.Errorf("entry_condition: %w", err)
+		}
+	}
+	if input.Responses != nil {
+		for i, r := range *input.Responses {
+			if err := condition.Validate(r.Condition); err != nil {
+				return fmt.Errorf("responses[%d].condition: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
@@ -33,6 +33,12 @@ func updateDialogNode(repos *repository.Container, client *db.Client) gin.Handle
 			return
 		}
 
+		if err := validateDialogConditions(input); err != nil {
+			slog.Warn("bad request", slog.String("service", "dialog_nodes"), slog.String("reason", "invalid condition"), slog.String("error", err.Error()), slog.String("client_ip", c.ClientIP()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
 		updates := repository.DialogNodeUpdates{
 			NPCText:        input.NpcText,
 			IsEntry:        input.IsEntry,
@@ -16,6 +16,8 @@ var validEffectTypes = map[string]bool{
 	"mana_change": true, "message": true, "teleport": true,
 	"apply_effect": true, "tag_add": true, "tag_remove": true,
 	"change_race": true, "change_class": true,
+	"dialog_flag_set": true, "dialog_flag_clear": true,
+	"dialog_counter_add": true, "dialog_counter_set": true,
 }
 
 func createEffectDef(repos *repository.Container) gin.HandlerFunc {
@@ -14,6 +14,7 @@ import (
 	"herbst-server/db/room"
 	"herbst-server/dblog"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // revealConditions stores reveal conditions in memory (GitHub #12)
@@ -716,9 +717,16 @@ func RegisterEquipmentRoutes(router *gin.Engine, repos *repository.Container, cl
 			}
 		}
 
-		// Check skill level if required
+		// Check skill level if required. Perception checks outdoors are
+		// harder at night and in bad weather.
+		skillLevel := req.SkillLevel
+		if req.RevealType == "perception_check" {
+			if rm, err := client.Equipment.QueryRoom(eq).Only(c.Request.Context()); err == nil {
+				skillLevel += service.CurrentRoomConditions(c.Request.Context(), repos.World, repos.Zone, rm, time.Now()).PerceptionModifier()
+			}
+		}
 		if minLevel, ok := revealCond["minLevel"].(float64); ok && minLevel > 0 {
-			if req.SkillLevel < int(minLevel) {
+			if skillLevel < int(minLevel) {
 				c.JSON(http.StatusForbidden, gin.H{"error": "Skill level too low"})
 				return
 			}
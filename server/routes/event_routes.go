@@ -2,6 +2,7 @@ package routes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	"herbst-server/db/competencylevelthreshold"
 	"herbst-server/db/systemlog"
 	"herbst-server/events"
+	"herbst-server/middleware"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
@@ -26,21 +28,35 @@ func RegisterEventRoutes(router *gin.Engine, client *db.Client, logger *slog.Log
 
 	// Wire up subscribers — these stay in memory for the lifetime of the process.
 	xpSvc := newXPService(client, logger)
-	bus.Subscribe(events.EventNPCDefeated, events.DefeatXPSubscriber(xpSvc, client, logger))
-	bus.Subscribe(events.EventCharacterDied, events.DeathPenaltySubscriber(xpSvc, logger, 10)) // 10% death penalty
-	bus.Subscribe(events.EventQuestComplete, events.QuestXPSubscriber(xpSvc, logger))
+	// Durable subscribers are delivered through the outbox when it is enabled.
+	bus.SubscribeDurable("defeat_xp", events.EventNPCDefeated, events.DefeatXPSubscriber(xpSvc, client, logger))
+	bus.SubscribeDurable("death_penalty", events.EventCharacterDied, events.DeathPenaltySubscriber(xpSvc, logger, 10)) // 10% death penalty
+	bus.SubscribeDurable("quest_xp", events.EventQuestComplete, events.QuestXPSubscriber(xpSvc, logger))
 
 	// Phase 5: Event system subscribers
-	events.RegisterAchievementSubscriber(client, logger)
+	events.RegisterAchievementSubscriber(client, xpSvc, logger)
 	events.RegisterQuestTriggerSubscriber(client, logger)
 	events.RegisterEventLogSubscriber(client, logger)
 
 	// POST /api/events — the bridge from the game server to the event bus.
-	router.POST("/api/events", handleEvent(logger))
+	// Only services holding the events:publish scope may publish.
+	router.POST("/api/events", middleware.ServiceAuthMiddleware(middleware.ScopeEventsPublish), handleEvent(logger))
 	// GET /api/events — health/debug endpoint listing active subscriber counts.
 	router.GET("/api/events", handleEventDebug(logger))
 	// GET /api/event-logs — query system_logs for event audit trail.
 	router.GET("/api/event-logs", handleGetEventLogs(client, logger))
+
+	// Outbox administration: durable subscriber status, replay after a
+	// subscriber bug fix, and the dead-letter list.
+	admin := router.Group("/api/admin/events")
+	admin.Use(middleware.AuthMiddleware(nil))
+	admin.Use(middleware.AdminMiddleware())
+	{
+		admin.GET("/subscribers", handleOutboxStatus())
+		admin.POST("/replay", handleOutboxReplay())
+		admin.GET("/dead-letters", handleListDeadLetters())
+		admin.POST("/dead-letters/:id/retry", handleRetryDeadLetter())
+	}
 }
 
 func newXPService(client *db.Client, logger *slog.Logger) *xpServiceWrapper {
@@ -305,3 +321,108 @@ func handleGetEventLogs(client *db.Client, logger *slog.Logger) gin.HandlerFunc
 		c.JSON(http.StatusOK, gin.H{"event_logs": result})
 	}
 }
+
+// --- outbox administration ---
+
+// requireOutbox returns the bus's outbox, answering 503 if durable delivery
+// is off.
+func requireOutbox(c *gin.Context) *events.Outbox {
+	o := events.Default().Outbox()
+	if o == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "durable event delivery is not enabled"})
+	}
+	return o
+}
+
+// handleOutboxStatus handles GET /api/admin/events/subscribers.
+func handleOutboxStatus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		o := requireOutbox(c)
+		if o == nil {
+			return
+		}
+		status, err := o.Status(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"subscribers": status})
+	}
+}
+
+// handleOutboxReplay handles POST /api/admin/events/replay
+// {"subscriber": "defeat_xp", "since": <unix ms>}. Every event from since
+// onwards is delivered to that subscriber again.
+func handleOutboxReplay() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		o := requireOutbox(c)
+		if o == nil {
+			return
+		}
+		var req struct {
+			Subscriber string `json:"subscriber" binding:"required"`
+			Since      int64  `json:"since"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		count, err := o.Replay(c.Request.Context(), req.Subscriber, req.Since)
+		if errors.Is(err, events.ErrUnknownSubscriber) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"subscriber": req.Subscriber, "since": req.Since, "events": count})
+	}
+}
+
+// handleListDeadLetters handles GET /api/admin/events/dead-letters?subscriber=&limit=.
+func handleListDeadLetters() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		o := requireOutbox(c)
+		if o == nil {
+			return
+		}
+		limit := 100
+		if v := c.Query("limit"); v != "" {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 && n <= 500 {
+				limit = n
+			}
+		}
+		letters, err := o.DeadLetters(c.Request.Context(), c.Query("subscriber"), limit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"dead_letters": letters})
+	}
+}
+
+// handleRetryDeadLetter handles POST /api/admin/events/dead-letters/:id/retry.
+func handleRetryDeadLetter() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		o := requireOutbox(c)
+		if o == nil {
+			return
+		}
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		err := o.RetryDeadLetter(c.Request.Context(), id)
+		switch {
+		case db.IsNotFound(err):
+			c.JSON(http.StatusNotFound, gin.H{"error": "dead letter not found"})
+		case errors.Is(err, events.ErrUnknownSubscriber):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case err != nil:
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusOK, gin.H{"retried": id})
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/db"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// RegisterExitRoutes registers the endpoints for the exits of the
+// character's room: listing them, opening, closing, locking and unlocking
+// doors, and searching for hidden ones.
+func RegisterExitRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container, client *db.Client) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/exits", exitListHandler(svc, repos))
+		chars.POST("/:id/exits/:dir/open", doorHandler(repos, svc.Exit.Open, "opens"))
+		chars.POST("/:id/exits/:dir/close", doorHandler(repos, svc.Exit.Close, "closes"))
+		chars.POST("/:id/exits/:dir/lock", doorHandler(repos, svc.Exit.Lock, "locks"))
+		chars.POST("/:id/exits/:dir/unlock", doorHandler(repos, svc.Exit.Unlock, "unlocks"))
+		chars.POST("/:id/search", exitSearchHandler(svc, repos, client))
+	}
+}
+
+// exitErrorStatus maps exit service errors to HTTP status codes.
+func exitErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrNoExit),
+		errors.Is(err, service.ErrNoDoor):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrExitLocked),
+		errors.Is(err, service.ErrDoorOpen),
+		errors.Is(err, service.ErrDoorShut),
+		errors.Is(err, service.ErrDoorLockedYet),
+		errors.Is(err, service.ErrDoorUnlocked),
+		errors.Is(err, service.ErrDoorNoLock),
+		errors.Is(err, service.ErrDoorNoKey),
+		errors.Is(err, service.ErrDoorCloseFirst):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondExitError(c *gin.Context, err error, charID int) {
+	status := exitErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("exit request failed", err, slog.String("service", "exits"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+func exitListHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		exits, err := svc.Exit.Exits(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondExitError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"exits": exits})
+	}
+}
+
+// doorHandler runs one door action on the :dir exit and tells the room.
+func doorHandler(repos *repository.Container, action func(ctx context.Context, charID int, dir string) (*service.ExitView, error), verb string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		dir := c.Param("dir")
+		exit, err := action(c.Request.Context(), ch.ID, dir)
+		if err != nil {
+			respondExitError(c, err, ch.ID)
+			return
+		}
+		announceToRoom(c.Request.Context(), ch.CurrentRoomId, ch.ID, stream.TypeEmote,
+			fmt.Sprintf("%s %s the door %s.", ch.Name, verb, dir))
+		c.JSON(http.StatusOK, exit)
+	}
+}
+
+func exitSearchHandler(svc *service.Container, repos *repository.Container, client *db.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		ctx := c.Request.Context()
+		found, err := svc.Exit.Search(ctx, ch.ID, searchPerception(ctx, client, svc, ch))
+		if err != nil {
+			respondExitError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"found": found})
+	}
+}
+
+// searchPerception is how well a character searches their room: their
+// examine level, worsened by darkness and bad weather like examine is.
+func searchPerception(ctx context.Context, client *db.Client, services *service.Container, ch *db.Character) int {
+	level := getExamineLevel(ctx, client, ch.ID)
+	if rc, err := services.WorldTime.RoomConditions(ctx, ch.CurrentRoomId); err == nil {
+		level = max(0, level+rc.PerceptionModifier())
+	}
+	return level
+}
+
+// exitTarget resolves where moving dir from rm takes ch, honouring doors,
+// hidden exits, instance portals and private houses. When the way is barred it returns the
+// line to show the player; a non-nil error means something broke.
+func exitTarget(ctx context.Context, services *service.Container, ch *db.Character, rm *db.Room, dir string) (int, string, error) {
+	targetID, err := service.ExitTarget(rm, ch.FoundExits, dir)
+	// An instance portal leads into the party's own copy of its zone.
+	copyRoom, portal, perr := services.Instance.Portal(ctx, ch.ID, rm.ID, dir)
+	if perr != nil {
+		return 0, "", perr
+	}
+	if portal && (err == nil || errors.Is(err, service.ErrNoExit)) {
+		return copyRoom, "", nil
+	}
+	switch {
+	case errors.Is(err, service.ErrNoExit):
+		return 0, "You can't go that way.", nil
+	case errors.Is(err, service.ErrExitLocked):
+		return 0, fmt.Sprintf("The door %s is locked.", dir), nil
+	case errors.Is(err, service.ErrExitClosed):
+		return 0, fmt.Sprintf("The door %s is closed.", dir), nil
+	}
+	ok, err := services.House.MayEnter(ctx, ch.ID, targetID)
+	if err != nil {
+		return 0, "", err
+	}
+	if !ok {
+		return 0, "That house is private.", nil
+	}
+	return targetID, "", nil
+}
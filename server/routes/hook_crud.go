@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"herbst-server/condition"
 	"herbst-server/dblog"
 	"herbst-server/repository"
 	"log/slog"
@@ -105,15 +106,20 @@ func createTemplateHook(repos *repository.Container) gin.HandlerFunc {
 			}
 			target = *input.Target
 		}
-		condition := ""
+		cond := ""
 		if input.Condition != nil {
-			condition = *input.Condition
+			if err := condition.Validate(*input.Condition); err != nil {
+				slog.Warn("hook invalid condition", slog.String("service", "hooks"), slog.String("error", err.Error()))
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			cond = *input.Condition
 		}
 		h, err := repos.EffectHook.Create(c.Request.Context(), repository.CreateEffectHookInput{
 			Name:          *input.Name,
 			Event:         *input.Event,
 			Target:        target,
-			Condition:     condition,
+			Condition:     cond,
 			Enabled:       enabled,
 			EffectID:      *input.EffectID,
 			NPCTemplateID: &templateID,
@@ -148,6 +154,13 @@ func updateHook(repos *repository.Container) gin.HandlerFunc {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
+		if input.Condition != nil {
+			if err := condition.Validate(*input.Condition); err != nil {
+				slog.Warn("hook invalid condition", slog.String("service", "hooks"), slog.Int("hook_id", id), slog.String("error", err.Error()))
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
 
 		updates := repository.EffectHookUpdates{
 			Name:      input.Name,
@@ -51,10 +51,12 @@ var validHookEvents = map[string]bool{
 	"on_kill": true, "on_enter_room": true, "on_leave_room": true,
 	"on_equip": true, "on_unequip": true, "on_login": true,
 	"on_effect_start": true, "on_effect_end": true,
+	// Fired by the world clock as each phase of the day begins.
+	"dawn": true, "day": true, "dusk": true, "night": true,
 }
 
 var validHookTargets = map[string]bool{
-	"self": true, "attacker": true, "killer": true, "room": true, "owner": true,
+	"self": true, "attacker": true, "killer": true, "room": true, "room_except_source": true, "owner": true,
 }
 
 func hookToView(h *db.EffectHook) hookView {
@@ -0,0 +1,255 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterHouseRoutes registers the player housing endpoints. Characters
+// buy plots, rename and describe their rooms, place decorations and manage
+// their guest list; /api/houses is where admins put plots up for sale.
+func RegisterHouseRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/house", houseViewHandler(svc, repos))
+		chars.GET("/:id/house/plots", housePlotsHandler(svc, repos))
+		chars.POST("/:id/house/buy", houseBuyHandler(svc, repos))
+		chars.PUT("/:id/house/rooms/:roomId", houseEditRoomHandler(svc, repos))
+		chars.POST("/:id/house/place", houseItemHandler(repos, svc.House.Place))
+		chars.POST("/:id/house/retrieve", houseItemHandler(repos, svc.House.Retrieve))
+		chars.POST("/:id/house/guests", houseAddGuestHandler(svc, repos))
+		chars.DELETE("/:id/house/guests/:name", houseRemoveGuestHandler(svc, repos))
+	}
+
+	houses := r.Group("/api/houses")
+	houses.Use(middleware.AuthMiddleware(nil))
+	houses.Use(middleware.AdminMiddleware())
+	{
+		houses.GET("", listHousePlots(svc))
+		houses.POST("", createHousePlot(svc))
+		houses.DELETE("/:id", deleteHousePlot(svc))
+	}
+}
+
+// houseErrorStatus maps house service errors to HTTP status codes.
+func houseErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrNoPlot),
+		errors.Is(err, service.ErrNoHouse),
+		errors.Is(err, service.ErrNotDecoration),
+		errors.Is(err, service.ErrNotGuest):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrPlotTaken),
+		errors.Is(err, service.ErrPlotSold),
+		errors.Is(err, service.ErrHouseOwned),
+		errors.Is(err, service.ErrNotInHouse),
+		errors.Is(err, service.ErrInsufficientGold):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrPlotDirection),
+		errors.Is(err, service.ErrInvalidPlot),
+		errors.Is(err, service.ErrHouseMaxRooms),
+		errors.Is(err, service.ErrHouseRoomName),
+		errors.Is(err, service.ErrHouseRoomDesc),
+		errors.Is(err, service.ErrHouseItem),
+		errors.Is(err, service.ErrGuestSelf):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondHouseError(c *gin.Context, err error, charID int) {
+	status := houseErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("house request failed", err, slog.String("service", "houses"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+func houseViewHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		view, err := svc.House.View(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondHouseError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+// housePlotsHandler lists the plots for sale from the character's room.
+func housePlotsHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		plots, err := svc.House.Plots(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondHouseError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"plots": plots})
+	}
+}
+
+func houseBuyHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req struct {
+			Direction string `json:"direction"`
+		}
+		// The body is optional when only one plot is for sale here.
+		_ = c.ShouldBindJSON(&req)
+		view, err := svc.House.Buy(c.Request.Context(), ch.ID, req.Direction)
+		if err != nil {
+			respondHouseError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusCreated, view)
+	}
+}
+
+func houseEditRoomHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		roomID, err := strconv.Atoi(c.Param("roomId"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid room id"})
+			return
+		}
+		var req service.HouseRoomInput
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "name or description is required"})
+			return
+		}
+		view, err := svc.House.EditRoom(c.Request.Context(), ch.ID, roomID, req)
+		if err != nil {
+			respondHouseError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+// houseItemHandler binds an item_id and hands it to Place or Retrieve.
+func houseItemHandler(repos *repository.Container, move func(ctx context.Context, charID, itemID int) (*service.HouseItem, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req struct {
+			ItemID int `json:"item_id" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "item_id is required"})
+			return
+		}
+		item, err := move(c.Request.Context(), ch.ID, req.ItemID)
+		if err != nil {
+			respondHouseError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, item)
+	}
+}
+
+func houseAddGuestHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req struct {
+			Name string `json:"name" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+			return
+		}
+		view, err := svc.House.AddGuest(c.Request.Context(), ch.ID, req.Name)
+		if err != nil {
+			respondHouseError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+func houseRemoveGuestHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		view, err := svc.House.RemoveGuest(c.Request.Context(), ch.ID, c.Param("name"))
+		if err != nil {
+			respondHouseError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+func listHousePlots(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		plots, err := svc.House.ListPlots(c.Request.Context())
+		if err != nil {
+			respondHouseError(c, err, 0)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"plots": plots})
+	}
+}
+
+func createHousePlot(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req service.HousePlotInput
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		plot, err := svc.House.CreatePlot(c.Request.Context(), req)
+		if err != nil {
+			respondHouseError(c, err, 0)
+			return
+		}
+		c.JSON(http.StatusCreated, plot)
+	}
+}
+
+func deleteHousePlot(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		if err := svc.House.DeletePlot(c.Request.Context(), id); err != nil {
+			respondHouseError(c, err, 0)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,184 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterMailRoutes registers the mailbox endpoints: reading the inbox,
+// sending mail with gold, items or a COD charge, and collecting, returning
+// or deleting a letter.
+func RegisterMailRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/mail", mailInboxHandler(svc, repos))
+		chars.POST("/:id/mail", mailSendHandler(svc, repos))
+		chars.GET("/:id/mail/:mail_id", mailReadHandler(svc, repos))
+		chars.POST("/:id/mail/:mail_id/collect", mailCollectHandler(svc, repos))
+		chars.POST("/:id/mail/:mail_id/return", mailReturnHandler(svc, repos))
+		chars.DELETE("/:id/mail/:mail_id", mailDeleteHandler(svc, repos))
+	}
+}
+
+// mailErrorStatus maps mail service errors to HTTP status codes.
+func mailErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrMailNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNotAtMailbox),
+		errors.Is(err, service.ErrInsufficientGold),
+		errors.Is(err, service.ErrMailNothingAttached),
+		errors.Is(err, service.ErrMailHasAttachments),
+		errors.Is(err, service.ErrMailAlreadyReturned):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrMailIgnored):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrInvalidMailRecipient),
+		errors.Is(err, service.ErrMailSubject),
+		errors.Is(err, service.ErrMailTooLong),
+		errors.Is(err, service.ErrMailAmount),
+		errors.Is(err, service.ErrMailTooManyItems),
+		errors.Is(err, service.ErrMailItem),
+		errors.Is(err, service.ErrMailCODWithoutItems):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondMailError(c *gin.Context, err error, charID int) {
+	status := mailErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("mail request failed", err, slog.String("service", "mail"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+// mailID parses the :mail_id path parameter.
+func mailID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("mail_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid mail id"})
+		return 0, false
+	}
+	return id, true
+}
+
+func mailInboxHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		inbox, err := svc.Mail.Inbox(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondMailError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, inbox)
+	}
+}
+
+func mailSendHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req service.SendMailInput
+		if err := c.ShouldBindJSON(&req); err != nil || req.To == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to is required"})
+			return
+		}
+		sent, err := svc.Mail.Send(c.Request.Context(), ch.ID, req)
+		if err != nil {
+			respondMailError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, sent)
+	}
+}
+
+func mailReadHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		id, ok := mailID(c)
+		if !ok {
+			return
+		}
+		m, err := svc.Mail.Read(c.Request.Context(), ch.ID, id)
+		if err != nil {
+			respondMailError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, m)
+	}
+}
+
+func mailCollectHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		id, ok := mailID(c)
+		if !ok {
+			return
+		}
+		result, err := svc.Mail.Collect(c.Request.Context(), ch.ID, id)
+		if err != nil {
+			respondMailError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, result)
+	}
+}
+
+func mailReturnHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		id, ok := mailID(c)
+		if !ok {
+			return
+		}
+		if err := svc.Mail.Return(c.Request.Context(), ch.ID, id); err != nil {
+			respondMailError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "mail returned to sender"})
+	}
+}
+
+func mailDeleteHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		id, ok := mailID(c)
+		if !ok {
+			return
+		}
+		if err := svc.Mail.Delete(c.Request.Context(), ch.ID, id); err != nil {
+			respondMailError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "mail deleted"})
+	}
+}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"herbst-server/db"
+	"herbst-server/db/schema"
 	"herbst-server/dblog"
 	"herbst-server/middleware"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // RegisterNPCTemplateRoutes registers REST endpoints for NPC templates.
@@ -33,20 +35,22 @@ func RegisterNPCTemplateRoutes(r *gin.Engine, repos *repository.Container) {
 
 // npcTemplateView is the JSON shape returned by the API.
 type npcTemplateView struct {
-	ID              string         `json:"id"`
-	Slug            string         `json:"slug"`
-	Name            string         `json:"name"`
-	Description     string         `json:"description"`
-	RaceID          int            `json:"race_id"`
-	Disposition     string         `json:"disposition"`
-	Level           int            `json:"level"`
-	XpValue         int            `json:"xp_value"`
-	Skills          map[string]int `json:"skills"`
-	TradesWith      []string       `json:"trades_with"`
-	Greeting        string         `json:"greeting"`
-	RespawnRooms    []string       `json:"respawn_rooms"`
-	RespawnCooldown int            `json:"respawn_cooldown"`
-	WorldID         string         `json:"world_id"`
+	ID              string             `json:"id"`
+	Slug            string             `json:"slug"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description"`
+	RaceID          int                `json:"race_id"`
+	Disposition     string             `json:"disposition"`
+	Level           int                `json:"level"`
+	XpValue         int                `json:"xp_value"`
+	Skills          map[string]int     `json:"skills"`
+	TradesWith      []string           `json:"trades_with"`
+	Greeting        string             `json:"greeting"`
+	RespawnRooms    []string           `json:"respawn_rooms"`
+	RespawnCooldown int                `json:"respawn_cooldown"`
+	WorldID         string             `json:"world_id"`
+	LootTable       schema.LootTable   `json:"loot_table"`
+	Behavior        schema.NPCBehavior `json:"behavior"`
 }
 
 func listNPCTemplates(repos *repository.Container) gin.HandlerFunc {
@@ -88,6 +92,8 @@ func listNPCTemplates(repos *repository.Container) gin.HandlerFunc {
 				Greeting:        t.Greeting,
 				RespawnRooms:    t.RespawnRooms,
 				RespawnCooldown: t.RespawnCooldown,
+				LootTable:       t.LootTable,
+				Behavior:        t.Behavior,
 				WorldID:         t.WorldID,
 			}
 		}
@@ -124,6 +130,8 @@ func getNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 			Greeting:        tmpl.Greeting,
 			RespawnRooms:    tmpl.RespawnRooms,
 			RespawnCooldown: tmpl.RespawnCooldown,
+			LootTable:       tmpl.LootTable,
+			Behavior:        tmpl.Behavior,
 			WorldID:         tmpl.WorldID,
 		})
 	}
@@ -132,20 +140,22 @@ func getNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 func createNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req struct {
-			ID              string         `json:"id"`
-			Slug            string         `json:"slug"`
-			Name            string         `json:"name"`
-			Description     string         `json:"description"`
-			RaceID          int            `json:"race_id"`
-			Disposition     string         `json:"disposition"`
-			Level           int            `json:"level"`
-			XpValue         int            `json:"xp_value"`
-			Skills          map[string]int `json:"skills"`
-			TradesWith      []string       `json:"trades_with"`
-			Greeting        string         `json:"greeting"`
-			RespawnRooms    []string       `json:"respawn_rooms"`
-			RespawnCooldown int            `json:"respawn_cooldown"`
-			WorldID         string         `json:"world_id"`
+			ID              string              `json:"id"`
+			Slug            string              `json:"slug"`
+			Name            string              `json:"name"`
+			Description     string              `json:"description"`
+			RaceID          int                 `json:"race_id"`
+			Disposition     string              `json:"disposition"`
+			Level           int                 `json:"level"`
+			XpValue         int                 `json:"xp_value"`
+			Skills          map[string]int      `json:"skills"`
+			TradesWith      []string            `json:"trades_with"`
+			Greeting        string              `json:"greeting"`
+			RespawnRooms    []string            `json:"respawn_rooms"`
+			RespawnCooldown int                 `json:"respawn_cooldown"`
+			WorldID         string              `json:"world_id"`
+			LootTable       *schema.LootTable   `json:"loot_table"`
+			Behavior        *schema.NPCBehavior `json:"behavior"`
 		}
 		if err := c.ShouldBindJSON(&req); err != nil {
 			slog.Warn("bad request", slog.String("service", "npcs"), slog.String("reason", "invalid request body"), slog.String("error", err.Error()))
@@ -164,7 +174,7 @@ func createNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 		disposition := "neutral"
 		if req.Disposition != "" {
 			switch req.Disposition {
-			case "hostile", "friendly", "neutral":
+			case "hostile", "friendly", "neutral", "shopkeeper":
 				disposition = req.Disposition
 			default:
 				slog.Warn("bad request", slog.String("service", "npcs"), slog.String("reason", "invalid disposition"), slog.String("disposition", req.Disposition))
@@ -173,6 +183,12 @@ func createNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 			}
 		}
 
+		if err := service.ValidateNPCBehavior(req.Behavior); err != nil {
+			slog.Warn("bad request", slog.String("service", "npcs"), slog.String("reason", "invalid behavior"), slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid behavior: " + err.Error()})
+			return
+		}
+
 		cooldown := req.RespawnCooldown
 		created, err := repos.NPCTemplate.Create(c.Request.Context(), repository.CreateNPCTemplateInput{
 			ID:              req.ID,
@@ -189,6 +205,8 @@ func createNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 			RespawnRooms:    req.RespawnRooms,
 			RespawnCooldown: &cooldown,
 			WorldID:         req.WorldID,
+			LootTable:       req.LootTable,
+			Behavior:        req.Behavior,
 		})
 		if err != nil {
 			dblog.Error("failed to create npc template", err, slog.String("service", "npcs"))
@@ -211,6 +229,8 @@ func createNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 			Greeting:        created.Greeting,
 			RespawnRooms:    created.RespawnRooms,
 			RespawnCooldown: created.RespawnCooldown,
+			LootTable:       created.LootTable,
+			Behavior:        created.Behavior,
 			WorldID:         created.WorldID,
 		})
 	}
@@ -219,19 +239,21 @@ func createNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 // updateNPCTemplateRequest accepts all template fields as optional pointers.
 // Only non-nil fields are applied.
 type updateNPCTemplateRequest struct {
-	Name            *string         `json:"name"`
-	Slug            *string         `json:"slug"`
-	Description     *string         `json:"description"`
-	RaceID          *int            `json:"race_id"`
-	Disposition     *string         `json:"disposition"`
-	Level           *int            `json:"level"`
-	XpValue         *int            `json:"xp_value"`
-	Skills          *map[string]int `json:"skills"`
-	TradesWith      *[]string       `json:"trades_with"`
-	Greeting        *string         `json:"greeting"`
-	RespawnRooms    *[]string       `json:"respawn_rooms"`
-	RespawnCooldown *int            `json:"respawn_cooldown"`
-	WorldID         *string         `json:"world_id"`
+	Name            *string             `json:"name"`
+	Slug            *string             `json:"slug"`
+	Description     *string             `json:"description"`
+	RaceID          *int                `json:"race_id"`
+	Disposition     *string             `json:"disposition"`
+	Level           *int                `json:"level"`
+	XpValue         *int                `json:"xp_value"`
+	Skills          *map[string]int     `json:"skills"`
+	TradesWith      *[]string           `json:"trades_with"`
+	Greeting        *string             `json:"greeting"`
+	RespawnRooms    *[]string           `json:"respawn_rooms"`
+	RespawnCooldown *int                `json:"respawn_cooldown"`
+	WorldID         *string             `json:"world_id"`
+	LootTable       *schema.LootTable   `json:"loot_table"`
+	Behavior        *schema.NPCBehavior `json:"behavior"`
 }
 
 func updateNPCTemplate(repos *repository.Container) gin.HandlerFunc {
@@ -262,10 +284,17 @@ func updateNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 			Greeting:        req.Greeting,
 			RespawnRooms:    req.RespawnRooms,
 			RespawnCooldown: req.RespawnCooldown,
+			LootTable:       req.LootTable,
+			Behavior:        req.Behavior,
+		}
+		if err := service.ValidateNPCBehavior(req.Behavior); err != nil {
+			slog.Warn("bad request", slog.String("service", "npcs"), slog.String("reason", "invalid behavior"), slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid behavior: " + err.Error()})
+			return
 		}
 		if req.Disposition != nil {
 			switch *req.Disposition {
-			case "hostile", "friendly", "neutral":
+			case "hostile", "friendly", "neutral", "shopkeeper":
 				updates.Disposition = req.Disposition
 			default:
 				slog.Warn("bad request", slog.String("service", "npcs"), slog.String("reason", "invalid disposition"), slog.String("disposition", *req.Disposition))
@@ -296,6 +325,8 @@ func updateNPCTemplate(repos *repository.Container) gin.HandlerFunc {
 			Greeting:        updated.Greeting,
 			RespawnRooms:    updated.RespawnRooms,
 			RespawnCooldown: updated.RespawnCooldown,
+			LootTable:       updated.LootTable,
+			Behavior:        updated.Behavior,
 		})
 	}
 }
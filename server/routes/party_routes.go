@@ -0,0 +1,170 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// RegisterPartyRoutes registers the party endpoints: showing the
+// character's party, inviting others into it, accepting an invite, and
+// leaving or kicking someone out.
+func RegisterPartyRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/party", partyGetHandler(svc, repos))
+		chars.POST("/:id/party/invite", partyInviteHandler(svc, repos))
+		chars.POST("/:id/party/accept", partyAcceptHandler(svc, repos))
+		chars.POST("/:id/party/leave", partyLeaveHandler(svc, repos))
+		chars.POST("/:id/party/kick", partyKickHandler(svc, repos))
+	}
+}
+
+// partyErrorStatus maps party service errors to HTTP status codes.
+func partyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrNoPartyInvite),
+		errors.Is(err, service.ErrNotPartyMember):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNotInParty),
+		errors.Is(err, service.ErrAlreadyInParty),
+		errors.Is(err, service.ErrTargetInParty),
+		errors.Is(err, service.ErrPartyFull):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrNotPartyLeader):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrInvalidPartyTarget):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondPartyError(c *gin.Context, err error, charID int) {
+	status := partyErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("party request failed", err, slog.String("service", "party"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+// respondPartyUpdate pushes the update's notice to the other characters it
+// concerns and returns it to the caller.
+func respondPartyUpdate(c *gin.Context, update *service.PartyUpdate, charID int) {
+	if update.Notice != "" {
+		for _, id := range update.Notify {
+			stream.Default().Send(id, stream.Event{
+				Type:    stream.TypeParty,
+				Text:    update.Notice,
+				ActorID: charID,
+			})
+		}
+	}
+	c.JSON(http.StatusOK, update)
+}
+
+// partyTargetRequest is the body for invite and kick.
+type partyTargetRequest struct {
+	Name string `json:"name"`
+}
+
+func bindPartyTarget(c *gin.Context) (string, bool) {
+	var req partyTargetRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+		return "", false
+	}
+	return req.Name, true
+}
+
+func partyGetHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		party, err := svc.Party.Get(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondPartyError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, party)
+	}
+}
+
+func partyInviteHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		name, ok := bindPartyTarget(c)
+		if !ok {
+			return
+		}
+		update, err := svc.Party.Invite(c.Request.Context(), ch.ID, name)
+		if err != nil {
+			respondPartyError(c, err, ch.ID)
+			return
+		}
+		respondPartyUpdate(c, update, ch.ID)
+	}
+}
+
+func partyAcceptHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		update, err := svc.Party.Accept(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondPartyError(c, err, ch.ID)
+			return
+		}
+		respondPartyUpdate(c, update, ch.ID)
+	}
+}
+
+func partyLeaveHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		update, err := svc.Party.Leave(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondPartyError(c, err, ch.ID)
+			return
+		}
+		respondPartyUpdate(c, update, ch.ID)
+	}
+}
+
+func partyKickHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		name, ok := bindPartyTarget(c)
+		if !ok {
+			return
+		}
+		update, err := svc.Party.Kick(c.Request.Context(), ch.ID, name)
+		if err != nil {
+			respondPartyError(c, err, ch.ID)
+			return
+		}
+		respondPartyUpdate(c, update, ch.ID)
+	}
+}
@@ -48,7 +48,7 @@ func questToView(q *db.Quest) questView {
 		Rewards: questRewardsInput{
 			XP: r.XP, ItemIDs: itemIDs, EffectIDs: effIDs,
 			TagAdds: tagAdds, TagRemoves: tagRemoves,
-			AchievementIDs: achIDs,
+			AchievementIDs: achIDs, Reputation: r.Reputation,
 		},
 		RepeatMode:    string(q.RepeatMode),
 		CooldownHours: q.CooldownHours, IsActive: q.IsActive,
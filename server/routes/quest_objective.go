@@ -3,19 +3,22 @@ package routes
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"herbst-server/db"
 	"herbst-server/db/character"
 	"herbst-server/db/quest"
 	"herbst-server/db/questprogress"
+	"herbst-server/dblog"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // advanceQuestObjective increments active quests matching the given objective
 // and returns player-facing completion/reward messages.
 // For repeatable quests (repeat_mode = "always"), it will also reset completed quests.
-func advanceQuestObjective(ctx context.Context, client *db.Client, repos *repository.Container, charID int, objectiveType, targetID string, count int) []string {
+func advanceQuestObjective(ctx context.Context, client *db.Client, repos *repository.Container, rep service.ReputationService, charID int, objectiveType, targetID string, count int) []string {
 	if count <= 0 {
 		count = 1
 	}
@@ -103,6 +106,7 @@ func advanceQuestObjective(ctx context.Context, client *db.Client, repos *reposi
 		}
 		if updated.Status == questprogress.StatusCompleted {
 			messages = append(messages, fmt.Sprintf("Quest completed: %s!", q.Name))
+			grantQuestReputation(ctx, rep, charID, q)
 			rewardSummary := applyQuestRewards(q.Rewards)
 			messages = append(messages, fmt.Sprintf("Rewards: XP=%d, Items=%v", rewardSummary["xp"], rewardSummary["item_ids"]))
 		}
@@ -110,6 +114,17 @@ func advanceQuestObjective(ctx context.Context, client *db.Client, repos *reposi
 	return messages
 }
 
+// grantQuestReputation applies a completed quest's reputation rewards.
+// Failures are logged; the quest stays completed.
+func grantQuestReputation(ctx context.Context, rep service.ReputationService, charID int, q *db.Quest) {
+	if len(q.Rewards.Reputation) == 0 {
+		return
+	}
+	if err := rep.AwardQuest(ctx, charID, q.ID); err != nil {
+		dblog.Error("failed to grant quest reputation", err, slog.String("service", "quests"), slog.Int("character_id", charID), slog.Int("quest_id", q.ID))
+	}
+}
+
 // acceptQuestIfNotActive creates an active quest progress record for a character if none exists.
 func acceptQuestIfNotActive(ctx context.Context, client *db.Client, repos *repository.Container, charID, questID int) error {
 	exists, err := repos.QuestProgress.CountActiveByCharacter(ctx, charID, questID)
@@ -9,6 +9,7 @@ import (
 	"herbst-server/db"
 	"herbst-server/db/questprogress"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // progressResult holds the result of advancing a quest objective.
@@ -20,7 +21,7 @@ type progressResult struct {
 
 // advanceObjective increments counts and checks for completion.
 // TODO: migrate to fully use repos once QuestProgressRepo supports update+query
-func advanceObjective(client *db.Client, repos *repository.Container, c *gin.Context, progress *db.QuestProgress, input questCheckInput, questID int) progressResult {
+func advanceObjective(client *db.Client, repos *repository.Container, rep service.ReputationService, c *gin.Context, progress *db.QuestProgress, input questCheckInput, questID int) progressResult {
 	counts := progress.ObjectiveCounts
 	if counts == nil {
 		counts = map[string]int{}
@@ -63,6 +64,7 @@ func advanceObjective(client *db.Client, repos *repository.Container, c *gin.Con
 	view := questProgressToView(updated)
 	if q != nil && updated.Status == questprogress.StatusCompleted && updated.Edges.Character != nil {
 		view.RewardsApplied = applyQuestRewards(q.Rewards)
+		grantQuestReputation(c.Request.Context(), rep, updated.Edges.Character.ID, q)
 	}
 	return progressResult{view: view}
 }
@@ -10,12 +10,13 @@ import (
 	"herbst-server/db/quest"
 	"herbst-server/db/questprogress"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // checkProgress increments objective counts and advances quest progress.
 // If all objectives are complete, the quest is marked completed and rewards applied.
 // TODO: migrate to fully use repos once QuestProgressRepo supports complex queries
-func checkProgress(repos *repository.Container, client *db.Client) gin.HandlerFunc {
+func checkProgress(repos *repository.Container, svc *service.Container, client *db.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		charID, err := strconv.Atoi(c.Param("id"))
 		if err != nil {
@@ -50,7 +51,7 @@ func checkProgress(repos *repository.Container, client *db.Client) gin.HandlerFu
 			c.JSON(http.StatusNotFound, gin.H{"error": "active quest progress not found"})
 			return
 		}
-		result := advanceObjective(client, repos, c, progress, input, questID)
+		result := advanceObjective(client, repos, svc.Reputation, c, progress, input, questID)
 		if result.err != nil {
 			status := http.StatusInternalServerError
 			if result.notFound {
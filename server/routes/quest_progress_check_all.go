@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"herbst-server/db/character"
 	"herbst-server/db/questprogress"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // checkAllInput is the JSON request body for bulk quest progress checking.
@@ -18,12 +20,16 @@ type checkAllInput struct {
 	ObjectiveType string `json:"objective_type" binding:"required"`
 	TargetID      string `json:"target_id" binding:"required"`
 	Count         int    `json:"count"`
+	// ShareWithParty also credits kill objectives to the character's party
+	// members standing in the same room. The client sets it only for the
+	// killing blow so a shared fight is credited once per member.
+	ShareWithParty bool `json:"share_with_party"`
 }
 
 // checkAllQuests finds all active quests for a character that match the
 // given objective type and target, then increments progress on each.
 // TODO: migrate to fully use repos once QuestProgressRepo supports complex queries
-func checkAllQuests(repos *repository.Container, client *db.Client) gin.HandlerFunc {
+func checkAllQuests(repos *repository.Container, svc *service.Container, client *db.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		charID, err := strconv.Atoi(c.Param("id"))
 		if err != nil {
@@ -38,7 +44,11 @@ func checkAllQuests(repos *repository.Container, client *db.Client) gin.HandlerF
 			return
 		}
 
-		messages := advanceQuestObjective(c.Request.Context(), client, repos, charID, input.ObjectiveType, input.TargetID, input.Count)
+		messages := advanceQuestObjective(c.Request.Context(), client, repos, svc.Reputation, charID, input.ObjectiveType, input.TargetID, input.Count)
+		var sharedWith []int
+		if input.ShareWithParty && input.ObjectiveType == "kill" {
+			sharedWith = sharePartyQuestCredit(c.Request.Context(), client, repos, svc.Reputation, charID, input.TargetID, input.Count)
+		}
 
 		progresses, err := client.QuestProgress.Query().
 			Where(
@@ -75,6 +85,33 @@ func checkAllQuests(repos *repository.Container, client *db.Client) gin.HandlerF
 		}
 
 		slog.Info("quest progress checked", slog.Int("character_id", charID), slog.Int("updated_count", len(results)), slog.String("user_email", c.GetString("email")), slog.String("service", "quests"))
-		c.JSON(http.StatusOK, gin.H{"updated": results, "count": len(results), "messages": messages})
+		c.JSON(http.StatusOK, gin.H{"updated": results, "count": len(results), "messages": messages, "shared_with": sharedWith})
+	}
+}
+
+// sharePartyQuestCredit advances a kill objective for every member of the
+// character's party who is in the same room, returning their IDs.
+func sharePartyQuestCredit(ctx context.Context, client *db.Client, repos *repository.Container, rep service.ReputationService, charID int, targetID string, count int) []int {
+	p, err := repos.Party.GetForCharacter(ctx, charID)
+	if err != nil {
+		return nil
+	}
+	roomID := 0
+	for _, m := range p.Edges.Members {
+		if m.ID == charID {
+			roomID = m.CurrentRoomId
+		}
+	}
+	var shared []int
+	for _, m := range p.Edges.Members {
+		if m.ID == charID || m.CurrentRoomId != roomID {
+			continue
+		}
+		advanceQuestObjective(ctx, client, repos, rep, m.ID, "kill", targetID, count)
+		shared = append(shared, m.ID)
+	}
+	if len(shared) > 0 {
+		slog.Info("party quest credit shared", slog.Int("character_id", charID), slog.Int("party_id", p.ID), slog.String("target_id", targetID), slog.Int("members", len(shared)), slog.String("service", "quests"))
 	}
+	return shared
 }
@@ -19,7 +19,7 @@ func RegisterQuestProgressRoutes(r *gin.Engine, repos *repository.Container, svc
 		qp.POST("", acceptQuest(svc))
 		// TODO: migrate checkProgress/abandonQuest/checkAllQuests to fully use repos once
 		// QuestProgressRepo has methods for complex multi-table queries
-		qp.PUT("/:questId/check", checkProgress(repos, client))
+		qp.PUT("/:questId/check", checkProgress(repos, svc, client))
 		qp.PUT("/:questId/abandon", abandonQuest(repos, client))
 	}
 
@@ -28,6 +28,6 @@ func RegisterQuestProgressRoutes(r *gin.Engine, repos *repository.Container, svc
 	admin.Use(middleware.AuthMiddleware(nil))
 	admin.Use(middleware.AdminMiddleware())
 	{
-		admin.POST("/check-all", checkAllQuests(repos, client))
+		admin.POST("/check-all", checkAllQuests(repos, svc, client))
 	}
 }
\ No newline at end of file
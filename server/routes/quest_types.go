@@ -30,12 +30,13 @@ type questObjectiveInput struct {
 
 // questRewardsInput mirrors schema.QuestRewards for JSON input/output.
 type questRewardsInput struct {
-	XP             int      `json:"xp"`
-	ItemIDs        []string `json:"item_ids"`
-	EffectIDs      []int    `json:"effect_ids"`
-	TagAdds        []string `json:"tag_adds"`
-	TagRemoves     []string `json:"tag_removes"`
-	AchievementIDs []int    `json:"achievement_ids"`
+	XP             int            `json:"xp"`
+	ItemIDs        []string       `json:"item_ids"`
+	EffectIDs      []int          `json:"effect_ids"`
+	TagAdds        []string       `json:"tag_adds"`
+	TagRemoves     []string       `json:"tag_removes"`
+	AchievementIDs []int          `json:"achievement_ids"`
+	Reputation     map[string]int `json:"reputation,omitempty"`
 }
 
 // questInput is the JSON request shape for creating/updating a Quest.
@@ -81,5 +81,6 @@ func rewardsToSchema(r questRewardsInput) schema.QuestRewards {
 		TagAdds:        r.TagAdds,
 		TagRemoves:     r.TagRemoves,
 		AchievementIDs: r.AchievementIDs,
+		Reputation:     r.Reputation,
 	}
 }
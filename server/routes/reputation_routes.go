@@ -0,0 +1,85 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterReputationRoutes registers the faction reputation endpoints. The
+// owner of the :id character can read its standings; only admins can
+// change them directly.
+func RegisterReputationRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/reputation", reputationListHandler(svc, repos))
+		chars.POST("/:id/reputation", middleware.AdminMiddleware(), reputationAdjustHandler(svc))
+	}
+}
+
+// reputationErrorStatus maps reputation service errors to HTTP status codes.
+func reputationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNoFaction):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondReputationError(c *gin.Context, err error, charID int) {
+	status := reputationErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("reputation request failed", err, slog.String("service", "reputation"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+func reputationListHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		standings, err := svc.Reputation.Standings(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondReputationError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"reputation": standings})
+	}
+}
+
+// reputationAdjustHandler moves a character's standing with a faction by
+// delta, as quests and kills do.
+func reputationAdjustHandler(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		var req struct {
+			Faction string `json:"faction" binding:"required"`
+			Delta   int    `json:"delta" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		standing, err := svc.Reputation.Adjust(c.Request.Context(), id, req.Faction, req.Delta)
+		if err != nil {
+			respondReputationError(c, err, id)
+			return
+		}
+		c.JSON(http.StatusOK, standing)
+	}
+}
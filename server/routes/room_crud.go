@@ -11,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"herbst-server/db"
 	"herbst-server/db/room"
+	"herbst-server/db/schema"
 	"herbst-server/dblog"
 	"herbst-server/service"
 	"log/slog"
@@ -48,14 +49,15 @@ func validateExits(ctx context.Context, client *db.Client, exits map[string]int,
 func createRoom(svc *service.Container, client *db.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input struct {
-			Name           string         `json:"name" binding:"required"`
-			Description    string         `json:"description" binding:"required"`
-			IsStartingRoom bool           `json:"isStartingRoom"`
-			IsRootRoom     bool           `json:"isRootRoom"`
-			Exits          map[string]int `json:"exits"`
-			Atmosphere     string         `json:"atmosphere"`
-			PosZ           int            `json:"posZ"`
-			ZoneIDs        []string       `json:"zoneIds"`
+			Name           string                      `json:"name" binding:"required"`
+			Description    string                      `json:"description" binding:"required"`
+			IsStartingRoom bool                        `json:"isStartingRoom"`
+			IsRootRoom     bool                        `json:"isRootRoom"`
+			Exits          map[string]int              `json:"exits"`
+			ExitStates     map[string]schema.ExitState `json:"exitStates"`
+			Atmosphere     string                      `json:"atmosphere"`
+			PosZ           int                         `json:"posZ"`
+			ZoneIDs        []string                    `json:"zoneIds"`
 		}
 		if err := c.ShouldBindJSON(&input); err != nil {
 			slog.Warn("bad request", slog.String("service", "rooms"), slog.String("reason", "invalid json"), slog.String("client_ip", c.ClientIP()))
@@ -70,12 +72,18 @@ func createRoom(svc *service.Container, client *db.Client) gin.HandlerFunc {
 				return
 			}
 		}
+		if errors := service.ValidateExitStates(input.Exits, input.ExitStates); len(errors) > 0 {
+			slog.Warn("invalid exit states", slog.String("service", "rooms"), slog.Any("errors", errors), slog.String("client_ip", c.ClientIP()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid exit states: " + strings.Join(errors, "; ")})
+			return
+		}
 		room, err := svc.Room.CreateRoom(c.Request.Context(), service.CreateRoomInput{
 			Name:           input.Name,
 			Description:    input.Description,
 			IsStartingRoom: input.IsStartingRoom,
 			IsRootRoom:     input.IsRootRoom,
 			Exits:          input.Exits,
+			ExitStates:     input.ExitStates,
 			Atmosphere:     input.Atmosphere,
 			PosZ:           input.PosZ,
 			WorldID:        c.Query("world_id"),
@@ -153,15 +161,16 @@ func updateRoom(svc *service.Container, client *db.Client) gin.HandlerFunc {
 			return
 		}
 		var input struct {
-			Name           *string         `json:"name"`
-			Description    *string         `json:"description"`
-			IsStartingRoom *bool           `json:"isStartingRoom"`
-			IsRootRoom     *bool           `json:"isRootRoom"`
-			Exits          *map[string]int `json:"exits"`
-			Atmosphere     *string         `json:"atmosphere"`
-			PosZ           *int            `json:"posZ"`
-			Version        *int            `json:"version"`
-			ZoneIDs        *[]string       `json:"zoneIds"`
+			Name           *string                      `json:"name"`
+			Description    *string                      `json:"description"`
+			IsStartingRoom *bool                        `json:"isStartingRoom"`
+			IsRootRoom     *bool                        `json:"isRootRoom"`
+			Exits          *map[string]int              `json:"exits"`
+			ExitStates     *map[string]schema.ExitState `json:"exitStates"`
+			Atmosphere     *string                      `json:"atmosphere"`
+			PosZ           *int                         `json:"posZ"`
+			Version        *int                         `json:"version"`
+			ZoneIDs        *[]string                    `json:"zoneIds"`
 		}
 		if err := c.ShouldBindJSON(&input); err != nil {
 			slog.Warn("bad request", slog.String("service", "rooms"), slog.String("reason", "invalid json"), slog.String("client_ip", c.ClientIP()))
@@ -182,6 +191,7 @@ func updateRoom(svc *service.Container, client *db.Client) gin.HandlerFunc {
 			IsStartingRoom: input.IsStartingRoom,
 			IsRootRoom:     input.IsRootRoom,
 			Exits:          input.Exits,
+			ExitStates:     input.ExitStates,
 			Atmosphere:     input.Atmosphere,
 			PosZ:           input.PosZ,
 			Version:        input.Version,
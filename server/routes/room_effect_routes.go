@@ -0,0 +1,91 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// RegisterRoomEffectRoutes registers the endpoint the SSH server calls when
+// a hook targeting "room" or "room_except_source" fires. The server resolves
+// the occupants of the source's room and applies the hook's effect to each
+// of them. Players can't call it: it needs the effects:apply service scope,
+// so hooks only fire when the game says they do.
+func RegisterRoomEffectRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	r.POST("/api/characters/:id/effects/room", middleware.ServiceAuthMiddleware(middleware.ScopeEffectsApply), applyRoomHookHandler(svc, repos))
+}
+
+// roomEffectRequest is the body for POST /api/characters/:id/effects/room.
+// The effect always lands in the character's current room.
+type roomEffectRequest struct {
+	HookID int                    `json:"hook_id"`
+	Extras map[string]interface{} `json:"extras"`
+}
+
+// roomEffectErrorStatus maps room effect service errors to HTTP status codes.
+func roomEffectErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrHookNotFound),
+		errors.Is(err, service.ErrCharacterNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNotRoomHook):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrHookDisabled),
+		errors.Is(err, service.ErrRoomHookNoRoom):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func applyRoomHookHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		ch, err := repos.Character.Get(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Character not found"})
+			return
+		}
+		var req roomEffectRequest
+		if err := c.ShouldBindJSON(&req); err != nil || req.HookID == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "hook_id is required"})
+			return
+		}
+		result, err := svc.RoomEffect.ApplyRoomHook(c.Request.Context(), req.HookID, ch.ID, ch.CurrentRoomId, req.Extras)
+		if err != nil {
+			status := roomEffectErrorStatus(err)
+			if status == http.StatusInternalServerError {
+				dblog.Error("room effect failed", err, slog.String("service", "effects"), slog.Int("character_id", ch.ID), slog.Int("hook_id", req.HookID))
+			}
+			c.JSON(status, gin.H{"error": err.Error()})
+			return
+		}
+
+		// The source sees the message locally; everyone else gets it live.
+		if result.Message != "" {
+			for _, t := range result.Targets {
+				if t.IsNPC || t.ID == ch.ID {
+					continue
+				}
+				stream.Default().Send(t.ID, stream.Event{
+					Type:    stream.TypeEffect,
+					Text:    result.Message,
+					RoomID:  result.RoomID,
+					ActorID: ch.ID,
+				})
+			}
+		}
+		slog.Info("room effect applied", slog.Int("character_id", ch.ID), slog.Int("hook_id", req.HookID), slog.Int("room_id", result.RoomID), slog.Int("targets", len(result.Targets)), slog.String("service", "effects"))
+		c.JSON(http.StatusOK, result)
+	}
+}
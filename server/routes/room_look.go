@@ -45,6 +45,7 @@ func (rc *roomClient) getCharacters(c *gin.Context) {
 	type charView struct {
 		ID            int    `json:"id"`
 		Name          string `json:"name"`
+		Title         string `json:"title,omitempty"`
 		IsNPC         bool   `json:"isNPC"`
 		Level         int    `json:"level"`
 		Class         string `json:"class"`
@@ -71,7 +72,7 @@ func (rc *roomClient) getCharacters(c *gin.Context) {
 			}
 		}
 		cv := charView{
-			ID: ch.ID, Name: ch.Name, IsNPC: ch.IsNPC,
+			ID: ch.ID, Name: ch.Name, Title: ch.Title, IsNPC: ch.IsNPC,
 			Level: ch.Level, Class: ch.Class, Race: ch.Race,
 			Hp: ch.Hitpoints, MaxHp: ch.MaxHitpoints,
 			NpcTemplateID: ch.NpcTemplateID,
@@ -116,12 +117,17 @@ func (rc *roomClient) getLook(c *gin.Context) {
 	npcs, players := partitionCharacters(c, characters)
 	equipments, _ := rc.db.Equipment.Query().All(c.Request.Context())
 	items := filterVisibleItems(equipments, id)
+	conditions, _ := rc.svc.WorldTime.RoomConditions(c.Request.Context(), id)
 	c.JSON(http.StatusOK, gin.H{
 		"id":             room.ID,
 		"name":           room.Name,
-		"description":    room.Description,
+		"description":    withConditions(room.Description, conditions),
+		"time":           conditions.Time,
+		"weather":        conditions.Weather,
+		"outdoor":        conditions.Outdoor,
 		"isStartingRoom": room.IsStartingRoom,
 		"exits":          room.Exits,
+		"exitStates":     room.ExitStates,
 		"items":          items,
 		"npcs":           npcs,
 		"players":        players,
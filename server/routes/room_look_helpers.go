@@ -6,8 +6,18 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"herbst-server/db"
+	"herbst-server/service"
 )
 
+// withConditions appends the time of day and weather to an outdoor room's
+// description.
+func withConditions(description string, rc service.RoomConditions) string {
+	if suffix := rc.Suffix(); suffix != "" {
+		return description + "\n\n" + suffix
+	}
+	return description
+}
+
 // partitionCharacters splits characters into NPC and player lists.
 func partitionCharacters(c *gin.Context, characters []*db.Character) (npcs, players []interface{}) {
 	userID, exists := c.Get("user_id")
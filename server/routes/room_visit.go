@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/dblog"
+	"herbst-server/events"
+	"herbst-server/repository"
+)
+
+// recordRoomVisit adds roomID to the rooms ch has entered. The first visit
+// to a room publishes room.visited, which drives rooms_visited achievements.
+func recordRoomVisit(ctx context.Context, repos *repository.Container, ch *db.Character, roomID int) {
+	if ch.IsNPC {
+		return
+	}
+	for _, id := range ch.VisitedRooms {
+		if id == roomID {
+			return
+		}
+	}
+	visited := append(append(make([]int, 0, len(ch.VisitedRooms)+1), ch.VisitedRooms...), roomID)
+	if _, err := repos.Character.Update(ctx, ch.ID, repository.CharacterUpdates{VisitedRooms: visited}); err != nil {
+		dblog.Error("failed to record room visit", err, slog.String("service", "rooms"), slog.Int("character_id", ch.ID), slog.Int("room_id", roomID))
+		return
+	}
+	events.Publish(events.Event{
+		Type: events.EventRoomVisited,
+		Payload: map[string]interface{}{
+			"character_id":  ch.ID,
+			"room_id":       roomID,
+			"rooms_visited": len(visited),
+		},
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
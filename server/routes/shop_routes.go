@@ -0,0 +1,377 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/db"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// RegisterShopRoutes registers shop admin CRUD and the player buy/sell endpoints.
+func RegisterShopRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	shops := r.Group("/api/shops")
+	shops.Use(middleware.AuthMiddleware(nil))
+	shops.Use(middleware.AdminMiddleware())
+	{
+		shops.GET("", listShops(repos))
+		shops.POST("", createShop(repos))
+		shops.GET("/:id", getShop(repos))
+		shops.PUT("/:id", updateShop(repos))
+		shops.DELETE("/:id", deleteShop(repos))
+		shops.POST("/:id/restock", restockShop(svc))
+		shops.GET("/:id/items", listShopItems(repos))
+		shops.POST("/:id/items", createShopItem(repos))
+		shops.PUT("/:id/items/:template_id", updateShopItem(repos))
+		shops.DELETE("/:id/items/:template_id", deleteShopItem(repos))
+	}
+
+	// Player-facing: trade with the shopkeeper in the character's room
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/shop", shopListHandler(svc, repos))
+		chars.POST("/:id/shop/buy", shopBuyHandler(svc, repos))
+		chars.POST("/:id/shop/sell", shopSellHandler(svc, repos))
+		chars.POST("/:id/shop/value", shopValueHandler(svc, repos))
+	}
+}
+
+// shopErrorStatus maps shop service errors to HTTP status codes.
+func shopErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrNoShopkeeper),
+		errors.Is(err, service.ErrItemNotForSale),
+		errors.Is(err, service.ErrItemNotInventory):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrOutOfStock),
+		errors.Is(err, service.ErrShopCannotAfford),
+		errors.Is(err, service.ErrNPCHostile):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrInsufficientGold),
+		errors.Is(err, service.ErrItemNotSellable),
+		errors.Is(err, service.ErrInvalidQuantity):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondShopError(c *gin.Context, err error, charID int) {
+	status := shopErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("shop request failed", err, slog.String("service", "shops"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+// shopTradeRequest is the body for buy/sell/value.
+type shopTradeRequest struct {
+	Item     string `json:"item"`
+	Quantity int    `json:"quantity"`
+}
+
+func bindShopTrade(c *gin.Context) (shopTradeRequest, bool) {
+	var req shopTradeRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Item == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "item is required"})
+		return req, false
+	}
+	return req, true
+}
+
+func shopListHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		charID := ch.ID
+		view, err := svc.Shop.ListWares(c.Request.Context(), charID)
+		if err != nil {
+			respondShopError(c, err, charID)
+			return
+		}
+		c.JSON(http.StatusOK, view)
+	}
+}
+
+func shopBuyHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		charID := ch.ID
+		req, ok := bindShopTrade(c)
+		if !ok {
+			return
+		}
+		if req.Quantity == 0 {
+			req.Quantity = 1
+		}
+		result, err := svc.Shop.Buy(c.Request.Context(), charID, req.Item, req.Quantity)
+		if err != nil {
+			respondShopError(c, err, charID)
+			return
+		}
+		slog.Info("shop purchase", slog.String("service", "shops"), slog.Int("character_id", charID), slog.String("item", result.ItemName), slog.Int("quantity", result.Quantity), slog.Int("gold", result.Gold))
+		c.JSON(http.StatusOK, result)
+	}
+}
+
+func shopSellHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		charID := ch.ID
+		req, ok := bindShopTrade(c)
+		if !ok {
+			return
+		}
+		result, err := svc.Shop.Sell(c.Request.Context(), charID, req.Item)
+		if err != nil {
+			respondShopError(c, err, charID)
+			return
+		}
+		slog.Info("shop sale", slog.String("service", "shops"), slog.Int("character_id", charID), slog.String("item", result.ItemName), slog.Int("gold", result.Gold))
+		c.JSON(http.StatusOK, result)
+	}
+}
+
+func shopValueHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		charID := ch.ID
+		req, ok := bindShopTrade(c)
+		if !ok {
+			return
+		}
+		appraisal, err := svc.Shop.Appraise(c.Request.Context(), charID, req.Item)
+		if err != nil {
+			respondShopError(c, err, charID)
+			return
+		}
+		c.JSON(http.StatusOK, appraisal)
+	}
+}
+
+// ─── Admin CRUD ───────────────────────────────────────────────────────────────
+
+func listShops(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		shops, err := repos.ShopTemplate.List(c.Request.Context(), c.DefaultQuery("world_id", "1"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"shops": shops})
+	}
+}
+
+func getShop(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		shop, err := repos.ShopTemplate.Get(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "shop not found"})
+			return
+		}
+		c.JSON(http.StatusOK, shop)
+	}
+}
+
+func createShop(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		input := repository.CreateShopTemplateInput{
+			WorldID:      "1",
+			MaxInventory: 50,
+			GoldReserves: 1000,
+			IsActive:     true,
+		}
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if input.Name == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+			return
+		}
+		shop, err := repos.ShopTemplate.Create(c.Request.Context(), input)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		slog.Info("shop created", slog.String("service", "shops"), slog.Int("shop_id", shop.ID), slog.String("user_email", c.GetString("email")))
+		c.JSON(http.StatusCreated, shop)
+	}
+}
+
+func updateShop(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		var updates repository.ShopTemplateUpdates
+		if err := c.ShouldBindJSON(&updates); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		shop, err := repos.ShopTemplate.Update(c.Request.Context(), id, updates)
+		if err != nil {
+			if db.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "shop not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, shop)
+	}
+}
+
+func deleteShop(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		if err := repos.ShopTemplate.Delete(c.Request.Context(), id); err != nil {
+			if db.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "shop not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"deleted": id})
+	}
+}
+
+func restockShop(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		n, err := svc.Shop.Restock(c.Request.Context(), id)
+		if err != nil {
+			dblog.Error("manual restock failed", err, slog.String("service", "shops"), slog.Int("shop_id", id))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"shop_id": id, "items_restocked": n})
+	}
+}
+
+func listShopItems(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		items, err := repos.ShopItem.ListByShop(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"items": items})
+	}
+}
+
+func createShopItem(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := getIDParam(c)
+		if !ok {
+			return
+		}
+		input := repository.CreateShopItemInput{MaxStock: 99, IsEnabled: true}
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if input.EquipmentTemplateID == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "equipment_template_id is required"})
+			return
+		}
+		if _, err := repos.EquipmentTemplate.Get(c.Request.Context(), input.EquipmentTemplateID); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "equipment template not found"})
+			return
+		}
+		input.ShopID = id
+		item, err := repos.ShopItem.Create(c.Request.Context(), input)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, item)
+	}
+}
+
+// shopItemParams parses the :id and :template_id path params.
+func shopItemParams(c *gin.Context) (int, int, bool) {
+	id, ok := getIDParam(c)
+	if !ok {
+		return 0, 0, false
+	}
+	templateID, err := strconv.Atoi(c.Param("template_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid template_id"})
+		return 0, 0, false
+	}
+	return id, templateID, true
+}
+
+func updateShopItem(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, templateID, ok := shopItemParams(c)
+		if !ok {
+			return
+		}
+		var updates repository.ShopItemUpdates
+		if err := c.ShouldBindJSON(&updates); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		item, err := repos.ShopItem.Update(c.Request.Context(), id, templateID, updates)
+		if err != nil {
+			if db.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "shop item not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, item)
+	}
+}
+
+func deleteShopItem(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, templateID, ok := shopItemParams(c)
+		if !ok {
+			return
+		}
+		if err := repos.ShopItem.Delete(c.Request.Context(), id, templateID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"deleted": templateID})
+	}
+}
@@ -0,0 +1,218 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/db"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// streamKeepAlive is how often an idle event stream sends an SSE comment so
+// proxies and clients don't time the connection out.
+const streamKeepAlive = 20 * time.Second
+
+var errNotYourCharacter = errors.New("character does not belong to user")
+
+// RegisterStreamRoutes registers the per-character live event stream and the
+// move endpoint that keeps the server's idea of where a character stands in
+// step with the client. Both require a Bearer token for the character's
+// owner (or an admin).
+func RegisterStreamRoutes(r *gin.Engine, repos *repository.Container, services *service.Container) {
+	stream.Default().SetRoomLookup(playersInRoom(repos))
+
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/stream", characterStreamHandler(repos))
+		chars.POST("/:id/move", characterMoveHandler(repos, services))
+	}
+}
+
+// playersInRoom lists the player characters standing in a room.
+func playersInRoom(repos *repository.Container) stream.RoomLookup {
+	return func(ctx context.Context, roomID int) ([]int, error) {
+		chars, err := repos.Character.ListByRoom(ctx, roomID)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, 0, len(chars))
+		for _, ch := range chars {
+			if !ch.IsNPC {
+				ids = append(ids, ch.ID)
+			}
+		}
+		return ids, nil
+	}
+}
+
+// authorizeCharacter loads the :id character and checks that the caller
+// owns it. Admins may act on any character. It writes the error response
+// itself and returns nil when the request should stop.
+func authorizeCharacter(c *gin.Context, repos *repository.Container) *db.Character {
+	charID, ok := getIDParam(c)
+	if !ok {
+		return nil
+	}
+	return authorizeCharacterID(c, repos, charID)
+}
+
+// authorizeCharacterID is authorizeCharacter for a character named
+// somewhere other than the path, such as a request body.
+func authorizeCharacterID(c *gin.Context, repos *repository.Container, charID int) *db.Character {
+	ctx := c.Request.Context()
+	if !c.GetBool("is_admin") {
+		userID, _ := c.Get("user_id")
+		uid, _ := userID.(uint)
+		owned, err := repos.Character.ListByUser(ctx, int(uid))
+		if err != nil {
+			dblog.Error("failed to load user characters", err, slog.String("service", "stream"), slog.Int("user_id", int(uid)))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load characters"})
+			return nil
+		}
+		for _, ch := range owned {
+			if ch.ID == charID {
+				return ch
+			}
+		}
+		c.JSON(http.StatusForbidden, gin.H{"error": errNotYourCharacter.Error()})
+		return nil
+	}
+	ch, err := repos.Character.Get(ctx, charID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "character not found"})
+		return nil
+	}
+	return ch
+}
+
+// characterStreamHandler handles GET /api/characters/:id/stream. It serves
+// the character's live room events as server-sent events, one JSON
+// stream.Event per message, until the client disconnects.
+func characterStreamHandler(repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		flusher, ok := c.Writer.(http.Flusher)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "streaming not supported"})
+			return
+		}
+
+		events, cancel := stream.Default().Subscribe(ch.ID)
+		defer cancel()
+
+		c.Writer.Header().Set("Content-Type", "text/event-stream")
+		c.Writer.Header().Set("Cache-Control", "no-cache")
+		c.Writer.Header().Set("Connection", "keep-alive")
+		c.Writer.WriteHeader(http.StatusOK)
+		fmt.Fprint(c.Writer, ": connected\n\n")
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(streamKeepAlive)
+		defer keepAlive.Stop()
+		ctx := c.Request.Context()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-keepAlive.C:
+				fmt.Fprint(c.Writer, ": keepalive\n\n")
+				flusher.Flush()
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(ev)
+				if err != nil {
+					continue
+				}
+				fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", ev.Type, data)
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// characterMoveHandler handles POST /api/characters/:id/move {"direction"}.
+// It follows an exit from the character's current room, persists the new
+// room and tells both rooms about it.
+func characterMoveHandler(repos *repository.Container, services *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var req struct {
+			Direction string `json:"direction"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil || req.Direction == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "direction is required"})
+			return
+		}
+		ctx := c.Request.Context()
+		rm, err := repos.Room.Get(ctx, ch.CurrentRoomId)
+		if err != nil {
+			c.JSON(http.StatusConflict, gin.H{"error": "character is not in a room"})
+			return
+		}
+		targetID, barred, err := exitTarget(ctx, services, ch, rm, req.Direction)
+		if err != nil {
+			dblog.Error("failed to open instance", err, slog.String("service", "stream"), slog.Int("character_id", ch.ID), slog.Int("room_id", rm.ID))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open instance"})
+			return
+		}
+		if barred != "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": barred})
+			return
+		}
+		if _, err := repos.Character.Update(ctx, ch.ID, repository.CharacterUpdates{CurrentRoomID: &targetID}); err != nil {
+			dblog.Error("failed to move character", err, slog.String("service", "stream"), slog.Int("character_id", ch.ID), slog.Int("target_room", targetID))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to move character"})
+			return
+		}
+		announceMove(ctx, ch, rm.ID, targetID, req.Direction)
+		recordRoomVisit(ctx, repos, ch, targetID)
+		services.NPCBehavior.PlayerEntered(ctx, ch.ID, targetID)
+		services.RoomTrigger.Left(ctx, ch.ID, rm.ID)
+		services.RoomTrigger.Entered(ctx, ch.ID, targetID)
+		c.JSON(http.StatusOK, gin.H{"room_id": targetID, "from_room_id": rm.ID, "direction": req.Direction})
+	}
+}
+
+// announceMove tells the players in the old and new rooms that a character
+// walked between them.
+func announceMove(ctx context.Context, ch *db.Character, fromRoom, toRoom int, direction string) {
+	hub := stream.Default()
+	hub.ToRoom(ctx, fromRoom, stream.Event{
+		Type:    stream.TypeLeave,
+		Text:    fmt.Sprintf("%s leaves %s.", ch.Name, direction),
+		ActorID: ch.ID,
+	}, ch.ID)
+	hub.ToRoom(ctx, toRoom, stream.Event{
+		Type:    stream.TypeEnter,
+		Text:    fmt.Sprintf("%s arrives.", ch.Name),
+		ActorID: ch.ID,
+	}, ch.ID)
+}
+
+// announceToRoom sends a line to everyone in the room but the actor.
+func announceToRoom(ctx context.Context, roomID, actorID int, eventType, text string) {
+	stream.Default().ToRoom(ctx, roomID, stream.Event{
+		Type:    eventType,
+		Text:    text,
+		ActorID: actorID,
+	}, actorID)
+}
@@ -0,0 +1,160 @@
+package routes
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"herbst-server/dblog"
+	"herbst-server/middleware"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// RegisterTradeRoutes registers the player-to-player trade endpoints:
+// asking someone to trade, putting items and gold on offer, and confirming
+// or calling off the exchange.
+func RegisterTradeRoutes(r *gin.Engine, svc *service.Container, repos *repository.Container) {
+	chars := r.Group("/api/characters")
+	chars.Use(middleware.AuthMiddleware(nil))
+	{
+		chars.GET("/:id/trade", tradeGetHandler(svc, repos))
+		chars.POST("/:id/trade", tradeRequestHandler(svc, repos))
+		chars.POST("/:id/trade/offer", tradeOfferHandler(svc, repos))
+		chars.POST("/:id/trade/confirm", tradeConfirmHandler(svc, repos))
+		chars.POST("/:id/trade/cancel", tradeCancelHandler(svc, repos))
+	}
+}
+
+// tradeErrorStatus maps trade service errors to HTTP status codes.
+func tradeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrNotTrading):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrAlreadyTrading),
+		errors.Is(err, service.ErrTargetTrading),
+		errors.Is(err, service.ErrTradeApart),
+		errors.Is(err, service.ErrTradeChanged),
+		errors.Is(err, service.ErrInsufficientGold):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrTradeIgnored):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrInvalidTradeTarget),
+		errors.Is(err, service.ErrTradeItem),
+		errors.Is(err, service.ErrTradeTooManyItems),
+		errors.Is(err, service.ErrTradeAmount):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func respondTradeError(c *gin.Context, err error, charID int) {
+	status := tradeErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		dblog.Error("trade request failed", err, slog.String("service", "trade"), slog.Int("character_id", charID))
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+// respondTradeUpdate pushes the update's notice to the other trader and
+// returns it to the caller.
+func respondTradeUpdate(c *gin.Context, update *service.TradeUpdate, charID int) {
+	if update.Notice != "" {
+		for _, id := range update.Notify {
+			stream.Default().Send(id, stream.Event{
+				Type:    stream.TypeTrade,
+				Text:    update.Notice,
+				ActorID: charID,
+			})
+		}
+	}
+	c.JSON(http.StatusOK, update)
+}
+
+func tradeGetHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		trade, err := svc.Trade.Get(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondTradeError(c, err, ch.ID)
+			return
+		}
+		c.JSON(http.StatusOK, trade)
+	}
+}
+
+func tradeRequestHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		name, ok := bindPartyTarget(c)
+		if !ok {
+			return
+		}
+		update, err := svc.Trade.Request(c.Request.Context(), ch.ID, name)
+		if err != nil {
+			respondTradeError(c, err, ch.ID)
+			return
+		}
+		respondTradeUpdate(c, update, ch.ID)
+	}
+}
+
+func tradeOfferHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		var in service.TradeOfferInput
+		if err := c.ShouldBindJSON(&in); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		update, err := svc.Trade.Offer(c.Request.Context(), ch.ID, in)
+		if err != nil {
+			respondTradeError(c, err, ch.ID)
+			return
+		}
+		respondTradeUpdate(c, update, ch.ID)
+	}
+}
+
+func tradeConfirmHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		update, err := svc.Trade.Confirm(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondTradeError(c, err, ch.ID)
+			return
+		}
+		respondTradeUpdate(c, update, ch.ID)
+	}
+}
+
+func tradeCancelHandler(svc *service.Container, repos *repository.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ch := authorizeCharacter(c, repos)
+		if ch == nil {
+			return
+		}
+		update, err := svc.Trade.Cancel(c.Request.Context(), ch.ID)
+		if err != nil {
+			respondTradeError(c, err, ch.ID)
+			return
+		}
+		respondTradeUpdate(c, update, ch.ID)
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"herbst-server/condition"
 	"herbst-server/db"
 	"herbst-server/middleware"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // RegisterTriggerRoutes registers CRUD endpoints for Trigger definitions.
@@ -32,46 +34,85 @@ func RegisterTriggerRoutes(r *gin.Engine, repos *repository.Container) {
 
 // triggerView is the JSON shape returned by the API.
 type triggerView struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	WorldID     string `json:"world_id"`
-	TriggerType string `json:"trigger_type"`
-	TargetType  string `json:"target_type"`
-	TargetID    int    `json:"target_id"`
-	RoomID      *int   `json:"room_id,omitempty"`
-	EquipmentID *int   `json:"equipment_id,omitempty"`
-	Condition   string `json:"condition,omitempty"`
-	Enabled     bool   `json:"enabled"`
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	WorldID      string `json:"world_id"`
+	TriggerType  string `json:"trigger_type"`
+	TargetType   string `json:"target_type"`
+	TargetID     int    `json:"target_id"`
+	RoomID       *int   `json:"room_id,omitempty"`
+	EquipmentID  *int   `json:"equipment_id,omitempty"`
+	Condition    string `json:"condition,omitempty"`
+	Keyword      string `json:"keyword,omitempty"`
+	IntervalSecs int    `json:"interval_secs,omitempty"`
+	Affects      string `json:"affects,omitempty"`
+	Enabled      bool   `json:"enabled"`
 }
 
 // triggerInput is the request body for create and update.
 type triggerInput struct {
-	Name        string  `json:"name"`
-	WorldID     string  `json:"world_id"`
-	TriggerType string  `json:"trigger_type"`
-	TargetType  string  `json:"target_type"`
-	TargetID    int     `json:"target_id"`
-	RoomID      *int    `json:"room_id,omitempty"`
-	EquipmentID *int    `json:"equipment_id,omitempty"`
-	Condition   string  `json:"condition"`
-	Enabled     bool    `json:"enabled"`
+	Name         string `json:"name"`
+	WorldID      string `json:"world_id"`
+	TriggerType  string `json:"trigger_type"`
+	TargetType   string `json:"target_type"`
+	TargetID     int    `json:"target_id"`
+	RoomID       *int   `json:"room_id,omitempty"`
+	EquipmentID  *int   `json:"equipment_id,omitempty"`
+	Condition    string `json:"condition"`
+	Keyword      string `json:"keyword"`
+	IntervalSecs int    `json:"interval_secs"`
+	Affects      string `json:"affects"`
+	Enabled      bool   `json:"enabled"`
 }
 
 func triggerToView(t *db.Trigger) triggerView {
 	return triggerView{
-		ID:          t.ID,
-		Name:        t.Name,
-		WorldID:     t.WorldID,
-		TriggerType: t.TriggerType,
-		TargetType:  t.TargetType,
-		TargetID:    t.TargetID,
-		RoomID:      t.RoomID,
-		EquipmentID: t.EquipmentID,
-		Condition:   t.Condition,
-		Enabled:     t.Enabled,
+		ID:           t.ID,
+		Name:         t.Name,
+		WorldID:      t.WorldID,
+		TriggerType:  t.TriggerType,
+		TargetType:   t.TargetType,
+		TargetID:     t.TargetID,
+		RoomID:       t.RoomID,
+		EquipmentID:  t.EquipmentID,
+		Condition:    t.Condition,
+		Keyword:      t.Keyword,
+		IntervalSecs: t.IntervalSecs,
+		Affects:      t.Affects,
+		Enabled:      t.Enabled,
 	}
 }
 
+// serverTriggerTypes are the trigger types the server fires itself.
+var serverTriggerTypes = map[string]bool{
+	service.TriggerEnter: true,
+	service.TriggerLeave: true,
+	service.TriggerSay:   true,
+	service.TriggerDrop:  true,
+	service.TriggerTimer: true,
+}
+
+// validateServerTrigger checks the fields the server-fired trigger types
+// need, returning what is wrong or "" when the input is fine.
+func validateServerTrigger(input triggerInput) string {
+	if !serverTriggerTypes[input.TriggerType] {
+		return ""
+	}
+	switch {
+	case input.RoomID == nil || *input.RoomID == 0:
+		return input.TriggerType + " triggers need a room_id"
+	case input.TargetType != "effect":
+		return input.TriggerType + " triggers must target an effect"
+	case input.TriggerType == service.TriggerTimer && input.IntervalSecs <= 0:
+		return "timer triggers need interval_secs above 0"
+	}
+	switch input.Affects {
+	case "", "self", service.HookTargetRoom, service.HookTargetRoomExceptSource:
+		return ""
+	}
+	return "affects must be self, room or room_except_source"
+}
+
 func listTriggers(repos *repository.Container) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		triggers, err := repos.Trigger.List(c.Request.Context())
@@ -110,16 +151,29 @@ func createTrigger(repos *repository.Container) gin.HandlerFunc {
 			return
 		}
 
+		if err := condition.Validate(input.Condition); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if msg := validateServerTrigger(input); msg != "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": msg})
+			return
+		}
+
 		created, err := repos.Trigger.Create(c.Request.Context(), repository.CreateTriggerInput{
-			Name:        input.Name,
-			WorldID:     input.WorldID,
-			TriggerType: input.TriggerType,
-			TargetType:  input.TargetType,
-			TargetID:    input.TargetID,
-			RoomID:      input.RoomID,
-			EquipmentID: input.EquipmentID,
-			Condition:   input.Condition,
-			Enabled:     input.Enabled,
+			Name:         input.Name,
+			WorldID:      input.WorldID,
+			TriggerType:  input.TriggerType,
+			TargetType:   input.TargetType,
+			TargetID:     input.TargetID,
+			RoomID:       input.RoomID,
+			EquipmentID:  input.EquipmentID,
+			Condition:    input.Condition,
+			Keyword:      input.Keyword,
+			IntervalSecs: input.IntervalSecs,
+			Affects:      input.Affects,
+			Enabled:      input.Enabled,
 		})
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -165,16 +219,29 @@ func updateTrigger(repos *repository.Container) gin.HandlerFunc {
 			return
 		}
 
+		if err := condition.Validate(input.Condition); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if msg := validateServerTrigger(input); msg != "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": msg})
+			return
+		}
+
 		updates := repository.TriggerUpdates{
-			Name:        &input.Name,
-			WorldID:     &input.WorldID,
-			TriggerType: &input.TriggerType,
-			TargetType:  &input.TargetType,
-			TargetID:    &input.TargetID,
-			RoomID:      input.RoomID,
-			EquipmentID: input.EquipmentID,
-			Condition:   &input.Condition,
-			Enabled:     &input.Enabled,
+			Name:         &input.Name,
+			WorldID:      &input.WorldID,
+			TriggerType:  &input.TriggerType,
+			TargetType:   &input.TargetType,
+			TargetID:     &input.TargetID,
+			RoomID:       input.RoomID,
+			EquipmentID:  input.EquipmentID,
+			Condition:    &input.Condition,
+			Keyword:      &input.Keyword,
+			IntervalSecs: &input.IntervalSecs,
+			Affects:      &input.Affects,
+			Enabled:      &input.Enabled,
 		}
 
 		updated, err := repos.Trigger.Update(c.Request.Context(), id, updates)
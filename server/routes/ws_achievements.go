@@ -0,0 +1,91 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// titledName is how a character appears in who and examine: their name
+// followed by their chosen title, e.g. "Mira the Ratcatcher".
+func titledName(name, title string) string {
+	if title == "" {
+		return name
+	}
+	return name + " " + title
+}
+
+// tryWho lists the players who are online.
+func tryWho(repos *repository.Container) string {
+	players, err := onlinePlayers(context.Background(), repos)
+	if err != nil {
+		dblog.Error("ws who command failed", err, slog.String("service", "characters"))
+		return "You can't make out who else is around right now."
+	}
+	if len(players) == 0 {
+		return "You are alone in the world."
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Players online (%d):\n", len(players))
+	for _, p := range players {
+		fmt.Fprintf(&b, "  [%3d %-10s] %s\n", p.Level, p.Class, titledName(p.Name, p.Title))
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// tryAchievements shows the character's completed achievements and their
+// progress towards the rest.
+func tryAchievements(wsc *WSConn, services *service.Container) string {
+	view, err := services.Achievement.List(context.Background(), wsc.CharacterID)
+	if err != nil {
+		dblog.Error("ws achievements command failed", err, slog.Int("character_id", wsc.CharacterID))
+		return "Your deeds escape you for the moment."
+	}
+	if len(view.Achievements) == 0 {
+		return "There are no achievements to earn yet."
+	}
+	var b strings.Builder
+	b.WriteString("Achievements:\n")
+	for _, a := range view.Achievements {
+		mark := " "
+		if a.Completed {
+			mark = "*"
+		}
+		fmt.Fprintf(&b, "  [%s] %-28s %d/%d", mark, a.Name, a.Progress, a.Target)
+		if a.Title != "" {
+			fmt.Fprintf(&b, "  (title: %s)", a.Title)
+		}
+		b.WriteString("\n")
+	}
+	if view.Title != "" {
+		fmt.Fprintf(&b, "Current title: %s", view.Title)
+	} else {
+		b.WriteString("Use 'title <title>' to display a title you have earned.")
+	}
+	return b.String()
+}
+
+// trySetTitle handles "title <title>" and "title none".
+func trySetTitle(title string, wsc *WSConn, services *service.Container) string {
+	if strings.TrimSpace(title) == "" {
+		return "Usage: title <title> | title none"
+	}
+	set, err := services.Achievement.SetTitle(context.Background(), wsc.CharacterID, title)
+	if err != nil {
+		if errors.Is(err, service.ErrTitleNotEarned) {
+			return "You have not earned that title."
+		}
+		dblog.Error("ws title command failed", err, slog.Int("character_id", wsc.CharacterID))
+		return "You can't change your title right now."
+	}
+	if set == "" {
+		return "You no longer display a title."
+	}
+	return fmt.Sprintf("Your title is now \"%s\".", set)
+}
@@ -0,0 +1,109 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"herbst-server/combat"
+	"herbst-server/db"
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// pushCombatResult sends a tick result to every listed character that has
+// an open WebSocket, and the tick's lines to anyone else watching from the
+// same room. It is registered as the combat engine's notifier.
+func pushCombatResult(characterIDs []int, result combat.TickResult) {
+	if len(result.Lines) > 0 {
+		stream.Default().ToRoom(context.Background(), result.RoomID, stream.Event{
+			Type: stream.TypeCombat,
+			Text: strings.Join(result.Lines, "\n"),
+		}, characterIDs...)
+	}
+
+	want := make(map[int]bool, len(characterIDs))
+	for _, id := range characterIDs {
+		want[id] = true
+	}
+	msg := ServerMessage{
+		Type:      MsgCombat,
+		Text:      strings.Join(result.Lines, "\n"),
+		Data:      result,
+		Timestamp: time.Now().UnixMilli(),
+	}
+	for _, wsc := range GetConnections() {
+		if want[wsc.CharacterID] {
+			wsc.send(msg)
+		}
+	}
+}
+
+// combatErrorText turns a combat engine error into a line for the player.
+func combatErrorText(err error, charID int) string {
+	if combatErrorStatus(err) == http.StatusInternalServerError {
+		dblog.Error("ws combat command failed", err, slog.Int("character_id", charID))
+		return "Your limbs refuse to obey. Try again in a moment."
+	}
+	msg := err.Error()
+	return strings.ToUpper(msg[:1]) + msg[1:] + "."
+}
+
+// tryAttack engages a hostile NPC in the character's room. The fight itself
+// is resolved by the combat engine on each tick.
+func tryAttack(targetName string, wsc *WSConn, repos *repository.Container, services *service.Container) string {
+	ctx := context.Background()
+	char, err := repos.Character.Get(ctx, wsc.CharacterID)
+	if err != nil {
+		dblog.Error("tryAttack: failed to get character", err, slog.Int("character_id", wsc.CharacterID))
+		return "You try to attack, but something is wrong with your character."
+	}
+
+	// Find NPC by name in current room
+	roomChars, err := repos.Character.ListByRoom(ctx, char.CurrentRoomId)
+	if err != nil {
+		dblog.Error("tryAttack: failed to list room characters", err, slog.Int("room_id", char.CurrentRoomId))
+		return fmt.Sprintf("You swing at empty air — %s is not here.", targetName)
+	}
+
+	var targetNPC *db.Character
+	for _, ch := range roomChars {
+		if ch.IsNPC && strings.Contains(strings.ToLower(ch.Name), strings.ToLower(targetName)) {
+			targetNPC = ch
+			break
+		}
+	}
+	if targetNPC == nil {
+		return fmt.Sprintf("There is no %s here to attack.", targetName)
+	}
+
+	// Check disposition via template
+	if targetNPC.NpcTemplateID != "" {
+		tmpl, err := repos.NPCTemplate.Get(ctx, targetNPC.NpcTemplateID)
+		if err == nil && tmpl.Disposition != "hostile" {
+			return fmt.Sprintf("%s is friendly. They recoil in surprise. (Type '/combat confirm' to attack neutral targets — Phase 6.)", targetNPC.Name)
+		}
+	}
+
+	view, err := services.CombatEngine.Engage(ctx, char.ID, targetNPC.ID)
+	if err != nil {
+		return combatErrorText(err, char.ID)
+	}
+	if len(view.Players) > 1 {
+		return fmt.Sprintf("⚔ You join the fight against %s! (%d/%d HP)", view.NPC.Name, view.NPC.HP, view.NPC.MaxHP)
+	}
+	return fmt.Sprintf("⚔ You enter combat with %s! (%d/%d HP)", view.NPC.Name, view.NPC.HP, view.NPC.MaxHP)
+}
+
+// tryCombatAction queues an action for the next combat tick.
+func tryCombatAction(action combat.ActionType, wsc *WSConn, services *service.Container) string {
+	if err := services.CombatEngine.Queue(wsc.CharacterID, combat.Action{Type: action}); err != nil {
+		return combatErrorText(err, wsc.CharacterID)
+	}
+	return fmt.Sprintf("⏱ Queued: %s", action)
+}
@@ -2,69 +2,77 @@ package routes
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"strconv"
 
 	"herbst-server/db"
-	"herbst-server/db/schema"
 	"herbst-server/dblog"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // handleDialogChoice processes a player's dialog response. The command format is
-// "dialog <template_id> <node_id> [<choice_index>]". choice_index is 1-based.
-func handleDialogChoice(templateID, nodeID, choiceStr string, wsc *WSConn, repos *repository.Container, client *db.Client) string {
+// "dialog <template_id> <node_id> [<choice_index>]". choice_index is 1-based and
+// counts only the responses the player was shown.
+func handleDialogChoice(templateID, nodeID, choiceStr string, wsc *WSConn, repos *repository.Container, services *service.Container, client *db.Client) string {
 	ctx := context.Background()
 
-	node, err := repos.DialogNode.Get(ctx, nodeID)
-	if err != nil {
-		dblog.Error("handleDialogChoice: failed to get dialog node", err, slog.String("node_id", nodeID))
-		return "Failed to load dialog node."
-	}
-
-	tmpl, err := repos.NPCTemplate.Get(ctx, templateID)
-	if err != nil {
-		dblog.Error("handleDialogChoice: failed to get NPC template", err, slog.String("template_id", templateID))
-		return "Invalid NPC template."
-	}
-
 	slog.Info("dialog choice", slog.Int("character_id", wsc.CharacterID), slog.String("template_id", templateID), slog.String("node_id", nodeID), slog.String("choice", choiceStr))
 
-	resp := pickResponse(node.Responses, choiceStr)
-	if resp == nil {
+	choice, ok := parseDialogChoice(choiceStr)
+	if !ok {
+		return "That is not a valid response."
+	}
+	result, err := services.Conversation.Choose(ctx, wsc.CharacterID, templateID, nodeID, choice)
+	switch {
+	case errors.Is(err, service.ErrInvalidDialogChoice):
 		return "That is not a valid response."
+	case errors.Is(err, service.ErrNPCTemplateNotFound):
+		return "Invalid NPC template."
+	case errors.Is(err, service.ErrDialogNodeNotFound):
+		return "Failed to load dialog node."
+	case err != nil:
+		dblog.Error("handleDialogChoice: failed to choose response", err, slog.String("template_id", templateID), slog.String("node_id", nodeID))
+		return "The conversation trails off."
 	}
 
-	applyDialogEffects(ctx, wsc, repos, client, resp.Effects)
+	applyDialogEffects(ctx, wsc, repos, client, result.Response.Effects)
 
-	if resp.NextNodeID == "" {
+	if result.Response.NextNodeID == "" {
 		return "You end the conversation."
 	}
-
-	nextNode, err := repos.DialogNode.Get(ctx, resp.NextNodeID)
-	if err != nil {
-		dblog.Error("handleDialogChoice: failed to get next node", err, slog.String("node_id", resp.NextNodeID))
+	if result.NextNode == nil {
 		return "The conversation trails off."
 	}
 
-	applyDialogEffects(ctx, wsc, repos, client, nextNode.OnEnterEffects)
+	applyDialogEffects(ctx, wsc, repos, client, result.NextNode.OnEnterEffects)
 
-	nodes, _ := repos.DialogNode.ListByTemplate(ctx, templateID)
-	sendConversationScreen(wsc, tmpl.Name, templateID, nodes, nextNode.ID)
+	conv := result.Conversation
+	sendConversationScreen(wsc, conv.NPCName, conv.NPCTemplateID, conv.Nodes, conv.CurrentNodeID)
 	return ""
 }
 
-// pickResponse selects the response chosen by the player. choiceStr is 1-based.
-func pickResponse(responses []schema.DialogResponse, choiceStr string) *schema.DialogResponse {
-	if len(responses) == 0 {
-		return nil
-	}
+// parseDialogChoice reads the 1-based choice index. An empty choice is 0,
+// which picks the first visible response.
+func parseDialogChoice(choiceStr string) (int, bool) {
 	if choiceStr == "" {
-		return &responses[0]
+		return 0, true
 	}
 	idx, err := strconv.Atoi(choiceStr)
-	if err != nil || idx < 1 || idx > len(responses) {
-		return nil
+	if err != nil || idx < 1 {
+		return 0, false
+	}
+	return idx, true
+}
+
+// entryNodeEffects returns the on-enter effects of the node a conversation
+// opened on.
+func entryNodeEffects(conv *service.Conversation) []int {
+	for _, n := range conv.Nodes {
+		if n.ID == conv.CurrentNodeID {
+			return n.OnEnterEffects
+		}
 	}
-	return &responses[idx-1]
+	return nil
 }
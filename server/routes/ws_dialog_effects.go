@@ -8,6 +8,7 @@ import (
 	"herbst-server/db"
 	"herbst-server/dblog"
 	"herbst-server/repository"
+	"herbst-server/service"
 )
 
 // applyDialogEffects runs a list of effect IDs attached to a dialog response or node entry.
@@ -64,6 +65,8 @@ func applyDialogEffect(ctx context.Context, wsc *WSConn, repos *repository.Conta
 		if text != "" {
 			sendNotification(wsc, text)
 		}
+	case service.EffectDialogFlagSet, service.EffectDialogFlagClear, service.EffectDialogCounterAdd, service.EffectDialogCounterSet:
+		// Dialog state is written by the conversation service.
 	}
 	return nil
 }
\ No newline at end of file
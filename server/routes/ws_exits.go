@@ -0,0 +1,78 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"herbst-server/db"
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// exitErrorText turns an exit service error into a line for the player.
+// Unexpected errors are logged and replaced with a generic message.
+func exitErrorText(err error, charID int) string {
+	if exitErrorStatus(err) == http.StatusInternalServerError {
+		dblog.Error("ws exit command failed", err, slog.Int("character_id", charID))
+		return "The door won't budge. Try again in a moment."
+	}
+	msg := err.Error()
+	return strings.ToUpper(msg[:1]) + msg[1:] + "."
+}
+
+// tryDoor handles open, close, lock and unlock <direction>.
+func tryDoor(verb, dir string, wsc *WSConn, repos *repository.Container, services *service.Container) string {
+	actions := map[string]func(context.Context, int, string) (*service.ExitView, error){
+		"open":   services.Exit.Open,
+		"close":  services.Exit.Close,
+		"lock":   services.Exit.Lock,
+		"unlock": services.Exit.Unlock,
+	}
+	if dir == "" {
+		return fmt.Sprintf("%s which way?", strings.ToUpper(verb[:1])+verb[1:])
+	}
+	ctx := context.Background()
+	char, err := repos.Character.Get(ctx, wsc.CharacterID)
+	if err != nil {
+		dblog.Error("ws door command: failed to get character", err, slog.Int("character_id", wsc.CharacterID))
+		return "Something is wrong with your character."
+	}
+	if _, err := actions[verb](ctx, char.ID, dir); err != nil {
+		return exitErrorText(err, char.ID)
+	}
+	announceToRoom(ctx, char.CurrentRoomId, char.ID, stream.TypeEmote, fmt.Sprintf("%s %ss the door %s.", char.Name, verb, dir))
+	return fmt.Sprintf("You %s the door %s.", verb, dir)
+}
+
+// trySearch searches the room for hidden exits and shows any found.
+func trySearch(wsc *WSConn, repos *repository.Container, services *service.Container, client *db.Client) string {
+	ctx := context.Background()
+	char, err := repos.Character.Get(ctx, wsc.CharacterID)
+	if err != nil {
+		dblog.Error("ws search command: failed to get character", err, slog.Int("character_id", wsc.CharacterID))
+		return "Something is wrong with your character."
+	}
+	found, err := services.Exit.Search(ctx, char.ID, searchPerception(ctx, client, services, char))
+	if err != nil {
+		return exitErrorText(err, char.ID)
+	}
+	if len(found) == 0 {
+		return "You search the room but find nothing."
+	}
+	dirs := make([]string, len(found))
+	for i, ex := range found {
+		dirs[i] = ex.Direction
+	}
+	// Show the room again, now with the found exits.
+	if fresh, err := repos.Character.Get(ctx, char.ID); err == nil {
+		if screen, err := buildRoomScreen(ctx, fresh.CurrentRoomId, fresh.CurrentWorld, fresh.FoundExits, repos); err == nil {
+			sendScreen(wsc, screen)
+		}
+	}
+	return fmt.Sprintf("You find a hidden way %s!", strings.Join(dirs, " and "))
+}
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"herbst-server/dblog"
+	"herbst-server/service"
+)
+
+// tryReputation shows the character's standing with each faction they have
+// any standing with.
+func tryReputation(wsc *WSConn, services *service.Container) string {
+	standings, err := services.Reputation.Standings(context.Background(), wsc.CharacterID)
+	if err != nil {
+		dblog.Error("ws reputation command failed", err, slog.Int("character_id", wsc.CharacterID))
+		return "You can't recall where you stand with anyone right now."
+	}
+	if len(standings) == 0 {
+		return "No faction has formed an opinion of you yet."
+	}
+	var b strings.Builder
+	b.WriteString("Reputation:\n")
+	for _, s := range standings {
+		fmt.Fprintf(&b, "  %-24s %-10s %5d\n", s.Faction, s.Tier, s.Standing)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -3,6 +3,7 @@ package routes
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
+	"herbst-server/combat"
 	"herbst-server/db"
 	"herbst-server/db/character"
 	"herbst-server/db/characterskill"
@@ -20,6 +22,8 @@ import (
 	"herbst-server/dblog"
 	"herbst-server/middleware"
 	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
 )
 
 // ─── Message protocol ─────────────────────────────────────────────────────────
@@ -47,6 +51,8 @@ const (
 	MsgScreen    = "screen"
 	MsgVitals    = "vitals"
 	MsgNotify    = "notification"  // Notification events (quest completed, etc.)
+	MsgCombat    = "combat"        // Per-tick combat results
+	MsgEvent     = "event"         // Live room events (arrivals, speech, NPC movement)
 )
 
 // VitalsPayload represents character vitality stats
@@ -82,6 +88,7 @@ type RoomExit struct {
 	Direction string `json:"direction"`
 	Target    int    `json:"target"`
 	Label     string `json:"label"`
+	State     string `json:"state"` // open, closed or locked
 }
 
 // RoomScreenPayload is the structured room data sent to the client.
@@ -171,24 +178,27 @@ func isCharacterConnected(ch *db.Character, connected map[int]bool) bool {
 	return connected[ch.ID]
 }
 
-func buildRoomScreen(ctx context.Context, roomID int, worldID string, repos *repository.Container) (RoomScreenPayload, error) {
+// buildRoomScreen builds the room view for a character who has found the
+// hidden exits in found; hidden exits they haven't found are left out.
+func buildRoomScreen(ctx context.Context, roomID int, worldID string, found []string, repos *repository.Container) (RoomScreenPayload, error) {
 	rm, err := repos.Room.Get(ctx, roomID)
 	if err != nil {
 		return RoomScreenPayload{}, err
 	}
 
-	// Build exits from room.Exits map (direction -> targetID)
+	// Build exits from the exits the character can see
 	var exits []RoomExit
-	for dir, targetID := range rm.Exits {
-		label := fmt.Sprintf("Exit %s", dir)
+	for _, ex := range service.VisibleExits(rm, found) {
+		label := fmt.Sprintf("Exit %s", ex.Direction)
 		// Try to get the target room name for a nicer label
-		if tgt, err := repos.Room.Get(ctx, targetID); err == nil {
+		if tgt, err := repos.Room.Get(ctx, ex.Target); err == nil {
 			label = tgt.Name
 		}
 		exits = append(exits, RoomExit{
-			Direction: dir,
-			Target:    targetID,
+			Direction: ex.Direction,
+			Target:    ex.Target,
 			Label:     label,
+			State:     ex.State,
 		})
 	}
 
@@ -243,11 +253,13 @@ func buildRoomScreen(ctx context.Context, roomID int, worldID string, repos *rep
 		}
 	}
 
+	conditions := service.CurrentRoomConditions(ctx, repos.World, repos.Zone, rm, time.Now())
+
 	return RoomScreenPayload{
 		ViewType:    "room",
 		ID:          roomID,
 		Title:       rm.Name,
-		Description: rm.Description,
+		Description: withConditions(rm.Description, conditions),
 		Exits:       exits,
 		Characters:  chars,
 		Items:       items,
@@ -257,11 +269,12 @@ func buildRoomScreen(ctx context.Context, roomID int, worldID string, repos *rep
 // ─── Handler ──────────────────────────────────────────────────────────────────
 
 // RegisterWSRoutes registers the WebSocket upgrade endpoint.
-func RegisterWSRoutes(router *gin.Engine, repos *repository.Container, client *db.Client) {
-	router.GET("/ws", wsHandler(repos, client))
+func RegisterWSRoutes(router *gin.Engine, repos *repository.Container, services *service.Container, client *db.Client) {
+	router.GET("/ws", wsHandler(repos, services, client))
+	services.CombatEngine.SetNotifier(pushCombatResult)
 }
 
-func wsHandler(repos *repository.Container, client *db.Client) gin.HandlerFunc {
+func wsHandler(repos *repository.Container, services *service.Container, client *db.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// JWT from query param (WebSocket constructor can't set headers)
 		token := c.Query("token")
@@ -356,7 +369,7 @@ func wsHandler(repos *repository.Container, client *db.Client) gin.HandlerFunc {
 		})
 
 		// Send structured room screen
-		roomScreen, err := buildRoomScreen(c.Request.Context(), char.CurrentRoomId, char.CurrentWorld, repos)
+		roomScreen, err := buildRoomScreen(c.Request.Context(), char.CurrentRoomId, char.CurrentWorld, char.FoundExits, repos)
 		if err == nil {
 			sendScreen(wsc, roomScreen)
 		} else {
@@ -368,15 +381,20 @@ func wsHandler(repos *repository.Container, client *db.Client) gin.HandlerFunc {
 			})
 		}
 
+		// Forward live room events for this character
+		roomEvents, cancelEvents := stream.Default().Subscribe(charID)
+		defer cancelEvents()
+		go wsc.forwardEvents(roomEvents)
+
 		// Start goroutines
 		go wsc.writePump()
-		wsc.readPump(repos, client)
+		wsc.readPump(repos, services, client)
 	}
 }
 
 // ─── Read pump ────────────────────────────────────────────────────────────────
 
-func (wsc *WSConn) readPump(repos *repository.Container, client *db.Client) {
+func (wsc *WSConn) readPump(repos *repository.Container, services *service.Container, client *db.Client) {
 	wsc.Conn.SetReadDeadline(time.Now().Add(60 * time.Second))
 	wsc.Conn.SetPongHandler(func(string) error {
 		wsc.Conn.SetReadDeadline(time.Now().Add(60 * time.Second))
@@ -403,7 +421,7 @@ func (wsc *WSConn) readPump(repos *repository.Container, client *db.Client) {
 			wsc.send(ServerMessage{Type: MsgPing, Text: "pong", Timestamp: time.Now().UnixMilli()})
 
 		case "command":
-			response := handleCommand(msg.Payload, wsc, repos, client)
+			response := handleCommand(msg.Payload, wsc, repos, services, client)
 			wsc.send(ServerMessage{Type: MsgOutput, Text: response, Timestamp: time.Now().UnixMilli()})
 
 		default:
@@ -442,6 +460,14 @@ func (wsc *WSConn) writePump() {
 	}
 }
 
+// forwardEvents relays stream events to the socket until the subscription
+// is cancelled.
+func (wsc *WSConn) forwardEvents(events <-chan stream.Event) {
+	for ev := range events {
+		wsc.send(ServerMessage{Type: MsgEvent, Text: ev.Text, Data: ev, Timestamp: ev.Timestamp})
+	}
+}
+
 func (wsc *WSConn) send(msg ServerMessage) {
 	select {
 	case wsc.Send <- msg:
@@ -533,8 +559,9 @@ func examineNPC(ctx context.Context, roomID int, targetName string, repos *repos
 	return nil, ""
 }
 
-// fireExamineTriggers fires examine triggers for the target and returns combined description
-func fireExamineTriggers(ctx context.Context, targetName string, examineLevel int, targetID int, roomID int, repos *repository.Container) (string, error) {
+// fireExamineTriggers fires examine triggers for the target and returns combined description.
+// Triggers whose condition is false (or fails to evaluate) for charID are skipped.
+func fireExamineTriggers(ctx context.Context, targetName string, examineLevel int, charID int, targetID int, roomID int, repos *repository.Container, conds service.ConditionService) (string, error) {
 	var results []string
 
 	// Get examine triggers for the room
@@ -550,12 +577,22 @@ func fireExamineTriggers(ctx context.Context, targetName string, examineLevel in
 	// Filter and fire triggers where examine_weight <= player level
 	for _, t := range triggers {
 		if t.TriggerType == "examine" && t.Enabled && t.ExamineWeight <= examineLevel {
+			if t.Condition != "" {
+				ok, err := conds.Evaluate(ctx, t.Condition, service.ConditionInput{SourceID: charID, TargetID: targetID, RoomID: roomID})
+				if err != nil {
+					slog.Warn("trigger condition failed", slog.String("service", "triggers"), slog.Int("trigger_id", t.ID), slog.String("error", err.Error()))
+					continue
+				}
+				if !ok {
+					continue
+				}
+			}
 			if t.TargetType == "dialog_node" {
-				results = append(results, fmt.Sprintf("Dialog node: %s", t.TargetID))
+				results = append(results, fmt.Sprintf("Dialog node: %d", t.TargetID))
 			} else if t.TargetType == "effect" {
-				results = append(results, fmt.Sprintf("Effect: %s", t.TargetID))
+				results = append(results, fmt.Sprintf("Effect: %d", t.TargetID))
 			} else if t.TargetType == "recipe" {
-				results = append(results, fmt.Sprintf("Recipe: %s", t.TargetID))
+				results = append(results, fmt.Sprintf("Recipe: %d", t.TargetID))
 			}
 		}
 	}
@@ -626,7 +663,7 @@ func tryTake(targetName string, wsc *WSConn, repos *repository.Container) string
 		slog.Int("room_id", char.CurrentRoomId))
 
 	// Refresh room screen
-	roomScreen, err := buildRoomScreen(ctx, char.CurrentRoomId, char.CurrentWorld, repos)
+	roomScreen, err := buildRoomScreen(ctx, char.CurrentRoomId, char.CurrentWorld, char.FoundExits, repos)
 	if err != nil {
 		dblog.Error("tryTake: failed to build room screen", err, slog.Int("room_id", char.CurrentRoomId))
 	} else {
@@ -638,7 +675,7 @@ func tryTake(targetName string, wsc *WSConn, repos *repository.Container) string
 
 // ─── drop ────────────────────────────────────────────────────────────────────
 
-func tryDrop(targetName string, wsc *WSConn, repos *repository.Container) string {
+func tryDrop(targetName string, wsc *WSConn, repos *repository.Container, services *service.Container) string {
 	ctx := context.Background()
 	char, err := repos.Character.Get(ctx, wsc.CharacterID)
 	if err != nil {
@@ -681,9 +718,10 @@ func tryDrop(targetName string, wsc *WSConn, repos *repository.Container) string
 		dblog.Error("tryDrop: failed to update equipment", err, slog.Int("equipment_id", targetItem.ID))
 		return fmt.Sprintf("Failed to drop the %s.", targetItem.Name)
 	}
+	services.RoomTrigger.Dropped(ctx, char.ID, char.CurrentRoomId, targetItem.Name)
 
 	// Refresh room screen
-	roomScreen, err := buildRoomScreen(ctx, char.CurrentRoomId, char.CurrentWorld, repos)
+	roomScreen, err := buildRoomScreen(ctx, char.CurrentRoomId, char.CurrentWorld, char.FoundExits, repos)
 	if err != nil {
 		dblog.Error("tryDrop: failed to build room screen", err, slog.Int("room_id", char.CurrentRoomId))
 	} else {
@@ -695,7 +733,7 @@ func tryDrop(targetName string, wsc *WSConn, repos *repository.Container) string
 
 // ─── Command handler ──────────────────────────────────────────────────────────
 
-func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client *db.Client) string {
+func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, services *service.Container, client *db.Client) string {
 	if cmd == "" {
 		return "Type a command and press Enter."
 	}
@@ -712,6 +750,10 @@ func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client
 
 		examineLevel := getExamineLevel(ctx, client, char.ID)
 		roomID := char.CurrentRoomId
+		// Darkness and bad weather outdoors hide details.
+		if rc, err := services.WorldTime.RoomConditions(ctx, roomID); err == nil {
+			examineLevel = max(0, examineLevel+rc.PerceptionModifier())
+		}
 
 		// Try to find the target as an item, NPC, or player character
 		var targetDescription string
@@ -757,7 +799,7 @@ func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client
 						}
 						desc += fmt.Sprintf("\n\nLevel: %d", ch.Level)
 					} else {
-						desc = fmt.Sprintf("[%s]\nA player adventurer.", ch.Name)
+						desc = fmt.Sprintf("[%s]\nA player adventurer.", titledName(ch.Name, ch.Title))
 						desc += fmt.Sprintf("\nLevel: %d", ch.Level)
 					}
 
@@ -790,7 +832,7 @@ func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client
 		}
 
 		// Fire examine triggers
-		examineResult, err := fireExamineTriggers(ctx, target, examineLevel, 0, roomID, repos)
+		examineResult, err := fireExamineTriggers(ctx, target, examineLevel, char.ID, 0, roomID, repos, services.Condition)
 		if err != nil {
 			dblog.Error("examine: failed to fire triggers", err, slog.Int("room_id", roomID))
 		}
@@ -804,7 +846,7 @@ func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client
 	// talk to <target>
 	if len(cmd) > 5 && strings.HasPrefix(cmd, "talk ") {
 		target := cmd[5:]
-		return tryTalk(target, wsc, repos)
+		return tryTalk(target, wsc, repos, services, client)
 	}
 
 	// dialog <template_id> <node_id> [<choice_index>]
@@ -818,17 +860,17 @@ func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client
 		if len(parts) >= 4 {
 			choiceStr = parts[3]
 		}
-		return handleDialogChoice(parts[1], parts[2], choiceStr, wsc, repos, client)
+		return handleDialogChoice(parts[1], parts[2], choiceStr, wsc, repos, services, client)
 	}
 
 	// attack <target> and fight <target>
 	if strings.HasPrefix(cmd, "attack ") {
 		target := strings.TrimPrefix(cmd, "attack ")
-		return tryAttack(target, wsc, repos, client)
+		return tryAttack(target, wsc, repos, services)
 	}
 	if strings.HasPrefix(cmd, "fight ") {
 		target := strings.TrimPrefix(cmd, "fight ")
-		return tryAttack(target, wsc, repos, client)
+		return tryAttack(target, wsc, repos, services)
 	}
 
 	parts := strings.Fields(cmd)
@@ -853,7 +895,7 @@ func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client
 		"d": "down", "down": "down",
 	}
 	if dir, ok := directionMap[base]; ok {
-		return tryMove(dir, wsc, repos, client)
+		return tryMove(dir, wsc, repos, services, client)
 	}
 
 	switch base {
@@ -870,7 +912,7 @@ func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client
 			dblog.Error("look command: failed to get character", err, slog.Int("character_id", wsc.CharacterID))
 			return "You look around, but your surroundings refuse to come into focus."
 		}
-		roomScreen, err := buildRoomScreen(ctx, char.CurrentRoomId, char.CurrentWorld, repos)
+		roomScreen, err := buildRoomScreen(ctx, char.CurrentRoomId, char.CurrentWorld, char.FoundExits, repos)
 		if err != nil {
 			dblog.Error("look command: failed to build room screen", err, slog.Int("room_id", char.CurrentRoomId))
 		} else {
@@ -887,23 +929,66 @@ func handleCommand(cmd string, wsc *WSConn, repos *repository.Container, client
 
 	case "drop":
 		target := strings.TrimPrefix(cmd, "drop ")
-		return tryDrop(target, wsc, repos)
+		return tryDrop(target, wsc, repos, services)
+
+	case "list", "wares":
+		return tryShopList(wsc, services)
+
+	case "buy":
+		return tryShopBuy(parts[1:], wsc, services)
+
+	case "sell":
+		return tryShopSell(strings.Join(parts[1:], " "), wsc, services)
+
+	case "value", "appraise":
+		return tryShopValue(strings.Join(parts[1:], " "), wsc, services)
+
+	case "attack", "defend", "flee", "wait":
+		return tryCombatAction(combat.ActionType(base), wsc, services)
 
 	case "quit", "exit":
 		return "Disconnecting is not yet implemented. Use the browser UI."
 
 	case "who":
-		return "You are alone in the void. (Player list coming soon.)"
+		return tryWho(repos)
+
+	case "time", "weather":
+		return tryTime(wsc, repos, services)
+
+	case "achievements", "ach":
+		return tryAchievements(wsc, services)
+
+	case "reputation", "rep":
+		return tryReputation(wsc, services)
+
+	case "title":
+		return trySetTitle(strings.Join(parts[1:], " "), wsc, services)
+
+	case "trade":
+		return tryTrade(parts[1:], wsc, repos, services)
+
+	case "open", "close", "lock", "unlock":
+		dir := ""
+		if len(parts) > 1 {
+			dir = strings.ToLower(parts[1])
+			if d, ok := directionMap[dir]; ok {
+				dir = d
+			}
+		}
+		return tryDoor(base, dir, wsc, repos, services)
+
+	case "search":
+		return trySearch(wsc, repos, services, client)
 
 	case "help":
-		return "Available commands: look, who, help, quit, examine <target>, directions (n/s/e/w/u/d), take <item>, drop <item>. (More coming in Phase 6.)"
+		return "Available commands: look, who, time, achievements, reputation, title [name|none], help, quit, examine <target>, directions (n/s/e/w/u/d), open|close|lock|unlock <direction>, search, take <item>, drop <item>, list, buy <item> [qty], sell <item>, value <item>, trade <player>|add|remove|gold|confirm|cancel, attack <target>, defend, flee, wait. (More coming in Phase 6.)"
 
 	default:
 		return fmt.Sprintf("You typed: \"%s\". (Command not yet implemented.)", cmd)
 	}
 }
 
-func tryMove(dir string, wsc *WSConn, repos *repository.Container, client *db.Client) string {
+func tryMove(dir string, wsc *WSConn, repos *repository.Container, services *service.Container, client *db.Client) string {
 	ctx := context.Background()
 
 	char, err := repos.Character.Get(ctx, wsc.CharacterID)
@@ -918,10 +1003,14 @@ func tryMove(dir string, wsc *WSConn, repos *repository.Container, client *db.Cl
 		return "You can't figure out where you are."
 	}
 
-	// Check if exit exists in room.Exits map
-	targetID, ok := rm.Exits[dir]
-	if !ok {
-		return "You can't go that way."
+	// Closed doors and hidden exits bar the way; portals lead into instances.
+	targetID, barred, err := exitTarget(ctx, services, char, rm, dir)
+	if err != nil {
+		dblog.Error("tryMove: failed to open instance", err, slog.Int("character_id", char.ID), slog.Int("room_id", rm.ID))
+		return "The portal flickers but will not let you through."
+	}
+	if barred != "" {
+		return barred
 	}
 
 	// Move the character
@@ -932,15 +1021,20 @@ func tryMove(dir string, wsc *WSConn, repos *repository.Container, client *db.Cl
 		dblog.Error("tryMove: failed to update character room", err, slog.Int("character_id", char.ID), slog.Int("target_room", targetID))
 		return "Something prevents you from moving."
 	}
+	announceMove(ctx, char, rm.ID, targetID, dir)
+	recordRoomVisit(ctx, repos, char, targetID)
+	services.NPCBehavior.PlayerEntered(ctx, char.ID, targetID)
+	services.RoomTrigger.Left(ctx, char.ID, rm.ID)
+	services.RoomTrigger.Entered(ctx, char.ID, targetID)
 
 	// Check explore quests and notify player
-	questMsgs := advanceQuestObjective(ctx, client, repos, char.ID, "explore", fmt.Sprintf("%d", targetID), 1)
+	questMsgs := advanceQuestObjective(ctx, client, repos, services.Reputation, char.ID, "explore", fmt.Sprintf("%d", targetID), 1)
 	for _, msg := range questMsgs {
 		sendNotification(wsc, msg)
 	}
 
 	// Send new room screen
-	roomScreen, err := buildRoomScreen(ctx, targetID, char.CurrentWorld, repos)
+	roomScreen, err := buildRoomScreen(ctx, targetID, char.CurrentWorld, char.FoundExits, repos)
 	if err != nil {
 		dblog.Error("tryMove: failed to build new room screen", err, slog.Int("room_id", targetID))
 		return fmt.Sprintf("You move %s, but the new room refuses to resolve.", dir)
@@ -952,7 +1046,7 @@ func tryMove(dir string, wsc *WSConn, repos *repository.Container, client *db.Cl
 
 // ─── talk ────────────────────────────────────────────────────────────────────
 
-func tryTalk(targetName string, wsc *WSConn, repos *repository.Container) string {
+func tryTalk(targetName string, wsc *WSConn, repos *repository.Container, services *service.Container, client *db.Client) string {
 	ctx := context.Background()
 	char, err := repos.Character.Get(ctx, wsc.CharacterID)
 	if err != nil {
@@ -990,23 +1084,19 @@ func tryTalk(targetName string, wsc *WSConn, repos *repository.Container) string
 	}
 
 	// If the NPC template has dialog nodes, open the conversation overlay
-	// with the entry node (or the first node if no entry is marked).
-	nodes, err := repos.DialogNode.ListByTemplate(ctx, tmpl.ID)
-	if err != nil {
-		dblog.Error("tryTalk: failed to list dialog nodes", err, slog.String("template_id", tmpl.ID))
-	} else if len(nodes) > 0 {
-		entryID := ""
-		for _, n := range nodes {
-			if n.IsEntry {
-				entryID = n.ID
-				break
-			}
-		}
-		if entryID == "" {
-			entryID = nodes[0].ID
-		}
-		sendConversationScreen(wsc, tmpl.Name, tmpl.ID, nodes, entryID)
+	// with the first entry node whose condition holds for this character.
+	conv, err := services.Conversation.Start(ctx, char.ID, tmpl.ID)
+	switch {
+	case err == nil:
+		applyDialogEffects(ctx, wsc, repos, client, entryNodeEffects(conv))
+		sendConversationScreen(wsc, conv.NPCName, conv.NPCTemplateID, conv.Nodes, conv.CurrentNodeID)
 		return ""
+	case errors.Is(err, service.ErrNoDialogEntry):
+		return fmt.Sprintf("%s has nothing to say to you.", targetNPC.Name)
+	case errors.Is(err, service.ErrNPCHostile):
+		return fmt.Sprintf("%s refuses to speak with you.", targetNPC.Name)
+	case !errors.Is(err, service.ErrNoDialog):
+		dblog.Error("tryTalk: failed to start conversation", err, slog.String("template_id", tmpl.ID))
 	}
 
 	// No dialog nodes — fall back to greeting text
@@ -1015,101 +1105,3 @@ func tryTalk(targetName string, wsc *WSConn, repos *repository.Container) string
 	}
 	return fmt.Sprintf("%s greets you with a nod.", targetNPC.Name)
 }
-
-// ─── attack ────────────────────────────────────────────────────────────────────
-
-func tryAttack(targetName string, wsc *WSConn, repos *repository.Container, client *db.Client) string {
-	ctx := context.Background()
-	char, err := repos.Character.Get(ctx, wsc.CharacterID)
-	if err != nil {
-		dblog.Error("tryAttack: failed to get character", err, slog.Int("character_id", wsc.CharacterID))
-		return "You try to attack, but something is wrong with your character."
-	}
-
-	// Find NPC by name in current room
-	roomChars, err := repos.Character.ListByRoom(ctx, char.CurrentRoomId)
-	if err != nil {
-		dblog.Error("tryAttack: failed to list room characters", err, slog.Int("room_id", char.CurrentRoomId))
-		return fmt.Sprintf("You swing at empty air — %s is not here.", targetName)
-	}
-
-	var targetNPC *db.Character
-	for _, ch := range roomChars {
-		if ch.IsNPC && strings.Contains(strings.ToLower(ch.Name), strings.ToLower(targetName)) {
-			targetNPC = ch
-			break
-		}
-	}
-	if targetNPC == nil {
-		return fmt.Sprintf("There is no %s here to attack.", targetName)
-	}
-
-	// Check if player is trying to attack themselves (by ID, not name)
-	if targetNPC.ID == char.ID {
-		return "You cannot attack yourself!"
-	}
-
-	// Check disposition via template
-	hostile := true
-	var tmpl *db.NPCTemplate
-	if targetNPC.NpcTemplateID != "" {
-		var tmplErr error
-		tmpl, tmplErr = repos.NPCTemplate.Get(ctx, targetNPC.NpcTemplateID)
-		if tmplErr == nil {
-			hostile = tmpl.Disposition == "hostile"
-		}
-	}
-
-	if !hostile {
-		return fmt.Sprintf("%s is friendly. They recoil in surprise. (Type '/combat confirm' to attack neutral targets — Phase 6.)", targetNPC.Name)
-	}
-
-	// Simple damage roll (1d6 + str bonus)
-	damage := 1 + (char.Strength / 5)
-	if damage < 1 {
-		damage = 1
-	}
-
-	// Apply damage respecting immortality
-	newHP := targetNPC.Hitpoints - damage
-	if newHP < 0 {
-		newHP = 0
-	}
-	if targetNPC.IsImmortal && newHP < 1 {
-		newHP = 1
-	}
-	_, err = repos.Character.Update(ctx, targetNPC.ID, repository.CharacterUpdates{
-		Hitpoints: &newHP,
-	})
-	if err != nil {
-		dblog.Error("tryAttack: failed to apply damage", err, slog.Int("npc_id", targetNPC.ID), slog.Int("damage", damage))
-		return fmt.Sprintf("You swing your weapon, but your blow seems to pass through %s!", targetNPC.Name)
-	}
-
-	// Auto-heal immortal training dummy after 3s
-	if targetNPC.IsImmortal && strings.Contains(strings.ToLower(targetNPC.Name), "dummy") {
-		go func(npcID int, maxHP int) {
-			time.Sleep(3 * time.Second)
-			full := maxHP
-			_, healErr := repos.Character.Update(context.Background(), npcID, repository.CharacterUpdates{Hitpoints: &full})
-			if healErr != nil {
-				dblog.Error("training dummy auto-heal failed", healErr, slog.Int("npc_id", npcID))
-			}
-		}(targetNPC.ID, targetNPC.MaxHitpoints)
-	}
-
-	// Build result message
-	var msg string
-	if newHP == 0 && !targetNPC.IsImmortal {
-		msg = fmt.Sprintf("You strike %s with a mighty blow for %d damage! They crumple to the ground.", targetNPC.Name, damage)
-	} else {
-		msg = fmt.Sprintf("You attack %s for %d damage! (%d/%d HP)", targetNPC.Name, damage, newHP, targetNPC.MaxHitpoints)
-	}
-
-	// Training dummy counter-attack: always 0 damage
-	if strings.Contains(strings.ToLower(targetNPC.Name), "dummy") {
-		msg += "\nThe dummy swings harmlessly at you."
-	}
-
-	return msg
-}
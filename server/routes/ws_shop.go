@@ -0,0 +1,86 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"herbst-server/dblog"
+	"herbst-server/service"
+)
+
+// shopErrorText turns a shop service error into a line for the player.
+// Unexpected errors are logged and replaced with a generic message.
+func shopErrorText(err error, charID int) string {
+	if shopErrorStatus(err) == http.StatusInternalServerError {
+		dblog.Error("ws shop command failed", err, slog.Int("character_id", charID))
+		return "The shopkeeper seems distracted. Try again in a moment."
+	}
+	msg := err.Error()
+	return strings.ToUpper(msg[:1]) + msg[1:] + "."
+}
+
+// tryShopList shows the wares of the shopkeeper in the character's room.
+func tryShopList(wsc *WSConn, services *service.Container) string {
+	view, err := services.Shop.ListWares(context.Background(), wsc.CharacterID)
+	if err != nil {
+		return shopErrorText(err, wsc.CharacterID)
+	}
+	if len(view.Wares) == 0 {
+		return fmt.Sprintf("%s has nothing for sale right now.", view.ShopkeeperName)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s — %s\n", view.ShopkeeperName, view.ShopName)
+	for _, w := range view.Wares {
+		fmt.Fprintf(&b, "  %-28s %6d gold  (%d in stock)\n", w.Name, w.Price, w.Quantity)
+	}
+	b.WriteString("Use 'buy <item> [qty]' to purchase.")
+	return b.String()
+}
+
+// tryShopBuy handles "buy <item> [qty]". A trailing number is the quantity.
+func tryShopBuy(args []string, wsc *WSConn, services *service.Container) string {
+	if len(args) == 0 {
+		return "Buy what?"
+	}
+	qty := 1
+	if n, err := strconv.Atoi(args[len(args)-1]); err == nil && len(args) > 1 {
+		qty = n
+		args = args[:len(args)-1]
+	}
+	result, err := services.Shop.Buy(context.Background(), wsc.CharacterID, strings.Join(args, " "), qty)
+	if err != nil {
+		return shopErrorText(err, wsc.CharacterID)
+	}
+	return result.Message
+}
+
+// tryShopSell handles "sell <item>".
+func tryShopSell(target string, wsc *WSConn, services *service.Container) string {
+	if target == "" {
+		return "Sell what?"
+	}
+	result, err := services.Shop.Sell(context.Background(), wsc.CharacterID, target)
+	if err != nil {
+		return shopErrorText(err, wsc.CharacterID)
+	}
+	return result.Message
+}
+
+// tryShopValue handles "value <item>", quoting what the shopkeeper would pay.
+func tryShopValue(target string, wsc *WSConn, services *service.Container) string {
+	if target == "" {
+		return "Value what?"
+	}
+	a, err := services.Shop.Appraise(context.Background(), wsc.CharacterID, target)
+	if err != nil {
+		return shopErrorText(err, wsc.CharacterID)
+	}
+	if !a.WillBuy {
+		return fmt.Sprintf("Your %s is worth about %d gold, but %s.", a.ItemName, a.Value, a.Reason)
+	}
+	return fmt.Sprintf("The shopkeeper would pay %d gold for your %s.", a.Offer, a.ItemName)
+}
@@ -0,0 +1,136 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/service"
+	"herbst-server/stream"
+)
+
+// tradeErrorText turns a trade service error into a line for the player.
+// Unexpected errors are logged and replaced with a generic message.
+func tradeErrorText(err error, charID int) string {
+	if tradeErrorStatus(err) == http.StatusInternalServerError {
+		dblog.Error("ws trade command failed", err, slog.Int("character_id", charID))
+		return "The trade falls through. Try again in a moment."
+	}
+	msg := err.Error()
+	return strings.ToUpper(msg[:1]) + msg[1:] + "."
+}
+
+// tryTrade handles the trade command:
+// trade                 - show the open trade
+// trade <player>        - ask a player to trade, or accept their request
+// trade add <item>      - offer an item
+// trade remove <item>   - take an item off your offer
+// trade gold <amount>   - offer gold
+// trade confirm         - accept the trade as it stands
+// trade cancel          - call the trade off
+func tryTrade(args []string, wsc *WSConn, repos *repository.Container, services *service.Container) string {
+	ctx := context.Background()
+	if len(args) == 0 {
+		view, err := services.Trade.Get(ctx, wsc.CharacterID)
+		if err != nil {
+			return tradeErrorText(err, wsc.CharacterID)
+		}
+		return formatTrade(view)
+	}
+
+	rest := strings.Join(args[1:], " ")
+	var (
+		update *service.TradeUpdate
+		err    error
+	)
+	switch strings.ToLower(args[0]) {
+	case "add", "offer":
+		if rest == "" {
+			return "Offer what?"
+		}
+		id, ok := inventoryItemID(ctx, rest, wsc.CharacterID, repos)
+		if !ok {
+			return fmt.Sprintf("You don't have any %s to offer.", rest)
+		}
+		update, err = services.Trade.Offer(ctx, wsc.CharacterID, service.TradeOfferInput{Add: []int{id}})
+	case "remove":
+		view, gerr := services.Trade.Get(ctx, wsc.CharacterID)
+		if gerr != nil {
+			return tradeErrorText(gerr, wsc.CharacterID)
+		}
+		id := 0
+		for _, it := range view.You.Items {
+			if strings.Contains(strings.ToLower(it.Name), strings.ToLower(rest)) {
+				id = it.ID
+				break
+			}
+		}
+		if rest == "" || id == 0 {
+			return "You aren't offering that."
+		}
+		update, err = services.Trade.Offer(ctx, wsc.CharacterID, service.TradeOfferInput{Remove: []int{id}})
+	case "gold":
+		gold, cerr := strconv.Atoi(rest)
+		if cerr != nil {
+			return "Usage: trade gold <amount>"
+		}
+		update, err = services.Trade.Offer(ctx, wsc.CharacterID, service.TradeOfferInput{Gold: &gold})
+	case "confirm", "accept":
+		update, err = services.Trade.Confirm(ctx, wsc.CharacterID)
+	case "cancel":
+		update, err = services.Trade.Cancel(ctx, wsc.CharacterID)
+	default:
+		update, err = services.Trade.Request(ctx, wsc.CharacterID, strings.Join(args, " "))
+	}
+	if err != nil {
+		return tradeErrorText(err, wsc.CharacterID)
+	}
+	for _, id := range update.Notify {
+		stream.Default().Send(id, stream.Event{Type: stream.TypeTrade, Text: update.Notice, ActorID: wsc.CharacterID})
+	}
+	if update.Trade != nil {
+		return update.Message + "\n" + formatTrade(update.Trade)
+	}
+	return update.Message
+}
+
+// inventoryItemID finds an unequipped item the character carries by name.
+func inventoryItemID(ctx context.Context, name string, charID int, repos *repository.Container) (int, bool) {
+	inventory, err := repos.Equipment.ListByOwner(ctx, charID)
+	if err != nil {
+		dblog.Error("ws trade command: failed to list inventory", err, slog.Int("character_id", charID))
+		return 0, false
+	}
+	for _, item := range inventory {
+		if !item.IsEquipped && strings.Contains(strings.ToLower(item.Name), strings.ToLower(name)) {
+			return item.ID, true
+		}
+	}
+	return 0, false
+}
+
+// formatTrade shows both sides of a trade.
+func formatTrade(view *service.TradeView) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Trading with %s:\n", view.With)
+	side := func(label string, o service.TradeOfferView) {
+		mark := ""
+		if o.Confirmed {
+			mark = " (confirmed)"
+		}
+		fmt.Fprintf(&b, "  %s%s: %d gold", label, mark, o.Gold)
+		for _, it := range o.Items {
+			fmt.Fprintf(&b, ", %s", it.Name)
+		}
+		b.WriteString("\n")
+	}
+	side("You offer", view.You)
+	side(view.With+" offers", view.Them)
+	b.WriteString("Use 'trade confirm' to accept or 'trade cancel' to call it off.")
+	return b.String()
+}
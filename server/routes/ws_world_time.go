@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/service"
+)
+
+// tryTime tells the character the time of day and, outdoors, the weather.
+func tryTime(wsc *WSConn, repos *repository.Container, services *service.Container) string {
+	ctx := context.Background()
+	char, err := repos.Character.Get(ctx, wsc.CharacterID)
+	if err != nil {
+		dblog.Error("ws time command failed", err, slog.Int("character_id", wsc.CharacterID))
+		return "You have lost all track of time."
+	}
+	rc, err := services.WorldTime.RoomConditions(ctx, char.CurrentRoomId)
+	if err != nil {
+		dblog.Error("ws time command failed", err, slog.Int("room_id", char.CurrentRoomId))
+		return "You have lost all track of time."
+	}
+	line := fmt.Sprintf("It is %s on day %d (%s).", rc.Time.Clock(), rc.Time.Day, rc.Time.Phase)
+	if !rc.Outdoor {
+		return line + " You can't see the sky from in here."
+	}
+	if suffix := rc.Suffix(); suffix != "" {
+		line += " " + suffix
+	}
+	return line
+}
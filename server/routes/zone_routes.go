@@ -4,9 +4,11 @@ import (
 	"context"
 	"net/http"
 	"sort"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"herbst-server/db"
+	"herbst-server/db/schema"
 	"herbst-server/middleware"
 	"herbst-server/repository"
 	"herbst-server/service"
@@ -24,18 +26,26 @@ func RegisterZoneRoutes(r *gin.Engine, svc *service.Container, repos *repository
 		g.PUT("/zones/:id", updateZone(svc))
 		g.DELETE("/zones/:id", deleteZone(svc))
 		g.GET("/zones/:id/rooms", listZoneRooms(svc, repos))
+		g.POST("/zones/:id/reset", resetZone(svc))
+		g.GET("/zones/:id/reset", getZoneResetReport(svc))
+		g.GET("/zones/:id/instances", listZoneInstances(svc))
+		g.DELETE("/zones/:id/instances/:instance_id", closeZoneInstance(svc))
 	}
 }
 
 type zoneInput struct {
-	ID           string `json:"id" binding:"required"`
-	WorldID      string `json:"world_id" binding:"required"`
-	Name         string `json:"name" binding:"required"`
-	Description  string `json:"description"`
-	MinLevel     int    `json:"min_level"`
-	ParentZoneID string `json:"parent_zone_id"`
-	Color        string `json:"color"`
-	RoomIDs      []int  `json:"room_ids"`
+	ID                string                 `json:"id" binding:"required"`
+	WorldID           string                 `json:"world_id" binding:"required"`
+	Name              string                 `json:"name" binding:"required"`
+	Description       string                 `json:"description"`
+	MinLevel          int                    `json:"min_level"`
+	ParentZoneID      string                 `json:"parent_zone_id"`
+	Color             string                 `json:"color"`
+	RoomIDs           []int                  `json:"room_ids"`
+	Resets            []schema.ZoneReset     `json:"resets"`
+	ResetIntervalSecs int                    `json:"reset_interval_secs"`
+	Weather           *schema.ZoneWeather    `json:"weather"`
+	Instance          *schema.ZoneInstancing `json:"instance"`
 }
 
 func listZones(svc *service.Container) gin.HandlerFunc {
@@ -61,14 +71,18 @@ func createZone(svc *service.Container, repos *repository.Container) gin.Handler
 			return
 		}
 		zone, err := svc.Zone.CreateZone(c.Request.Context(), repository.CreateZoneInput{
-			ID:           input.ID,
-			WorldID:      input.WorldID,
-			Name:         input.Name,
-			Description:  input.Description,
-			MinLevel:     input.MinLevel,
-			ParentZoneID: input.ParentZoneID,
-			Color:        input.Color,
-			RoomIDs:      input.RoomIDs,
+			ID:                input.ID,
+			WorldID:           input.WorldID,
+			Name:              input.Name,
+			Description:       input.Description,
+			MinLevel:          input.MinLevel,
+			ParentZoneID:      input.ParentZoneID,
+			Color:             input.Color,
+			RoomIDs:           input.RoomIDs,
+			Resets:            input.Resets,
+			ResetIntervalSecs: input.ResetIntervalSecs,
+			Weather:           input.Weather,
+			Instance:          input.Instance,
 		})
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -94,24 +108,32 @@ func updateZone(svc *service.Container) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		id := c.Param("id")
 		var input struct {
-			Name         *string `json:"name"`
-			Description  *string `json:"description"`
-			MinLevel     *int    `json:"min_level"`
-			ParentZoneID *string `json:"parent_zone_id"`
-			Color        *string `json:"color"`
-			RoomIDs      *[]int  `json:"room_ids"`
+			Name              *string                `json:"name"`
+			Description       *string                `json:"description"`
+			MinLevel          *int                   `json:"min_level"`
+			ParentZoneID      *string                `json:"parent_zone_id"`
+			Color             *string                `json:"color"`
+			RoomIDs           *[]int                 `json:"room_ids"`
+			Resets            *[]schema.ZoneReset    `json:"resets"`
+			ResetIntervalSecs *int                   `json:"reset_interval_secs"`
+			Weather           *schema.ZoneWeather    `json:"weather"`
+			Instance          *schema.ZoneInstancing `json:"instance"`
 		}
 		if err := c.ShouldBindJSON(&input); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
 		zone, err := svc.Zone.UpdateZone(c.Request.Context(), id, repository.ZoneUpdates{
-			Name:         input.Name,
-			Description:  input.Description,
-			MinLevel:     input.MinLevel,
-			ParentZoneID: input.ParentZoneID,
-			Color:        input.Color,
-			RoomIDs:      input.RoomIDs,
+			Name:              input.Name,
+			Description:       input.Description,
+			MinLevel:          input.MinLevel,
+			ParentZoneID:      input.ParentZoneID,
+			Color:             input.Color,
+			RoomIDs:           input.RoomIDs,
+			Resets:            input.Resets,
+			ResetIntervalSecs: input.ResetIntervalSecs,
+			Weather:           input.Weather,
+			Instance:          input.Instance,
 		})
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -132,6 +154,85 @@ func deleteZone(svc *service.Container) gin.HandlerFunc {
 	}
 }
 
+// resetZone runs the zone's reset script immediately and returns the report.
+func resetZone(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.Param("id")
+		if _, err := svc.Zone.GetZone(c.Request.Context(), id); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "zone not found"})
+			return
+		}
+		report, err := svc.Zone.ResetZone(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, report)
+	}
+}
+
+// getZoneResetReport returns what the zone's most recent reset did.
+func getZoneResetReport(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		zone, err := svc.Zone.GetZone(c.Request.Context(), c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "zone not found"})
+			return
+		}
+		if zone.LastResetAt == nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "zone has not been reset yet"})
+			return
+		}
+		c.JSON(http.StatusOK, zone.LastResetReport)
+	}
+}
+
+// listZoneInstances returns the open private copies of an instanced zone.
+func listZoneInstances(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.Param("id")
+		if _, err := svc.Zone.GetZone(c.Request.Context(), id); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "zone not found"})
+			return
+		}
+		instances, err := svc.Instance.List(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"zone_id": id, "instances": instances})
+	}
+}
+
+// closeZoneInstance tears a copy down, sending anyone inside back out.
+func closeZoneInstance(svc *service.Container) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		instanceID, err := strconv.Atoi(c.Param("instance_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid instance id"})
+			return
+		}
+		instances, err := svc.Instance.List(c.Request.Context(), c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		found := false
+		for _, inst := range instances {
+			found = found || inst.ID == instanceID
+		}
+		if !found {
+			c.JSON(http.StatusNotFound, gin.H{"error": "instance not found"})
+			return
+		}
+		if err := svc.Instance.Close(c.Request.Context(), instanceID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "instance closed"})
+	}
+}
+
 // zoneRoomView is the JSON shape for a single room in the zone list.
 type zoneRoomView struct {
 	ID      int    `json:"id"`
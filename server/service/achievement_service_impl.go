@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"herbst-server/events"
+	"herbst-server/repository"
+)
+
+var ErrTitleNotEarned = errors.New("you have not earned that title")
+
+// achievementService implements AchievementService using repository interfaces.
+type achievementService struct {
+	charRepo        repository.CharacterRepo
+	achievementRepo repository.AchievementRepo
+	progressRepo    repository.CharacterAchievementRepo
+}
+
+// NewAchievementService creates a new AchievementService.
+func NewAchievementService(
+	charRepo repository.CharacterRepo,
+	achievementRepo repository.AchievementRepo,
+	progressRepo repository.CharacterAchievementRepo,
+) AchievementService {
+	return &achievementService{
+		charRepo:        charRepo,
+		achievementRepo: achievementRepo,
+		progressRepo:    progressRepo,
+	}
+}
+
+// List returns every achievement with the character's progress towards it.
+// Completed achievements come first, most recent first; the rest follow by
+// how close the character is to finishing them.
+func (s *achievementService) List(ctx context.Context, charID int) (*AchievementsView, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	all, err := s.achievementRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.progressRepo.ListByCharacter(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	byAchievement := make(map[int]int, len(rows))
+	for i, r := range rows {
+		byAchievement[r.AchievementID] = i
+	}
+
+	view := &AchievementsView{Title: char.Title, Achievements: make([]AchievementProgress, 0, len(all))}
+	for _, a := range all {
+		p := AchievementProgress{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			Icon:        a.Icon,
+			Title:       a.Title,
+			XPReward:    a.XpReward,
+		}
+		if crit, err := events.ParseAchievementCriteria(a.Criteria); err == nil {
+			p.Target = crit.Threshold
+		}
+		if i, ok := byAchievement[a.ID]; ok {
+			r := rows[i]
+			p.Progress = r.Progress
+			if r.CompletedAt != nil {
+				p.Completed = true
+				p.Progress = p.Target
+				at := r.CompletedAt.Format(time.RFC3339)
+				p.CompletedAt = &at
+			}
+		}
+		view.Achievements = append(view.Achievements, p)
+	}
+
+	sort.SliceStable(view.Achievements, func(i, j int) bool {
+		a, b := view.Achievements[i], view.Achievements[j]
+		if a.Completed != b.Completed {
+			return a.Completed
+		}
+		if a.Completed {
+			return *a.CompletedAt > *b.CompletedAt
+		}
+		return a.fraction() > b.fraction()
+	})
+	return view, nil
+}
+
+// SetTitle changes the title shown next to the character's name. Only
+// titles granted by completed achievements may be chosen; "" or "none"
+// clears it.
+func (s *achievementService) SetTitle(ctx context.Context, charID int, title string) (string, error) {
+	if _, err := s.charRepo.Get(ctx, charID); err != nil {
+		return "", ErrCharacterNotFound
+	}
+	title = strings.TrimSpace(title)
+	if strings.EqualFold(title, "none") {
+		title = ""
+	}
+	if title != "" {
+		rows, err := s.progressRepo.ListCompleted(ctx, charID)
+		if err != nil {
+			return "", err
+		}
+		earned := ""
+		for _, r := range rows {
+			if a := r.Edges.Achievement; a != nil && a.Title != "" && strings.EqualFold(a.Title, title) {
+				earned = a.Title
+				break
+			}
+		}
+		if earned == "" {
+			return "", ErrTitleNotEarned
+		}
+		title = earned
+	}
+	if _, err := s.charRepo.Update(ctx, charID, repository.CharacterUpdates{Title: &title}); err != nil {
+		return "", err
+	}
+	return title, nil
+}
@@ -0,0 +1,566 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/auction"
+	"herbst-server/db/character"
+	"herbst-server/db/equipment"
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+var (
+	ErrAuctionNotFound   = errors.New("there is no such auction")
+	ErrNotAtAuctionHouse = errors.New("you need to be at an auction house")
+	ErrAuctionItem       = errors.New("you can only list unequipped items you carry")
+	ErrAuctionPrice      = errors.New("the starting bid must be at least 1 and the buyout no lower")
+	ErrAuctionDuration   = fmt.Errorf("auctions run for 1 to %d hours", MaxAuctionHours)
+	ErrTooManyAuctions   = fmt.Errorf("you can have at most %d auctions open", MaxAuctionListings)
+	ErrAuctionOwnBid     = errors.New("you can't bid on your own auction")
+	ErrBidTooLow         = errors.New("your bid is too low")
+	ErrAuctionClosed     = errors.New("that auction has ended")
+	ErrAuctionChanged    = errors.New("someone got there first; check the auction and try again")
+	ErrNoBuyout          = errors.New("that auction has no buyout")
+	ErrAuctionHasBids    = errors.New("you can't cancel an auction that has bids")
+	ErrNoAuctionProceeds = errors.New("you have no proceeds to collect")
+	ErrNotAuctionSeller  = errors.New("that isn't your auction")
+)
+
+// AuctionTag marks rooms with access to the auction house. The auction house
+// is shared by the whole world; listing, bidding and collecting need one of
+// these rooms, while checking on your own auctions works anywhere.
+const AuctionTag = "auction"
+
+const (
+	// AuctionTaxPercent is the share of the sale price the auction house
+	// keeps.
+	AuctionTaxPercent = 5
+	// MaxAuctionListings is how many open auctions one seller can have.
+	MaxAuctionListings = 10
+	// MaxAuctionHours is the longest an auction can run.
+	MaxAuctionHours = 72
+	// DefaultAuctionHours is how long an auction runs when the seller doesn't
+	// say.
+	DefaultAuctionHours = 24
+
+	auctioneerName = "The Auctioneer"
+)
+
+// auctionService implements AuctionService using repository interfaces.
+type auctionService struct {
+	auctionRepo repository.AuctionRepo
+	charRepo    repository.CharacterRepo
+	roomRepo    repository.RoomRepo
+	equipRepo   repository.EquipmentRepo
+	tellRepo    repository.OfflineTellRepo
+	tx          repository.TransactionRunner
+	logger      *slog.Logger
+}
+
+// NewAuctionService creates a new AuctionService.
+func NewAuctionService(
+	auctionRepo repository.AuctionRepo,
+	charRepo repository.CharacterRepo,
+	roomRepo repository.RoomRepo,
+	equipRepo repository.EquipmentRepo,
+	tellRepo repository.OfflineTellRepo,
+	tx repository.TransactionRunner,
+	logger *slog.Logger,
+) AuctionService {
+	return &auctionService{
+		auctionRepo: auctionRepo,
+		charRepo:    charRepo,
+		roomRepo:    roomRepo,
+		equipRepo:   equipRepo,
+		tellRepo:    tellRepo,
+		tx:          tx,
+		logger:      logger,
+	}
+}
+
+// Browse lists the open auctions, optionally only items whose name contains
+// search.
+func (s *auctionService) Browse(ctx context.Context, charID int, search string) ([]AuctionView, error) {
+	if _, err := s.atAuctionHouse(ctx, charID); err != nil {
+		return nil, err
+	}
+	auctions, err := s.auctionRepo.ListOpen(ctx, strings.TrimSpace(search))
+	if err != nil {
+		return nil, err
+	}
+	views := make([]AuctionView, 0, len(auctions))
+	for _, a := range auctions {
+		views = append(views, auctionView(a, charID))
+	}
+	return views, nil
+}
+
+// Mine shows the character's own listings, the auctions they are winning
+// and the proceeds waiting for them.
+func (s *auctionService) Mine(ctx context.Context, charID int) (*MyAuctionsView, error) {
+	selling, err := s.auctionRepo.ListBySeller(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	bidding, err := s.auctionRepo.ListByBidder(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	view := &MyAuctionsView{
+		Selling: make([]AuctionView, 0, len(selling)),
+		Bidding: make([]AuctionView, 0, len(bidding)),
+	}
+	for _, a := range selling {
+		view.Selling = append(view.Selling, auctionView(a, charID))
+		if a.Status == auction.StatusSold {
+			view.Proceeds += a.Proceeds
+		}
+	}
+	for _, a := range bidding {
+		view.Bidding = append(view.Bidding, auctionView(a, charID))
+	}
+	return view, nil
+}
+
+// List puts an item up for auction. The item leaves the seller's inventory
+// until it sells or the auction ends without bids.
+func (s *auctionService) List(ctx context.Context, charID int, in ListAuctionInput) (*AuctionView, error) {
+	seller, err := s.atAuctionHouse(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	hours, err := validateListing(in)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.equipRepo.Get(ctx, in.ItemID)
+	if err != nil || item.OwnerId == nil || *item.OwnerId != charID || item.IsEquipped || item.IsImmovable {
+		return nil, ErrAuctionItem
+	}
+	open, err := s.auctionRepo.CountOpenBySeller(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	if open >= MaxAuctionListings {
+		return nil, ErrTooManyAuctions
+	}
+
+	var listed *db.Auction
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.Equipment.Update().
+			Where(equipment.ID(item.ID), equipment.OwnerId(charID),
+				equipment.IsEquipped(false), equipment.IsImmovable(false)).
+			ClearOwnerId().
+			ClearRoom().
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrAuctionItem
+		}
+		listed, err = tx.Auction.Create().
+			SetSellerID(seller.ID).
+			SetSellerName(seller.Name).
+			SetItemID(item.ID).
+			SetItemName(item.Name).
+			SetStartBid(in.StartBid).
+			SetBuyout(in.Buyout).
+			SetExpiresAt(time.Now().Add(time.Duration(hours) * time.Hour)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("auction listed", "auction_id", listed.ID, "seller_id", charID, "item_id", item.ID, "start_bid", in.StartBid, "buyout", in.Buyout, slog.String("service", "auction"))
+	view := auctionView(listed, charID)
+	return &view, nil
+}
+
+// Bid places a bid. The bid is taken from the bidder's gold at once and
+// given back if they are outbid. A bid at or above the buyout buys the item
+// outright at the buyout price.
+func (s *auctionService) Bid(ctx context.Context, charID, auctionID, amount int) (*AuctionView, error) {
+	bidder, err := s.atAuctionHouse(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.openAuction(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+	if a.SellerID == charID {
+		return nil, ErrAuctionOwnBid
+	}
+	if a.Buyout > 0 && amount >= a.Buyout {
+		return s.buy(ctx, bidder, a)
+	}
+	if amount < minNextBid(a) {
+		return nil, fmt.Errorf("%w: the least you can bid is %d", ErrBidTooLow, minNextBid(a))
+	}
+
+	var updated *db.Auction
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		if err := takeGold(ctx, tx, charID, amount); err != nil {
+			return err
+		}
+		n, err := tx.Auction.Update().
+			Where(auction.ID(a.ID), auction.StatusEQ(auction.StatusOpen), auction.ExpiresAtGT(time.Now()),
+				auction.Bid(a.Bid), auction.BidderID(a.BidderID)).
+			SetBid(amount).
+			SetBidderID(charID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrAuctionChanged
+		}
+		if a.BidderID != 0 {
+			if err := tx.Character.UpdateOneID(a.BidderID).AddGoldCredits(a.Bid).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		updated, err = tx.Auction.Get(ctx, a.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if a.BidderID != 0 && a.BidderID != charID {
+		s.notify(ctx, a.BidderID, fmt.Sprintf("You have been outbid on %s (auction #%d): %s bid %d gold. Your %d gold has been returned.",
+			a.ItemName, a.ID, bidder.Name, amount, a.Bid))
+	}
+	view := auctionView(updated, charID)
+	return &view, nil
+}
+
+// Buyout buys the item at its buyout price, ending the auction.
+func (s *auctionService) Buyout(ctx context.Context, charID, auctionID int) (*AuctionView, error) {
+	buyer, err := s.atAuctionHouse(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.openAuction(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+	if a.SellerID == charID {
+		return nil, ErrAuctionOwnBid
+	}
+	if a.Buyout == 0 {
+		return nil, ErrNoBuyout
+	}
+	return s.buy(ctx, buyer, a)
+}
+
+// Cancel takes an auction without bids off the auction house and gives the
+// item back to the seller.
+func (s *auctionService) Cancel(ctx context.Context, charID, auctionID int) error {
+	if _, err := s.atAuctionHouse(ctx, charID); err != nil {
+		return err
+	}
+	a, err := s.openAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+	if a.SellerID != charID {
+		return ErrNotAuctionSeller
+	}
+	if a.BidderID != 0 {
+		return ErrAuctionHasBids
+	}
+	return s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.Auction.Delete().
+			Where(auction.ID(a.ID), auction.StatusEQ(auction.StatusOpen), auction.BidderID(0)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrAuctionChanged
+		}
+		return returnEscrowedItem(ctx, tx, a.ItemID, a.SellerID)
+	})
+}
+
+// Collect pays the seller the proceeds of everything they have sold.
+func (s *auctionService) Collect(ctx context.Context, charID int) (int, error) {
+	if _, err := s.atAuctionHouse(ctx, charID); err != nil {
+		return 0, err
+	}
+	selling, err := s.auctionRepo.ListBySeller(ctx, charID)
+	if err != nil {
+		return 0, err
+	}
+	var ids []int
+	total := 0
+	for _, a := range selling {
+		if a.Status == auction.StatusSold {
+			ids = append(ids, a.ID)
+			total += a.Proceeds
+		}
+	}
+	if len(ids) == 0 {
+		return 0, ErrNoAuctionProceeds
+	}
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.Auction.Delete().
+			Where(auction.IDIn(ids...), auction.SellerID(charID), auction.StatusEQ(auction.StatusSold)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if n != len(ids) {
+			return ErrAuctionChanged
+		}
+		return tx.Character.UpdateOneID(charID).AddGoldCredits(total).Exec(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// SettleDue ends the auctions that have run out of time. The high bidder
+// wins the item; an auction without bids gives it back to the seller.
+func (s *auctionService) SettleDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.auctionRepo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	settled := 0
+	for _, a := range due {
+		if err := s.settle(ctx, a); err != nil {
+			if !errors.Is(err, ErrAuctionChanged) {
+				dblog.Error("failed to settle auction", err, slog.String("service", "auction"), slog.Int("auction_id", a.ID))
+			}
+			continue
+		}
+		settled++
+	}
+	return settled, nil
+}
+
+// settle ends one auction that has run out of time.
+func (s *auctionService) settle(ctx context.Context, a *db.Auction) error {
+	if a.BidderID == 0 {
+		err := s.tx.WithTx(ctx, func(tx *db.Tx) error {
+			n, err := tx.Auction.Delete().
+				Where(auction.ID(a.ID), auction.StatusEQ(auction.StatusOpen), auction.BidderID(0)).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return ErrAuctionChanged
+			}
+			return returnEscrowedItem(ctx, tx, a.ItemID, a.SellerID)
+		})
+		if err != nil {
+			return err
+		}
+		s.notify(ctx, a.SellerID, fmt.Sprintf("Your auction of %s ended without bids. It has been returned to you.", a.ItemName))
+		return nil
+	}
+
+	err := s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.Auction.Update().
+			Where(auction.ID(a.ID), auction.StatusEQ(auction.StatusOpen), auction.Bid(a.Bid), auction.BidderID(a.BidderID)).
+			SetStatus(auction.StatusSold).
+			SetProceeds(a.Bid - auctionTax(a.Bid)).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrAuctionChanged
+		}
+		return returnEscrowedItem(ctx, tx, a.ItemID, a.BidderID)
+	})
+	if err != nil {
+		return err
+	}
+	s.notify(ctx, a.BidderID, fmt.Sprintf("You won %s for %d gold. It is in your inventory.", a.ItemName, a.Bid))
+	s.notify(ctx, a.SellerID, fmt.Sprintf("Your %s sold for %d gold. Collect %d gold at an auction house.", a.ItemName, a.Bid, a.Bid-auctionTax(a.Bid)))
+	return nil
+}
+
+// buy ends an auction at its buyout price.
+func (s *auctionService) buy(ctx context.Context, buyer *db.Character, a *db.Auction) (*AuctionView, error) {
+	price := a.Buyout
+	var sold *db.Auction
+	err := s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		if err := takeGold(ctx, tx, buyer.ID, price); err != nil {
+			return err
+		}
+		n, err := tx.Auction.Update().
+			Where(auction.ID(a.ID), auction.StatusEQ(auction.StatusOpen), auction.ExpiresAtGT(time.Now()),
+				auction.Bid(a.Bid), auction.BidderID(a.BidderID)).
+			SetStatus(auction.StatusSold).
+			SetBid(price).
+			SetBidderID(buyer.ID).
+			SetProceeds(price - auctionTax(price)).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrAuctionChanged
+		}
+		if a.BidderID != 0 {
+			if err := tx.Character.UpdateOneID(a.BidderID).AddGoldCredits(a.Bid).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if err := returnEscrowedItem(ctx, tx, a.ItemID, buyer.ID); err != nil {
+			return err
+		}
+		sold, err = tx.Auction.Get(ctx, a.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if a.BidderID != 0 && a.BidderID != buyer.ID {
+		s.notify(ctx, a.BidderID, fmt.Sprintf("%s was bought out on auction #%d. Your %d gold has been returned.", a.ItemName, a.ID, a.Bid))
+	}
+	s.notify(ctx, a.SellerID, fmt.Sprintf("Your %s sold for %d gold. Collect %d gold at an auction house.", a.ItemName, price, price-auctionTax(price)))
+	s.logger.Info("auction bought out", "auction_id", a.ID, "buyer_id", buyer.ID, "price", price, slog.String("service", "auction"))
+	view := auctionView(sold, buyer.ID)
+	return &view, nil
+}
+
+// notify tells a character about their auctions: on the live event stream
+// when they are online, otherwise as a tell waiting for them.
+func (s *auctionService) notify(ctx context.Context, charID int, text string) {
+	if stream.Default().Listening(charID) {
+		stream.Default().Send(charID, stream.Event{Type: stream.TypeAuction, Text: text})
+		return
+	}
+	if _, err := s.tellRepo.CreateNotice(ctx, charID, auctioneerName, text); err != nil {
+		dblog.Error("failed to queue auction notice", err, slog.String("service", "auction"), slog.Int("character_id", charID))
+	}
+}
+
+func (s *auctionService) atAuctionHouse(ctx context.Context, charID int) (*db.Character, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	room, err := s.roomRepo.Get(ctx, char.CurrentRoomId)
+	if err != nil || !roomHasTag(room, AuctionTag) {
+		return nil, ErrNotAtAuctionHouse
+	}
+	return char, nil
+}
+
+func (s *auctionService) openAuction(ctx context.Context, id int) (*db.Auction, error) {
+	a, err := s.auctionRepo.Get(ctx, id)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, ErrAuctionNotFound
+		}
+		return nil, err
+	}
+	if a.Status != auction.StatusOpen || !time.Now().Before(a.ExpiresAt) {
+		return nil, ErrAuctionClosed
+	}
+	return a, nil
+}
+
+// takeGold removes gold from a character inside a transaction, failing if
+// they don't have enough.
+func takeGold(ctx context.Context, tx *db.Tx, charID, amount int) error {
+	n, err := tx.Character.Update().
+		Where(character.ID(charID), character.GoldCreditsGTE(amount)).
+		AddGoldCredits(-amount).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrInsufficientGold
+	}
+	return nil
+}
+
+// returnEscrowedItem gives an item held by the auction house to a character.
+func returnEscrowedItem(ctx context.Context, tx *db.Tx, itemID, ownerID int) error {
+	n, err := tx.Equipment.Update().
+		Where(equipment.ID(itemID), equipment.OwnerIdIsNil()).
+		SetOwnerId(ownerID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("auction item %d is no longer in escrow", itemID)
+	}
+	return nil
+}
+
+// validateListing checks a new listing's prices and returns how many hours
+// it runs.
+func validateListing(in ListAuctionInput) (int, error) {
+	if in.StartBid < 1 || in.Buyout < 0 || (in.Buyout > 0 && in.Buyout < in.StartBid) {
+		return 0, ErrAuctionPrice
+	}
+	hours := in.Hours
+	if hours == 0 {
+		hours = DefaultAuctionHours
+	}
+	if hours < 1 || hours > MaxAuctionHours {
+		return 0, ErrAuctionDuration
+	}
+	return hours, nil
+}
+
+// minNextBid is the least the next bid on an auction can be: the starting
+// bid, then 5% over the high bid and at least 1 gold more.
+func minNextBid(a *db.Auction) int {
+	if a.BidderID == 0 {
+		return a.StartBid
+	}
+	step := a.Bid / 20
+	if step < 1 {
+		step = 1
+	}
+	return a.Bid + step
+}
+
+// auctionTax is what the auction house keeps from a sale.
+func auctionTax(price int) int {
+	return price * AuctionTaxPercent / 100
+}
+
+func auctionView(a *db.Auction, charID int) AuctionView {
+	v := AuctionView{
+		ID:        a.ID,
+		Item:      a.ItemName,
+		ItemID:    a.ItemID,
+		Seller:    a.SellerName,
+		StartBid:  a.StartBid,
+		Buyout:    a.Buyout,
+		Bid:       a.Bid,
+		Status:    string(a.Status),
+		Winning:   a.BidderID != 0 && a.BidderID == charID,
+		ExpiresAt: a.ExpiresAt,
+	}
+	if a.Status == auction.StatusOpen {
+		v.MinBid = minNextBid(a)
+	}
+	if a.SellerID == charID {
+		v.Proceeds = a.Proceeds
+	}
+	return v
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/auction"
+)
+
+// auctionFixture is Ann selling a sword to Bob and Cara at the auction
+// house.
+type auctionFixture struct {
+	ann, bob, cara *db.Character
+	sword          *db.Equipment
+	listed         *AuctionView
+}
+
+// newAuctionFixture lists Ann's sword at a starting bid of 100 with the
+// given buyout. Bob and Cara carry 500 gold each.
+func newAuctionFixture(t *testing.T, svc *Container, client *db.Client, buyout int) *auctionFixture {
+	t.Helper()
+	hall := testRoom(t, client, "Auction Hall", AuctionTag)
+	f := &auctionFixture{
+		ann:  testCharacter(t, client, "Ann", hall.ID, 0),
+		bob:  testCharacter(t, client, "Bob", hall.ID, 500),
+		cara: testCharacter(t, client, "Cara", hall.ID, 500),
+	}
+	f.sword = testItem(t, client, "sword", f.ann.ID)
+	listed, err := svc.Auction.List(context.Background(), f.ann.ID, ListAuctionInput{ItemID: f.sword.ID, StartBid: 100, Buyout: buyout})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	f.listed = listed
+	return f
+}
+
+// goldOf is how much gold ch carries now.
+func goldOf(t *testing.T, client *db.Client, ch *db.Character) int {
+	t.Helper()
+	return client.Character.GetX(context.Background(), ch.ID).GoldCredits
+}
+
+func TestAuctionBidRefundsOutbidBidder(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newAuctionFixture(t, svc, client, 0)
+
+	if _, err := svc.Auction.Bid(ctx, f.bob.ID, f.listed.ID, 100); err != nil {
+		t.Fatalf("Bob's bid: %v", err)
+	}
+	if got := goldOf(t, client, f.bob); got != 400 {
+		t.Errorf("Bob's gold after bidding = %d, want 400", got)
+	}
+	if _, err := svc.Auction.Bid(ctx, f.cara.ID, f.listed.ID, 104); !errors.Is(err, ErrBidTooLow) {
+		t.Errorf("bid under the step: got %v, want ErrBidTooLow", err)
+	}
+	view, err := svc.Auction.Bid(ctx, f.cara.ID, f.listed.ID, 150)
+	if err != nil {
+		t.Fatalf("Cara's bid: %v", err)
+	}
+	if !view.Winning || view.Bid != 150 {
+		t.Errorf("Cara's view: winning %v at %d, want winning at 150", view.Winning, view.Bid)
+	}
+	if got := goldOf(t, client, f.bob); got != 500 {
+		t.Errorf("Bob's gold after being outbid = %d, want 500", got)
+	}
+	if got := goldOf(t, client, f.cara); got != 350 {
+		t.Errorf("Cara's gold = %d, want 350", got)
+	}
+	if _, err := svc.Auction.Bid(ctx, f.ann.ID, f.listed.ID, 200); !errors.Is(err, ErrAuctionOwnBid) {
+		t.Errorf("seller bid: got %v, want ErrAuctionOwnBid", err)
+	}
+}
+
+func TestAuctionSettleDuePaysSellerAfterTax(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newAuctionFixture(t, svc, client, 0)
+	if _, err := svc.Auction.Bid(ctx, f.bob.ID, f.listed.ID, 200); err != nil {
+		t.Fatalf("bid: %v", err)
+	}
+
+	later := time.Now().Add(DefaultAuctionHours*time.Hour + time.Minute)
+	if n, err := svc.Auction.SettleDue(ctx, later); err != nil || n != 1 {
+		t.Fatalf("settle: %d, %v; want 1 settled", n, err)
+	}
+	if it := client.Equipment.GetX(ctx, f.sword.ID); it.OwnerId == nil || *it.OwnerId != f.bob.ID {
+		t.Errorf("sword not given to Bob")
+	}
+	a := client.Auction.GetX(ctx, f.listed.ID)
+	if a.Status != auction.StatusSold || a.Proceeds != 190 {
+		t.Errorf("auction %s with proceeds %d, want sold with 190", a.Status, a.Proceeds)
+	}
+	paid, err := svc.Auction.Collect(ctx, f.ann.ID)
+	if err != nil || paid != 190 {
+		t.Fatalf("collect: %d, %v; want 190", paid, err)
+	}
+	if got := goldOf(t, client, f.ann); got != 190 {
+		t.Errorf("Ann's gold = %d, want 190", got)
+	}
+	if _, err := svc.Auction.Collect(ctx, f.ann.ID); !errors.Is(err, ErrNoAuctionProceeds) {
+		t.Errorf("second collect: got %v, want ErrNoAuctionProceeds", err)
+	}
+}
+
+func TestAuctionSettleDueWithoutBidsReturnsItem(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newAuctionFixture(t, svc, client, 0)
+
+	later := time.Now().Add(DefaultAuctionHours*time.Hour + time.Minute)
+	if n, err := svc.Auction.SettleDue(ctx, later); err != nil || n != 1 {
+		t.Fatalf("settle: %d, %v; want 1 settled", n, err)
+	}
+	if it := client.Equipment.GetX(ctx, f.sword.ID); it.OwnerId == nil || *it.OwnerId != f.ann.ID {
+		t.Errorf("sword not returned to Ann")
+	}
+	if client.Auction.Query().CountX(ctx) != 0 {
+		t.Error("auction without bids was not removed")
+	}
+}
+
+func TestAuctionBuyoutRefundsHighBidder(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newAuctionFixture(t, svc, client, 300)
+	if _, err := svc.Auction.Bid(ctx, f.bob.ID, f.listed.ID, 100); err != nil {
+		t.Fatalf("bid: %v", err)
+	}
+
+	view, err := svc.Auction.Buyout(ctx, f.cara.ID, f.listed.ID)
+	if err != nil {
+		t.Fatalf("buyout: %v", err)
+	}
+	if view.Status != string(auction.StatusSold) || view.Bid != 300 {
+		t.Errorf("auction %s at %d, want sold at 300", view.Status, view.Bid)
+	}
+	if got := goldOf(t, client, f.bob); got != 500 {
+		t.Errorf("Bob's gold = %d, want 500", got)
+	}
+	if got := goldOf(t, client, f.cara); got != 200 {
+		t.Errorf("Cara's gold = %d, want 200", got)
+	}
+	if it := client.Equipment.GetX(ctx, f.sword.ID); it.OwnerId == nil || *it.OwnerId != f.cara.ID {
+		t.Errorf("sword not given to Cara")
+	}
+	if a := client.Auction.GetX(ctx, f.listed.ID); a.Proceeds != 285 {
+		t.Errorf("proceeds = %d, want 285", a.Proceeds)
+	}
+	if _, err := svc.Auction.Bid(ctx, f.bob.ID, f.listed.ID, 400); !errors.Is(err, ErrAuctionClosed) {
+		t.Errorf("bid after buyout: got %v, want ErrAuctionClosed", err)
+	}
+}
+
+func TestAuctionBuyoutWithoutGoldChangesNothing(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newAuctionFixture(t, svc, client, 600)
+
+	if _, err := svc.Auction.Buyout(ctx, f.bob.ID, f.listed.ID); !errors.Is(err, ErrInsufficientGold) {
+		t.Fatalf("got %v, want ErrInsufficientGold", err)
+	}
+	if got := goldOf(t, client, f.bob); got != 500 {
+		t.Errorf("Bob's gold = %d, want 500", got)
+	}
+	if a := client.Auction.GetX(ctx, f.listed.ID); a.Status != auction.StatusOpen || a.BidderID != 0 {
+		t.Errorf("auction changed: %s, bidder %d", a.Status, a.BidderID)
+	}
+	if it := client.Equipment.GetX(ctx, f.sword.ID); it.OwnerId != nil {
+		t.Errorf("sword left escrow")
+	}
+}
@@ -0,0 +1,73 @@
+package service
+
+// BankConfig is the bank section of a world config:
+//
+//	"bank": {
+//	  "stash_slots": 10, "slot_step": 5, "slot_cost": 100,
+//	  "max_slots": 50, "account_stash": true
+//	}
+//
+// A stash starts with StashSlots slots. Each expansion adds SlotStep slots
+// up to MaxSlots; the first costs SlotCost gold, the second twice that, and
+// so on. AccountStash turns on a stash shared by all of a user's characters
+// in the world.
+type BankConfig struct {
+	StashSlots   int
+	SlotStep     int
+	SlotCost     int
+	MaxSlots     int
+	AccountStash bool
+}
+
+// DefaultBankConfig is used for worlds without a bank section.
+var DefaultBankConfig = BankConfig{StashSlots: 10, SlotStep: 5, SlotCost: 100, MaxSlots: 50}
+
+// ParseBankConfig reads the bank section of a world config map, falling
+// back to DefaultBankConfig for anything missing or invalid.
+func ParseBankConfig(worldCfg map[string]interface{}) BankConfig {
+	cfg := DefaultBankConfig
+	section, ok := worldCfg["bank"].(map[string]interface{})
+	if !ok {
+		return cfg
+	}
+	if v, ok := section["stash_slots"].(float64); ok && v >= 1 {
+		cfg.StashSlots = int(v)
+	}
+	if v, ok := section["slot_step"].(float64); ok && v >= 1 {
+		cfg.SlotStep = int(v)
+	}
+	if v, ok := section["slot_cost"].(float64); ok && v >= 0 {
+		cfg.SlotCost = int(v)
+	}
+	if v, ok := section["max_slots"].(float64); ok && v >= 1 {
+		cfg.MaxSlots = int(v)
+	}
+	if cfg.MaxSlots < cfg.StashSlots {
+		cfg.MaxSlots = cfg.StashSlots
+	}
+	if v, ok := section["account_stash"].(bool); ok {
+		cfg.AccountStash = v
+	}
+	return cfg
+}
+
+// ExpandCost is what the next expansion of a stash with the given number of
+// slots costs, or -1 when it is already as big as it gets.
+func (c BankConfig) ExpandCost(slots int) int {
+	if slots >= c.MaxSlots {
+		return -1
+	}
+	done := 0
+	if slots > c.StashSlots {
+		done = (slots - c.StashSlots + c.SlotStep - 1) / c.SlotStep
+	}
+	return c.SlotCost * (done + 1)
+}
+
+// expanded is the number of slots after one more expansion.
+func (c BankConfig) expanded(slots int) int {
+	if slots+c.SlotStep > c.MaxSlots {
+		return c.MaxSlots
+	}
+	return slots + c.SlotStep
+}
@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"herbst-server/db"
+	"herbst-server/db/equipment"
+	"herbst-server/db/stash"
+	"herbst-server/repository"
+)
+
+var (
+	ErrNotAtBank      = errors.New("you need to be at a bank")
+	ErrNoAccountStash = errors.New("there is no shared account stash in this world")
+	ErrBankAmount     = errors.New("gold must not be negative")
+	ErrBankNothing    = errors.New("say how much gold or which items")
+	ErrBankItem       = errors.New("you can only store unequipped items you carry")
+	ErrBankBoundItem  = errors.New("that item can't be shared with your other characters")
+	ErrNotInStash     = errors.New("that isn't in the stash")
+	ErrBankGold       = errors.New("there isn't that much gold in the stash")
+	ErrStashFull      = errors.New("the stash is full")
+	ErrStashMaxed     = errors.New("the stash can't get any bigger")
+	ErrBankBusy       = errors.New("the stash changed; try again")
+)
+
+// BankTag marks bank rooms, where stashes can be used.
+const BankTag = "bank"
+
+// bankService implements BankService using repository interfaces.
+type bankService struct {
+	stashRepo repository.StashRepo
+	charRepo  repository.CharacterRepo
+	roomRepo  repository.RoomRepo
+	equipRepo repository.EquipmentRepo
+	worldRepo repository.WorldRepo
+	tx        repository.TransactionRunner
+	logger    *slog.Logger
+}
+
+// NewBankService creates a new BankService.
+func NewBankService(
+	stashRepo repository.StashRepo,
+	charRepo repository.CharacterRepo,
+	roomRepo repository.RoomRepo,
+	equipRepo repository.EquipmentRepo,
+	worldRepo repository.WorldRepo,
+	tx repository.TransactionRunner,
+	logger *slog.Logger,
+) BankService {
+	return &bankService{
+		stashRepo: stashRepo,
+		charRepo:  charRepo,
+		roomRepo:  roomRepo,
+		equipRepo: equipRepo,
+		worldRepo: worldRepo,
+		tx:        tx,
+		logger:    logger,
+	}
+}
+
+// View shows the character's stash, and the account stash when the world
+// has one.
+func (s *bankService) View(ctx context.Context, charID int) (*BankView, error) {
+	char, cfg, err := s.atBank(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	return s.view(ctx, char, cfg)
+}
+
+// Deposit moves gold and items from the character into a stash.
+func (s *bankService) Deposit(ctx context.Context, charID int, in BankTransferInput) (*BankView, error) {
+	char, cfg, err := s.atBank(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := validateTransfer(in)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.stash(ctx, char, cfg, in.Account)
+	if err != nil {
+		return nil, err
+	}
+	if len(st.ItemIds)+len(ids) > st.Slots {
+		return nil, ErrStashFull
+	}
+
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		if in.Gold > 0 {
+			if err := takeGold(ctx, tx, charID, in.Gold); err != nil {
+				return err
+			}
+		}
+		if len(ids) > 0 {
+			if in.Account {
+				bound, err := tx.Equipment.Query().
+					Where(equipment.IDIn(ids...), equipment.IsImmovable(true)).
+					Exist(ctx)
+				if err != nil {
+					return err
+				}
+				if bound {
+					return ErrBankBoundItem
+				}
+			}
+			n, err := tx.Equipment.Update().
+				Where(equipment.IDIn(ids...), equipment.OwnerId(charID), equipment.IsEquipped(false)).
+				ClearOwnerId().
+				ClearRoom().
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n != len(ids) {
+				return ErrBankItem
+			}
+		}
+		return updateStash(ctx, tx, st, append(append([]int{}, st.ItemIds...), ids...), in.Gold, st.Slots)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.view(ctx, char, cfg)
+}
+
+// Withdraw moves gold and items from a stash to the character.
+func (s *bankService) Withdraw(ctx context.Context, charID int, in BankTransferInput) (*BankView, error) {
+	char, cfg, err := s.atBank(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := validateTransfer(in)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.stash(ctx, char, cfg, in.Account)
+	if err != nil {
+		return nil, err
+	}
+	if in.Gold > st.Gold {
+		return nil, ErrBankGold
+	}
+	remaining, ok := withoutItems(st.ItemIds, ids)
+	if !ok {
+		return nil, ErrNotInStash
+	}
+
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		if err := updateStash(ctx, tx, st, remaining, -in.Gold, st.Slots); err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			n, err := tx.Equipment.Update().
+				Where(equipment.IDIn(ids...), equipment.OwnerIdIsNil()).
+				SetOwnerId(charID).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n != len(ids) {
+				return fmt.Errorf("stash %d items are no longer in escrow", st.ID)
+			}
+		}
+		if in.Gold > 0 {
+			return tx.Character.UpdateOneID(charID).AddGoldCredits(in.Gold).Exec(ctx)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.view(ctx, char, cfg)
+}
+
+// Expand buys more slots for a stash with the character's gold.
+func (s *bankService) Expand(ctx context.Context, charID int, account bool) (*BankView, error) {
+	char, cfg, err := s.atBank(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.stash(ctx, char, cfg, account)
+	if err != nil {
+		return nil, err
+	}
+	cost := cfg.ExpandCost(st.Slots)
+	if cost < 0 {
+		return nil, ErrStashMaxed
+	}
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		if cost > 0 {
+			if err := takeGold(ctx, tx, charID, cost); err != nil {
+				return err
+			}
+		}
+		return updateStash(ctx, tx, st, st.ItemIds, 0, cfg.expanded(st.Slots))
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("stash expanded", "character_id", charID, "stash_id", st.ID, "slots", cfg.expanded(st.Slots), "cost", cost, slog.String("service", "bank"))
+	return s.view(ctx, char, cfg)
+}
+
+// atBank loads the character and their world's bank config, checking they
+// are in a bank room.
+func (s *bankService) atBank(ctx context.Context, charID int) (*db.Character, BankConfig, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, BankConfig{}, ErrCharacterNotFound
+	}
+	room, err := s.roomRepo.Get(ctx, char.CurrentRoomId)
+	if err != nil || !roomHasTag(room, BankTag) {
+		return nil, BankConfig{}, ErrNotAtBank
+	}
+	cfg := DefaultBankConfig
+	if char.WorldID != 0 {
+		if w, err := s.worldRepo.Get(ctx, char.WorldID); err == nil {
+			cfg = ParseBankConfig(w.Config)
+		}
+	}
+	return char, cfg, nil
+}
+
+// stash returns the character's stash or their account stash, opening it
+// on first use.
+func (s *bankService) stash(ctx context.Context, char *db.Character, cfg BankConfig, account bool) (*db.Stash, error) {
+	in := repository.CreateStashInput{CharacterID: char.ID, Slots: cfg.StashSlots}
+	get := func() (*db.Stash, error) { return s.stashRepo.GetForCharacter(ctx, char.ID) }
+	if account {
+		if !cfg.AccountStash || char.WorldID == 0 {
+			return nil, ErrNoAccountStash
+		}
+		userID, err := s.charRepo.GetUserID(ctx, char.ID)
+		if err != nil {
+			return nil, ErrNoAccountStash
+		}
+		in = repository.CreateStashInput{UserID: userID, WorldID: char.WorldID, Slots: cfg.StashSlots}
+		get = func() (*db.Stash, error) { return s.stashRepo.GetForAccount(ctx, userID, char.WorldID) }
+	}
+
+	st, err := get()
+	if err == nil || !db.IsNotFound(err) {
+		return st, err
+	}
+	st, err = s.stashRepo.Create(ctx, in)
+	if err != nil && db.IsConstraintError(err) {
+		// Opened by another request at the same time.
+		return get()
+	}
+	return st, err
+}
+
+func (s *bankService) view(ctx context.Context, char *db.Character, cfg BankConfig) (*BankView, error) {
+	fresh, err := s.charRepo.Get(ctx, char.ID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	own, err := s.stash(ctx, fresh, cfg, false)
+	if err != nil {
+		return nil, err
+	}
+	view := &BankView{CarriedGold: fresh.GoldCredits, Stash: s.stashView(ctx, own, cfg)}
+	if cfg.AccountStash {
+		shared, err := s.stash(ctx, fresh, cfg, true)
+		if err != nil && !errors.Is(err, ErrNoAccountStash) {
+			return nil, err
+		}
+		if shared != nil {
+			sv := s.stashView(ctx, shared, cfg)
+			view.Account = &sv
+		}
+	}
+	return view, nil
+}
+
+func (s *bankService) stashView(ctx context.Context, st *db.Stash, cfg BankConfig) StashView {
+	items := make([]StashItem, 0, len(st.ItemIds))
+	for _, id := range st.ItemIds {
+		name := "something"
+		if item, err := s.equipRepo.Get(ctx, id); err == nil {
+			name = item.Name
+		}
+		items = append(items, StashItem{ID: id, Name: name})
+	}
+	return StashView{
+		Gold:       st.Gold,
+		Slots:      st.Slots,
+		Items:      items,
+		ExpandCost: cfg.ExpandCost(st.Slots),
+	}
+}
+
+// updateStash writes a stash's items, gold and slots inside a transaction,
+// failing with ErrBankBusy if the stash changed since it was read.
+func updateStash(ctx context.Context, tx *db.Tx, st *db.Stash, items []int, gold, slots int) error {
+	n, err := tx.Stash.Update().
+		Where(stash.ID(st.ID), stash.Version(st.Version)).
+		SetItemIds(items).
+		AddGold(gold).
+		SetSlots(slots).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrBankBusy
+	}
+	return nil
+}
+
+// validateTransfer checks a deposit or withdrawal and returns its item IDs
+// without duplicates.
+func validateTransfer(in BankTransferInput) ([]int, error) {
+	if in.Gold < 0 {
+		return nil, ErrBankAmount
+	}
+	seen := make(map[int]bool, len(in.ItemIDs))
+	ids := make([]int, 0, len(in.ItemIDs))
+	for _, id := range in.ItemIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if in.Gold == 0 && len(ids) == 0 {
+		return nil, ErrBankNothing
+	}
+	return ids, nil
+}
+
+// withoutItems returns stored with ids taken out, reporting false if any of
+// ids isn't stored.
+func withoutItems(stored, ids []int) ([]int, bool) {
+	take := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		take[id] = true
+	}
+	remaining := make([]int, 0, len(stored))
+	for _, id := range stored {
+		if take[id] {
+			delete(take, id)
+			continue
+		}
+		remaining = append(remaining, id)
+	}
+	return remaining, len(take) == 0
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"herbst-server/db"
+	"herbst-server/db/stash"
+	"herbst-server/repository"
+)
+
+// bankCustomer puts a character carrying 150 gold and a sword in a bank room.
+func bankCustomer(t *testing.T, client *db.Client) (*db.Character, *db.Equipment) {
+	t.Helper()
+	bank := testRoom(t, client, "Bank", BankTag)
+	ch := testCharacter(t, client, "Ann", bank.ID, 150)
+	return ch, testItem(t, client, "sword", ch.ID)
+}
+
+func TestBankDepositAndWithdraw(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	ann, sword := bankCustomer(t, client)
+
+	view, err := svc.Bank.Deposit(ctx, ann.ID, BankTransferInput{Gold: 50, ItemIDs: []int{sword.ID}})
+	if err != nil {
+		t.Fatalf("deposit: %v", err)
+	}
+	if view.CarriedGold != 100 || view.Stash.Gold != 50 || len(view.Stash.Items) != 1 {
+		t.Errorf("after deposit: carrying %d, stash %d gold and %d items; want 100, 50 and 1",
+			view.CarriedGold, view.Stash.Gold, len(view.Stash.Items))
+	}
+	if it := client.Equipment.GetX(ctx, sword.ID); it.OwnerId != nil {
+		t.Errorf("deposited sword still carried")
+	}
+
+	if _, err := svc.Bank.Withdraw(ctx, ann.ID, BankTransferInput{Gold: 60}); !errors.Is(err, ErrBankGold) {
+		t.Errorf("overdraw: got %v, want ErrBankGold", err)
+	}
+	other := testItem(t, client, "shield", ann.ID)
+	if _, err := svc.Bank.Withdraw(ctx, ann.ID, BankTransferInput{ItemIDs: []int{other.ID}}); !errors.Is(err, ErrNotInStash) {
+		t.Errorf("withdraw carried item: got %v, want ErrNotInStash", err)
+	}
+
+	view, err = svc.Bank.Withdraw(ctx, ann.ID, BankTransferInput{Gold: 20, ItemIDs: []int{sword.ID}})
+	if err != nil {
+		t.Fatalf("withdraw: %v", err)
+	}
+	if view.CarriedGold != 120 || view.Stash.Gold != 30 || len(view.Stash.Items) != 0 {
+		t.Errorf("after withdraw: carrying %d, stash %d gold and %d items; want 120, 30 and 0",
+			view.CarriedGold, view.Stash.Gold, len(view.Stash.Items))
+	}
+	if it := client.Equipment.GetX(ctx, sword.ID); it.OwnerId == nil || *it.OwnerId != ann.ID {
+		t.Errorf("sword not given back")
+	}
+}
+
+func TestBankDepositNeedsFreeSlots(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	ann, sword := bankCustomer(t, client)
+	ids := []int{sword.ID}
+	for len(ids) <= DefaultBankConfig.StashSlots {
+		ids = append(ids, testItem(t, client, "rock", ann.ID).ID)
+	}
+
+	if _, err := svc.Bank.Deposit(ctx, ann.ID, BankTransferInput{Gold: 10, ItemIDs: ids}); !errors.Is(err, ErrStashFull) {
+		t.Fatalf("got %v, want ErrStashFull", err)
+	}
+	if goldOf(t, client, ann) != 150 {
+		t.Errorf("gold taken for a refused deposit")
+	}
+	if it := client.Equipment.GetX(ctx, sword.ID); it.OwnerId == nil {
+		t.Errorf("sword taken for a refused deposit")
+	}
+}
+
+func TestBankStashVersionGuard(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	ann, _ := bankCustomer(t, client)
+	if _, err := svc.Bank.Deposit(ctx, ann.ID, BankTransferInput{Gold: 10}); err != nil {
+		t.Fatalf("deposit: %v", err)
+	}
+	stale := client.Stash.Query().Where(stash.CharacterID(ann.ID)).OnlyX(ctx)
+	client.Stash.UpdateOneID(stale.ID).AddGold(5).AddVersion(1).ExecX(ctx)
+
+	err := repository.NewEntTransactionRunner(client).WithTx(ctx, func(tx *db.Tx) error {
+		return updateStash(ctx, tx, stale, stale.ItemIds, -10, stale.Slots)
+	})
+	if !errors.Is(err, ErrBankBusy) {
+		t.Fatalf("got %v, want ErrBankBusy", err)
+	}
+	if got := client.Stash.GetX(ctx, stale.ID).Gold; got != 15 {
+		t.Errorf("stash gold = %d, want 15", got)
+	}
+}
+
+func TestBankExpand(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	ann, _ := bankCustomer(t, client)
+
+	view, err := svc.Bank.Expand(ctx, ann.ID, false)
+	if err != nil {
+		t.Fatalf("expand: %v", err)
+	}
+	cfg := DefaultBankConfig
+	if view.Stash.Slots != cfg.StashSlots+cfg.SlotStep || view.CarriedGold != 150-cfg.SlotCost {
+		t.Errorf("after expanding: %d slots and %d gold, want %d and %d",
+			view.Stash.Slots, view.CarriedGold, cfg.StashSlots+cfg.SlotStep, 150-cfg.SlotCost)
+	}
+	if _, err := svc.Bank.Expand(ctx, ann.ID, false); !errors.Is(err, ErrInsufficientGold) {
+		t.Fatalf("second expand: got %v, want ErrInsufficientGold", err)
+	}
+	if view, _ := svc.Bank.View(ctx, ann.ID); view.Stash.Slots != cfg.StashSlots+cfg.SlotStep {
+		t.Errorf("slots = %d after a failed expand, want %d", view.Stash.Slots, cfg.StashSlots+cfg.SlotStep)
+	}
+	if _, err := svc.Bank.Expand(ctx, ann.ID, true); !errors.Is(err, ErrNoAccountStash) {
+		t.Errorf("account expand without an account stash: got %v, want ErrNoAccountStash", err)
+	}
+}
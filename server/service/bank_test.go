@@ -0,0 +1,68 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseBankConfig(t *testing.T) {
+	if got := ParseBankConfig(nil); got != DefaultBankConfig {
+		t.Fatalf("no bank section: got %+v, want defaults", got)
+	}
+
+	got := ParseBankConfig(map[string]interface{}{
+		"bank": map[string]interface{}{
+			"stash_slots":   float64(20),
+			"slot_step":     float64(10),
+			"slot_cost":     float64(0),
+			"max_slots":     float64(5),
+			"account_stash": true,
+		},
+	})
+	want := BankConfig{StashSlots: 20, SlotStep: 10, SlotCost: 0, MaxSlots: 20, AccountStash: true}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestBankExpandCost(t *testing.T) {
+	cfg := BankConfig{StashSlots: 10, SlotStep: 5, SlotCost: 100, MaxSlots: 22}
+	cases := []struct {
+		slots, cost, next int
+	}{
+		{10, 100, 15},
+		{15, 200, 20},
+		{20, 300, 22},
+		{22, -1, 22},
+	}
+	for _, c := range cases {
+		if got := cfg.ExpandCost(c.slots); got != c.cost {
+			t.Errorf("%d slots: cost %d, want %d", c.slots, got, c.cost)
+		}
+		if got := cfg.expanded(c.slots); got != c.next {
+			t.Errorf("%d slots: expands to %d, want %d", c.slots, got, c.next)
+		}
+	}
+}
+
+func TestBankTransfer(t *testing.T) {
+	if _, err := validateTransfer(BankTransferInput{Gold: -5}); !errors.Is(err, ErrBankAmount) {
+		t.Errorf("negative gold: got %v", err)
+	}
+	if _, err := validateTransfer(BankTransferInput{}); !errors.Is(err, ErrBankNothing) {
+		t.Errorf("empty transfer: got %v", err)
+	}
+	ids, err := validateTransfer(BankTransferInput{ItemIDs: []int{3, 4, 3}})
+	if err != nil || !reflect.DeepEqual(ids, []int{3, 4}) {
+		t.Errorf("got %v, %v; want [3 4]", ids, err)
+	}
+
+	left, ok := withoutItems([]int{1, 2, 3}, []int{3, 1})
+	if !ok || !reflect.DeepEqual(left, []int{2}) {
+		t.Errorf("got %v, %v; want [2]", left, ok)
+	}
+	if _, ok := withoutItems([]int{1, 2}, []int{2, 9}); ok {
+		t.Error("withdrawing an item that isn't stored should fail")
+	}
+}
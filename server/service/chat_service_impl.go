@@ -15,6 +15,7 @@ type chatService struct {
 	subRepo         repository.ChannelSubscriptionRepo
 	tellRepo        repository.OfflineTellRepo
 	ignoreRepo      repository.IgnoreRepo
+	partyRepo       repository.PartyRepo
 }
 
 // NewChatService creates a new ChatService.
@@ -23,18 +24,20 @@ func NewChatService(
 	subRepo repository.ChannelSubscriptionRepo,
 	tellRepo repository.OfflineTellRepo,
 	ignoreRepo repository.IgnoreRepo,
+	partyRepo repository.PartyRepo,
 ) ChatService {
 	return &chatService{
 		charRepo:   charRepo,
 		subRepo:    subRepo,
 		tellRepo:   tellRepo,
 		ignoreRepo: ignoreRepo,
+		partyRepo:  partyRepo,
 	}
 }
 
 // DefaultChannels returns the list of available chat channels.
 func DefaultChannels() []string {
-	return []string{"ooc", "guild", "group", "trade", "help", "race", "clan"}
+	return []string{"ooc", "guild", "group", "party", "trade", "help", "race", "clan"}
 }
 
 func (s *chatService) SendSay(ctx context.Context, charID, roomID int, message string) (*MessageResult, error) {
@@ -148,6 +151,7 @@ func (s *chatService) SendEmote(ctx context.Context, charID int, action string)
 		Channel:           "emote",
 		Message:           action,
 		Type:              "emote",
+		RoomID:            &char.CurrentRoomId,
 	}, nil
 }
 
@@ -168,6 +172,19 @@ func (s *chatService) SendChannel(ctx context.Context, channel, message string,
 	if err != nil {
 		return nil, fmt.Errorf("character not found: %w", err)
 	}
+	// The party channel reaches the sender's party members only.
+	recipients := []int{}
+	if channel == "party" {
+		p, err := s.partyRepo.GetForCharacter(ctx, charID)
+		if err != nil {
+			return nil, ErrNotInParty
+		}
+		for _, m := range p.Edges.Members {
+			if m.ID != charID {
+				recipients = append(recipients, m.ID)
+			}
+		}
+	}
 	sub, err := s.subRepo.GetByCharacterAndChannel(ctx, charID, channel)
 	if err != nil {
 		// subscription doesn't exist, create it
@@ -181,7 +198,7 @@ func (s *chatService) SendChannel(ctx context.Context, channel, message string,
 	return &MessageResult{
 		FromCharacterID:   charID,
 		FromCharacterName: char.Name,
-		ToCharacterIDs:    []int{},
+		ToCharacterIDs:    recipients,
 		Channel:           channel,
 		Message:           message,
 		Type:              "channel",
@@ -309,6 +326,8 @@ func (s *chatService) DeliverQueuedTells(ctx context.Context, charID int) ([]Que
 		fromName := "unknown"
 		if fromChar != nil {
 			fromName = fromChar.Name
+		} else if t.FromName != "" {
+			fromName = t.FromName
 		}
 		result = append(result, QueuedTell{
 			ID:            t.ID,
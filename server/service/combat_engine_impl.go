@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"herbst-server/combat"
+	"herbst-server/db"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+var (
+	ErrSkillNotEquipped   = errors.New("skill not equipped")
+	ErrNotEnoughResources = errors.New("not enough mana or stamina")
+)
+
+// trainingCategories are the competency categories that gate weapon and
+// armor proficiency. Requirements outside this set are ignored.
+var trainingCategories = map[string]bool{
+	"blades": true, "staves": true, "knives": true, "martial": true, "brawling": true, "tech": true,
+	"light_armor": true, "cloth_armor": true, "heavy_armor": true,
+}
+
+// combatBackend loads combatants and persists fight outcomes for the
+// combat engine. Damage goes through CombatService so resistances, the
+// damage log and npc_defeated events behave exactly as for any other hit.
+type combatBackend struct {
+	charRepo       repository.CharacterRepo
+	npcTmplRepo    repository.NPCTemplateRepo
+	roomRepo       repository.RoomRepo
+	equipRepo      repository.EquipmentRepo
+	competencyRepo repository.CompetencyRepo
+	charAbilRepo   repository.CharacterAbilityRepo
+	combatSvc      CombatService
+}
+
+// NewCombatBackend creates the combat.Backend used by the combat engine.
+func NewCombatBackend(
+	charRepo repository.CharacterRepo,
+	npcTmplRepo repository.NPCTemplateRepo,
+	roomRepo repository.RoomRepo,
+	equipRepo repository.EquipmentRepo,
+	competencyRepo repository.CompetencyRepo,
+	charAbilRepo repository.CharacterAbilityRepo,
+	combatSvc CombatService,
+) combat.Backend {
+	return &combatBackend{
+		charRepo:       charRepo,
+		npcTmplRepo:    npcTmplRepo,
+		roomRepo:       roomRepo,
+		equipRepo:      equipRepo,
+		competencyRepo: competencyRepo,
+		charAbilRepo:   charAbilRepo,
+		combatSvc:      combatSvc,
+	}
+}
+
+// Load builds a combatant from the character and its equipped items.
+// Players: to-hit is level/3, AC is 10 + level/3 + level/2 + armor, and
+// unarmed attacks are 1d6 + STR mod. NPCs: AC is 10 + level/2, weapons add
+// level/3, unarmed NPCs hit for a flat level + 2, and the template's
+// behavior sets when they flee.
+func (b *combatBackend) Load(ctx context.Context, id int) (*combat.Combatant, error) {
+	ch, err := b.charRepo.Get(ctx, id)
+	if err != nil {
+		return nil, ErrCharNotFound
+	}
+	c := &combat.Combatant{
+		ID:            ch.ID,
+		Name:          ch.Name,
+		Level:         ch.Level,
+		HP:            ch.Hitpoints,
+		MaxHP:         ch.MaxHitpoints,
+		ToHit:         ch.Level / 3,
+		IsNPC:         ch.IsNPC,
+		RoomID:        ch.CurrentRoomId,
+		NPCTemplateID: ch.NpcTemplateID,
+		NPCSkill:      ch.NpcSkillID,
+	}
+
+	var equipped []*db.Equipment
+	if items, err := b.equipRepo.ListByOwner(ctx, id); err == nil {
+		for _, item := range items {
+			if item.IsEquipped {
+				equipped = append(equipped, item)
+			}
+		}
+	}
+	main := weaponInSlot(equipped, "main_hand")
+	if main == nil {
+		main = weaponInSlot(equipped, "")
+	}
+
+	if ch.IsNPC {
+		if ch.NpcTemplateID != "" {
+			if tmpl, err := b.npcTmplRepo.Get(ctx, ch.NpcTemplateID); err == nil {
+				c.FleeBelow = tmpl.Behavior.FleeBelowPct
+			}
+		}
+		c.AC = 10 + ch.Level/2
+		if main != nil {
+			c.MainHand = combat.Weapon{
+				Name:      main.Name,
+				DiceCount: main.DamageDiceCount,
+				DiceSides: main.DamageDiceSides,
+				Bonus:     main.DamageBonus + main.Stats[AffixStatDamage] + ch.Level/3,
+				Extra:     ExtraDamageDice(main.Stats),
+			}
+		} else {
+			c.MainHand = combat.Weapon{Bonus: ch.Level + 2}
+		}
+		return c, nil
+	}
+
+	armor := 0
+	for _, item := range equipped {
+		ac := item.ArmorRating + item.Stats[AffixStatArmor]
+		if ac <= 0 {
+			continue
+		}
+		if !b.trained(ctx, id, item) {
+			ac /= 2
+			if ac < 1 {
+				ac = 1
+			}
+		}
+		armor += ac
+	}
+	c.AC = 10 + ch.Level/3 + ch.Level/2 + armor
+
+	strMod := (ch.Strength + equippedStat(equipped, "strength") - 10) / 2
+	if main == nil {
+		c.MainHand = combat.Weapon{Name: "fists", DiceCount: 1, DiceSides: 6, Bonus: strMod}
+		return c, nil
+	}
+	c.MainHand = b.playerWeapon(ctx, id, main, strMod)
+	if !main.IsTwoHanded {
+		off := weaponInSlot(equipped, "off_hand")
+		if off == nil {
+			off = weaponInSlot(equipped, "tail")
+		}
+		if off != nil && off.ID != main.ID {
+			w := b.playerWeapon(ctx, id, off, strMod)
+			c.OffHand = &w
+		}
+	}
+	return c, nil
+}
+
+// playerWeapon applies the STR modifier, halved when the wielder is
+// untrained, and the weapon's damage affixes.
+func (b *combatBackend) playerWeapon(ctx context.Context, charID int, item *db.Equipment, strMod int) combat.Weapon {
+	bonus := item.DamageBonus + item.Stats[AffixStatDamage]
+	w := combat.Weapon{
+		Name:      item.Name,
+		DiceCount: item.DamageDiceCount,
+		DiceSides: item.DamageDiceSides,
+		Bonus:     bonus + strMod,
+		Extra:     ExtraDamageDice(item.Stats),
+	}
+	if !b.trained(ctx, charID, item) {
+		w.Bonus = bonus + strMod/2
+		w.Untrained = true
+	}
+	return w
+}
+
+// trained reports whether the character meets the item's skill requirement.
+func (b *combatBackend) trained(ctx context.Context, charID int, item *db.Equipment) bool {
+	if item.SkillRequirement == "" || !trainingCategories[item.SkillRequirement] {
+		return true
+	}
+	required := item.SkillRequirementLevel
+	if required <= 0 {
+		required = 1
+	}
+	comp, err := b.competencyRepo.GetCharacterCompetency(ctx, charID, item.SkillRequirement)
+	if err != nil {
+		return false
+	}
+	return comp.Level >= required
+}
+
+// equippedStat sums a stat bonus across equipped items.
+func equippedStat(items []*db.Equipment, stat string) int {
+	total := 0
+	for _, item := range items {
+		total += item.Stats[stat]
+	}
+	return total
+}
+
+// weaponInSlot returns the first item with damage dice in slot, or in any
+// slot when slot is empty.
+func weaponInSlot(items []*db.Equipment, slot string) *db.Equipment {
+	for _, item := range items {
+		if item.DamageDiceCount > 0 && (slot == "" || item.Slot == slot) {
+			return item
+		}
+	}
+	return nil
+}
+
+func (b *combatBackend) ApplyDamage(ctx context.Context, attackerID, targetID, damage int) (int, bool, error) {
+	res, err := b.combatSvc.ApplyDamage(ctx, attackerID, targetID, damage)
+	if err != nil {
+		return 0, false, err
+	}
+	return res.HP, res.Defeated, nil
+}
+
+func (b *combatBackend) Heal(ctx context.Context, id, amount int) (int, error) {
+	res, err := b.combatSvc.HealCharacter(ctx, id, amount)
+	if err != nil {
+		return 0, err
+	}
+	return res.HP, nil
+}
+
+// Flee moves the NPC through a random exit of its room.
+func (b *combatBackend) Flee(ctx context.Context, npcID int) (string, error) {
+	npc, err := b.charRepo.Get(ctx, npcID)
+	if err != nil {
+		return "", ErrCharNotFound
+	}
+	room, err := b.roomRepo.Get(ctx, npc.CurrentRoomId)
+	if err != nil {
+		return "", err
+	}
+	open := passableExits(room)
+	exits := sortedExitDirs(open)
+	if len(exits) == 0 {
+		return "", nil
+	}
+	exit := exits[rand.Intn(len(exits))]
+	dest := open[exit]
+	if _, err := b.charRepo.Update(ctx, npcID, repository.CharacterUpdates{CurrentRoomID: &dest}); err != nil {
+		return "", err
+	}
+	stream.Default().ToRoom(ctx, dest, stream.Event{
+		Type:    stream.TypeNPC,
+		Text:    fmt.Sprintf("%s scrambles in, fleeing for its life.", npc.Name),
+		ActorID: npcID,
+	})
+	return exit, nil
+}
+
+// UseSkill resolves an equipped active ability, deducts its mana and stamina
+// costs and scales its effect values by the caster's stats.
+func (b *combatBackend) UseSkill(ctx context.Context, charID, skillID int) (*combat.Skill, error) {
+	ch, err := b.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharNotFound
+	}
+	links, err := b.charAbilRepo.ListByCharacterWithDetails(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	var ability *db.Ability
+	for _, ca := range links {
+		if ab := ca.Edges.Ability; ab != nil && ab.ID == skillID && ab.AbilityClass == "active" {
+			ability = ab
+			break
+		}
+	}
+	if ability == nil {
+		return nil, ErrSkillNotEquipped
+	}
+	if ch.Mana < ability.ManaCost || ch.Stamina < ability.StaminaCost {
+		return nil, ErrNotEnoughResources
+	}
+	if ability.ManaCost > 0 {
+		if _, err := b.combatSvc.AdjustMana(ctx, charID, -ability.ManaCost); err != nil {
+			return nil, err
+		}
+	}
+	if ability.StaminaCost > 0 {
+		if _, err := b.combatSvc.AdjustStamina(ctx, charID, -ability.StaminaCost); err != nil {
+			return nil, err
+		}
+	}
+
+	effects := append([]*db.AbilityEffect(nil), ability.Edges.Effects...)
+	sort.Slice(effects, func(i, j int) bool { return effects[i].SortOrder < effects[j].SortOrder })
+	skill := &combat.Skill{ID: ability.ID, Name: ability.Name, Cooldown: ability.Cooldown}
+	for _, e := range effects {
+		skill.Effects = append(skill.Effects, combat.SkillEffect{
+			Type:     e.EffectType,
+			Target:   e.Target,
+			Value:    scaledEffectValue(ch, e.ScalingStat, e.ScalingRatio, e.Value),
+			Duration: e.Duration,
+		})
+	}
+	return skill, nil
+}
+
+// scaledEffectValue adds ratio × base for every point of the scaling stat's
+// modifier above zero.
+func scaledEffectValue(ch *db.Character, stat string, ratio float64, base int) int {
+	if stat == "" || ratio == 0 {
+		return base
+	}
+	var value int
+	switch stat {
+	case "strength":
+		value = ch.Strength
+	case "dexterity":
+		value = ch.Dexterity
+	case "constitution":
+		value = ch.Constitution
+	case "intelligence":
+		value = ch.Intelligence
+	case "wisdom":
+		value = ch.Wisdom
+	case "charisma":
+		value = ch.Charisma
+	default:
+		value = 10
+	}
+	mod := (value - 10) / 2
+	if mod < 0 {
+		mod = 0
+	}
+	scaled := int(float64(base) + float64(mod)*ratio*float64(base))
+	if scaled < 1 && base > 0 {
+		scaled = base
+	}
+	return scaled
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"time"
 
+	"herbst-server/db"
 	"herbst-server/events"
 	"herbst-server/repository"
 )
@@ -16,6 +17,7 @@ type combatService struct {
 	npcTmplRepo     repository.NPCTemplateRepo
 	equipRepo       repository.EquipmentRepo
 	resistanceSvc   ResistanceService
+	lootSvc         LootService
 	logger          *slog.Logger
 }
 
@@ -25,6 +27,7 @@ func NewCombatService(
 	npcTmplRepo repository.NPCTemplateRepo,
 	equipRepo repository.EquipmentRepo,
 	resistanceSvc ResistanceService,
+	lootSvc LootService,
 	logger *slog.Logger,
 ) CombatService {
 	return &combatService{
@@ -33,6 +36,7 @@ func NewCombatService(
 		npcTmplRepo:   npcTmplRepo,
 		equipRepo:     equipRepo,
 		resistanceSvc: resistanceSvc,
+		lootSvc:       lootSvc,
 		logger:        logger,
 	}
 }
@@ -90,25 +94,53 @@ func (s *combatService) ApplyDamage(ctx context.Context, attackerID, targetID in
 		return nil, err
 	}
 	defeated := newHP == 0
-	if defeated && updated.IsNPC {
-		baseXP := updated.Level * 100
-		events.Publish(events.Event{
-			Type: events.EventNPCDefeated,
-			Payload: map[string]interface{}{
-				"npc_id":    updated.ID,
-				"npc_level": updated.Level,
-				"base_xp":   baseXP,
-			},
-			Timestamp: time.Now().UnixMilli(),
-		})
-	}
-	// Persist damage to damage_logs table on every hit
+	// Persist damage to damage_logs table on every hit. This must happen
+	// before npc_defeated is published so the killing blow counts toward
+	// the XP split.
 	if damage > 0 {
 		s.LogDamage(ctx, attackerID, targetID, damage)
 	}
+	if defeated && updated.IsNPC {
+		// Only the blow that took the NPC from alive to dead drops loot,
+		// so it lands on the NPC before the killer builds the corpse.
+		if s.lootSvc != nil && char.Hitpoints > 0 {
+			if _, err := s.lootSvc.DropLoot(ctx, updated); err != nil {
+				s.logger.Error("failed to drop loot", "npc_id", updated.ID, "error", err)
+			}
+		}
+		s.publishDefeat(ctx, updated)
+	}
 	return &CombatResult{ID: updated.ID, HP: updated.Hitpoints, MaxHP: updated.MaxHitpoints, Defeated: defeated}, nil
 }
 
+// publishDefeat announces an NPC's death. Numbers are sent as float64, the
+// same shape subscribers receive for events posted to /api/events.
+func (s *combatService) publishDefeat(ctx context.Context, npc *db.Character) {
+	baseXP := npc.Level * 10
+	xpMultiplier := 1.0
+	if npc.NpcTemplateID != "" {
+		if tmpl, err := s.npcTmplRepo.Get(ctx, npc.NpcTemplateID); err == nil {
+			if tmpl.XpValue > 0 {
+				baseXP = tmpl.XpValue
+			}
+			if tmpl.XpMultiplier > 0 {
+				xpMultiplier = tmpl.XpMultiplier
+			}
+		}
+	}
+	events.Publish(events.Event{
+		Type: events.EventNPCDefeated,
+		Payload: map[string]interface{}{
+			"npc_id":          float64(npc.ID),
+			"npc_level":       float64(npc.Level),
+			"npc_template_id": npc.NpcTemplateID,
+			"base_xp":         float64(baseXP),
+			"xp_multiplier":   xpMultiplier,
+		},
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
+
 func (s *combatService) LogDamage(ctx context.Context, attackerID, targetID, damage int) {
 	_, err := s.damageRepo.Create(ctx, attackerID, targetID, damage)
 	if err != nil {
@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"herbst-server/condition"
+	"herbst-server/db"
+	"herbst-server/repository"
+)
+
+// ErrInvalidCondition wraps condition syntax errors.
+var ErrInvalidCondition = errors.New("invalid condition")
+
+// conditionService implements ConditionService using repository interfaces.
+type conditionService struct {
+	charRepo    repository.CharacterRepo
+	roomRepo    repository.RoomRepo
+	npcRepo     repository.NPCTemplateRepo
+	tagRepo     repository.CharacterTagRepo
+	activeRepo  repository.ActiveEffectRepo
+	effectRepo  repository.EffectRepo
+	factionRepo repository.CharacterFactionRepo
+	questRepo   repository.QuestProgressRepo
+	dialogRepo  repository.DialogStateRepo
+	worldRepo   repository.WorldRepo
+	zoneRepo    repository.ZoneRepository
+	repRepo     repository.ReputationRepo
+}
+
+// NewConditionService creates a new ConditionService.
+func NewConditionService(
+	charRepo repository.CharacterRepo,
+	roomRepo repository.RoomRepo,
+	npcRepo repository.NPCTemplateRepo,
+	tagRepo repository.CharacterTagRepo,
+	activeRepo repository.ActiveEffectRepo,
+	effectRepo repository.EffectRepo,
+	factionRepo repository.CharacterFactionRepo,
+	questRepo repository.QuestProgressRepo,
+	dialogRepo repository.DialogStateRepo,
+	worldRepo repository.WorldRepo,
+	zoneRepo repository.ZoneRepository,
+	repRepo repository.ReputationRepo,
+) ConditionService {
+	return &conditionService{
+		charRepo:    charRepo,
+		roomRepo:    roomRepo,
+		npcRepo:     npcRepo,
+		tagRepo:     tagRepo,
+		activeRepo:  activeRepo,
+		effectRepo:  effectRepo,
+		factionRepo: factionRepo,
+		questRepo:   questRepo,
+		dialogRepo:  dialogRepo,
+		worldRepo:   worldRepo,
+		zoneRepo:    zoneRepo,
+		repRepo:     repRepo,
+	}
+}
+
+func (s *conditionService) Validate(expr string) error {
+	if err := condition.Validate(expr); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCondition, err)
+	}
+	return nil
+}
+
+// Evaluate compiles expr and runs it against the environment described by in.
+// An empty expression is always true.
+func (s *conditionService) Evaluate(ctx context.Context, expr string, in ConditionInput) (bool, error) {
+	compiled, err := condition.Compile(expr)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrInvalidCondition, err)
+	}
+	if expr == "" {
+		return true, nil
+	}
+	env, err := s.buildEnv(ctx, in)
+	if err != nil {
+		return false, err
+	}
+	return compiled.Eval(env)
+}
+
+// EvaluateAll builds the environment once and evaluates every expression
+// against it. Bad expressions are false rather than an error, so one broken
+// dialog condition only hides its own response.
+func (s *conditionService) EvaluateAll(ctx context.Context, exprs []string, in ConditionInput) ([]bool, error) {
+	results := make([]bool, len(exprs))
+	var env condition.Env
+	for i, expr := range exprs {
+		if expr == "" {
+			results[i] = true
+			continue
+		}
+		compiled, err := condition.Compile(expr)
+		if err != nil {
+			continue
+		}
+		if env == nil {
+			if env, err = s.buildEnv(ctx, in); err != nil {
+				return nil, err
+			}
+		}
+		results[i], _ = compiled.Eval(env)
+	}
+	return results, nil
+}
+
+// buildEnv loads the source, target, room and NPC template named by in.
+// The room also brings its world's time of day and its weather. Anything
+// not supplied is left null.
+func (s *conditionService) buildEnv(ctx context.Context, in ConditionInput) (condition.Env, error) {
+	env := condition.Env{"event": map[string]interface{}{}}
+	if in.Extras != nil {
+		env["event"] = in.Extras
+	}
+
+	roomID := in.RoomID
+	if in.SourceID > 0 {
+		src, err := s.charRepo.Get(ctx, in.SourceID)
+		if err != nil {
+			return nil, fmt.Errorf("load source character: %w", err)
+		}
+		env["source"] = s.characterView(ctx, src)
+		if roomID == 0 {
+			roomID = src.CurrentRoomId
+		}
+	}
+	if in.TargetID > 0 {
+		tgt, err := s.charRepo.Get(ctx, in.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("load target character: %w", err)
+		}
+		env["target"] = s.characterView(ctx, tgt)
+	}
+	if roomID > 0 {
+		room, err := s.roomRepo.Get(ctx, roomID)
+		if err != nil {
+			return nil, fmt.Errorf("load room: %w", err)
+		}
+		rc := CurrentRoomConditions(ctx, s.worldRepo, s.zoneRepo, room, time.Now())
+		env["room"] = map[string]interface{}{
+			"id":         room.ID,
+			"name":       room.Name,
+			"atmosphere": string(room.Atmosphere),
+			"tags":       room.Tags,
+			"zone_ids":   room.ZoneIds,
+			"outdoor":    rc.Outdoor,
+			"weather":    rc.Weather,
+		}
+		env["world"] = map[string]interface{}{
+			"day":    rc.Time.Day,
+			"hour":   rc.Time.Hour,
+			"minute": rc.Time.Minute,
+			"phase":  rc.Time.Phase,
+		}
+	}
+	if in.NPCTemplateID != "" {
+		tmpl, err := s.npcRepo.Get(ctx, in.NPCTemplateID)
+		if err != nil {
+			return nil, fmt.Errorf("load npc template: %w", err)
+		}
+		env["npc"] = map[string]interface{}{
+			"id":          tmpl.ID,
+			"name":        tmpl.Name,
+			"level":       tmpl.Level,
+			"disposition": string(tmpl.Disposition),
+			"race_id":     tmpl.RaceID,
+		}
+		if in.SourceID > 0 {
+			env["dialog"] = s.dialogView(ctx, in.SourceID, tmpl.ID)
+		}
+	}
+	return env, nil
+}
+
+// characterView flattens a character into the object scripts see. Tags,
+// active effect names, faction standings, reputation and quest statuses
+// are best-effort: lookup failures leave them empty.
+func (s *conditionService) characterView(ctx context.Context, ch *db.Character) map[string]interface{} {
+	tags := []string{}
+	if charTags, err := s.tagRepo.ListByCharacter(ctx, ch.ID); err == nil {
+		for _, t := range charTags {
+			tags = append(tags, t.Tag)
+		}
+	}
+	effects := []string{}
+	if active, err := s.activeRepo.ListActiveByCharacter(ctx, ch.ID); err == nil {
+		for _, ae := range active {
+			if eff, err := s.effectRepo.Get(ctx, ae.EffectID); err == nil {
+				effects = append(effects, eff.Name)
+			}
+		}
+	}
+	reputation, tiers := s.reputation(ctx, ch.ID)
+	return map[string]interface{}{
+		"id":              ch.ID,
+		"name":            ch.Name,
+		"is_npc":          ch.IsNPC,
+		"npc_template_id": ch.NpcTemplateID,
+		"room_id":         ch.CurrentRoomId,
+		"level":           ch.Level,
+		"xp":              ch.Xp,
+		"hp":              ch.Hitpoints,
+		"max_hp":          ch.MaxHitpoints,
+		"stamina":         ch.Stamina,
+		"max_stamina":     ch.MaxStamina,
+		"mana":            ch.Mana,
+		"max_mana":        ch.MaxMana,
+		"gold":            ch.GoldCredits,
+		"race":            ch.Race,
+		"class":           ch.Class,
+		"gender":          ch.Gender,
+		"strength":        ch.Strength,
+		"dexterity":       ch.Dexterity,
+		"constitution":    ch.Constitution,
+		"intelligence":    ch.Intelligence,
+		"wisdom":          ch.Wisdom,
+		"charisma":        ch.Charisma,
+		"tags":            tags,
+		"effects":         effects,
+		"factions":        s.factionStandings(ctx, ch.ID),
+		"reputation":      reputation,
+		"reputation_tier": tiers,
+		"quests":          s.questStatuses(ctx, ch.ID),
+	}
+}
+
+// factionStandings maps faction name to the character's reputation, so
+// scripts can write source.factions.merchants >= 50.
+func (s *conditionService) factionStandings(ctx context.Context, charID int) map[string]interface{} {
+	standings := map[string]interface{}{}
+	if s.factionRepo == nil {
+		return standings
+	}
+	memberships, err := s.factionRepo.ListByCharacter(ctx, charID)
+	if err != nil {
+		return standings
+	}
+	for _, m := range memberships {
+		if m.Edges.Faction != nil {
+			standings[m.Edges.Faction.Name] = m.Reputation
+		}
+	}
+	return standings
+}
+
+// reputation maps faction name to the character's reputation standing and
+// to its tier, so scripts can write source.reputation.merchants >= 300 or
+// source.reputation_tier.merchants == "hostile". Factions they have no
+// standing with are absent.
+func (s *conditionService) reputation(ctx context.Context, charID int) (map[string]interface{}, map[string]interface{}) {
+	standings, tiers := map[string]interface{}{}, map[string]interface{}{}
+	if s.repRepo == nil {
+		return standings, tiers
+	}
+	rows, err := s.repRepo.ListByCharacter(ctx, charID)
+	if err != nil {
+		return standings, tiers
+	}
+	for _, r := range rows {
+		standings[r.Faction] = r.Standing
+		tiers[r.Faction] = ReputationTier(r.Standing)
+	}
+	return standings, tiers
+}
+
+// questStatusRank orders quest statuses when a repeatable quest has several
+// progress rows: an active run wins over a completed one, and so on.
+var questStatusRank = map[string]int{"abandoned": 1, "failed": 2, "completed": 3, "active": 4}
+
+// questStatuses maps quest name to the character's progress status
+// ("active", "completed", "failed" or "abandoned"), e.g.
+// source.quests["Rat Problem"] == "completed".
+func (s *conditionService) questStatuses(ctx context.Context, charID int) map[string]interface{} {
+	statuses := map[string]interface{}{}
+	if s.questRepo == nil {
+		return statuses
+	}
+	progress, err := s.questRepo.ListByCharacter(ctx, charID)
+	if err != nil {
+		return statuses
+	}
+	for _, p := range progress {
+		if p.Edges.Quest == nil {
+			continue
+		}
+		name := p.Edges.Quest.Name
+		status := string(p.Status)
+		if prev, ok := statuses[name].(string); ok && questStatusRank[prev] >= questStatusRank[status] {
+			continue
+		}
+		statuses[name] = status
+	}
+	return statuses
+}
+
+// dialogView is what the NPC template remembers about the character.
+// Characters the NPC has never met get empty flags and counters.
+func (s *conditionService) dialogView(ctx context.Context, charID int, npcTemplateID string) map[string]interface{} {
+	view := map[string]interface{}{
+		"flags":        map[string]interface{}{},
+		"counters":     map[string]interface{}{},
+		"met":          false,
+		"times_talked": 0,
+		"last_node_id": "",
+	}
+	if s.dialogRepo == nil {
+		return view
+	}
+	state, err := s.dialogRepo.Get(ctx, charID, npcTemplateID)
+	if err != nil {
+		return view
+	}
+	flags := make(map[string]interface{}, len(state.Flags))
+	for k, v := range state.Flags {
+		flags[k] = v
+	}
+	counters := make(map[string]interface{}, len(state.Counters))
+	for k, v := range state.Counters {
+		counters[k] = v
+	}
+	view["flags"] = flags
+	view["counters"] = counters
+	view["met"] = state.FirstMetAt != nil
+	view["times_talked"] = state.TimesTalked
+	view["last_node_id"] = state.LastNodeID
+	return view
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"log/slog"
 
+	"herbst-server/combat"
 	"herbst-server/db"
 	"herbst-server/repository"
 )
@@ -17,6 +18,7 @@ type Container struct {
 	Quest              QuestService
 	QuestProgress      QuestProgressService
 	Combat             CombatService
+	CombatEngine       *combat.Manager
 	Resistance         ResistanceService
 	Equipment          EquipmentService
 	NPC                NPCService
@@ -26,6 +28,25 @@ type Container struct {
 	Chat               ChatService
 	Zone               *ZoneService
 	ReclassRerace      ReclassReraceService
+	Shop               ShopService
+	Condition          ConditionService
+	Party              PartyService
+	RoomEffect         RoomEffectService
+	Crafting           CraftingService
+	Loot               LootService
+	Conversation       ConversationService
+	Achievement        AchievementService
+	NPCBehavior        NPCBehaviorService
+	WorldTime          WorldTimeService
+	Instance           InstanceService
+	Mail               MailService
+	Trade              TradeService
+	Auction            AuctionService
+	Bank               BankService
+	Exit               ExitService
+	RoomTrigger        RoomTriggerService
+	Reputation         ReputationService
+	House              HouseService
 	Client             *db.Client
 }
 
@@ -35,24 +56,55 @@ type Container struct {
 // to use repo interfaces.
 func NewContainer(client *db.Client, repos *repository.Container, logger *slog.Logger) *Container {
 	charSvc := NewCharacterService(client, repos)
+	reputationSvc := NewReputationService(repos.Reputation, repos.Character, repos.NPCTemplate, repos.Quest, logger)
 	xpSvc := NewXPAwardService(client, logger)
 	abilityEligSvc := NewAbilityEligibilityService(client)
 	resistanceSvc := NewResistanceService(repos.Character, repos.Race, client, logger)
+	lootSvc := NewLootService(repos.NPCTemplate, repos.EquipmentTemplate, repos.Equipment, repos.World, logger)
+	combatSvc := NewCombatService(repos.Character, repos.DamageLog, repos.NPCTemplate, repos.Equipment, resistanceSvc, lootSvc, logger)
+	combatBackend := NewCombatBackend(repos.Character, repos.NPCTemplate, repos.Room, repos.Equipment, repos.Competency, repos.CharacterAbility, combatSvc)
+	combatEngine := combat.NewManager(combatBackend, nil, logger)
+	abilitySvc := NewAbilityService(repos.CharacterAbility, repos.Ability, repos.Character)
+	skillXPSvc := NewSkillXPService(client, repos.Character, repos.CharacterAbility, abilitySvc, logger)
+	conditionSvc := NewConditionService(repos.Character, repos.Room, repos.NPCTemplate, repos.CharacterTag, repos.ActiveEffect, repos.Effect, repos.CharacterFaction, repos.QuestProgress, repos.DialogState, repos.World, repos.Zone, repos.Reputation)
+	roomEffectSvc := NewRoomEffectService(repos.Character, repos.EffectHook, repos.Effect, repos.CharacterTag, conditionSvc)
+	zoneSvc := NewZoneService(repos.Zone, repos.NPCTemplate, repos.Character, repos.Race, repos.Equipment, repos.EquipmentTemplate, repos.ZoneInstance, logger)
 
 	return &Container{
 		Character:          charSvc,
 		XP:                 xpSvc,
+		SkillXP:            skillXPSvc,
 		AbilityEligibility: abilityEligSvc,
 		Quest:             NewQuestService(repos.Quest, repos.QuestProgress),
 		QuestProgress:     NewQuestProgressService(repos.QuestProgress, repos.Quest, repos.Character),
 		Room:               NewRoomService(repos.Room, repos.Character, repos.Equipment, repos.NPCTemplate, repos.Tx, repos.Zone),
-		Combat:             NewCombatService(repos.Character, repos.DamageLog, repos.NPCTemplate, repos.Equipment, resistanceSvc, logger),
+		Combat:             combatSvc,
+		CombatEngine:       combatEngine,
 		Resistance:         resistanceSvc,
-		Ability:            NewAbilityService(repos.CharacterAbility, repos.Ability, repos.Character),
-		Chat:               NewChatService(repos.Character, repos.ChannelSubscription, repos.OfflineTell, repos.Ignore),
+		Ability:            abilitySvc,
+		Chat:               NewChatService(repos.Character, repos.ChannelSubscription, repos.OfflineTell, repos.Ignore, repos.Party),
 		NPC:                NewNPCService(repos.NPCTemplate),
-		Zone:               NewZoneService(repos.Zone, repos.NPCTemplate),
+		Zone:               zoneSvc,
 		ReclassRerace:      NewReclassReraceService(client, logger),
+		Shop:               NewShopService(repos.Character, repos.NPCTemplate, repos.ShopTemplate, repos.ShopItem, repos.Equipment, repos.EquipmentTemplate, repos.Tx, reputationSvc),
+		Condition:          conditionSvc,
+		Party:              NewPartyService(repos.Character, repos.Party, repos.World),
+		RoomEffect:         roomEffectSvc,
+		Crafting:           NewCraftingService(repos.Character, repos.Room, repos.CraftingRecipe, repos.Competency, repos.Equipment, repos.EquipmentTemplate, repos.Tx, skillXPSvc, combatEngine.InCombat, logger),
+		Loot:               lootSvc,
+		Conversation:       NewConversationService(repos.NPCTemplate, repos.DialogNode, repos.DialogState, repos.Effect, conditionSvc, reputationSvc, logger),
+		Achievement:        NewAchievementService(repos.Character, repos.Achievement, repos.CharacterAchievement),
+		NPCBehavior:        NewNPCBehaviorService(repos.Character, repos.NPCTemplate, repos.Room, repos.CharacterFaction, repos.World, reputationSvc, combatEngine, logger),
+		WorldTime:          NewWorldTimeService(repos.World, repos.Zone, repos.Room, repos.Character, repos.EffectHook, roomEffectSvc, logger),
+		Instance:           NewInstanceService(repos.Zone, repos.ZoneInstance, repos.Room, repos.Character, repos.Equipment, repos.Party, zoneSvc, logger),
+		Mail:               NewMailService(repos.Mail, repos.Character, repos.Room, repos.Equipment, repos.Ignore, repos.Tx, logger),
+		Trade:              NewTradeService(repos.Character, repos.Equipment, repos.Ignore, repos.Tx, logger),
+		Auction:            NewAuctionService(repos.Auction, repos.Character, repos.Room, repos.Equipment, repos.OfflineTell, repos.Tx, logger),
+		Bank:               NewBankService(repos.Stash, repos.Character, repos.Room, repos.Equipment, repos.World, repos.Tx, logger),
+		Exit:               NewExitService(repos.Room, repos.Character, repos.Equipment, repos.EquipmentTemplate, logger),
+		RoomTrigger:        NewRoomTriggerService(repos.Trigger, repos.Character, roomEffectSvc, logger),
+		Reputation:         reputationSvc,
+		House:              NewHouseService(repos.House, repos.Character, repos.Room, repos.Equipment, repos.Zone, repos.Tx, logger),
 		Client:             client,
 	}
 }
\ No newline at end of file
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/schema"
+	"herbst-server/repository"
+)
+
+var (
+	ErrNPCTemplateNotFound = errors.New("npc template not found")
+	ErrNoDialog            = errors.New("npc has no dialog")
+	ErrNoDialogEntry       = errors.New("npc has nothing to say to you")
+	ErrDialogNodeNotFound  = errors.New("dialog node not found")
+	ErrInvalidDialogChoice = errors.New("that is not a valid response")
+)
+
+// Dialog effect types that write the NPC's memory of the character. They
+// are applied by the conversation service; other effect types attached to
+// dialog are left to the caller.
+const (
+	EffectDialogFlagSet    = "dialog_flag_set"    // parameters: flag, value (default true)
+	EffectDialogFlagClear  = "dialog_flag_clear"  // parameters: flag
+	EffectDialogCounterAdd = "dialog_counter_add" // parameters: counter, amount (default 1)
+	EffectDialogCounterSet = "dialog_counter_set" // parameters: counter, amount
+)
+
+// conversationService implements ConversationService using repository interfaces.
+type conversationService struct {
+	npcRepo    repository.NPCTemplateRepo
+	nodeRepo   repository.DialogNodeRepo
+	stateRepo  repository.DialogStateRepo
+	effectRepo repository.EffectRepo
+	conditions ConditionService
+	reputation ReputationService
+	logger     *slog.Logger
+}
+
+// NewConversationService creates a new ConversationService.
+func NewConversationService(
+	npcRepo repository.NPCTemplateRepo,
+	nodeRepo repository.DialogNodeRepo,
+	stateRepo repository.DialogStateRepo,
+	effectRepo repository.EffectRepo,
+	conditions ConditionService,
+	reputation ReputationService,
+	logger *slog.Logger,
+) ConversationService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &conversationService{
+		npcRepo:    npcRepo,
+		nodeRepo:   nodeRepo,
+		stateRepo:  stateRepo,
+		effectRepo: effectRepo,
+		conditions: conditions,
+		reputation: reputation,
+		logger:     logger,
+	}
+}
+
+// Start opens a conversation. The entry node is the first node marked as
+// an entry whose entry_condition holds (or the first node when none are
+// marked). Entry conditions see the state from before this talk, so
+// "!dialog.met" is true on the first meeting. NPCs won't talk to a
+// character hostile with their faction.
+func (s *conversationService) Start(ctx context.Context, charID int, templateID string) (*Conversation, error) {
+	tmpl, err := s.npcRepo.Get(ctx, templateID)
+	if err != nil {
+		return nil, ErrNPCTemplateNotFound
+	}
+	if s.reputation.Tier(ctx, charID, tmpl.Behavior.Faction) == RepHostile {
+		return nil, ErrNPCHostile
+	}
+	nodes, err := s.nodeRepo.ListByTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, ErrNoDialog
+	}
+
+	candidates := make([]*db.DialogNode, 0, len(nodes))
+	for _, n := range nodes {
+		if n.IsEntry {
+			candidates = append(candidates, n)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = nodes[:1]
+	}
+	exprs := make([]string, len(candidates))
+	for i, n := range candidates {
+		exprs[i] = n.EntryCondition
+	}
+	ok, err := s.conditions.EvaluateAll(ctx, exprs, s.conditionInput(charID, templateID))
+	if err != nil {
+		return nil, err
+	}
+	var entry *db.DialogNode
+	for i, n := range candidates {
+		if ok[i] {
+			entry = n
+			break
+		}
+	}
+	if entry == nil {
+		return nil, ErrNoDialogEntry
+	}
+
+	state, err := s.stateRepo.GetOrCreate(ctx, charID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	talks := state.TimesTalked + 1
+	updates := repository.DialogStateUpdates{TimesTalked: &talks, LastNodeID: &entry.ID}
+	if state.FirstMetAt == nil {
+		now := time.Now()
+		updates.FirstMetAt = &now
+	}
+	s.applyStateEffects(ctx, state, &updates, entry.OnEnterEffects)
+	if state, err = s.stateRepo.Update(ctx, state.ID, updates); err != nil {
+		return nil, err
+	}
+	s.logger.Info("conversation started", "character_id", charID, "npc_template_id", templateID, "node_id", entry.ID, "times_talked", talks, slog.String("service", "dialog"))
+	return s.view(ctx, charID, tmpl, nodes, entry.ID, state)
+}
+
+// Choose picks a response from the node. choice is 1-based and counts only
+// the responses the character can see; 0 picks the first of them. The
+// node must be the one the character's conversation with the NPC is at,
+// so entry conditions and the NPC's other nodes can't be skipped to, and
+// NPCs hostile to the character won't carry on talking. The response's
+// dialog state effects are applied, then the next node's.
+func (s *conversationService) Choose(ctx context.Context, charID int, templateID, nodeID string, choice int) (*DialogChoice, error) {
+	tmpl, err := s.npcRepo.Get(ctx, templateID)
+	if err != nil {
+		return nil, ErrNPCTemplateNotFound
+	}
+	if s.reputation.Tier(ctx, charID, tmpl.Behavior.Faction) == RepHostile {
+		return nil, ErrInvalidDialogChoice
+	}
+	if _, err := s.nodeRepo.Get(ctx, nodeID); err != nil {
+		return nil, ErrDialogNodeNotFound
+	}
+	nodes, err := s.nodeRepo.ListByTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	node := findDialogNode(nodes, nodeID)
+	if node == nil {
+		return nil, ErrInvalidDialogChoice
+	}
+	state, err := s.stateRepo.GetOrCreate(ctx, charID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if state.LastNodeID != nodeID {
+		return nil, ErrInvalidDialogChoice
+	}
+
+	visible, err := s.visibleResponses(ctx, charID, templateID, node.Responses)
+	if err != nil {
+		return nil, err
+	}
+	if choice == 0 {
+		choice = 1
+	}
+	if choice < 1 || choice > len(visible) {
+		return nil, ErrInvalidDialogChoice
+	}
+	resp := visible[choice-1]
+	result := &DialogChoice{Response: resp}
+
+	var updates repository.DialogStateUpdates
+	s.applyStateEffects(ctx, state, &updates, resp.Effects)
+
+	var next *db.DialogNode
+	if resp.NextNodeID != "" {
+		next = findDialogNode(nodes, resp.NextNodeID)
+		if next == nil {
+			s.logger.Warn("dialog response leads to a node the NPC does not have", "npc_template_id", templateID, "node_id", nodeID, "next_node_id", resp.NextNodeID, slog.String("service", "dialog"))
+		} else {
+			updates.LastNodeID = &next.ID
+			s.applyStateEffects(ctx, state, &updates, next.OnEnterEffects)
+		}
+	}
+	if state, err = s.stateRepo.Update(ctx, state.ID, updates); err != nil {
+		return nil, err
+	}
+	if next == nil {
+		return result, nil
+	}
+
+	result.NextNode = next
+	if result.Conversation, err = s.view(ctx, charID, tmpl, nodes, next.ID, state); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// view builds the conversation as the character sees it, evaluating every
+// response condition in the tree against one environment.
+func (s *conversationService) view(ctx context.Context, charID int, tmpl *db.NPCTemplate, nodes []*db.DialogNode, currentID string, state *db.DialogState) (*Conversation, error) {
+	var exprs []string
+	for _, n := range nodes {
+		for _, r := range n.Responses {
+			exprs = append(exprs, r.Condition)
+		}
+	}
+	ok, err := s.conditions.EvaluateAll(ctx, exprs, s.conditionInput(charID, tmpl.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	conv := &Conversation{
+		NPCTemplateID: tmpl.ID,
+		NPCName:       tmpl.Name,
+		CurrentNodeID: currentID,
+		Nodes:         make([]*db.DialogNode, 0, len(nodes)),
+		State:         dialogStateView(state),
+	}
+	i := 0
+	for _, n := range nodes {
+		gated := *n
+		gated.Responses = make([]schema.DialogResponse, 0, len(n.Responses))
+		for _, r := range n.Responses {
+			if ok[i] {
+				gated.Responses = append(gated.Responses, r)
+			}
+			i++
+		}
+		conv.Nodes = append(conv.Nodes, &gated)
+	}
+	return conv, nil
+}
+
+// visibleResponses filters a node's responses down to those whose
+// conditions hold for the character.
+func (s *conversationService) visibleResponses(ctx context.Context, charID int, templateID string, responses []schema.DialogResponse) ([]schema.DialogResponse, error) {
+	exprs := make([]string, len(responses))
+	for i, r := range responses {
+		exprs[i] = r.Condition
+	}
+	ok, err := s.conditions.EvaluateAll(ctx, exprs, s.conditionInput(charID, templateID))
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]schema.DialogResponse, 0, len(responses))
+	for i, r := range responses {
+		if ok[i] {
+			visible = append(visible, r)
+		}
+	}
+	return visible, nil
+}
+
+func (s *conversationService) conditionInput(charID int, templateID string) ConditionInput {
+	return ConditionInput{SourceID: charID, NPCTemplateID: templateID}
+}
+
+// applyStateEffects folds the dialog state effects among effectIDs into
+// updates. Effects of other types, and effects that fail to load, are
+// skipped.
+func (s *conversationService) applyStateEffects(ctx context.Context, state *db.DialogState, updates *repository.DialogStateUpdates, effectIDs []int) {
+	for _, id := range effectIDs {
+		eff, err := s.effectRepo.Get(ctx, id)
+		if err != nil {
+			s.logger.Warn("dialog effect not found", "effect_id", id, slog.String("service", "dialog"))
+			continue
+		}
+		switch eff.EffectType {
+		case EffectDialogFlagSet, EffectDialogFlagClear:
+			flag, _ := eff.Parameters["flag"].(string)
+			if flag == "" {
+				continue
+			}
+			if updates.Flags == nil {
+				updates.Flags = copyFlags(state.Flags)
+			}
+			value := eff.EffectType == EffectDialogFlagSet
+			if v, ok := eff.Parameters["value"].(bool); ok && value {
+				value = v
+			}
+			if value {
+				updates.Flags[flag] = true
+			} else {
+				delete(updates.Flags, flag)
+			}
+		case EffectDialogCounterAdd, EffectDialogCounterSet:
+			counter, _ := eff.Parameters["counter"].(string)
+			if counter == "" {
+				continue
+			}
+			if updates.Counters == nil {
+				updates.Counters = copyCounters(state.Counters)
+			}
+			if eff.EffectType == EffectDialogCounterSet {
+				updates.Counters[counter] = effectIntParam(eff.Parameters, "amount")
+				continue
+			}
+			amount := 1
+			if _, ok := eff.Parameters["amount"]; ok {
+				amount = effectIntParam(eff.Parameters, "amount")
+			}
+			updates.Counters[counter] += amount
+		}
+	}
+}
+
+func dialogStateView(state *db.DialogState) DialogStateView {
+	return DialogStateView{
+		Flags:       copyFlags(state.Flags),
+		Counters:    copyCounters(state.Counters),
+		Met:         state.FirstMetAt != nil,
+		TimesTalked: state.TimesTalked,
+		LastNodeID:  state.LastNodeID,
+	}
+}
+
+// findDialogNode returns the node with the given ID, or nil.
+func findDialogNode(nodes []*db.DialogNode, id string) *db.DialogNode {
+	for _, n := range nodes {
+		if n.ID == id {
+			return n
+		}
+	}
+	return nil
+}
+
+func copyFlags(in map[string]bool) map[string]bool {
+	out := make(map[string]bool, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+func copyCounters(in map[string]int) map[string]int {
+	out := make(map[string]int, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"herbst-server/db"
+	"herbst-server/db/npctemplate"
+	"herbst-server/db/schema"
+	"herbst-server/repository"
+)
+
+// stubEffectRepo serves effects from a map; other methods are unused.
+type stubEffectRepo struct {
+	repository.EffectRepo
+	effects map[int]*db.Effect
+}
+
+func (r stubEffectRepo) Get(_ context.Context, id int) (*db.Effect, error) {
+	if eff, ok := r.effects[id]; ok {
+		return eff, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func TestApplyStateEffects(t *testing.T) {
+	effects := stubEffectRepo{effects: map[int]*db.Effect{
+		1: {EffectType: EffectDialogFlagSet, Parameters: map[string]interface{}{"flag": "asked"}},
+		2: {EffectType: EffectDialogFlagClear, Parameters: map[string]interface{}{"flag": "rude"}},
+		3: {EffectType: EffectDialogCounterAdd, Parameters: map[string]interface{}{"counter": "bribes"}},
+		4: {EffectType: EffectDialogCounterAdd, Parameters: map[string]interface{}{"counter": "bribes", "amount": float64(2)}},
+		5: {EffectType: EffectDialogCounterSet, Parameters: map[string]interface{}{"counter": "mood", "amount": float64(-1)}},
+		6: {EffectType: "message", Parameters: map[string]interface{}{"text": "hi"}},
+	}}
+	svc := &conversationService{effectRepo: effects, logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	state := &db.DialogState{
+		Flags:    map[string]bool{"rude": true, "known": true},
+		Counters: map[string]int{"bribes": 1},
+	}
+
+	var updates repository.DialogStateUpdates
+	svc.applyStateEffects(context.Background(), state, &updates, []int{1, 2, 3, 4, 5, 6, 99})
+
+	if !updates.Flags["asked"] || !updates.Flags["known"] || updates.Flags["rude"] {
+		t.Errorf("flags: got %v", updates.Flags)
+	}
+	if updates.Counters["bribes"] != 4 || updates.Counters["mood"] != -1 {
+		t.Errorf("counters: got %v", updates.Counters)
+	}
+	if !state.Flags["rude"] || state.Counters["bribes"] != 1 {
+		t.Errorf("stored state was modified: %v %v", state.Flags, state.Counters)
+	}
+}
+
+// dialogNPC creates an NPC of faction with the given dialog nodes. The
+// first node is the entry.
+func dialogNPC(t *testing.T, client *db.Client, id, faction string, nodes ...*db.DialogNode) {
+	t.Helper()
+	ctx := context.Background()
+	client.NPCTemplate.Create().
+		SetID(id).
+		SetName(id).
+		SetDescription(id).
+		SetDisposition(npctemplate.DispositionFriendly).
+		SetSkills(map[string]int{}).
+		SetTradesWith([]string{}).
+		SetGreeting("Hello.").
+		SetBehavior(schema.NPCBehavior{Faction: faction}).
+		SaveX(ctx)
+	for i, n := range nodes {
+		client.DialogNode.Create().
+			SetID(n.ID).
+			SetNpcTemplateID(id).
+			SetNpcText(n.ID).
+			SetResponses(n.Responses).
+			SetIsEntry(i == 0).
+			SetEntryCondition(n.EntryCondition).
+			SaveX(ctx)
+	}
+}
+
+func TestChooseOnlyFromCurrentNode(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	ann := testCharacter(t, client, "Ann", testRoom(t, client, "Gate").ID, 0)
+	dialogNPC(t, client, "guard", "watch",
+		&db.DialogNode{ID: "guard_hello", Responses: []schema.DialogResponse{{Label: "Any news?", NextNodeID: "guard_news"}}},
+		&db.DialogNode{ID: "guard_news", Responses: []schema.DialogResponse{{Label: "Thanks.", NextNodeID: "guard_hello"}}},
+		&db.DialogNode{ID: "guard_secret", EntryCondition: "false", Responses: []schema.DialogResponse{{Label: "Let me in.", NextNodeID: "guard_hello"}}},
+	)
+	dialogNPC(t, client, "smith", "",
+		&db.DialogNode{ID: "smith_hello", Responses: []schema.DialogResponse{{Label: "Bye.", NextNodeID: "smith_hello"}}},
+	)
+
+	if _, err := svc.Conversation.Start(ctx, ann.ID, "guard"); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	for _, tc := range []struct{ tmpl, node string }{
+		{"guard", "guard_news"},   // in the tree, but not reached yet
+		{"guard", "guard_secret"}, // gated by its entry condition
+		{"guard", "smith_hello"},  // another NPC's node
+		{"smith", "smith_hello"},  // never talked to the smith
+	} {
+		if _, err := svc.Conversation.Choose(ctx, ann.ID, tc.tmpl, tc.node, 1); !errors.Is(err, ErrInvalidDialogChoice) {
+			t.Errorf("choose %s/%s: got %v, want ErrInvalidDialogChoice", tc.tmpl, tc.node, err)
+		}
+	}
+
+	result, err := svc.Conversation.Choose(ctx, ann.ID, "guard", "guard_hello", 1)
+	if err != nil {
+		t.Fatalf("choose from the current node: %v", err)
+	}
+	if result.NextNode == nil || result.NextNode.ID != "guard_news" {
+		t.Fatalf("next node = %v, want guard_news", result.NextNode)
+	}
+	if _, err := svc.Conversation.Choose(ctx, ann.ID, "guard", "guard_hello", 1); !errors.Is(err, ErrInvalidDialogChoice) {
+		t.Errorf("choose from a node already left: got %v, want ErrInvalidDialogChoice", err)
+	}
+
+	if _, err := svc.Reputation.Adjust(ctx, ann.ID, "watch", MinStanding); err != nil {
+		t.Fatalf("adjust reputation: %v", err)
+	}
+	if _, err := svc.Conversation.Choose(ctx, ann.ID, "guard", "guard_news", 1); !errors.Is(err, ErrInvalidDialogChoice) {
+		t.Errorf("choose while hostile: got %v, want ErrInvalidDialogChoice", err)
+	}
+}
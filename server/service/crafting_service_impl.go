@@ -0,0 +1,616 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sync"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/equipment"
+	"herbst-server/events"
+	"herbst-server/repository"
+)
+
+var (
+	ErrRecipeNotFound     = errors.New("recipe not found")
+	ErrNoCraftingStation  = errors.New("missing crafting station")
+	ErrCraftWrongClass    = errors.New("wrong class for recipe")
+	ErrCraftSkillTooLow   = errors.New("not enough skill")
+	ErrMissingIngredient  = errors.New("missing ingredient")
+	ErrAlreadyCrafting    = errors.New("you are already crafting something")
+	ErrCraftInCombat      = errors.New("you can't craft while fighting")
+	ErrNotCrafting        = errors.New("you are not crafting anything")
+	ErrCraftRoomNotFound  = errors.New("room not found")
+	ErrCraftOutputMissing = errors.New("recipe output template missing")
+)
+
+// Craft outcomes.
+const (
+	CraftSuccess = "success"
+	CraftPartial = "partial" // consumed inputs lost, output degraded
+	CraftFailure = "failure" // consumed inputs lost, nothing made
+)
+
+// Craft update stages.
+const (
+	CraftStageProgress  = "progress"
+	CraftStageDone      = "done"
+	CraftStageCancelled = "cancelled"
+)
+
+const (
+	// craftCheckInterval is how often a running job checks that the crafter
+	// hasn't wandered off or been pulled into a fight.
+	craftCheckInterval = time.Second
+	// craftPartialBand is how many points past the success chance a roll
+	// can land and still produce a degraded item.
+	craftPartialBand = 20
+	// craftBaseSkillXP is the skill XP for a successful craft of a level-0
+	// recipe; each required skill level adds craftSkillXPPerLevel.
+	craftBaseSkillXP     = 10
+	craftSkillXPPerLevel = 5
+)
+
+// CraftNotifier is called with progress, completion and cancellation of a
+// character's crafting job.
+type CraftNotifier func(charID int, update CraftUpdate)
+
+// craftJob is a running craft.
+type craftJob struct {
+	view    CraftJob
+	recipe  *db.CraftingRecipe
+	skill   int
+	cancel  chan string
+	stopped bool
+}
+
+// craftingService implements CraftingService using repository interfaces.
+type craftingService struct {
+	charRepo     repository.CharacterRepo
+	roomRepo     repository.RoomRepo
+	recipeRepo   repository.CraftingRecipeRepo
+	compRepo     repository.CompetencyRepo
+	equipRepo    repository.EquipmentRepo
+	templateRepo repository.EquipmentTemplateRepo
+	tx           repository.TransactionRunner
+	skillXP      SkillXPService
+	inCombat     func(charID int) bool
+	logger       *slog.Logger
+
+	mu     sync.Mutex
+	jobs   map[int]*craftJob
+	notify CraftNotifier
+	roll   func() int // 1..100
+}
+
+// NewCraftingService creates a new CraftingService. inCombat reports
+// whether a character is fighting; it may be nil.
+func NewCraftingService(
+	charRepo repository.CharacterRepo,
+	roomRepo repository.RoomRepo,
+	recipeRepo repository.CraftingRecipeRepo,
+	compRepo repository.CompetencyRepo,
+	equipRepo repository.EquipmentRepo,
+	templateRepo repository.EquipmentTemplateRepo,
+	tx repository.TransactionRunner,
+	skillXP SkillXPService,
+	inCombat func(charID int) bool,
+	logger *slog.Logger,
+) CraftingService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if inCombat == nil {
+		inCombat = func(int) bool { return false }
+	}
+	return &craftingService{
+		charRepo:     charRepo,
+		roomRepo:     roomRepo,
+		recipeRepo:   recipeRepo,
+		compRepo:     compRepo,
+		equipRepo:    equipRepo,
+		templateRepo: templateRepo,
+		tx:           tx,
+		skillXP:      skillXP,
+		inCombat:     inCombat,
+		logger:       logger,
+		jobs:         make(map[int]*craftJob),
+		roll:         func() int { return rand.Intn(100) + 1 },
+	}
+}
+
+// SetNotifier registers the function that pushes craft updates to players.
+func (s *craftingService) SetNotifier(fn CraftNotifier) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notify = fn
+}
+
+// Start checks the recipe's requirements and begins crafting it. Recipes
+// without a craft time finish immediately and return their result.
+func (s *craftingService) Start(ctx context.Context, charID int, recipeName string) (*CraftJob, *CraftResult, error) {
+	recipe, err := s.recipeRepo.Get(ctx, recipeName)
+	if err != nil {
+		return nil, nil, ErrRecipeNotFound
+	}
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, nil, ErrCharacterNotFound
+	}
+	if s.inCombat(charID) {
+		return nil, nil, ErrCraftInCombat
+	}
+	skill, err := s.checkRequirements(ctx, char, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.mu.Lock()
+	if _, busy := s.jobs[charID]; busy {
+		s.mu.Unlock()
+		return nil, nil, ErrAlreadyCrafting
+	}
+	if recipe.CraftTimeSecs <= 0 {
+		s.mu.Unlock()
+		result, err := s.finish(ctx, charID, recipe, skill)
+		return nil, result, err
+	}
+	now := time.Now()
+	job := &craftJob{
+		view: CraftJob{
+			CharacterID:  charID,
+			Recipe:       recipe.Name,
+			DisplayName:  recipe.DisplayName,
+			RoomID:       char.CurrentRoomId,
+			StartedAt:    now,
+			FinishesAt:   now.Add(time.Duration(recipe.CraftTimeSecs) * time.Second),
+			DurationSecs: recipe.CraftTimeSecs,
+		},
+		recipe: recipe,
+		skill:  skill,
+		cancel: make(chan string, 1),
+	}
+	s.jobs[charID] = job
+	s.mu.Unlock()
+
+	go s.run(job)
+	view := job.view
+	return &view, nil, nil
+}
+
+// Cancel stops the character's running job. Nothing is consumed.
+func (s *craftingService) Cancel(charID int, reason string) (*CraftJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[charID]
+	if !ok || job.stopped {
+		return nil, ErrNotCrafting
+	}
+	job.stopped = true
+	job.cancel <- reason
+	view := job.view
+	return &view, nil
+}
+
+// Active returns the character's running job.
+func (s *craftingService) Active(charID int) (*CraftJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[charID]
+	if !ok {
+		return nil, false
+	}
+	view := job.view
+	return &view, true
+}
+
+// run drives a job until it finishes or is cancelled, reporting progress
+// at each quarter.
+func (s *craftingService) run(job *craftJob) {
+	charID := job.view.CharacterID
+	ticker := time.NewTicker(craftCheckInterval)
+	defer ticker.Stop()
+	deadline := time.NewTimer(time.Until(job.view.FinishesAt))
+	defer deadline.Stop()
+
+	s.send(charID, CraftUpdate{Stage: CraftStageProgress, Recipe: job.view.Recipe,
+		Message: fmt.Sprintf("You begin crafting %s.", job.view.DisplayName)})
+	reported := 0
+	for {
+		select {
+		case reason := <-job.cancel:
+			s.stop(charID, reason)
+			return
+		case <-ticker.C:
+			if reason := s.interrupted(job); reason != "" {
+				s.mu.Lock()
+				job.stopped = true
+				s.mu.Unlock()
+				s.stop(charID, reason)
+				return
+			}
+			total := job.view.FinishesAt.Sub(job.view.StartedAt)
+			pct := int(time.Since(job.view.StartedAt) * 100 / total)
+			if quarter := pct / 25 * 25; quarter > reported && quarter < 100 {
+				reported = quarter
+				s.send(charID, CraftUpdate{Stage: CraftStageProgress, Recipe: job.view.Recipe, Percent: quarter,
+					Message: fmt.Sprintf("Crafting %s... %d%%", job.view.DisplayName, quarter)})
+			}
+		case <-deadline.C:
+			s.mu.Lock()
+			if job.stopped {
+				s.mu.Unlock()
+				continue // a cancel is waiting on job.cancel
+			}
+			job.stopped = true
+			s.mu.Unlock()
+
+			result, err := s.finish(context.Background(), charID, job.recipe, job.skill)
+			s.mu.Lock()
+			delete(s.jobs, charID)
+			s.mu.Unlock()
+			if err != nil {
+				s.logger.Warn("craft failed to complete", "character_id", charID, "recipe", job.view.Recipe, "error", err, slog.String("service", "crafting"))
+				s.send(charID, CraftUpdate{Stage: CraftStageCancelled, Recipe: job.view.Recipe,
+					Message: fmt.Sprintf("You can't finish crafting %s: %v.", job.view.DisplayName, err)})
+				return
+			}
+			s.send(charID, CraftUpdate{Stage: CraftStageDone, Recipe: job.view.Recipe, Percent: 100, Message: result.Message, Result: result})
+			return
+		}
+	}
+}
+
+// interrupted reports why a job can't go on, or "".
+func (s *craftingService) interrupted(job *craftJob) string {
+	charID := job.view.CharacterID
+	if s.inCombat(charID) {
+		return "You are attacked and stop crafting."
+	}
+	char, err := s.charRepo.Get(context.Background(), charID)
+	if err != nil {
+		return "You stop crafting."
+	}
+	if char.CurrentRoomId != job.view.RoomID {
+		return "You leave your work behind and stop crafting."
+	}
+	return ""
+}
+
+func (s *craftingService) stop(charID int, reason string) {
+	s.mu.Lock()
+	job := s.jobs[charID]
+	delete(s.jobs, charID)
+	s.mu.Unlock()
+	if job == nil {
+		return
+	}
+	if reason == "" {
+		reason = "You stop crafting."
+	}
+	s.logger.Info("craft cancelled", "character_id", charID, "recipe", job.view.Recipe, slog.String("service", "crafting"))
+	s.send(charID, CraftUpdate{Stage: CraftStageCancelled, Recipe: job.view.Recipe, Message: reason})
+}
+
+func (s *craftingService) send(charID int, update CraftUpdate) {
+	s.mu.Lock()
+	fn := s.notify
+	s.mu.Unlock()
+	if fn != nil {
+		fn(charID, update)
+	}
+}
+
+// checkRequirements verifies station, class and skill, and returns the
+// character's level in the recipe's skill.
+func (s *craftingService) checkRequirements(ctx context.Context, char *db.Character, recipe *db.CraftingRecipe) (int, error) {
+	room, err := s.roomRepo.Get(ctx, char.CurrentRoomId)
+	if err != nil {
+		return 0, ErrCraftRoomNotFound
+	}
+	if !roomHasTag(room, recipe.RequiredStationTag) {
+		return 0, fmt.Errorf("%w: this room does not have a %s", ErrNoCraftingStation, recipe.RequiredStationTag)
+	}
+	if recipe.RequiredClass != "" && char.Class != recipe.RequiredClass {
+		return 0, fmt.Errorf("%w: you don't have the required class: %s", ErrCraftWrongClass, recipe.RequiredClass)
+	}
+	level := 0
+	if recipe.RequiredSkill != "" {
+		if comp, err := s.compRepo.GetCharacterCompetency(ctx, char.ID, recipe.RequiredSkill); err == nil && comp != nil {
+			level = comp.Level
+		}
+		if s.skillXP != nil {
+			if skillLevel, err := s.skillXP.GetSkillLevel(ctx, char.ID, recipe.RequiredSkill); err == nil && skillLevel > level {
+				level = skillLevel
+			}
+		}
+		if level < recipe.RequiredSkillLevel {
+			return 0, fmt.Errorf("%w: you don't have enough skill in %s (need %d, have %d)", ErrCraftSkillTooLow, recipe.RequiredSkill, recipe.RequiredSkillLevel, level)
+		}
+	}
+	if _, _, err := s.gatherInputs(ctx, char.ID, recipe); err != nil {
+		return 0, err
+	}
+	return level, nil
+}
+
+// craftInput is a recipe input resolved to a template.
+type craftInput struct {
+	TemplateID int
+	Quantity   int
+	Consumed   bool
+}
+
+// craftOutput is how many of a template a finished craft makes.
+type craftOutput struct {
+	Template *db.EquipmentTemplate
+	Quantity int
+}
+
+// gatherInputs resolves the recipe's inputs and checks the character has
+// them all.
+func (s *craftingService) gatherInputs(ctx context.Context, charID int, recipe *db.CraftingRecipe) ([]craftInput, []*db.Equipment, error) {
+	inventory, err := s.equipRepo.ListByOwner(ctx, charID)
+	if err != nil {
+		return nil, nil, err
+	}
+	available := make(map[int]int)
+	for _, item := range inventory {
+		if item.EquipmentTemplateID > 0 {
+			available[item.EquipmentTemplateID] += item.Quantity
+		}
+	}
+	var inputs []craftInput
+	for _, in := range recipe.Inputs {
+		tmpl, err := s.templateRepo.GetBySlug(ctx, in.EquipmentTemplateSlug, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %s", ErrMissingIngredient, in.EquipmentTemplateSlug)
+		}
+		if available[tmpl.ID] < in.Quantity {
+			return nil, nil, fmt.Errorf("%w: %s", ErrMissingIngredient, tmpl.Name)
+		}
+		inputs = append(inputs, craftInput{TemplateID: tmpl.ID, Quantity: in.Quantity, Consumed: in.Consumed})
+	}
+	return inputs, inventory, nil
+}
+
+// consumeInput uses up one consumed input from the character's inventory.
+// Each stack must still hold what gatherInputs saw, so an item traded or
+// dropped in the meantime rolls the craft back.
+func consumeInput(ctx context.Context, tx *db.Tx, charID int, in craftInput, inventory []*db.Equipment) error {
+	need := in.Quantity
+	for _, item := range inventory {
+		if item.EquipmentTemplateID != in.TemplateID || need <= 0 {
+			continue
+		}
+		var n int
+		var err error
+		if item.Quantity <= need {
+			need -= item.Quantity
+			n, err = tx.Equipment.Delete().
+				Where(equipment.ID(item.ID), equipment.OwnerId(charID), equipment.Quantity(item.Quantity)).
+				Exec(ctx)
+		} else {
+			qty := item.Quantity - need
+			need = 0
+			n, err = tx.Equipment.Update().
+				Where(equipment.ID(item.ID), equipment.OwnerId(charID), equipment.Quantity(item.Quantity)).
+				SetQuantity(qty).
+				Save(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		if n != 1 {
+			return ErrMissingIngredient
+		}
+	}
+	return nil
+}
+
+// finish rolls the outcome, uses up the consumed inputs, makes the output
+// and awards skill XP.
+func (s *craftingService) finish(ctx context.Context, charID int, recipe *db.CraftingRecipe, skill int) (*CraftResult, error) {
+	inputs, inventory, err := s.gatherInputs(ctx, charID, recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CraftResult{Recipe: recipe.Name, Outcome: CraftSuccess}
+	if recipe.RequiredSkill != "" {
+		result.Chance = CraftSuccessChance(skill, recipe.RequiredSkillLevel)
+		result.Roll = s.roll()
+		result.Outcome = CraftOutcome(result.Roll, result.Chance)
+	}
+
+	var outputs []craftOutput
+	if result.Outcome != CraftFailure {
+		for _, out := range recipe.Outputs {
+			tmpl, err := s.templateRepo.GetBySlug(ctx, out.EquipmentTemplateSlug, "")
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrCraftOutputMissing, out.EquipmentTemplateSlug)
+			}
+			qty := out.Quantity
+			if result.Outcome == CraftPartial {
+				qty = (qty + 1) / 2
+			}
+			outputs = append(outputs, craftOutput{Template: tmpl, Quantity: qty})
+		}
+	}
+
+	// Using up the inputs and making the output happen together, so a
+	// failed step can't eat the ingredients without producing anything.
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		for _, in := range inputs {
+			if in.Consumed {
+				if err := consumeInput(ctx, tx, charID, in, inventory); err != nil {
+					return err
+				}
+			}
+		}
+		for _, out := range outputs {
+			for i := 0; i < out.Quantity; i++ {
+				created, err := repository.CreateEquipmentTx(ctx, tx, craftedItem(out.Template, charID, result.Outcome == CraftPartial))
+				if err != nil {
+					return err
+				}
+				result.Outputs = append(result.Outputs, CraftedItem{Name: created.Name, InstanceID: created.ID})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if recipe.RequiredSkill != "" && s.skillXP != nil {
+		xp := CraftSkillXP(recipe.RequiredSkillLevel, result.Outcome)
+		if _, _, _, err := s.skillXP.AwardSkillXP(ctx, charID, recipe.RequiredSkill, xp, "craft:"+recipe.Name); err != nil {
+			s.logger.Warn("craft skill xp not awarded", "character_id", charID, "skill", recipe.RequiredSkill, "error", err, slog.String("service", "crafting"))
+		} else {
+			result.SkillXP = xp
+		}
+	}
+
+	if result.Outcome != CraftFailure {
+		s.countCraft(ctx, charID, recipe.Name)
+	}
+
+	switch result.Outcome {
+	case CraftSuccess:
+		result.Message = fmt.Sprintf("You finish crafting %s.", recipe.DisplayName)
+	case CraftPartial:
+		result.Message = fmt.Sprintf("Your %s comes out crude; some of the materials are wasted.", recipe.DisplayName)
+	default:
+		result.Message = fmt.Sprintf("You botch the %s and ruin the materials.", recipe.DisplayName)
+	}
+	s.logger.Info("item crafted", "character_id", charID, "recipe", recipe.Name, "outcome", result.Outcome, "output_count", len(result.Outputs), slog.String("service", "crafting"))
+	return result, nil
+}
+
+// countCraft adds one to the character's crafted count for the recipe and
+// publishes item.crafted for achievements. A failed update is logged and
+// otherwise ignored; the items are already made.
+func (s *craftingService) countCraft(ctx context.Context, charID int, recipeName string) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		s.logger.Warn("craft not counted", "character_id", charID, "recipe", recipeName, "error", err, slog.String("service", "crafting"))
+		return
+	}
+	counts := make(map[string]int, len(char.CraftedCounts)+1)
+	for k, v := range char.CraftedCounts {
+		counts[k] = v
+	}
+	counts[recipeName]++
+	if _, err := s.charRepo.Update(ctx, charID, repository.CharacterUpdates{CraftedCounts: counts}); err != nil {
+		s.logger.Warn("craft not counted", "character_id", charID, "recipe", recipeName, "error", err, slog.String("service", "crafting"))
+		return
+	}
+	events.Publish(events.Event{
+		Type: events.EventItemCrafted,
+		Payload: map[string]interface{}{
+			"character_id": charID,
+			"recipe":       recipeName,
+			"crafted":      counts[recipeName],
+		},
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
+
+// CraftSuccessChance is the percent chance to craft a recipe cleanly:
+// 60% at exactly the required skill level, 5% more per level above it,
+// between 20% and 95%.
+func CraftSuccessChance(skill, required int) int {
+	chance := 60 + 5*(skill-required)
+	if chance < 20 {
+		return 20
+	}
+	if chance > 95 {
+		return 95
+	}
+	return chance
+}
+
+// CraftOutcome maps a d100 roll against the success chance to an outcome.
+func CraftOutcome(roll, chance int) string {
+	switch {
+	case roll <= chance:
+		return CraftSuccess
+	case roll <= chance+craftPartialBand:
+		return CraftPartial
+	default:
+		return CraftFailure
+	}
+}
+
+// CraftSkillXP is the skill XP for a craft: full for a success, half for a
+// partial failure and a quarter for a failure.
+func CraftSkillXP(requiredLevel int, outcome string) int {
+	xp := craftBaseSkillXP + craftSkillXPPerLevel*requiredLevel
+	switch outcome {
+	case CraftPartial:
+		xp /= 2
+	case CraftFailure:
+		xp /= 4
+	}
+	if xp < 1 {
+		xp = 1
+	}
+	return xp
+}
+
+// craftedItem builds an item from its template. Degraded items are crude:
+// weaker and never better than common.
+func craftedItem(t *db.EquipmentTemplate, ownerID int, degraded bool) repository.CreateEquipmentInput {
+	in := repository.CreateEquipmentInput{
+		Name:                  t.Name,
+		Description:           t.Description,
+		Slot:                  t.Slot,
+		Level:                 t.Level,
+		ItemType:              t.ItemType,
+		ArmorRating:           t.ArmorRating,
+		ArmorType:             t.ArmorType,
+		DamageDiceCount:       t.DamageDiceCount,
+		DamageDiceSides:       t.DamageDiceSides,
+		DamageBonus:           t.DamageBonus,
+		DamageType:            t.DamageType,
+		WeaponType:            t.WeaponType,
+		IsTwoHanded:           t.IsTwoHanded,
+		Stats:                 t.Stats,
+		Rarity:                t.Rarity,
+		SkillRequirement:      t.SkillRequirement,
+		SkillRequirementLevel: t.SkillRequirementLevel,
+		Weight:                t.Weight,
+		IsImmovable:           t.IsImmovable,
+		Color:                 t.Color,
+		IsVisible:             t.IsVisible,
+		EffectType:            t.EffectType,
+		EffectValue:           t.EffectValue,
+		EffectDuration:        t.EffectDuration,
+		EquipmentTemplateID:   &t.ID,
+		OwnerID:               &ownerID,
+	}
+	if degraded {
+		in.Name = "Crude " + t.Name
+		in.Rarity = "common"
+		if in.ArmorRating > 0 {
+			in.ArmorRating--
+		}
+		in.DamageBonus--
+		in.EffectValue /= 2
+	}
+	return in
+}
+
+func roomHasTag(room *db.Room, tag string) bool {
+	for _, t := range room.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/equipment"
+	"herbst-server/db/schema"
+	"herbst-server/repository"
+)
+
+func TestCraftOutcome(t *testing.T) {
+	cases := []struct {
+		skill, required, roll int
+		want                  string
+	}{
+		{5, 5, 60, CraftSuccess},
+		{5, 5, 61, CraftPartial},
+		{5, 5, 80, CraftPartial},
+		{5, 5, 81, CraftFailure},
+		{30, 0, 95, CraftSuccess},
+		{30, 0, 96, CraftPartial},
+		{0, 20, 20, CraftSuccess},
+		{0, 20, 41, CraftFailure},
+	}
+	for _, c := range cases {
+		got := CraftOutcome(c.roll, CraftSuccessChance(c.skill, c.required))
+		if got != c.want {
+			t.Errorf("skill %d vs %d, roll %d: got %s, want %s", c.skill, c.required, c.roll, got, c.want)
+		}
+	}
+}
+
+func TestCraftSkillXP(t *testing.T) {
+	if got := CraftSkillXP(2, CraftSuccess); got != 20 {
+		t.Errorf("success: got %d, want 20", got)
+	}
+	if got := CraftSkillXP(2, CraftPartial); got != 10 {
+		t.Errorf("partial: got %d, want 10", got)
+	}
+	if got := CraftSkillXP(2, CraftFailure); got != 5 {
+		t.Errorf("failure: got %d, want 5", got)
+	}
+}
+
+// craftFixture is a smith at a forge carrying three iron ore, with a recipe
+// that turns two ore into an iron bar.
+type craftFixture struct {
+	crafting CraftingService
+	client   *db.Client
+	smith    *db.Character
+	ore      *db.Equipment
+	fighting atomic.Bool
+	updates  chan CraftUpdate
+}
+
+func newCraftFixture(t *testing.T, craftSecs int, output string) *craftFixture {
+	t.Helper()
+	ctx := context.Background()
+	_, client := newTestServices(t)
+	f := &craftFixture{client: client, updates: make(chan CraftUpdate, 16)}
+	forge := testRoom(t, client, "Forge", "forge")
+	f.smith = testCharacter(t, client, "Smith", forge.ID, 0)
+	var oreTmpl *db.EquipmentTemplate
+	for _, slug := range []string{"iron_ore", "iron_bar"} {
+		tmpl := client.EquipmentTemplate.Create().
+			SetSlug(slug).
+			SetWorldID("").
+			SetName(slug).
+			SetDescription(slug).
+			SetSlot("none").
+			SaveX(ctx)
+		if oreTmpl == nil {
+			oreTmpl = tmpl
+		}
+	}
+	f.ore = client.Equipment.Create().
+		SetName("iron ore").
+		SetDescription("iron ore").
+		SetSlot("none").
+		SetOwnerId(f.smith.ID).
+		SetEquipmentTemplateID(oreTmpl.ID).
+		SetQuantity(3).
+		SaveX(ctx)
+	client.CraftingRecipe.Create().
+		SetName("iron_bar").
+		SetDisplayName("an iron bar").
+		SetRequiredStationTag("forge").
+		SetInputs([]schema.CraftingInput{{EquipmentTemplateSlug: "iron_ore", Quantity: 2, Consumed: true}}).
+		SetOutputs([]schema.CraftingOutput{{EquipmentTemplateSlug: output, Quantity: 1}}).
+		SetCraftTimeSecs(craftSecs).
+		SaveX(ctx)
+
+	repos := repository.NewContainer(client)
+	f.crafting = NewCraftingService(repos.Character, repos.Room, repos.CraftingRecipe, repos.Competency,
+		repos.Equipment, repos.EquipmentTemplate, repos.Tx, nil, func(int) bool { return f.fighting.Load() }, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	f.crafting.SetNotifier(func(_ int, u CraftUpdate) { f.updates <- u })
+	return f
+}
+
+// oreLeft is how much ore the smith still carries.
+func (f *craftFixture) oreLeft(t *testing.T) int {
+	t.Helper()
+	ore, err := f.client.Equipment.Get(context.Background(), f.ore.ID)
+	if err != nil {
+		return 0
+	}
+	return ore.Quantity
+}
+
+// waitFor returns the first update at stage, failing after a few seconds.
+func (f *craftFixture) waitFor(t *testing.T, stage string) CraftUpdate {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case u := <-f.updates:
+			if u.Stage == stage {
+				return u
+			}
+		case <-timeout:
+			t.Fatalf("no %s update", stage)
+		}
+	}
+}
+
+func TestCraftConsumesInputsAndMakesOutput(t *testing.T) {
+	f := newCraftFixture(t, 0, "iron_bar")
+	_, result, err := f.crafting.Start(context.Background(), f.smith.ID, "iron_bar")
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if result.Outcome != CraftSuccess || len(result.Outputs) != 1 {
+		t.Fatalf("got %s with %d outputs, want success with 1", result.Outcome, len(result.Outputs))
+	}
+	if got := f.oreLeft(t); got != 1 {
+		t.Errorf("ore left = %d, want 1", got)
+	}
+	bars := f.client.Equipment.Query().Where(equipment.OwnerId(f.smith.ID), equipment.Name("iron_bar")).CountX(context.Background())
+	if bars != 1 {
+		t.Errorf("bars = %d, want 1", bars)
+	}
+}
+
+func TestCraftMissingOutputKeepsInputs(t *testing.T) {
+	f := newCraftFixture(t, 0, "steel_bar")
+	_, _, err := f.crafting.Start(context.Background(), f.smith.ID, "iron_bar")
+	if !errors.Is(err, ErrCraftOutputMissing) {
+		t.Fatalf("got %v, want ErrCraftOutputMissing", err)
+	}
+	if got := f.oreLeft(t); got != 3 {
+		t.Errorf("ore left = %d, want 3", got)
+	}
+}
+
+func TestCraftCancelledWhenCrafterMoves(t *testing.T) {
+	f := newCraftFixture(t, 30, "iron_bar")
+	if _, _, err := f.crafting.Start(context.Background(), f.smith.ID, "iron_bar"); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	street := testRoom(t, f.client, "Street")
+	f.client.Character.UpdateOneID(f.smith.ID).SetCurrentRoomId(street.ID).ExecX(context.Background())
+
+	f.waitFor(t, CraftStageCancelled)
+	if _, ok := f.crafting.Active(f.smith.ID); ok {
+		t.Error("job still active after moving")
+	}
+	if got := f.oreLeft(t); got != 3 {
+		t.Errorf("ore left = %d, want 3", got)
+	}
+}
+
+func TestCraftCancelledByCombat(t *testing.T) {
+	f := newCraftFixture(t, 30, "iron_bar")
+	if _, _, err := f.crafting.Start(context.Background(), f.smith.ID, "iron_bar"); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	f.fighting.Store(true)
+
+	f.waitFor(t, CraftStageCancelled)
+	if _, ok := f.crafting.Active(f.smith.ID); ok {
+		t.Error("job still active after combat started")
+	}
+	if got := f.oreLeft(t); got != 3 {
+		t.Errorf("ore left = %d, want 3", got)
+	}
+	if _, _, err := f.crafting.Start(context.Background(), f.smith.ID, "iron_bar"); !errors.Is(err, ErrCraftInCombat) {
+		t.Errorf("start while fighting: got %v, want ErrCraftInCombat", err)
+	}
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"herbst-server/db"
+	"herbst-server/db/schema"
+	"herbst-server/repository"
+)
+
+var (
+	ErrNoDoor         = errors.New("there is no door that way")
+	ErrDoorOpen       = errors.New("it is already open")
+	ErrDoorShut       = errors.New("it is already closed")
+	ErrDoorLockedYet  = errors.New("it is already locked")
+	ErrDoorUnlocked   = errors.New("it isn't locked")
+	ErrDoorNoLock     = errors.New("that door has no lock")
+	ErrDoorNoKey      = errors.New("you don't have the key")
+	ErrDoorCloseFirst = errors.New("close it first")
+)
+
+// exitService implements ExitService using repository interfaces.
+type exitService struct {
+	roomRepo      repository.RoomRepo
+	charRepo      repository.CharacterRepo
+	equipRepo     repository.EquipmentRepo
+	equipTmplRepo repository.EquipmentTemplateRepo
+	logger        *slog.Logger
+}
+
+// NewExitService creates a new ExitService.
+func NewExitService(
+	roomRepo repository.RoomRepo,
+	charRepo repository.CharacterRepo,
+	equipRepo repository.EquipmentRepo,
+	equipTmplRepo repository.EquipmentTemplateRepo,
+	logger *slog.Logger,
+) ExitService {
+	return &exitService{
+		roomRepo:      roomRepo,
+		charRepo:      charRepo,
+		equipRepo:     equipRepo,
+		equipTmplRepo: equipTmplRepo,
+		logger:        logger,
+	}
+}
+
+// Exits lists the exits of the character's room they can see.
+func (s *exitService) Exits(ctx context.Context, charID int) ([]ExitView, error) {
+	char, room, err := s.here(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	return VisibleExits(room, char.FoundExits), nil
+}
+
+// Open opens a closed, unlocked door.
+func (s *exitService) Open(ctx context.Context, charID int, dir string) (*ExitView, error) {
+	return s.door(ctx, charID, dir, func(st *schema.ExitState) error {
+		switch {
+		case st.Locked:
+			return ErrExitLocked
+		case !st.Closed:
+			return ErrDoorOpen
+		}
+		st.Closed = false
+		return nil
+	})
+}
+
+// Close shuts an open door.
+func (s *exitService) Close(ctx context.Context, charID int, dir string) (*ExitView, error) {
+	return s.door(ctx, charID, dir, func(st *schema.ExitState) error {
+		if st.Closed {
+			return ErrDoorShut
+		}
+		st.Closed = true
+		return nil
+	})
+}
+
+// Lock locks a closed door with the key the character carries.
+func (s *exitService) Lock(ctx context.Context, charID int, dir string) (*ExitView, error) {
+	return s.door(ctx, charID, dir, func(st *schema.ExitState) error {
+		switch {
+		case st.Key == "":
+			return ErrDoorNoLock
+		case st.Locked:
+			return ErrDoorLockedYet
+		case !st.Closed:
+			return ErrDoorCloseFirst
+		}
+		st.Locked = true
+		return nil
+	})
+}
+
+// Unlock unlocks a locked door with the key the character carries. The
+// door stays closed.
+func (s *exitService) Unlock(ctx context.Context, charID int, dir string) (*ExitView, error) {
+	return s.door(ctx, charID, dir, func(st *schema.ExitState) error {
+		switch {
+		case st.Key == "":
+			return ErrDoorNoLock
+		case !st.Locked:
+			return ErrDoorUnlocked
+		}
+		st.Locked = false
+		return nil
+	})
+}
+
+// Search looks for hidden exits in the character's room. Each one whose
+// threshold perception meets is found for good; the newly found exits are
+// returned.
+func (s *exitService) Search(ctx context.Context, charID int, perception int) ([]ExitView, error) {
+	char, room, err := s.here(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	dirs := make([]string, 0, len(room.ExitStates))
+	for dir := range room.ExitStates {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	found := append([]string{}, char.FoundExits...)
+	var newly []ExitView
+	for _, dir := range dirs {
+		st := room.ExitStates[dir]
+		if !st.Hidden || ExitVisible(room, found, dir) || perception < st.HiddenThreshold {
+			continue
+		}
+		found = append(found, foundExitKey(room.ID, dir))
+		newly = append(newly, exitView(room, dir))
+	}
+	if len(newly) == 0 {
+		return []ExitView{}, nil
+	}
+	if _, err := s.charRepo.Update(ctx, charID, repository.CharacterUpdates{FoundExits: found}); err != nil {
+		return nil, err
+	}
+	return newly, nil
+}
+
+// here loads the character and the room they are in.
+func (s *exitService) here(ctx context.Context, charID int) (*db.Character, *db.Room, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, nil, ErrCharacterNotFound
+	}
+	room, err := s.roomRepo.Get(ctx, char.CurrentRoomId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return char, room, nil
+}
+
+// door applies change to the door dir of the character's room, checking
+// their key when the lock changes, and mirrors the result on the door back
+// unless the exit is one-way.
+func (s *exitService) door(ctx context.Context, charID int, dir string, change func(*schema.ExitState) error) (*ExitView, error) {
+	char, room, err := s.here(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	if !ExitVisible(room, char.FoundExits, dir) {
+		return nil, ErrNoExit
+	}
+	st := room.ExitStates[dir]
+	if !st.Door {
+		return nil, ErrNoDoor
+	}
+	wasLocked := st.Locked
+	if err := change(&st); err != nil {
+		return nil, err
+	}
+	if st.Locked != wasLocked {
+		ok, err := s.hasKey(ctx, charID, room.WorldID, st.Key)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrDoorNoKey
+		}
+	}
+
+	room.ExitStates[dir] = st
+	if _, err := s.roomRepo.Update(ctx, room.ID, repository.RoomUpdates{ExitStates: &room.ExitStates}); err != nil {
+		return nil, err
+	}
+	if !st.OneWay {
+		s.mirror(ctx, room, dir, st)
+	}
+	view := exitView(room, dir)
+	return &view, nil
+}
+
+// mirror copies a door's closed and locked state to the matching door on
+// the other side, if there is one.
+func (s *exitService) mirror(ctx context.Context, room *db.Room, dir string, st schema.ExitState) {
+	back, ok := oppositeDir[dir]
+	if !ok {
+		return
+	}
+	other, err := s.roomRepo.Get(ctx, room.Exits[dir])
+	if err != nil || other.Exits[back] != room.ID {
+		return
+	}
+	ost, ok := other.ExitStates[back]
+	if !ok || !ost.Door {
+		return
+	}
+	ost.Closed, ost.Locked = st.Closed, st.Locked
+	other.ExitStates[back] = ost
+	if _, err := s.roomRepo.Update(ctx, other.ID, repository.RoomUpdates{ExitStates: &other.ExitStates}); err != nil {
+		s.logger.Warn("failed to mirror door", "room_id", other.ID, "direction", back, "error", err, slog.String("service", "exits"))
+	}
+}
+
+// hasKey reports whether the character carries an item made from the key
+// template slug, looked up in the room's world first.
+func (s *exitService) hasKey(ctx context.Context, charID int, worldID, slug string) (bool, error) {
+	tmpl, err := s.equipTmplRepo.GetBySlug(ctx, slug, worldID)
+	if err != nil {
+		tmpl, err = s.equipTmplRepo.GetBySlug(ctx, slug, "")
+	}
+	if err != nil {
+		return false, nil
+	}
+	items, err := s.equipRepo.ListByOwner(ctx, charID)
+	if err != nil {
+		return false, err
+	}
+	for _, it := range items {
+		if it.EquipmentTemplateID == tmpl.ID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
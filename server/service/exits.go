@@ -0,0 +1,155 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"herbst-server/db"
+	"herbst-server/db/schema"
+)
+
+var (
+	ErrNoExit     = errors.New("you can't go that way")
+	ErrExitClosed = errors.New("the door is closed")
+	ErrExitLocked = errors.New("the door is locked")
+)
+
+// Door states shown to players.
+const (
+	ExitOpen   = "open"
+	ExitClosed = "closed"
+	ExitLocked = "locked"
+)
+
+// foundExitKey is how a found hidden exit is recorded on a character.
+func foundExitKey(roomID int, dir string) string {
+	return fmt.Sprintf("%d:%s", roomID, dir)
+}
+
+// ExitVisible reports whether a character who has found the hidden exits in
+// found can see the exit dir of room.
+func ExitVisible(room *db.Room, found []string, dir string) bool {
+	if _, ok := room.Exits[dir]; !ok {
+		return false
+	}
+	st := room.ExitStates[dir]
+	if !st.Hidden {
+		return true
+	}
+	key := foundExitKey(room.ID, dir)
+	for _, f := range found {
+		if f == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ExitTarget returns the room that moving dir from room leads to. A hidden
+// exit the character hasn't found is ErrNoExit, as if it weren't there; a
+// shut door is ErrExitClosed or ErrExitLocked.
+func ExitTarget(room *db.Room, found []string, dir string) (int, error) {
+	if !ExitVisible(room, found, dir) {
+		return 0, ErrNoExit
+	}
+	st := room.ExitStates[dir]
+	switch {
+	case st.Locked:
+		return 0, ErrExitLocked
+	case st.Closed:
+		return 0, ErrExitClosed
+	}
+	return room.Exits[dir], nil
+}
+
+// passableExits are the exits of room not barred by a closed door, which is
+// where NPCs can walk.
+func passableExits(room *db.Room) map[string]int {
+	out := make(map[string]int, len(room.Exits))
+	for dir, to := range room.Exits {
+		if !room.ExitStates[dir].Closed {
+			out[dir] = to
+		}
+	}
+	return out
+}
+
+// VisibleExits lists the exits of room a character can see, sorted by
+// direction.
+func VisibleExits(room *db.Room, found []string) []ExitView {
+	views := make([]ExitView, 0, len(room.Exits))
+	for dir := range room.Exits {
+		if ExitVisible(room, found, dir) {
+			views = append(views, exitView(room, dir))
+		}
+	}
+	sort.Slice(views, func(i, j int) bool { return views[i].Direction < views[j].Direction })
+	return views
+}
+
+func exitView(room *db.Room, dir string) ExitView {
+	st := room.ExitStates[dir]
+	return ExitView{
+		Direction: dir,
+		Target:    room.Exits[dir],
+		Door:      st.Door,
+		State:     exitStateName(st),
+	}
+}
+
+func exitStateName(st schema.ExitState) string {
+	switch {
+	case st.Locked:
+		return ExitLocked
+	case st.Closed:
+		return ExitClosed
+	}
+	return ExitOpen
+}
+
+// ValidateExitStates checks exit states against the exits they describe.
+func ValidateExitStates(exits map[string]int, states map[string]schema.ExitState) []string {
+	var errs []string
+	for dir, st := range states {
+		if _, ok := exits[dir]; !ok {
+			errs = append(errs, fmt.Sprintf("exit state '%s' has no matching exit", dir))
+			continue
+		}
+		if (st.Closed || st.Locked || st.Key != "") && !st.Door {
+			errs = append(errs, fmt.Sprintf("exit '%s' is closed, locked or keyed but is not a door", dir))
+		}
+		if st.Locked && st.Key == "" {
+			errs = append(errs, fmt.Sprintf("exit '%s' is locked but has no key", dir))
+		}
+		if st.HiddenThreshold < 0 {
+			errs = append(errs, fmt.Sprintf("exit '%s' has a negative hidden threshold", dir))
+		}
+	}
+	return errs
+}
+
+// normalizeExitStates drops empty states and makes a locked door closed.
+func normalizeExitStates(states map[string]schema.ExitState) map[string]schema.ExitState {
+	out := make(map[string]schema.ExitState, len(states))
+	for dir, st := range states {
+		if st.Locked {
+			st.Closed = true
+		}
+		if st != (schema.ExitState{}) {
+			out[dir] = st
+		}
+	}
+	return out
+}
+
+// exitStatesFor keeps only the states of exits that are still there.
+func exitStatesFor(exits map[string]int, states map[string]schema.ExitState) map[string]schema.ExitState {
+	out := make(map[string]schema.ExitState, len(states))
+	for dir, st := range states {
+		if _, ok := exits[dir]; ok {
+			out[dir] = st
+		}
+	}
+	return out
+}
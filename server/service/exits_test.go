@@ -0,0 +1,90 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"herbst-server/db"
+	"herbst-server/db/schema"
+)
+
+func exitTestRoom() *db.Room {
+	return &db.Room{
+		ID:    7,
+		Exits: map[string]int{"north": 1, "east": 2, "down": 3, "west": 4},
+		ExitStates: map[string]schema.ExitState{
+			"east": {Door: true, Closed: true},
+			"down": {Door: true, Closed: true, Locked: true, Key: "iron-key"},
+			"west": {Hidden: true, HiddenThreshold: 25},
+		},
+	}
+}
+
+func TestExitTarget(t *testing.T) {
+	room := exitTestRoom()
+	cases := []struct {
+		dir   string
+		found []string
+		to    int
+		err   error
+	}{
+		{"north", nil, 1, nil},
+		{"south", nil, 0, ErrNoExit},
+		{"east", nil, 0, ErrExitClosed},
+		{"down", nil, 0, ErrExitLocked},
+		{"west", nil, 0, ErrNoExit},
+		{"west", []string{"8:west"}, 0, ErrNoExit},
+		{"west", []string{"7:west"}, 4, nil},
+	}
+	for _, c := range cases {
+		to, err := ExitTarget(room, c.found, c.dir)
+		if to != c.to || !errors.Is(err, c.err) {
+			t.Errorf("%s found %v: got %d, %v; want %d, %v", c.dir, c.found, to, err, c.to, c.err)
+		}
+	}
+}
+
+func TestVisibleExits(t *testing.T) {
+	room := exitTestRoom()
+	got := VisibleExits(room, nil)
+	want := []ExitView{
+		{Direction: "down", Target: 3, Door: true, State: ExitLocked},
+		{Direction: "east", Target: 2, Door: true, State: ExitClosed},
+		{Direction: "north", Target: 1, State: ExitOpen},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("exit %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if n := len(VisibleExits(room, []string{"7:west"})); n != 4 {
+		t.Errorf("with the hidden exit found: got %d exits, want 4", n)
+	}
+	if n := len(passableExits(room)); n != 2 {
+		t.Errorf("passable exits: got %d, want 2", n)
+	}
+}
+
+func TestValidateExitStates(t *testing.T) {
+	exits := map[string]int{"north": 1, "east": 2}
+	ok := map[string]schema.ExitState{"north": {Door: true, Locked: true, Key: "k"}, "east": {Hidden: true, HiddenThreshold: 10}}
+	if errs := ValidateExitStates(exits, ok); len(errs) != 0 {
+		t.Errorf("valid states: got %v", errs)
+	}
+	bad := map[string]schema.ExitState{
+		"south": {Door: true},
+		"north": {Closed: true},
+		"east":  {Door: true, Locked: true},
+	}
+	if errs := ValidateExitStates(exits, bad); len(errs) != 3 {
+		t.Errorf("invalid states: got %v, want 3 errors", errs)
+	}
+
+	norm := normalizeExitStates(map[string]schema.ExitState{"north": {Door: true, Locked: true, Key: "k"}, "east": {}})
+	if _, ok := norm["east"]; ok || !norm["north"].Closed {
+		t.Errorf("normalized states: got %+v", norm)
+	}
+}
@@ -0,0 +1,607 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"herbst-server/db"
+	"herbst-server/db/equipment"
+	"herbst-server/db/house"
+	"herbst-server/db/room"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+var (
+	ErrNoPlot        = errors.New("there is no house plot for sale here")
+	ErrPlotDirection = errors.New("there are several plots here; say which way")
+	ErrPlotTaken     = errors.New("that plot has already been sold")
+	ErrPlotSold      = errors.New("a plot with an owner can't be removed")
+	ErrInvalidPlot   = errors.New("invalid house plot")
+	ErrHouseOwned    = errors.New("you already own a house")
+	ErrNoHouse       = errors.New("you don't own a house")
+	ErrNotInHouse    = errors.New("you need to be in your house")
+	ErrHouseRoomName = errors.New("room names must be 1-60 characters")
+	ErrHouseRoomDesc = errors.New("room descriptions can be at most 2000 characters")
+	ErrHouseItem     = errors.New("you can only place unequipped items you carry")
+	ErrNotDecoration = errors.New("that isn't one of your decorations")
+	ErrGuestSelf     = errors.New("you can always enter your own house")
+	ErrNotGuest      = errors.New("they aren't on your guest list")
+	ErrHouseMaxRooms = errors.New("a house can have 1-10 rooms")
+)
+
+// HouseTag marks the rooms of player houses.
+const HouseTag = "house"
+
+// Limits on what owners can call their rooms, and on house sizes.
+const (
+	maxHouseRoomName = 60
+	maxHouseRoomDesc = 2000
+	maxHouseRooms    = 10
+)
+
+// newHouseRoomDesc is the description a house room starts with.
+const newHouseRoomDesc = "A bare, empty room. Its owner has yet to make it their own."
+
+// houseService implements HouseService using repository interfaces.
+type houseService struct {
+	houseRepo repository.HouseRepo
+	charRepo  repository.CharacterRepo
+	roomRepo  repository.RoomRepo
+	equipRepo repository.EquipmentRepo
+	zoneRepo  repository.ZoneRepository
+	tx        repository.TransactionRunner
+	logger    *slog.Logger
+}
+
+// NewHouseService creates a new HouseService.
+func NewHouseService(
+	houseRepo repository.HouseRepo,
+	charRepo repository.CharacterRepo,
+	roomRepo repository.RoomRepo,
+	equipRepo repository.EquipmentRepo,
+	zoneRepo repository.ZoneRepository,
+	tx repository.TransactionRunner,
+	logger *slog.Logger,
+) HouseService {
+	return &houseService{
+		houseRepo: houseRepo,
+		charRepo:  charRepo,
+		roomRepo:  roomRepo,
+		equipRepo: equipRepo,
+		zoneRepo:  zoneRepo,
+		tx:        tx,
+		logger:    logger,
+	}
+}
+
+// Plots lists the plots for sale in the character's room.
+func (s *houseService) Plots(ctx context.Context, charID int) ([]HousePlotView, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	plots, err := s.forSale(ctx, char.CurrentRoomId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]HousePlotView, len(plots))
+	for i, h := range plots {
+		out[i] = plotView(h)
+	}
+	return out, nil
+}
+
+// Buy buys the plot in the given direction from the character's room, or
+// the only one there when dir is empty. The house's rooms are built and
+// the entrance opens onto them as the gold changes hands.
+func (s *houseService) Buy(ctx context.Context, charID int, dir string) (*HouseView, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	if _, err := s.houseRepo.GetByOwner(ctx, charID); err == nil {
+		return nil, ErrHouseOwned
+	} else if !db.IsNotFound(err) {
+		return nil, err
+	}
+	plot, err := s.pickPlot(ctx, char.CurrentRoomId, strings.ToLower(strings.TrimSpace(dir)))
+	if err != nil {
+		return nil, err
+	}
+	back, ok := oppositeDir[plot.Direction]
+	if !ok {
+		return nil, ErrNoPlot
+	}
+
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.House.Update().
+			Where(house.ID(plot.ID), house.OwnerID(0)).
+			SetOwnerID(charID).
+			SetPurchasedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrPlotTaken
+		}
+		if plot.Price > 0 {
+			if err := takeGold(ctx, tx, charID, plot.Price); err != nil {
+				return err
+			}
+		}
+
+		entrance, err := tx.Room.Get(ctx, plot.EntranceRoomID)
+		if err != nil {
+			return err
+		}
+		if _, built := entrance.Exits[plot.Direction]; built {
+			return ErrPlotTaken
+		}
+		roomIDs := make([]int, 0, plot.RoomCount)
+		for i := 0; i < max(1, plot.RoomCount); i++ {
+			name := fmt.Sprintf("%s's House", char.Name)
+			if i > 0 {
+				name = fmt.Sprintf("%s's House, Room %d", char.Name, i+1)
+			}
+			rm, err := tx.Room.Create().
+				SetName(name).
+				SetDescription(newHouseRoomDesc).
+				SetWorldID(entrance.WorldID).
+				SetExits(map[string]int{}).
+				SetZoneIds([]string{plot.ZoneID}).
+				SetTags([]string{HouseTag}).
+				SetHouseID(plot.ID).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			roomIDs = append(roomIDs, rm.ID)
+		}
+		// The rooms run on in the plot's direction: the entrance leads
+		// into the first, and each leads on to the next.
+		for i, id := range roomIDs {
+			exits := map[string]int{back: plot.EntranceRoomID}
+			if i > 0 {
+				exits[back] = roomIDs[i-1]
+			}
+			if i+1 < len(roomIDs) {
+				exits[plot.Direction] = roomIDs[i+1]
+			}
+			if err := tx.Room.UpdateOneID(id).SetExits(exits).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		exits := make(map[string]int, len(entrance.Exits)+1)
+		for d, to := range entrance.Exits {
+			exits[d] = to
+		}
+		exits[plot.Direction] = roomIDs[0]
+		if err := tx.Room.UpdateOneID(entrance.ID).SetExits(exits).Exec(ctx); err != nil {
+			return err
+		}
+		return tx.House.UpdateOneID(plot.ID).SetRoomIds(roomIDs).Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("house bought", "character_id", charID, "house_id", plot.ID, "price", plot.Price, slog.String("service", "houses"))
+	return s.View(ctx, charID)
+}
+
+// View shows the character's house: its rooms, their decorations and the
+// guest list.
+func (s *houseService) View(ctx context.Context, charID int) (*HouseView, error) {
+	h, err := s.owned(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	return s.view(ctx, h), nil
+}
+
+// EditRoom renames and redescribes one of the character's house rooms.
+// Empty fields are left as they are.
+func (s *houseService) EditRoom(ctx context.Context, charID, roomID int, in HouseRoomInput) (*HouseView, error) {
+	h, err := s.owned(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(h.RoomIds, roomID) {
+		return nil, ErrNotInHouse
+	}
+	name, desc := strings.TrimSpace(in.Name), strings.TrimSpace(in.Description)
+	if utf8.RuneCountInString(name) > maxHouseRoomName {
+		return nil, ErrHouseRoomName
+	}
+	if utf8.RuneCountInString(desc) > maxHouseRoomDesc {
+		return nil, ErrHouseRoomDesc
+	}
+	updates := repository.RoomUpdates{}
+	if name != "" {
+		updates.Name = &name
+	}
+	if desc != "" {
+		updates.Description = &desc
+	}
+	if updates.Name == nil && updates.Description == nil {
+		return nil, ErrHouseRoomName
+	}
+	if _, err := s.roomRepo.Update(ctx, roomID, updates); err != nil {
+		return nil, err
+	}
+	return s.view(ctx, h), nil
+}
+
+// Place sets an item the character carries in their house room as a
+// decoration. Decorations are immovable, so nobody can carry them off.
+func (s *houseService) Place(ctx context.Context, charID, itemID int) (*HouseItem, error) {
+	h, roomID, err := s.inOwnHouse(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.equipRepo.Get(ctx, itemID)
+	if err != nil || item.OwnerId == nil || *item.OwnerId != charID || item.IsEquipped {
+		return nil, ErrHouseItem
+	}
+	decorations := copyDecorations(h.Decorations)
+	decorations[item.ID] = item.IsImmovable
+
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.Equipment.Update().
+			Where(equipment.ID(item.ID), equipment.OwnerId(charID), equipment.IsEquipped(false)).
+			ClearOwnerId().
+			SetRoomID(roomID).
+			SetIsImmovable(true).
+			ClearExpiresAt().
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrHouseItem
+		}
+		return tx.House.UpdateOneID(h.ID).SetDecorations(decorations).Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &HouseItem{ID: item.ID, Name: item.Name}, nil
+}
+
+// Retrieve takes a decoration in the character's house room back into
+// their inventory, as movable as it was before it was placed.
+func (s *houseService) Retrieve(ctx context.Context, charID, itemID int) (*HouseItem, error) {
+	h, roomID, err := s.inOwnHouse(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	wasImmovable, ok := h.Decorations[itemID]
+	if !ok {
+		return nil, ErrNotDecoration
+	}
+	item, err := s.equipRepo.Get(ctx, itemID)
+	if err != nil {
+		return nil, ErrNotDecoration
+	}
+	decorations := copyDecorations(h.Decorations)
+	delete(decorations, itemID)
+
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.Equipment.Update().
+			Where(equipment.ID(itemID), equipment.OwnerIdIsNil(), equipment.HasRoomWith(room.ID(roomID))).
+			ClearRoom().
+			SetOwnerId(charID).
+			SetIsImmovable(wasImmovable).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrNotDecoration
+		}
+		return tx.House.UpdateOneID(h.ID).SetDecorations(decorations).Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &HouseItem{ID: item.ID, Name: item.Name}, nil
+}
+
+// AddGuest lets the named character into the house.
+func (s *houseService) AddGuest(ctx context.Context, charID int, name string) (*HouseView, error) {
+	h, err := s.owned(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	guest, err := s.charRepo.GetByName(ctx, strings.TrimSpace(name))
+	if err != nil || guest.IsNPC {
+		return nil, ErrCharacterNotFound
+	}
+	if guest.ID == charID {
+		return nil, ErrGuestSelf
+	}
+	owner, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	if slices.Contains(h.GuestIds, guest.ID) {
+		return s.view(ctx, h), nil
+	}
+	if h, err = s.houseRepo.SetGuests(ctx, h.ID, append(append([]int{}, h.GuestIds...), guest.ID)); err != nil {
+		return nil, err
+	}
+	stream.Default().Send(guest.ID, stream.Event{
+		Type: stream.TypeHouse,
+		Text: fmt.Sprintf("%s has added you to their house's guest list.", owner.Name),
+	})
+	return s.view(ctx, h), nil
+}
+
+// RemoveGuest takes the named character off the guest list. If they are
+// in the house, they are shown out to the entrance.
+func (s *houseService) RemoveGuest(ctx context.Context, charID int, name string) (*HouseView, error) {
+	h, err := s.owned(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	guestID := 0
+	for _, id := range h.GuestIds {
+		if g, err := s.charRepo.Get(ctx, id); err == nil && strings.EqualFold(g.Name, strings.TrimSpace(name)) {
+			guestID = id
+			break
+		}
+	}
+	if guestID == 0 {
+		return nil, ErrNotGuest
+	}
+	guests := slices.DeleteFunc(append([]int{}, h.GuestIds...), func(id int) bool { return id == guestID })
+	if h, err = s.houseRepo.SetGuests(ctx, h.ID, guests); err != nil {
+		return nil, err
+	}
+	s.showOut(ctx, h, guestID)
+	return s.view(ctx, h), nil
+}
+
+// MayEnter reports whether the character may walk into roomID. Public
+// rooms are open to all; house rooms only to the owner and their guests.
+func (s *houseService) MayEnter(ctx context.Context, charID, roomID int) (bool, error) {
+	rm, err := s.roomRepo.Get(ctx, roomID)
+	if err != nil {
+		return false, err
+	}
+	if rm.HouseID == nil {
+		return true, nil
+	}
+	h, err := s.houseRepo.Get(ctx, *rm.HouseID)
+	if err != nil {
+		return false, err
+	}
+	return houseAdmits(h, charID), nil
+}
+
+// ListPlots lists every plot, sold or not.
+func (s *houseService) ListPlots(ctx context.Context) ([]HousePlotView, error) {
+	plots, err := s.houseRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]HousePlotView, len(plots))
+	for i, h := range plots {
+		out[i] = plotView(h)
+	}
+	return out, nil
+}
+
+// CreatePlot puts a plot up for sale. The entrance room must be in the
+// zone and have no exit yet in the plot's direction.
+func (s *houseService) CreatePlot(ctx context.Context, in HousePlotInput) (*HousePlotView, error) {
+	dir := strings.ToLower(strings.TrimSpace(in.Direction))
+	if _, ok := oppositeDir[dir]; !ok {
+		return nil, fmt.Errorf("%w: direction %q is not a compass direction, up or down", ErrInvalidPlot, in.Direction)
+	}
+	if in.Price < 0 {
+		return nil, fmt.Errorf("%w: price must not be negative", ErrInvalidPlot)
+	}
+	if in.RoomCount == 0 {
+		in.RoomCount = 1
+	}
+	if in.RoomCount < 1 || in.RoomCount > maxHouseRooms {
+		return nil, ErrHouseMaxRooms
+	}
+	if _, err := s.zoneRepo.Get(ctx, in.ZoneID); err != nil {
+		return nil, fmt.Errorf("%w: zone %q not found", ErrInvalidPlot, in.ZoneID)
+	}
+	entrance, err := s.roomRepo.Get(ctx, in.EntranceRoomID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: entrance room %d not found", ErrInvalidPlot, in.EntranceRoomID)
+	}
+	switch {
+	case !slices.Contains(entrance.ZoneIds, in.ZoneID):
+		return nil, fmt.Errorf("%w: entrance room %d is not in zone %q", ErrInvalidPlot, entrance.ID, in.ZoneID)
+	case entrance.HouseID != nil || entrance.InstanceID != nil:
+		return nil, fmt.Errorf("%w: the entrance must be a public room", ErrInvalidPlot)
+	}
+	if _, taken := entrance.Exits[dir]; taken {
+		return nil, fmt.Errorf("%w: room %d already has an exit %s", ErrInvalidPlot, entrance.ID, dir)
+	}
+	h, err := s.houseRepo.Create(ctx, repository.CreateHouseInput{
+		ZoneID:         in.ZoneID,
+		EntranceRoomID: entrance.ID,
+		Direction:      dir,
+		Price:          in.Price,
+		RoomCount:      in.RoomCount,
+	})
+	if err != nil {
+		if db.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: there is already a plot %s of room %d", ErrInvalidPlot, dir, entrance.ID)
+		}
+		return nil, err
+	}
+	view := plotView(h)
+	return &view, nil
+}
+
+// DeletePlot takes an unsold plot off the market.
+func (s *houseService) DeletePlot(ctx context.Context, id int) error {
+	h, err := s.houseRepo.Get(ctx, id)
+	if err != nil {
+		return ErrNoPlot
+	}
+	if h.OwnerID != 0 {
+		return ErrPlotSold
+	}
+	return s.houseRepo.Delete(ctx, id)
+}
+
+// forSale lists the unsold plots entered from roomID.
+func (s *houseService) forSale(ctx context.Context, roomID int) ([]*db.House, error) {
+	plots, err := s.houseRepo.ListByEntrance(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(plots, func(h *db.House) bool { return h.OwnerID != 0 }), nil
+}
+
+// pickPlot finds the plot for sale in direction dir from roomID. With no
+// direction, the room must have exactly one plot for sale.
+func (s *houseService) pickPlot(ctx context.Context, roomID int, dir string) (*db.House, error) {
+	plots, err := s.forSale(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		switch len(plots) {
+		case 0:
+			return nil, ErrNoPlot
+		case 1:
+			return plots[0], nil
+		default:
+			return nil, ErrPlotDirection
+		}
+	}
+	for _, h := range plots {
+		if h.Direction == dir {
+			return h, nil
+		}
+	}
+	return nil, ErrNoPlot
+}
+
+// owned loads the character's house.
+func (s *houseService) owned(ctx context.Context, charID int) (*db.House, error) {
+	if _, err := s.charRepo.Get(ctx, charID); err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	h, err := s.houseRepo.GetByOwner(ctx, charID)
+	if db.IsNotFound(err) {
+		return nil, ErrNoHouse
+	}
+	return h, err
+}
+
+// inOwnHouse loads the character's house, checking they are standing in
+// one of its rooms, and returns that room.
+func (s *houseService) inOwnHouse(ctx context.Context, charID int) (*db.House, int, error) {
+	h, err := s.owned(ctx, charID)
+	if err != nil {
+		return nil, 0, err
+	}
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, 0, ErrCharacterNotFound
+	}
+	if !slices.Contains(h.RoomIds, char.CurrentRoomId) {
+		return nil, 0, ErrNotInHouse
+	}
+	return h, char.CurrentRoomId, nil
+}
+
+// showOut moves charID from any of the house's rooms to its entrance.
+func (s *houseService) showOut(ctx context.Context, h *db.House, charID int) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil || !slices.Contains(h.RoomIds, char.CurrentRoomId) {
+		return
+	}
+	to := h.EntranceRoomID
+	if _, err := s.charRepo.Update(ctx, charID, repository.CharacterUpdates{CurrentRoomID: &to}); err != nil {
+		s.logger.Warn("failed to show guest out", "character_id", charID, "house_id", h.ID, "error", err, slog.String("service", "houses"))
+		return
+	}
+	stream.Default().Send(charID, stream.Event{Type: stream.TypeHouse, Text: "You are no longer welcome here, and are shown out."})
+	stream.Default().ToRoom(ctx, to, stream.Event{Type: stream.TypeHouse, Text: fmt.Sprintf("%s is shown out of a house.", char.Name), ActorID: charID}, charID)
+}
+
+func (s *houseService) view(ctx context.Context, h *db.House) *HouseView {
+	v := &HouseView{
+		ID:             h.ID,
+		ZoneID:         h.ZoneID,
+		EntranceRoomID: h.EntranceRoomID,
+		Direction:      h.Direction,
+		Rooms:          make([]HouseRoomView, 0, len(h.RoomIds)),
+		Guests:         make([]HouseGuest, 0, len(h.GuestIds)),
+	}
+	byRoom := map[int][]HouseItem{}
+	ids := make([]int, 0, len(h.Decorations))
+	for id := range h.Decorations {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		item, err := s.equipRepo.Get(ctx, id)
+		if err != nil {
+			continue
+		}
+		if rm, err := item.QueryRoom().OnlyID(ctx); err == nil {
+			byRoom[rm] = append(byRoom[rm], HouseItem{ID: item.ID, Name: item.Name})
+		}
+	}
+	for _, id := range h.RoomIds {
+		rv := HouseRoomView{ID: id, Decorations: byRoom[id]}
+		if rm, err := s.roomRepo.Get(ctx, id); err == nil {
+			rv.Name, rv.Description = rm.Name, rm.Description
+		}
+		if rv.Decorations == nil {
+			rv.Decorations = []HouseItem{}
+		}
+		v.Rooms = append(v.Rooms, rv)
+	}
+	for _, id := range h.GuestIds {
+		if g, err := s.charRepo.Get(ctx, id); err == nil {
+			v.Guests = append(v.Guests, HouseGuest{ID: g.ID, Name: g.Name})
+		}
+	}
+	return v
+}
+
+// houseAdmits reports whether charID may enter h's rooms.
+func houseAdmits(h *db.House, charID int) bool {
+	return h.OwnerID == charID || slices.Contains(h.GuestIds, charID)
+}
+
+func plotView(h *db.House) HousePlotView {
+	return HousePlotView{
+		ID:             h.ID,
+		ZoneID:         h.ZoneID,
+		EntranceRoomID: h.EntranceRoomID,
+		Direction:      h.Direction,
+		Price:          h.Price,
+		RoomCount:      h.RoomCount,
+		OwnerID:        h.OwnerID,
+	}
+}
+
+func copyDecorations(in map[int]bool) map[int]bool {
+	out := make(map[int]bool, len(in)+1)
+	for id, was := range in {
+		out[id] = was
+	}
+	return out
+}
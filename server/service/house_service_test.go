@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"herbst-server/db"
+)
+
+// housePlot puts a two-room plot north of a street up for 100 gold and
+// has Ann, carrying gold, stand on the street.
+func housePlot(t *testing.T, client *db.Client, gold int) (street *db.Room, plot *db.House, ann *db.Character) {
+	t.Helper()
+	street = testRoom(t, client, "Street")
+	plot = client.House.Create().
+		SetZoneID("town").
+		SetEntranceRoomID(street.ID).
+		SetDirection("north").
+		SetPrice(100).
+		SetRoomCount(2).
+		SaveX(context.Background())
+	return street, plot, testCharacter(t, client, "Ann", street.ID, gold)
+}
+
+func TestHouseBuy(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	street, plot, ann := housePlot(t, client, 150)
+
+	view, err := svc.House.Buy(ctx, ann.ID, "")
+	if err != nil {
+		t.Fatalf("buy: %v", err)
+	}
+	if len(view.Rooms) != 2 {
+		t.Fatalf("house has %d rooms, want 2", len(view.Rooms))
+	}
+	if got := goldOf(t, client, ann); got != 50 {
+		t.Errorf("Ann's gold = %d, want 50", got)
+	}
+	h := client.House.GetX(ctx, plot.ID)
+	if h.OwnerID != ann.ID || len(h.RoomIds) != 2 {
+		t.Errorf("house owner %d with rooms %v, want Ann with 2 rooms", h.OwnerID, h.RoomIds)
+	}
+	if to := client.Room.GetX(ctx, street.ID).Exits["north"]; to != h.RoomIds[0] {
+		t.Errorf("street leads north to %d, want %d", to, h.RoomIds[0])
+	}
+	first := client.Room.GetX(ctx, h.RoomIds[0])
+	if first.HouseID == nil || *first.HouseID != plot.ID || first.Exits["south"] != street.ID || first.Exits["north"] != h.RoomIds[1] {
+		t.Errorf("first room not linked into the house: house %v, exits %v", first.HouseID, first.Exits)
+	}
+
+	client.House.Create().
+		SetZoneID("town").
+		SetEntranceRoomID(street.ID).
+		SetDirection("east").
+		SetPrice(0).
+		SaveX(ctx)
+	if _, err := svc.House.Buy(ctx, ann.ID, "east"); !errors.Is(err, ErrHouseOwned) {
+		t.Errorf("second buy: got %v, want ErrHouseOwned", err)
+	}
+}
+
+func TestHouseBuyWithoutGoldChangesNothing(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	street, plot, ann := housePlot(t, client, 50)
+
+	if _, err := svc.House.Buy(ctx, ann.ID, "north"); !errors.Is(err, ErrInsufficientGold) {
+		t.Fatalf("got %v, want ErrInsufficientGold", err)
+	}
+	if h := client.House.GetX(ctx, plot.ID); h.OwnerID != 0 || len(h.RoomIds) != 0 {
+		t.Errorf("plot changed: owner %d, rooms %v", h.OwnerID, h.RoomIds)
+	}
+	if n := client.Room.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d rooms, want only the street", n)
+	}
+	if _, ok := client.Room.GetX(ctx, street.ID).Exits["north"]; ok {
+		t.Error("street gained an exit north")
+	}
+	if got := goldOf(t, client, ann); got != 50 {
+		t.Errorf("Ann's gold = %d, want 50", got)
+	}
+}
+
+func TestHousePlaceAndRetrieve(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	_, plot, ann := housePlot(t, client, 100)
+	if _, err := svc.House.Buy(ctx, ann.ID, ""); err != nil {
+		t.Fatalf("buy: %v", err)
+	}
+	vase := testItem(t, client, "vase", ann.ID)
+	statue := client.Equipment.Create().
+		SetName("statue").
+		SetDescription("statue").
+		SetSlot("none").
+		SetOwnerId(ann.ID).
+		SetIsImmovable(true).
+		SaveX(ctx)
+
+	if _, err := svc.House.Place(ctx, ann.ID, vase.ID); !errors.Is(err, ErrNotInHouse) {
+		t.Errorf("place on the street: got %v, want ErrNotInHouse", err)
+	}
+	home := client.House.GetX(ctx, plot.ID).RoomIds[1]
+	client.Character.UpdateOneID(ann.ID).SetCurrentRoomId(home).ExecX(ctx)
+
+	for _, it := range []*db.Equipment{vase, statue} {
+		if _, err := svc.House.Place(ctx, ann.ID, it.ID); err != nil {
+			t.Fatalf("place %s: %v", it.Name, err)
+		}
+	}
+	placed := client.Equipment.GetX(ctx, vase.ID)
+	if placed.OwnerId != nil || !placed.IsImmovable {
+		t.Errorf("placed vase: owner %v, immovable %v; want room-held and immovable", placed.OwnerId, placed.IsImmovable)
+	}
+	if rm, err := placed.QueryRoom().Only(ctx); err != nil || rm.ID != home {
+		t.Errorf("placed vase not in room %d: %v", home, err)
+	}
+
+	if _, err := svc.House.Retrieve(ctx, ann.ID, testItem(t, client, "cup", ann.ID).ID); !errors.Is(err, ErrNotDecoration) {
+		t.Errorf("retrieve a carried item: got %v, want ErrNotDecoration", err)
+	}
+	for _, it := range []*db.Equipment{vase, statue} {
+		if _, err := svc.House.Retrieve(ctx, ann.ID, it.ID); err != nil {
+			t.Fatalf("retrieve %s: %v", it.Name, err)
+		}
+		got := client.Equipment.GetX(ctx, it.ID)
+		if got.OwnerId == nil || *got.OwnerId != ann.ID || got.IsImmovable != it.IsImmovable {
+			t.Errorf("retrieved %s: owner %v, immovable %v; want Ann and %v", it.Name, got.OwnerId, got.IsImmovable, it.IsImmovable)
+		}
+	}
+	if h := client.House.GetX(ctx, plot.ID); len(h.Decorations) != 0 {
+		t.Errorf("decorations left: %v", h.Decorations)
+	}
+	if _, err := svc.House.Retrieve(ctx, ann.ID, vase.ID); !errors.Is(err, ErrNotDecoration) {
+		t.Errorf("second retrieve: got %v, want ErrNotDecoration", err)
+	}
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"herbst-server/db"
+)
+
+func TestHouseAdmits(t *testing.T) {
+	h := &db.House{OwnerID: 1, GuestIds: []int{2}}
+	if !houseAdmits(h, 1) || !houseAdmits(h, 2) {
+		t.Error("the owner and guests should be let in")
+	}
+	if houseAdmits(h, 3) {
+		t.Error("strangers should be kept out")
+	}
+}
+
+func TestCreatePlotValidation(t *testing.T) {
+	s := &houseService{}
+	for _, in := range []HousePlotInput{
+		{Direction: "sideways", Price: 100},
+		{Direction: "north", Price: -1},
+	} {
+		if _, err := s.CreatePlot(context.Background(), in); !errors.Is(err, ErrInvalidPlot) {
+			t.Errorf("%+v: got %v, want ErrInvalidPlot", in, err)
+		}
+	}
+}
+
+func TestCopyDecorations(t *testing.T) {
+	orig := map[int]bool{1: true}
+	cp := copyDecorations(orig)
+	cp[2] = false
+	if len(orig) != 1 || !cp[1] {
+		t.Errorf("copy should keep entries without touching the original: %v %v", orig, cp)
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"herbst-server/db"
 	"herbst-server/db/schema"
@@ -168,6 +169,196 @@ type ChatService interface {
 	DeliverQueuedTells(ctx context.Context, charID int) ([]QueuedTell, error)
 }
 
+// ConditionService validates and evaluates EffectHook/Trigger condition
+// expressions (see package condition).
+type ConditionService interface {
+	Validate(expr string) error
+	Evaluate(ctx context.Context, expr string, in ConditionInput) (bool, error)
+	// EvaluateAll evaluates several expressions against one environment.
+	// Expressions that fail to compile or evaluate come back false.
+	EvaluateAll(ctx context.Context, exprs []string, in ConditionInput) ([]bool, error)
+}
+
+// ShopService handles buying from and selling to shopkeeper NPCs.
+type ShopService interface {
+	ListWares(ctx context.Context, charID int) (*ShopView, error)
+	Buy(ctx context.Context, charID int, itemName string, quantity int) (*ShopTradeResult, error)
+	Sell(ctx context.Context, charID int, itemName string) (*ShopTradeResult, error)
+	Appraise(ctx context.Context, charID int, itemName string) (*ShopAppraisal, error)
+	Restock(ctx context.Context, shopID int) (int, error)
+	RestockDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// PartyService manages player groups: invites, membership and leadership.
+type PartyService interface {
+	Get(ctx context.Context, charID int) (*PartyView, error)
+	MemberIDs(ctx context.Context, charID int) ([]int, error)
+	Invite(ctx context.Context, charID int, targetName string) (*PartyUpdate, error)
+	Accept(ctx context.Context, charID int) (*PartyUpdate, error)
+	Leave(ctx context.Context, charID int) (*PartyUpdate, error)
+	Kick(ctx context.Context, charID int, targetName string) (*PartyUpdate, error)
+}
+
+// CraftingService runs timed crafting jobs: one per character, cancelled
+// when the crafter moves or is drawn into combat.
+type CraftingService interface {
+	Start(ctx context.Context, charID int, recipeName string) (*CraftJob, *CraftResult, error)
+	Cancel(charID int, reason string) (*CraftJob, error)
+	Active(charID int) (*CraftJob, bool)
+	SetNotifier(fn CraftNotifier)
+}
+
+// LootService generates equipment drops for NPCs that die.
+type LootService interface {
+	DropLoot(ctx context.Context, npc *db.Character) ([]LootItem, error)
+}
+
+// ConversationService runs NPC dialog trees for a character: it picks the
+// entry node, hides responses whose conditions fail and keeps what each NPC
+// template remembers about the character between talks.
+type ConversationService interface {
+	Start(ctx context.Context, charID int, templateID string) (*Conversation, error)
+	Choose(ctx context.Context, charID int, templateID, nodeID string, choice int) (*DialogChoice, error)
+}
+
+// AchievementService reports a character's achievement progress and manages
+// the title earned achievements let them display.
+type AchievementService interface {
+	List(ctx context.Context, charID int) (*AchievementsView, error)
+	SetTitle(ctx context.Context, charID int, title string) (string, error)
+}
+
+// NPCBehaviorService runs the behavior scripts on NPC templates: daily
+// schedules, attacking players, and joining allies' fights. Fleeing is
+// handled by the combat engine.
+type NPCBehaviorService interface {
+	Tick(ctx context.Context, now time.Time) error
+	PlayerEntered(ctx context.Context, charID, roomID int)
+}
+
+// WorldTimeService runs each world's clock and its zones' weather. Tick
+// re-rolls weather that is due and, when a world moves into a new phase of
+// the day, announces it outdoors and fires that phase's hooks.
+type WorldTimeService interface {
+	Tick(ctx context.Context, now time.Time) error
+	Now(ctx context.Context, worldID int) (GameTime, error)
+	RoomConditions(ctx context.Context, roomID int) (RoomConditions, error)
+}
+
+// InstanceService runs private copies of instanced zones. Portal routes a
+// character through a zone's portal into their party's copy, opening one if
+// needed; Tick resets copies and closes those that expired or sat empty.
+type InstanceService interface {
+	Portal(ctx context.Context, charID, fromRoomID int, dir string) (int, bool, error)
+	Tick(ctx context.Context, now time.Time) error
+	List(ctx context.Context, zoneID string) ([]*db.ZoneInstance, error)
+	Close(ctx context.Context, id int) error
+}
+
+// MailService delivers player mail. Mail is sent and its gold and items
+// collected at mailboxes; unclaimed mail goes back to its sender.
+type MailService interface {
+	Inbox(ctx context.Context, charID int) (*InboxView, error)
+	Read(ctx context.Context, charID, mailID int) (*MailView, error)
+	Send(ctx context.Context, charID int, in SendMailInput) (*MailView, error)
+	Collect(ctx context.Context, charID, mailID int) (*MailCollectResult, error)
+	Return(ctx context.Context, charID, mailID int) error
+	Delete(ctx context.Context, charID, mailID int) error
+	ExpireDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// AuctionService runs the world-wide auction house: players list items
+// with a starting bid and an optional buyout, bid on each other's items and
+// collect their proceeds after tax.
+type AuctionService interface {
+	Browse(ctx context.Context, charID int, search string) ([]AuctionView, error)
+	Mine(ctx context.Context, charID int) (*MyAuctionsView, error)
+	List(ctx context.Context, charID int, in ListAuctionInput) (*AuctionView, error)
+	Bid(ctx context.Context, charID, auctionID, amount int) (*AuctionView, error)
+	Buyout(ctx context.Context, charID, auctionID int) (*AuctionView, error)
+	Cancel(ctx context.Context, charID, auctionID int) error
+	Collect(ctx context.Context, charID int) (int, error)
+	SettleDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// TradeService runs trades between two players in the same room. Both add
+// items and gold, both confirm, and everything changes hands at once.
+type TradeService interface {
+	Get(ctx context.Context, charID int) (*TradeView, error)
+	Request(ctx context.Context, charID int, targetName string) (*TradeUpdate, error)
+	Offer(ctx context.Context, charID int, in TradeOfferInput) (*TradeUpdate, error)
+	Confirm(ctx context.Context, charID int) (*TradeUpdate, error)
+	Cancel(ctx context.Context, charID int) (*TradeUpdate, error)
+}
+
+// BankService runs bank rooms: characters deposit and withdraw gold and
+// items in their own stash, or in an account stash shared by all of a
+// user's characters in the world, and pay gold to make stashes bigger.
+type BankService interface {
+	View(ctx context.Context, charID int) (*BankView, error)
+	Deposit(ctx context.Context, charID int, in BankTransferInput) (*BankView, error)
+	Withdraw(ctx context.Context, charID int, in BankTransferInput) (*BankView, error)
+	Expand(ctx context.Context, charID int, account bool) (*BankView, error)
+}
+
+// HouseService sells house plots and lets their owners furnish them and
+// choose who may come in. Admins put plots up for sale.
+type HouseService interface {
+	Plots(ctx context.Context, charID int) ([]HousePlotView, error)
+	Buy(ctx context.Context, charID int, dir string) (*HouseView, error)
+	View(ctx context.Context, charID int) (*HouseView, error)
+	EditRoom(ctx context.Context, charID, roomID int, in HouseRoomInput) (*HouseView, error)
+	Place(ctx context.Context, charID, itemID int) (*HouseItem, error)
+	Retrieve(ctx context.Context, charID, itemID int) (*HouseItem, error)
+	AddGuest(ctx context.Context, charID int, name string) (*HouseView, error)
+	RemoveGuest(ctx context.Context, charID int, name string) (*HouseView, error)
+	MayEnter(ctx context.Context, charID, roomID int) (bool, error)
+	ListPlots(ctx context.Context) ([]HousePlotView, error)
+	CreatePlot(ctx context.Context, in HousePlotInput) (*HousePlotView, error)
+	DeletePlot(ctx context.Context, id int) error
+}
+
+// ExitService works room exits that are more than open passages: players
+// open, close, lock and unlock doors, and search rooms for hidden exits.
+type ExitService interface {
+	Exits(ctx context.Context, charID int) ([]ExitView, error)
+	Open(ctx context.Context, charID int, dir string) (*ExitView, error)
+	Close(ctx context.Context, charID int, dir string) (*ExitView, error)
+	Lock(ctx context.Context, charID int, dir string) (*ExitView, error)
+	Unlock(ctx context.Context, charID int, dir string) (*ExitView, error)
+	Search(ctx context.Context, charID int, perception int) ([]ExitView, error)
+}
+
+// RoomEffectService applies hook and trigger effects to everyone in a room,
+// or to a single character.
+type RoomEffectService interface {
+	ApplyRoomHook(ctx context.Context, hookID, sourceID, roomID int, extras map[string]interface{}) (*RoomEffectResult, error)
+	ApplyHook(ctx context.Context, hookID, sourceID, charID int, extras map[string]interface{}) (bool, error)
+	ApplyTrigger(ctx context.Context, t *db.Trigger, sourceID, roomID int, extras map[string]interface{}) (*RoomEffectResult, error)
+}
+
+// ReputationService keeps each character's standing with factions. Tiers
+// of standing decide how a faction's NPCs treat the character: whether
+// they attack, talk or trade, and at what prices.
+type ReputationService interface {
+	Standings(ctx context.Context, charID int) ([]ReputationStanding, error)
+	Tier(ctx context.Context, charID int, faction string) string
+	Adjust(ctx context.Context, charID int, faction string, delta int) (*ReputationStanding, error)
+	AwardKill(ctx context.Context, charID int, npcTemplateID string) error
+	AwardQuest(ctx context.Context, charID, questID int) error
+}
+
+// RoomTriggerService fires the room triggers the server watches for itself:
+// characters entering and leaving, speech, dropped items and timers. The
+// event methods are fire-and-forget; failures are logged.
+type RoomTriggerService interface {
+	Entered(ctx context.Context, charID, roomID int)
+	Left(ctx context.Context, charID, roomID int)
+	Said(ctx context.Context, charID, roomID int, message string)
+	Dropped(ctx context.Context, charID, roomID int, itemName string)
+	Tick(ctx context.Context, now time.Time) error
+}
+
 // ReclassReraceService handles reclassing (faction switch with skill retention) and reracing (race change with stat recalc).
 type ReclassReraceService interface {
 	Reclass(ctx context.Context, characterID int, newFactionID int) error
@@ -261,6 +452,7 @@ type CreateRoomInput struct {
 	IsStartingRoom bool
 	IsRootRoom     bool
 	Exits          map[string]int
+	ExitStates     map[string]schema.ExitState
 	Atmosphere     string
 	PosX           int
 	PosY           int
@@ -276,6 +468,7 @@ type UpdateRoomInput struct {
 	IsStartingRoom *bool
 	IsRootRoom     *bool
 	Exits          *map[string]int
+	ExitStates     *map[string]schema.ExitState
 	Atmosphere     *string
 	PosX           *int
 	PosY           *int
@@ -456,4 +649,444 @@ type QueuedTell struct {
 	RecipientName string `json:"recipient_name"`
 	Message       string `json:"message"`
 	QueuedAt      string `json:"queued_at"`
-}
\ No newline at end of file
+}
+
+// PartyView is a party and its members, leader first.
+type PartyView struct {
+	ID       int           `json:"id"`
+	LeaderID int           `json:"leader_id"`
+	Members  []PartyMember `json:"members"`
+}
+
+// LeaderName returns the name of the party leader.
+func (p *PartyView) LeaderName() string {
+	for _, m := range p.Members {
+		if m.Leader {
+			return m.Name
+		}
+	}
+	return ""
+}
+
+// MemberIDs returns every member's ID except the given one.
+func (p *PartyView) MemberIDs(except int) []int {
+	ids := make([]int, 0, len(p.Members))
+	for _, m := range p.Members {
+		if m.ID != except {
+			ids = append(ids, m.ID)
+		}
+	}
+	return ids
+}
+
+// PartyMember is one member of a party.
+type PartyMember struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Level  int    `json:"level"`
+	HP     int    `json:"hp"`
+	MaxHP  int    `json:"max_hp"`
+	RoomID int    `json:"room_id"`
+	Leader bool   `json:"leader"`
+}
+
+// PartyUpdate is the outcome of a party command: the party afterwards (nil
+// if it was disbanded or never formed), a message for the acting character
+// and a notice for the other characters affected.
+type PartyUpdate struct {
+	Party   *PartyView `json:"party,omitempty"`
+	Message string     `json:"message"`
+	Notice  string     `json:"-"`
+	Notify  []int      `json:"-"`
+}
+
+// ShopView is the stock of a shop as seen by a customer.
+type ShopView struct {
+	ShopID         int        `json:"shop_id"`
+	ShopName       string     `json:"shop_name"`
+	ShopkeeperID   int        `json:"shopkeeper_id"`
+	ShopkeeperName string     `json:"shopkeeper_name"`
+	Wares          []ShopWare `json:"wares"`
+}
+
+// ShopWare is a single item for sale.
+type ShopWare struct {
+	ShopItemID          int    `json:"shop_item_id"`
+	EquipmentTemplateID int    `json:"equipment_template_id"`
+	Name                string `json:"name"`
+	Category            string `json:"category,omitempty"`
+	Price               int    `json:"price"`
+	Quantity            int    `json:"quantity"`
+}
+
+// ShopTradeResult is returned by buy and sell operations.
+type ShopTradeResult struct {
+	ItemName    string `json:"item_name"`
+	ItemIDs     []int  `json:"item_ids"`
+	Quantity    int    `json:"quantity"`
+	Gold        int    `json:"gold"`
+	GoldCredits int    `json:"gold_credits"`
+	Message     string `json:"message"`
+}
+
+// ShopAppraisal is what a shopkeeper would pay for an item.
+type ShopAppraisal struct {
+	ItemID   int    `json:"item_id"`
+	ItemName string `json:"item_name"`
+	Value    int    `json:"value"`
+	Offer    int    `json:"offer"`
+	WillBuy  bool   `json:"will_buy"`
+	Stocked  bool   `json:"stocked"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// ConditionInput identifies the entities a condition is evaluated against.
+// Zero values are left null in the expression environment; RoomID defaults
+// to the source character's current room.
+type ConditionInput struct {
+	SourceID      int                    `json:"source_id"`
+	TargetID      int                    `json:"target_id"`
+	RoomID        int                    `json:"room_id"`
+	NPCTemplateID string                 `json:"npc_template_id"`
+	Extras        map[string]interface{} `json:"extras"`
+}
+
+// ReputationStanding is a character's standing with one faction.
+type ReputationStanding struct {
+	Faction  string `json:"faction"`
+	Standing int    `json:"standing"`
+	Tier     string `json:"tier"`
+}
+
+// HousePlotInput puts a plot up for sale: a house entered by going
+// Direction from EntranceRoomID, built with RoomCount rooms (default 1).
+type HousePlotInput struct {
+	ZoneID         string `json:"zone_id"`
+	EntranceRoomID int    `json:"entrance_room_id"`
+	Direction      string `json:"direction"`
+	Price          int    `json:"price"`
+	RoomCount      int    `json:"room_count"`
+}
+
+// HousePlotView is a plot. OwnerID is 0 while it is for sale.
+type HousePlotView struct {
+	ID             int    `json:"id"`
+	ZoneID         string `json:"zone_id"`
+	EntranceRoomID int    `json:"entrance_room_id"`
+	Direction      string `json:"direction"`
+	Price          int    `json:"price"`
+	RoomCount      int    `json:"room_count"`
+	OwnerID        int    `json:"owner_id"`
+}
+
+// HouseRoomInput renames or redescribes a house room. Empty fields are
+// left as they are.
+type HouseRoomInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// HouseView is a house as its owner sees it.
+type HouseView struct {
+	ID             int             `json:"id"`
+	ZoneID         string          `json:"zone_id"`
+	EntranceRoomID int             `json:"entrance_room_id"`
+	Direction      string          `json:"direction"`
+	Rooms          []HouseRoomView `json:"rooms"`
+	Guests         []HouseGuest    `json:"guests"`
+}
+
+// HouseRoomView is one room of a house and the decorations placed in it.
+type HouseRoomView struct {
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Decorations []HouseItem `json:"decorations"`
+}
+
+// HouseItem is an item placed in a house as decoration.
+type HouseItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// HouseGuest is a character on a house's guest list.
+type HouseGuest struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// RoomEffectResult reports which occupants a room effect reached.
+type RoomEffectResult struct {
+	RoomID     int                `json:"room_id"`
+	EffectID   int                `json:"effect_id"`
+	EffectType string             `json:"effect_type"`
+	Message    string             `json:"message,omitempty"`
+	Targets    []RoomEffectTarget `json:"targets"`
+}
+
+// RoomEffectTarget is one occupant affected by a room effect.
+type RoomEffectTarget struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	IsNPC bool   `json:"is_npc"`
+}
+
+// CraftJob is a crafting job in progress.
+type CraftJob struct {
+	CharacterID  int       `json:"character_id"`
+	Recipe       string    `json:"recipe"`
+	DisplayName  string    `json:"display_name"`
+	RoomID       int       `json:"room_id"`
+	StartedAt    time.Time `json:"started_at"`
+	FinishesAt   time.Time `json:"finishes_at"`
+	DurationSecs int       `json:"duration_secs"`
+}
+
+// CraftResult is the outcome of a finished craft.
+type CraftResult struct {
+	Recipe  string        `json:"recipe"`
+	Outcome string        `json:"outcome"`
+	Chance  int           `json:"chance,omitempty"`
+	Roll    int           `json:"roll,omitempty"`
+	Outputs []CraftedItem `json:"outputs"`
+	SkillXP int           `json:"skill_xp,omitempty"`
+	Message string        `json:"message"`
+}
+
+// CraftedItem is an item made by a craft.
+type CraftedItem struct {
+	Name       string `json:"name"`
+	InstanceID int    `json:"instance_id"`
+}
+
+// CraftUpdate reports a crafting job's progress to the crafter.
+type CraftUpdate struct {
+	Stage   string       `json:"stage"`
+	Recipe  string       `json:"recipe"`
+	Percent int          `json:"percent,omitempty"`
+	Message string       `json:"message"`
+	Result  *CraftResult `json:"result,omitempty"`
+}
+
+// LootItem is an item generated by an NPC's loot table.
+type LootItem struct {
+	ID      int           `json:"id"`
+	Name    string        `json:"name"`
+	Rarity  string        `json:"rarity"`
+	Affixes []RolledAffix `json:"affixes,omitempty"`
+}
+
+// Conversation is a dialog tree as one character sees it. Nodes carry only
+// the responses the character may pick, in their original order.
+type Conversation struct {
+	NPCTemplateID string           `json:"npc_template_id"`
+	NPCName       string           `json:"npc_name"`
+	CurrentNodeID string           `json:"current_node_id"`
+	Nodes         []*db.DialogNode `json:"nodes"`
+	State         DialogStateView  `json:"state"`
+}
+
+// DialogChoice is the result of picking a response. NextNode and
+// Conversation are nil when the response ends the conversation.
+type DialogChoice struct {
+	Response     schema.DialogResponse `json:"response"`
+	NextNode     *db.DialogNode        `json:"next_node,omitempty"`
+	Conversation *Conversation         `json:"conversation,omitempty"`
+}
+
+// DialogStateView is what an NPC template remembers about a character.
+type DialogStateView struct {
+	Flags       map[string]bool `json:"flags"`
+	Counters    map[string]int  `json:"counters"`
+	Met         bool            `json:"met"`
+	TimesTalked int             `json:"times_talked"`
+	LastNodeID  string          `json:"last_node_id,omitempty"`
+}
+
+// AchievementsView is a character's achievements and current title.
+type AchievementsView struct {
+	Title        string                `json:"title"`
+	Achievements []AchievementProgress `json:"achievements"`
+}
+
+// AchievementProgress is one achievement and how far a character is towards it.
+type AchievementProgress struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Icon        string  `json:"icon,omitempty"`
+	Title       string  `json:"title,omitempty"`
+	XPReward    int     `json:"xp_reward"`
+	Progress    int     `json:"progress"`
+	Target      int     `json:"target"`
+	Completed   bool    `json:"completed"`
+	CompletedAt *string `json:"completed_at,omitempty"`
+}
+
+func (p AchievementProgress) fraction() float64 {
+	if p.Target <= 0 {
+		return 0
+	}
+	return float64(p.Progress) / float64(p.Target)
+}
+
+// SendMailInput is a mail to send. Gold and items are attached from the
+// sender's purse and inventory; COD is what the recipient pays to collect
+// the items.
+type SendMailInput struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	Gold    int    `json:"gold"`
+	COD     int    `json:"cod"`
+	ItemIDs []int  `json:"item_ids"`
+}
+
+// InboxView is a character's mail, newest first.
+type InboxView struct {
+	Mail   []MailView `json:"mail"`
+	Unread int        `json:"unread"`
+}
+
+// MailView is one mail and whatever is still attached to it.
+type MailView struct {
+	ID        int        `json:"id"`
+	From      string     `json:"from"`
+	FromID    int        `json:"from_id"`
+	Subject   string     `json:"subject"`
+	Body      string     `json:"body,omitempty"`
+	Gold      int        `json:"gold,omitempty"`
+	COD       int        `json:"cod,omitempty"`
+	Items     []MailItem `json:"items,omitempty"`
+	Read      bool       `json:"read"`
+	Returned  bool       `json:"returned,omitempty"`
+	SentAt    time.Time  `json:"sent_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+}
+
+// MailItem is an item attached to a mail.
+type MailItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// MailCollectResult is what collecting a mail's attachments gave and cost.
+type MailCollectResult struct {
+	Gold    int        `json:"gold,omitempty"`
+	Items   []MailItem `json:"items,omitempty"`
+	CODPaid int        `json:"cod_paid,omitempty"`
+}
+
+// ListAuctionInput puts an item up for auction. Buyout 0 means no buyout;
+// Hours 0 means the default duration.
+type ListAuctionInput struct {
+	ItemID   int `json:"item_id"`
+	StartBid int `json:"start_bid"`
+	Buyout   int `json:"buyout"`
+	Hours    int `json:"hours"`
+}
+
+// AuctionView is one auction as a character sees it. Proceeds are only
+// shown to the seller.
+type AuctionView struct {
+	ID        int       `json:"id"`
+	Item      string    `json:"item"`
+	ItemID    int       `json:"item_id"`
+	Seller    string    `json:"seller"`
+	StartBid  int       `json:"start_bid"`
+	Buyout    int       `json:"buyout,omitempty"`
+	Bid       int       `json:"bid"`
+	MinBid    int       `json:"min_bid,omitempty"`
+	Winning   bool      `json:"winning"`
+	Status    string    `json:"status"`
+	Proceeds  int       `json:"proceeds,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// MyAuctionsView is a character's own auctions, the auctions they hold the
+// high bid on and the proceeds waiting to be collected.
+type MyAuctionsView struct {
+	Selling  []AuctionView `json:"selling"`
+	Bidding  []AuctionView `json:"bidding"`
+	Proceeds int           `json:"proceeds"`
+}
+
+// TradeOfferInput changes one side of a trade. Gold replaces the gold on
+// offer when set.
+type TradeOfferInput struct {
+	Gold   *int  `json:"gold"`
+	Add    []int `json:"add"`
+	Remove []int `json:"remove"`
+}
+
+// TradeView is an open trade as one of the traders sees it.
+type TradeView struct {
+	With   string         `json:"with"`
+	WithID int            `json:"with_id"`
+	You    TradeOfferView `json:"you"`
+	Them   TradeOfferView `json:"them"`
+}
+
+// TradeOfferView is one side of a trade.
+type TradeOfferView struct {
+	Gold      int         `json:"gold"`
+	Items     []TradeItem `json:"items"`
+	Confirmed bool        `json:"confirmed"`
+}
+
+// TradeItem is an item on offer in a trade. Quantity is the stack size
+// the other side saw; the trade fails if it has changed by the end.
+type TradeItem struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+}
+
+// TradeUpdate is the result of a trade action: the trade as it now stands,
+// a message for the caller and a notice for the other trader.
+type TradeUpdate struct {
+	Trade   *TradeView `json:"trade,omitempty"`
+	Message string     `json:"message"`
+	Notice  string     `json:"-"`
+	Notify  []int      `json:"-"`
+}
+
+// BankTransferInput moves gold and items into or out of a stash. Account
+// picks the shared account stash instead of the character's own.
+type BankTransferInput struct {
+	Account bool  `json:"account"`
+	Gold    int   `json:"gold"`
+	ItemIDs []int `json:"item_ids"`
+}
+
+// BankView is what a character sees at a bank: the gold they carry, their
+// stash and, in worlds that have one, their account stash.
+type BankView struct {
+	CarriedGold int        `json:"carried_gold"`
+	Stash       StashView  `json:"stash"`
+	Account     *StashView `json:"account,omitempty"`
+}
+
+// StashView is one stash. ExpandCost is -1 when it can't get any bigger.
+type StashView struct {
+	Gold       int         `json:"gold"`
+	Slots      int         `json:"slots"`
+	Items      []StashItem `json:"items"`
+	ExpandCost int         `json:"expand_cost"`
+}
+
+// StashItem is an item kept in a stash.
+type StashItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ExitView is an exit as a character sees it. State is open, closed or
+// locked; exits that aren't doors are always open.
+type ExitView struct {
+	Direction string `json:"direction"`
+	Target    int    `json:"target"`
+	Door      bool   `json:"door,omitempty"`
+	State     string `json:"state"`
+}
@@ -0,0 +1,392 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"herbst-server/combat"
+	"herbst-server/constants"
+	"herbst-server/db"
+	"herbst-server/repository"
+)
+
+// Affix positions decide where an affix's name goes on the item.
+const (
+	AffixPrefix = "prefix" // "Flaming Iron Sword"
+	AffixSuffix = "suffix" // "Iron Sword of the Bear"
+)
+
+// Item kinds an affix can apply to (LootAffix.AppliesTo).
+const (
+	AffixForWeapon = "weapon"
+	AffixForArmor  = "armor"
+	AffixForAny    = "any"
+)
+
+// Stat keys written into Equipment.Stats by affixes that combat reads.
+// Any other stat key ("strength", "dexterity", ...) is a stat bonus.
+const (
+	AffixStatArmor  = "armor"  // added to the item's armor rating
+	AffixStatDamage = "damage" // added to the weapon's damage bonus
+
+	// Extra damage dice are stored as "<type>_damage_dice" (count) and
+	// "<type>_damage_sides", e.g. fire_damage_dice=1, fire_damage_sides=4.
+	affixDiceSuffix  = "_damage_dice"
+	affixSidesSuffix = "_damage_sides"
+)
+
+// LootAffix is one entry in a world's affix pool (world config
+// loot.affixes). An affix either adds Min..Max to Stat or, when DamageType
+// is set, adds DiceCount d DiceSides extra damage of that type.
+type LootAffix struct {
+	Name       string `json:"name"`
+	Position   string `json:"position"`
+	AppliesTo  string `json:"applies_to"`
+	MinRarity  string `json:"min_rarity"`
+	Weight     int    `json:"weight"`
+	Stat       string `json:"stat"`
+	Min        int    `json:"min"`
+	Max        int    `json:"max"`
+	DamageType string `json:"damage_type"`
+	DiceCount  int    `json:"dice_count"`
+	DiceSides  int    `json:"dice_sides"`
+}
+
+// RolledAffix is an affix applied to a dropped item.
+type RolledAffix struct {
+	Name       string `json:"name"`
+	Position   string `json:"position"`
+	Stat       string `json:"stat,omitempty"`
+	Value      int    `json:"value,omitempty"`
+	DamageType string `json:"damage_type,omitempty"`
+	DiceCount  int    `json:"dice_count,omitempty"`
+	DiceSides  int    `json:"dice_sides,omitempty"`
+}
+
+// LootConfig is the "loot" section of a world's config.
+type LootConfig struct {
+	// Rarity weights tiers for loot tables that don't set their own.
+	Rarity map[string]int `json:"rarity"`
+	// AffixCount is how many affixes an item of each tier gets.
+	AffixCount map[string]int `json:"affix_count"`
+	// Affixes is the pool affixes are drawn from.
+	Affixes []LootAffix `json:"affixes"`
+}
+
+// DefaultLootConfig is used for worlds without a loot section.
+var DefaultLootConfig = LootConfig{
+	Rarity:     map[string]int{"common": 70, "uncommon": 20, "rare": 8, "epic": 2},
+	AffixCount: map[string]int{"common": 0, "uncommon": 1, "rare": 2, "epic": 3, "legendary": 4},
+	Affixes: []LootAffix{
+		{Name: "Sturdy", Position: AffixPrefix, AppliesTo: AffixForArmor, Stat: AffixStatArmor, Min: 1, Max: 2},
+		{Name: "Keen", Position: AffixPrefix, AppliesTo: AffixForWeapon, Stat: AffixStatDamage, Min: 1, Max: 3},
+		{Name: "Flaming", Position: AffixPrefix, AppliesTo: AffixForWeapon, MinRarity: "rare", DamageType: "fire", DiceCount: 1, DiceSides: 4},
+		{Name: "Frozen", Position: AffixPrefix, AppliesTo: AffixForWeapon, MinRarity: "rare", DamageType: "cold", DiceCount: 1, DiceSides: 4},
+		{Name: "of the Bear", Position: AffixSuffix, AppliesTo: AffixForAny, Stat: "strength", Min: 1, Max: 3},
+		{Name: "of the Fox", Position: AffixSuffix, AppliesTo: AffixForAny, Stat: "dexterity", Min: 1, Max: 3},
+		{Name: "of the Owl", Position: AffixSuffix, AppliesTo: AffixForAny, Stat: "intelligence", Min: 1, Max: 3},
+	},
+}
+
+// ParseLootConfig reads the loot section of a world config map. Missing
+// parts fall back to DefaultLootConfig.
+func ParseLootConfig(worldCfg map[string]interface{}) LootConfig {
+	cfg := DefaultLootConfig
+	section, ok := worldCfg["loot"].(map[string]interface{})
+	if !ok {
+		return cfg
+	}
+	raw, err := json.Marshal(section)
+	if err != nil {
+		return cfg
+	}
+	var parsed LootConfig
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return cfg
+	}
+	if len(parsed.Rarity) > 0 {
+		cfg.Rarity = parsed.Rarity
+	}
+	if len(parsed.AffixCount) > 0 {
+		cfg.AffixCount = parsed.AffixCount
+	}
+	if parsed.Affixes != nil {
+		cfg.Affixes = parsed.Affixes
+	}
+	return cfg
+}
+
+// lootService implements LootService using repository interfaces.
+type lootService struct {
+	npcTmplRepo  repository.NPCTemplateRepo
+	templateRepo repository.EquipmentTemplateRepo
+	equipRepo    repository.EquipmentRepo
+	worldRepo    repository.WorldRepo
+	logger       *slog.Logger
+	intn         func(n int) int
+}
+
+// NewLootService creates a new LootService.
+func NewLootService(
+	npcTmplRepo repository.NPCTemplateRepo,
+	templateRepo repository.EquipmentTemplateRepo,
+	equipRepo repository.EquipmentRepo,
+	worldRepo repository.WorldRepo,
+	logger *slog.Logger,
+) LootService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &lootService{
+		npcTmplRepo:  npcTmplRepo,
+		templateRepo: templateRepo,
+		equipRepo:    equipRepo,
+		worldRepo:    worldRepo,
+		logger:       logger,
+		intn:         rand.Intn,
+	}
+}
+
+// DropLoot rolls the NPC template's loot table and gives the items to the
+// NPC, so they end up in its corpse with the rest of its gear.
+func (s *lootService) DropLoot(ctx context.Context, npc *db.Character) ([]LootItem, error) {
+	if npc.NpcTemplateID == "" {
+		return nil, nil
+	}
+	tmpl, err := s.npcTmplRepo.Get(ctx, npc.NpcTemplateID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tmpl.LootTable.Drops) == 0 {
+		return nil, nil
+	}
+	cfg := s.config(ctx, npc, tmpl)
+	weights := tmpl.LootTable.Rarity
+	if len(weights) == 0 {
+		weights = cfg.Rarity
+	}
+
+	var dropped []LootItem
+	for _, drop := range tmpl.LootTable.Drops {
+		chance := drop.Chance
+		if chance <= 0 {
+			chance = 100
+		}
+		if s.intn(100) >= chance {
+			continue
+		}
+		itemTmpl, err := s.templateRepo.GetBySlug(ctx, drop.Template, "")
+		if err != nil {
+			s.logger.Warn("loot template not found", "npc_template_id", tmpl.ID, "template", drop.Template, slog.String("service", "loot"))
+			continue
+		}
+		for i := 0; i < lootCount(drop.CountMin, drop.CountMax, s.intn); i++ {
+			rarity := RollRarity(weights, s.intn)
+			affixes := RollAffixes(cfg.Affixes, itemKind(itemTmpl), rarity, cfg.AffixCount[rarity], s.intn)
+			input := craftedItem(itemTmpl, npc.ID, false)
+			input.Rarity = rarity
+			input.Name, input.Stats = ApplyAffixes(itemTmpl.Name, itemTmpl.Stats, affixes)
+			created, err := s.equipRepo.Create(ctx, input)
+			if err != nil {
+				return dropped, fmt.Errorf("create loot %s: %w", drop.Template, err)
+			}
+			dropped = append(dropped, LootItem{ID: created.ID, Name: created.Name, Rarity: rarity, Affixes: affixes})
+		}
+	}
+	if len(dropped) > 0 {
+		s.logger.Info("loot dropped", "npc_id", npc.ID, "npc_template_id", tmpl.ID, "count", len(dropped), slog.String("service", "loot"))
+	}
+	return dropped, nil
+}
+
+// config loads the loot config of the NPC's world.
+func (s *lootService) config(ctx context.Context, npc *db.Character, tmpl *db.NPCTemplate) LootConfig {
+	worldID := npc.WorldID
+	if worldID == 0 {
+		worldID, _ = strconv.Atoi(tmpl.WorldID)
+	}
+	if worldID == 0 || s.worldRepo == nil {
+		return DefaultLootConfig
+	}
+	w, err := s.worldRepo.Get(ctx, worldID)
+	if err != nil {
+		return DefaultLootConfig
+	}
+	return ParseLootConfig(w.Config)
+}
+
+// lootCount rolls how many of a drop to make.
+func lootCount(min, max int, intn func(int) int) int {
+	if min <= 0 {
+		min = 1
+	}
+	if max <= min {
+		return min
+	}
+	return min + intn(max-min+1)
+}
+
+// rarityRank orders rarities; unknown tiers rank as common.
+func rarityRank(rarity string) int {
+	for i, r := range constants.RarityCatalog {
+		if r == rarity {
+			return i
+		}
+	}
+	return 0
+}
+
+// RollRarity picks a rarity tier by weight. Tiers are considered in
+// catalog order so the same roll always gives the same tier.
+func RollRarity(weights map[string]int, intn func(int) int) string {
+	total := 0
+	for _, r := range constants.RarityCatalog {
+		if w := weights[r]; w > 0 {
+			total += w
+		}
+	}
+	if total == 0 {
+		return "common"
+	}
+	roll := intn(total)
+	for _, r := range constants.RarityCatalog {
+		w := weights[r]
+		if w <= 0 {
+			continue
+		}
+		if roll < w {
+			return r
+		}
+		roll -= w
+	}
+	return "common"
+}
+
+// itemKind classifies a template for affix matching.
+func itemKind(t *db.EquipmentTemplate) string {
+	switch {
+	case t.DamageDiceCount > 0:
+		return AffixForWeapon
+	case t.ArmorRating > 0 || t.ArmorType != "":
+		return AffixForArmor
+	default:
+		return AffixForAny
+	}
+}
+
+// RollAffixes draws up to n distinct affixes from the pool that fit the
+// item kind and rarity, weighted by LootAffix.Weight (default 1), and
+// rolls their values.
+func RollAffixes(pool []LootAffix, kind, rarity string, n int, intn func(int) int) []RolledAffix {
+	var eligible []LootAffix
+	for _, a := range pool {
+		if a.AppliesTo != "" && a.AppliesTo != AffixForAny && a.AppliesTo != kind {
+			continue
+		}
+		if a.MinRarity != "" && rarityRank(rarity) < rarityRank(a.MinRarity) {
+			continue
+		}
+		eligible = append(eligible, a)
+	}
+
+	var out []RolledAffix
+	for len(out) < n && len(eligible) > 0 {
+		total := 0
+		for _, a := range eligible {
+			total += affixWeight(a)
+		}
+		roll := intn(total)
+		pick := 0
+		for i, a := range eligible {
+			if roll < affixWeight(a) {
+				pick = i
+				break
+			}
+			roll -= affixWeight(a)
+		}
+		a := eligible[pick]
+		eligible = append(eligible[:pick], eligible[pick+1:]...)
+
+		rolled := RolledAffix{Name: a.Name, Position: a.Position}
+		if a.DamageType != "" {
+			rolled.DamageType = a.DamageType
+			rolled.DiceCount = a.DiceCount
+			rolled.DiceSides = a.DiceSides
+		} else {
+			rolled.Stat = a.Stat
+			rolled.Value = a.Min
+			if a.Max > a.Min {
+				rolled.Value += intn(a.Max - a.Min + 1)
+			}
+		}
+		out = append(out, rolled)
+	}
+	return out
+}
+
+func affixWeight(a LootAffix) int {
+	if a.Weight <= 0 {
+		return 1
+	}
+	return a.Weight
+}
+
+// ApplyAffixes returns the item's name with the first prefix and suffix
+// added, and a copy of its stats with every affix written in.
+func ApplyAffixes(name string, base map[string]int, affixes []RolledAffix) (string, map[string]int) {
+	stats := make(map[string]int, len(base)+len(affixes))
+	for k, v := range base {
+		stats[k] = v
+	}
+	var prefix, suffix string
+	for _, a := range affixes {
+		switch {
+		case a.Position == AffixPrefix && prefix == "":
+			prefix = a.Name
+		case a.Position == AffixSuffix && suffix == "":
+			suffix = a.Name
+		}
+		if a.DamageType != "" {
+			stats[a.DamageType+affixDiceSuffix] += a.DiceCount
+			if a.DiceSides > stats[a.DamageType+affixSidesSuffix] {
+				stats[a.DamageType+affixSidesSuffix] = a.DiceSides
+			}
+			continue
+		}
+		if a.Stat != "" {
+			stats[a.Stat] += a.Value
+		}
+	}
+	if prefix != "" {
+		name = prefix + " " + name
+	}
+	if suffix != "" {
+		name = name + " " + suffix
+	}
+	return name, stats
+}
+
+// ExtraDamageDice reads the extra damage dice affixes wrote into an
+// item's stats, sorted by damage type.
+func ExtraDamageDice(stats map[string]int) []combat.ExtraDamage {
+	var out []combat.ExtraDamage
+	for key, count := range stats {
+		if !strings.HasSuffix(key, affixDiceSuffix) || count <= 0 {
+			continue
+		}
+		damageType := strings.TrimSuffix(key, affixDiceSuffix)
+		sides := stats[damageType+affixSidesSuffix]
+		if sides <= 0 {
+			continue
+		}
+		out = append(out, combat.ExtraDamage{Type: damageType, DiceCount: count, DiceSides: sides})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Type < out[j].Type })
+	return out
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+
+	"herbst-server/combat"
+)
+
+// seq returns an intn that yields the given values in order, each clamped
+// below n.
+func seq(values ...int) func(int) int {
+	i := 0
+	return func(n int) int {
+		v := values[i%len(values)]
+		i++
+		if v >= n {
+			return n - 1
+		}
+		return v
+	}
+}
+
+func TestRollRarity(t *testing.T) {
+	weights := map[string]int{"common": 70, "uncommon": 20, "rare": 8, "epic": 2}
+	cases := map[int]string{0: "common", 69: "common", 70: "uncommon", 89: "uncommon", 90: "rare", 98: "epic", 99: "epic"}
+	for roll, want := range cases {
+		if got := RollRarity(weights, seq(roll)); got != want {
+			t.Errorf("roll %d: got %s, want %s", roll, got, want)
+		}
+	}
+	if got := RollRarity(nil, seq(0)); got != "common" {
+		t.Errorf("no weights: got %s, want common", got)
+	}
+}
+
+func TestRollAffixesRespectsKindAndRarity(t *testing.T) {
+	pool := []LootAffix{
+		{Name: "Sturdy", Position: AffixPrefix, AppliesTo: AffixForArmor, Stat: AffixStatArmor, Min: 1, Max: 1},
+		{Name: "Flaming", Position: AffixPrefix, AppliesTo: AffixForWeapon, MinRarity: "rare", DamageType: "fire", DiceCount: 1, DiceSides: 4},
+		{Name: "of the Bear", Position: AffixSuffix, AppliesTo: AffixForAny, Stat: "strength", Min: 1, Max: 3},
+	}
+
+	got := RollAffixes(pool, AffixForWeapon, "uncommon", 2, seq(0))
+	if len(got) != 1 || got[0].Name != "of the Bear" || got[0].Value != 1 {
+		t.Fatalf("uncommon weapon: got %+v, want only 'of the Bear' +1", got)
+	}
+
+	got = RollAffixes(pool, AffixForWeapon, "rare", 2, seq(0, 0, 2))
+	if len(got) != 2 || got[0].Name != "Flaming" || got[1].Name != "of the Bear" || got[1].Value != 3 {
+		t.Fatalf("rare weapon: got %+v", got)
+	}
+}
+
+func TestApplyAffixes(t *testing.T) {
+	base := map[string]int{"strength": 1}
+	name, stats := ApplyAffixes("Iron Sword", base, []RolledAffix{
+		{Name: "Flaming", Position: AffixPrefix, DamageType: "fire", DiceCount: 1, DiceSides: 4},
+		{Name: "Keen", Position: AffixPrefix, Stat: AffixStatDamage, Value: 2},
+		{Name: "of the Bear", Position: AffixSuffix, Stat: "strength", Value: 2},
+	})
+	if name != "Flaming Iron Sword of the Bear" {
+		t.Errorf("name = %q", name)
+	}
+	if stats["strength"] != 3 || stats[AffixStatDamage] != 2 {
+		t.Errorf("stats = %v", stats)
+	}
+	if base["strength"] != 1 {
+		t.Errorf("template stats were modified: %v", base)
+	}
+	extra := ExtraDamageDice(stats)
+	if len(extra) != 1 || extra[0] != (combat.ExtraDamage{Type: "fire", DiceCount: 1, DiceSides: 4}) {
+		t.Errorf("extra dice = %+v", extra)
+	}
+}
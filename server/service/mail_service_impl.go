@@ -0,0 +1,452 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/character"
+	"herbst-server/db/equipment"
+	"herbst-server/db/mail"
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+var (
+	ErrMailNotFound         = errors.New("you have no such mail")
+	ErrNotAtMailbox         = errors.New("you need to be at a mailbox")
+	ErrInvalidMailRecipient = errors.New("you can't send mail to them")
+	ErrMailIgnored          = errors.New("they are ignoring you")
+	ErrMailSubject          = errors.New("mail needs a subject")
+	ErrMailTooLong          = errors.New("that mail is too long")
+	ErrMailAmount           = errors.New("gold and cod must not be negative")
+	ErrMailTooManyItems     = fmt.Errorf("you can attach at most %d items", MaxMailItems)
+	ErrMailItem             = errors.New("you can only attach unequipped items you carry")
+	ErrMailCODWithoutItems  = errors.New("cash on delivery needs attached items")
+	ErrMailNothingAttached  = errors.New("there is nothing attached to that mail")
+	ErrMailHasAttachments   = errors.New("take or return the attachments first")
+	ErrMailAlreadyReturned  = errors.New("returned mail can't be sent back again")
+)
+
+// MailboxTag marks rooms with a mailbox. Mail can only be sent and its
+// attachments collected there; the inbox can be read anywhere.
+const MailboxTag = "mailbox"
+
+const (
+	// MailLifetime is how long mail waits in an inbox before it is returned
+	// to its sender, or deleted if it has nothing attached.
+	MailLifetime = 30 * 24 * time.Hour
+	// MaxMailItems is how many items one mail can carry.
+	MaxMailItems = 6
+
+	maxMailSubject = 80
+	maxMailBody    = 2000
+)
+
+// mailService implements MailService using repository interfaces.
+type mailService struct {
+	mailRepo   repository.MailRepo
+	charRepo   repository.CharacterRepo
+	roomRepo   repository.RoomRepo
+	equipRepo  repository.EquipmentRepo
+	ignoreRepo repository.IgnoreRepo
+	tx         repository.TransactionRunner
+	logger     *slog.Logger
+}
+
+// NewMailService creates a new MailService.
+func NewMailService(
+	mailRepo repository.MailRepo,
+	charRepo repository.CharacterRepo,
+	roomRepo repository.RoomRepo,
+	equipRepo repository.EquipmentRepo,
+	ignoreRepo repository.IgnoreRepo,
+	tx repository.TransactionRunner,
+	logger *slog.Logger,
+) MailService {
+	return &mailService{
+		mailRepo:   mailRepo,
+		charRepo:   charRepo,
+		roomRepo:   roomRepo,
+		equipRepo:  equipRepo,
+		ignoreRepo: ignoreRepo,
+		tx:         tx,
+		logger:     logger,
+	}
+}
+
+// Inbox lists the character's mail, newest first, without bodies.
+func (s *mailService) Inbox(ctx context.Context, charID int) (*InboxView, error) {
+	mails, err := s.mailRepo.ListByRecipient(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	view := &InboxView{Mail: make([]MailView, 0, len(mails))}
+	for _, m := range mails {
+		mv := s.mailView(ctx, m)
+		mv.Body = ""
+		view.Mail = append(view.Mail, mv)
+		if !m.IsRead {
+			view.Unread++
+		}
+	}
+	return view, nil
+}
+
+// Read returns one of the character's mails in full and marks it read.
+func (s *mailService) Read(ctx context.Context, charID, mailID int) (*MailView, error) {
+	m, err := s.owned(ctx, charID, mailID)
+	if err != nil {
+		return nil, err
+	}
+	if !m.IsRead {
+		read := true
+		if m, err = s.mailRepo.Update(ctx, m.ID, repository.MailUpdates{IsRead: &read}); err != nil {
+			return nil, err
+		}
+	}
+	mv := s.mailView(ctx, m)
+	return &mv, nil
+}
+
+// Send mails another player from a mailbox. Attached gold is taken from the
+// sender and attached items go into escrow straight away; COD asks the
+// recipient to pay that much gold, to the sender, before taking the items.
+func (s *mailService) Send(ctx context.Context, charID int, in SendMailInput) (*MailView, error) {
+	sender, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	if err := s.atMailbox(ctx, sender); err != nil {
+		return nil, err
+	}
+	recipient, err := s.charRepo.GetByName(ctx, strings.TrimSpace(in.To))
+	if err != nil || recipient.IsNPC || recipient.ID == sender.ID {
+		return nil, ErrInvalidMailRecipient
+	}
+	ignored, err := s.ignoreRepo.Exists(ctx, recipient.ID, sender.ID)
+	if err != nil {
+		return nil, fmt.Errorf("checking ignore: %w", err)
+	}
+	if ignored {
+		return nil, ErrMailIgnored
+	}
+	itemIDs, err := validateMail(&in)
+	if err != nil {
+		return nil, err
+	}
+
+	var sent *db.Mail
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		if in.Gold > 0 {
+			n, err := tx.Character.Update().
+				Where(character.ID(sender.ID), character.GoldCreditsGTE(in.Gold)).
+				AddGoldCredits(-in.Gold).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return ErrInsufficientGold
+			}
+		}
+		if len(itemIDs) > 0 {
+			n, err := tx.Equipment.Update().
+				Where(equipment.IDIn(itemIDs...), equipment.OwnerId(sender.ID), equipment.IsEquipped(false)).
+				ClearOwnerId().
+				ClearRoom().
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n != len(itemIDs) {
+				return ErrMailItem
+			}
+		}
+		sent, err = tx.Mail.Create().
+			SetSenderID(sender.ID).
+			SetSenderName(sender.Name).
+			SetRecipientID(recipient.ID).
+			SetSubject(in.Subject).
+			SetBody(in.Body).
+			SetGold(in.Gold).
+			SetItemIds(itemIDs).
+			SetCod(in.COD).
+			SetExpiresAt(time.Now().Add(MailLifetime)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stream.Default().Send(recipient.ID, stream.Event{Type: stream.TypeMail, Text: fmt.Sprintf("You have new mail from %s.", sender.Name)})
+	s.logger.Info("mail sent", "mail_id", sent.ID, "sender_id", sender.ID, "recipient_id", recipient.ID, "gold", in.Gold, "items", len(itemIDs), slog.String("service", "mail"))
+	mv := s.mailView(ctx, sent)
+	return &mv, nil
+}
+
+// validateMail trims and checks a mail before it is sent, returning its
+// attached item IDs without duplicates.
+func validateMail(in *SendMailInput) ([]int, error) {
+	in.Subject = strings.TrimSpace(in.Subject)
+	in.Body = strings.TrimSpace(in.Body)
+	if in.Subject == "" {
+		return nil, ErrMailSubject
+	}
+	if len(in.Subject) > maxMailSubject || len(in.Body) > maxMailBody {
+		return nil, ErrMailTooLong
+	}
+	if in.Gold < 0 || in.COD < 0 {
+		return nil, ErrMailAmount
+	}
+	seen := map[int]bool{}
+	ids := make([]int, 0, len(in.ItemIDs))
+	for _, id := range in.ItemIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > MaxMailItems {
+		return nil, ErrMailTooManyItems
+	}
+	if in.COD > 0 && len(ids) == 0 {
+		return nil, ErrMailCODWithoutItems
+	}
+	return ids, nil
+}
+
+// Collect takes a mail's gold and items at a mailbox, first paying its COD
+// to the sender by return mail.
+func (s *mailService) Collect(ctx context.Context, charID, mailID int) (*MailCollectResult, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	m, err := s.owned(ctx, charID, mailID)
+	if err != nil {
+		return nil, err
+	}
+	if m.Gold == 0 && len(m.ItemIds) == 0 {
+		return nil, ErrMailNothingAttached
+	}
+	if err := s.atMailbox(ctx, char); err != nil {
+		return nil, err
+	}
+
+	result := &MailCollectResult{Gold: m.Gold, Items: s.itemNames(ctx, m.ItemIds)}
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		// Guard on the gold and the escrowed items so a second collect of the
+		// same mail finds nothing left.
+		n, err := tx.Mail.Update().
+			Where(mail.ID(m.ID), mail.Gold(m.Gold)).
+			SetGold(0).
+			SetCod(0).
+			ClearItemIds().
+			SetIsRead(true).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrMailNothingAttached
+		}
+		if len(m.ItemIds) > 0 {
+			n, err := tx.Equipment.Update().
+				Where(equipment.IDIn(m.ItemIds...), equipment.OwnerIdIsNil()).
+				SetOwnerId(charID).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return ErrMailNothingAttached
+			}
+		}
+		if m.Cod > 0 && len(m.ItemIds) > 0 {
+			n, err := tx.Character.Update().
+				Where(character.ID(charID), character.GoldCreditsGTE(m.Cod)).
+				AddGoldCredits(-m.Cod).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return ErrInsufficientGold
+			}
+			if err := tx.Mail.Create().
+				SetSenderID(charID).
+				SetSenderName(char.Name).
+				SetRecipientID(m.SenderID).
+				SetSubject("COD payment: " + m.Subject).
+				SetGold(m.Cod).
+				SetExpiresAt(time.Now().Add(MailLifetime)).
+				Exec(ctx); err != nil {
+				return err
+			}
+			result.CODPaid = m.Cod
+		}
+		if m.Gold > 0 {
+			return tx.Character.UpdateOneID(charID).AddGoldCredits(m.Gold).Exec(ctx)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.CODPaid > 0 {
+		stream.Default().Send(m.SenderID, stream.Event{Type: stream.TypeMail, Text: fmt.Sprintf("%s paid %d gold cash on delivery. You have new mail.", char.Name, result.CODPaid)})
+	}
+	return result, nil
+}
+
+// Return sends a mail back to its sender with its attachments and without
+// its COD.
+func (s *mailService) Return(ctx context.Context, charID, mailID int) error {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return ErrCharacterNotFound
+	}
+	m, err := s.owned(ctx, charID, mailID)
+	if err != nil {
+		return err
+	}
+	if m.Returned {
+		return ErrMailAlreadyReturned
+	}
+	if _, err := s.charRepo.Get(ctx, m.SenderID); err != nil {
+		return ErrInvalidMailRecipient
+	}
+	return s.bounce(ctx, m, char.ID, char.Name)
+}
+
+// Delete deletes a mail with nothing left attached.
+func (s *mailService) Delete(ctx context.Context, charID, mailID int) error {
+	m, err := s.owned(ctx, charID, mailID)
+	if err != nil {
+		return err
+	}
+	if m.Gold > 0 || len(m.ItemIds) > 0 {
+		return ErrMailHasAttachments
+	}
+	return s.mailRepo.Delete(ctx, m.ID)
+}
+
+// ExpireDue deals with mail that has sat unclaimed for MailLifetime: mail
+// with attachments goes back to its sender once, everything else is
+// deleted. It returns how many mails it handled.
+func (s *mailService) ExpireDue(ctx context.Context, now time.Time) (int, error) {
+	expired, err := s.mailRepo.ListExpired(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	handled := 0
+	for _, m := range expired {
+		attached := m.Gold > 0 || len(m.ItemIds) > 0
+		if attached && !m.Returned {
+			if _, err := s.charRepo.Get(ctx, m.SenderID); err == nil {
+				name := "The postmaster"
+				if r, err := s.charRepo.Get(ctx, m.RecipientID); err == nil {
+					name = r.Name
+				}
+				if err := s.bounce(ctx, m, m.RecipientID, name); err != nil {
+					dblog.Error("failed to return expired mail", err, slog.String("service", "mail"), slog.Int("mail_id", m.ID))
+					continue
+				}
+				handled++
+				continue
+			}
+		}
+		for _, id := range m.ItemIds {
+			if it, err := s.equipRepo.Get(ctx, id); err == nil && it.OwnerId == nil {
+				if err := s.equipRepo.Delete(ctx, id); err != nil {
+					dblog.Error("failed to delete expired mail item", err, slog.String("service", "mail"), slog.Int("equipment_id", id))
+				}
+			}
+		}
+		if err := s.mailRepo.Delete(ctx, m.ID); err != nil {
+			dblog.Error("failed to delete expired mail", err, slog.String("service", "mail"), slog.Int("mail_id", m.ID))
+			continue
+		}
+		handled++
+	}
+	return handled, nil
+}
+
+// bounce hands a mail back to its original sender as returned mail from
+// fromID.
+func (s *mailService) bounce(ctx context.Context, m *db.Mail, fromID int, fromName string) error {
+	noCOD, unread, returned := 0, false, true
+	expires := time.Now().Add(MailLifetime)
+	if _, err := s.mailRepo.Update(ctx, m.ID, repository.MailUpdates{
+		SenderID:    &fromID,
+		SenderName:  &fromName,
+		RecipientID: &m.SenderID,
+		Cod:         &noCOD,
+		IsRead:      &unread,
+		Returned:    &returned,
+		ExpiresAt:   &expires,
+	}); err != nil {
+		return err
+	}
+	stream.Default().Send(m.SenderID, stream.Event{Type: stream.TypeMail, Text: fmt.Sprintf("Your mail \"%s\" was returned to you.", m.Subject)})
+	return nil
+}
+
+// owned loads a mail in the character's inbox.
+func (s *mailService) owned(ctx context.Context, charID, mailID int) (*db.Mail, error) {
+	m, err := s.mailRepo.Get(ctx, mailID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, ErrMailNotFound
+		}
+		return nil, err
+	}
+	if m.RecipientID != charID {
+		return nil, ErrMailNotFound
+	}
+	return m, nil
+}
+
+// atMailbox checks that the character is standing at a mailbox.
+func (s *mailService) atMailbox(ctx context.Context, char *db.Character) error {
+	room, err := s.roomRepo.Get(ctx, char.CurrentRoomId)
+	if err != nil || !roomHasTag(room, MailboxTag) {
+		return ErrNotAtMailbox
+	}
+	return nil
+}
+
+func (s *mailService) mailView(ctx context.Context, m *db.Mail) MailView {
+	return MailView{
+		ID:        m.ID,
+		From:      m.SenderName,
+		FromID:    m.SenderID,
+		Subject:   m.Subject,
+		Body:      m.Body,
+		Gold:      m.Gold,
+		COD:       m.Cod,
+		Items:     s.itemNames(ctx, m.ItemIds),
+		Read:      m.IsRead,
+		Returned:  m.Returned,
+		SentAt:    m.SentAt,
+		ExpiresAt: m.ExpiresAt,
+	}
+}
+
+// itemNames lists the attached items that still exist.
+func (s *mailService) itemNames(ctx context.Context, ids []int) []MailItem {
+	var items []MailItem
+	for _, id := range ids {
+		if it, err := s.equipRepo.Get(ctx, id); err == nil {
+			items = append(items, MailItem{ID: it.ID, Name: it.Name})
+		}
+	}
+	return items
+}
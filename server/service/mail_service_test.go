@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/mail"
+)
+
+// sendCOD has Ann mail Bob a sword at 25 gold cash on delivery.
+func sendCOD(t *testing.T, svc *Container, client *db.Client, bobGold int) (ann, bob *db.Character, sword *db.Equipment, sent *MailView) {
+	t.Helper()
+	post := testRoom(t, client, "Post Office", MailboxTag)
+	ann = testCharacter(t, client, "Ann", post.ID, 0)
+	bob = testCharacter(t, client, "Bob", post.ID, bobGold)
+	sword = testItem(t, client, "sword", ann.ID)
+	sent, err := svc.Mail.Send(context.Background(), ann.ID, SendMailInput{
+		To: "Bob", Subject: "Your sword", COD: 25, ItemIDs: []int{sword.ID},
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	return ann, bob, sword, sent
+}
+
+func TestMailCODCollect(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	ann, bob, sword, sent := sendCOD(t, svc, client, 30)
+
+	if it := client.Equipment.GetX(ctx, sword.ID); it.OwnerId != nil {
+		t.Fatalf("sword owner = %d, want escrow", *it.OwnerId)
+	}
+	result, err := svc.Mail.Collect(ctx, bob.ID, sent.ID)
+	if err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+	if result.CODPaid != 25 {
+		t.Errorf("cod paid = %d, want 25", result.CODPaid)
+	}
+	if it := client.Equipment.GetX(ctx, sword.ID); it.OwnerId == nil || *it.OwnerId != bob.ID {
+		t.Errorf("sword not given to Bob")
+	}
+	if got := client.Character.GetX(ctx, bob.ID).GoldCredits; got != 5 {
+		t.Errorf("Bob's gold = %d, want 5", got)
+	}
+
+	payment := client.Mail.Query().Where(mail.RecipientID(ann.ID)).OnlyX(ctx)
+	if payment.Gold != 25 {
+		t.Fatalf("payment gold = %d, want 25", payment.Gold)
+	}
+	if _, err := svc.Mail.Collect(ctx, ann.ID, payment.ID); err != nil {
+		t.Fatalf("collect payment: %v", err)
+	}
+	if got := client.Character.GetX(ctx, ann.ID).GoldCredits; got != 25 {
+		t.Errorf("Ann's gold = %d, want 25", got)
+	}
+	if _, err := svc.Mail.Collect(ctx, bob.ID, sent.ID); !errors.Is(err, ErrMailNothingAttached) {
+		t.Errorf("second collect: got %v, want ErrMailNothingAttached", err)
+	}
+}
+
+func TestMailCODCollectWithoutGold(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	_, bob, sword, sent := sendCOD(t, svc, client, 10)
+
+	if _, err := svc.Mail.Collect(ctx, bob.ID, sent.ID); !errors.Is(err, ErrInsufficientGold) {
+		t.Fatalf("got %v, want ErrInsufficientGold", err)
+	}
+	if it := client.Equipment.GetX(ctx, sword.ID); it.OwnerId != nil {
+		t.Errorf("sword left escrow")
+	}
+	if m := client.Mail.GetX(ctx, sent.ID); len(m.ItemIds) != 1 || m.Cod != 25 {
+		t.Errorf("mail attachments changed: items %v, cod %d", m.ItemIds, m.Cod)
+	}
+	if got := client.Character.GetX(ctx, bob.ID).GoldCredits; got != 10 {
+		t.Errorf("Bob's gold = %d, want 10", got)
+	}
+}
+
+func TestMailExpireDueReturnsToSender(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	ann, bob, sword, sent := sendCOD(t, svc, client, 0)
+	note, err := svc.Mail.Send(ctx, ann.ID, SendMailInput{To: "Bob", Subject: "Hello"})
+	if err != nil {
+		t.Fatalf("send note: %v", err)
+	}
+
+	later := time.Now().Add(MailLifetime + time.Hour)
+	if n, err := svc.Mail.ExpireDue(ctx, later); err != nil || n != 2 {
+		t.Fatalf("expire: %d, %v; want 2 handled", n, err)
+	}
+	if client.Mail.Query().Where(mail.ID(note.ID)).ExistX(ctx) {
+		t.Error("mail without attachments was not deleted")
+	}
+	back := client.Mail.GetX(ctx, sent.ID)
+	if back.RecipientID != ann.ID || back.SenderID != bob.ID || !back.Returned {
+		t.Errorf("mail not returned to Ann: recipient %d, sender %d, returned %v", back.RecipientID, back.SenderID, back.Returned)
+	}
+	if back.Cod != 0 {
+		t.Errorf("returned mail cod = %d, want 0", back.Cod)
+	}
+	if _, err := svc.Mail.Collect(ctx, ann.ID, sent.ID); err != nil {
+		t.Fatalf("collect returned mail: %v", err)
+	}
+	if it := client.Equipment.GetX(ctx, sword.ID); it.OwnerId == nil || *it.OwnerId != ann.ID {
+		t.Errorf("sword not back with Ann")
+	}
+}
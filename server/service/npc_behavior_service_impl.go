@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"herbst-server/combat"
+	"herbst-server/db"
+	"herbst-server/db/schema"
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+// maxPathRooms bounds the search for a scheduled NPC's route so a badly
+// linked world can't stall the behavior tick.
+const maxPathRooms = 200
+
+type npcBehaviorService struct {
+	charRepo        repository.CharacterRepo
+	npcTmplRepo     repository.NPCTemplateRepo
+	roomRepo        repository.RoomRepo
+	charFactionRepo repository.CharacterFactionRepo
+	worldRepo       repository.WorldRepo
+	reputation      ReputationService
+	engine          *combat.Manager
+	logger          *slog.Logger
+}
+
+// NewNPCBehaviorService creates the service that runs NPC template behavior
+// scripts against the combat engine.
+func NewNPCBehaviorService(
+	charRepo repository.CharacterRepo,
+	npcTmplRepo repository.NPCTemplateRepo,
+	roomRepo repository.RoomRepo,
+	charFactionRepo repository.CharacterFactionRepo,
+	worldRepo repository.WorldRepo,
+	reputation ReputationService,
+	engine *combat.Manager,
+	logger *slog.Logger,
+) NPCBehaviorService {
+	return &npcBehaviorService{
+		charRepo:        charRepo,
+		npcTmplRepo:     npcTmplRepo,
+		roomRepo:        roomRepo,
+		charFactionRepo: charFactionRepo,
+		worldRepo:       worldRepo,
+		reputation:      reputation,
+		engine:          engine,
+		logger:          logger,
+	}
+}
+
+// behaviorCache loads each NPC template's behavior once per tick.
+type behaviorCache struct {
+	repo  repository.NPCTemplateRepo
+	byTpl map[string]*schema.NPCBehavior
+}
+
+func (c *behaviorCache) get(ctx context.Context, npc *db.Character) *schema.NPCBehavior {
+	if npc.NpcTemplateID == "" {
+		return nil
+	}
+	if b, ok := c.byTpl[npc.NpcTemplateID]; ok {
+		return b
+	}
+	var b *schema.NPCBehavior
+	if tmpl, err := c.repo.Get(ctx, npc.NpcTemplateID); err == nil {
+		b = &tmpl.Behavior
+	}
+	c.byTpl[npc.NpcTemplateID] = b
+	return b
+}
+
+// Tick runs one round of behavior for every living NPC instance that is not
+// already fighting: scheduled NPCs take a step towards where they should be,
+// then NPCs join fights their allies are in, then aggressive NPCs — and
+// NPCs whose faction a player is hostile with — pick a fight with a player
+// in their room.
+func (s *npcBehaviorService) Tick(ctx context.Context, now time.Time) error {
+	all, err := s.charRepo.ListAllNPCs(ctx)
+	if err != nil {
+		return fmt.Errorf("list npcs: %w", err)
+	}
+	cache := &behaviorCache{repo: s.npcTmplRepo, byTpl: map[string]*schema.NPCBehavior{}}
+	hours := map[string]int{}
+	var living []*db.Character
+	byRoom := map[int][]*db.Character{}
+	for _, npc := range all {
+		if !npc.IsInstance || npc.Hitpoints <= 0 || npc.CurrentRoomId == 0 {
+			continue
+		}
+		living = append(living, npc)
+		byRoom[npc.CurrentRoomId] = append(byRoom[npc.CurrentRoomId], npc)
+	}
+
+	for _, npc := range living {
+		b := cache.get(ctx, npc)
+		if b == nil || s.engine.Fighting(npc.ID) {
+			continue
+		}
+		if dest := scheduledRoom(b.Schedule, s.gameHour(ctx, hours, npc, now)); dest != 0 && dest != npc.CurrentRoomId {
+			s.walkToward(ctx, npc, dest)
+			continue
+		}
+		if b.AssistAllies && b.Faction != "" && s.assist(ctx, cache, npc, b.Faction, byRoom[npc.CurrentRoomId]) {
+			continue
+		}
+		if b.Aggro != nil || b.Faction != "" {
+			s.aggro(ctx, npc, b, 0)
+		}
+	}
+	return nil
+}
+
+// gameHour is the hour of day on the clock of npc's world, cached per tick
+// in hours.
+func (s *npcBehaviorService) gameHour(ctx context.Context, hours map[string]int, npc *db.Character, now time.Time) int {
+	worldID := npc.CurrentWorld
+	if worldID == "" && npc.WorldID != 0 {
+		worldID = strconv.Itoa(npc.WorldID)
+	}
+	if h, ok := hours[worldID]; ok {
+		return h
+	}
+	h := worldClock(ctx, s.worldRepo, worldID).At(now).Hour
+	hours[worldID] = h
+	return h
+}
+
+// PlayerEntered gives aggressive NPCs in roomID, and those of factions the
+// player is hostile with, the chance to attack a player the moment they
+// walk in.
+func (s *npcBehaviorService) PlayerEntered(ctx context.Context, charID, roomID int) {
+	npcs, err := s.charRepo.ListNPCsByRoom(ctx, roomID)
+	if err != nil {
+		dblog.Error("failed to list npcs for aggro", err, slog.String("service", "npcs"), slog.Int("room_id", roomID))
+		return
+	}
+	cache := &behaviorCache{repo: s.npcTmplRepo, byTpl: map[string]*schema.NPCBehavior{}}
+	for _, npc := range npcs {
+		if !npc.IsInstance || npc.Hitpoints <= 0 || s.engine.Fighting(npc.ID) {
+			continue
+		}
+		if b := cache.get(ctx, npc); b != nil && (b.Aggro != nil || b.Faction != "") && s.aggro(ctx, npc, b, charID) {
+			return
+		}
+	}
+}
+
+// aggro has npc attack a player in its room — only charID if it is set.
+// Players who are not online, already fighting or filtered out by the
+// behavior are left alone. It reports whether a fight started.
+func (s *npcBehaviorService) aggro(ctx context.Context, npc *db.Character, b *schema.NPCBehavior, charID int) bool {
+	chars, err := s.charRepo.ListByRoom(ctx, npc.CurrentRoomId)
+	if err != nil {
+		dblog.Error("failed to list room for aggro", err, slog.String("service", "npcs"), slog.Int("room_id", npc.CurrentRoomId))
+		return false
+	}
+	for _, p := range chars {
+		if p.IsNPC || p.Hitpoints <= 0 || (charID != 0 && p.ID != charID) {
+			continue
+		}
+		if !stream.Default().Listening(p.ID) || s.engine.InCombat(p.ID) {
+			continue
+		}
+		if !aggroAllowed(b, p.Level, s.factionNames(ctx, p.ID), s.reputation.Tier(ctx, p.ID, b.Faction)) {
+			continue
+		}
+		if _, err := s.engine.Engage(ctx, p.ID, npc.ID); err != nil {
+			if !errors.Is(err, combat.ErrAlreadyInCombat) && !errors.Is(err, combat.ErrTargetNotHere) {
+				dblog.Error("npc aggro failed", err, slog.String("service", "npcs"), slog.Int("npc_id", npc.ID), slog.Int("character_id", p.ID))
+			}
+			continue
+		}
+		stream.Default().Send(p.ID, stream.Event{Type: stream.TypeCombat, Text: fmt.Sprintf("⚔ %s attacks you!", npc.Name), ActorID: npc.ID})
+		stream.Default().ToRoom(ctx, npc.CurrentRoomId, stream.Event{
+			Type:    stream.TypeCombat,
+			Text:    fmt.Sprintf("⚔ %s attacks %s!", npc.Name, p.Name),
+			ActorID: npc.ID,
+		}, p.ID)
+		return true
+	}
+	return false
+}
+
+// assist joins npc to a fight an ally of the same faction in the room is
+// in. It reports whether it did.
+func (s *npcBehaviorService) assist(ctx context.Context, cache *behaviorCache, npc *db.Character, faction string, roomNPCs []*db.Character) bool {
+	for _, ally := range roomNPCs {
+		if ally.ID == npc.ID || !s.engine.Fighting(ally.ID) {
+			continue
+		}
+		ab := cache.get(ctx, ally)
+		if ab == nil || !strings.EqualFold(ab.Faction, faction) {
+			continue
+		}
+		if err := s.engine.Assist(ctx, npc.ID, ally.ID); err != nil {
+			continue
+		}
+		stream.Default().ToRoom(ctx, npc.CurrentRoomId, stream.Event{
+			Type:    stream.TypeCombat,
+			Text:    fmt.Sprintf("⚔ %s rushes to %s's aid!", npc.Name, ally.Name),
+			ActorID: npc.ID,
+		})
+		return true
+	}
+	return false
+}
+
+// factionNames lists the factions the character is an active member of.
+func (s *npcBehaviorService) factionNames(ctx context.Context, charID int) []string {
+	memberships, err := s.charFactionRepo.ListByCharacter(ctx, charID)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, m := range memberships {
+		if m.Status == "active" && m.Edges.Faction != nil {
+			names = append(names, m.Edges.Faction.Name)
+		}
+	}
+	return names
+}
+
+// walkToward moves npc one room along the shortest path to dest. NPCs in a
+// zone copy only walk between its rooms.
+func (s *npcBehaviorService) walkToward(ctx context.Context, npc *db.Character, dest int) {
+	var instanceID *int
+	if here, err := s.roomRepo.Get(ctx, npc.CurrentRoomId); err == nil {
+		instanceID = here.InstanceID
+	}
+	exits := func(roomID int) (map[string]int, error) {
+		rm, err := s.roomRepo.Get(ctx, roomID)
+		if err != nil {
+			return nil, err
+		}
+		if instanceID == nil || (rm.InstanceID != nil && *rm.InstanceID == *instanceID) {
+			return passableExits(rm), nil
+		}
+		return nil, nil
+	}
+	dir, next := nextStep(npc.CurrentRoomId, dest, exits)
+	if next == 0 {
+		return
+	}
+	if instanceID != nil {
+		if rm, err := s.roomRepo.Get(ctx, next); err != nil || rm.InstanceID == nil || *rm.InstanceID != *instanceID {
+			return
+		}
+	}
+	if _, err := s.charRepo.Update(ctx, npc.ID, repository.CharacterUpdates{CurrentRoomID: &next}); err != nil {
+		dblog.Error("scheduled npc move failed", err, slog.String("service", "npcs"), slog.Int("npc_id", npc.ID), slog.Int("room_id", next))
+		return
+	}
+	hub := stream.Default()
+	hub.ToRoom(ctx, npc.CurrentRoomId, stream.Event{Type: stream.TypeNPC, Text: fmt.Sprintf("%s leaves %s.", npc.Name, dir), ActorID: npc.ID})
+	hub.ToRoom(ctx, next, stream.Event{Type: stream.TypeNPC, Text: fmt.Sprintf("%s arrives.", npc.Name), ActorID: npc.ID})
+	npc.CurrentRoomId = next
+}
+
+// scheduledRoom returns the room the schedule puts an NPC in at hour, or 0
+// if no entry covers it. Entries wrap past midnight when To <= From.
+func scheduledRoom(schedule []schema.NPCScheduleEntry, hour int) int {
+	for _, e := range schedule {
+		if e.From < e.To && hour >= e.From && hour < e.To {
+			return e.RoomID
+		}
+		if e.From >= e.To && (hour >= e.From || hour < e.To) {
+			return e.RoomID
+		}
+	}
+	return 0
+}
+
+// nextStep finds the shortest path from one room to another by breadth-first
+// search over exits and returns its first step. It returns 0 if dest can't
+// be reached within maxPathRooms rooms.
+func nextStep(from, dest int, exits func(roomID int) (map[string]int, error)) (string, int) {
+	type step struct {
+		dir  string
+		room int
+	}
+	first := map[int]step{from: {}}
+	queue := []int{from}
+	for len(queue) > 0 && len(first) <= maxPathRooms {
+		cur := queue[0]
+		queue = queue[1:]
+		out, err := exits(cur)
+		if err != nil {
+			continue
+		}
+		for _, dir := range sortedExitDirs(out) {
+			next := out[dir]
+			if _, seen := first[next]; seen {
+				continue
+			}
+			s := first[cur]
+			if cur == from {
+				s = step{dir: dir, room: next}
+			}
+			if next == dest {
+				return s.dir, s.room
+			}
+			first[next] = s
+			queue = append(queue, next)
+		}
+	}
+	return "", 0
+}
+
+// sortedExitDirs returns a room's exit directions in a stable order.
+func sortedExitDirs(exits map[string]int) []string {
+	dirs := make([]string, 0, len(exits))
+	for dir := range exits {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// aggroAllowed reports whether an NPC with behavior b attacks a player of
+// the given level who belongs to factions and has reputation tier with the
+// NPC's faction. Players hostile with the faction are attacked even by NPCs
+// without aggro; friendly ones or better never are.
+func aggroAllowed(b *schema.NPCBehavior, level int, factions []string, tier string) bool {
+	if b.Faction != "" && tier == RepHostile {
+		return true
+	}
+	if b.Aggro == nil || TierAtLeast(tier, RepFriendly) {
+		return false
+	}
+	if level < b.Aggro.MinLevel || (b.Aggro.MaxLevel > 0 && level > b.Aggro.MaxLevel) {
+		return false
+	}
+	for _, f := range factions {
+		if b.Faction != "" && strings.EqualFold(f, b.Faction) {
+			return false
+		}
+		for _, ignored := range b.Aggro.IgnoreFactions {
+			if strings.EqualFold(f, ignored) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ValidateNPCBehavior checks a behavior definition before it is saved on a
+// template.
+func ValidateNPCBehavior(b *schema.NPCBehavior) error {
+	if b == nil {
+		return nil
+	}
+	if b.FleeBelowPct < 0 || b.FleeBelowPct > 100 {
+		return fmt.Errorf("flee_below_pct must be 0-100")
+	}
+	if b.AssistAllies && b.Faction == "" {
+		return fmt.Errorf("assist_allies needs a faction")
+	}
+	if b.Aggro != nil && (b.Aggro.MinLevel < 0 || (b.Aggro.MaxLevel > 0 && b.Aggro.MaxLevel < b.Aggro.MinLevel)) {
+		return fmt.Errorf("aggro level range is invalid")
+	}
+	for faction := range b.KillReputation {
+		if strings.TrimSpace(faction) == "" {
+			return fmt.Errorf("kill_reputation faction names can't be empty")
+		}
+	}
+	for i, e := range b.Schedule {
+		if e.From < 0 || e.From > 23 || e.To < 0 || e.To > 23 {
+			return fmt.Errorf("schedule[%d]: hours must be 0-23", i)
+		}
+		if e.RoomID <= 0 {
+			return fmt.Errorf("schedule[%d]: room_id is required", i)
+		}
+	}
+	return nil
+}
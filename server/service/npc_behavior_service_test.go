@@ -0,0 +1,101 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"herbst-server/db/schema"
+)
+
+func TestScheduledRoom(t *testing.T) {
+	schedule := []schema.NPCScheduleEntry{
+		{From: 8, To: 18, RoomID: 10}, // shop by day
+		{From: 22, To: 6, RoomID: 20}, // home overnight
+	}
+	cases := map[int]int{8: 10, 17: 10, 18: 0, 21: 0, 22: 20, 0: 20, 5: 20, 6: 0}
+	for hour, want := range cases {
+		if got := scheduledRoom(schedule, hour); got != want {
+			t.Errorf("hour %d: got room %d, want %d", hour, got, want)
+		}
+	}
+}
+
+func TestNextStep(t *testing.T) {
+	// 1 -east-> 2 -north-> 3, and a longer way round 1 -south-> 4 -> 5 -> 3.
+	world := map[int]map[string]int{
+		1: {"east": 2, "south": 4},
+		2: {"west": 1, "north": 3},
+		3: {"south": 2},
+		4: {"north": 1, "east": 5},
+		5: {"north": 3},
+		6: {},
+	}
+	exits := func(id int) (map[string]int, error) {
+		if out, ok := world[id]; ok {
+			return out, nil
+		}
+		return nil, errors.New("no such room")
+	}
+	if dir, next := nextStep(1, 3, exits); dir != "east" || next != 2 {
+		t.Errorf("1 → 3: got %s/%d, want east/2", dir, next)
+	}
+	if dir, next := nextStep(4, 2, exits); dir != "north" || next != 1 {
+		t.Errorf("4 → 2: got %s/%d, want north/1", dir, next)
+	}
+	if _, next := nextStep(1, 6, exits); next != 0 {
+		t.Errorf("unreachable room: got %d, want 0", next)
+	}
+}
+
+func TestAggroAllowed(t *testing.T) {
+	b := &schema.NPCBehavior{
+		Faction: "foot_clan",
+		Aggro:   &schema.NPCAggro{MinLevel: 3, MaxLevel: 10, IgnoreFactions: []string{"Ninja"}},
+	}
+	cases := []struct {
+		level    int
+		factions []string
+		want     bool
+	}{
+		{5, nil, true},
+		{2, nil, false},
+		{11, nil, false},
+		{5, []string{"ninja"}, false},
+		{5, []string{"Foot_Clan"}, false},
+		{5, []string{"surf_wardens"}, true},
+	}
+	for _, c := range cases {
+		if got := aggroAllowed(b, c.level, c.factions, RepNeutral); got != c.want {
+			t.Errorf("level %d %v: got %v, want %v", c.level, c.factions, got, c.want)
+		}
+	}
+	if aggroAllowed(&schema.NPCBehavior{}, 5, nil, RepNeutral) {
+		t.Error("an NPC without aggro should never attack")
+	}
+	if !aggroAllowed(&schema.NPCBehavior{Faction: "foot_clan"}, 5, nil, RepHostile) {
+		t.Error("an NPC should attack players hostile with its faction")
+	}
+	if aggroAllowed(b, 5, nil, RepFriendly) {
+		t.Error("an aggressive NPC should leave friendly players alone")
+	}
+}
+
+func TestValidateNPCBehavior(t *testing.T) {
+	bad := []schema.NPCBehavior{
+		{FleeBelowPct: 120},
+		{AssistAllies: true},
+		{Aggro: &schema.NPCAggro{MinLevel: 5, MaxLevel: 2}},
+		{Schedule: []schema.NPCScheduleEntry{{From: 8, To: 24, RoomID: 1}}},
+		{Schedule: []schema.NPCScheduleEntry{{From: 8, To: 18}}},
+		{KillReputation: map[string]int{" ": -5}},
+	}
+	for i, b := range bad {
+		if err := ValidateNPCBehavior(&b); err == nil {
+			t.Errorf("bad[%d]: expected an error for %+v", i, b)
+		}
+	}
+	good := schema.NPCBehavior{Faction: "foot_clan", AssistAllies: true, FleeBelowPct: 25, Aggro: &schema.NPCAggro{}}
+	if err := ValidateNPCBehavior(&good); err != nil {
+		t.Errorf("good behavior: %v", err)
+	}
+}
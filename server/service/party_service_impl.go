@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/events"
+	"herbst-server/repository"
+)
+
+var (
+	ErrNotInParty         = errors.New("you are not in a party")
+	ErrAlreadyInParty     = errors.New("you are already in a party")
+	ErrTargetInParty      = errors.New("they are already in a party")
+	ErrNotPartyLeader     = errors.New("only the party leader can do that")
+	ErrNoPartyInvite      = errors.New("you have no pending party invite")
+	ErrPartyFull          = errors.New("the party is full")
+	ErrInvalidPartyTarget = errors.New("you can only party with other players")
+	ErrNotPartyMember     = errors.New("they are not in your party")
+)
+
+// PartyInviteTTL is how long a party invite can be accepted.
+const PartyInviteTTL = 2 * time.Minute
+
+// partyService implements PartyService using repository interfaces.
+type partyService struct {
+	charRepo  repository.CharacterRepo
+	partyRepo repository.PartyRepo
+	worldRepo repository.WorldRepo
+}
+
+// NewPartyService creates a new PartyService.
+func NewPartyService(
+	charRepo repository.CharacterRepo,
+	partyRepo repository.PartyRepo,
+	worldRepo repository.WorldRepo,
+) PartyService {
+	return &partyService{
+		charRepo:  charRepo,
+		partyRepo: partyRepo,
+		worldRepo: worldRepo,
+	}
+}
+
+// Get returns the character's party.
+func (s *partyService) Get(ctx context.Context, charID int) (*PartyView, error) {
+	p, err := s.partyRepo.GetForCharacter(ctx, charID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, ErrNotInParty
+		}
+		return nil, err
+	}
+	return partyView(p), nil
+}
+
+// MemberIDs returns the IDs of everyone in the character's party, including
+// the character. It is empty when the character is not in a party.
+func (s *partyService) MemberIDs(ctx context.Context, charID int) ([]int, error) {
+	p, err := s.partyRepo.GetForCharacter(ctx, charID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	ids := make([]int, 0, len(p.Edges.Members))
+	for _, m := range p.Edges.Members {
+		ids = append(ids, m.ID)
+	}
+	return ids, nil
+}
+
+// Invite asks another player to join the character's party. A character
+// who is not in a party may invite; the party forms when the invite is
+// accepted.
+func (s *partyService) Invite(ctx context.Context, charID int, targetName string) (*PartyUpdate, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	target, err := s.charRepo.GetByName(ctx, targetName)
+	if err != nil || target.IsNPC || target.ID == char.ID {
+		return nil, ErrInvalidPartyTarget
+	}
+	if _, err := s.partyRepo.GetForCharacter(ctx, target.ID); err == nil {
+		return nil, ErrTargetInParty
+	} else if !db.IsNotFound(err) {
+		return nil, err
+	}
+
+	size := 1
+	if p, err := s.partyRepo.GetForCharacter(ctx, charID); err == nil {
+		if p.LeaderID != charID {
+			return nil, ErrNotPartyLeader
+		}
+		size = len(p.Edges.Members)
+	} else if !db.IsNotFound(err) {
+		return nil, err
+	}
+	if size >= s.maxSize(ctx, char) {
+		return nil, ErrPartyFull
+	}
+
+	if _, err := s.partyRepo.CreateInvite(ctx, charID, target.ID, time.Now().Add(PartyInviteTTL)); err != nil {
+		return nil, err
+	}
+	return &PartyUpdate{
+		Message: fmt.Sprintf("You invite %s to join your party.", target.Name),
+		Notice:  fmt.Sprintf("%s invites you to join their party. Type 'party accept' to join.", char.Name),
+		Notify:  []int{target.ID},
+	}, nil
+}
+
+// Accept joins the party of whoever sent the character's latest invite.
+func (s *partyService) Accept(ctx context.Context, charID int) (*PartyUpdate, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	if _, err := s.partyRepo.GetForCharacter(ctx, charID); err == nil {
+		return nil, ErrAlreadyInParty
+	} else if !db.IsNotFound(err) {
+		return nil, err
+	}
+	inv, err := s.partyRepo.LatestInvite(ctx, charID, time.Now())
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, ErrNoPartyInvite
+		}
+		return nil, err
+	}
+
+	p, err := s.partyRepo.GetForCharacter(ctx, inv.InviterID)
+	switch {
+	case db.IsNotFound(err):
+		if p, err = s.partyRepo.Create(ctx, inv.InviterID); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case p.LeaderID != inv.InviterID:
+		// The inviter has since joined someone else's party.
+		_ = s.partyRepo.DeleteInvites(ctx, charID)
+		return nil, ErrNoPartyInvite
+	}
+	if len(p.Edges.Members) >= s.maxSize(ctx, char) {
+		return nil, ErrPartyFull
+	}
+
+	if err := s.partyRepo.AddMember(ctx, p.ID, charID); err != nil {
+		return nil, err
+	}
+	_ = s.partyRepo.DeleteInvites(ctx, charID)
+	if p, err = s.partyRepo.Get(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	view := partyView(p)
+	return &PartyUpdate{
+		Party:   view,
+		Message: fmt.Sprintf("You join %s's party.", view.LeaderName()),
+		Notice:  fmt.Sprintf("%s joins the party.", char.Name),
+		Notify:  view.MemberIDs(charID),
+	}, nil
+}
+
+// Leave takes the character out of its party. If the leader leaves,
+// another member takes over; a party left with one member is disbanded.
+func (s *partyService) Leave(ctx context.Context, charID int) (*PartyUpdate, error) {
+	p, err := s.partyRepo.GetForCharacter(ctx, charID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, ErrNotInParty
+		}
+		return nil, err
+	}
+	name := memberName(p, charID)
+	update, err := s.removeMember(ctx, p, charID)
+	if err != nil {
+		return nil, err
+	}
+	update.Message = "You leave the party."
+	if update.Party == nil {
+		update.Notice = fmt.Sprintf("%s leaves the party. The party has disbanded.", name)
+	} else {
+		update.Notice = fmt.Sprintf("%s leaves the party.", name)
+		if update.Party.LeaderID != p.LeaderID {
+			update.Notice += fmt.Sprintf(" %s is now the leader.", update.Party.LeaderName())
+		}
+	}
+	return update, nil
+}
+
+// Kick removes another member from the leader's party.
+func (s *partyService) Kick(ctx context.Context, charID int, targetName string) (*PartyUpdate, error) {
+	p, err := s.partyRepo.GetForCharacter(ctx, charID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, ErrNotInParty
+		}
+		return nil, err
+	}
+	if p.LeaderID != charID {
+		return nil, ErrNotPartyLeader
+	}
+	var target *db.Character
+	for _, m := range p.Edges.Members {
+		if m.ID != charID && strings.EqualFold(m.Name, targetName) {
+			target = m
+			break
+		}
+	}
+	if target == nil {
+		return nil, ErrNotPartyMember
+	}
+	update, err := s.removeMember(ctx, p, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	update.Message = fmt.Sprintf("You remove %s from the party.", target.Name)
+	notify := []int{target.ID}
+	for _, id := range update.Notify {
+		if id != charID {
+			notify = append(notify, id)
+		}
+	}
+	update.Notify = notify
+	if update.Party == nil {
+		update.Notice = fmt.Sprintf("%s has been removed from the party. The party has disbanded.", target.Name)
+	} else {
+		update.Notice = fmt.Sprintf("%s has been removed from the party.", target.Name)
+	}
+	return update, nil
+}
+
+// removeMember drops charID from p, handing over leadership or disbanding
+// as needed. The returned update notifies everyone left behind.
+func (s *partyService) removeMember(ctx context.Context, p *db.Party, charID int) (*PartyUpdate, error) {
+	var remaining []*db.Character
+	for _, m := range p.Edges.Members {
+		if m.ID != charID {
+			remaining = append(remaining, m)
+		}
+	}
+	if len(remaining) < 2 {
+		if err := s.partyRepo.Delete(ctx, p.ID); err != nil {
+			return nil, err
+		}
+		if p.LeaderID != 0 {
+			_ = s.partyRepo.DeleteInvitesFrom(ctx, p.LeaderID)
+		}
+		update := &PartyUpdate{}
+		for _, m := range remaining {
+			update.Notify = append(update.Notify, m.ID)
+		}
+		return update, nil
+	}
+
+	if err := s.partyRepo.RemoveMember(ctx, p.ID, charID); err != nil {
+		return nil, err
+	}
+	if p.LeaderID == charID {
+		sort.Slice(remaining, func(i, j int) bool { return remaining[i].ID < remaining[j].ID })
+		if err := s.partyRepo.SetLeader(ctx, p.ID, remaining[0].ID); err != nil {
+			return nil, err
+		}
+		_ = s.partyRepo.DeleteInvitesFrom(ctx, charID)
+	}
+	p, err := s.partyRepo.Get(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	view := partyView(p)
+	return &PartyUpdate{Party: view, Notify: view.MemberIDs(0)}, nil
+}
+
+// maxSize returns the party size limit from the character's world config.
+func (s *partyService) maxSize(ctx context.Context, char *db.Character) int {
+	if char.WorldID == 0 {
+		return events.DefaultPartyConfig.MaxSize
+	}
+	w, err := s.worldRepo.Get(ctx, char.WorldID)
+	if err != nil {
+		return events.DefaultPartyConfig.MaxSize
+	}
+	return events.ParsePartyConfig(w.Config).MaxSize
+}
+
+// partyView builds the API view of a party, leader first.
+func partyView(p *db.Party) *PartyView {
+	view := &PartyView{ID: p.ID, LeaderID: p.LeaderID}
+	for _, m := range p.Edges.Members {
+		view.Members = append(view.Members, PartyMember{
+			ID:     m.ID,
+			Name:   m.Name,
+			Level:  m.Level,
+			HP:     m.Hitpoints,
+			MaxHP:  m.MaxHitpoints,
+			RoomID: m.CurrentRoomId,
+			Leader: m.ID == p.LeaderID,
+		})
+	}
+	sort.SliceStable(view.Members, func(i, j int) bool {
+		if view.Members[i].Leader != view.Members[j].Leader {
+			return view.Members[i].Leader
+		}
+		return view.Members[i].ID < view.Members[j].ID
+	})
+	return view
+}
+
+func memberName(p *db.Party, charID int) string {
+	for _, m := range p.Edges.Members {
+		if m.ID == charID {
+			return m.Name
+		}
+	}
+	return "Someone"
+}
@@ -0,0 +1,91 @@
+package service
+
+import "strings"
+
+// Reputation tiers, from worst standing to best.
+const (
+	RepHostile    = "hostile"
+	RepUnfriendly = "unfriendly"
+	RepNeutral    = "neutral"
+	RepFriendly   = "friendly"
+	RepHonored    = "honored"
+	RepRevered    = "revered"
+	RepExalted    = "exalted"
+)
+
+// Standing limits. A character with no standing with a faction is at 0.
+const (
+	MinStanding = -1000
+	MaxStanding = 1000
+)
+
+// DefaultKillReputation is the standing lost with an NPC's faction for
+// killing it, unless its behavior sets kill_reputation.
+const DefaultKillReputation = -10
+
+// reputationTiers lists each tier with the lowest standing in it and the
+// multiplier it puts on shop prices, worst first.
+var reputationTiers = []struct {
+	name     string
+	min      int
+	priceMod float64
+}{
+	{RepHostile, MinStanding, 1.5},
+	{RepUnfriendly, -500, 1.2},
+	{RepNeutral, -100, 1.0},
+	{RepFriendly, 100, 0.95},
+	{RepHonored, 300, 0.9},
+	{RepRevered, 600, 0.85},
+	{RepExalted, 900, 0.8},
+}
+
+// ReputationTier names the tier a standing falls in.
+func ReputationTier(standing int) string {
+	tier := reputationTiers[0].name
+	for _, t := range reputationTiers {
+		if standing >= t.min {
+			tier = t.name
+		}
+	}
+	return tier
+}
+
+// tierRank orders tiers: 0 for hostile up to 6 for exalted. Unknown tiers
+// rank as neutral.
+func tierRank(tier string) int {
+	for i, t := range reputationTiers {
+		if t.name == tier {
+			return i
+		}
+	}
+	return tierRank(RepNeutral)
+}
+
+// TierAtLeast reports whether tier is min or better.
+func TierAtLeast(tier, min string) bool {
+	return tierRank(tier) >= tierRank(min)
+}
+
+// ReputationPriceMod is what a shopkeeper of a faction charges a character
+// of the given tier, as a multiple of the listed price. What they pay for
+// items moves the other way.
+func ReputationPriceMod(tier string) float64 {
+	return reputationTiers[tierRank(tier)].priceMod
+}
+
+// clampStanding keeps a standing within MinStanding and MaxStanding.
+func clampStanding(v int) int {
+	return max(MinStanding, min(MaxStanding, v))
+}
+
+// killReputation is the standing per faction a player gains or loses for
+// killing an NPC of the given faction with the given kill_reputation.
+func killReputation(faction string, rep map[string]int) map[string]int {
+	if len(rep) > 0 {
+		return rep
+	}
+	if strings.TrimSpace(faction) == "" {
+		return nil
+	}
+	return map[string]int{faction: DefaultKillReputation}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"herbst-server/db"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+var (
+	ErrNoFaction    = errors.New("faction is required")
+	ErrNPCHostile   = errors.New("they refuse to deal with you")
+	ErrQuestMissing = errors.New("quest not found")
+)
+
+// reputationService implements ReputationService using repository
+// interfaces.
+type reputationService struct {
+	repRepo   repository.ReputationRepo
+	charRepo  repository.CharacterRepo
+	npcRepo   repository.NPCTemplateRepo
+	questRepo repository.QuestRepo
+	logger    *slog.Logger
+}
+
+// NewReputationService creates a new ReputationService.
+func NewReputationService(
+	repRepo repository.ReputationRepo,
+	charRepo repository.CharacterRepo,
+	npcRepo repository.NPCTemplateRepo,
+	questRepo repository.QuestRepo,
+	logger *slog.Logger,
+) ReputationService {
+	return &reputationService{
+		repRepo:   repRepo,
+		charRepo:  charRepo,
+		npcRepo:   npcRepo,
+		questRepo: questRepo,
+		logger:    logger,
+	}
+}
+
+// Standings lists the character's standing with every faction they have
+// gained or lost any with.
+func (s *reputationService) Standings(ctx context.Context, charID int) ([]ReputationStanding, error) {
+	if _, err := s.charRepo.Get(ctx, charID); err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	rows, err := s.repRepo.ListByCharacter(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ReputationStanding, len(rows))
+	for i, r := range rows {
+		out[i] = standingView(r.Faction, r.Standing)
+	}
+	return out, nil
+}
+
+// Tier is the character's tier with faction. No faction, no standing yet
+// and lookup failures all count as neutral.
+func (s *reputationService) Tier(ctx context.Context, charID int, faction string) string {
+	if strings.TrimSpace(faction) == "" {
+		return RepNeutral
+	}
+	r, err := s.repRepo.Get(ctx, charID, faction)
+	if err != nil {
+		return RepNeutral
+	}
+	return ReputationTier(r.Standing)
+}
+
+// Adjust changes the character's standing with faction by delta, within
+// MinStanding and MaxStanding, and tells them when their tier changes.
+func (s *reputationService) Adjust(ctx context.Context, charID int, faction string, delta int) (*ReputationStanding, error) {
+	faction = strings.TrimSpace(faction)
+	if faction == "" {
+		return nil, ErrNoFaction
+	}
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	before := 0
+	r, err := s.repRepo.Get(ctx, charID, faction)
+	switch {
+	case db.IsNotFound(err):
+		r, err = s.repRepo.Create(ctx, charID, faction, clampStanding(delta))
+	case err == nil:
+		before = r.Standing
+		r, err = s.repRepo.SetStanding(ctx, r.ID, clampStanding(r.Standing+delta))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	view := standingView(r.Faction, r.Standing)
+	if was := ReputationTier(before); view.Tier != was && !char.IsNPC {
+		stream.Default().Send(charID, stream.Event{
+			Type: stream.TypeReputation,
+			Text: fmt.Sprintf("You are now %s with %s.", view.Tier, r.Faction),
+		})
+	}
+	s.logger.Info("reputation changed", "character_id", charID, "faction", r.Faction, "delta", delta, "standing", r.Standing, slog.String("service", "reputation"))
+	return &view, nil
+}
+
+// AwardKill applies an NPC template's kill reputation to the player who
+// killed one of its NPCs. Kills by NPCs earn nothing.
+func (s *reputationService) AwardKill(ctx context.Context, charID int, npcTemplateID string) error {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return ErrCharacterNotFound
+	}
+	if char.IsNPC || npcTemplateID == "" {
+		return nil
+	}
+	tmpl, err := s.npcRepo.Get(ctx, npcTemplateID)
+	if err != nil {
+		return ErrNPCTemplateNotFound
+	}
+	return s.adjustAll(ctx, charID, killReputation(tmpl.Behavior.Faction, tmpl.Behavior.KillReputation))
+}
+
+// AwardQuest applies a finished quest's reputation rewards.
+func (s *reputationService) AwardQuest(ctx context.Context, charID, questID int) error {
+	q, err := s.questRepo.Get(ctx, questID)
+	if err != nil {
+		return ErrQuestMissing
+	}
+	return s.adjustAll(ctx, charID, q.Rewards.Reputation)
+}
+
+// adjustAll applies each faction's delta in faction order.
+func (s *reputationService) adjustAll(ctx context.Context, charID int, deltas map[string]int) error {
+	factions := make([]string, 0, len(deltas))
+	for f := range deltas {
+		factions = append(factions, f)
+	}
+	sort.Strings(factions)
+	for _, f := range factions {
+		if deltas[f] == 0 {
+			continue
+		}
+		if _, err := s.Adjust(ctx, charID, f, deltas[f]); err != nil {
+			return fmt.Errorf("adjust %s reputation: %w", f, err)
+		}
+	}
+	return nil
+}
+
+func standingView(faction string, standing int) ReputationStanding {
+	return ReputationStanding{Faction: faction, Standing: standing, Tier: ReputationTier(standing)}
+}
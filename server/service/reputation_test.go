@@ -0,0 +1,66 @@
+package service
+
+import "testing"
+
+func TestReputationTier(t *testing.T) {
+	cases := []struct {
+		standing int
+		want     string
+	}{
+		{-1000, RepHostile},
+		{-501, RepHostile},
+		{-500, RepUnfriendly},
+		{-101, RepUnfriendly},
+		{0, RepNeutral},
+		{99, RepNeutral},
+		{100, RepFriendly},
+		{300, RepHonored},
+		{600, RepRevered},
+		{1000, RepExalted},
+	}
+	for _, c := range cases {
+		if got := ReputationTier(c.standing); got != c.want {
+			t.Errorf("standing %d: got %s, want %s", c.standing, got, c.want)
+		}
+	}
+	if !TierAtLeast(RepHonored, RepFriendly) || TierAtLeast(RepNeutral, RepFriendly) {
+		t.Error("TierAtLeast ordered tiers wrongly")
+	}
+	if clampStanding(5000) != MaxStanding || clampStanding(-5000) != MinStanding {
+		t.Error("standings should be clamped to the limits")
+	}
+}
+
+func TestReputationPricing(t *testing.T) {
+	if got := reputationPrice(100, ReputationPriceMod(RepExalted)); got != 80 {
+		t.Errorf("exalted price: got %d, want 80", got)
+	}
+	if got := reputationPrice(100, ReputationPriceMod(RepUnfriendly)); got != 120 {
+		t.Errorf("unfriendly price: got %d, want 120", got)
+	}
+	if got := reputationPrice(1, ReputationPriceMod(RepExalted)); got != 1 {
+		t.Errorf("prices should never drop below 1, got %d", got)
+	}
+	if got := reputationOffer(100, 0.5, ReputationPriceMod(RepNeutral)); got != 50 {
+		t.Errorf("neutral offer: got %d, want 50", got)
+	}
+	if got := reputationOffer(100, 0.5, ReputationPriceMod(RepExalted)); got != 60 {
+		t.Errorf("exalted offer: got %d, want 60", got)
+	}
+	if got := reputationOffer(100, 0.9, ReputationPriceMod(RepExalted)); got != 80 {
+		t.Errorf("offers should not top the shop's price, got %d", got)
+	}
+}
+
+func TestKillReputation(t *testing.T) {
+	if got := killReputation("foot_clan", nil); got["foot_clan"] != DefaultKillReputation || len(got) != 1 {
+		t.Errorf("default kill reputation: got %v", got)
+	}
+	custom := map[string]int{"foot_clan": -25, "turtles": 5}
+	if got := killReputation("foot_clan", custom); len(got) != 2 || got["turtles"] != 5 {
+		t.Errorf("custom kill reputation: got %v", got)
+	}
+	if got := killReputation("", nil); got != nil {
+		t.Errorf("factionless NPCs should cost nothing, got %v", got)
+	}
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"herbst-server/db"
+	"herbst-server/repository"
+)
+
+var (
+	ErrHookNotFound   = errors.New("hook not found")
+	ErrNotRoomHook    = errors.New("hook does not target a room")
+	ErrHookDisabled   = errors.New("hook is disabled")
+	ErrRoomHookNoRoom = errors.New("no room to apply the effect in")
+)
+
+// Room hook targets.
+const (
+	HookTargetRoom             = "room"
+	HookTargetRoomExceptSource = "room_except_source"
+)
+
+// maxRoomEffectDepth limits apply_effect chains, matching the client.
+const maxRoomEffectDepth = 3
+
+// npcRoomEffectTypes are the effect types that also land on NPCs in the
+// room. Everything else (XP, messages, movement) only makes sense for
+// players.
+var npcRoomEffectTypes = map[string]bool{
+	"hp_change":      true,
+	"stamina_change": true,
+	"mana_change":    true,
+	"tag_add":        true,
+	"tag_remove":     true,
+}
+
+// roomEffectService implements RoomEffectService using repository interfaces.
+type roomEffectService struct {
+	charRepo   repository.CharacterRepo
+	hookRepo   repository.EffectHookRepo
+	effectRepo repository.EffectRepo
+	tagRepo    repository.CharacterTagRepo
+	conditions ConditionService
+}
+
+// NewRoomEffectService creates a new RoomEffectService.
+func NewRoomEffectService(
+	charRepo repository.CharacterRepo,
+	hookRepo repository.EffectHookRepo,
+	effectRepo repository.EffectRepo,
+	tagRepo repository.CharacterTagRepo,
+	conditions ConditionService,
+) RoomEffectService {
+	return &roomEffectService{
+		charRepo:   charRepo,
+		hookRepo:   hookRepo,
+		effectRepo: effectRepo,
+		tagRepo:    tagRepo,
+		conditions: conditions,
+	}
+}
+
+// ApplyRoomHook applies a room-targeted hook's effect to every occupant of
+// roomID (or the source's current room when roomID is 0). The hook's
+// condition is evaluated per occupant, with the occupant as target. Hooks
+// fired by the world rather than a character pass sourceID 0 and a room.
+func (s *roomEffectService) ApplyRoomHook(ctx context.Context, hookID, sourceID, roomID int, extras map[string]interface{}) (*RoomEffectResult, error) {
+	hook, err := s.hookRepo.GetWithEdges(ctx, hookID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, ErrHookNotFound
+		}
+		return nil, err
+	}
+	if hook.Target != HookTargetRoom && hook.Target != HookTargetRoomExceptSource {
+		return nil, ErrNotRoomHook
+	}
+	if !hook.Enabled {
+		return nil, ErrHookDisabled
+	}
+	eff := hook.Edges.Effect
+	if eff == nil {
+		return nil, fmt.Errorf("hook %d has no effect", hookID)
+	}
+	if roomID == 0 {
+		source, err := s.charRepo.Get(ctx, sourceID)
+		if err != nil {
+			return nil, ErrCharacterNotFound
+		}
+		roomID = source.CurrentRoomId
+	}
+	if roomID == 0 {
+		return nil, ErrRoomHookNoRoom
+	}
+
+	occupants, err := s.charRepo.ListByRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	npcTemplateID := ""
+	if hook.Edges.NpcTemplate != nil {
+		npcTemplateID = hook.Edges.NpcTemplate.ID
+	}
+
+	result := &RoomEffectResult{RoomID: roomID, EffectID: eff.ID, EffectType: eff.EffectType, Message: roomEffectMessage(eff)}
+	for _, ch := range occupants {
+		if hook.Target == HookTargetRoomExceptSource && ch.ID == sourceID {
+			continue
+		}
+		if hook.Condition != "" {
+			ok, err := s.conditions.Evaluate(ctx, hook.Condition, ConditionInput{
+				SourceID:      sourceID,
+				TargetID:      ch.ID,
+				RoomID:        roomID,
+				NPCTemplateID: npcTemplateID,
+				Extras:        extras,
+			})
+			if err != nil || !ok {
+				continue
+			}
+		}
+		applied, err := s.apply(ctx, eff, ch, 0)
+		if err != nil {
+			return result, fmt.Errorf("apply effect %d to character %d: %w", eff.ID, ch.ID, err)
+		}
+		if applied {
+			result.Targets = append(result.Targets, RoomEffectTarget{ID: ch.ID, Name: ch.Name, IsNPC: ch.IsNPC})
+		}
+	}
+	return result, nil
+}
+
+// ApplyHook applies a hook's effect to a single character, evaluating the
+// hook's condition with charID as target. It reports false when the
+// condition fails or the effect doesn't reach that character.
+func (s *roomEffectService) ApplyHook(ctx context.Context, hookID, sourceID, charID int, extras map[string]interface{}) (bool, error) {
+	hook, err := s.hookRepo.GetWithEdges(ctx, hookID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return false, ErrHookNotFound
+		}
+		return false, err
+	}
+	if !hook.Enabled {
+		return false, ErrHookDisabled
+	}
+	eff := hook.Edges.Effect
+	if eff == nil {
+		return false, fmt.Errorf("hook %d has no effect", hookID)
+	}
+	ch, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return false, ErrCharacterNotFound
+	}
+	if hook.Condition != "" {
+		in := ConditionInput{SourceID: sourceID, TargetID: ch.ID, RoomID: ch.CurrentRoomId, Extras: extras}
+		if hook.Edges.NpcTemplate != nil {
+			in.NPCTemplateID = hook.Edges.NpcTemplate.ID
+		}
+		if ok, err := s.conditions.Evaluate(ctx, hook.Condition, in); err != nil || !ok {
+			return false, nil
+		}
+	}
+	return s.apply(ctx, eff, ch, 0)
+}
+
+// ApplyTrigger applies a server-fired room trigger's effect. A self trigger
+// reaches only the source character; room triggers reach every occupant of
+// roomID, less the source for room_except_source. The trigger's condition is
+// evaluated per character, with that character as target.
+func (s *roomEffectService) ApplyTrigger(ctx context.Context, t *db.Trigger, sourceID, roomID int, extras map[string]interface{}) (*RoomEffectResult, error) {
+	eff, err := s.effectRepo.Get(ctx, t.TargetID)
+	if err != nil {
+		return nil, fmt.Errorf("trigger %d effect %d: %w", t.ID, t.TargetID, err)
+	}
+
+	var targets []*db.Character
+	if t.Affects == HookTargetRoom || t.Affects == HookTargetRoomExceptSource || sourceID == 0 {
+		if targets, err = s.charRepo.ListByRoom(ctx, roomID); err != nil {
+			return nil, err
+		}
+	} else {
+		ch, err := s.charRepo.Get(ctx, sourceID)
+		if err != nil {
+			return nil, ErrCharacterNotFound
+		}
+		targets = []*db.Character{ch}
+	}
+
+	result := &RoomEffectResult{RoomID: roomID, EffectID: eff.ID, EffectType: eff.EffectType, Message: roomEffectMessage(eff)}
+	for _, ch := range targets {
+		if t.Affects == HookTargetRoomExceptSource && ch.ID == sourceID {
+			continue
+		}
+		if t.Condition != "" {
+			ok, err := s.conditions.Evaluate(ctx, t.Condition, ConditionInput{
+				SourceID: sourceID,
+				TargetID: ch.ID,
+				RoomID:   roomID,
+				Extras:   extras,
+			})
+			if err != nil || !ok {
+				continue
+			}
+		}
+		applied, err := s.apply(ctx, eff, ch, 0)
+		if err != nil {
+			return result, fmt.Errorf("apply effect %d to character %d: %w", eff.ID, ch.ID, err)
+		}
+		if applied {
+			result.Targets = append(result.Targets, RoomEffectTarget{ID: ch.ID, Name: ch.Name, IsNPC: ch.IsNPC})
+		}
+	}
+	return result, nil
+}
+
+// apply applies eff to one occupant. It reports false when the effect
+// type doesn't reach this kind of character.
+func (s *roomEffectService) apply(ctx context.Context, eff *db.Effect, ch *db.Character, depth int) (bool, error) {
+	if depth > maxRoomEffectDepth {
+		return false, nil
+	}
+	if ch.IsNPC && !npcRoomEffectTypes[eff.EffectType] && eff.EffectType != "apply_effect" {
+		return false, nil
+	}
+
+	var updates repository.CharacterUpdates
+	switch eff.EffectType {
+	case "hp_change":
+		amount := effectIntParam(eff.Parameters, "amount")
+		if amount < 0 && ch.IsImmortal {
+			return false, nil
+		}
+		hp := clampStat(ch.Hitpoints+amount, ch.MaxHitpoints)
+		updates.Hitpoints = &hp
+	case "stamina_change":
+		v := clampStat(ch.Stamina+effectIntParam(eff.Parameters, "amount"), ch.MaxStamina)
+		updates.Stamina = &v
+	case "mana_change":
+		v := clampStat(ch.Mana+effectIntParam(eff.Parameters, "amount"), ch.MaxMana)
+		updates.Mana = &v
+	case "xp_gain", "xp_drain":
+		amount := effectIntParam(eff.Parameters, "amount")
+		if eff.EffectType == "xp_drain" {
+			amount = -amount
+		}
+		xp := ch.Xp + amount
+		if xp < 0 {
+			xp = 0
+		}
+		updates.Xp = &xp
+	case "xp_set":
+		xp := effectIntParam(eff.Parameters, "amount")
+		updates.Xp = &xp
+	case "bind_point_set":
+		roomID := effectIntParam(eff.Parameters, "room_id")
+		updates.RespawnRoomID = &roomID
+	case "teleport":
+		roomID := effectIntParam(eff.Parameters, "room_id")
+		updates.CurrentRoomID = &roomID
+	case "tag_add":
+		tag, _ := eff.Parameters["tag_name"].(string)
+		if tag == "" {
+			return false, nil
+		}
+		if _, err := s.tagRepo.Create(ctx, ch.ID, tag, "effect"); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "tag_remove":
+		tag, _ := eff.Parameters["tag_name"].(string)
+		tags, err := s.tagRepo.ListByCharacter(ctx, ch.ID)
+		if err != nil {
+			return false, err
+		}
+		for _, t := range tags {
+			if t.Tag == tag {
+				if err := s.tagRepo.Delete(ctx, t.ID); err != nil {
+					return false, err
+				}
+			}
+		}
+		return true, nil
+	case "message":
+		// Delivered by the caller from RoomEffectResult.Message.
+		return true, nil
+	case "apply_effect":
+		nested, err := s.effectRepo.Get(ctx, effectIntParam(eff.Parameters, "effect_id"))
+		if err != nil {
+			return false, nil
+		}
+		return s.apply(ctx, nested, ch, depth+1)
+	default:
+		return false, nil
+	}
+
+	if _, err := s.charRepo.Update(ctx, ch.ID, updates); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// roomEffectMessage is the line shown to the room's players: a message
+// effect's text, or the effect's on_start message.
+func roomEffectMessage(eff *db.Effect) string {
+	if eff.EffectType == "message" {
+		text, _ := eff.Parameters["text"].(string)
+		return text
+	}
+	return eff.Messages["on_start"]
+}
+
+// clampStat keeps a resource between 0 and its maximum.
+func clampStat(v, max int) int {
+	if v < 0 {
+		return 0
+	}
+	if max > 0 && v > max {
+		return max
+	}
+	return v
+}
+
+func effectIntParam(params map[string]interface{}, key string) int {
+	switch n := params[key].(type) {
+	case float64:
+		return int(n)
+	case int:
+		return n
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	default:
+		return 0
+	}
+}
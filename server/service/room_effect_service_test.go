@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"herbst-server/db"
+)
+
+// testRoomHook creates an effect of effectType and a hook applying it to
+// target.
+func testRoomHook(t *testing.T, client *db.Client, target, effectType string, params map[string]interface{}) *db.EffectHook {
+	t.Helper()
+	ctx := context.Background()
+	eff := client.Effect.Create().
+		SetName(t.Name() + " " + effectType).
+		SetDescription("test effect").
+		SetEffectType(effectType).
+		SetParameters(params).
+		SaveX(ctx)
+	return client.EffectHook.Create().
+		SetName(t.Name() + " hook").
+		SetEvent("on_enter_room").
+		SetTarget(target).
+		SetEffectID(eff.ID).
+		SaveX(ctx)
+}
+
+func TestApplyRoomHookReachesOccupants(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	hall := testRoom(t, client, "Hall")
+	yard := testRoom(t, client, "Yard")
+	source := testCharacter(t, client, "Leo", hall.ID, 0)
+	other := testCharacter(t, client, "Raph", hall.ID, 0)
+	npc := client.Character.Create().SetName("Foot Soldier").SetIsNPC(true).
+		SetCurrentRoomId(hall.ID).SetStartingRoomId(hall.ID).SaveX(ctx)
+	away := testCharacter(t, client, "Donnie", yard.ID, 0)
+	hook := testRoomHook(t, client, HookTargetRoom, "hp_change", map[string]interface{}{"amount": -10})
+
+	result, err := svc.RoomEffect.ApplyRoomHook(ctx, hook.ID, source.ID, 0, nil)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if result.RoomID != hall.ID || len(result.Targets) != 3 {
+		t.Fatalf("expected the three occupants of the hall, got %+v", result)
+	}
+	for _, id := range []int{source.ID, other.ID, npc.ID} {
+		if hp := client.Character.GetX(ctx, id).Hitpoints; hp != 90 {
+			t.Errorf("character %d: hp %d, want 90", id, hp)
+		}
+	}
+	if hp := client.Character.GetX(ctx, away.ID).Hitpoints; hp != 100 {
+		t.Errorf("a character in another room was hit: hp %d", hp)
+	}
+}
+
+func TestApplyRoomHookExceptSource(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	hall := testRoom(t, client, "Hall")
+	source := testCharacter(t, client, "Leo", hall.ID, 0)
+	other := testCharacter(t, client, "Raph", hall.ID, 0)
+	hook := testRoomHook(t, client, HookTargetRoomExceptSource, "hp_change", map[string]interface{}{"amount": -10})
+
+	result, err := svc.RoomEffect.ApplyRoomHook(ctx, hook.ID, source.ID, 0, nil)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if len(result.Targets) != 1 || result.Targets[0].ID != other.ID {
+		t.Fatalf("expected only %d to be reached, got %+v", other.ID, result.Targets)
+	}
+	if hp := client.Character.GetX(ctx, source.ID).Hitpoints; hp != 100 {
+		t.Errorf("the source was hit: hp %d", hp)
+	}
+}
+
+func TestApplyRoomHookSkipsNPCsForPlayerEffects(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	hall := testRoom(t, client, "Hall")
+	source := testCharacter(t, client, "Leo", hall.ID, 0)
+	npc := client.Character.Create().SetName("Foot Soldier").SetIsNPC(true).
+		SetCurrentRoomId(hall.ID).SetStartingRoomId(hall.ID).SaveX(ctx)
+	hook := testRoomHook(t, client, HookTargetRoom, "xp_gain", map[string]interface{}{"amount": 50})
+
+	result, err := svc.RoomEffect.ApplyRoomHook(ctx, hook.ID, source.ID, 0, nil)
+	if err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if len(result.Targets) != 1 || result.Targets[0].ID != source.ID {
+		t.Fatalf("expected only the player to be reached, got %+v", result.Targets)
+	}
+	if xp := client.Character.GetX(ctx, source.ID).Xp; xp != 50 {
+		t.Errorf("player xp %d, want 50", xp)
+	}
+	if xp := client.Character.GetX(ctx, npc.ID).Xp; xp != 0 {
+		t.Errorf("NPC gained xp: %d", xp)
+	}
+}
+
+func TestApplyRoomHookRejectsOtherTargets(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	hall := testRoom(t, client, "Hall")
+	source := testCharacter(t, client, "Leo", hall.ID, 0)
+	hook := testRoomHook(t, client, "self", "hp_change", map[string]interface{}{"amount": 10})
+
+	if _, err := svc.RoomEffect.ApplyRoomHook(ctx, hook.ID, source.ID, 0, nil); err != ErrNotRoomHook {
+		t.Fatalf("got %v, want ErrNotRoomHook", err)
+	}
+}
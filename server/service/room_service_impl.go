@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"herbst-server/db"
 	"herbst-server/db/character"
@@ -51,6 +52,7 @@ func (s *roomService) CreateRoom(ctx context.Context, input CreateRoomInput) (*d
 		IsStartingRoom: input.IsStartingRoom,
 		IsRootRoom:     input.IsRootRoom,
 		Exits:          input.Exits,
+		ExitStates:     normalizeExitStates(input.ExitStates),
 		Atmosphere:     input.Atmosphere,
 		PosZ:           input.PosZ,
 		WorldID:        input.WorldID,
@@ -120,6 +122,22 @@ func (s *roomService) UpdateRoom(ctx context.Context, id int, input UpdateRoomIn
 		PosZ:           input.PosZ,
 		ZoneIDs:        input.ZoneIDs,
 	}
+	// Exit states follow the exits: new states are checked against the
+	// exits the room will have, and removed exits lose their state.
+	exits := existing.Exits
+	if input.Exits != nil {
+		exits = *input.Exits
+	}
+	if input.ExitStates != nil {
+		if errs := ValidateExitStates(exits, *input.ExitStates); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid exit states: %s", strings.Join(errs, "; "))
+		}
+		states := normalizeExitStates(*input.ExitStates)
+		updates.ExitStates = &states
+	} else if input.Exits != nil && len(existing.ExitStates) > 0 {
+		states := exitStatesFor(exits, existing.ExitStates)
+		updates.ExitStates = &states
+	}
 	updated, err := s.roomRepo.Update(ctx, id, updates)
 	if err != nil {
 		return nil, err
@@ -236,6 +254,7 @@ func (s *roomService) DeleteRoom(ctx context.Context, id int) error {
 			if changed {
 				_, err := tx.Room.UpdateOneID(r.ID).
 					SetExits(newExits).
+					SetExitStates(exitStatesFor(newExits, r.ExitStates)).
 					AddVersion(1).
 					Save(ctx)
 				if err != nil {
@@ -278,7 +297,8 @@ func (s *roomService) CleanupOrphanExits(ctx context.Context, worldID string) (i
 			newExits[dir] = targetID
 		}
 		if changed {
-			_, err := s.roomRepo.Update(ctx, r.ID, repository.RoomUpdates{Exits: &newExits})
+			states := exitStatesFor(newExits, r.ExitStates)
+			_, err := s.roomRepo.Update(ctx, r.ID, repository.RoomUpdates{Exits: &newExits, ExitStates: &states})
 			if err != nil {
 				continue
 			}
@@ -355,7 +375,8 @@ func (s *roomService) DeleteBidirectionalExit(ctx context.Context, sourceID int,
 	}
 	targetID, hasTarget := sourceExits[direction]
 	delete(sourceExits, direction)
-	_, err = s.roomRepo.Update(ctx, sourceID, repository.RoomUpdates{Exits: &sourceExits})
+	sourceStates := exitStatesFor(sourceExits, source.ExitStates)
+	_, err = s.roomRepo.Update(ctx, sourceID, repository.RoomUpdates{Exits: &sourceExits, ExitStates: &sourceStates})
 	if err != nil {
 		return err
 	}
@@ -367,7 +388,8 @@ func (s *roomService) DeleteBidirectionalExit(ctx context.Context, sourceID int,
 		targetExits := target.Exits
 		if targetExits != nil {
 			delete(targetExits, reverseDir)
-			_, _ = s.roomRepo.Update(ctx, targetID, repository.RoomUpdates{Exits: &targetExits})
+			targetStates := exitStatesFor(targetExits, target.ExitStates)
+			_, _ = s.roomRepo.Update(ctx, targetID, repository.RoomUpdates{Exits: &targetExits, ExitStates: &targetStates})
 		}
 	}
 	return nil
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"herbst-server/db"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+// Room trigger types the server fires itself.
+const (
+	TriggerEnter = "enter"
+	TriggerLeave = "leave"
+	TriggerSay   = "say"
+	TriggerDrop  = "drop"
+	TriggerTimer = "timer"
+)
+
+// roomTriggerService implements RoomTriggerService using repository
+// interfaces.
+type roomTriggerService struct {
+	triggerRepo repository.TriggerRepo
+	charRepo    repository.CharacterRepo
+	roomEffects RoomEffectService
+	logger      *slog.Logger
+
+	// lastFired is when each timer trigger last fired. Tick runs on one
+	// goroutine, so it needs no lock.
+	lastFired map[int]time.Time
+}
+
+// NewRoomTriggerService creates a new RoomTriggerService.
+func NewRoomTriggerService(
+	triggerRepo repository.TriggerRepo,
+	charRepo repository.CharacterRepo,
+	roomEffects RoomEffectService,
+	logger *slog.Logger,
+) RoomTriggerService {
+	return &roomTriggerService{
+		triggerRepo: triggerRepo,
+		charRepo:    charRepo,
+		roomEffects: roomEffects,
+		logger:      logger,
+		lastFired:   map[int]time.Time{},
+	}
+}
+
+// Entered fires the enter triggers of the room charID just walked into.
+func (s *roomTriggerService) Entered(ctx context.Context, charID, roomID int) {
+	s.fire(ctx, TriggerEnter, charID, roomID, nil, func(*db.Trigger) bool { return true })
+}
+
+// Left fires the leave triggers of the room charID just walked out of.
+func (s *roomTriggerService) Left(ctx context.Context, charID, roomID int) {
+	s.fire(ctx, TriggerLeave, charID, roomID, nil, func(*db.Trigger) bool { return true })
+}
+
+// Said fires the say triggers whose keyword is in message.
+func (s *roomTriggerService) Said(ctx context.Context, charID, roomID int, message string) {
+	extras := map[string]interface{}{"message": message}
+	s.fire(ctx, TriggerSay, charID, roomID, extras, func(t *db.Trigger) bool {
+		return saidKeyword(message, t.Keyword)
+	})
+}
+
+// Dropped fires the drop triggers whose keyword is part of the dropped
+// item's name.
+func (s *roomTriggerService) Dropped(ctx context.Context, charID, roomID int, itemName string) {
+	extras := map[string]interface{}{"item_name": itemName}
+	s.fire(ctx, TriggerDrop, charID, roomID, extras, func(t *db.Trigger) bool {
+		return droppedMatches(itemName, t.Keyword)
+	})
+}
+
+// Tick fires every timer trigger whose interval has passed, as long as a
+// player is in its room to notice. The first tick only starts each timer,
+// so a restart doesn't fire them all at once.
+func (s *roomTriggerService) Tick(ctx context.Context, now time.Time) error {
+	triggers, err := s.triggerRepo.ListByTriggerType(ctx, TriggerTimer)
+	if err != nil {
+		return err
+	}
+	for _, t := range triggers {
+		if !t.Enabled || t.RoomID == nil || t.IntervalSecs <= 0 || t.TargetType != "effect" {
+			continue
+		}
+		last, started := s.lastFired[t.ID]
+		if started && now.Sub(last) < time.Duration(t.IntervalSecs)*time.Second {
+			continue
+		}
+		s.lastFired[t.ID] = now
+		if !started || !s.playerPresent(ctx, *t.RoomID) {
+			continue
+		}
+		s.run(ctx, t, 0, *t.RoomID, nil)
+	}
+	return nil
+}
+
+// fire runs the enabled triggers of type typ in roomID that match.
+func (s *roomTriggerService) fire(ctx context.Context, typ string, charID, roomID int, extras map[string]interface{}, match func(*db.Trigger) bool) {
+	if roomID == 0 {
+		return
+	}
+	triggers, err := s.triggerRepo.ListByRoom(ctx, roomID)
+	if err != nil {
+		s.logger.Warn("failed to list room triggers", "room_id", roomID, "error", err, slog.String("service", "room_triggers"))
+		return
+	}
+	for _, t := range triggers {
+		// Only effects run server-side; recipe and dialog triggers are
+		// played out by the client.
+		if t.TriggerType != typ || !t.Enabled || t.TargetType != "effect" || !match(t) {
+			continue
+		}
+		s.run(ctx, t, charID, roomID, extras)
+	}
+}
+
+// run applies a trigger's effect and shows its message to whoever it
+// reached: the source alone for self triggers, otherwise the room.
+func (s *roomTriggerService) run(ctx context.Context, t *db.Trigger, sourceID, roomID int, extras map[string]interface{}) {
+	in := map[string]interface{}{"trigger_id": t.ID, "trigger_type": t.TriggerType}
+	for k, v := range extras {
+		in[k] = v
+	}
+	result, err := s.roomEffects.ApplyTrigger(ctx, t, sourceID, roomID, in)
+	if err != nil {
+		s.logger.Warn("room trigger failed", "trigger_id", t.ID, "room_id", roomID, "error", err, slog.String("service", "room_triggers"))
+		return
+	}
+	if result.Message == "" || len(result.Targets) == 0 {
+		return
+	}
+	ev := stream.Event{Type: stream.TypeEffect, Text: result.Message, ActorID: sourceID}
+	switch {
+	case t.Affects == HookTargetRoomExceptSource:
+		stream.Default().ToRoom(ctx, roomID, ev, sourceID)
+	case t.Affects == HookTargetRoom || sourceID == 0:
+		stream.Default().ToRoom(ctx, roomID, ev)
+	default:
+		stream.Default().Send(sourceID, ev)
+	}
+}
+
+// playerPresent reports whether a connected player is in roomID.
+func (s *roomTriggerService) playerPresent(ctx context.Context, roomID int) bool {
+	occupants, err := s.charRepo.ListByRoom(ctx, roomID)
+	if err != nil {
+		return false
+	}
+	for _, ch := range occupants {
+		if !ch.IsNPC && stream.Default().Listening(ch.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// saidKeyword reports whether message contains keyword as whole words,
+// ignoring case and punctuation. An empty keyword matches anything said.
+func saidKeyword(message, keyword string) bool {
+	kw := spokenWords(keyword)
+	if kw == "" {
+		return true
+	}
+	return strings.Contains(" "+spokenWords(message)+" ", " "+kw+" ")
+}
+
+// spokenWords lowercases s and reduces it to its words, one space apart.
+func spokenWords(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// droppedMatches reports whether keyword is part of the dropped item's
+// name, ignoring case. An empty keyword matches any item.
+func droppedMatches(itemName, keyword string) bool {
+	return strings.Contains(strings.ToLower(itemName), strings.ToLower(strings.TrimSpace(keyword)))
+}
@@ -0,0 +1,40 @@
+package service
+
+import "testing"
+
+func TestSaidKeyword(t *testing.T) {
+	cases := []struct {
+		message, keyword string
+		want             bool
+	}{
+		{"Open sesame!", "open sesame", true},
+		{"please, OPEN... sesame", "open sesame", true},
+		{"reopen sesame", "open sesame", false},
+		{"open the sesame", "open sesame", false},
+		{"hello there", "", true},
+		{"hello there", "  ", true},
+		{"friend", "mellon", false},
+	}
+	for _, c := range cases {
+		if got := saidKeyword(c.message, c.keyword); got != c.want {
+			t.Errorf("saidKeyword(%q, %q) = %v, want %v", c.message, c.keyword, got, c.want)
+		}
+	}
+}
+
+func TestDroppedMatches(t *testing.T) {
+	cases := []struct {
+		item, keyword string
+		want          bool
+	}{
+		{"Golden Idol", "idol", true},
+		{"Golden Idol", " Golden ", true},
+		{"Rusty Dagger", "idol", false},
+		{"Rusty Dagger", "", true},
+	}
+	for _, c := range cases {
+		if got := droppedMatches(c.item, c.keyword); got != c.want {
+			t.Errorf("droppedMatches(%q, %q) = %v, want %v", c.item, c.keyword, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,525 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"herbst-server/constants"
+	"herbst-server/db"
+	"herbst-server/db/character"
+	"herbst-server/db/equipment"
+	"herbst-server/db/shopitem"
+	"herbst-server/db/shoptemplate"
+	"herbst-server/repository"
+)
+
+var (
+	ErrNoShopkeeper     = errors.New("there is no shopkeeper here")
+	ErrItemNotForSale   = errors.New("the shopkeeper doesn't sell that")
+	ErrOutOfStock       = errors.New("the shopkeeper is out of stock")
+	ErrInsufficientGold = errors.New("you can't afford that")
+	ErrItemNotInventory = errors.New("you don't have that item")
+	ErrItemNotSellable  = errors.New("the shopkeeper won't buy that")
+	ErrShopCannotAfford = errors.New("the shopkeeper can't afford to buy that")
+	ErrInvalidQuantity  = errors.New("quantity must be at least 1")
+)
+
+// stackableItemTypes are item types bought as a single stacked row.
+var stackableItemTypes = map[string]bool{
+	"consumable": true,
+	"potion":     true,
+	"ingredient": true,
+}
+
+// shopService implements ShopService using repository interfaces.
+type shopService struct {
+	charRepo     repository.CharacterRepo
+	npcRepo      repository.NPCTemplateRepo
+	shopRepo     repository.ShopTemplateRepo
+	shopItemRepo repository.ShopItemRepo
+	equipRepo    repository.EquipmentRepo
+	templateRepo repository.EquipmentTemplateRepo
+	tx           repository.TransactionRunner
+	reputation   ReputationService
+}
+
+// NewShopService creates a new ShopService.
+func NewShopService(
+	charRepo repository.CharacterRepo,
+	npcRepo repository.NPCTemplateRepo,
+	shopRepo repository.ShopTemplateRepo,
+	shopItemRepo repository.ShopItemRepo,
+	equipRepo repository.EquipmentRepo,
+	templateRepo repository.EquipmentTemplateRepo,
+	tx repository.TransactionRunner,
+	reputation ReputationService,
+) ShopService {
+	return &shopService{
+		charRepo:     charRepo,
+		npcRepo:      npcRepo,
+		shopRepo:     shopRepo,
+		shopItemRepo: shopItemRepo,
+		equipRepo:    equipRepo,
+		templateRepo: templateRepo,
+		tx:           tx,
+		reputation:   reputation,
+	}
+}
+
+// ItemValue returns the base trade value of an item from its level and rarity.
+func ItemValue(level int, rarity string) int {
+	if level < 1 {
+		level = 1
+	}
+	return level * 10 * constants.RarityValueMultiplier(rarity)
+}
+
+// shopContext is the shop a character is currently standing in.
+type shopContext struct {
+	char       *db.Character
+	shopkeeper *db.Character
+	shop       *db.ShopTemplate
+	priceMod   float64 // from the character's reputation with the shopkeeper's faction
+}
+
+// findShop locates a living shopkeeper NPC in the character's room and the
+// shop they serve. Shopkeepers won't trade with characters hostile with
+// their faction.
+func (s *shopService) findShop(ctx context.Context, charID int) (*shopContext, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	npcs, err := s.charRepo.ListNPCsByRoom(ctx, char.CurrentRoomId)
+	if err != nil {
+		return nil, fmt.Errorf("list npcs in room: %w", err)
+	}
+	for _, npc := range npcs {
+		if npc.Hitpoints <= 0 || npc.NpcTemplateID == "" {
+			continue
+		}
+		tmpl, err := s.npcRepo.Get(ctx, npc.NpcTemplateID)
+		if err != nil || tmpl.Disposition != "shopkeeper" {
+			continue
+		}
+		shop, err := s.shopRepo.GetForNPC(ctx, npc.ID, npc.NpcTemplateID)
+		if err != nil {
+			continue
+		}
+		tier := s.reputation.Tier(ctx, charID, tmpl.Behavior.Faction)
+		if tier == RepHostile {
+			return nil, ErrNPCHostile
+		}
+		return &shopContext{char: char, shopkeeper: npc, shop: shop, priceMod: ReputationPriceMod(tier)}, nil
+	}
+	return nil, ErrNoShopkeeper
+}
+
+// wares returns the enabled items of a shop with their template names
+// resolved, priced for the character's reputation.
+func (s *shopService) wares(ctx context.Context, sc *shopContext) ([]ShopWare, error) {
+	items, err := s.shopItemRepo.ListByShop(ctx, sc.shop.ID)
+	if err != nil {
+		return nil, err
+	}
+	var wares []ShopWare
+	for _, it := range items {
+		if !it.IsEnabled {
+			continue
+		}
+		tmpl, err := s.templateRepo.Get(ctx, it.EquipmentTemplateID)
+		if err != nil {
+			continue
+		}
+		wares = append(wares, ShopWare{
+			ShopItemID:          it.ID,
+			EquipmentTemplateID: it.EquipmentTemplateID,
+			Name:                tmpl.Name,
+			Category:            it.Category,
+			Price:               reputationPrice(it.Price, sc.priceMod),
+			Quantity:            it.Quantity,
+		})
+	}
+	return wares, nil
+}
+
+// ListWares returns the stock of the shop in the character's room.
+func (s *shopService) ListWares(ctx context.Context, charID int) (*ShopView, error) {
+	sc, err := s.findShop(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	wares, err := s.wares(ctx, sc)
+	if err != nil {
+		return nil, fmt.Errorf("list wares: %w", err)
+	}
+	return &ShopView{
+		ShopID:         sc.shop.ID,
+		ShopName:       sc.shop.Name,
+		ShopkeeperID:   sc.shopkeeper.ID,
+		ShopkeeperName: sc.shopkeeper.Name,
+		Wares:          wares,
+	}, nil
+}
+
+// Buy purchases quantity units of the named ware. Gold, stock and shop
+// reserves move in a single transaction.
+func (s *shopService) Buy(ctx context.Context, charID int, itemName string, quantity int) (*ShopTradeResult, error) {
+	if quantity < 1 {
+		return nil, ErrInvalidQuantity
+	}
+	sc, err := s.findShop(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	wares, err := s.wares(ctx, sc)
+	if err != nil {
+		return nil, fmt.Errorf("list wares: %w", err)
+	}
+	var ware *ShopWare
+	for i := range wares {
+		if strings.Contains(strings.ToLower(wares[i].Name), strings.ToLower(itemName)) {
+			ware = &wares[i]
+			break
+		}
+	}
+	if ware == nil {
+		return nil, ErrItemNotForSale
+	}
+
+	total := ware.Price * quantity
+	var itemIDs []int
+	var balance int
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.ShopItem.Update().
+			Where(shopitem.ID(ware.ShopItemID), shopitem.QuantityGTE(quantity)).
+			AddQuantity(-quantity).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrOutOfStock
+		}
+		n, err = tx.Character.Update().
+			Where(character.ID(charID), character.GoldCreditsGTE(total)).
+			AddGoldCredits(-total).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrInsufficientGold
+		}
+		if err := tx.ShopTemplate.UpdateOneID(sc.shop.ID).AddGoldReserves(total).Exec(ctx); err != nil {
+			return err
+		}
+
+		tmpl, err := tx.EquipmentTemplate.Get(ctx, ware.EquipmentTemplateID)
+		if err != nil {
+			return err
+		}
+		rows, stack := quantity, 1
+		if stackableItemTypes[tmpl.ItemType] {
+			rows, stack = 1, quantity
+		}
+		for i := 0; i < rows; i++ {
+			created, err := newEquipmentFromTemplate(tx, tmpl).
+				SetOwnerId(charID).
+				SetQuantity(stack).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			itemIDs = append(itemIDs, created.ID)
+		}
+
+		buyer, err := tx.Character.Get(ctx, charID)
+		if err != nil {
+			return err
+		}
+		balance = buyer.GoldCredits
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShopTradeResult{
+		ItemName:    ware.Name,
+		ItemIDs:     itemIDs,
+		Quantity:    quantity,
+		Gold:        total,
+		GoldCredits: balance,
+		Message:     fmt.Sprintf("You buy %d x %s from %s for %d gold.", quantity, ware.Name, sc.shopkeeper.Name, total),
+	}, nil
+}
+
+// findInventoryItem returns the first unequipped inventory item whose name
+// contains itemName.
+func (s *shopService) findInventoryItem(ctx context.Context, charID int, itemName string) (*db.Equipment, error) {
+	inventory, err := s.equipRepo.ListByOwner(ctx, charID)
+	if err != nil {
+		return nil, fmt.Errorf("list inventory: %w", err)
+	}
+	for _, it := range inventory {
+		if !it.IsEquipped && strings.Contains(strings.ToLower(it.Name), strings.ToLower(itemName)) {
+			return it, nil
+		}
+	}
+	return nil, ErrItemNotInventory
+}
+
+// appraise computes what the shop would pay for one unit of an item.
+func (s *shopService) appraise(ctx context.Context, sc *shopContext, item *db.Equipment) *ShopAppraisal {
+	value := ItemValue(item.Level, item.Rarity)
+	stocked := false
+	if item.EquipmentTemplateID > 0 {
+		if si, err := s.shopItemRepo.Get(ctx, sc.shop.ID, item.EquipmentTemplateID); err == nil && si.Price > 0 {
+			value = si.Price
+			stocked = true
+		}
+	}
+	ratio := sc.shop.BuybackRatio
+	if ratio <= 0 {
+		ratio = 0.5
+	}
+	offer := reputationOffer(value, ratio, sc.priceMod)
+
+	a := &ShopAppraisal{
+		ItemID:   item.ID,
+		ItemName: item.Name,
+		Value:    value,
+		Offer:    offer,
+		WillBuy:  true,
+		Stocked:  stocked,
+	}
+	switch {
+	case item.IsImmovable || item.ItemType == "quest" || item.ItemType == "corpse":
+		a.WillBuy = false
+		a.Reason = ErrItemNotSellable.Error()
+	case sc.shop.GoldReserves < offer:
+		a.WillBuy = false
+		a.Reason = ErrShopCannotAfford.Error()
+	}
+	return a
+}
+
+// Appraise reports what the shopkeeper would pay for an inventory item.
+func (s *shopService) Appraise(ctx context.Context, charID int, itemName string) (*ShopAppraisal, error) {
+	sc, err := s.findShop(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.findInventoryItem(ctx, charID, itemName)
+	if err != nil {
+		return nil, err
+	}
+	return s.appraise(ctx, sc, item), nil
+}
+
+// Sell sells one unit of an inventory item to the shopkeeper, paid from the
+// shop's gold reserves. Items the shop stocks go back on its shelves.
+func (s *shopService) Sell(ctx context.Context, charID int, itemName string) (*ShopTradeResult, error) {
+	sc, err := s.findShop(ctx, charID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.findInventoryItem(ctx, charID, itemName)
+	if err != nil {
+		return nil, err
+	}
+	a := s.appraise(ctx, sc, item)
+	if !a.WillBuy && a.Reason == ErrItemNotSellable.Error() {
+		return nil, ErrItemNotSellable
+	}
+
+	var balance int
+	err = s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		n, err := tx.ShopTemplate.Update().
+			Where(shoptemplate.ID(sc.shop.ID), shoptemplate.GoldReservesGTE(a.Offer)).
+			AddGoldReserves(-a.Offer).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrShopCannotAfford
+		}
+
+		// Take one unit, guarding against the item having moved since lookup.
+		if item.Quantity > 1 {
+			n, err = tx.Equipment.Update().
+				Where(equipment.ID(item.ID), equipment.OwnerId(charID), equipment.QuantityGT(1)).
+				AddQuantity(-1).
+				Save(ctx)
+		} else {
+			n, err = tx.Equipment.Delete().
+				Where(equipment.ID(item.ID), equipment.OwnerId(charID)).
+				Exec(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrItemNotInventory
+		}
+
+		if a.Stocked {
+			if err := restockOne(ctx, tx, sc.shop, item.EquipmentTemplateID); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Character.UpdateOneID(charID).AddGoldCredits(a.Offer).Exec(ctx); err != nil {
+			return err
+		}
+		seller, err := tx.Character.Get(ctx, charID)
+		if err != nil {
+			return err
+		}
+		balance = seller.GoldCredits
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShopTradeResult{
+		ItemName:    item.Name,
+		ItemIDs:     []int{item.ID},
+		Quantity:    1,
+		Gold:        a.Offer,
+		GoldCredits: balance,
+		Message:     fmt.Sprintf("You sell %s to %s for %d gold.", item.Name, sc.shopkeeper.Name, a.Offer),
+	}, nil
+}
+
+// reputationPrice is what a shop charges for an item listed at price from
+// a character its prices are multiplied by mod for.
+func reputationPrice(price int, mod float64) int {
+	if mod <= 0 {
+		return price
+	}
+	return max(1, int(float64(price)*mod))
+}
+
+// reputationOffer is what a shop pays for an item worth value at its
+// buyback ratio. A discount on its prices is a bonus on its offers, but an
+// offer never tops what the shop would charge for the item.
+func reputationOffer(value int, ratio, mod float64) int {
+	if mod <= 0 {
+		mod = 1
+	}
+	offer := int(float64(value) * ratio * (2 - mod))
+	return max(1, min(offer, reputationPrice(value, mod)))
+}
+
+// restockOne puts a bought-back unit on the shelf, respecting the item's
+// max_stock and the shop's max_inventory.
+func restockOne(ctx context.Context, tx *db.Tx, shop *db.ShopTemplate, templateID int) error {
+	items, err := tx.ShopItem.Query().Where(shopitem.ShopIDEQ(shop.ID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	total := 0
+	var target *db.ShopItem
+	for _, it := range items {
+		total += it.Quantity
+		if it.EquipmentTemplateID == templateID {
+			target = it
+		}
+	}
+	if target == nil || target.Quantity >= target.MaxStock || total >= shop.MaxInventory {
+		return nil
+	}
+	return tx.ShopItem.UpdateOneID(target.ID).AddQuantity(1).Exec(ctx)
+}
+
+// Restock refills every enabled item of a shop to its max_stock.
+func (s *shopService) Restock(ctx context.Context, shopID int) (int, error) {
+	now := time.Now()
+	restocked := 0
+	err := s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		items, err := tx.ShopItem.Query().
+			Where(shopitem.ShopIDEQ(shopID), shopitem.IsEnabledEQ(true)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, it := range items {
+			if it.Quantity >= it.MaxStock {
+				continue
+			}
+			if err := tx.ShopItem.UpdateOneID(it.ID).
+				SetQuantity(it.MaxStock).
+				SetLastRestocked(now).
+				Exec(ctx); err != nil {
+				return err
+			}
+			restocked++
+		}
+		return tx.ShopTemplate.UpdateOneID(shopID).SetLastRestocked(now).Exec(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return restocked, nil
+}
+
+// RestockDue restocks every active shop whose restock interval has elapsed.
+// It returns the number of shops restocked.
+func (s *shopService) RestockDue(ctx context.Context, now time.Time) (int, error) {
+	shops, err := s.shopRepo.ListActive(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, shop := range shops {
+		if shop.RestockIntervalSeconds <= 0 {
+			continue
+		}
+		interval := time.Duration(shop.RestockIntervalSeconds) * time.Second
+		if shop.LastRestocked != nil && now.Sub(*shop.LastRestocked) < interval {
+			continue
+		}
+		if _, err := s.Restock(ctx, shop.ID); err != nil {
+			return count, fmt.Errorf("restock shop %d: %w", shop.ID, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// newEquipmentFromTemplate returns a create builder pre-filled from a template.
+func newEquipmentFromTemplate(tx *db.Tx, t *db.EquipmentTemplate) *db.EquipmentCreate {
+	return tx.Equipment.Create().
+		SetName(t.Name).
+		SetDescription(t.Description).
+		SetSlot(t.Slot).
+		SetLevel(t.Level).
+		SetWeight(t.Weight).
+		SetItemType(t.ItemType).
+		SetArmorRating(t.ArmorRating).
+		SetArmorType(t.ArmorType).
+		SetDamageDiceCount(t.DamageDiceCount).
+		SetDamageDiceSides(t.DamageDiceSides).
+		SetDamageBonus(t.DamageBonus).
+		SetDamageType(t.DamageType).
+		SetWeaponType(t.WeaponType).
+		SetIsTwoHanded(t.IsTwoHanded).
+		SetStats(t.Stats).
+		SetRarity(t.Rarity).
+		SetSkillRequirement(t.SkillRequirement).
+		SetSkillRequirementLevel(t.SkillRequirementLevel).
+		SetIsImmovable(t.IsImmovable).
+		SetColor(t.Color).
+		SetIsVisible(t.IsVisible).
+		SetEffectType(t.EffectType).
+		SetEffectValue(t.EffectValue).
+		SetEffectDuration(t.EffectDuration).
+		SetEquipmentTemplateID(t.ID)
+}
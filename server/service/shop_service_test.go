@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/npctemplate"
+)
+
+// shopFixture is a shopkeeper standing in a room, selling potions.
+type shopFixture struct {
+	room  *db.Room
+	shop  *db.ShopTemplate
+	ware  *db.ShopItem
+	tmpl  *db.EquipmentTemplate
+	buyer *db.Character
+}
+
+// newShopFixture stocks 3 potions at 10 gold each, up to 5, in a shop with
+// 100 gold in reserve. The buyer carries gold.
+func newShopFixture(t *testing.T, client *db.Client, gold int) *shopFixture {
+	t.Helper()
+	ctx := context.Background()
+	f := &shopFixture{room: testRoom(t, client, "Market")}
+	client.NPCTemplate.Create().
+		SetID("merchant").
+		SetName("Merchant").
+		SetDescription("A merchant.").
+		SetDisposition(npctemplate.DispositionShopkeeper).
+		SetSkills(map[string]int{}).
+		SetTradesWith([]string{}).
+		SetGreeting("Welcome!").
+		SaveX(ctx)
+	client.Character.Create().
+		SetName("Merchant").
+		SetIsNPC(true).
+		SetNpcTemplateID("merchant").
+		SetCurrentRoomId(f.room.ID).
+		SetStartingRoomId(f.room.ID).
+		SaveX(ctx)
+	f.shop = client.ShopTemplate.Create().
+		SetName("Potion Shop").
+		SetNpcTemplateID("merchant").
+		SetGoldReserves(100).
+		SaveX(ctx)
+	f.tmpl = client.EquipmentTemplate.Create().
+		SetSlug("healing_potion").
+		SetName("Healing Potion").
+		SetDescription("Red and fizzy.").
+		SetSlot("none").
+		SetItemType("potion").
+		SaveX(ctx)
+	f.ware = client.ShopItem.Create().
+		SetShopID(f.shop.ID).
+		SetEquipmentTemplateID(f.tmpl.ID).
+		SetCategory("potions").
+		SetPrice(10).
+		SetQuantity(3).
+		SetMaxStock(5).
+		SaveX(ctx)
+	f.buyer = testCharacter(t, client, "Leo", f.room.ID, gold)
+	return f
+}
+
+func TestShopBuyMovesGoldAndStock(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newShopFixture(t, client, 100)
+
+	result, err := svc.Shop.Buy(ctx, f.buyer.ID, "potion", 2)
+	if err != nil {
+		t.Fatalf("buy: %v", err)
+	}
+	if result.Gold != 20 || result.GoldCredits != 80 {
+		t.Errorf("paid %d leaving %d, want 20 leaving 80", result.Gold, result.GoldCredits)
+	}
+	if q := client.ShopItem.GetX(ctx, f.ware.ID).Quantity; q != 1 {
+		t.Errorf("stock %d, want 1", q)
+	}
+	if r := client.ShopTemplate.GetX(ctx, f.shop.ID).GoldReserves; r != 120 {
+		t.Errorf("reserves %d, want 120", r)
+	}
+	// Potions stack, so both come as one row.
+	if len(result.ItemIDs) != 1 || client.Equipment.GetX(ctx, result.ItemIDs[0]).Quantity != 2 {
+		t.Errorf("expected one stack of 2 potions, got %v", result.ItemIDs)
+	}
+
+	if _, err := svc.Shop.Buy(ctx, f.buyer.ID, "potion", 2); !errors.Is(err, ErrOutOfStock) {
+		t.Fatalf("buying past the stock: got %v, want ErrOutOfStock", err)
+	}
+	if g := client.Character.GetX(ctx, f.buyer.ID).GoldCredits; g != 80 {
+		t.Errorf("a failed purchase cost gold: %d", g)
+	}
+}
+
+func TestShopBuyInsufficientGoldRollsBack(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newShopFixture(t, client, 5)
+
+	if _, err := svc.Shop.Buy(ctx, f.buyer.ID, "potion", 1); !errors.Is(err, ErrInsufficientGold) {
+		t.Fatalf("got %v, want ErrInsufficientGold", err)
+	}
+	if q := client.ShopItem.GetX(ctx, f.ware.ID).Quantity; q != 3 {
+		t.Errorf("stock taken without payment: %d", q)
+	}
+	if r := client.ShopTemplate.GetX(ctx, f.shop.ID).GoldReserves; r != 100 {
+		t.Errorf("reserves changed: %d", r)
+	}
+}
+
+func TestShopSellPaysFromReserves(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newShopFixture(t, client, 0)
+	item := client.Equipment.Create().
+		SetName("Healing Potion").
+		SetDescription("Red and fizzy.").
+		SetSlot("none").
+		SetItemType("potion").
+		SetOwnerId(f.buyer.ID).
+		SetEquipmentTemplateID(f.tmpl.ID).
+		SaveX(ctx)
+
+	result, err := svc.Shop.Sell(ctx, f.buyer.ID, "potion")
+	if err != nil {
+		t.Fatalf("sell: %v", err)
+	}
+	// A stocked item is worth its shop price; the shop pays half.
+	if result.Gold != 5 || result.GoldCredits != 5 {
+		t.Errorf("paid %d leaving %d, want 5 leaving 5", result.Gold, result.GoldCredits)
+	}
+	if r := client.ShopTemplate.GetX(ctx, f.shop.ID).GoldReserves; r != 95 {
+		t.Errorf("reserves %d, want 95", r)
+	}
+	if _, err := client.Equipment.Get(ctx, item.ID); !db.IsNotFound(err) {
+		t.Errorf("sold item still exists: %v", err)
+	}
+	if q := client.ShopItem.GetX(ctx, f.ware.ID).Quantity; q != 4 {
+		t.Errorf("sold potion should go back on the shelf: stock %d", q)
+	}
+}
+
+func TestShopSellBeyondReserves(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newShopFixture(t, client, 0)
+	client.ShopTemplate.UpdateOneID(f.shop.ID).SetGoldReserves(2).ExecX(ctx)
+	item := testItem(t, client, "Old Boot", f.buyer.ID)
+
+	if _, err := svc.Shop.Sell(ctx, f.buyer.ID, "boot"); !errors.Is(err, ErrShopCannotAfford) {
+		t.Fatalf("got %v, want ErrShopCannotAfford", err)
+	}
+	if _, err := client.Equipment.Get(ctx, item.ID); err != nil {
+		t.Errorf("the item should be kept: %v", err)
+	}
+	if g := client.Character.GetX(ctx, f.buyer.ID).GoldCredits; g != 0 {
+		t.Errorf("paid for an unsold item: %d", g)
+	}
+}
+
+func TestShopRestockDue(t *testing.T) {
+	svc, client := newTestServices(t)
+	ctx := context.Background()
+	f := newShopFixture(t, client, 0)
+	now := time.Now()
+	client.ShopTemplate.UpdateOneID(f.shop.ID).
+		SetRestockIntervalSeconds(3600).
+		SetLastRestocked(now.Add(-30 * time.Minute)).
+		ExecX(ctx)
+
+	if n, err := svc.Shop.RestockDue(ctx, now); err != nil || n != 0 {
+		t.Fatalf("restocked %d (%v) before the interval passed", n, err)
+	}
+	if q := client.ShopItem.GetX(ctx, f.ware.ID).Quantity; q != 3 {
+		t.Errorf("stock %d before restock, want 3", q)
+	}
+
+	if n, err := svc.Shop.RestockDue(ctx, now.Add(time.Hour)); err != nil || n != 1 {
+		t.Fatalf("restocked %d (%v), want 1", n, err)
+	}
+	if q := client.ShopItem.GetX(ctx, f.ware.ID).Quantity; q != 5 {
+		t.Errorf("stock %d after restock, want max_stock 5", q)
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"herbst-server/db"
+	"herbst-server/events"
+	"herbst-server/repository"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "herbst-server/db/runtime"
+)
+
+// initEvents starts the global event bus the services publish to.
+var initEvents sync.Once
+
+// newTestServices wires the services over a fresh in-memory sqlite
+// database holding the full schema.
+func newTestServices(t *testing.T) (*Container, *db.Client) {
+	t.Helper()
+	initEvents.Do(func() { events.Init(slog.New(slog.NewTextHandler(io.Discard, nil))) })
+	client, err := db.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_foreign_keys=on")
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewContainer(client, repository.NewContainer(client), logger), client
+}
+
+// testRoom creates a room with the given tags.
+func testRoom(t *testing.T, client *db.Client, name string, tags ...string) *db.Room {
+	t.Helper()
+	return client.Room.Create().
+		SetName(name).
+		SetDescription(name).
+		SetWorldID("1").
+		SetExits(map[string]int{}).
+		SetTags(tags).
+		SaveX(context.Background())
+}
+
+// testCharacter creates a player in roomID carrying gold.
+func testCharacter(t *testing.T, client *db.Client, name string, roomID, gold int) *db.Character {
+	t.Helper()
+	return client.Character.Create().
+		SetName(name).
+		SetCurrentRoomId(roomID).
+		SetStartingRoomId(roomID).
+		SetGoldCredits(gold).
+		SaveX(context.Background())
+}
+
+// testItem creates an item carried by ownerID.
+func testItem(t *testing.T, client *db.Client, name string, ownerID int) *db.Equipment {
+	t.Helper()
+	return client.Equipment.Create().
+		SetName(name).
+		SetDescription(name).
+		SetSlot("none").
+		SetOwnerId(ownerID).
+		SaveX(context.Background())
+}
@@ -0,0 +1,462 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/character"
+	"herbst-server/db/equipment"
+	"herbst-server/db/predicate"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+var (
+	ErrNotTrading         = errors.New("you are not trading with anyone")
+	ErrAlreadyTrading     = errors.New("you are already trading")
+	ErrTargetTrading      = errors.New("they are already trading with someone")
+	ErrInvalidTradeTarget = errors.New("you can only trade with players here")
+	ErrTradeIgnored       = errors.New("they are ignoring you")
+	ErrTradeItem          = errors.New("you can only offer unequipped items you carry")
+	ErrTradeTooManyItems  = fmt.Errorf("you can offer at most %d items", MaxTradeItems)
+	ErrTradeAmount        = errors.New("gold must not be negative")
+	ErrTradeApart         = errors.New("you need to be in the same room to trade")
+	ErrTradeChanged       = errors.New("the trade changed; check it and confirm again")
+)
+
+const (
+	// TradeRequestTTL is how long a trade request can be answered.
+	TradeRequestTTL = time.Minute
+	// TradeIdleTimeout is how long a trade stays open without anyone
+	// changing or confirming it.
+	TradeIdleTimeout = 5 * time.Minute
+	// MaxTradeItems is how many items one side can offer.
+	MaxTradeItems = 8
+)
+
+// tradeOffer is what one side of a trade puts up.
+type tradeOffer struct {
+	charID    int
+	name      string
+	gold      int
+	items     []TradeItem
+	confirmed bool
+}
+
+// itemIDs returns the IDs of the offered items.
+func (o *tradeOffer) itemIDs() []int {
+	ids := make([]int, len(o.items))
+	for i, it := range o.items {
+		ids[i] = it.ID
+	}
+	return ids
+}
+
+// itemsAsOffered matches the offered items at the stack sizes offered.
+func (o *tradeOffer) itemsAsOffered() predicate.Equipment {
+	preds := make([]predicate.Equipment, len(o.items))
+	for i, it := range o.items {
+		preds[i] = equipment.And(equipment.ID(it.ID), equipment.Quantity(it.Quantity))
+	}
+	return equipment.Or(preds...)
+}
+
+// hasItem reports whether the item is already on offer.
+func (o *tradeOffer) hasItem(id int) bool {
+	for _, it := range o.items {
+		if it.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// removeItem takes an item off the offer, reporting whether it was on it.
+func (o *tradeOffer) removeItem(id int) bool {
+	for i, it := range o.items {
+		if it.ID == id {
+			o.items = append(o.items[:i], o.items[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// tradeSession is an open trade between two players. Nothing moves until
+// both have confirmed the same offers; any change clears both confirmations.
+type tradeSession struct {
+	sides   [2]*tradeOffer
+	updated time.Time
+}
+
+// mine returns the character's side of the trade and the other side.
+func (t *tradeSession) mine(charID int) (*tradeOffer, *tradeOffer) {
+	if t.sides[0].charID == charID {
+		return t.sides[0], t.sides[1]
+	}
+	return t.sides[1], t.sides[0]
+}
+
+// changed clears both confirmations after an offer changes.
+func (t *tradeSession) changed(now time.Time) {
+	t.sides[0].confirmed = false
+	t.sides[1].confirmed = false
+	t.updated = now
+}
+
+// tradeRequest is an unanswered `trade <player>`.
+type tradeRequest struct {
+	to      int
+	expires time.Time
+}
+
+// tradeService implements TradeService. Open trades live in memory: nothing
+// is taken from either player until the trade commits, so a trade lost to a
+// restart or a disconnect costs nobody anything.
+type tradeService struct {
+	charRepo   repository.CharacterRepo
+	equipRepo  repository.EquipmentRepo
+	ignoreRepo repository.IgnoreRepo
+	tx         repository.TransactionRunner
+	logger     *slog.Logger
+
+	mu       sync.Mutex
+	requests map[int]tradeRequest  // requester -> request
+	sessions map[int]*tradeSession // either trader -> session
+}
+
+// NewTradeService creates a new TradeService.
+func NewTradeService(
+	charRepo repository.CharacterRepo,
+	equipRepo repository.EquipmentRepo,
+	ignoreRepo repository.IgnoreRepo,
+	tx repository.TransactionRunner,
+	logger *slog.Logger,
+) TradeService {
+	return &tradeService{
+		charRepo:   charRepo,
+		equipRepo:  equipRepo,
+		ignoreRepo: ignoreRepo,
+		tx:         tx,
+		logger:     logger,
+		requests:   make(map[int]tradeRequest),
+		sessions:   make(map[int]*tradeSession),
+	}
+}
+
+// Get returns the character's open trade.
+func (s *tradeService) Get(ctx context.Context, charID int) (*TradeView, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.session(charID)
+	if t == nil {
+		return nil, ErrNotTrading
+	}
+	return tradeView(t, charID), nil
+}
+
+// Request asks another player in the room to trade. If they already asked
+// the character, the trade opens instead.
+func (s *tradeService) Request(ctx context.Context, charID int, targetName string) (*TradeUpdate, error) {
+	char, err := s.charRepo.Get(ctx, charID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+	target, err := s.charRepo.GetByName(ctx, strings.TrimSpace(targetName))
+	if err != nil || target.IsNPC || target.ID == char.ID ||
+		target.CurrentRoomId != char.CurrentRoomId || !stream.Default().Listening(target.ID) {
+		return nil, ErrInvalidTradeTarget
+	}
+	ignored, err := s.ignoreRepo.Exists(ctx, target.ID, char.ID)
+	if err != nil {
+		return nil, fmt.Errorf("checking ignore: %w", err)
+	}
+	if ignored {
+		return nil, ErrTradeIgnored
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.session(charID) != nil {
+		return nil, ErrAlreadyTrading
+	}
+	if s.session(target.ID) != nil {
+		return nil, ErrTargetTrading
+	}
+
+	now := time.Now()
+	if req, ok := s.requests[target.ID]; ok && req.to == charID && now.Before(req.expires) {
+		delete(s.requests, target.ID)
+		delete(s.requests, charID)
+		t := &tradeSession{
+			sides: [2]*tradeOffer{
+				{charID: target.ID, name: target.Name},
+				{charID: char.ID, name: char.Name},
+			},
+			updated: now,
+		}
+		s.sessions[target.ID] = t
+		s.sessions[charID] = t
+		return &TradeUpdate{
+			Trade:   tradeView(t, charID),
+			Message: fmt.Sprintf("You begin trading with %s.", target.Name),
+			Notice:  fmt.Sprintf("%s agrees to trade with you.", char.Name),
+			Notify:  []int{target.ID},
+		}, nil
+	}
+
+	s.requests[charID] = tradeRequest{to: target.ID, expires: now.Add(TradeRequestTTL)}
+	return &TradeUpdate{
+		Message: fmt.Sprintf("You ask %s to trade.", target.Name),
+		Notice:  fmt.Sprintf("%s wants to trade with you. Type 'trade %s' to begin.", char.Name, char.Name),
+		Notify:  []int{target.ID},
+	}, nil
+}
+
+// Offer changes the character's side of the trade: items are added or
+// taken off, and gold is set when given. Both confirmations are cleared.
+func (s *tradeService) Offer(ctx context.Context, charID int, in TradeOfferInput) (*TradeUpdate, error) {
+	if in.Gold != nil && *in.Gold < 0 {
+		return nil, ErrTradeAmount
+	}
+
+	// Look the items up before taking the lock.
+	var add []*db.Equipment
+	for _, id := range in.Add {
+		it, err := s.equipRepo.Get(ctx, id)
+		if err != nil || it.OwnerId == nil || *it.OwnerId != charID || it.IsEquipped || it.IsImmovable {
+			return nil, ErrTradeItem
+		}
+		add = append(add, it)
+	}
+	if in.Gold != nil && *in.Gold > 0 {
+		char, err := s.charRepo.Get(ctx, charID)
+		if err != nil {
+			return nil, ErrCharacterNotFound
+		}
+		if char.GoldCredits < *in.Gold {
+			return nil, ErrInsufficientGold
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.session(charID)
+	if t == nil {
+		return nil, ErrNotTrading
+	}
+	mine, theirs := t.mine(charID)
+	count := len(mine.items)
+	for _, id := range in.Remove {
+		if !mine.hasItem(id) {
+			return nil, ErrTradeItem
+		}
+		count--
+	}
+	for _, it := range add {
+		if !mine.hasItem(it.ID) {
+			count++
+		}
+	}
+	if count > MaxTradeItems {
+		return nil, ErrTradeTooManyItems
+	}
+	for _, id := range in.Remove {
+		mine.removeItem(id)
+	}
+	for _, it := range add {
+		if !mine.hasItem(it.ID) {
+			mine.items = append(mine.items, TradeItem{ID: it.ID, Name: it.Name, Quantity: it.Quantity})
+		}
+	}
+	if in.Gold != nil {
+		mine.gold = *in.Gold
+	}
+	t.changed(time.Now())
+	return &TradeUpdate{
+		Trade:   tradeView(t, charID),
+		Message: "You change your offer.",
+		Notice:  fmt.Sprintf("%s changes their offer: %s.", mine.name, offerSummary(mine)),
+		Notify:  []int{theirs.charID},
+	}, nil
+}
+
+// Confirm accepts the trade as it stands. Once both sides have confirmed,
+// the gold and items change hands in one transaction.
+func (s *tradeService) Confirm(ctx context.Context, charID int) (*TradeUpdate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.session(charID)
+	if t == nil {
+		return nil, ErrNotTrading
+	}
+	mine, theirs := t.mine(charID)
+	mine.confirmed = true
+	t.updated = time.Now()
+	if !theirs.confirmed {
+		return &TradeUpdate{
+			Trade:   tradeView(t, charID),
+			Message: fmt.Sprintf("You confirm the trade. Waiting for %s.", theirs.name),
+			Notice:  fmt.Sprintf("%s confirms the trade. Type 'trade confirm' to complete it.", mine.name),
+			Notify:  []int{theirs.charID},
+		}, nil
+	}
+
+	if err := s.commit(ctx, t); err != nil {
+		if errors.Is(err, ErrTradeChanged) || errors.Is(err, ErrTradeApart) || errors.Is(err, ErrInsufficientGold) {
+			t.changed(time.Now())
+		}
+		return nil, err
+	}
+	s.end(t)
+	s.logger.Info("trade completed",
+		"character_id", t.sides[0].charID, "gold", t.sides[0].gold, "items", len(t.sides[0].items),
+		"other_id", t.sides[1].charID, "other_gold", t.sides[1].gold, "other_items", len(t.sides[1].items),
+		slog.String("service", "trade"))
+	return &TradeUpdate{
+		Message: fmt.Sprintf("You trade with %s. You receive %s.", theirs.name, offerSummary(theirs)),
+		Notice:  fmt.Sprintf("You trade with %s. You receive %s.", mine.name, offerSummary(mine)),
+		Notify:  []int{theirs.charID},
+	}, nil
+}
+
+// Cancel ends the character's trade without anything changing hands.
+func (s *tradeService) Cancel(ctx context.Context, charID int) (*TradeUpdate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.session(charID)
+	if t == nil {
+		return nil, ErrNotTrading
+	}
+	mine, theirs := t.mine(charID)
+	s.end(t)
+	return &TradeUpdate{
+		Message: fmt.Sprintf("You cancel the trade with %s.", theirs.name),
+		Notice:  fmt.Sprintf("%s cancels the trade.", mine.name),
+		Notify:  []int{theirs.charID},
+	}, nil
+}
+
+// commit swaps both offers in one transaction. Every move is guarded on the
+// state the offers were confirmed against, so an item dropped, equipped or
+// sold from its stack, or gold spent, since then fails the whole trade.
+func (s *tradeService) commit(ctx context.Context, t *tradeSession) error {
+	a, err := s.charRepo.Get(ctx, t.sides[0].charID)
+	if err != nil {
+		return ErrCharacterNotFound
+	}
+	b, err := s.charRepo.Get(ctx, t.sides[1].charID)
+	if err != nil {
+		return ErrCharacterNotFound
+	}
+	if a.CurrentRoomId != b.CurrentRoomId {
+		return ErrTradeApart
+	}
+
+	return s.tx.WithTx(ctx, func(tx *db.Tx) error {
+		for i, from := range t.sides {
+			to := t.sides[1-i]
+			if from.gold > 0 {
+				n, err := tx.Character.Update().
+					Where(character.ID(from.charID), character.GoldCreditsGTE(from.gold)).
+					AddGoldCredits(-from.gold).
+					Save(ctx)
+				if err != nil {
+					return err
+				}
+				if n == 0 {
+					return ErrInsufficientGold
+				}
+				if err := tx.Character.UpdateOneID(to.charID).AddGoldCredits(from.gold).Exec(ctx); err != nil {
+					return err
+				}
+			}
+			if len(from.items) > 0 {
+				ids := from.itemIDs()
+				n, err := tx.Equipment.Update().
+					Where(from.itemsAsOffered(), equipment.OwnerId(from.charID),
+						equipment.IsEquipped(false), equipment.IsImmovable(false)).
+					SetOwnerId(to.charID).
+					Save(ctx)
+				if err != nil {
+					return err
+				}
+				if n != len(ids) {
+					return ErrTradeChanged
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// session returns the character's open trade, dropping it first if it has
+// gone idle or either trader is no longer online. The caller holds s.mu.
+func (s *tradeService) session(charID int) *tradeSession {
+	t, ok := s.sessions[charID]
+	if !ok {
+		return nil
+	}
+	if time.Since(t.updated) > TradeIdleTimeout ||
+		!stream.Default().Listening(t.sides[0].charID) || !stream.Default().Listening(t.sides[1].charID) {
+		s.end(t)
+		for _, side := range t.sides {
+			stream.Default().Send(side.charID, stream.Event{Type: stream.TypeTrade, Text: "Your trade has been called off."})
+		}
+		return nil
+	}
+	return t
+}
+
+// end forgets a trade. The caller holds s.mu.
+func (s *tradeService) end(t *tradeSession) {
+	for _, side := range t.sides {
+		if s.sessions[side.charID] == t {
+			delete(s.sessions, side.charID)
+		}
+	}
+}
+
+// tradeView shows a trade from the character's side.
+func tradeView(t *tradeSession, charID int) *TradeView {
+	mine, theirs := t.mine(charID)
+	return &TradeView{
+		With:   theirs.name,
+		WithID: theirs.charID,
+		You:    offerView(mine),
+		Them:   offerView(theirs),
+	}
+}
+
+func offerView(o *tradeOffer) TradeOfferView {
+	return TradeOfferView{
+		Gold:      o.gold,
+		Items:     append([]TradeItem{}, o.items...),
+		Confirmed: o.confirmed,
+	}
+}
+
+// offerSummary describes an offer in a sentence, e.g. "40 gold, Iron Sword".
+func offerSummary(o *tradeOffer) string {
+	var parts []string
+	if o.gold > 0 {
+		parts = append(parts, fmt.Sprintf("%d gold", o.gold))
+	}
+	for _, it := range o.items {
+		if it.Quantity > 1 {
+			parts = append(parts, fmt.Sprintf("%s (x%d)", it.Name, it.Quantity))
+		} else {
+			parts = append(parts, it.Name)
+		}
+	}
+	if len(parts) == 0 {
+		return "nothing"
+	}
+	return strings.Join(parts, ", ")
+}
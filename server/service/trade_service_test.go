@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/stream"
+)
+
+// openTrade puts two online characters in a trade: an item against gold.
+// It returns the service and a func that takes the second trader offline.
+func openTrade(t *testing.T) (*tradeService, func()) {
+	t.Helper()
+	var cancels []func()
+	for _, id := range []int{901, 902} {
+		_, cancel := stream.Default().Subscribe(id)
+		t.Cleanup(cancel)
+		cancels = append(cancels, cancel)
+	}
+	s := NewTradeService(nil, nil, nil, nil, nil).(*tradeService)
+	sess := &tradeSession{
+		sides: [2]*tradeOffer{
+			{charID: 901, name: "Mira", items: []TradeItem{{ID: 1, Name: "Iron Sword"}}},
+			{charID: 902, name: "Tobin", gold: 40},
+		},
+		updated: time.Now(),
+	}
+	s.sessions[901], s.sessions[902] = sess, sess
+	return s, cancels[1]
+}
+
+func TestTradeChangeClearsConfirmations(t *testing.T) {
+	s, _ := openTrade(t)
+	ctx := context.Background()
+
+	update, err := s.Confirm(ctx, 901)
+	if err != nil {
+		t.Fatalf("confirm: %v", err)
+	}
+	if !update.Trade.You.Confirmed || update.Trade.Them.Confirmed {
+		t.Fatalf("only the confirming side should be confirmed, got %+v", update.Trade)
+	}
+
+	zero := 0
+	update, err = s.Offer(ctx, 902, TradeOfferInput{Gold: &zero})
+	if err != nil {
+		t.Fatalf("offer: %v", err)
+	}
+	if update.Trade.You.Confirmed || update.Trade.Them.Confirmed {
+		t.Errorf("changing an offer should clear both confirmations, got %+v", update.Trade)
+	}
+	if update.Trade.You.Gold != 0 || len(update.Trade.Them.Items) != 1 {
+		t.Errorf("unexpected offers after change: %+v", update.Trade)
+	}
+
+	if _, err := s.Offer(ctx, 901, TradeOfferInput{Remove: []int{7}}); !errors.Is(err, ErrTradeItem) {
+		t.Errorf("removing an item not on offer: got %v, want %v", err, ErrTradeItem)
+	}
+	if _, err := s.Offer(ctx, 901, TradeOfferInput{Remove: []int{1}}); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	view, _ := s.Get(ctx, 901)
+	if len(view.You.Items) != 0 {
+		t.Errorf("item should be off the offer, got %+v", view.You.Items)
+	}
+}
+
+func TestTradeCancelEndsForBoth(t *testing.T) {
+	s, _ := openTrade(t)
+	ctx := context.Background()
+
+	update, err := s.Cancel(ctx, 902)
+	if err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	if len(update.Notify) != 1 || update.Notify[0] != 901 {
+		t.Errorf("the other trader should be told, got %v", update.Notify)
+	}
+	for _, id := range []int{901, 902} {
+		if _, err := s.Get(ctx, id); !errors.Is(err, ErrNotTrading) {
+			t.Errorf("character %d: got %v, want %v", id, err, ErrNotTrading)
+		}
+	}
+}
+
+func TestTradeDroppedWhenTraderGoesOffline(t *testing.T) {
+	s, disconnect := openTrade(t)
+	disconnect()
+
+	if _, err := s.Get(context.Background(), 901); !errors.Is(err, ErrNotTrading) {
+		t.Errorf("got %v, want %v", err, ErrNotTrading)
+	}
+	if len(s.sessions) != 0 {
+		t.Errorf("trade should be forgotten for both traders, got %d entries", len(s.sessions))
+	}
+}
+
+// tradeArrows has Ann offer Bob her stack of 5 arrows for 10 gold, both
+// online and in the same room, with the offer recorded as Bob saw it.
+func tradeArrows(t *testing.T) (s *tradeService, client *db.Client, ann, bob *db.Character, arrows *db.Equipment) {
+	t.Helper()
+	svc, client := newTestServices(t)
+	market := testRoom(t, client, "Market")
+	ann = testCharacter(t, client, "Ann", market.ID, 0)
+	bob = testCharacter(t, client, "Bob", market.ID, 10)
+	arrows = client.Equipment.UpdateOne(testItem(t, client, "arrows", ann.ID)).SetQuantity(5).SaveX(context.Background())
+	for _, id := range []int{ann.ID, bob.ID} {
+		_, cancel := stream.Default().Subscribe(id)
+		t.Cleanup(cancel)
+	}
+	s = svc.Trade.(*tradeService)
+	sess := &tradeSession{
+		sides: [2]*tradeOffer{
+			{charID: ann.ID, name: "Ann", items: []TradeItem{{ID: arrows.ID, Name: "arrows", Quantity: 5}}},
+			{charID: bob.ID, name: "Bob", gold: 10},
+		},
+		updated: time.Now(),
+	}
+	s.sessions[ann.ID], s.sessions[bob.ID] = sess, sess
+	return s, client, ann, bob, arrows
+}
+
+func TestTradeFailsWhenStackShrinks(t *testing.T) {
+	s, client, ann, bob, arrows := tradeArrows(t)
+	ctx := context.Background()
+	client.Equipment.UpdateOneID(arrows.ID).SetQuantity(1).ExecX(ctx)
+
+	if _, err := s.Confirm(ctx, ann.ID); err != nil {
+		t.Fatalf("Ann's confirm: %v", err)
+	}
+	if _, err := s.Confirm(ctx, bob.ID); !errors.Is(err, ErrTradeChanged) {
+		t.Fatalf("got %v, want ErrTradeChanged", err)
+	}
+	if it := client.Equipment.GetX(ctx, arrows.ID); it.OwnerId == nil || *it.OwnerId != ann.ID {
+		t.Errorf("arrows changed hands")
+	}
+	if got := goldOf(t, client, ann); got != 0 {
+		t.Errorf("Ann's gold = %d, want 0", got)
+	}
+}
+
+func TestTradeSwapsStackAsOffered(t *testing.T) {
+	s, client, ann, bob, arrows := tradeArrows(t)
+	ctx := context.Background()
+
+	if view, _ := s.Get(ctx, bob.ID); len(view.Them.Items) != 1 || view.Them.Items[0].Quantity != 5 {
+		t.Errorf("Bob sees %+v, want 5 arrows", view.Them.Items)
+	}
+	if _, err := s.Confirm(ctx, ann.ID); err != nil {
+		t.Fatalf("Ann's confirm: %v", err)
+	}
+	if _, err := s.Confirm(ctx, bob.ID); err != nil {
+		t.Fatalf("Bob's confirm: %v", err)
+	}
+	if it := client.Equipment.GetX(ctx, arrows.ID); it.OwnerId == nil || *it.OwnerId != bob.ID || it.Quantity != 5 {
+		t.Errorf("arrows not given to Bob as offered")
+	}
+	if got := goldOf(t, client, ann); got != 10 {
+		t.Errorf("Ann's gold = %d, want 10", got)
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/schema"
+	"herbst-server/repository"
+)
+
+// Phases of the game day. Each is also a hook event fired when the phase
+// begins.
+const (
+	PhaseDawn  = "dawn"
+	PhaseDay   = "day"
+	PhaseDusk  = "dusk"
+	PhaseNight = "night"
+)
+
+// OutdoorTag marks rooms open to the sky. Only outdoor rooms show the time
+// of day and weather, and only they get darker at night.
+const OutdoorTag = "outdoor"
+
+// defaultWeatherChangeMins is how often a zone's weather is re-rolled when
+// its table doesn't say.
+const defaultWeatherChangeMins = 30
+
+// ErrInvalidWeather is returned for a zone weather table that can't be
+// rolled.
+var ErrInvalidWeather = errors.New("invalid weather")
+
+// ClockConfig is the clock section of a world config.
+type ClockConfig struct {
+	TimeRatio float64 `json:"time_ratio"` // game seconds per real second
+	DayLength int     `json:"day_length"` // game hours per day
+	Dawn      int     `json:"dawn"`       // hour dawn begins
+	Dusk      int     `json:"dusk"`       // hour dusk begins
+}
+
+// DefaultClockConfig is used for worlds without a clock section: a 24-hour
+// day that passes in two real hours.
+var DefaultClockConfig = ClockConfig{TimeRatio: 12, DayLength: 24, Dawn: 6, Dusk: 18}
+
+// ParseClockConfig reads the clock section of a world config map, falling
+// back to DefaultClockConfig for anything missing or invalid. Dawn and dusk
+// default to a quarter and three quarters of the way through the day.
+func ParseClockConfig(worldCfg map[string]interface{}) ClockConfig {
+	cfg := DefaultClockConfig
+	section, ok := worldCfg["clock"].(map[string]interface{})
+	if !ok {
+		return cfg
+	}
+	if ratio, ok := section["time_ratio"].(float64); ok && ratio > 0 {
+		cfg.TimeRatio = ratio
+	}
+	if length, ok := section["day_length"].(float64); ok && length >= 4 {
+		cfg.DayLength = int(length)
+		cfg.Dawn, cfg.Dusk = cfg.DayLength/4, cfg.DayLength*3/4
+	}
+	dawn, dusk := cfg.Dawn, cfg.Dusk
+	if v, ok := section["dawn"].(float64); ok {
+		dawn = int(v)
+	}
+	if v, ok := section["dusk"].(float64); ok {
+		dusk = int(v)
+	}
+	if dawn >= 0 && dawn < dusk && dusk < cfg.DayLength {
+		cfg.Dawn, cfg.Dusk = dawn, dusk
+	}
+	return cfg
+}
+
+// GameTime is a moment on a world's clock.
+type GameTime struct {
+	Day    int    `json:"day"`
+	Hour   int    `json:"hour"`
+	Minute int    `json:"minute"`
+	Phase  string `json:"phase"`
+}
+
+// Clock formats the time of day as hh:mm.
+func (t GameTime) Clock() string {
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+// At converts a real time to game time. The game clock started at the Unix
+// epoch, so every server agrees on it without storing anything.
+func (c ClockConfig) At(t time.Time) GameTime {
+	gameMins := int64(float64(t.Unix()) * c.TimeRatio / 60)
+	perDay := int64(c.DayLength) * 60
+	inDay := gameMins % perDay
+	hour := int(inDay / 60)
+	return GameTime{
+		Day:    int(gameMins/perDay) + 1,
+		Hour:   hour,
+		Minute: int(inDay % 60),
+		Phase:  c.phase(hour),
+	}
+}
+
+// phase names the part of the day an hour falls in. Dawn and dusk last one
+// game hour each.
+func (c ClockConfig) phase(hour int) string {
+	switch {
+	case hour == c.Dawn:
+		return PhaseDawn
+	case hour == c.Dusk:
+		return PhaseDusk
+	case hour > c.Dawn && hour < c.Dusk:
+		return PhaseDay
+	default:
+		return PhaseNight
+	}
+}
+
+// weatherKind is how one kind of weather reads and how much it hides.
+type weatherKind struct {
+	describe   string
+	onset      string
+	perception int
+}
+
+// weatherKinds are the weathers a zone table may roll.
+var weatherKinds = map[string]weatherKind{
+	"clear":  {"The sky is clear.", "The clouds part and the sky clears.", 0},
+	"cloudy": {"Grey clouds hang low overhead.", "Clouds roll in overhead.", 0},
+	"rain":   {"Rain patters down all around.", "It starts to rain.", -5},
+	"storm":  {"A storm rages, rain lashing down between flashes of lightning.", "Thunder rumbles as a storm breaks overhead.", -10},
+	"fog":    {"A thick fog blankets everything.", "A thick fog rolls in.", -15},
+	"snow":   {"Snow drifts down from a heavy sky.", "Snow begins to fall.", -5},
+}
+
+// phaseText is the description suffix and announcement for each phase.
+var phaseText = map[string]struct{ describe, onset string }{
+	PhaseDawn:  {"The first light of dawn spreads across the sky.", "Dawn breaks."},
+	PhaseDay:   {"", "The sun climbs into the sky."},
+	PhaseDusk:  {"The light fades as dusk settles in.", "The sun sinks and dusk settles in."},
+	PhaseNight: {"It is night, and the darkness hides much.", "Night falls."},
+}
+
+// phasePerception is how much harder it is to spot things outdoors at each
+// phase, on the examine-level scale.
+var phasePerception = map[string]int{PhaseDusk: -10, PhaseNight: -25}
+
+// RoomConditions is the time and weather as they are felt in one room.
+type RoomConditions struct {
+	Time    GameTime `json:"time"`
+	Weather string   `json:"weather,omitempty"`
+	Outdoor bool     `json:"outdoor"`
+}
+
+// Suffix is the line appended to an outdoor room's description. Indoor
+// rooms have none.
+func (rc RoomConditions) Suffix() string {
+	if !rc.Outdoor {
+		return ""
+	}
+	var parts []string
+	if text := phaseText[rc.Time.Phase].describe; text != "" {
+		parts = append(parts, text)
+	}
+	if kind, ok := weatherKinds[rc.Weather]; ok {
+		parts = append(parts, kind.describe)
+	}
+	return strings.Join(parts, " ")
+}
+
+// PerceptionModifier adjusts perception and search checks made in the room:
+// outdoors, darkness and bad weather make things harder to spot.
+func (rc RoomConditions) PerceptionModifier() int {
+	if !rc.Outdoor {
+		return 0
+	}
+	return phasePerception[rc.Time.Phase] + weatherKinds[rc.Weather].perception
+}
+
+// IsOutdoor reports whether a room's tags mark it as outdoors.
+func IsOutdoor(tags []string) bool {
+	for _, t := range tags {
+		if t == OutdoorTag || t == "outdoors" {
+			return true
+		}
+	}
+	return false
+}
+
+// CurrentRoomConditions reads the clock of room's world and the weather of
+// its zone — the first of its zones with weather — at now.
+func CurrentRoomConditions(ctx context.Context, worlds repository.WorldRepo, zones repository.ZoneRepository, room *db.Room, now time.Time) RoomConditions {
+	rc := RoomConditions{Outdoor: IsOutdoor(room.Tags)}
+	rc.Time = worldClock(ctx, worlds, room.WorldID).At(now)
+	for _, zid := range room.ZoneIds {
+		if z, err := zones.Get(ctx, zid); err == nil && z.CurrentWeather != "" {
+			rc.Weather = z.CurrentWeather
+			break
+		}
+	}
+	return rc
+}
+
+// worldClock loads the clock config of a world by its string ID.
+func worldClock(ctx context.Context, worlds repository.WorldRepo, worldID string) ClockConfig {
+	id, _ := strconv.Atoi(worldID)
+	if id == 0 || worlds == nil {
+		return DefaultClockConfig
+	}
+	w, err := worlds.Get(ctx, id)
+	if err != nil {
+		return DefaultClockConfig
+	}
+	return ParseClockConfig(w.Config)
+}
+
+// RollWeather picks a weather from a zone's weighted table.
+func RollWeather(patterns []schema.WeatherChance, intn func(int) int) string {
+	total := 0
+	for _, p := range patterns {
+		total += p.Weight
+	}
+	if total <= 0 {
+		return ""
+	}
+	roll := intn(total)
+	for _, p := range patterns {
+		if roll < p.Weight {
+			return p.Weather
+		}
+		roll -= p.Weight
+	}
+	return ""
+}
+
+// ValidateZoneWeather checks a zone weather table: known weathers,
+// non-negative weights with at least one above zero.
+func ValidateZoneWeather(w *schema.ZoneWeather) error {
+	if w == nil || len(w.Patterns) == 0 {
+		return nil
+	}
+	if w.ChangeMins < 0 {
+		return fmt.Errorf("%w: change_mins must be non-negative", ErrInvalidWeather)
+	}
+	total := 0
+	for i, p := range w.Patterns {
+		if _, ok := weatherKinds[p.Weather]; !ok {
+			return fmt.Errorf("%w: pattern %d: unknown weather %q", ErrInvalidWeather, i, p.Weather)
+		}
+		if p.Weight < 0 {
+			return fmt.Errorf("%w: pattern %d: weight must be non-negative", ErrInvalidWeather, i)
+		}
+		total += p.Weight
+	}
+	if total == 0 {
+		return fmt.Errorf("%w: at least one pattern needs a weight", ErrInvalidWeather)
+	}
+	return nil
+}
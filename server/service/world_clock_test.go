@@ -0,0 +1,92 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"herbst-server/db/schema"
+)
+
+func TestParseClockConfig(t *testing.T) {
+	if got := ParseClockConfig(nil); got != DefaultClockConfig {
+		t.Errorf("no clock section: got %+v", got)
+	}
+	got := ParseClockConfig(map[string]interface{}{"clock": map[string]interface{}{"time_ratio": 60.0, "day_length": 20.0}})
+	if got.TimeRatio != 60 || got.DayLength != 20 || got.Dawn != 5 || got.Dusk != 15 {
+		t.Errorf("scaled day: got %+v", got)
+	}
+	got = ParseClockConfig(map[string]interface{}{"clock": map[string]interface{}{"dawn": 19.0, "dusk": 7.0}})
+	if got.Dawn != 6 || got.Dusk != 18 {
+		t.Errorf("dusk before dawn should be ignored: got %+v", got)
+	}
+}
+
+func TestClockAt(t *testing.T) {
+	// At ratio 60 one real minute is one game hour.
+	cfg := ClockConfig{TimeRatio: 60, DayLength: 24, Dawn: 6, Dusk: 18}
+	cases := []struct {
+		mins  int
+		hour  int
+		phase string
+	}{
+		{0, 0, PhaseNight},
+		{6, 6, PhaseDawn},
+		{12, 12, PhaseDay},
+		{18, 18, PhaseDusk},
+		{23, 23, PhaseNight},
+		{24 + 7, 7, PhaseDay},
+	}
+	for _, c := range cases {
+		gt := cfg.At(time.Unix(int64(c.mins)*60, 0))
+		if gt.Hour != c.hour || gt.Phase != c.phase {
+			t.Errorf("%d mins: got %+v, want hour %d %s", c.mins, gt, c.hour, c.phase)
+		}
+	}
+	if gt := cfg.At(time.Unix(25*60, 0)); gt.Day != 2 {
+		t.Errorf("day after midnight: got %d, want 2", gt.Day)
+	}
+}
+
+func TestRoomConditions(t *testing.T) {
+	night := RoomConditions{Time: GameTime{Phase: PhaseNight}, Weather: "fog", Outdoor: true}
+	if got := night.PerceptionModifier(); got != -40 {
+		t.Errorf("foggy night outdoors: got %d, want -40", got)
+	}
+	if night.Suffix() == "" {
+		t.Error("outdoor room should describe the night and fog")
+	}
+	indoors := RoomConditions{Time: GameTime{Phase: PhaseNight}, Weather: "fog"}
+	if indoors.PerceptionModifier() != 0 || indoors.Suffix() != "" {
+		t.Errorf("indoor room should be unaffected: %d %q", indoors.PerceptionModifier(), indoors.Suffix())
+	}
+	if !IsOutdoor([]string{"forest", OutdoorTag}) || IsOutdoor([]string{"forge"}) {
+		t.Error("IsOutdoor should key off the outdoor tag")
+	}
+}
+
+func TestRollWeather(t *testing.T) {
+	patterns := []schema.WeatherChance{{Weather: "clear", Weight: 3}, {Weather: "never", Weight: 0}, {Weather: "rain", Weight: 1}}
+	for roll, want := range []string{"clear", "clear", "clear", "rain"} {
+		if got := RollWeather(patterns, func(int) int { return roll }); got != want {
+			t.Errorf("roll %d: got %q, want %q", roll, got, want)
+		}
+	}
+}
+
+func TestValidateZoneWeather(t *testing.T) {
+	if err := ValidateZoneWeather(&schema.ZoneWeather{Patterns: []schema.WeatherChance{{Weather: "rain", Weight: 1}}, ChangeMins: 10}); err != nil {
+		t.Errorf("valid table: %v", err)
+	}
+	invalid := []schema.ZoneWeather{
+		{Patterns: []schema.WeatherChance{{Weather: "hail", Weight: 1}}},
+		{Patterns: []schema.WeatherChance{{Weather: "rain", Weight: -1}}},
+		{Patterns: []schema.WeatherChance{{Weather: "rain", Weight: 0}}},
+		{Patterns: []schema.WeatherChance{{Weather: "rain", Weight: 1}}, ChangeMins: -5},
+	}
+	for i, w := range invalid {
+		if err := ValidateZoneWeather(&w); !errors.Is(err, ErrInvalidWeather) {
+			t.Errorf("invalid[%d]: got %v, want ErrInvalidWeather", i, err)
+		}
+	}
+}
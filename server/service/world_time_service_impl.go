@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+type worldTimeService struct {
+	worldRepo   repository.WorldRepo
+	zoneRepo    repository.ZoneRepository
+	roomRepo    repository.RoomRepo
+	charRepo    repository.CharacterRepo
+	hookRepo    repository.EffectHookRepo
+	roomEffects RoomEffectService
+	logger      *slog.Logger
+	intn        func(n int) int
+
+	// phases is the last phase seen per world. Tick runs on one goroutine,
+	// so it needs no lock.
+	phases map[int]string
+}
+
+// NewWorldTimeService creates the service that runs world clocks and zone
+// weather.
+func NewWorldTimeService(
+	worldRepo repository.WorldRepo,
+	zoneRepo repository.ZoneRepository,
+	roomRepo repository.RoomRepo,
+	charRepo repository.CharacterRepo,
+	hookRepo repository.EffectHookRepo,
+	roomEffects RoomEffectService,
+	logger *slog.Logger,
+) WorldTimeService {
+	return &worldTimeService{
+		worldRepo:   worldRepo,
+		zoneRepo:    zoneRepo,
+		roomRepo:    roomRepo,
+		charRepo:    charRepo,
+		hookRepo:    hookRepo,
+		roomEffects: roomEffects,
+		logger:      logger,
+		intn:        rand.Intn,
+		phases:      map[int]string{},
+	}
+}
+
+// Tick advances every world's clock and zone's weather to now. The first
+// tick only records each world's phase, so a restart doesn't re-fire dusk.
+func (s *worldTimeService) Tick(ctx context.Context, now time.Time) error {
+	worlds, err := s.worldRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list worlds: %w", err)
+	}
+	for _, w := range worlds {
+		gt := ParseClockConfig(w.Config).At(now)
+		prev, seen := s.phases[w.ID]
+		s.phases[w.ID] = gt.Phase
+		if seen && prev != gt.Phase {
+			s.phaseChanged(ctx, w, gt)
+		}
+	}
+
+	zones, err := s.zoneRepo.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("list zones: %w", err)
+	}
+	for _, z := range zones {
+		s.rollWeather(ctx, z, now)
+	}
+	return nil
+}
+
+// Now is the current game time in a world.
+func (s *worldTimeService) Now(ctx context.Context, worldID int) (GameTime, error) {
+	w, err := s.worldRepo.Get(ctx, worldID)
+	if err != nil {
+		return GameTime{}, err
+	}
+	return ParseClockConfig(w.Config).At(time.Now()), nil
+}
+
+// RoomConditions is the current time and weather in a room.
+func (s *worldTimeService) RoomConditions(ctx context.Context, roomID int) (RoomConditions, error) {
+	room, err := s.roomRepo.Get(ctx, roomID)
+	if err != nil {
+		return RoomConditions{}, err
+	}
+	return CurrentRoomConditions(ctx, s.worldRepo, s.zoneRepo, room, time.Now()), nil
+}
+
+// phaseChanged tells players outdoors that the day has moved on, then fires
+// the world's hooks for the new phase.
+func (s *worldTimeService) phaseChanged(ctx context.Context, w *db.World, gt GameTime) {
+	worldKey := strconv.Itoa(w.ID)
+	outdoors := s.outdoorPlayers(ctx, func(r *db.Room) bool { return r.WorldID == worldKey })
+	for _, players := range outdoors {
+		for _, p := range players {
+			stream.Default().Send(p.ID, stream.Event{Type: stream.TypeWorld, Text: phaseText[gt.Phase].onset})
+		}
+	}
+	s.logger.Info("world phase changed", "world_id", w.ID, "phase", gt.Phase, "day", gt.Day, slog.String("service", "world_time"))
+
+	hooks, err := s.hookRepo.ListByEvent(ctx, gt.Phase)
+	if err != nil {
+		dblog.Error("failed to list phase hooks", err, slog.String("service", "world_time"), slog.String("phase", gt.Phase))
+		return
+	}
+	extras := map[string]interface{}{"phase": gt.Phase, "day": gt.Day, "hour": gt.Hour}
+	for _, h := range hooks {
+		if !h.Enabled || h.WorldID != worldKey {
+			continue
+		}
+		full, err := s.hookRepo.GetWithEdges(ctx, h.ID)
+		if err != nil {
+			continue
+		}
+		if full.Edges.NpcTemplate != nil {
+			s.fireForTemplate(ctx, full, full.Edges.NpcTemplate.ID, extras)
+		} else {
+			s.fireOutdoors(ctx, full, outdoors, extras)
+		}
+	}
+}
+
+// fireForTemplate fires a phase hook bound to an NPC template for each of
+// its living instances: on the NPC itself for self hooks, or on the NPC's
+// room for room hooks.
+func (s *worldTimeService) fireForTemplate(ctx context.Context, h *db.EffectHook, templateID string, extras map[string]interface{}) {
+	npcs, err := s.charRepo.ListAllNPCs(ctx)
+	if err != nil {
+		dblog.Error("failed to list npcs for phase hook", err, slog.String("service", "world_time"), slog.Int("hook_id", h.ID))
+		return
+	}
+	for _, npc := range npcs {
+		if !npc.IsInstance || npc.NpcTemplateID != templateID || npc.Hitpoints <= 0 || npc.CurrentRoomId == 0 {
+			continue
+		}
+		switch h.Target {
+		case HookTargetRoom, HookTargetRoomExceptSource:
+			s.fireRoom(ctx, h, npc.ID, npc.CurrentRoomId, extras)
+		case "self":
+			if _, err := s.roomEffects.ApplyHook(ctx, h.ID, npc.ID, npc.ID, extras); err != nil {
+				s.logger.Warn("phase hook failed", "hook_id", h.ID, "npc_id", npc.ID, "error", err, slog.String("service", "world_time"))
+			}
+		}
+	}
+}
+
+// fireOutdoors fires a world-level phase hook on the players standing
+// outdoors: once per room for room hooks, otherwise once per player.
+func (s *worldTimeService) fireOutdoors(ctx context.Context, h *db.EffectHook, outdoors map[int][]*db.Character, extras map[string]interface{}) {
+	for roomID, players := range outdoors {
+		if h.Target == HookTargetRoom || h.Target == HookTargetRoomExceptSource {
+			s.fireRoom(ctx, h, 0, roomID, extras)
+			continue
+		}
+		for _, p := range players {
+			applied, err := s.roomEffects.ApplyHook(ctx, h.ID, 0, p.ID, extras)
+			if err != nil {
+				s.logger.Warn("phase hook failed", "hook_id", h.ID, "character_id", p.ID, "error", err, slog.String("service", "world_time"))
+				continue
+			}
+			if msg := h.Edges.Effect.Messages["on_start"]; applied && msg != "" {
+				stream.Default().Send(p.ID, stream.Event{Type: stream.TypeEffect, Text: msg})
+			}
+		}
+	}
+}
+
+// fireRoom applies a room hook and shows its message to the room.
+func (s *worldTimeService) fireRoom(ctx context.Context, h *db.EffectHook, sourceID, roomID int, extras map[string]interface{}) {
+	result, err := s.roomEffects.ApplyRoomHook(ctx, h.ID, sourceID, roomID, extras)
+	if err != nil {
+		s.logger.Warn("phase hook failed", "hook_id", h.ID, "room_id", roomID, "error", err, slog.String("service", "world_time"))
+		return
+	}
+	if result.Message != "" && len(result.Targets) > 0 {
+		stream.Default().ToRoom(ctx, roomID, stream.Event{Type: stream.TypeEffect, Text: result.Message, ActorID: sourceID})
+	}
+}
+
+// rollWeather re-rolls a zone's weather once its change interval has
+// passed, or straight away if it has none yet, announcing any change in the
+// zone's outdoor rooms.
+func (s *worldTimeService) rollWeather(ctx context.Context, z *db.Zone, now time.Time) {
+	if len(z.Weather.Patterns) == 0 {
+		return
+	}
+	every := time.Duration(z.Weather.ChangeMins) * time.Minute
+	if every <= 0 {
+		every = defaultWeatherChangeMins * time.Minute
+	}
+	if z.CurrentWeather != "" && z.WeatherChangedAt != nil && now.Sub(*z.WeatherChangedAt) < every {
+		return
+	}
+	weather := RollWeather(z.Weather.Patterns, s.intn)
+	if weather == "" {
+		return
+	}
+	if _, err := s.zoneRepo.Update(ctx, z.ID, repository.ZoneUpdates{CurrentWeather: &weather, WeatherChangedAt: &now}); err != nil {
+		dblog.Error("failed to update zone weather", err, slog.String("service", "world_time"), slog.String("zone_id", z.ID))
+		return
+	}
+	if z.CurrentWeather == "" || weather == z.CurrentWeather {
+		return
+	}
+	inZone := func(r *db.Room) bool {
+		for _, zid := range r.ZoneIds {
+			if zid == z.ID {
+				return true
+			}
+		}
+		return false
+	}
+	for _, players := range s.outdoorPlayers(ctx, inZone) {
+		for _, p := range players {
+			stream.Default().Send(p.ID, stream.Event{Type: stream.TypeWorld, Text: weatherKinds[weather].onset})
+		}
+	}
+}
+
+// outdoorPlayers groups the online players standing in outdoor rooms that
+// match by room ID.
+func (s *worldTimeService) outdoorPlayers(ctx context.Context, match func(*db.Room) bool) map[int][]*db.Character {
+	out := map[int][]*db.Character{}
+	chars, err := s.charRepo.ListAll(ctx)
+	if err != nil {
+		dblog.Error("failed to list characters", err, slog.String("service", "world_time"))
+		return out
+	}
+	rooms := map[int]bool{}
+	for _, ch := range chars {
+		if ch.IsNPC || ch.CurrentRoomId == 0 || !stream.Default().Listening(ch.ID) {
+			continue
+		}
+		ok, cached := rooms[ch.CurrentRoomId]
+		if !cached {
+			if r, err := s.roomRepo.Get(ctx, ch.CurrentRoomId); err == nil {
+				ok = IsOutdoor(r.Tags) && match(r)
+			}
+			rooms[ch.CurrentRoomId] = ok
+		}
+		if ok {
+			out[ch.CurrentRoomId] = append(out[ch.CurrentRoomId], ch)
+		}
+	}
+	return out
+}
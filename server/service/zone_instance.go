@@ -0,0 +1,66 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"herbst-server/db"
+	"herbst-server/db/schema"
+)
+
+// Instance lifetimes used when a zone's instancing config doesn't say.
+const (
+	defaultInstanceLifetimeMins = 120
+	defaultInstanceIdleMins     = 15
+)
+
+// ErrInvalidInstancing is returned for a zone instancing config that can't
+// open copies.
+var ErrInvalidInstancing = errors.New("invalid instancing")
+
+// IsInstanced reports whether z is a template for private copies rather
+// than a zone players walk into directly.
+func IsInstanced(z *db.Zone) bool {
+	return z.Instance.PortalRoomID != 0
+}
+
+// ValidateZoneInstancing checks a zone's instancing config against the rooms
+// the zone holds: the portal sits outside the zone and leads to one of its
+// rooms.
+func ValidateZoneInstancing(inst *schema.ZoneInstancing, roomIDs []int) error {
+	if inst == nil || inst.PortalRoomID == 0 {
+		return nil
+	}
+	if inst.PortalExit == "" {
+		return fmt.Errorf("%w: portal_exit is required", ErrInvalidInstancing)
+	}
+	if inst.LifetimeMins < 0 || inst.IdleMins < 0 {
+		return fmt.Errorf("%w: lifetime_mins and idle_mins must be non-negative", ErrInvalidInstancing)
+	}
+	inZone := false
+	for _, id := range roomIDs {
+		if id == inst.PortalRoomID {
+			return fmt.Errorf("%w: portal room must be outside the zone", ErrInvalidInstancing)
+		}
+		if id == inst.EntryRoomID {
+			inZone = true
+		}
+	}
+	if !inZone {
+		return fmt.Errorf("%w: entry_room_id must be one of the zone's rooms", ErrInvalidInstancing)
+	}
+	return nil
+}
+
+// remapExits points exits between copied rooms at the copies. Exits leading
+// out of the zone are kept, so a copy can be left the way the zone can.
+func remapExits(exits map[string]int, rooms map[int]int) map[string]int {
+	out := make(map[string]int, len(exits))
+	for dir, to := range exits {
+		if c, ok := rooms[to]; ok {
+			to = c
+		}
+		out[dir] = to
+	}
+	return out
+}
@@ -0,0 +1,535 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/dblog"
+	"herbst-server/repository"
+	"herbst-server/stream"
+)
+
+type instanceService struct {
+	zoneRepo     repository.ZoneRepository
+	instanceRepo repository.ZoneInstanceRepo
+	roomRepo     repository.RoomRepo
+	charRepo     repository.CharacterRepo
+	equipRepo    repository.EquipmentRepo
+	partyRepo    repository.PartyRepo
+	zones        *ZoneService
+	logger       *slog.Logger
+
+	// mu serialises opening and closing copies, so two party members
+	// stepping through the portal together share one copy.
+	mu sync.Mutex
+}
+
+// NewInstanceService creates the service that runs private zone copies.
+func NewInstanceService(
+	zoneRepo repository.ZoneRepository,
+	instanceRepo repository.ZoneInstanceRepo,
+	roomRepo repository.RoomRepo,
+	charRepo repository.CharacterRepo,
+	equipRepo repository.EquipmentRepo,
+	partyRepo repository.PartyRepo,
+	zones *ZoneService,
+	logger *slog.Logger,
+) InstanceService {
+	return &instanceService{
+		zoneRepo:     zoneRepo,
+		instanceRepo: instanceRepo,
+		roomRepo:     roomRepo,
+		charRepo:     charRepo,
+		equipRepo:    equipRepo,
+		partyRepo:    partyRepo,
+		zones:        zones,
+		logger:       logger,
+	}
+}
+
+// Portal resolves a move through an instance portal. If dir out of
+// fromRoomID is a zone's portal, it returns the entry room of the copy
+// belonging to the character's party — or to the character alone — opening
+// one first if needed. ok is false when the exit isn't a portal.
+func (s *instanceService) Portal(ctx context.Context, charID, fromRoomID int, dir string) (int, bool, error) {
+	z, err := s.portalZone(ctx, fromRoomID, dir)
+	if err != nil || z == nil {
+		return 0, false, err
+	}
+
+	var partyID *int
+	if p, err := s.partyRepo.GetForCharacter(ctx, charID); err == nil {
+		partyID = &p.ID
+	} else if !db.IsNotFound(err) {
+		return 0, false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var inst *db.ZoneInstance
+	if partyID != nil {
+		inst, err = s.instanceRepo.FindForParty(ctx, z.ID, *partyID)
+	} else {
+		inst, err = s.instanceRepo.FindSolo(ctx, z.ID, charID)
+	}
+	if db.IsNotFound(err) {
+		inst, err = s.open(ctx, z, charID, partyID, fromRoomID, time.Now())
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if inst.EmptySince != nil {
+		if inst, err = s.instanceRepo.Update(ctx, inst.ID, repository.ZoneInstanceUpdates{ClearEmptySince: true}); err != nil {
+			return 0, false, err
+		}
+	}
+	return inst.EntryRoomID, true, nil
+}
+
+// portalZone finds the instanced zone whose portal is dir out of roomID.
+func (s *instanceService) portalZone(ctx context.Context, roomID int, dir string) (*db.Zone, error) {
+	zones, err := s.zoneRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, z := range zones {
+		if IsInstanced(z) && z.Instance.PortalRoomID == roomID && z.Instance.PortalExit == dir {
+			return z, nil
+		}
+	}
+	return nil, nil
+}
+
+// open copies z's rooms, NPCs and items into a new instance, then runs the
+// zone's reset script inside it. A copy that fails half-way is torn down.
+func (s *instanceService) open(ctx context.Context, z *db.Zone, ownerID int, partyID *int, returnRoomID int, now time.Time) (*db.ZoneInstance, error) {
+	inst, err := s.instanceRepo.Create(ctx, repository.CreateZoneInstanceInput{
+		ZoneID:       z.ID,
+		OwnerID:      ownerID,
+		PartyID:      partyID,
+		ReturnRoomID: returnRoomID,
+		ExpiresAt:    now.Add(instanceLifetime(z)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create instance: %w", err)
+	}
+
+	rooms, items := map[int]int{}, map[int]int{}
+	err = s.populate(ctx, z, inst.ID, rooms, items)
+	entry := rooms[z.Instance.EntryRoomID]
+	if err == nil && entry == 0 {
+		err = fmt.Errorf("entry room %d was not copied", z.Instance.EntryRoomID)
+	}
+	saved, saveErr := s.instanceRepo.Update(ctx, inst.ID, repository.ZoneInstanceUpdates{
+		RoomMap:     rooms,
+		ItemMap:     items,
+		EntryRoomID: &entry,
+		LastResetAt: &now,
+	})
+	if saveErr == nil {
+		inst = saved
+	} else if err == nil {
+		err = saveErr
+	}
+	if err != nil {
+		inst.RoomMap, inst.ItemMap = rooms, items
+		s.close(ctx, inst, "")
+		return nil, fmt.Errorf("open instance of zone %s: %w", z.ID, err)
+	}
+
+	if _, err := s.zones.ResetInstance(ctx, z, rooms, items); err != nil {
+		s.logger.Warn("instance reset failed", "instance_id", inst.ID, "error", err, slog.String("service", "instances"))
+	}
+	s.logger.Info("instance opened", "instance_id", inst.ID, "zone_id", z.ID, "owner_id", ownerID, "rooms", len(rooms), slog.String("service", "instances"))
+	return inst, nil
+}
+
+// populate copies z into instance instID, recording each copied room and
+// item in rooms and items as it goes so a failure can be cleaned up.
+func (s *instanceService) populate(ctx context.Context, z *db.Zone, instID int, rooms, items map[int]int) error {
+	var originals []*db.Room
+	for _, id := range z.RoomIds {
+		r, err := s.roomRepo.Get(ctx, id)
+		if err != nil {
+			continue
+		}
+		c, err := s.roomRepo.Create(ctx, repository.CreateRoomInput{
+			Name:        r.Name,
+			Description: r.Description,
+			Exits:       map[string]int{},
+			Atmosphere:  string(r.Atmosphere),
+			PosX:        r.PosX,
+			PosY:        r.PosY,
+			PosZ:        r.PosZ,
+			WorldID:     r.WorldID,
+			ZoneIDs:     r.ZoneIds,
+			Tags:        r.Tags,
+			InstanceID:  &instID,
+		})
+		if err != nil {
+			return fmt.Errorf("copy room %d: %w", r.ID, err)
+		}
+		rooms[r.ID] = c.ID
+		originals = append(originals, r)
+	}
+
+	for _, r := range originals {
+		exits := remapExits(r.Exits, rooms)
+		states := exitStatesFor(exits, r.ExitStates)
+		if _, err := s.roomRepo.Update(ctx, rooms[r.ID], repository.RoomUpdates{Exits: &exits, ExitStates: &states}); err != nil {
+			return fmt.Errorf("copy exits of room %d: %w", r.ID, err)
+		}
+		onFloor, err := s.equipRepo.ListByRoom(ctx, r.ID)
+		if err != nil {
+			return err
+		}
+		roomID := rooms[r.ID]
+		for _, it := range onFloor {
+			if it.OwnerId != nil {
+				continue
+			}
+			if _, err := s.cloneItem(ctx, it, nil, &roomID, items); err != nil {
+				return err
+			}
+		}
+	}
+
+	npcs, err := s.charRepo.ListAllNPCs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, npc := range npcs {
+		roomID, ok := rooms[npc.CurrentRoomId]
+		if !ok || npc.Hitpoints <= 0 {
+			continue
+		}
+		c, err := s.charRepo.Create(ctx, repository.CreateCharacterInput{
+			Name:           npc.Name,
+			IsNPC:          true,
+			IsInstance:     npc.IsInstance,
+			InstanceNumber: npc.InstanceNumber,
+			NPCTemplateID:  npc.NpcTemplateID,
+			RoomID:         roomID,
+			StartingRoomID: roomID,
+			RespawnRoomID:  roomID,
+			WorldID:        npc.CurrentWorld,
+			Strength:       npc.Strength,
+			Dexterity:      npc.Dexterity,
+			Constitution:   npc.Constitution,
+			Intelligence:   npc.Intelligence,
+			Wisdom:         npc.Wisdom,
+			Charisma:       npc.Charisma,
+			Race:           npc.Race,
+			Gender:         npc.Gender,
+			Description:    npc.Description,
+			Class:          npc.Class,
+			HP:             npc.MaxHitpoints,
+			MaxHP:          npc.MaxHitpoints,
+			Stamina:        npc.MaxStamina,
+			MaxStamina:     npc.MaxStamina,
+			Mana:           npc.MaxMana,
+			MaxMana:        npc.MaxMana,
+			Level:          npc.Level,
+		})
+		if err != nil {
+			return fmt.Errorf("copy npc %d: %w", npc.ID, err)
+		}
+		carried, err := s.equipRepo.ListByOwner(ctx, npc.ID)
+		if err != nil {
+			return err
+		}
+		for _, it := range carried {
+			if _, err := s.cloneItem(ctx, it, &c.ID, nil, items); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// cloneItem copies an item, and a container's contents with it, giving the
+// copy to ownerID or leaving it in roomID.
+func (s *instanceService) cloneItem(ctx context.Context, it *db.Equipment, ownerID, roomID *int, items map[int]int) (*db.Equipment, error) {
+	input := repository.CreateEquipmentInput{
+		Name:                  it.Name,
+		Description:           it.Description,
+		Slot:                  it.Slot,
+		OwnerID:               ownerID,
+		RoomID:                roomID,
+		Level:                 it.Level,
+		ItemType:              it.ItemType,
+		ArmorRating:           it.ArmorRating,
+		ArmorType:             it.ArmorType,
+		DamageDiceCount:       it.DamageDiceCount,
+		DamageDiceSides:       it.DamageDiceSides,
+		DamageBonus:           it.DamageBonus,
+		DamageType:            it.DamageType,
+		WeaponType:            it.WeaponType,
+		IsTwoHanded:           it.IsTwoHanded,
+		Stats:                 it.Stats,
+		Rarity:                it.Rarity,
+		SkillRequirement:      it.SkillRequirement,
+		SkillRequirementLevel: it.SkillRequirementLevel,
+		Weight:                it.Weight,
+		IsEquipped:            it.IsEquipped,
+		IsImmovable:           it.IsImmovable,
+		Color:                 it.Color,
+		IsVisible:             it.IsVisible,
+		EffectType:            it.EffectType,
+		EffectValue:           it.EffectValue,
+		EffectDuration:        it.EffectDuration,
+		Healing:               it.Healing,
+		IsContainer:           it.IsContainer,
+		ContainerCapacity:     it.ContainerCapacity,
+		IsLocked:              it.IsLocked,
+		RevealCondition:       it.RevealCondition,
+		ExpiresAt:             it.ExpiresAt,
+	}
+	if it.EquipmentTemplateID != 0 {
+		input.EquipmentTemplateID = &it.EquipmentTemplateID
+	}
+	if it.KeyItemID != "" {
+		input.KeyItemID = &it.KeyItemID
+	}
+	if it.IsContainer && it.ContainedItems != "" {
+		var contents, copied []int
+		if err := json.Unmarshal([]byte(it.ContainedItems), &contents); err == nil {
+			for _, id := range contents {
+				inner, err := s.equipRepo.Get(ctx, id)
+				if err != nil || inner.OwnerId != nil {
+					continue
+				}
+				c, err := s.cloneItem(ctx, inner, nil, nil, items)
+				if err != nil {
+					return nil, err
+				}
+				copied = append(copied, c.ID)
+			}
+		}
+		data, _ := json.Marshal(copied)
+		input.ContainedItems = string(data)
+	}
+	c, err := s.equipRepo.Create(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("copy item %d: %w", it.ID, err)
+	}
+	items[it.ID] = c.ID
+	return c, nil
+}
+
+// Tick closes copies that have expired or sat empty too long, and resets
+// the rest on their zone's reset interval.
+func (s *instanceService) Tick(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	instances, err := s.instanceRepo.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("list instances: %w", err)
+	}
+	if len(instances) == 0 {
+		return nil
+	}
+	chars, err := s.charRepo.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("list characters: %w", err)
+	}
+	occupied := map[int]bool{}
+	for _, ch := range chars {
+		if !ch.IsNPC && stream.Default().Listening(ch.ID) {
+			occupied[ch.CurrentRoomId] = true
+		}
+	}
+
+	for _, inst := range instances {
+		z, err := s.zoneRepo.Get(ctx, inst.ZoneID)
+		if db.IsNotFound(err) {
+			s.close(ctx, inst, "The world around you dissolves.")
+			continue
+		} else if err != nil {
+			continue
+		}
+		if !now.Before(inst.ExpiresAt) {
+			s.close(ctx, inst, "Time runs out, and you are pulled back through the portal.")
+			continue
+		}
+
+		empty := true
+		for _, id := range inst.RoomMap {
+			if occupied[id] {
+				empty = false
+				break
+			}
+		}
+		switch {
+		case !empty && inst.EmptySince != nil:
+			if _, err := s.instanceRepo.Update(ctx, inst.ID, repository.ZoneInstanceUpdates{ClearEmptySince: true}); err != nil {
+				dblog.Error("failed to update instance", err, slog.String("service", "instances"), slog.Int("instance_id", inst.ID))
+			}
+		case empty && inst.EmptySince == nil:
+			if _, err := s.instanceRepo.Update(ctx, inst.ID, repository.ZoneInstanceUpdates{EmptySince: &now}); err != nil {
+				dblog.Error("failed to update instance", err, slog.String("service", "instances"), slog.Int("instance_id", inst.ID))
+			}
+		case empty && now.Sub(*inst.EmptySince) >= instanceIdle(z):
+			s.close(ctx, inst, "You are pulled back through the portal.")
+			continue
+		}
+
+		if z.ResetIntervalSecs <= 0 || len(z.Resets) == 0 {
+			continue
+		}
+		interval := time.Duration(z.ResetIntervalSecs) * time.Second
+		if inst.LastResetAt != nil && now.Sub(*inst.LastResetAt) < interval {
+			continue
+		}
+		if _, err := s.zones.ResetInstance(ctx, z, inst.RoomMap, inst.ItemMap); err != nil {
+			s.logger.Warn("instance reset failed", "instance_id", inst.ID, "error", err, slog.String("service", "instances"))
+			continue
+		}
+		if _, err := s.instanceRepo.Update(ctx, inst.ID, repository.ZoneInstanceUpdates{LastResetAt: &now}); err != nil {
+			dblog.Error("failed to update instance", err, slog.String("service", "instances"), slog.Int("instance_id", inst.ID))
+		}
+	}
+	return nil
+}
+
+// List returns the open copies of a zone.
+func (s *instanceService) List(ctx context.Context, zoneID string) ([]*db.ZoneInstance, error) {
+	return s.instanceRepo.ListByZone(ctx, zoneID)
+}
+
+// Close tears a copy down straight away, sending anyone inside back out.
+func (s *instanceService) Close(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	inst, err := s.instanceRepo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.close(ctx, inst, "The world around you dissolves, and you find yourself back where you started.")
+}
+
+// close sends players in the copy back to the room they entered it from,
+// telling them msg, then deletes the copy's NPCs, items and rooms. If the
+// players can't all be moved out, nothing is deleted and the error is
+// returned, so the copy stays for the next Tick to retry rather than
+// leaving anyone in a deleted room. Failures after that are logged rather
+// than returned so one stuck row doesn't keep the rest of the copy alive.
+func (s *instanceService) close(ctx context.Context, inst *db.ZoneInstance, msg string) error {
+	copies := make(map[int]bool, len(inst.RoomMap))
+	for _, id := range inst.RoomMap {
+		copies[id] = true
+	}
+
+	chars, err := s.charRepo.ListAll(ctx)
+	if err != nil {
+		dblog.Error("failed to list characters", err, slog.String("service", "instances"), slog.Int("instance_id", inst.ID))
+		return fmt.Errorf("close instance %d: %w", inst.ID, err)
+	}
+	var npcs []*db.Character
+	var stuck error
+	for _, ch := range chars {
+		if ch.IsNPC {
+			if copies[ch.CurrentRoomId] || copies[ch.StartingRoomId] {
+				npcs = append(npcs, ch)
+			}
+			continue
+		}
+		if !copies[ch.CurrentRoomId] {
+			continue
+		}
+		back := inst.ReturnRoomID
+		if _, err := s.charRepo.Update(ctx, ch.ID, repository.CharacterUpdates{CurrentRoomID: &back}); err != nil {
+			dblog.Error("failed to move character out of instance", err, slog.String("service", "instances"), slog.Int("character_id", ch.ID))
+			stuck = err
+			continue
+		}
+		if msg != "" {
+			stream.Default().Send(ch.ID, stream.Event{Type: stream.TypeWorld, Text: msg})
+		}
+	}
+	if stuck != nil {
+		return fmt.Errorf("close instance %d: %w", inst.ID, stuck)
+	}
+	for _, npc := range npcs {
+		s.deleteNPC(ctx, npc)
+	}
+
+	for id := range copies {
+		onFloor, err := s.equipRepo.ListByRoom(ctx, id)
+		if err == nil {
+			for _, it := range onFloor {
+				s.deleteItem(ctx, it)
+			}
+		}
+	}
+	// Copies nobody picked up, such as the contents of copied containers.
+	for _, id := range inst.ItemMap {
+		if it, err := s.equipRepo.Get(ctx, id); err == nil && it.OwnerId == nil {
+			s.deleteItem(ctx, it)
+		}
+	}
+	for id := range copies {
+		if err := s.roomRepo.Delete(ctx, id); err != nil && !db.IsNotFound(err) {
+			dblog.Error("failed to delete instance room", err, slog.String("service", "instances"), slog.Int("room_id", id))
+		}
+	}
+	if err := s.instanceRepo.Delete(ctx, inst.ID); err != nil && !db.IsNotFound(err) {
+		dblog.Error("failed to delete instance", err, slog.String("service", "instances"), slog.Int("instance_id", inst.ID))
+		return nil
+	}
+	s.logger.Info("instance closed", "instance_id", inst.ID, "zone_id", inst.ZoneID, slog.String("service", "instances"))
+	return nil
+}
+
+// deleteNPC deletes a copied NPC and everything it carries.
+func (s *instanceService) deleteNPC(ctx context.Context, npc *db.Character) {
+	if carried, err := s.equipRepo.ListByOwner(ctx, npc.ID); err == nil {
+		for _, it := range carried {
+			s.deleteItem(ctx, it)
+		}
+	}
+	if err := s.charRepo.Delete(ctx, npc.ID); err != nil && !db.IsNotFound(err) {
+		dblog.Error("failed to delete instance npc", err, slog.String("service", "instances"), slog.Int("character_id", npc.ID))
+	}
+}
+
+// deleteItem deletes an item and, for a container, the contents nobody has
+// taken out.
+func (s *instanceService) deleteItem(ctx context.Context, it *db.Equipment) {
+	if it.IsContainer && it.ContainedItems != "" {
+		var contents []int
+		if json.Unmarshal([]byte(it.ContainedItems), &contents) == nil {
+			for _, id := range contents {
+				if inner, err := s.equipRepo.Get(ctx, id); err == nil && inner.OwnerId == nil {
+					s.deleteItem(ctx, inner)
+				}
+			}
+		}
+	}
+	if err := s.equipRepo.Delete(ctx, it.ID); err != nil && !db.IsNotFound(err) {
+		dblog.Error("failed to delete instance item", err, slog.String("service", "instances"), slog.Int("equipment_id", it.ID))
+	}
+}
+
+// instanceLifetime is how long a copy of z lasts from when it opens.
+func instanceLifetime(z *db.Zone) time.Duration {
+	mins := z.Instance.LifetimeMins
+	if mins <= 0 {
+		mins = defaultInstanceLifetimeMins
+	}
+	return time.Duration(mins) * time.Minute
+}
+
+// instanceIdle is how long a copy of z may sit empty before it closes.
+func instanceIdle(z *db.Zone) time.Duration {
+	mins := z.Instance.IdleMins
+	if mins <= 0 {
+		mins = defaultInstanceIdleMins
+	}
+	return time.Duration(mins) * time.Minute
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"herbst-server/db"
+	"herbst-server/db/schema"
+	"herbst-server/repository"
+)
+
+func TestValidateZoneInstancing(t *testing.T) {
+	rooms := []int{10, 11, 12}
+	if err := ValidateZoneInstancing(&schema.ZoneInstancing{PortalRoomID: 5, PortalExit: "down", EntryRoomID: 10}, rooms); err != nil {
+		t.Errorf("valid config: %v", err)
+	}
+	if err := ValidateZoneInstancing(&schema.ZoneInstancing{}, rooms); err != nil {
+		t.Errorf("no portal means not instanced: %v", err)
+	}
+	invalid := []schema.ZoneInstancing{
+		{PortalRoomID: 5, EntryRoomID: 10},
+		{PortalRoomID: 11, PortalExit: "down", EntryRoomID: 10},
+		{PortalRoomID: 5, PortalExit: "down", EntryRoomID: 99},
+		{PortalRoomID: 5, PortalExit: "down", EntryRoomID: 10, IdleMins: -1},
+	}
+	for i, inst := range invalid {
+		if err := ValidateZoneInstancing(&inst, rooms); !errors.Is(err, ErrInvalidInstancing) {
+			t.Errorf("invalid[%d]: got %v, want ErrInvalidInstancing", i, err)
+		}
+	}
+}
+
+func TestRemapExits(t *testing.T) {
+	rooms := map[int]int{10: 110, 11: 111}
+	got := remapExits(map[string]int{"north": 11, "up": 5}, rooms)
+	if got["north"] != 111 {
+		t.Errorf("exit inside the zone should lead to the copy, got %d", got["north"])
+	}
+	if got["up"] != 5 {
+		t.Errorf("exit out of the zone should be kept, got %d", got["up"])
+	}
+}
+
+func TestMapRoomIDs(t *testing.T) {
+	got := mapRoomIDs([]int{10, 7, 11}, map[int]int{10: 110, 11: 111})
+	if len(got) != 2 || got[0] != 110 || got[1] != 111 {
+		t.Errorf("got %v, want [110 111]", got)
+	}
+}
+
+// stuckCharRepo lists one player standing in room 20, or fails to list at
+// all, and can't move anyone.
+type stuckCharRepo struct {
+	repository.CharacterRepo
+	listErr error
+}
+
+func (r stuckCharRepo) ListAll(context.Context) ([]*db.Character, error) {
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+	return []*db.Character{{ID: 1, CurrentRoomId: 20}}, nil
+}
+
+func (r stuckCharRepo) Update(context.Context, int, repository.CharacterUpdates) (*db.Character, error) {
+	return nil, errors.New("database is locked")
+}
+
+// deletedRooms records the rooms deleted through it.
+type deletedRooms struct {
+	repository.RoomRepo
+	ids *[]int
+}
+
+func (r deletedRooms) Delete(_ context.Context, id int) error {
+	*r.ids = append(*r.ids, id)
+	return nil
+}
+
+func TestCloseKeepsCopyWhenPlayersCantLeave(t *testing.T) {
+	inst := &db.ZoneInstance{ID: 3, RoomMap: map[int]int{10: 20}, ReturnRoomID: 5}
+	for _, chars := range []stuckCharRepo{{listErr: errors.New("database is locked")}, {}} {
+		var deleted []int
+		s := &instanceService{
+			charRepo: chars,
+			roomRepo: deletedRooms{ids: &deleted},
+			logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		if err := s.close(context.Background(), inst, ""); err == nil {
+			t.Errorf("listing error %v: close returned nil", chars.listErr)
+		}
+		if len(deleted) != 0 {
+			t.Errorf("listing error %v: rooms %v deleted with a player still inside", chars.listErr, deleted)
+		}
+	}
+}
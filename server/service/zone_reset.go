@@ -0,0 +1,511 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"herbst-server/db"
+	"herbst-server/db/schema"
+	"herbst-server/repository"
+)
+
+// Zone reset command types.
+const (
+	ZoneResetNPC   = "npc"
+	ZoneResetItem  = "item"
+	ZoneResetEquip = "equip"
+	ZoneResetLock  = "lock"
+)
+
+// ValidateResets checks a zone reset script before it is saved: each
+// command needs the keys its type uses, and the templates it names must
+// exist.
+func (s *ZoneService) ValidateResets(ctx context.Context, worldID string, resets []schema.ZoneReset) error {
+	for i, r := range resets {
+		if err := validateReset(r); err != nil {
+			return fmt.Errorf("resets[%d]: %w", i, err)
+		}
+		if r.NPCTemplateID != "" {
+			if _, err := s.npcTemplate.Get(ctx, r.NPCTemplateID); err != nil {
+				return fmt.Errorf("resets[%d]: npc template not found: %s", i, r.NPCTemplateID)
+			}
+		}
+		if r.Item != "" {
+			if _, err := s.itemTemplate(ctx, r.Item, worldID); err != nil {
+				return fmt.Errorf("resets[%d]: equipment template not found: %s", i, r.Item)
+			}
+		}
+	}
+	return nil
+}
+
+// validateReset checks the shape of one reset command.
+func validateReset(r schema.ZoneReset) error {
+	if r.Count < 0 || r.Max < 0 {
+		return fmt.Errorf("count and max must be non-negative")
+	}
+	switch r.Type {
+	case ZoneResetNPC:
+		if r.NPCTemplateID == "" || len(r.RoomIDs) == 0 {
+			return fmt.Errorf("npc reset needs npc_template_id and room_ids")
+		}
+		if r.Count < 1 {
+			return fmt.Errorf("npc reset needs a count of at least 1")
+		}
+		if r.Max > 0 && r.Max < r.Count {
+			return fmt.Errorf("max must be at least count")
+		}
+	case ZoneResetItem:
+		if r.Item == "" || (len(r.RoomIDs) == 0 && r.ContainerID == 0) {
+			return fmt.Errorf("item reset needs item and room_ids or container_id")
+		}
+	case ZoneResetEquip:
+		if r.NPCTemplateID == "" || r.Item == "" {
+			return fmt.Errorf("equip reset needs npc_template_id and item")
+		}
+	case ZoneResetLock:
+		if r.ContainerID == 0 {
+			return fmt.Errorf("lock reset needs container_id")
+		}
+	default:
+		return fmt.Errorf("unknown reset type %q", r.Type)
+	}
+	return nil
+}
+
+// ResetDue resets every zone in every world whose reset interval has
+// elapsed. It returns the reports of the zones it reset.
+func (s *ZoneService) ResetDue(ctx context.Context, now time.Time) ([]*schema.ZoneResetReport, error) {
+	zones, err := s.zoneRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var reports []*schema.ZoneResetReport
+	for _, z := range zones {
+		// Instanced zones only reset inside their copies.
+		if z.ResetIntervalSecs <= 0 || len(z.Resets) == 0 || IsInstanced(z) {
+			continue
+		}
+		interval := time.Duration(z.ResetIntervalSecs) * time.Second
+		if z.LastResetAt != nil && now.Sub(*z.LastResetAt) < interval {
+			continue
+		}
+		report, err := s.ResetZone(ctx, z.ID)
+		if err != nil {
+			return reports, fmt.Errorf("reset zone %s: %w", z.ID, err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// ResetZone runs the zone's reset script and saves the report. A failing
+// command is recorded in the report and does not stop the ones after it.
+func (s *ZoneService) ResetZone(ctx context.Context, id string) (*schema.ZoneResetReport, error) {
+	z, err := s.zoneRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	npcs, err := s.charRepo.ListAllNPCs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	npcs, err = s.outsideInstances(ctx, npcs)
+	if err != nil {
+		return nil, err
+	}
+
+	report := s.runResets(ctx, z, z.Resets, npcs)
+	if _, err := s.zoneRepo.Update(ctx, z.ID, repository.ZoneUpdates{
+		LastResetAt:     &report.At,
+		LastResetReport: report,
+	}); err != nil {
+		return report, err
+	}
+	s.logger.Info("zone reset", "zone_id", z.ID, "commands", len(report.Commands), slog.String("service", "zones"))
+	return report, nil
+}
+
+// runResets runs a reset script against z's rooms. npcs are the NPCs the
+// script may revive, equip and count towards population caps.
+func (s *ZoneService) runResets(ctx context.Context, z *db.Zone, resets []schema.ZoneReset, npcs []*db.Character) *schema.ZoneResetReport {
+	report := &schema.ZoneResetReport{ZoneID: z.ID, At: time.Now(), Commands: []schema.ZoneResetResult{}}
+	for i, r := range resets {
+		res := schema.ZoneResetResult{Index: i, Type: r.Type}
+		var err error
+		switch r.Type {
+		case ZoneResetNPC:
+			npcs, err = s.resetNPCs(ctx, z, r, npcs, &res)
+		case ZoneResetItem:
+			err = s.resetItems(ctx, z, r, &res)
+		case ZoneResetEquip:
+			err = s.resetEquipment(ctx, z, r, npcs, &res)
+		case ZoneResetLock:
+			err = s.resetLock(ctx, r, &res)
+		default:
+			err = fmt.Errorf("unknown reset type %q", r.Type)
+		}
+		if err != nil {
+			res.Error = err.Error()
+			s.logger.Warn("zone reset command failed", "zone_id", z.ID, "index", i, "type", r.Type, "error", err, slog.String("service", "zones"))
+		}
+		report.Commands = append(report.Commands, res)
+	}
+	return report
+}
+
+// ResetInstance runs z's reset script inside one private copy of the zone.
+// Rooms and containers in the script are swapped for their copies; anything
+// that wasn't copied is dropped. Population caps only count the copy's own
+// NPCs. The report is returned, not saved on the zone.
+func (s *ZoneService) ResetInstance(ctx context.Context, z *db.Zone, rooms, items map[int]int) (*schema.ZoneResetReport, error) {
+	all, err := s.charRepo.ListAllNPCs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inCopy := make(map[int]bool, len(rooms))
+	for _, id := range rooms {
+		inCopy[id] = true
+	}
+	var npcs []*db.Character
+	for _, npc := range all {
+		if inCopy[npc.CurrentRoomId] || inCopy[npc.StartingRoomId] {
+			npcs = append(npcs, npc)
+		}
+	}
+
+	copyZone := *z
+	copyZone.RoomIds = mapRoomIDs(z.RoomIds, rooms)
+	resets := make([]schema.ZoneReset, 0, len(z.Resets))
+	for _, r := range z.Resets {
+		mapped := r
+		if len(r.RoomIDs) > 0 {
+			mapped.RoomIDs = mapRoomIDs(r.RoomIDs, rooms)
+			if len(mapped.RoomIDs) == 0 {
+				continue
+			}
+		}
+		if r.ContainerID != 0 {
+			if mapped.ContainerID = items[r.ContainerID]; mapped.ContainerID == 0 {
+				continue
+			}
+		}
+		resets = append(resets, mapped)
+	}
+	return s.runResets(ctx, &copyZone, resets, npcs), nil
+}
+
+// outsideInstances drops the NPCs living in zone copies, so they don't count
+// towards the population caps of the zones they were copied from.
+func (s *ZoneService) outsideInstances(ctx context.Context, npcs []*db.Character) ([]*db.Character, error) {
+	instances, err := s.instanceRepo.ListAll(ctx)
+	if err != nil || len(instances) == 0 {
+		return npcs, err
+	}
+	copied := map[int]bool{}
+	for _, inst := range instances {
+		for _, id := range inst.RoomMap {
+			copied[id] = true
+		}
+	}
+	out := make([]*db.Character, 0, len(npcs))
+	for _, npc := range npcs {
+		if !copied[npc.CurrentRoomId] && !copied[npc.StartingRoomId] {
+			out = append(out, npc)
+		}
+	}
+	return out, nil
+}
+
+// mapRoomIDs swaps each room for its copy, dropping rooms that have none.
+func mapRoomIDs(ids []int, rooms map[int]int) []int {
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if c, ok := rooms[id]; ok {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// resetNPCs keeps r.Count living instances of the template in r.RoomIDs.
+// Dead instances in those rooms are revived before new ones are spawned,
+// and nothing is added once the world holds r.Max living instances. It
+// returns npcs with any spawned instances appended.
+func (s *ZoneService) resetNPCs(ctx context.Context, z *db.Zone, r schema.ZoneReset, npcs []*db.Character, res *schema.ZoneResetResult) ([]*db.Character, error) {
+	tmpl, err := s.npcTemplate.Get(ctx, r.NPCTemplateID)
+	if err != nil {
+		return npcs, fmt.Errorf("npc template not found: %s", r.NPCTemplateID)
+	}
+	max := r.Max
+	if max == 0 {
+		max = r.Count
+	}
+
+	inRooms := make(map[int]bool, len(r.RoomIDs))
+	for _, id := range r.RoomIDs {
+		inRooms[id] = true
+	}
+	occupancy := make(map[int]int, len(r.RoomIDs))
+	var dead []*db.Character
+	alive, aliveInWorld, lastInstance := 0, 0, 0
+	for _, npc := range npcs {
+		if npc.NpcTemplateID != tmpl.ID || npc.CurrentWorld != z.WorldID {
+			continue
+		}
+		if npc.InstanceNumber > lastInstance {
+			lastInstance = npc.InstanceNumber
+		}
+		if npc.Hitpoints <= 0 {
+			if inRooms[npc.CurrentRoomId] {
+				dead = append(dead, npc)
+			}
+			continue
+		}
+		aliveInWorld++
+		if inRooms[npc.CurrentRoomId] {
+			alive++
+			occupancy[npc.CurrentRoomId]++
+		}
+	}
+
+	for alive < r.Count {
+		if aliveInWorld >= max {
+			res.Capped = true
+			break
+		}
+		room := emptiestRoom(r.RoomIDs, occupancy)
+		if len(dead) > 0 {
+			npc := dead[0]
+			dead = dead[1:]
+			hp := npc.MaxHitpoints
+			if _, err := s.charRepo.Update(ctx, npc.ID, repository.CharacterUpdates{
+				Hitpoints:     &hp,
+				CurrentRoomID: &room,
+				ClearDiedAt:   true,
+			}); err != nil {
+				return npcs, err
+			}
+			npc.Hitpoints, npc.CurrentRoomId, npc.DiedAt = hp, room, nil
+			res.Revived++
+		} else {
+			lastInstance++
+			npc, err := s.charRepo.Create(ctx, repository.CreateCharacterInput{
+				Name:           tmpl.Name,
+				IsNPC:          true,
+				IsInstance:     true,
+				InstanceNumber: lastInstance,
+				NPCTemplateID:  tmpl.ID,
+				RoomID:         room,
+				StartingRoomID: room,
+				RespawnRoomID:  room,
+				WorldID:        z.WorldID,
+				Race:           s.templateRace(ctx, tmpl),
+				Level:          tmpl.Level,
+				HP:             100,
+				MaxHP:          100,
+				Stamina:        50,
+				MaxStamina:     50,
+				Mana:           25,
+				MaxMana:        25,
+			})
+			if err != nil {
+				return npcs, err
+			}
+			npcs = append(npcs, npc)
+			res.Spawned++
+		}
+		occupancy[room]++
+		alive++
+		aliveInWorld++
+	}
+	return npcs, nil
+}
+
+// emptiestRoom returns the room in ids with the fewest occupants, the
+// first one listed on a tie.
+func emptiestRoom(ids []int, occupancy map[int]int) int {
+	best := ids[0]
+	for _, id := range ids[1:] {
+		if occupancy[id] < occupancy[best] {
+			best = id
+		}
+	}
+	return best
+}
+
+// templateRace is the race name new instances of tmpl get.
+func (s *ZoneService) templateRace(ctx context.Context, tmpl *db.NPCTemplate) string {
+	if tmpl.RaceID != 0 {
+		if race, err := s.raceRepo.Get(ctx, tmpl.RaceID); err == nil {
+			return race.Name
+		}
+	}
+	return "human"
+}
+
+// resetItems tops each room in r.RoomIDs, or the container r.ContainerID,
+// up to r.Count copies of the item.
+func (s *ZoneService) resetItems(ctx context.Context, z *db.Zone, r schema.ZoneReset, res *schema.ZoneResetResult) error {
+	tmpl, err := s.itemTemplate(ctx, r.Item, z.WorldID)
+	if err != nil {
+		return fmt.Errorf("equipment template not found: %s", r.Item)
+	}
+	want := r.Count
+	if want == 0 {
+		want = 1
+	}
+
+	if r.ContainerID != 0 {
+		return s.stockContainer(ctx, r.ContainerID, tmpl, want, res)
+	}
+	for _, roomID := range r.RoomIDs {
+		items, err := s.equipRepo.ListByRoom(ctx, roomID)
+		if err != nil {
+			return err
+		}
+		have := 0
+		for _, it := range items {
+			if it.EquipmentTemplateID == tmpl.ID && it.OwnerId == nil {
+				have++
+			}
+		}
+		for ; have < want; have++ {
+			input := craftedItem(tmpl, 0, false)
+			input.OwnerID = nil
+			room := roomID
+			input.RoomID = &room
+			if _, err := s.equipRepo.Create(ctx, input); err != nil {
+				return err
+			}
+			res.Placed++
+		}
+	}
+	return nil
+}
+
+// stockContainer tops a container up to want copies of the item. Items
+// that were taken out (now owned by someone) or deleted no longer count.
+func (s *ZoneService) stockContainer(ctx context.Context, containerID int, tmpl *db.EquipmentTemplate, want int, res *schema.ZoneResetResult) error {
+	container, err := s.equipRepo.Get(ctx, containerID)
+	if err != nil {
+		return fmt.Errorf("container not found: %d", containerID)
+	}
+	if !container.IsContainer {
+		return fmt.Errorf("item %d is not a container", containerID)
+	}
+	var contents []int
+	if container.ContainedItems != "" {
+		if err := json.Unmarshal([]byte(container.ContainedItems), &contents); err != nil {
+			return fmt.Errorf("container %d has unreadable contents: %w", containerID, err)
+		}
+	}
+
+	kept := make([]int, 0, len(contents))
+	have := 0
+	for _, id := range contents {
+		it, err := s.equipRepo.Get(ctx, id)
+		if err != nil || it.OwnerId != nil {
+			continue
+		}
+		kept = append(kept, id)
+		if it.EquipmentTemplateID == tmpl.ID {
+			have++
+		}
+	}
+	for ; have < want; have++ {
+		if container.ContainerCapacity > 0 && len(kept) >= container.ContainerCapacity {
+			res.Capped = true
+			break
+		}
+		input := craftedItem(tmpl, 0, false)
+		input.OwnerID = nil
+		it, err := s.equipRepo.Create(ctx, input)
+		if err != nil {
+			return err
+		}
+		kept = append(kept, it.ID)
+		res.Placed++
+	}
+	if len(kept) == len(contents) && res.Placed == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(kept)
+	contained := string(data)
+	_, err = s.equipRepo.Update(ctx, containerID, repository.EquipmentUpdates{ContainedItems: &contained})
+	return err
+}
+
+// resetEquipment gives every living instance of the template in the reset's
+// rooms (default: the zone's rooms) the item, equipped, unless it already
+// carries one.
+func (s *ZoneService) resetEquipment(ctx context.Context, z *db.Zone, r schema.ZoneReset, npcs []*db.Character, res *schema.ZoneResetResult) error {
+	tmpl, err := s.itemTemplate(ctx, r.Item, z.WorldID)
+	if err != nil {
+		return fmt.Errorf("equipment template not found: %s", r.Item)
+	}
+	rooms := r.RoomIDs
+	if len(rooms) == 0 {
+		rooms = z.RoomIds
+	}
+	inRooms := make(map[int]bool, len(rooms))
+	for _, id := range rooms {
+		inRooms[id] = true
+	}
+
+	for _, npc := range npcs {
+		if npc.NpcTemplateID != r.NPCTemplateID || npc.Hitpoints <= 0 || !inRooms[npc.CurrentRoomId] {
+			continue
+		}
+		items, err := s.equipRepo.ListByOwner(ctx, npc.ID)
+		if err != nil {
+			return err
+		}
+		has := false
+		for _, it := range items {
+			if it.EquipmentTemplateID == tmpl.ID {
+				has = true
+				break
+			}
+		}
+		if has {
+			continue
+		}
+		input := craftedItem(tmpl, npc.ID, false)
+		input.IsEquipped = true
+		if _, err := s.equipRepo.Create(ctx, input); err != nil {
+			return err
+		}
+		res.Equipped++
+	}
+	return nil
+}
+
+// resetLock locks the container again.
+func (s *ZoneService) resetLock(ctx context.Context, r schema.ZoneReset, res *schema.ZoneResetResult) error {
+	container, err := s.equipRepo.Get(ctx, r.ContainerID)
+	if err != nil {
+		return fmt.Errorf("container not found: %d", r.ContainerID)
+	}
+	if container.IsLocked {
+		return nil
+	}
+	locked := true
+	if _, err := s.equipRepo.Update(ctx, container.ID, repository.EquipmentUpdates{IsLocked: &locked}); err != nil {
+		return err
+	}
+	res.Locked = true
+	return nil
+}
+
+// itemTemplate finds an equipment template by slug, preferring the zone's
+// world over templates shared by every world.
+func (s *ZoneService) itemTemplate(ctx context.Context, slug, worldID string) (*db.EquipmentTemplate, error) {
+	if t, err := s.equipTmplRepo.GetBySlug(ctx, slug, worldID); err == nil {
+		return t, nil
+	}
+	return s.equipTmplRepo.GetBySlug(ctx, slug, "")
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"testing"
+
+	"herbst-server/db/schema"
+)
+
+func TestValidateReset(t *testing.T) {
+	valid := []schema.ZoneReset{
+		{Type: ZoneResetNPC, NPCTemplateID: "giant_rat", RoomIDs: []int{1, 2}, Count: 3, Max: 5},
+		{Type: ZoneResetItem, Item: "torch", RoomIDs: []int{1}, Count: 1},
+		{Type: ZoneResetItem, Item: "torch", ContainerID: 9, Count: 2},
+		{Type: ZoneResetEquip, NPCTemplateID: "guard", Item: "iron_sword"},
+		{Type: ZoneResetLock, ContainerID: 9},
+	}
+	for i, r := range valid {
+		if err := validateReset(r); err != nil {
+			t.Errorf("valid[%d]: %v", i, err)
+		}
+	}
+	invalid := []schema.ZoneReset{
+		{Type: ZoneResetNPC, NPCTemplateID: "giant_rat", Count: 1},
+		{Type: ZoneResetNPC, NPCTemplateID: "giant_rat", RoomIDs: []int{1}},
+		{Type: ZoneResetNPC, NPCTemplateID: "giant_rat", RoomIDs: []int{1}, Count: 3, Max: 2},
+		{Type: ZoneResetItem, Item: "torch"},
+		{Type: ZoneResetEquip, Item: "iron_sword"},
+		{Type: ZoneResetLock},
+		{Type: ZoneResetItem, Item: "torch", RoomIDs: []int{1}, Count: -1},
+		{Type: "door"},
+	}
+	for i, r := range invalid {
+		if err := validateReset(r); err == nil {
+			t.Errorf("invalid[%d]: expected an error for %+v", i, r)
+		}
+	}
+}
+
+func TestEmptiestRoom(t *testing.T) {
+	occupancy := map[int]int{1: 2, 2: 0, 3: 1}
+	if got := emptiestRoom([]int{1, 2, 3}, occupancy); got != 2 {
+		t.Errorf("got room %d, want 2", got)
+	}
+	// Ties go to the first room listed.
+	if got := emptiestRoom([]int{4, 5}, occupancy); got != 4 {
+		t.Errorf("got room %d, want 4", got)
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"regexp"
 
 	"herbst-server/db"
@@ -12,14 +13,35 @@ import (
 var colorRegex = regexp.MustCompile(`^#([A-Fa-f0-9]{3}){1,2}$`)
 
 type ZoneService struct {
-	zoneRepo    repository.ZoneRepository
-	npcTemplate repository.NPCTemplateRepo
+	zoneRepo      repository.ZoneRepository
+	npcTemplate   repository.NPCTemplateRepo
+	charRepo      repository.CharacterRepo
+	raceRepo      repository.RaceRepo
+	equipRepo     repository.EquipmentRepo
+	equipTmplRepo repository.EquipmentTemplateRepo
+	instanceRepo  repository.ZoneInstanceRepo
+	logger        *slog.Logger
 }
 
-func NewZoneService(zoneRepo repository.ZoneRepository, npcTemplate repository.NPCTemplateRepo) *ZoneService {
+func NewZoneService(
+	zoneRepo repository.ZoneRepository,
+	npcTemplate repository.NPCTemplateRepo,
+	charRepo repository.CharacterRepo,
+	raceRepo repository.RaceRepo,
+	equipRepo repository.EquipmentRepo,
+	equipTmplRepo repository.EquipmentTemplateRepo,
+	instanceRepo repository.ZoneInstanceRepo,
+	logger *slog.Logger,
+) *ZoneService {
 	return &ZoneService{
-		zoneRepo:    zoneRepo,
-		npcTemplate: npcTemplate,
+		zoneRepo:      zoneRepo,
+		npcTemplate:   npcTemplate,
+		charRepo:      charRepo,
+		raceRepo:      raceRepo,
+		equipRepo:     equipRepo,
+		equipTmplRepo: equipTmplRepo,
+		instanceRepo:  instanceRepo,
+		logger:        logger,
 	}
 }
 
@@ -49,6 +71,18 @@ func (s *ZoneService) CreateZone(ctx context.Context, input repository.CreateZon
 	if input.MinLevel < 0 {
 		return nil, fmt.Errorf("min_level must be non-negative")
 	}
+	if input.ResetIntervalSecs < 0 {
+		return nil, fmt.Errorf("reset_interval_secs must be non-negative")
+	}
+	if err := s.ValidateResets(ctx, input.WorldID, input.Resets); err != nil {
+		return nil, err
+	}
+	if err := ValidateZoneWeather(input.Weather); err != nil {
+		return nil, err
+	}
+	if err := ValidateZoneInstancing(input.Instance, input.RoomIDs); err != nil {
+		return nil, err
+	}
 	return s.zoneRepo.Create(ctx, input)
 }
 
@@ -86,6 +120,42 @@ func (s *ZoneService) UpdateZone(ctx context.Context, id string, updates reposit
 	if updates.MinLevel != nil && *updates.MinLevel < 0 {
 		return nil, fmt.Errorf("min_level must be non-negative")
 	}
+	if updates.ResetIntervalSecs != nil && *updates.ResetIntervalSecs < 0 {
+		return nil, fmt.Errorf("reset_interval_secs must be non-negative")
+	}
+	if updates.Resets != nil {
+		existing, err := s.zoneRepo.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.ValidateResets(ctx, existing.WorldID, *updates.Resets); err != nil {
+			return nil, err
+		}
+	}
+	if updates.Weather != nil {
+		if err := ValidateZoneWeather(updates.Weather); err != nil {
+			return nil, err
+		}
+		// A new table takes effect on the next weather tick.
+		cleared := ""
+		updates.CurrentWeather = &cleared
+	}
+	if updates.Instance != nil || updates.RoomIDs != nil {
+		existing, err := s.zoneRepo.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		inst, rooms := &existing.Instance, existing.RoomIds
+		if updates.Instance != nil {
+			inst = updates.Instance
+		}
+		if updates.RoomIDs != nil {
+			rooms = *updates.RoomIDs
+		}
+		if err := ValidateZoneInstancing(inst, rooms); err != nil {
+			return nil, err
+		}
+	}
 	return s.zoneRepo.Update(ctx, id, updates)
 }
 
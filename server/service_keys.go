@@ -0,0 +1,44 @@
+package main
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"herbst-server/middleware"
+)
+
+// watchServiceKeys loads the service keys and reloads them on SIGHUP, so a
+// key can be rotated by editing SERVICE_KEYS_FILE without a restart.
+func watchServiceKeys() {
+	logServiceKeys("loaded")
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := middleware.ReloadServiceKeys(); err != nil {
+				log.Printf("[service-keys] reload failed, keeping current keys: %v", err)
+				continue
+			}
+			logServiceKeys("reloaded")
+		}
+	}()
+}
+
+func logServiceKeys(what string) {
+	ids := middleware.ServiceKeys().IDs()
+	sort.Strings(ids)
+	log.Printf("[service-keys] %s %d key(s): %s", what, len(ids), strings.Join(ids, ", "))
+	if len(ids) == 0 {
+		log.Printf("[service-keys] ERROR: no service keys; signed requests from the SSH server will be refused. Set SERVICE_KEYS or SERVICE_KEYS_FILE (or SERVICE_KEYS_DEV=1 for local development)")
+	}
+	for _, id := range ids {
+		if id == "dev" {
+			log.Printf("[service-keys] WARNING: using the development service key; set SERVICE_KEYS or SERVICE_KEYS_FILE in production")
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"herbst-server/service"
+)
+
+// startShopRestock launches the background goroutine that refills shop stock.
+// Each shop restocks on its own restock_interval_seconds; the ticker only sets
+// how often we check which shops are due.
+func startShopRestock(services *service.Container) {
+	interval := 1 * time.Minute
+	log.Printf("[shop-restock] running: checking shops every %s", interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			n, err := services.Shop.RestockDue(context.Background(), time.Now())
+			if err != nil {
+				log.Printf("[shop-restock] restock error: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("[shop-restock] restocked %d shop(s)", n)
+			}
+		}
+	}()
+}
@@ -0,0 +1,152 @@
+// Package stream pushes live room events — arrivals, departures, speech,
+// combat and roaming NPCs — to connected player sessions. Each session
+// subscribes for its character; publishers address a character or a whole
+// room and the Hub fans the event out to whoever is listening.
+package stream
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Event types delivered to sessions.
+const (
+	TypeEnter       = "enter"
+	TypeLeave       = "leave"
+	TypeSay         = "say"
+	TypeEmote       = "emote"
+	TypeCombat      = "combat"
+	TypeNPC         = "npc"
+	TypeChannel     = "channel"
+	TypeParty       = "party"
+	TypeEffect      = "effect"
+	TypeCraft       = "craft"
+	TypeAchievement = "achievement"
+	TypeWorld       = "world"
+	TypeMail        = "mail"
+	TypeTrade       = "trade"
+	TypeAuction     = "auction"
+	TypeReputation  = "reputation"
+	TypeHouse       = "house"
+)
+
+// subscriberBuffer is how many events a slow session may fall behind
+// before further events to it are dropped.
+const subscriberBuffer = 32
+
+// Event is a single line of live output for a session.
+type Event struct {
+	Type      string `json:"type"`
+	Text      string `json:"text"`
+	RoomID    int    `json:"room_id,omitempty"`
+	ActorID   int    `json:"actor_id,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// RoomLookup returns the IDs of the player characters currently in a room.
+type RoomLookup func(ctx context.Context, roomID int) ([]int, error)
+
+// Hub routes events to subscribed characters.
+type Hub struct {
+	mu     sync.RWMutex
+	subs   map[int]map[chan Event]struct{}
+	lookup RoomLookup
+}
+
+// NewHub creates an empty Hub. Room delivery is a no-op until a RoomLookup
+// is set.
+func NewHub() *Hub {
+	return &Hub{subs: make(map[int]map[chan Event]struct{})}
+}
+
+var defaultHub = NewHub()
+
+// Default returns the process-wide Hub.
+func Default() *Hub {
+	return defaultHub
+}
+
+// SetRoomLookup sets how the Hub finds the characters in a room.
+func (h *Hub) SetRoomLookup(fn RoomLookup) {
+	h.mu.Lock()
+	h.lookup = fn
+	h.mu.Unlock()
+}
+
+// Subscribe registers a listener for charID. The returned cancel func must
+// be called when the session goes away; it closes the channel.
+func (h *Hub) Subscribe(charID int) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+	h.mu.Lock()
+	if h.subs[charID] == nil {
+		h.subs[charID] = make(map[chan Event]struct{})
+	}
+	h.subs[charID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subs[charID], ch)
+			if len(h.subs[charID]) == 0 {
+				delete(h.subs, charID)
+			}
+			h.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Listening reports whether charID has at least one open session.
+func (h *Hub) Listening(charID int) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subs[charID]) > 0
+}
+
+// Send delivers ev to every session of charID.
+func (h *Hub) Send(charID int, ev Event) {
+	if ev.Timestamp == 0 {
+		ev.Timestamp = time.Now().UnixMilli()
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for ch := range h.subs[charID] {
+		select {
+		case ch <- ev:
+		default:
+			// session too slow — drop rather than block the publisher
+		}
+	}
+}
+
+// ToRoom delivers ev to every player in roomID except the listed
+// characters (typically the actor, who already sees their own action).
+func (h *Hub) ToRoom(ctx context.Context, roomID int, ev Event, except ...int) {
+	h.mu.RLock()
+	lookup := h.lookup
+	empty := len(h.subs) == 0
+	h.mu.RUnlock()
+	if lookup == nil || empty || roomID == 0 {
+		return
+	}
+
+	ids, err := lookup(ctx, roomID)
+	if err != nil {
+		slog.Warn("stream: room lookup failed", "room_id", roomID, "error", err, slog.String("service", "stream"))
+		return
+	}
+	skip := make(map[int]bool, len(except))
+	for _, id := range except {
+		skip[id] = true
+	}
+	ev.RoomID = roomID
+	for _, id := range ids {
+		if !skip[id] {
+			h.Send(id, ev)
+		}
+	}
+}
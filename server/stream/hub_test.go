@@ -0,0 +1,56 @@
+package stream
+
+import (
+	"context"
+	"testing"
+)
+
+func TestToRoomSkipsActorAndOtherRooms(t *testing.T) {
+	h := NewHub()
+	rooms := map[int][]int{1: {10, 11}, 2: {12}}
+	h.SetRoomLookup(func(_ context.Context, roomID int) ([]int, error) {
+		return rooms[roomID], nil
+	})
+
+	actor, cancelActor := h.Subscribe(10)
+	defer cancelActor()
+	peer, cancelPeer := h.Subscribe(11)
+	defer cancelPeer()
+	elsewhere, cancelElsewhere := h.Subscribe(12)
+	defer cancelElsewhere()
+
+	h.ToRoom(context.Background(), 1, Event{Type: TypeSay, Text: "Ann says, \"hi\""}, 10)
+
+	select {
+	case ev := <-peer:
+		if ev.RoomID != 1 || ev.Text != "Ann says, \"hi\"" || ev.Timestamp == 0 {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+	default:
+		t.Fatal("peer in the room did not receive the event")
+	}
+	select {
+	case ev := <-actor:
+		t.Fatalf("actor received own event %+v", ev)
+	case ev := <-elsewhere:
+		t.Fatalf("character in another room received %+v", ev)
+	default:
+	}
+}
+
+func TestCancelClosesSubscription(t *testing.T) {
+	h := NewHub()
+	ch, cancel := h.Subscribe(5)
+	if !h.Listening(5) {
+		t.Fatal("expected character 5 to be listening")
+	}
+	cancel()
+	cancel()
+	if h.Listening(5) {
+		t.Fatal("expected no listeners after cancel")
+	}
+	if _, ok := <-ch; ok {
+		t.Fatal("expected channel to be closed")
+	}
+	h.Send(5, Event{Text: "nobody hears this"})
+}
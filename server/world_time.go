@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"herbst-server/service"
+)
+
+// startWorldTime runs a background goroutine that advances every world's
+// clock and zone weather. A game hour lasts five real minutes at the default
+// time ratio, so checking every 10s keeps phase changes close to on time.
+func startWorldTime(services *service.Container) {
+	interval := 10 * time.Second
+	log.Printf("[world-time] running: checking clocks and weather every %s", interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := services.WorldTime.Tick(context.Background(), time.Now()); err != nil {
+				log.Printf("[world-time] tick error: %v", err)
+			}
+			<-ticker.C
+		}
+	}()
+}
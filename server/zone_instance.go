@@ -0,0 +1,26 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"herbst-server/service"
+)
+
+// startZoneInstances runs a background goroutine that resets open zone
+// copies and closes those that expired or sat empty.
+func startZoneInstances(services *service.Container) {
+	interval := 30 * time.Second
+	log.Printf("[zone-instance] running: checking instances every %s", interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := services.Instance.Tick(context.Background(), time.Now()); err != nil {
+				log.Printf("[zone-instance] tick error: %v", err)
+			}
+		}
+	}()
+}
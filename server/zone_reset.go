@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"herbst-server/service"
+)
+
+// startZoneResets runs a background goroutine that resets every zone whose
+// reset_interval_secs has elapsed.
+func startZoneResets(services *service.Container) {
+	interval := 30 * time.Second
+	log.Printf("[zone-reset] running: checking zones every %s", interval)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			reports, err := services.Zone.ResetDue(context.Background(), time.Now())
+			if err != nil {
+				log.Printf("[zone-reset] reset error: %v", err)
+			}
+			if len(reports) > 0 {
+				log.Printf("[zone-reset] reset %d zone(s)", len(reports))
+			}
+		}
+	}()
+}